@@ -38,16 +38,12 @@ var _ = dsl.Service("mailing-list", func() {
 	})
 
 	dsl.Method("readyz", func() {
-		dsl.Description("Check if the service is able to take inbound requests.")
-		dsl.Result(dsl.Bytes, func() {
-			dsl.Example("OK")
-		})
+		dsl.Description("Check if the service is able to take inbound requests. Reports the status of each dependency; non-critical dependencies being down degrade the status without failing the probe.")
+		dsl.Result(ReadinessType)
 		dsl.Error("ServiceUnavailable", ServiceUnavailableError, "Service unavailable")
 		dsl.HTTP(func() {
 			dsl.GET("/readyz")
-			dsl.Response(dsl.StatusOK, func() {
-				dsl.ContentType("text/plain")
-			})
+			dsl.Response(dsl.StatusOK)
 			dsl.Response("ServiceUnavailable", dsl.StatusServiceUnavailable)
 		})
 	})
@@ -154,6 +150,34 @@ var _ = dsl.Service("mailing-list", func() {
 		})
 	})
 
+	dsl.Method("promote-groupsio-service", func() {
+		dsl.Description("Promote a formation GroupsIO service to its project's primary service, clearing its prefix")
+		dsl.Security(JWTAuth)
+		dsl.Payload(func() {
+			BearerTokenAttribute()
+			dsl.Attribute("service_id", dsl.String, "Service ID")
+			dsl.Required("service_id")
+			dsl.Token("bearer_token", dsl.String)
+		})
+		dsl.Result(GroupsioServicePromotionType)
+		dsl.Error("BadRequest", BadRequestError, "Bad request")
+		dsl.Error("NotFound", NotFoundError, "Service not found")
+		dsl.Error("Conflict", ConflictError, "Project already has a primary service")
+		dsl.Error("InternalServerError", InternalServerError, "Internal server error")
+		dsl.Error("ServiceUnavailable", ServiceUnavailableError, "Service unavailable")
+		dsl.HTTP(func() {
+			dsl.POST("/groupsio/services/{service_id}/promote")
+			dsl.Param("service_id")
+			dsl.Header("bearer_token:Authorization")
+			dsl.Response(dsl.StatusOK)
+			dsl.Response("BadRequest", dsl.StatusBadRequest)
+			dsl.Response("NotFound", dsl.StatusNotFound)
+			dsl.Response("Conflict", dsl.StatusConflict)
+			dsl.Response("InternalServerError", dsl.StatusInternalServerError)
+			dsl.Response("ServiceUnavailable", dsl.StatusServiceUnavailable)
+		})
+	})
+
 	dsl.Method("delete-groupsio-service", func() {
 		dsl.Description("Delete a GroupsIO service")
 		dsl.Security(JWTAuth)
@@ -196,6 +220,56 @@ var _ = dsl.Service("mailing-list", func() {
 		})
 	})
 
+	dsl.Method("list-groupsio-services-by-status", func() {
+		dsl.Description("List GroupsIO services across all projects that have the given status")
+		dsl.Security(JWTAuth)
+		dsl.Payload(func() {
+			BearerTokenAttribute()
+			dsl.Attribute("status", dsl.String, "Service status, e.g. pending")
+			dsl.Required("status")
+			dsl.Token("bearer_token", dsl.String)
+		})
+		dsl.Result(GroupsioServiceListType)
+		dsl.Error("BadRequest", BadRequestError, "Bad request")
+		dsl.Error("InternalServerError", InternalServerError, "Internal server error")
+		dsl.Error("ServiceUnavailable", ServiceUnavailableError, "Service unavailable")
+		dsl.HTTP(func() {
+			dsl.GET("/groupsio/services/_by_status")
+			dsl.Param("status")
+			dsl.Header("bearer_token:Authorization")
+			dsl.Response(dsl.StatusOK)
+			dsl.Response("BadRequest", dsl.StatusBadRequest)
+			dsl.Response("InternalServerError", dsl.StatusInternalServerError)
+			dsl.Response("ServiceUnavailable", dsl.StatusServiceUnavailable)
+		})
+	})
+
+	dsl.Method("list-unprovisioned-groupsio-services", func() {
+		dsl.Description("List a project's GroupsIO services that have no Groups.io group ID yet")
+		dsl.Security(JWTAuth)
+		dsl.Payload(func() {
+			BearerTokenAttribute()
+			dsl.Attribute("project_uid", dsl.String, "LFX v2 project UID", func() {
+				dsl.Format(dsl.FormatUUID)
+			})
+			dsl.Required("project_uid")
+			dsl.Token("bearer_token", dsl.String)
+		})
+		dsl.Result(GroupsioServiceListType)
+		dsl.Error("BadRequest", BadRequestError, "Bad request")
+		dsl.Error("InternalServerError", InternalServerError, "Internal server error")
+		dsl.Error("ServiceUnavailable", ServiceUnavailableError, "Service unavailable")
+		dsl.HTTP(func() {
+			dsl.GET("/groupsio/services/_unprovisioned")
+			dsl.Param("project_uid")
+			dsl.Header("bearer_token:Authorization")
+			dsl.Response(dsl.StatusOK)
+			dsl.Response("BadRequest", dsl.StatusBadRequest)
+			dsl.Response("InternalServerError", dsl.StatusInternalServerError)
+			dsl.Response("ServiceUnavailable", dsl.StatusServiceUnavailable)
+		})
+	})
+
 	dsl.Method("find-parent-groupsio-service", func() {
 		dsl.Description("Find the parent GroupsIO service for a project")
 		dsl.Security(JWTAuth)
@@ -255,6 +329,64 @@ var _ = dsl.Service("mailing-list", func() {
 		})
 	})
 
+	dsl.Method("list-groupsio-mailing-lists-by-visibility", func() {
+		dsl.Description("List a project's public or private GroupsIO subgroups")
+		dsl.Security(JWTAuth)
+		dsl.Payload(func() {
+			BearerTokenAttribute()
+			dsl.Attribute("project_uid", dsl.String, "LFX v2 project UID", func() {
+				dsl.Format(dsl.FormatUUID)
+			})
+			dsl.Attribute("public", dsl.Boolean, "List public (true) or private (false) subgroups")
+			dsl.Required("project_uid", "public")
+			dsl.Token("bearer_token", dsl.String)
+		})
+		dsl.Result(GroupsioSubgroupListType)
+		dsl.Error("BadRequest", BadRequestError, "Bad request")
+		dsl.Error("InternalServerError", InternalServerError, "Internal server error")
+		dsl.Error("ServiceUnavailable", ServiceUnavailableError, "Service unavailable")
+		dsl.HTTP(func() {
+			dsl.GET("/groupsio/mailing-lists/_by_visibility")
+			dsl.Param("project_uid")
+			dsl.Param("public")
+			dsl.Header("bearer_token:Authorization")
+			dsl.Response(dsl.StatusOK)
+			dsl.Response("BadRequest", dsl.StatusBadRequest)
+			dsl.Response("InternalServerError", dsl.StatusInternalServerError)
+			dsl.Response("ServiceUnavailable", dsl.StatusServiceUnavailable)
+		})
+	})
+
+	dsl.Method("list-groupsio-mailing-lists-updated-since", func() {
+		dsl.Description("List a project's GroupsIO subgroups updated after a cutoff, for incremental sync")
+		dsl.Security(JWTAuth)
+		dsl.Payload(func() {
+			BearerTokenAttribute()
+			dsl.Attribute("project_uid", dsl.String, "LFX v2 project UID", func() {
+				dsl.Format(dsl.FormatUUID)
+			})
+			dsl.Attribute("since", dsl.String, "Only subgroups updated after this time are returned (RFC 3339)", func() {
+				dsl.Format(dsl.FormatDateTime)
+			})
+			dsl.Required("project_uid", "since")
+			dsl.Token("bearer_token", dsl.String)
+		})
+		dsl.Result(GroupsioSubgroupListType)
+		dsl.Error("BadRequest", BadRequestError, "Bad request")
+		dsl.Error("InternalServerError", InternalServerError, "Internal server error")
+		dsl.Error("ServiceUnavailable", ServiceUnavailableError, "Service unavailable")
+		dsl.HTTP(func() {
+			dsl.GET("/groupsio/mailing-lists/_updated_since")
+			dsl.Param("project_uid")
+			dsl.Param("since")
+			dsl.Header("bearer_token:Authorization")
+			dsl.Response(dsl.StatusOK)
+			dsl.Response("BadRequest", dsl.StatusBadRequest)
+			dsl.Response("InternalServerError", dsl.StatusInternalServerError)
+			dsl.Response("ServiceUnavailable", dsl.StatusServiceUnavailable)
+		})
+	})
+
 	dsl.Method("create-groupsio-mailing-list", func() {
 		dsl.Description("Create a GroupsIO subgroup")
 		dsl.Security(JWTAuth)
@@ -429,6 +561,146 @@ var _ = dsl.Service("mailing-list", func() {
 		})
 	})
 
+	dsl.Method("list-groupsio-members-needing-review", func() {
+		dsl.Description("List members of a GroupsIO subgroup not reviewed since a cutoff, including members never reviewed")
+		dsl.Security(JWTAuth)
+		dsl.Payload(func() {
+			BearerTokenAttribute()
+			dsl.Attribute("subgroup_id", dsl.String, "Subgroup ID")
+			dsl.Attribute("older_than", dsl.String, "Review cutoff (RFC 3339)", func() {
+				dsl.Format(dsl.FormatDateTime)
+			})
+			dsl.Required("subgroup_id", "older_than")
+			dsl.Token("bearer_token", dsl.String)
+		})
+		dsl.Result(GroupsioMemberListType)
+		dsl.Error("BadRequest", BadRequestError, "Bad request")
+		dsl.Error("NotFound", NotFoundError, "Subgroup not found")
+		dsl.Error("InternalServerError", InternalServerError, "Internal server error")
+		dsl.Error("ServiceUnavailable", ServiceUnavailableError, "Service unavailable")
+		dsl.HTTP(func() {
+			dsl.GET("/groupsio/mailing-lists/{subgroup_id}/members/_needing_review")
+			dsl.Param("subgroup_id")
+			dsl.Param("older_than")
+			dsl.Header("bearer_token:Authorization")
+			dsl.Response(dsl.StatusOK)
+			dsl.Response("BadRequest", dsl.StatusBadRequest)
+			dsl.Response("NotFound", dsl.StatusNotFound)
+			dsl.Response("InternalServerError", dsl.StatusInternalServerError)
+			dsl.Response("ServiceUnavailable", dsl.StatusServiceUnavailable)
+		})
+	})
+
+	dsl.Method("list-groupsio-members-modified-since", func() {
+		dsl.Description("List members of a GroupsIO subgroup updated after a cutoff, for incremental sync")
+		dsl.Security(JWTAuth)
+		dsl.Payload(func() {
+			BearerTokenAttribute()
+			dsl.Attribute("subgroup_id", dsl.String, "Subgroup ID")
+			dsl.Attribute("since", dsl.String, "Only members updated after this time are returned (RFC 3339)", func() {
+				dsl.Format(dsl.FormatDateTime)
+			})
+			dsl.Required("subgroup_id", "since")
+			dsl.Token("bearer_token", dsl.String)
+		})
+		dsl.Result(GroupsioMemberListType)
+		dsl.Error("BadRequest", BadRequestError, "Bad request")
+		dsl.Error("NotFound", NotFoundError, "Subgroup not found")
+		dsl.Error("InternalServerError", InternalServerError, "Internal server error")
+		dsl.Error("ServiceUnavailable", ServiceUnavailableError, "Service unavailable")
+		dsl.HTTP(func() {
+			dsl.GET("/groupsio/mailing-lists/{subgroup_id}/members/_modified_since")
+			dsl.Param("subgroup_id")
+			dsl.Param("since")
+			dsl.Header("bearer_token:Authorization")
+			dsl.Response(dsl.StatusOK)
+			dsl.Response("BadRequest", dsl.StatusBadRequest)
+			dsl.Response("NotFound", dsl.StatusNotFound)
+			dsl.Response("InternalServerError", dsl.StatusInternalServerError)
+			dsl.Response("ServiceUnavailable", dsl.StatusServiceUnavailable)
+		})
+	})
+
+	dsl.Method("list-groupsio-members-by-organization", func() {
+		dsl.Description("List members of a GroupsIO subgroup whose organization matches, ignoring case")
+		dsl.Security(JWTAuth)
+		dsl.Payload(func() {
+			BearerTokenAttribute()
+			dsl.Attribute("subgroup_id", dsl.String, "Subgroup ID")
+			dsl.Attribute("organization", dsl.String, "Organization name")
+			dsl.Required("subgroup_id", "organization")
+			dsl.Token("bearer_token", dsl.String)
+		})
+		dsl.Result(GroupsioMemberListType)
+		dsl.Error("BadRequest", BadRequestError, "Bad request")
+		dsl.Error("NotFound", NotFoundError, "Subgroup not found")
+		dsl.Error("InternalServerError", InternalServerError, "Internal server error")
+		dsl.Error("ServiceUnavailable", ServiceUnavailableError, "Service unavailable")
+		dsl.HTTP(func() {
+			dsl.GET("/groupsio/mailing-lists/{subgroup_id}/members/_by_organization")
+			dsl.Param("subgroup_id")
+			dsl.Param("organization")
+			dsl.Header("bearer_token:Authorization")
+			dsl.Response(dsl.StatusOK)
+			dsl.Response("BadRequest", dsl.StatusBadRequest)
+			dsl.Response("NotFound", dsl.StatusNotFound)
+			dsl.Response("InternalServerError", dsl.StatusInternalServerError)
+			dsl.Response("ServiceUnavailable", dsl.StatusServiceUnavailable)
+		})
+	})
+
+	dsl.Method("list-groupsio-duplicate-members", func() {
+		dsl.Description("List groups of members of a GroupsIO subgroup that share an email address")
+		dsl.Security(JWTAuth)
+		dsl.Payload(func() {
+			BearerTokenAttribute()
+			dsl.Attribute("subgroup_id", dsl.String, "Subgroup ID")
+			dsl.Required("subgroup_id")
+			dsl.Token("bearer_token", dsl.String)
+		})
+		dsl.Result(GroupsioMemberDuplicatesType)
+		dsl.Error("NotFound", NotFoundError, "Subgroup not found")
+		dsl.Error("InternalServerError", InternalServerError, "Internal server error")
+		dsl.Error("ServiceUnavailable", ServiceUnavailableError, "Service unavailable")
+		dsl.HTTP(func() {
+			dsl.GET("/groupsio/mailing-lists/{subgroup_id}/members/_duplicates")
+			dsl.Param("subgroup_id")
+			dsl.Header("bearer_token:Authorization")
+			dsl.Response(dsl.StatusOK)
+			dsl.Response("NotFound", dsl.StatusNotFound)
+			dsl.Response("InternalServerError", dsl.StatusInternalServerError)
+			dsl.Response("ServiceUnavailable", dsl.StatusServiceUnavailable)
+		})
+	})
+
+	dsl.Method("preview-groupsio-delivery-mode-change", func() {
+		dsl.Description("Preview, without writing anything, which members of a GroupsIO subgroup a delivery mode change would affect")
+		dsl.Security(JWTAuth)
+		dsl.Payload(func() {
+			BearerTokenAttribute()
+			dsl.Attribute("subgroup_id", dsl.String, "Subgroup ID")
+			dsl.Attribute("target_mode", dsl.String, "Delivery mode to preview, e.g. email_delivery_digest")
+			dsl.Required("subgroup_id", "target_mode")
+			dsl.Token("bearer_token", dsl.String)
+		})
+		dsl.Result(GroupsioDeliveryModePreviewType)
+		dsl.Error("BadRequest", BadRequestError, "Bad request")
+		dsl.Error("NotFound", NotFoundError, "Subgroup not found")
+		dsl.Error("InternalServerError", InternalServerError, "Internal server error")
+		dsl.Error("ServiceUnavailable", ServiceUnavailableError, "Service unavailable")
+		dsl.HTTP(func() {
+			dsl.GET("/groupsio/mailing-lists/{subgroup_id}/members/_delivery_mode_preview")
+			dsl.Param("subgroup_id")
+			dsl.Param("target_mode")
+			dsl.Header("bearer_token:Authorization")
+			dsl.Response(dsl.StatusOK)
+			dsl.Response("BadRequest", dsl.StatusBadRequest)
+			dsl.Response("NotFound", dsl.StatusNotFound)
+			dsl.Response("InternalServerError", dsl.StatusInternalServerError)
+			dsl.Response("ServiceUnavailable", dsl.StatusServiceUnavailable)
+		})
+	})
+
 	dsl.Method("add-groupsio-member", func() {
 		dsl.Description("Add a member to a GroupsIO subgroup")
 		dsl.Security(JWTAuth)
@@ -458,6 +730,35 @@ var _ = dsl.Service("mailing-list", func() {
 		})
 	})
 
+	dsl.Method("upsert-groupsio-member", func() {
+		dsl.Description("Add a member to a GroupsIO subgroup, or update the member with the same email if there is one")
+		dsl.Security(JWTAuth)
+		dsl.Payload(func() {
+			BearerTokenAttribute()
+			dsl.Attribute("subgroup_id", dsl.String, "Subgroup ID")
+			dsl.Extend(GroupsioMemberRequestType)
+			dsl.Required("subgroup_id", "email")
+			dsl.Token("bearer_token", dsl.String)
+		})
+		dsl.Result(GroupsioMemberUpsertResultType)
+		dsl.Error("BadRequest", BadRequestError, "Bad request")
+		dsl.Error("NotFound", NotFoundError, "Subgroup not found")
+		dsl.Error("Conflict", ConflictError, "Member could not be added or found")
+		dsl.Error("InternalServerError", InternalServerError, "Internal server error")
+		dsl.Error("ServiceUnavailable", ServiceUnavailableError, "Service unavailable")
+		dsl.HTTP(func() {
+			dsl.PUT("/groupsio/mailing-lists/{subgroup_id}/members/_upsert")
+			dsl.Param("subgroup_id")
+			dsl.Header("bearer_token:Authorization")
+			dsl.Response(dsl.StatusOK)
+			dsl.Response("BadRequest", dsl.StatusBadRequest)
+			dsl.Response("NotFound", dsl.StatusNotFound)
+			dsl.Response("Conflict", dsl.StatusConflict)
+			dsl.Response("InternalServerError", dsl.StatusInternalServerError)
+			dsl.Response("ServiceUnavailable", dsl.StatusServiceUnavailable)
+		})
+	})
+
 	dsl.Method("get-groupsio-member", func() {
 		dsl.Description("Get a member of a GroupsIO subgroup by ID")
 		dsl.Security(JWTAuth)
@@ -492,6 +793,7 @@ var _ = dsl.Service("mailing-list", func() {
 			dsl.Attribute("subgroup_id", dsl.String, "Subgroup ID")
 			dsl.Attribute("member_id", dsl.String, "Member ID")
 			dsl.Extend(GroupsioMemberRequestType)
+			dsl.Attribute("mailing_list_uid", dsl.String, "Subgroup the member belongs to; a member cannot be moved, so any value other than subgroup_id is rejected")
 			dsl.Required("subgroup_id", "member_id")
 			dsl.Token("bearer_token", dsl.String)
 		})
@@ -564,6 +866,92 @@ var _ = dsl.Service("mailing-list", func() {
 		})
 	})
 
+	dsl.Method("import-groupsio-members", func() {
+		dsl.Description("Add many members to a GroupsIO subgroup, skipping those that already exist")
+		dsl.Security(JWTAuth)
+		dsl.Payload(func() {
+			BearerTokenAttribute()
+			dsl.Attribute("subgroup_id", dsl.String, "Subgroup ID")
+			dsl.Extend(GroupsioMemberImportRequestType)
+			dsl.Required("subgroup_id", "members")
+			dsl.Token("bearer_token", dsl.String)
+		})
+		dsl.Result(GroupsioImportSummaryType)
+		dsl.Error("BadRequest", BadRequestError, "Bad request")
+		dsl.Error("NotFound", NotFoundError, "Subgroup not found")
+		dsl.Error("Conflict", ConflictError, "Subgroup is frozen")
+		dsl.Error("InternalServerError", InternalServerError, "Internal server error")
+		dsl.Error("ServiceUnavailable", ServiceUnavailableError, "Service unavailable")
+		dsl.HTTP(func() {
+			dsl.POST("/groupsio/mailing-lists/{subgroup_id}/members/_import")
+			dsl.Param("subgroup_id")
+			dsl.Header("bearer_token:Authorization")
+			dsl.Response(dsl.StatusOK)
+			dsl.Response("BadRequest", dsl.StatusBadRequest)
+			dsl.Response("NotFound", dsl.StatusNotFound)
+			dsl.Response("Conflict", dsl.StatusConflict)
+			dsl.Response("InternalServerError", dsl.StatusInternalServerError)
+			dsl.Response("ServiceUnavailable", dsl.StatusServiceUnavailable)
+		})
+	})
+
+	dsl.Method("merge-groupsio-members", func() {
+		dsl.Description("Merge duplicate members of a GroupsIO subgroup by keeping one and removing the others")
+		dsl.Security(JWTAuth)
+		dsl.Payload(func() {
+			BearerTokenAttribute()
+			dsl.Attribute("subgroup_id", dsl.String, "Subgroup ID")
+			dsl.Extend(GroupsioMemberMergeRequestType)
+			dsl.Required("subgroup_id")
+			dsl.Token("bearer_token", dsl.String)
+		})
+		dsl.Error("BadRequest", BadRequestError, "Bad request")
+		dsl.Error("NotFound", NotFoundError, "Subgroup or member not found")
+		dsl.Error("InternalServerError", InternalServerError, "Internal server error")
+		dsl.Error("ServiceUnavailable", ServiceUnavailableError, "Service unavailable")
+		dsl.HTTP(func() {
+			dsl.POST("/groupsio/mailing-lists/{subgroup_id}/members/_merge")
+			dsl.Param("subgroup_id")
+			dsl.Header("bearer_token:Authorization")
+			dsl.Response(dsl.StatusNoContent)
+			dsl.Response("BadRequest", dsl.StatusBadRequest)
+			dsl.Response("NotFound", dsl.StatusNotFound)
+			dsl.Response("InternalServerError", dsl.StatusInternalServerError)
+			dsl.Response("ServiceUnavailable", dsl.StatusServiceUnavailable)
+		})
+	})
+
+	dsl.Method("change-groupsio-member-email", func() {
+		dsl.Description("Change a member's email address in place, keeping the member record and its history")
+		dsl.Security(JWTAuth)
+		dsl.Payload(func() {
+			BearerTokenAttribute()
+			dsl.Attribute("subgroup_id", dsl.String, "Subgroup ID")
+			dsl.Attribute("member_id", dsl.String, "Member ID")
+			dsl.Extend(GroupsioMemberEmailChangeRequestType)
+			dsl.Required("subgroup_id", "member_id", "email")
+			dsl.Token("bearer_token", dsl.String)
+		})
+		dsl.Result(GroupsioMemberType)
+		dsl.Error("BadRequest", BadRequestError, "Bad request")
+		dsl.Error("NotFound", NotFoundError, "Member not found")
+		dsl.Error("Conflict", ConflictError, "Another member already uses the email")
+		dsl.Error("InternalServerError", InternalServerError, "Internal server error")
+		dsl.Error("ServiceUnavailable", ServiceUnavailableError, "Service unavailable")
+		dsl.HTTP(func() {
+			dsl.PUT("/groupsio/mailing-lists/{subgroup_id}/members/{member_id}/email")
+			dsl.Param("subgroup_id")
+			dsl.Param("member_id")
+			dsl.Header("bearer_token:Authorization")
+			dsl.Response(dsl.StatusOK)
+			dsl.Response("BadRequest", dsl.StatusBadRequest)
+			dsl.Response("NotFound", dsl.StatusNotFound)
+			dsl.Response("Conflict", dsl.StatusConflict)
+			dsl.Response("InternalServerError", dsl.StatusInternalServerError)
+			dsl.Response("ServiceUnavailable", dsl.StatusServiceUnavailable)
+		})
+	})
+
 	// ---- Other endpoints ----
 
 	dsl.Method("check-groupsio-subscriber", func() {
@@ -643,6 +1031,201 @@ var _ = dsl.Service("mailing-list", func() {
 		})
 	})
 
+	// ---- GroupsIO Project overview endpoints ----
+
+	dsl.Method("get-groupsio-project-summary", func() {
+		dsl.Description("Get the number of GroupsIO services, subgroups and members in a project")
+		dsl.Security(JWTAuth)
+		dsl.Payload(func() {
+			BearerTokenAttribute()
+			dsl.Attribute("project_uid", dsl.String, "LFX v2 project UID", func() {
+				dsl.Format(dsl.FormatUUID)
+			})
+			dsl.Required("project_uid")
+			dsl.Token("bearer_token", dsl.String)
+		})
+		dsl.Result(GroupsioProjectSummaryType)
+		dsl.Error("BadRequest", BadRequestError, "Bad request")
+		dsl.Error("InternalServerError", InternalServerError, "Internal server error")
+		dsl.Error("ServiceUnavailable", ServiceUnavailableError, "Service unavailable")
+		dsl.HTTP(func() {
+			dsl.GET("/groupsio/projects/{project_uid}/summary")
+			dsl.Param("project_uid")
+			dsl.Header("bearer_token:Authorization")
+			dsl.Response(dsl.StatusOK)
+			dsl.Response("BadRequest", dsl.StatusBadRequest)
+			dsl.Response("InternalServerError", dsl.StatusInternalServerError)
+			dsl.Response("ServiceUnavailable", dsl.StatusServiceUnavailable)
+		})
+	})
+
+	dsl.Method("list-groupsio-orphaned-mailing-lists", func() {
+		dsl.Description("List a project's GroupsIO subgroups whose parent service no longer exists")
+		dsl.Security(JWTAuth)
+		dsl.Payload(func() {
+			BearerTokenAttribute()
+			dsl.Attribute("project_uid", dsl.String, "LFX v2 project UID", func() {
+				dsl.Format(dsl.FormatUUID)
+			})
+			dsl.Required("project_uid")
+			dsl.Token("bearer_token", dsl.String)
+		})
+		dsl.Result(GroupsioSubgroupListType)
+		dsl.Error("BadRequest", BadRequestError, "Bad request")
+		dsl.Error("InternalServerError", InternalServerError, "Internal server error")
+		dsl.Error("ServiceUnavailable", ServiceUnavailableError, "Service unavailable")
+		dsl.HTTP(func() {
+			dsl.GET("/groupsio/projects/{project_uid}/orphaned_mailing_lists")
+			dsl.Param("project_uid")
+			dsl.Header("bearer_token:Authorization")
+			dsl.Response(dsl.StatusOK)
+			dsl.Response("BadRequest", dsl.StatusBadRequest)
+			dsl.Response("InternalServerError", dsl.StatusInternalServerError)
+			dsl.Response("ServiceUnavailable", dsl.StatusServiceUnavailable)
+		})
+	})
+
+	dsl.Method("republish-groupsio-project-index", func() {
+		dsl.Description("Re-publish indexer messages for every GroupsIO service, subgroup and member of a project, e.g. after the search index is rebuilt. Safe to retry: a retry after a failure skips the subgroups whose members were already republished")
+		dsl.Security(JWTAuth)
+		dsl.Payload(func() {
+			BearerTokenAttribute()
+			dsl.Attribute("project_uid", dsl.String, "LFX v2 project UID", func() {
+				dsl.Format(dsl.FormatUUID)
+			})
+			dsl.Required("project_uid")
+			dsl.Token("bearer_token", dsl.String)
+		})
+		dsl.Result(GroupsioRepublishSummaryType)
+		dsl.Error("BadRequest", BadRequestError, "Bad request")
+		dsl.Error("InternalServerError", InternalServerError, "Internal server error")
+		dsl.Error("ServiceUnavailable", ServiceUnavailableError, "Service unavailable")
+		dsl.HTTP(func() {
+			dsl.POST("/groupsio/projects/{project_uid}/_republish_index")
+			dsl.Param("project_uid")
+			dsl.Header("bearer_token:Authorization")
+			dsl.Response(dsl.StatusOK)
+			dsl.Response("BadRequest", dsl.StatusBadRequest)
+			dsl.Response("InternalServerError", dsl.StatusInternalServerError)
+			dsl.Response("ServiceUnavailable", dsl.StatusServiceUnavailable)
+		})
+	})
+
+	dsl.Method("get-groupsio-mailing-list-stats", func() {
+		dsl.Description("Get member statistics for a GroupsIO subgroup")
+		dsl.Security(JWTAuth)
+		dsl.Payload(func() {
+			BearerTokenAttribute()
+			dsl.Attribute("subgroup_id", dsl.String, "Subgroup ID")
+			dsl.Required("subgroup_id")
+			dsl.Token("bearer_token", dsl.String)
+		})
+		dsl.Result(GroupsioSubgroupStatsType)
+		dsl.Error("NotFound", NotFoundError, "Subgroup not found")
+		dsl.Error("InternalServerError", InternalServerError, "Internal server error")
+		dsl.Error("ServiceUnavailable", ServiceUnavailableError, "Service unavailable")
+		dsl.HTTP(func() {
+			dsl.GET("/groupsio/mailing-lists/{subgroup_id}/stats")
+			dsl.Param("subgroup_id")
+			dsl.Header("bearer_token:Authorization")
+			dsl.Response(dsl.StatusOK)
+			dsl.Response("NotFound", dsl.StatusNotFound)
+			dsl.Response("InternalServerError", dsl.StatusInternalServerError)
+			dsl.Response("ServiceUnavailable", dsl.StatusServiceUnavailable)
+		})
+	})
+
+	dsl.Method("get-groupsio-service-tree", func() {
+		dsl.Description("Get a GroupsIO service with its subgroups nested beneath it")
+		dsl.Security(JWTAuth)
+		dsl.Payload(func() {
+			BearerTokenAttribute()
+			dsl.Attribute("service_id", dsl.String, "Service ID")
+			dsl.Attribute("include_member_counts", dsl.Boolean, "Include each subgroup's member count", func() {
+				dsl.Default(false)
+			})
+			dsl.Required("service_id")
+			dsl.Token("bearer_token", dsl.String)
+		})
+		dsl.Result(GroupsioServiceTreeType)
+		dsl.Error("NotFound", NotFoundError, "Service not found")
+		dsl.Error("InternalServerError", InternalServerError, "Internal server error")
+		dsl.Error("ServiceUnavailable", ServiceUnavailableError, "Service unavailable")
+		dsl.HTTP(func() {
+			dsl.GET("/groupsio/services/{service_id}/tree")
+			dsl.Param("service_id")
+			dsl.Param("include_member_counts")
+			dsl.Header("bearer_token:Authorization")
+			dsl.Response(dsl.StatusOK)
+			dsl.Response("NotFound", dsl.StatusNotFound)
+			dsl.Response("InternalServerError", dsl.StatusInternalServerError)
+			dsl.Response("ServiceUnavailable", dsl.StatusServiceUnavailable)
+		})
+	})
+
+	dsl.Method("get-groupsio-member-hierarchy", func() {
+		dsl.Description("Get a GroupsIO member with the subgroup, service and project above it")
+		dsl.Security(JWTAuth)
+		dsl.Payload(func() {
+			BearerTokenAttribute()
+			dsl.Attribute("subgroup_id", dsl.String, "Subgroup ID")
+			dsl.Attribute("member_id", dsl.String, "Member ID")
+			dsl.Required("subgroup_id", "member_id")
+			dsl.Token("bearer_token", dsl.String)
+		})
+		dsl.Result(GroupsioMemberHierarchyType)
+		dsl.Error("NotFound", NotFoundError, "Member not found")
+		dsl.Error("InternalServerError", InternalServerError, "Internal server error")
+		dsl.Error("ServiceUnavailable", ServiceUnavailableError, "Service unavailable")
+		dsl.HTTP(func() {
+			dsl.GET("/groupsio/mailing-lists/{subgroup_id}/members/{member_id}/hierarchy")
+			dsl.Param("subgroup_id")
+			dsl.Param("member_id")
+			dsl.Header("bearer_token:Authorization")
+			dsl.Response(dsl.StatusOK)
+			dsl.Response("NotFound", dsl.StatusNotFound)
+			dsl.Response("InternalServerError", dsl.StatusInternalServerError)
+			dsl.Response("ServiceUnavailable", dsl.StatusServiceUnavailable)
+		})
+	})
+
+	dsl.Method("list-groupsio-project-members", func() {
+		dsl.Description("Page through the members of every GroupsIO subgroup in a project")
+		dsl.Security(JWTAuth)
+		dsl.Payload(func() {
+			BearerTokenAttribute()
+			dsl.Attribute("project_uid", dsl.String, "LFX v2 project UID", func() {
+				dsl.Format(dsl.FormatUUID)
+			})
+			dsl.Attribute("limit", dsl.Int, "Maximum members per page; 0 uses the default of 100", func() {
+				dsl.Minimum(0)
+				dsl.Default(0)
+			})
+			dsl.Attribute("cursor", dsl.String, "next_cursor of the previous page")
+			dsl.Attribute("dedupe", dsl.Boolean, "Return each email once, from the first subgroup it appears in", func() {
+				dsl.Default(false)
+			})
+			dsl.Required("project_uid")
+			dsl.Token("bearer_token", dsl.String)
+		})
+		dsl.Result(GroupsioMemberPageType)
+		dsl.Error("BadRequest", BadRequestError, "Bad request")
+		dsl.Error("InternalServerError", InternalServerError, "Internal server error")
+		dsl.Error("ServiceUnavailable", ServiceUnavailableError, "Service unavailable")
+		dsl.HTTP(func() {
+			dsl.GET("/groupsio/projects/{project_uid}/members")
+			dsl.Param("project_uid")
+			dsl.Param("limit")
+			dsl.Param("cursor")
+			dsl.Param("dedupe")
+			dsl.Header("bearer_token:Authorization")
+			dsl.Response(dsl.StatusOK)
+			dsl.Response("BadRequest", dsl.StatusBadRequest)
+			dsl.Response("InternalServerError", dsl.StatusInternalServerError)
+			dsl.Response("ServiceUnavailable", dsl.StatusServiceUnavailable)
+		})
+	})
+
 	// Serve OpenAPI spec files under the /_groupsio/ prefix to match the httproute and ruleset.
 	dsl.Files("/_groupsio/openapi.json", "gen/http/openapi.json", func() {
 		dsl.Meta("swagger:generate", "false")
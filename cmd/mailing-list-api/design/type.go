@@ -63,6 +63,19 @@ var ServiceUnavailableError = dsl.Type("service-unavailable-error", func() {
 	dsl.Required("message")
 })
 
+// ReadinessType reports the aggregate readiness and the status of each dependency.
+var ReadinessType = dsl.Type("readiness", func() {
+	dsl.Description("Readiness report")
+	dsl.Attribute("status", dsl.String, "Aggregate status", func() {
+		dsl.Enum("ok", "degraded")
+		dsl.Example("degraded")
+	})
+	dsl.Attribute("dependencies", dsl.MapOf(dsl.String, dsl.String), "Status of each dependency", func() {
+		dsl.Example(map[string]string{"nats": "ok", "itx": "unavailable: token request failed"})
+	})
+	dsl.Required("status")
+})
+
 // GroupsioServiceType represents an ITX GroupsIO service.
 var GroupsioServiceType = dsl.Type("groupsio-service", func() {
 	dsl.Description("A GroupsIO service managed via ITX")
@@ -126,6 +139,7 @@ var GroupsioSubgroupType = dsl.Type("groupsio-subgroup", func() {
 	dsl.Attribute("audience_access", dsl.String, "Audience access setting")
 	dsl.Attribute("created_at", dsl.String, "Creation timestamp")
 	dsl.Attribute("updated_at", dsl.String, "Last update timestamp")
+	dsl.Attribute("warnings", dsl.ArrayOf(dsl.String), "Best-effort follow-up steps that failed after the subgroup was saved")
 })
 
 // GroupsioSubgroupRequestType represents a create/update request for a GroupsIO subgroup.
@@ -202,6 +216,31 @@ var GroupsioMemberRequestType = dsl.Type("groupsio-member-request", func() {
 	dsl.Attribute("job_title", dsl.String, "Member job title")
 })
 
+// GroupsioMemberUpsertResultType represents the outcome of a member upsert.
+var GroupsioMemberUpsertResultType = dsl.Type("groupsio-member-upsert-result", func() {
+	dsl.Description("The added or updated member, and whether it was added")
+	dsl.Attribute("member", GroupsioMemberType, "The member after the upsert")
+	dsl.Attribute("created", dsl.Boolean, "True when the member was added, false when an existing member was updated")
+	dsl.Required("member", "created")
+})
+
+// GroupsioMemberDuplicatesType represents the groups of members sharing an email.
+var GroupsioMemberDuplicatesType = dsl.Type("groupsio-member-duplicates", func() {
+	dsl.Description("Groups of member IDs that share an email address (ignoring case and surrounding whitespace)")
+	dsl.Attribute("groups", dsl.ArrayOf(dsl.ArrayOf(dsl.String)), "Each group lists the IDs of two or more members with the same email")
+	dsl.Required("groups")
+})
+
+// GroupsioMemberMergeRequestType represents a member merge request.
+var GroupsioMemberMergeRequestType = dsl.Type("groupsio-member-merge-request", func() {
+	dsl.Description("Request body for merging duplicate members into one")
+	dsl.Attribute("keep_member_id", dsl.String, "ID of the member to keep")
+	dsl.Attribute("merge_member_ids", dsl.ArrayOf(dsl.String), "IDs of the members to remove; each must share the kept member's email", func() {
+		dsl.MinLength(1)
+	})
+	dsl.Required("keep_member_id", "merge_member_ids")
+})
+
 // GroupsioMemberListType represents a list of GroupsIO members.
 var GroupsioMemberListType = dsl.Type("groupsio-member-list", func() {
 	dsl.Description("List of GroupsIO members")
@@ -209,6 +248,23 @@ var GroupsioMemberListType = dsl.Type("groupsio-member-list", func() {
 	dsl.Attribute("total", dsl.Int, "Total count")
 })
 
+// GroupsioMemberPageType represents one page of members across a project's subgroups.
+var GroupsioMemberPageType = dsl.Type("groupsio-member-page", func() {
+	dsl.Description("One page of members across a project's subgroups")
+	dsl.Attribute("items", dsl.ArrayOf(GroupsioMemberType), "Members on this page")
+	dsl.Attribute("next_cursor", dsl.String, "Cursor for the next page; omitted on the last page")
+	dsl.Required("items")
+})
+
+// GroupsioDeliveryModePreviewType represents the members a delivery mode change would affect.
+var GroupsioDeliveryModePreviewType = dsl.Type("groupsio-delivery-mode-preview", func() {
+	dsl.Description("Members whose delivery mode would change if the whole subgroup were switched to target_mode")
+	dsl.Attribute("target_mode", dsl.String, "Delivery mode the preview was computed for")
+	dsl.Attribute("affected", dsl.ArrayOf(dsl.String), "Emails of members on a different delivery mode")
+	dsl.Attribute("unchanged", dsl.ArrayOf(dsl.String), "Emails of members already on target_mode")
+	dsl.Required("target_mode", "affected", "unchanged")
+})
+
 // GroupsioInviteMembersRequestType represents an invite members request.
 var GroupsioInviteMembersRequestType = dsl.Type("groupsio-invite-members-request", func() {
 	dsl.Description("Request body for inviting members to a GroupsIO subgroup")
@@ -216,6 +272,48 @@ var GroupsioInviteMembersRequestType = dsl.Type("groupsio-invite-members-request
 	dsl.Required("emails")
 })
 
+// GroupsioMemberImportRequestType represents a bulk member import request.
+var GroupsioMemberImportRequestType = dsl.Type("groupsio-member-import-request", func() {
+	dsl.Description("Request body for importing members into a GroupsIO subgroup")
+	dsl.Attribute("members", dsl.ArrayOf(GroupsioMemberRequestType), "Members to add")
+	dsl.Required("members")
+})
+
+// GroupsioImportSummaryType represents the outcome of a member import.
+var GroupsioImportSummaryType = dsl.Type("groupsio-import-summary", func() {
+	dsl.Description("Outcome of a member import; members already on the subgroup are skipped, not errored")
+	dsl.Attribute("created", dsl.Int, "Number of members added")
+	dsl.Attribute("skipped", dsl.Int, "Number of members that already existed")
+	dsl.Attribute("errored", dsl.Int, "Number of members that could not be added")
+	dsl.Attribute("skipped_emails", dsl.ArrayOf(dsl.String), "Emails of the skipped members")
+	dsl.Attribute("errored_emails", dsl.ArrayOf(dsl.String), "Emails of the members that could not be added")
+	dsl.Required("created", "skipped", "errored")
+})
+
+// GroupsioServicePromotionType represents the outcome of promoting a formation service.
+var GroupsioServicePromotionType = dsl.Type("groupsio-service-promotion", func() {
+	dsl.Description("Outcome of promoting a formation service to primary")
+	dsl.Attribute("service", GroupsioServiceType, "The promoted service")
+	dsl.Attribute("prefixed_subgroups", dsl.ArrayOf(GroupsioSubgroupType), "Subgroups of the service whose names still start with the former formation prefix; they are not renamed")
+	dsl.Required("service")
+})
+
+// GroupsioRepublishSummaryType represents the outcome of an index republish.
+var GroupsioRepublishSummaryType = dsl.Type("groupsio-republish-summary", func() {
+	dsl.Description("Outcome of republishing a project's indexer messages")
+	dsl.Attribute("published", dsl.Int, "Number of indexer messages published")
+	dsl.Required("published")
+})
+
+// GroupsioMemberEmailChangeRequestType represents a member email change request.
+var GroupsioMemberEmailChangeRequestType = dsl.Type("groupsio-member-email-change-request", func() {
+	dsl.Description("Request body for changing a member's email address")
+	dsl.Attribute("email", dsl.String, "New email address", func() {
+		dsl.Format(dsl.FormatEmail)
+	})
+	dsl.Required("email")
+})
+
 // GroupsioCheckSubscriberRequestType represents a check subscriber request.
 var GroupsioCheckSubscriberRequestType = dsl.Type("groupsio-check-subscriber-request", func() {
 	dsl.Description("Request body for checking if an email is subscribed")
@@ -281,3 +379,67 @@ var GroupsioArtifactDownloadType = dsl.Type("groupsio-artifact-download", func()
 	dsl.Attribute("url", dsl.String, "Presigned S3 download URL (expires in 15 minutes)")
 	dsl.Required("url")
 })
+
+// GroupsioProjectSummaryType represents the GroupsIO footprint of a project.
+var GroupsioProjectSummaryType = dsl.Type("groupsio-project-summary", func() {
+	dsl.Description("Number of GroupsIO services, subgroups and members in a project")
+	dsl.Attribute("project_uid", dsl.String, "LFX v2 project UID", func() {
+		dsl.Format(dsl.FormatUUID)
+		dsl.Example("7cad5a8d-19d0-41a4-81a6-043453daf9ee")
+	})
+	dsl.Attribute("service_count", dsl.Int, "Number of services")
+	dsl.Attribute("mailing_list_count", dsl.Int, "Number of subgroups")
+	dsl.Attribute("member_count", dsl.Int, "Sum of the subgroups' member counts; a person in two subgroups is counted twice")
+	dsl.Required("project_uid", "service_count", "mailing_list_count", "member_count")
+})
+
+// GroupsioMemberHierarchyProjectType identifies the project at the top of a member hierarchy.
+var GroupsioMemberHierarchyProjectType = dsl.Type("groupsio-member-hierarchy-project", func() {
+	dsl.Description("The project a member's subgroup belongs to")
+	dsl.Attribute("uid", dsl.String, "LFX v2 project UID", func() {
+		dsl.Format(dsl.FormatUUID)
+		dsl.Example("7cad5a8d-19d0-41a4-81a6-043453daf9ee")
+	})
+	dsl.Attribute("name", dsl.String, "Project name")
+	dsl.Attribute("slug", dsl.String, "Project slug")
+	dsl.Required("uid")
+})
+
+// GroupsioMemberHierarchyType represents a member with the subgroup, service and project above it.
+var GroupsioMemberHierarchyType = dsl.Type("groupsio-member-hierarchy", func() {
+	dsl.Description("A member with its subgroup, service and project; entities that could not be resolved are omitted and explained in warnings")
+	dsl.Attribute("member", GroupsioMemberType, "The member")
+	dsl.Attribute("subgroup", GroupsioSubgroupType, "The member's subgroup")
+	dsl.Attribute("service", GroupsioServiceType, "The subgroup's service")
+	dsl.Attribute("project", GroupsioMemberHierarchyProjectType, "The service's project")
+	dsl.Attribute("warnings", dsl.ArrayOf(dsl.String), "Why an entity above the member could not be resolved")
+	dsl.Required("member", "warnings")
+})
+
+// GroupsioSubgroupStatsType represents member statistics for a GroupsIO subgroup.
+var GroupsioSubgroupStatsType = dsl.Type("groupsio-subgroup-stats", func() {
+	dsl.Description("Member statistics for a GroupsIO subgroup, computed from its current members")
+	dsl.Attribute("subgroup_id", dsl.String, "Subgroup ID")
+	dsl.Attribute("member_count", dsl.Int, "Number of members")
+	dsl.Attribute("members_by_delivery_mode", dsl.MapOf(dsl.String, dsl.Int), "Member count per delivery mode; members without one are counted under \"\"")
+	dsl.Attribute("bounce_count", dsl.Int, "Number of bouncing members")
+	dsl.Attribute("created_at", dsl.String, "Subgroup creation timestamp")
+	dsl.Attribute("updated_at", dsl.String, "Subgroup last update timestamp")
+	dsl.Required("subgroup_id", "member_count", "members_by_delivery_mode", "bounce_count")
+})
+
+// GroupsioServiceTreeSubgroupType represents a subgroup within a GroupsIO service tree.
+var GroupsioServiceTreeSubgroupType = dsl.Type("groupsio-service-tree-subgroup", func() {
+	dsl.Description("A GroupsIO subgroup within a service tree")
+	dsl.Attribute("subgroup", GroupsioSubgroupType, "The subgroup")
+	dsl.Attribute("member_count", dsl.Int, "Number of members; omitted unless member counts were requested")
+	dsl.Required("subgroup")
+})
+
+// GroupsioServiceTreeType represents a GroupsIO service with its subgroups nested beneath it.
+var GroupsioServiceTreeType = dsl.Type("groupsio-service-tree", func() {
+	dsl.Description("A GroupsIO service with its subgroups")
+	dsl.Attribute("service", GroupsioServiceType, "The service")
+	dsl.Attribute("mailing_lists", dsl.ArrayOf(GroupsioServiceTreeSubgroupType), "Subgroups of the service")
+	dsl.Required("service", "mailing_lists")
+})
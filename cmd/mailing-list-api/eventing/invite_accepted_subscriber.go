@@ -14,6 +14,7 @@ import (
 
 	"github.com/linuxfoundation/lfx-v2-mailing-list-service/internal/domain/port"
 	"github.com/linuxfoundation/lfx-v2-mailing-list-service/pkg/constants"
+	"github.com/linuxfoundation/lfx-v2-mailing-list-service/pkg/redaction"
 )
 
 const inviteAcceptedCallTimeout = 30 * time.Second
@@ -103,7 +104,7 @@ func (s *InviteAcceptedSubscriber) handle(msg *nats.Msg) {
 	if err := processInviteAcceptedEvent(ctx, evt, s.acceptanceClient, s.logger); err != nil {
 		s.logger.Warn("invite_accepted enrichment failed; best-effort, not retrying",
 			"error", err,
-			"email", evt.Recipient.Email,
+			"email", redaction.Email(evt.Recipient.Email),
 			"username", evt.AcceptedBy,
 		)
 	}
@@ -139,7 +140,7 @@ func processInviteAcceptedEvent(
 	}
 
 	logger.Debug("received invite_accepted event",
-		"email", email,
+		"email", redaction.Email(email),
 		"username", username,
 	)
 
@@ -148,7 +149,7 @@ func processInviteAcceptedEvent(
 	}
 
 	logger.Info("invite_accepted enrichment complete",
-		"email", email,
+		"email", redaction.Email(email),
 		"username", username,
 	)
 	return nil
@@ -23,6 +23,7 @@ import (
 	"github.com/linuxfoundation/lfx-v2-mailing-list-service/internal/infrastructure/proxy"
 	orchestrator "github.com/linuxfoundation/lfx-v2-mailing-list-service/internal/service"
 	logging "github.com/linuxfoundation/lfx-v2-mailing-list-service/pkg/log"
+	"github.com/linuxfoundation/lfx-v2-mailing-list-service/pkg/redaction"
 	"github.com/linuxfoundation/lfx-v2-mailing-list-service/pkg/utils"
 
 	"goa.design/clue/debug"
@@ -58,6 +59,8 @@ func main() {
 
 	ctx := context.Background()
 
+	redaction.SetEmailMode(service.RedactionMode())
+
 	// Set up OpenTelemetry SDK.
 	// Command-line/environment OTEL_SERVICE_VERSION takes precedence over
 	// the build-time Version variable.
@@ -106,16 +109,20 @@ func main() {
 		orchestrator.WithServiceReaderTranslator(translator),
 	)
 
-	serviceOrchestrator := orchestrator.NewGroupsIOServiceWriterOrchestrator(
-		orchestrator.WithServiceWriter(proxyClient),
-		orchestrator.WithServiceTranslator(translator),
-	)
-
 	mailingListReaderOrchestrator := orchestrator.NewGroupsIOMailingListReaderOrchestrator(
 		orchestrator.WithMailingListReader(proxyClient),
 		orchestrator.WithMailingListReaderTranslator(translator),
 	)
 
+	serviceOrchestrator := orchestrator.NewGroupsIOServiceWriterOrchestrator(
+		orchestrator.WithServiceWriter(proxyClient),
+		orchestrator.WithServiceWriterReader(serviceReaderOrchestrator),
+		orchestrator.WithServicePromoter(proxyClient),
+		orchestrator.WithServiceWriterMailingListReader(mailingListReaderOrchestrator),
+		orchestrator.WithIdempotentServiceDelete(service.IdempotentDeletes()),
+		orchestrator.WithServiceTranslator(translator),
+	)
+
 	mailingListEventPublisher := service.MessagePublisher(ctx)
 
 	committeeProjectLookup := service.CommitteeProjectLookup(ctx)
@@ -127,6 +134,13 @@ func main() {
 		orchestrator.WithMailingListPublisher(mailingListEventPublisher),
 		orchestrator.WithMailingListServiceReader(serviceReaderOrchestrator),
 		orchestrator.WithMailingListCommitteeProjectLookup(committeeProjectLookup),
+		orchestrator.WithReservedGroupNames(service.ReservedGroupNames()...),
+		orchestrator.WithAdoptExistingOnConflict(service.AdoptExistingMailingLists()),
+		orchestrator.WithStrictPublish(service.StrictMailingListPublish()),
+		orchestrator.WithCreateBudget(service.MailingListCreateBudget()),
+		orchestrator.WithCommitteeRequiredTypes(service.CommitteeRequiredListTypes()...),
+		orchestrator.WithMaxMailingListsPerService(service.MaxMailingListsPerService()),
+		orchestrator.WithIdempotentMailingListDelete(service.IdempotentDeletes()),
 	)
 
 	memberReaderOrchestrator := orchestrator.NewGroupsIOMailingListMemberReaderOrchestrator(
@@ -135,12 +149,34 @@ func main() {
 
 	memberWriterOrchestrator := orchestrator.NewGroupsIOMailingListMemberWriterOrchestrator(
 		orchestrator.WithMemberWriter(proxyClient),
+		orchestrator.WithMemberWriterReader(memberReaderOrchestrator),
+		orchestrator.WithMemberMailingListReader(mailingListReaderOrchestrator),
+		orchestrator.WithMemberServiceReader(serviceReaderOrchestrator),
+		orchestrator.WithMaxBatchSize(service.MaxBatchSize()),
+		orchestrator.WithImportRateLimit(service.ImportRateLimit()),
+		orchestrator.WithIdempotentMemberDelete(service.IdempotentDeletes()),
 	)
 
 	artifactReaderOrchestrator := orchestrator.NewGroupsIOArtifactReaderOrchestrator(
 		orchestrator.WithArtifactReader(proxyClient),
 	)
 
+	overviewReaderOrchestrator := orchestrator.NewGroupsIOOverviewReaderOrchestrator(
+		orchestrator.WithOverviewServiceReader(serviceReaderOrchestrator),
+		orchestrator.WithOverviewServiceBatchReader(serviceReaderOrchestrator),
+		orchestrator.WithOverviewMailingListReader(mailingListReaderOrchestrator),
+		orchestrator.WithOverviewMemberReader(memberReaderOrchestrator),
+		orchestrator.WithOverviewConcurrency(service.OverviewConcurrency()),
+	)
+
+	indexRepublisherOrchestrator := orchestrator.NewGroupsIOIndexRepublisherOrchestrator(
+		orchestrator.WithRepublishServiceReader(serviceReaderOrchestrator),
+		orchestrator.WithRepublishMailingListReader(mailingListReaderOrchestrator),
+		orchestrator.WithRepublishMemberReader(memberReaderOrchestrator),
+		orchestrator.WithRepublishPublisher(mailingListEventPublisher),
+		orchestrator.WithRepublishRateLimit(service.RepublishRateLimit()),
+	)
+
 	slog.InfoContext(ctx, "ITX proxy client initialized")
 
 	// ---- LFID invite feature ----
@@ -180,6 +216,9 @@ func main() {
 		memberReaderOrchestrator,
 		memberWriterOrchestrator,
 		artifactReaderOrchestrator,
+		overviewReaderOrchestrator,
+		indexRepublisherOrchestrator,
+		service.ReadinessChecks(ctx, proxyClient)...,
 	)
 
 	// Wrap the services in endpoints
@@ -41,6 +41,69 @@ func convertMember(m *model.GrpsIOMember) *mailinglist.GroupsioMember {
 	}
 }
 
+// convertServiceList wraps filtered services in a list whose total is the number returned.
+func convertServiceList(items []*model.GroupsIOService) *mailinglist.GroupsioServiceList {
+	result := make([]*mailinglist.GroupsioService, len(items))
+	for i, svc := range items {
+		result[i] = convertService(svc)
+	}
+	total := len(result)
+	return &mailinglist.GroupsioServiceList{Items: result, Total: &total}
+}
+
+// convertMemberRequest maps one member of a bulk request body to the domain model.
+func convertMemberRequest(m *mailinglist.GroupsioMemberRequest) *model.GrpsIOMember {
+	if m == nil {
+		return &model.GrpsIOMember{}
+	}
+	return &model.GrpsIOMember{
+		Email:          converter.StringVal(m.Email),
+		GroupsFullName: converter.StringVal(m.Name),
+		DeliveryMode:   converter.StringVal(m.DeliveryMode),
+		MemberType:     converter.StringVal(m.MemberType),
+		ModStatus:      converter.StringVal(m.ModStatus),
+		Organization:   converter.StringVal(m.Organization),
+		JobTitle:       converter.StringVal(m.JobTitle),
+	}
+}
+
+func convertImportSummary(s *model.ImportSummary) *mailinglist.GroupsioImportSummary {
+	if s == nil {
+		return nil
+	}
+	return &mailinglist.GroupsioImportSummary{
+		Created:       s.Created,
+		Skipped:       s.Skipped,
+		Errored:       s.Errored,
+		SkippedEmails: s.SkippedEmails,
+		ErroredEmails: s.ErroredEmails,
+	}
+}
+
+// convertMemberList wraps filtered members in a list whose total is the number returned.
+func convertMemberList(items []*model.GrpsIOMember) *mailinglist.GroupsioMemberList {
+	result := make([]*mailinglist.GroupsioMember, len(items))
+	for i, m := range items {
+		result[i] = convertMember(m)
+	}
+	total := len(result)
+	return &mailinglist.GroupsioMemberList{Items: result, Total: &total}
+}
+
+func convertMemberPage(page *model.MemberPage) *mailinglist.GroupsioMemberPage {
+	if page == nil {
+		return nil
+	}
+	result := &mailinglist.GroupsioMemberPage{Items: make([]*mailinglist.GroupsioMember, len(page.Members))}
+	for i, m := range page.Members {
+		result.Items[i] = convertMember(m)
+	}
+	if page.NextCursor != "" {
+		result.NextCursor = &page.NextCursor
+	}
+	return result
+}
+
 func convertMailingList(ml *model.GroupsIOMailingList) *mailinglist.GroupsioSubgroup {
 	if ml == nil {
 		return nil
@@ -69,9 +132,20 @@ func convertMailingList(ml *model.GroupsIOMailingList) *mailinglist.GroupsioSubg
 		AudienceAccess: &ml.AudienceAccess,
 		CreatedAt:      converter.NonEmptyString(createdAt),
 		UpdatedAt:      converter.NonEmptyString(updatedAt),
+		Warnings:       ml.Warnings,
 	}
 }
 
+// convertMailingListList wraps filtered mailing lists in a list whose total is the number returned.
+func convertMailingListList(items []*model.GroupsIOMailingList) *mailinglist.GroupsioSubgroupList {
+	result := make([]*mailinglist.GroupsioSubgroup, len(items))
+	for i, ml := range items {
+		result[i] = convertMailingList(ml)
+	}
+	total := len(result)
+	return &mailinglist.GroupsioSubgroupList{Items: result, Total: &total}
+}
+
 func convertArtifactUser(u *model.ArtifactUser) *mailinglist.GroupsioArtifactUser {
 	if u == nil {
 		return nil
@@ -161,3 +235,101 @@ func convertService(svc *model.GroupsIOService) *mailinglist.GroupsioService {
 		UpdatedAt:  converter.NonEmptyString(updatedAt),
 	}
 }
+
+func convertServicePromotion(p *model.ServicePromotion) *mailinglist.GroupsioServicePromotion {
+	if p == nil {
+		return nil
+	}
+	var prefixed []*mailinglist.GroupsioSubgroup
+	for _, ml := range p.PrefixedMailingLists {
+		prefixed = append(prefixed, convertMailingList(ml))
+	}
+	return &mailinglist.GroupsioServicePromotion{
+		Service:           convertService(p.Service),
+		PrefixedSubgroups: prefixed,
+	}
+}
+
+func convertProjectSummary(summary *model.ProjectSummary) *mailinglist.GroupsioProjectSummary {
+	if summary == nil {
+		return nil
+	}
+	return &mailinglist.GroupsioProjectSummary{
+		ProjectUID:       summary.ProjectUID,
+		ServiceCount:     summary.ServiceCount,
+		MailingListCount: summary.MailingListCount,
+		MemberCount:      summary.MemberCount,
+	}
+}
+
+func convertMailingListStats(stats *model.MailingListStats) *mailinglist.GroupsioSubgroupStats {
+	if stats == nil {
+		return nil
+	}
+	byMode := stats.MembersByDeliveryMode
+	if byMode == nil {
+		byMode = map[string]int{}
+	}
+	createdAt := ""
+	if !stats.CreatedAt.IsZero() {
+		createdAt = stats.CreatedAt.Format(time.RFC3339)
+	}
+	updatedAt := ""
+	if !stats.UpdatedAt.IsZero() {
+		updatedAt = stats.UpdatedAt.Format(time.RFC3339)
+	}
+	return &mailinglist.GroupsioSubgroupStats{
+		SubgroupID:            stats.MailingListUID,
+		MemberCount:           stats.MemberCount,
+		MembersByDeliveryMode: byMode,
+		BounceCount:           stats.BounceCount,
+		CreatedAt:             converter.NonEmptyString(createdAt),
+		UpdatedAt:             converter.NonEmptyString(updatedAt),
+	}
+}
+
+func convertServiceTree(tree *model.ServiceTree) *mailinglist.GroupsioServiceTree {
+	if tree == nil {
+		return nil
+	}
+	lists := make([]*mailinglist.GroupsioServiceTreeSubgroup, len(tree.MailingLists))
+	for i, ml := range tree.MailingLists {
+		lists[i] = &mailinglist.GroupsioServiceTreeSubgroup{
+			Subgroup:    convertMailingList(ml.MailingList),
+			MemberCount: ml.MemberCount,
+		}
+	}
+	return &mailinglist.GroupsioServiceTree{
+		Service:      convertService(tree.Service),
+		MailingLists: lists,
+	}
+}
+
+func convertMemberHierarchy(h *model.MemberHierarchy) *mailinglist.GroupsioMemberHierarchy {
+	if h == nil {
+		return nil
+	}
+	var project *mailinglist.GroupsioMemberHierarchyProject
+	if h.Project != nil {
+		project = &mailinglist.GroupsioMemberHierarchyProject{
+			UID:  h.Project.UID,
+			Name: converter.NonEmptyString(h.Project.Name),
+			Slug: converter.NonEmptyString(h.Project.Slug),
+		}
+	}
+	return &mailinglist.GroupsioMemberHierarchy{
+		Member:   convertMember(h.Member),
+		Subgroup: convertMailingList(h.MailingList),
+		Service:  convertService(h.Service),
+		Project:  project,
+		Warnings: nonNilStrings(h.Warnings),
+	}
+}
+
+// nonNilStrings returns s, or an empty slice when s is nil, so required arrays serialize as [].
+func nonNilStrings(s []string) []string {
+	if s == nil {
+		return []string{}
+	}
+	return s
+}
@@ -7,6 +7,7 @@ import (
 	"testing"
 	"time"
 
+	mailinglist "github.com/linuxfoundation/lfx-v2-mailing-list-service/gen/mailing_list"
 	"github.com/linuxfoundation/lfx-v2-mailing-list-service/internal/domain/model"
 	"github.com/stretchr/testify/suite"
 )
@@ -93,6 +94,35 @@ func (s *ServiceConvertersSuite) TestConvertMember() {
 	}
 }
 
+func (s *ServiceConvertersSuite) TestConvertMemberRequest() {
+	got := convertMemberRequest(&mailinglist.GroupsioMemberRequest{Email: ptr("a@example.com"), Name: ptr("Ada"), DeliveryMode: ptr("email_delivery_digest")})
+	s.Equal("a@example.com", got.Email)
+	s.Equal("Ada", got.GroupsFullName)
+	s.Equal("email_delivery_digest", got.DeliveryMode)
+
+	s.NotNil(convertMemberRequest(nil), "a null entry becomes an empty member that fails validation downstream")
+}
+
+func (s *ServiceConvertersSuite) TestConvertImportSummary() {
+	got := convertImportSummary(&model.ImportSummary{Created: 2, Skipped: 1, SkippedEmails: []string{"a@example.com"}})
+	s.Require().NotNil(got)
+	s.Equal(2, got.Created)
+	s.Equal(1, got.Skipped)
+	s.Equal([]string{"a@example.com"}, got.SkippedEmails)
+	s.Nil(convertImportSummary(nil))
+}
+
+func (s *ServiceConvertersSuite) TestConvertMemberList() {
+	got := convertMemberList([]*model.GrpsIOMember{{UID: "m-1", Email: "a@example.com"}, {UID: "m-2", Email: "b@example.com"}})
+	s.Require().Len(got.Items, 2)
+	s.Equal("b@example.com", ptrVal(got.Items[1].Email))
+	s.Equal(2, *got.Total)
+
+	empty := convertMemberList(nil)
+	s.NotNil(empty.Items, "an empty result serializes as [] rather than null")
+	s.Equal(0, *empty.Total)
+}
+
 func (s *ServiceConvertersSuite) TestConvertMailingList() {
 	nonZeroTime := time.Date(2024, 3, 15, 10, 0, 0, 0, time.UTC)
 
@@ -190,6 +220,17 @@ func (s *ServiceConvertersSuite) TestConvertMailingList() {
 	}
 }
 
+func (s *ServiceConvertersSuite) TestConvertMailingListList() {
+	got := convertMailingListList([]*model.GroupsIOMailingList{{UID: "ml-1", GroupName: "dev"}})
+	s.Require().Len(got.Items, 1)
+	s.Equal("dev", ptrVal(got.Items[0].Name))
+	s.Equal(1, *got.Total)
+
+	empty := convertMailingListList(nil)
+	s.NotNil(empty.Items)
+	s.Equal(0, *empty.Total)
+}
+
 func (s *ServiceConvertersSuite) TestConvertService() {
 	nonZeroTime := time.Date(2024, 3, 15, 10, 0, 0, 0, time.UTC)
 
@@ -280,6 +321,32 @@ func (s *ServiceConvertersSuite) TestConvertService() {
 	}
 }
 
+func (s *ServiceConvertersSuite) TestConvertServiceList() {
+	got := convertServiceList([]*model.GroupsIOService{{UID: "svc-1", Status: "pending"}})
+	s.Require().Len(got.Items, 1)
+	s.Equal("pending", ptrVal(got.Items[0].Status))
+	s.Equal(1, *got.Total)
+
+	empty := convertServiceList(nil)
+	s.NotNil(empty.Items)
+	s.Equal(0, *empty.Total)
+}
+
+func (s *ServiceConvertersSuite) TestConvertServicePromotion() {
+	got := convertServicePromotion(&model.ServicePromotion{
+		Service:              &model.GroupsIOService{UID: "svc-1", Type: "v2_primary"},
+		PrefixedMailingLists: []*model.GroupsIOMailingList{{UID: "ml-1", GroupName: "form-dev"}},
+	})
+	s.Require().NotNil(got)
+	s.Equal("v2_primary", ptrVal(got.Service.Type))
+	s.Require().Len(got.PrefixedSubgroups, 1)
+	s.Equal("ml-1", ptrVal(got.PrefixedSubgroups[0].ID))
+
+	none := convertServicePromotion(&model.ServicePromotion{Service: &model.GroupsIOService{UID: "svc-1"}})
+	s.Nil(none.PrefixedSubgroups, "no prefixed subgroups are omitted from the response")
+	s.Nil(convertServicePromotion(nil))
+}
+
 func (s *ServiceConvertersSuite) TestConvertArtifactUser() {
 	tests := []struct {
 		name      string
@@ -509,6 +576,93 @@ func (s *ServiceConvertersSuite) TestConvertArtifact() {
 	}
 }
 
+func (s *ServiceConvertersSuite) TestConvertMailingListStats() {
+	created := time.Date(2026, 1, 2, 3, 4, 5, 0, time.UTC)
+	got := convertMailingListStats(&model.MailingListStats{
+		MailingListUID:        "ml-1",
+		MemberCount:           3,
+		MembersByDeliveryMode: map[string]int{"email_delivery_single": 2, "": 1},
+		BounceCount:           1,
+		CreatedAt:             created,
+	})
+	s.Require().NotNil(got)
+	s.Equal("ml-1", got.SubgroupID)
+	s.Equal(3, got.MemberCount)
+	s.Equal(2, got.MembersByDeliveryMode["email_delivery_single"])
+	s.Equal("2026-01-02T03:04:05Z", ptrVal(got.CreatedAt))
+	s.Nil(got.UpdatedAt, "zero timestamps are omitted")
+
+	s.NotNil(convertMailingListStats(&model.MailingListStats{}).MembersByDeliveryMode)
+	s.Nil(convertMailingListStats(nil))
+}
+
+func (s *ServiceConvertersSuite) TestConvertServiceTree() {
+	count := 4
+	got := convertServiceTree(&model.ServiceTree{
+		Service: &model.GroupsIOService{UID: "svc-1"},
+		MailingLists: []*model.ServiceTreeMailingList{
+			{MailingList: &model.GroupsIOMailingList{UID: "ml-1"}, MemberCount: &count},
+			{MailingList: &model.GroupsIOMailingList{UID: "ml-2"}},
+		},
+	})
+	s.Require().NotNil(got)
+	s.Equal("svc-1", ptrVal(got.Service.ID))
+	s.Require().Len(got.MailingLists, 2)
+	s.Equal("ml-1", ptrVal(got.MailingLists[0].Subgroup.ID))
+	s.Equal(4, *got.MailingLists[0].MemberCount)
+	s.Nil(got.MailingLists[1].MemberCount, "counts are omitted when not requested")
+
+	s.NotNil(convertServiceTree(&model.ServiceTree{}).MailingLists)
+	s.Nil(convertServiceTree(nil))
+}
+
+func (s *ServiceConvertersSuite) TestConvertMemberHierarchy() {
+	got := convertMemberHierarchy(&model.MemberHierarchy{
+		Member:      &model.GrpsIOMember{UID: "m-1"},
+		MailingList: &model.GroupsIOMailingList{UID: "ml-1"},
+		Service:     &model.GroupsIOService{UID: "svc-1"},
+		Project:     &model.HierarchyProject{UID: "proj-1", Name: "Project One"},
+	})
+	s.Require().NotNil(got)
+	s.Equal("m-1", ptrVal(got.Member.ID))
+	s.Equal("ml-1", ptrVal(got.Subgroup.ID))
+	s.Equal("svc-1", ptrVal(got.Service.ID))
+	s.Equal("proj-1", got.Project.UID)
+	s.Equal("Project One", ptrVal(got.Project.Name))
+	s.Nil(got.Project.Slug)
+	s.NotNil(got.Warnings, "no warnings serialize as []")
+
+	partial := convertMemberHierarchy(&model.MemberHierarchy{
+		Member:   &model.GrpsIOMember{UID: "m-1"},
+		Warnings: []string{"mailing list ml-1 not found"},
+	})
+	s.Nil(partial.Subgroup)
+	s.Nil(partial.Service)
+	s.Nil(partial.Project)
+	s.Equal([]string{"mailing list ml-1 not found"}, partial.Warnings)
+	s.Nil(convertMemberHierarchy(nil))
+}
+
+func (s *ServiceConvertersSuite) TestConvertMemberPage() {
+	got := convertMemberPage(&model.MemberPage{
+		Members:    []*model.GrpsIOMember{{UID: "m-1"}, {UID: "m-2"}},
+		NextCursor: "next",
+	})
+	s.Require().NotNil(got)
+	s.Len(got.Items, 2)
+	s.Equal("next", ptrVal(got.NextCursor))
+
+	last := convertMemberPage(&model.MemberPage{Members: []*model.GrpsIOMember{}})
+	s.NotNil(last.Items)
+	s.Nil(last.NextCursor, "the last page has no cursor")
+	s.Nil(convertMemberPage(nil))
+}
+
+func (s *ServiceConvertersSuite) TestNonNilStrings() {
+	s.Equal([]string{}, nonNilStrings(nil))
+	s.Equal([]string{"a"}, nonNilStrings([]string{"a"}))
+}
+
 // ptr is a helper to get a pointer to a string literal.
 func ptr(s string) *string { return &s }
 
@@ -8,6 +8,8 @@ import (
 	"context"
 	"errors"
 	"log/slog"
+	"net/http"
+	"time"
 
 	mailinglist "github.com/linuxfoundation/lfx-v2-mailing-list-service/gen/mailing_list"
 	"github.com/linuxfoundation/lfx-v2-mailing-list-service/internal/domain/model"
@@ -22,25 +24,31 @@ import (
 // mailingListAPI implements the generated mailinglist.Service interface.
 type mailingListAPI struct {
 	auth              port.Authenticator
-	serviceReader     port.GroupsIOServiceReader
-	serviceWriter     port.GroupsIOServiceWriter
-	mailingListReader port.GroupsIOMailingListReader
+	serviceReader     port.GroupsIOServiceQueryReader
+	serviceWriter     port.GroupsIOServiceManager
+	mailingListReader port.GroupsIOMailingListQueryReader
 	mailingListWriter port.GroupsIOMailingListWriter
-	memberReader      port.GroupsIOMailingListMemberReader
-	memberWriter      port.GroupsIOMailingListMemberWriter
+	memberReader      port.GroupsIOMailingListMemberQueryReader
+	memberWriter      port.GroupsIOMailingListMemberManager
 	artifactReader    port.GroupsIOArtifactReader
+	overviewReader    port.GroupsIOOverviewReader
+	indexRepublisher  port.GroupsIOIndexRepublisher
+	readinessChecks   []ReadinessCheck
 }
 
 // NewMailingListAPI returns the mailing list API service implementation.
 func NewMailingListAPI(
 	auth port.Authenticator,
-	serviceReader port.GroupsIOServiceReader,
-	serviceWriter port.GroupsIOServiceWriter,
-	mailingListReader port.GroupsIOMailingListReader,
+	serviceReader port.GroupsIOServiceQueryReader,
+	serviceWriter port.GroupsIOServiceManager,
+	mailingListReader port.GroupsIOMailingListQueryReader,
 	mailingListWriter port.GroupsIOMailingListWriter,
-	memberReader port.GroupsIOMailingListMemberReader,
-	memberWriter port.GroupsIOMailingListMemberWriter,
+	memberReader port.GroupsIOMailingListMemberQueryReader,
+	memberWriter port.GroupsIOMailingListMemberManager,
 	artifactReader port.GroupsIOArtifactReader,
+	overviewReader port.GroupsIOOverviewReader,
+	indexRepublisher port.GroupsIOIndexRepublisher,
+	readinessChecks ...ReadinessCheck,
 ) mailinglist.Service {
 	return &mailingListAPI{
 		auth:              auth,
@@ -51,6 +59,9 @@ func NewMailingListAPI(
 		memberReader:      memberReader,
 		memberWriter:      memberWriter,
 		artifactReader:    artifactReader,
+		overviewReader:    overviewReader,
+		indexRepublisher:  indexRepublisher,
+		readinessChecks:   readinessChecks,
 	}
 }
 
@@ -68,9 +79,13 @@ func (s *mailingListAPI) Livez(_ context.Context) ([]byte, error) {
 	return []byte("OK"), nil
 }
 
-// Readyz implements the readiness probe endpoint.
-func (s *mailingListAPI) Readyz(_ context.Context) ([]byte, error) {
-	return []byte("OK"), nil
+// Readyz implements the readiness probe endpoint, reporting the status of each dependency.
+func (s *mailingListAPI) Readyz(ctx context.Context) (*mailinglist.Readiness, error) {
+	report, err := checkReadiness(ctx, s.readinessChecks)
+	if err != nil {
+		return nil, err
+	}
+	return report, nil
 }
 
 // ---- GroupsIO Service endpoints ----
@@ -78,7 +93,7 @@ func (s *mailingListAPI) Readyz(_ context.Context) ([]byte, error) {
 func (s *mailingListAPI) ListGroupsioServices(ctx context.Context, p *mailinglist.ListGroupsioServicesPayload) (*mailinglist.GroupsioServiceList, error) {
 	svcs, total, err := s.serviceReader.ListServices(ctx, converter.StringVal(p.ProjectUID))
 	if err != nil {
-		return nil, mapDomainError(err)
+		return nil, mapDomainError(ctx, err)
 	}
 	items := make([]*mailinglist.GroupsioService, len(svcs))
 	for i, svc := range svcs {
@@ -98,7 +113,7 @@ func (s *mailingListAPI) CreateGroupsioService(ctx context.Context, p *mailingli
 	}
 	resp, err := s.serviceWriter.CreateService(ctx, svc)
 	if err != nil {
-		return nil, mapDomainError(err)
+		return nil, mapDomainError(ctx, err)
 	}
 	return convertService(resp), nil
 }
@@ -106,7 +121,7 @@ func (s *mailingListAPI) CreateGroupsioService(ctx context.Context, p *mailingli
 func (s *mailingListAPI) GetGroupsioService(ctx context.Context, p *mailinglist.GetGroupsioServicePayload) (*mailinglist.GroupsioService, error) {
 	svc, err := s.serviceReader.GetService(ctx, p.ServiceID)
 	if err != nil {
-		return nil, mapDomainError(err)
+		return nil, mapDomainError(ctx, err)
 	}
 	return convertService(svc), nil
 }
@@ -122,27 +137,51 @@ func (s *mailingListAPI) UpdateGroupsioService(ctx context.Context, p *mailingli
 	}
 	resp, err := s.serviceWriter.UpdateService(ctx, p.ServiceID, svc)
 	if err != nil {
-		return nil, mapDomainError(err)
+		return nil, mapDomainError(ctx, err)
 	}
 	return convertService(resp), nil
 }
 
+func (s *mailingListAPI) PromoteGroupsioService(ctx context.Context, p *mailinglist.PromoteGroupsioServicePayload) (*mailinglist.GroupsioServicePromotion, error) {
+	resp, err := s.serviceWriter.PromoteFormationToPrimary(ctx, p.ServiceID)
+	if err != nil {
+		return nil, mapDomainError(ctx, err)
+	}
+	return convertServicePromotion(resp), nil
+}
+
 func (s *mailingListAPI) DeleteGroupsioService(ctx context.Context, p *mailinglist.DeleteGroupsioServicePayload) error {
-	return mapDomainError(s.serviceWriter.DeleteService(ctx, p.ServiceID))
+	return mapDomainError(ctx, s.serviceWriter.DeleteService(ctx, p.ServiceID))
 }
 
 func (s *mailingListAPI) GetGroupsioServiceProjects(ctx context.Context, _ *mailinglist.GetGroupsioServiceProjectsPayload) (*mailinglist.GroupsioProjectsResponse, error) {
 	projects, err := s.serviceReader.GetProjects(ctx)
 	if err != nil {
-		return nil, mapDomainError(err)
+		return nil, mapDomainError(ctx, err)
 	}
 	return &mailinglist.GroupsioProjectsResponse{Projects: projects}, nil
 }
 
+func (s *mailingListAPI) ListGroupsioServicesByStatus(ctx context.Context, p *mailinglist.ListGroupsioServicesByStatusPayload) (*mailinglist.GroupsioServiceList, error) {
+	svcs, err := s.serviceReader.ListServicesByStatus(ctx, p.Status)
+	if err != nil {
+		return nil, mapDomainError(ctx, err)
+	}
+	return convertServiceList(svcs), nil
+}
+
+func (s *mailingListAPI) ListUnprovisionedGroupsioServices(ctx context.Context, p *mailinglist.ListUnprovisionedGroupsioServicesPayload) (*mailinglist.GroupsioServiceList, error) {
+	svcs, err := s.serviceReader.ListUnprovisionedServices(ctx, p.ProjectUID)
+	if err != nil {
+		return nil, mapDomainError(ctx, err)
+	}
+	return convertServiceList(svcs), nil
+}
+
 func (s *mailingListAPI) FindParentGroupsioService(ctx context.Context, p *mailinglist.FindParentGroupsioServicePayload) (*mailinglist.GroupsioService, error) {
 	svc, err := s.serviceReader.FindParentService(ctx, p.ProjectUID)
 	if err != nil {
-		return nil, mapDomainError(err)
+		return nil, mapDomainError(ctx, err)
 	}
 	return convertService(svc), nil
 }
@@ -152,7 +191,7 @@ func (s *mailingListAPI) FindParentGroupsioService(ctx context.Context, p *maili
 func (s *mailingListAPI) ListGroupsioMailingLists(ctx context.Context, p *mailinglist.ListGroupsioMailingListsPayload) (*mailinglist.GroupsioSubgroupList, error) {
 	items, total, err := s.mailingListReader.ListMailingLists(ctx, converter.StringVal(p.ProjectUID), converter.StringVal(p.CommitteeUID))
 	if err != nil {
-		return nil, mapDomainError(err)
+		return nil, mapDomainError(ctx, err)
 	}
 	result := make([]*mailinglist.GroupsioSubgroup, len(items))
 	for i, ml := range items {
@@ -161,6 +200,26 @@ func (s *mailingListAPI) ListGroupsioMailingLists(ctx context.Context, p *mailin
 	return &mailinglist.GroupsioSubgroupList{Items: result, Total: &total}, nil
 }
 
+func (s *mailingListAPI) ListGroupsioMailingListsByVisibility(ctx context.Context, p *mailinglist.ListGroupsioMailingListsByVisibilityPayload) (*mailinglist.GroupsioSubgroupList, error) {
+	items, err := s.mailingListReader.ListMailingListsByVisibility(ctx, p.ProjectUID, p.Public)
+	if err != nil {
+		return nil, mapDomainError(ctx, err)
+	}
+	return convertMailingListList(items), nil
+}
+
+func (s *mailingListAPI) ListGroupsioMailingListsUpdatedSince(ctx context.Context, p *mailinglist.ListGroupsioMailingListsUpdatedSincePayload) (*mailinglist.GroupsioSubgroupList, error) {
+	since, err := time.Parse(time.RFC3339, p.Since)
+	if err != nil {
+		return nil, &mailinglist.BadRequestError{Message: "since must be an RFC 3339 timestamp"}
+	}
+	items, err := s.mailingListReader.ListMailingListsUpdatedSince(ctx, p.ProjectUID, since)
+	if err != nil {
+		return nil, mapDomainError(ctx, err)
+	}
+	return convertMailingListList(items), nil
+}
+
 func (s *mailingListAPI) CreateGroupsioMailingList(ctx context.Context, p *mailinglist.CreateGroupsioMailingListPayload) (*mailinglist.GroupsioSubgroup, error) {
 	ml := &model.GroupsIOMailingList{
 		ProjectUID:     converter.StringVal(p.ProjectUID),
@@ -175,7 +234,7 @@ func (s *mailingListAPI) CreateGroupsioMailingList(ctx context.Context, p *maili
 	}
 	resp, err := s.mailingListWriter.CreateMailingList(ctx, ml)
 	if err != nil {
-		return nil, mapDomainError(err)
+		return nil, mapDomainError(ctx, err)
 	}
 	return convertMailingList(resp), nil
 }
@@ -183,7 +242,7 @@ func (s *mailingListAPI) CreateGroupsioMailingList(ctx context.Context, p *maili
 func (s *mailingListAPI) GetGroupsioMailingList(ctx context.Context, p *mailinglist.GetGroupsioMailingListPayload) (*mailinglist.GroupsioSubgroup, error) {
 	ml, err := s.mailingListReader.GetMailingList(ctx, p.SubgroupID)
 	if err != nil {
-		return nil, mapDomainError(err)
+		return nil, mapDomainError(ctx, err)
 	}
 	return convertMailingList(ml), nil
 }
@@ -202,19 +261,19 @@ func (s *mailingListAPI) UpdateGroupsioMailingList(ctx context.Context, p *maili
 	}
 	resp, err := s.mailingListWriter.UpdateMailingList(ctx, p.SubgroupID, ml)
 	if err != nil {
-		return nil, mapDomainError(err)
+		return nil, mapDomainError(ctx, err)
 	}
 	return convertMailingList(resp), nil
 }
 
 func (s *mailingListAPI) DeleteGroupsioMailingList(ctx context.Context, p *mailinglist.DeleteGroupsioMailingListPayload) error {
-	return mapDomainError(s.mailingListWriter.DeleteMailingList(ctx, p.SubgroupID))
+	return mapDomainError(ctx, s.mailingListWriter.DeleteMailingList(ctx, p.SubgroupID))
 }
 
 func (s *mailingListAPI) GetGroupsioMailingListCount(ctx context.Context, p *mailinglist.GetGroupsioMailingListCountPayload) (*mailinglist.GroupsioCount, error) {
 	count, err := s.mailingListReader.GetMailingListCount(ctx, p.ProjectUID)
 	if err != nil {
-		return nil, mapDomainError(err)
+		return nil, mapDomainError(ctx, err)
 	}
 	return &mailinglist.GroupsioCount{Count: count}, nil
 }
@@ -222,7 +281,7 @@ func (s *mailingListAPI) GetGroupsioMailingListCount(ctx context.Context, p *mai
 func (s *mailingListAPI) GetGroupsioMailingListMemberCount(ctx context.Context, p *mailinglist.GetGroupsioMailingListMemberCountPayload) (*mailinglist.GroupsioCount, error) {
 	count, err := s.mailingListReader.GetMailingListMemberCount(ctx, p.SubgroupID)
 	if err != nil {
-		return nil, mapDomainError(err)
+		return nil, mapDomainError(ctx, err)
 	}
 	return &mailinglist.GroupsioCount{Count: count}, nil
 }
@@ -232,7 +291,7 @@ func (s *mailingListAPI) GetGroupsioMailingListMemberCount(ctx context.Context,
 func (s *mailingListAPI) ListGroupsioMembers(ctx context.Context, p *mailinglist.ListGroupsioMembersPayload) (*mailinglist.GroupsioMemberList, error) {
 	items, total, err := s.memberReader.ListMembers(ctx, p.SubgroupID)
 	if err != nil {
-		return nil, mapDomainError(err)
+		return nil, mapDomainError(ctx, err)
 	}
 	result := make([]*mailinglist.GroupsioMember, len(items))
 	for i, m := range items {
@@ -241,6 +300,61 @@ func (s *mailingListAPI) ListGroupsioMembers(ctx context.Context, p *mailinglist
 	return &mailinglist.GroupsioMemberList{Items: result, Total: &total}, nil
 }
 
+func (s *mailingListAPI) ListGroupsioMembersNeedingReview(ctx context.Context, p *mailinglist.ListGroupsioMembersNeedingReviewPayload) (*mailinglist.GroupsioMemberList, error) {
+	olderThan, err := time.Parse(time.RFC3339, p.OlderThan)
+	if err != nil {
+		return nil, &mailinglist.BadRequestError{Message: "older_than must be an RFC 3339 timestamp"}
+	}
+	items, err := s.memberReader.ListMembersNeedingReview(ctx, p.SubgroupID, olderThan)
+	if err != nil {
+		return nil, mapDomainError(ctx, err)
+	}
+	return convertMemberList(items), nil
+}
+
+func (s *mailingListAPI) ListGroupsioMembersModifiedSince(ctx context.Context, p *mailinglist.ListGroupsioMembersModifiedSincePayload) (*mailinglist.GroupsioMemberList, error) {
+	since, err := time.Parse(time.RFC3339, p.Since)
+	if err != nil {
+		return nil, &mailinglist.BadRequestError{Message: "since must be an RFC 3339 timestamp"}
+	}
+	items, err := s.memberReader.ListMembersModifiedSince(ctx, p.SubgroupID, since)
+	if err != nil {
+		return nil, mapDomainError(ctx, err)
+	}
+	return convertMemberList(items), nil
+}
+
+func (s *mailingListAPI) ListGroupsioMembersByOrganization(ctx context.Context, p *mailinglist.ListGroupsioMembersByOrganizationPayload) (*mailinglist.GroupsioMemberList, error) {
+	items, err := s.memberReader.ListMembersByOrganization(ctx, p.SubgroupID, p.Organization)
+	if err != nil {
+		return nil, mapDomainError(ctx, err)
+	}
+	return convertMemberList(items), nil
+}
+
+func (s *mailingListAPI) ListGroupsioDuplicateMembers(ctx context.Context, p *mailinglist.ListGroupsioDuplicateMembersPayload) (*mailinglist.GroupsioMemberDuplicates, error) {
+	groups, err := s.memberReader.FindDuplicateMembers(ctx, p.SubgroupID)
+	if err != nil {
+		return nil, mapDomainError(ctx, err)
+	}
+	if groups == nil {
+		groups = [][]string{}
+	}
+	return &mailinglist.GroupsioMemberDuplicates{Groups: groups}, nil
+}
+
+func (s *mailingListAPI) PreviewGroupsioDeliveryModeChange(ctx context.Context, p *mailinglist.PreviewGroupsioDeliveryModeChangePayload) (*mailinglist.GroupsioDeliveryModePreview, error) {
+	affected, unchanged, err := s.memberReader.PreviewDeliveryModeChange(ctx, p.SubgroupID, p.TargetMode)
+	if err != nil {
+		return nil, mapDomainError(ctx, err)
+	}
+	return &mailinglist.GroupsioDeliveryModePreview{
+		TargetMode: p.TargetMode,
+		Affected:   nonNilStrings(affected),
+		Unchanged:  nonNilStrings(unchanged),
+	}, nil
+}
+
 func (s *mailingListAPI) AddGroupsioMember(ctx context.Context, p *mailinglist.AddGroupsioMemberPayload) (*mailinglist.GroupsioMember, error) {
 	member := &model.GrpsIOMember{
 		Email:          converter.StringVal(p.Email),
@@ -253,15 +367,32 @@ func (s *mailingListAPI) AddGroupsioMember(ctx context.Context, p *mailinglist.A
 	}
 	resp, err := s.memberWriter.AddMember(ctx, p.SubgroupID, member)
 	if err != nil {
-		return nil, mapDomainError(err)
+		return nil, mapDomainError(ctx, err)
 	}
 	return convertMember(resp), nil
 }
 
+func (s *mailingListAPI) UpsertGroupsioMember(ctx context.Context, p *mailinglist.UpsertGroupsioMemberPayload) (*mailinglist.GroupsioMemberUpsertResult, error) {
+	member := &model.GrpsIOMember{
+		Email:          p.Email,
+		GroupsFullName: converter.StringVal(p.Name),
+		DeliveryMode:   converter.StringVal(p.DeliveryMode),
+		MemberType:     converter.StringVal(p.MemberType),
+		ModStatus:      converter.StringVal(p.ModStatus),
+		Organization:   converter.StringVal(p.Organization),
+		JobTitle:       converter.StringVal(p.JobTitle),
+	}
+	resp, created, err := s.memberWriter.UpsertMember(ctx, p.SubgroupID, member)
+	if err != nil {
+		return nil, mapDomainError(ctx, err)
+	}
+	return &mailinglist.GroupsioMemberUpsertResult{Member: convertMember(resp), Created: created}, nil
+}
+
 func (s *mailingListAPI) GetGroupsioMember(ctx context.Context, p *mailinglist.GetGroupsioMemberPayload) (*mailinglist.GroupsioMember, error) {
 	m, err := s.memberReader.GetMember(ctx, p.SubgroupID, p.MemberID)
 	if err != nil {
-		return nil, mapDomainError(err)
+		return nil, mapDomainError(ctx, err)
 	}
 	return convertMember(m), nil
 }
@@ -275,26 +406,54 @@ func (s *mailingListAPI) UpdateGroupsioMember(ctx context.Context, p *mailinglis
 		ModStatus:      converter.StringVal(p.ModStatus),
 		Organization:   converter.StringVal(p.Organization),
 		JobTitle:       converter.StringVal(p.JobTitle),
+		MailingListUID: converter.StringVal(p.MailingListUID),
 	}
 	resp, err := s.memberWriter.UpdateMember(ctx, p.SubgroupID, p.MemberID, member)
 	if err != nil {
-		return nil, mapDomainError(err)
+		return nil, mapDomainError(ctx, err)
 	}
 	return convertMember(resp), nil
 }
 
 func (s *mailingListAPI) DeleteGroupsioMember(ctx context.Context, p *mailinglist.DeleteGroupsioMemberPayload) error {
-	return mapDomainError(s.memberWriter.DeleteMember(ctx, p.SubgroupID, p.MemberID))
+	return mapDomainError(ctx, s.memberWriter.DeleteMember(ctx, p.SubgroupID, p.MemberID))
 }
 
 func (s *mailingListAPI) InviteGroupsioMembers(ctx context.Context, p *mailinglist.InviteGroupsioMembersPayload) error {
-	return mapDomainError(s.memberWriter.InviteMembers(ctx, p.SubgroupID, p.Emails))
+	return mapDomainError(ctx, s.memberWriter.InviteMembers(ctx, p.SubgroupID, p.Emails))
+}
+
+func (s *mailingListAPI) ImportGroupsioMembers(ctx context.Context, p *mailinglist.ImportGroupsioMembersPayload) (*mailinglist.GroupsioImportSummary, error) {
+	members := make([]*model.GrpsIOMember, len(p.Members))
+	for i, m := range p.Members {
+		members[i] = convertMemberRequest(m)
+	}
+	summary, err := s.memberWriter.ImportMembers(ctx, p.SubgroupID, members)
+	if err != nil {
+		return nil, mapDomainError(ctx, err)
+	}
+	return convertImportSummary(summary), nil
+}
+
+func (s *mailingListAPI) MergeGroupsioMembers(ctx context.Context, p *mailinglist.MergeGroupsioMembersPayload) error {
+	if err := s.memberWriter.MergeMembers(ctx, p.SubgroupID, p.KeepMemberID, p.MergeMemberIds); err != nil {
+		return mapDomainError(ctx, err)
+	}
+	return nil
+}
+
+func (s *mailingListAPI) ChangeGroupsioMemberEmail(ctx context.Context, p *mailinglist.ChangeGroupsioMemberEmailPayload) (*mailinglist.GroupsioMember, error) {
+	resp, err := s.memberWriter.ChangeMemberEmail(ctx, p.SubgroupID, p.MemberID, p.Email)
+	if err != nil {
+		return nil, mapDomainError(ctx, err)
+	}
+	return convertMember(resp), nil
 }
 
 func (s *mailingListAPI) CheckGroupsioSubscriber(ctx context.Context, p *mailinglist.CheckGroupsioSubscriberPayload) (*mailinglist.GroupsioCheckSubscriberResponse, error) {
 	subscribed, err := s.memberReader.CheckSubscriber(ctx, p.SubgroupID, p.Email)
 	if err != nil {
-		return nil, mapDomainError(err)
+		return nil, mapDomainError(ctx, err)
 	}
 	return &mailinglist.GroupsioCheckSubscriberResponse{Subscribed: subscribed}, nil
 }
@@ -304,7 +463,7 @@ func (s *mailingListAPI) CheckGroupsioSubscriber(ctx context.Context, p *mailing
 func (s *mailingListAPI) GetGroupsioArtifact(ctx context.Context, p *mailinglist.GetGroupsioArtifactPayload) (*mailinglist.GroupsioArtifact, error) {
 	artifact, err := s.artifactReader.GetArtifact(ctx, p.SubgroupID, p.ArtifactID)
 	if err != nil {
-		return nil, mapDomainError(err)
+		return nil, mapDomainError(ctx, err)
 	}
 	return convertArtifact(artifact), nil
 }
@@ -312,32 +471,136 @@ func (s *mailingListAPI) GetGroupsioArtifact(ctx context.Context, p *mailinglist
 func (s *mailingListAPI) GetGroupsioArtifactDownload(ctx context.Context, p *mailinglist.GetGroupsioArtifactDownloadPayload) (*mailinglist.GroupsioArtifactDownload, error) {
 	url, err := s.artifactReader.GetArtifactDownloadURL(ctx, p.SubgroupID, p.ArtifactID)
 	if err != nil {
-		return nil, mapDomainError(err)
+		return nil, mapDomainError(ctx, err)
 	}
 	return &mailinglist.GroupsioArtifactDownload{URL: url}, nil
 }
 
-// ---- Helpers ----
+// ---- GroupsIO Project overview endpoints ----
 
-func mapDomainError(err error) error {
-	if err == nil {
-		return nil
+func (s *mailingListAPI) GetGroupsioProjectSummary(ctx context.Context, p *mailinglist.GetGroupsioProjectSummaryPayload) (*mailinglist.GroupsioProjectSummary, error) {
+	summary, err := s.overviewReader.GetProjectSummary(ctx, p.ProjectUID)
+	if err != nil {
+		return nil, mapDomainError(ctx, err)
+	}
+	return convertProjectSummary(summary), nil
+}
+
+func (s *mailingListAPI) ListGroupsioOrphanedMailingLists(ctx context.Context, p *mailinglist.ListGroupsioOrphanedMailingListsPayload) (*mailinglist.GroupsioSubgroupList, error) {
+	items, err := s.overviewReader.ListOrphanedMailingLists(ctx, p.ProjectUID)
+	if err != nil {
+		return nil, mapDomainError(ctx, err)
+	}
+	return convertMailingListList(items), nil
+}
+
+func (s *mailingListAPI) RepublishGroupsioProjectIndex(ctx context.Context, p *mailinglist.RepublishGroupsioProjectIndexPayload) (*mailinglist.GroupsioRepublishSummary, error) {
+	published, err := s.indexRepublisher.RepublishProjectIndex(ctx, p.ProjectUID)
+	if err != nil {
+		slog.WarnContext(ctx, "project index republish stopped early",
+			"project_uid", p.ProjectUID, "published", published, "error", err)
+		return nil, mapDomainError(ctx, err)
+	}
+	return &mailinglist.GroupsioRepublishSummary{Published: published}, nil
+}
+
+func (s *mailingListAPI) GetGroupsioMailingListStats(ctx context.Context, p *mailinglist.GetGroupsioMailingListStatsPayload) (*mailinglist.GroupsioSubgroupStats, error) {
+	stats, err := s.overviewReader.GetMailingListStats(ctx, p.SubgroupID)
+	if err != nil {
+		return nil, mapDomainError(ctx, err)
+	}
+	return convertMailingListStats(stats), nil
+}
+
+func (s *mailingListAPI) GetGroupsioServiceTree(ctx context.Context, p *mailinglist.GetGroupsioServiceTreePayload) (*mailinglist.GroupsioServiceTree, error) {
+	tree, err := s.overviewReader.GetServiceTree(ctx, p.ServiceID, p.IncludeMemberCounts)
+	if err != nil {
+		return nil, mapDomainError(ctx, err)
+	}
+	return convertServiceTree(tree), nil
+}
+
+func (s *mailingListAPI) GetGroupsioMemberHierarchy(ctx context.Context, p *mailinglist.GetGroupsioMemberHierarchyPayload) (*mailinglist.GroupsioMemberHierarchy, error) {
+	hierarchy, err := s.overviewReader.GetMemberHierarchy(ctx, p.SubgroupID, p.MemberID)
+	if err != nil {
+		return nil, mapDomainError(ctx, err)
 	}
-	var notFound errs.NotFound
-	if errors.As(err, &notFound) {
-		return &mailinglist.NotFoundError{Message: notFound.Error()}
+	return convertMemberHierarchy(hierarchy), nil
+}
+
+func (s *mailingListAPI) ListGroupsioProjectMembers(ctx context.Context, p *mailinglist.ListGroupsioProjectMembersPayload) (*mailinglist.GroupsioMemberPage, error) {
+	opts := model.ListOptions{Limit: p.Limit, Dedupe: p.Dedupe}
+	if p.Cursor != nil {
+		opts.Cursor = *p.Cursor
+	}
+	page, err := s.overviewReader.ListMembersByProject(ctx, p.ProjectUID, opts)
+	if err != nil {
+		return nil, mapDomainError(ctx, err)
 	}
-	var validation errs.Validation
-	if errors.As(err, &validation) {
-		return &mailinglist.BadRequestError{Message: validation.Error()}
+	return convertMemberPage(page), nil
+}
+
+// ---- Helpers ----
+
+// HTTPStatusForError returns the HTTP status code a domain error is served with. It is the
+// single source of truth for the mapping: mapDomainError switches on it to pick the Goa error.
+// Wrapped errors are unwrapped with errors.As; anything unrecognised is a 500.
+func HTTPStatusForError(err error) int {
+	var (
+		notFound    errs.NotFound
+		validation  errs.Validation
+		conflict    errs.Conflict
+		unavailable errs.ServiceUnavailable
+		timeout     errs.Timeout
+	)
+	switch {
+	case err == nil:
+		return http.StatusOK
+	case errors.As(err, &notFound):
+		return http.StatusNotFound
+	case errors.As(err, &validation):
+		return http.StatusBadRequest
+	case errors.As(err, &conflict):
+		return http.StatusConflict
+	case errors.As(err, &unavailable), errors.As(err, &timeout):
+		return http.StatusServiceUnavailable
+	default:
+		return http.StatusInternalServerError
 	}
-	var conflict errs.Conflict
-	if errors.As(err, &conflict) {
-		return &mailinglist.ConflictError{Message: conflict.Error()}
+}
+
+// mapDomainError converts a domain error into the Goa error of the status HTTPStatusForError
+// assigns it. Only the typed error's own message is returned, since the wrapped chain can carry
+// ITX URLs and IDs. Errors of no known type are logged and get a generic message.
+func mapDomainError(ctx context.Context, err error) error {
+	if err == nil {
+		return nil
 	}
-	var unavailable errs.ServiceUnavailable
-	if errors.As(err, &unavailable) {
-		return &mailinglist.ServiceUnavailableError{Message: unavailable.Error()}
+	switch HTTPStatusForError(err) {
+	case http.StatusNotFound:
+		return &mailinglist.NotFoundError{Message: typedMessage[errs.NotFound](err)}
+	case http.StatusBadRequest:
+		return &mailinglist.BadRequestError{Message: typedMessage[errs.Validation](err)}
+	case http.StatusConflict:
+		return &mailinglist.ConflictError{Message: typedMessage[errs.Conflict](err)}
+	case http.StatusServiceUnavailable:
+		var unavailable errs.ServiceUnavailable
+		if errors.As(err, &unavailable) {
+			return &mailinglist.ServiceUnavailableError{Message: unavailable.Message()}
+		}
+		return &mailinglist.ServiceUnavailableError{Message: typedMessage[errs.Timeout](err)}
+	default:
+		slog.ErrorContext(ctx, "unexpected error serving request", "error", err)
+		return &mailinglist.InternalServerError{Message: "internal server error"}
 	}
-	return &mailinglist.InternalServerError{Message: err.Error()}
+}
+
+// typedMessage returns the own message of the first T in err's chain.
+func typedMessage[T interface {
+	error
+	Message() string
+}](err error) string {
+	var typed T
+	errors.As(err, &typed)
+	return typed.Message()
 }
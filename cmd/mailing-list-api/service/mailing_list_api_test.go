@@ -0,0 +1,86 @@
+// Copyright The Linux Foundation and each contributor to LFX.
+// SPDX-License-Identifier: MIT
+
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"testing"
+
+	mailinglist "github.com/linuxfoundation/lfx-v2-mailing-list-service/gen/mailing_list"
+	errs "github.com/linuxfoundation/lfx-v2-mailing-list-service/pkg/errors"
+	"github.com/stretchr/testify/suite"
+)
+
+type ErrorMappingSuite struct {
+	suite.Suite
+}
+
+func TestErrorMapping(t *testing.T) {
+	suite.Run(t, new(ErrorMappingSuite))
+}
+
+func (s *ErrorMappingSuite) TestHTTPStatusForError() {
+	tests := []struct {
+		name         string
+		err          error
+		expectStatus int
+	}{
+		{name: "nil is ok", err: nil, expectStatus: http.StatusOK},
+		{name: "not found", err: errs.NewNotFound("missing"), expectStatus: http.StatusNotFound},
+		{name: "validation", err: errs.NewValidation("bad"), expectStatus: http.StatusBadRequest},
+		{name: "conflict", err: errs.NewConflict("exists"), expectStatus: http.StatusConflict},
+		{name: "service unavailable", err: errs.NewServiceUnavailable("down"), expectStatus: http.StatusServiceUnavailable},
+		{name: "timeout", err: errs.NewTimeout("slow"), expectStatus: http.StatusServiceUnavailable},
+		{name: "unauthorized is not exposed", err: errs.NewUnauthorized("nope"), expectStatus: http.StatusInternalServerError},
+		{name: "unexpected", err: errs.NewUnexpected("boom"), expectStatus: http.StatusInternalServerError},
+		{name: "unknown", err: errors.New("boom"), expectStatus: http.StatusInternalServerError},
+		{name: "wrapped not found", err: fmt.Errorf("get service: %w", errs.NewNotFound("missing")), expectStatus: http.StatusNotFound},
+		{name: "wrapped conflict", err: fmt.Errorf("create list: %w", errs.NewConflict("exists")), expectStatus: http.StatusConflict},
+	}
+
+	for _, tt := range tests {
+		s.Run(tt.name, func() {
+			s.Equal(tt.expectStatus, HTTPStatusForError(tt.err))
+		})
+	}
+}
+
+func (s *ErrorMappingSuite) TestMapDomainError() {
+	tests := []struct {
+		name       string
+		err        error
+		expectType any
+	}{
+		{name: "not found", err: errs.NewNotFound("missing"), expectType: &mailinglist.NotFoundError{}},
+		{name: "validation", err: errs.NewValidation("bad"), expectType: &mailinglist.BadRequestError{}},
+		{name: "conflict", err: errs.NewConflict("exists"), expectType: &mailinglist.ConflictError{}},
+		{name: "service unavailable", err: errs.NewServiceUnavailable("down"), expectType: &mailinglist.ServiceUnavailableError{}},
+		{name: "timeout", err: errs.NewTimeout("slow"), expectType: &mailinglist.ServiceUnavailableError{}},
+		{name: "unexpected", err: errs.NewUnexpected("boom"), expectType: &mailinglist.InternalServerError{}},
+		{name: "unknown", err: errors.New("boom"), expectType: &mailinglist.InternalServerError{}},
+	}
+
+	for _, tt := range tests {
+		s.Run(tt.name, func() {
+			s.IsType(tt.expectType, mapDomainError(context.Background(), tt.err))
+		})
+	}
+	s.NoError(mapDomainError(context.Background(), nil))
+}
+
+func (s *ErrorMappingSuite) TestMapDomainError_WrappedCause_IsNotExposed() {
+	cause := errors.New("GET https://itx.example.com/v2/groupsio_service/svc-1: 404")
+
+	got := mapDomainError(context.Background(), fmt.Errorf("get service: %w", errs.NewNotFound("service not found", cause)))
+	s.Equal(&mailinglist.NotFoundError{Message: "service not found"}, got)
+
+	got = mapDomainError(context.Background(), errs.NewTimeout("ITX request timed out", cause))
+	s.Equal(&mailinglist.ServiceUnavailableError{Message: "ITX request timed out"}, got)
+
+	got = mapDomainError(context.Background(), errs.NewUnexpected("failed to parse response", cause))
+	s.Equal(&mailinglist.InternalServerError{Message: "internal server error"}, got)
+}
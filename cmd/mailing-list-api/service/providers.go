@@ -10,17 +10,20 @@ import (
 	"log"
 	"log/slog"
 	"os"
+	"slices"
 	"strconv"
 	"strings"
 	"sync"
 	"time"
 
+	"github.com/linuxfoundation/lfx-v2-mailing-list-service/internal/domain/model"
 	"github.com/linuxfoundation/lfx-v2-mailing-list-service/internal/domain/port"
 	"github.com/linuxfoundation/lfx-v2-mailing-list-service/internal/infrastructure/auth"
 	infrastructure "github.com/linuxfoundation/lfx-v2-mailing-list-service/internal/infrastructure/mock"
 	"github.com/linuxfoundation/lfx-v2-mailing-list-service/internal/infrastructure/nats"
 	"github.com/linuxfoundation/lfx-v2-mailing-list-service/internal/infrastructure/proxy"
 	"github.com/linuxfoundation/lfx-v2-mailing-list-service/pkg/constants"
+	"github.com/linuxfoundation/lfx-v2-mailing-list-service/pkg/redaction"
 )
 
 var (
@@ -96,15 +99,150 @@ func Translator(ctx context.Context) port.Translator {
 // ITXProxyConfig reads ITX proxy configuration from environment variables.
 func ITXProxyConfig() proxy.Config {
 	return proxy.Config{
-		BaseURL:     os.Getenv("ITX_BASE_URL"),
-		ClientID:    os.Getenv("ITX_CLIENT_ID"),
-		PrivateKey:  decodePrivateKey(os.Getenv("ITX_CLIENT_PRIVATE_KEY")),
-		Auth0Domain: os.Getenv("ITX_AUTH0_DOMAIN"),
-		Audience:    os.Getenv("ITX_AUDIENCE"),
-		Timeout:     30 * time.Second,
+		BaseURL:      os.Getenv("ITX_BASE_URL"),
+		ClientID:     os.Getenv("ITX_CLIENT_ID"),
+		PrivateKey:   decodePrivateKey(os.Getenv("ITX_CLIENT_PRIVATE_KEY")),
+		Auth0Domain:  os.Getenv("ITX_AUTH0_DOMAIN"),
+		Audience:     os.Getenv("ITX_AUDIENCE"),
+		Timeout:      30 * time.Second,
+		ReadTimeout:  envDuration("ITX_READ_TIMEOUT", 15*time.Second),
+		WriteTimeout: envDuration("ITX_WRITE_TIMEOUT", 30*time.Second),
 	}
 }
 
+// MaxBatchSize returns the maximum number of entries accepted by batch member
+// operations (MEMBER_MAX_BATCH_SIZE). Zero keeps the orchestrator default.
+func MaxBatchSize() int {
+	return envInt("MEMBER_MAX_BATCH_SIZE")
+}
+
+// ImportRateLimit returns the maximum number of member adds per second made by a member
+// import (MEMBER_IMPORT_RATE_LIMIT). Zero, the default, leaves imports unthrottled.
+func ImportRateLimit() int {
+	return envInt("MEMBER_IMPORT_RATE_LIMIT")
+}
+
+// RepublishRateLimit returns the maximum number of indexer messages per second sent when a
+// project's index is republished (INDEX_REPUBLISH_RATE_LIMIT). Zero, the default, leaves it
+// unthrottled.
+func RepublishRateLimit() int {
+	return envInt("INDEX_REPUBLISH_RATE_LIMIT")
+}
+
+// MaxMailingListsPerService returns the maximum number of mailing lists a service may have
+// (MAILING_LIST_MAX_PER_SERVICE). Zero, the default, leaves it unlimited.
+func MaxMailingListsPerService() int {
+	return envInt("MAILING_LIST_MAX_PER_SERVICE")
+}
+
+// OverviewConcurrency returns how many per-mailing-list reads an overview (project summary,
+// service tree) runs at once (OVERVIEW_CONCURRENCY). Zero, the default, keeps them serial.
+func OverviewConcurrency() int {
+	return envInt("OVERVIEW_CONCURRENCY")
+}
+
+// IdempotentDeletes reports whether deleting a service, mailing list or member that no longer
+// exists should succeed instead of returning 404 (IDEMPOTENT_DELETES=true).
+func IdempotentDeletes() bool {
+	return strings.EqualFold(os.Getenv("IDEMPOTENT_DELETES"), "true")
+}
+
+// AdoptExistingMailingLists reports whether creating a mailing list that already exists
+// (MAILING_LIST_ADOPT_EXISTING=true) should adopt the existing list instead of failing.
+func AdoptExistingMailingLists() bool {
+	return strings.EqualFold(os.Getenv("MAILING_LIST_ADOPT_EXISTING"), "true")
+}
+
+// StrictMailingListPublish reports whether a mailing list create should be rolled back when
+// its committee cannot be notified (MAILING_LIST_STRICT_PUBLISH=true) instead of succeeding
+// with a warning.
+func StrictMailingListPublish() bool {
+	return strings.EqualFold(os.Getenv("MAILING_LIST_STRICT_PUBLISH"), "true")
+}
+
+// CommitteeRequiredListTypes returns the mailing list types that must be associated with a
+// committee (MAILING_LIST_COMMITTEE_REQUIRED_TYPES, comma-separated, e.g.
+// "discussion_moderated"). Empty, the default, requires none.
+// Unknown type names are logged and dropped so a typo doesn't silently require nothing.
+func CommitteeRequiredListTypes() []string {
+	s := os.Getenv("MAILING_LIST_COMMITTEE_REQUIRED_TYPES")
+	if s == "" {
+		return nil
+	}
+	var types []string
+	for _, t := range strings.Split(s, ",") {
+		t = strings.TrimSpace(t)
+		if t == "" {
+			continue
+		}
+		if !slices.Contains(model.MailingListTypes, t) {
+			slog.Warn("ignoring unknown mailing list type in MAILING_LIST_COMMITTEE_REQUIRED_TYPES",
+				"type", t, "valid_types", strings.Join(model.MailingListTypes, ","))
+			continue
+		}
+		types = append(types, t)
+	}
+	return types
+}
+
+// ReservedGroupNames returns the mailing list names rejected on create
+// (MAILING_LIST_RESERVED_NAMES, comma-separated). Empty, the default, keeps
+// constants.DefaultReservedGroupNames.
+func ReservedGroupNames() []string {
+	s := os.Getenv("MAILING_LIST_RESERVED_NAMES")
+	if strings.TrimSpace(s) == "" {
+		return constants.DefaultReservedGroupNames
+	}
+	return strings.Split(s, ",")
+}
+
+// MailingListCreateBudget returns the time allowed for the pre-create steps of a mailing
+// list create (MAILING_LIST_CREATE_BUDGET, e.g. "10s"). Zero, the default, disables it.
+func MailingListCreateBudget() time.Duration {
+	return envDuration("MAILING_LIST_CREATE_BUDGET", 0)
+}
+
+// RedactionMode returns the email redaction mode used in logs (LOG_EMAIL_REDACTION:
+// none, partial or full; default partial). An invalid value falls back to full.
+func RedactionMode() redaction.Mode {
+	mode, err := redaction.ParseMode(os.Getenv("LOG_EMAIL_REDACTION"))
+	if err != nil {
+		slog.Warn("invalid LOG_EMAIL_REDACTION, redacting emails fully", "error", err)
+		return redaction.ModeFull
+	}
+	return mode
+}
+
+// envInt reads an integer environment variable, returning 0 when it is unset or cannot be
+// parsed. Every caller treats zero as "use the default".
+func envInt(key string) int {
+	s := os.Getenv(key)
+	if s == "" {
+		return 0
+	}
+	n, err := strconv.Atoi(s)
+	if err != nil {
+		slog.Warn("invalid integer, using default", "key", key, "value", s)
+		return 0
+	}
+	return n
+}
+
+// envDuration reads a duration environment variable (e.g. "10s"), returning
+// defaultVal if the variable is absent or cannot be parsed.
+func envDuration(key string, defaultVal time.Duration) time.Duration {
+	s := os.Getenv(key)
+	if s == "" {
+		return defaultVal
+	}
+	d, err := time.ParseDuration(s)
+	if err != nil {
+		slog.Warn("invalid duration, using default", "key", key, "value", s, "default", defaultVal)
+		return defaultVal
+	}
+	return d
+}
+
 // decodePrivateKey returns the raw PEM key, base64-decoding it first if needed.
 // Secrets stored in AWS Secrets Manager (and injected via External Secrets Operator)
 // are sometimes base64-encoded before storage; this handles both cases transparently.
@@ -286,3 +424,25 @@ func CommitteeProjectLookup(ctx context.Context) port.CommitteeProjectLookup {
 
 	return nil
 }
+
+// ReadinessChecks returns the dependencies reported by /readyz. NATS is critical when it
+// backs the repository (REPOSITORY_SOURCE=nats, the default). ITX is reported but only fails
+// the probe when READYZ_ITX_CRITICAL=true, so an ITX outage degrades the report without
+// taking every replica out of rotation.
+func ReadinessChecks(ctx context.Context, itx port.ReadinessChecker) []ReadinessCheck {
+	checks := []ReadinessCheck{{
+		Name:     "itx",
+		Critical: os.Getenv("READYZ_ITX_CRITICAL") == "true",
+		Checker:  itx,
+	}}
+
+	repoSource := os.Getenv("REPOSITORY_SOURCE")
+	if repoSource == "" || repoSource == "nats" {
+		checks = append(checks, ReadinessCheck{
+			Name:     "nats",
+			Critical: true,
+			Checker:  GetNATSClient(ctx),
+		})
+	}
+	return checks
+}
@@ -0,0 +1,41 @@
+// Copyright The Linux Foundation and each contributor to LFX.
+// SPDX-License-Identifier: MIT
+
+package service
+
+import (
+	"testing"
+
+	"github.com/linuxfoundation/lfx-v2-mailing-list-service/internal/domain/model"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestEnvInt(t *testing.T) {
+	tests := []struct {
+		name  string
+		value string
+		want  int
+	}{
+		{name: "unset", value: "", want: 0},
+		{name: "valid", value: "25", want: 25},
+		{name: "invalid", value: "ten", want: 0},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Setenv("TEST_ENV_INT", tt.value)
+			assert.Equal(t, tt.want, envInt("TEST_ENV_INT"))
+		})
+	}
+}
+
+func TestCommitteeRequiredListTypes_UnknownTypes_AreDropped(t *testing.T) {
+	t.Setenv("MAILING_LIST_COMMITTEE_REQUIRED_TYPES", " discussion_moderated ,discusion_open,,announcement")
+
+	assert.Equal(t, []string{model.TypeDiscussionModerated, model.TypeAnnouncement}, CommitteeRequiredListTypes())
+}
+
+func TestCommitteeRequiredListTypes_Unset_ReturnsNil(t *testing.T) {
+	t.Setenv("MAILING_LIST_COMMITTEE_REQUIRED_TYPES", "")
+
+	assert.Nil(t, CommitteeRequiredListTypes())
+}
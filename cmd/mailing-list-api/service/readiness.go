@@ -0,0 +1,70 @@
+// Copyright The Linux Foundation and each contributor to LFX.
+// SPDX-License-Identifier: MIT
+
+package service
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"sort"
+	"strings"
+	"time"
+
+	mailinglist "github.com/linuxfoundation/lfx-v2-mailing-list-service/gen/mailing_list"
+	"github.com/linuxfoundation/lfx-v2-mailing-list-service/internal/domain/port"
+)
+
+const (
+	readinessStatusOK       = "ok"
+	readinessStatusDegraded = "degraded"
+
+	// readinessCheckTimeout bounds each dependency check so a hung dependency cannot stall the probe.
+	readinessCheckTimeout = 3 * time.Second
+)
+
+// ReadinessCheck is a dependency reported by the readiness probe.
+// A failing Critical dependency fails the probe (503); a failing non-critical
+// dependency only marks the report as degraded.
+type ReadinessCheck struct {
+	Name     string
+	Critical bool
+	Checker  port.ReadinessChecker
+}
+
+// checkReadiness runs every check and builds the readiness report. The returned error is
+// non-nil when at least one critical dependency is not ready.
+func checkReadiness(ctx context.Context, checks []ReadinessCheck) (*mailinglist.Readiness, error) {
+	report := &mailinglist.Readiness{
+		Status:       readinessStatusOK,
+		Dependencies: make(map[string]string, len(checks)),
+	}
+
+	var failedCritical []string
+	for _, check := range checks {
+		checkCtx, cancel := context.WithTimeout(ctx, readinessCheckTimeout)
+		err := check.Checker.IsReady(checkCtx)
+		cancel()
+
+		if err == nil {
+			report.Dependencies[check.Name] = readinessStatusOK
+			continue
+		}
+
+		slog.WarnContext(ctx, "readiness dependency not ready",
+			"dependency", check.Name, "critical", check.Critical, "error", err)
+		report.Dependencies[check.Name] = fmt.Sprintf("unavailable: %v", err)
+		report.Status = readinessStatusDegraded
+		if check.Critical {
+			failedCritical = append(failedCritical, check.Name)
+		}
+	}
+
+	if len(failedCritical) > 0 {
+		sort.Strings(failedCritical)
+		return report, &mailinglist.ServiceUnavailableError{
+			Message: fmt.Sprintf("critical dependencies not ready: %s", strings.Join(failedCritical, ", ")),
+		}
+	}
+	return report, nil
+}
@@ -0,0 +1,86 @@
+// Copyright The Linux Foundation and each contributor to LFX.
+// SPDX-License-Identifier: MIT
+
+package service
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	mailinglist "github.com/linuxfoundation/lfx-v2-mailing-list-service/gen/mailing_list"
+	"github.com/stretchr/testify/suite"
+)
+
+// stubReadinessChecker returns the configured error from IsReady.
+type stubReadinessChecker struct {
+	err error
+}
+
+func (s *stubReadinessChecker) IsReady(_ context.Context) error { return s.err }
+
+type ReadinessSuite struct {
+	suite.Suite
+}
+
+func TestReadiness(t *testing.T) {
+	suite.Run(t, new(ReadinessSuite))
+}
+
+func (s *ReadinessSuite) TestReadyz() {
+	tests := []struct {
+		name          string
+		checks        []ReadinessCheck
+		expectErr     bool
+		expectStatus  string
+		expectDepsMap map[string]string
+	}{
+		{
+			name:          "no dependencies is ok",
+			expectStatus:  "ok",
+			expectDepsMap: map[string]string{},
+		},
+		{
+			name: "all dependencies ready",
+			checks: []ReadinessCheck{
+				{Name: "nats", Critical: true, Checker: &stubReadinessChecker{}},
+				{Name: "itx", Checker: &stubReadinessChecker{}},
+			},
+			expectStatus:  "ok",
+			expectDepsMap: map[string]string{"nats": "ok", "itx": "ok"},
+		},
+		{
+			name: "failing non-critical dependency degrades without failing",
+			checks: []ReadinessCheck{
+				{Name: "nats", Critical: true, Checker: &stubReadinessChecker{}},
+				{Name: "itx", Checker: &stubReadinessChecker{err: errors.New("token request failed")}},
+			},
+			expectStatus:  "degraded",
+			expectDepsMap: map[string]string{"nats": "ok", "itx": "unavailable: token request failed"},
+		},
+		{
+			name: "failing critical dependency fails the probe",
+			checks: []ReadinessCheck{
+				{Name: "nats", Critical: true, Checker: &stubReadinessChecker{err: errors.New("not connected")}},
+			},
+			expectErr: true,
+		},
+	}
+
+	for _, tc := range tests {
+		s.Run(tc.name, func() {
+			api := &mailingListAPI{readinessChecks: tc.checks}
+
+			report, err := api.Readyz(context.Background())
+			if tc.expectErr {
+				var unavailable *mailinglist.ServiceUnavailableError
+				s.Require().ErrorAs(err, &unavailable)
+				s.Contains(unavailable.Message, "nats")
+				return
+			}
+			s.Require().NoError(err)
+			s.Equal(tc.expectStatus, report.Status)
+			s.Equal(tc.expectDepsMap, report.Dependencies)
+		})
+	}
+}
@@ -23,7 +23,7 @@ import (
 //
 //	command (subcommand1|subcommand2|...)
 func UsageCommands() string {
-	return `mailing-list (livez|readyz|list-groupsio-services|create-groupsio-service|get-groupsio-service|update-groupsio-service|delete-groupsio-service|get-groupsio-service-projects|find-parent-groupsio-service|list-groupsio-mailing-lists|create-groupsio-mailing-list|get-groupsio-mailing-list|update-groupsio-mailing-list|delete-groupsio-mailing-list|get-groupsio-mailing-list-count|get-groupsio-mailing-list-member-count|list-groupsio-members|add-groupsio-member|get-groupsio-member|update-groupsio-member|delete-groupsio-member|invite-groupsio-members|check-groupsio-subscriber|get-groupsio-artifact|get-groupsio-artifact-download)
+	return `mailing-list (livez|readyz|list-groupsio-services|create-groupsio-service|get-groupsio-service|update-groupsio-service|promote-groupsio-service|delete-groupsio-service|get-groupsio-service-projects|list-groupsio-services-by-status|list-unprovisioned-groupsio-services|find-parent-groupsio-service|list-groupsio-mailing-lists|list-groupsio-mailing-lists-by-visibility|list-groupsio-mailing-lists-updated-since|create-groupsio-mailing-list|get-groupsio-mailing-list|update-groupsio-mailing-list|delete-groupsio-mailing-list|get-groupsio-mailing-list-count|get-groupsio-mailing-list-member-count|list-groupsio-members|list-groupsio-members-needing-review|list-groupsio-members-modified-since|list-groupsio-members-by-organization|list-groupsio-duplicate-members|preview-groupsio-delivery-mode-change|add-groupsio-member|upsert-groupsio-member|get-groupsio-member|update-groupsio-member|delete-groupsio-member|invite-groupsio-members|import-groupsio-members|merge-groupsio-members|change-groupsio-member-email|check-groupsio-subscriber|get-groupsio-artifact|get-groupsio-artifact-download|get-groupsio-project-summary|list-groupsio-orphaned-mailing-lists|republish-groupsio-project-index|get-groupsio-mailing-list-stats|get-groupsio-service-tree|get-groupsio-member-hierarchy|list-groupsio-project-members)
 `
 }
 
@@ -66,6 +66,10 @@ func ParseEndpoint(
 		mailingListUpdateGroupsioServiceServiceIDFlag   = mailingListUpdateGroupsioServiceFlags.String("service-id", "REQUIRED", "Service ID")
 		mailingListUpdateGroupsioServiceBearerTokenFlag = mailingListUpdateGroupsioServiceFlags.String("bearer-token", "", "")
 
+		mailingListPromoteGroupsioServiceFlags           = flag.NewFlagSet("promote-groupsio-service", flag.ExitOnError)
+		mailingListPromoteGroupsioServiceServiceIDFlag   = mailingListPromoteGroupsioServiceFlags.String("service-id", "REQUIRED", "Service ID")
+		mailingListPromoteGroupsioServiceBearerTokenFlag = mailingListPromoteGroupsioServiceFlags.String("bearer-token", "", "")
+
 		mailingListDeleteGroupsioServiceFlags           = flag.NewFlagSet("delete-groupsio-service", flag.ExitOnError)
 		mailingListDeleteGroupsioServiceServiceIDFlag   = mailingListDeleteGroupsioServiceFlags.String("service-id", "REQUIRED", "Service ID")
 		mailingListDeleteGroupsioServiceBearerTokenFlag = mailingListDeleteGroupsioServiceFlags.String("bearer-token", "", "")
@@ -73,6 +77,14 @@ func ParseEndpoint(
 		mailingListGetGroupsioServiceProjectsFlags           = flag.NewFlagSet("get-groupsio-service-projects", flag.ExitOnError)
 		mailingListGetGroupsioServiceProjectsBearerTokenFlag = mailingListGetGroupsioServiceProjectsFlags.String("bearer-token", "", "")
 
+		mailingListListGroupsioServicesByStatusFlags           = flag.NewFlagSet("list-groupsio-services-by-status", flag.ExitOnError)
+		mailingListListGroupsioServicesByStatusStatusFlag      = mailingListListGroupsioServicesByStatusFlags.String("status", "REQUIRED", "")
+		mailingListListGroupsioServicesByStatusBearerTokenFlag = mailingListListGroupsioServicesByStatusFlags.String("bearer-token", "", "")
+
+		mailingListListUnprovisionedGroupsioServicesFlags           = flag.NewFlagSet("list-unprovisioned-groupsio-services", flag.ExitOnError)
+		mailingListListUnprovisionedGroupsioServicesProjectUIDFlag  = mailingListListUnprovisionedGroupsioServicesFlags.String("project-uid", "REQUIRED", "")
+		mailingListListUnprovisionedGroupsioServicesBearerTokenFlag = mailingListListUnprovisionedGroupsioServicesFlags.String("bearer-token", "", "")
+
 		mailingListFindParentGroupsioServiceFlags           = flag.NewFlagSet("find-parent-groupsio-service", flag.ExitOnError)
 		mailingListFindParentGroupsioServiceProjectUIDFlag  = mailingListFindParentGroupsioServiceFlags.String("project-uid", "REQUIRED", "")
 		mailingListFindParentGroupsioServiceBearerTokenFlag = mailingListFindParentGroupsioServiceFlags.String("bearer-token", "", "")
@@ -82,6 +94,16 @@ func ParseEndpoint(
 		mailingListListGroupsioMailingListsCommitteeUIDFlag = mailingListListGroupsioMailingListsFlags.String("committee-uid", "", "")
 		mailingListListGroupsioMailingListsBearerTokenFlag  = mailingListListGroupsioMailingListsFlags.String("bearer-token", "", "")
 
+		mailingListListGroupsioMailingListsByVisibilityFlags           = flag.NewFlagSet("list-groupsio-mailing-lists-by-visibility", flag.ExitOnError)
+		mailingListListGroupsioMailingListsByVisibilityProjectUIDFlag  = mailingListListGroupsioMailingListsByVisibilityFlags.String("project-uid", "REQUIRED", "")
+		mailingListListGroupsioMailingListsByVisibilityPublicFlag      = mailingListListGroupsioMailingListsByVisibilityFlags.String("public", "REQUIRED", "")
+		mailingListListGroupsioMailingListsByVisibilityBearerTokenFlag = mailingListListGroupsioMailingListsByVisibilityFlags.String("bearer-token", "", "")
+
+		mailingListListGroupsioMailingListsUpdatedSinceFlags           = flag.NewFlagSet("list-groupsio-mailing-lists-updated-since", flag.ExitOnError)
+		mailingListListGroupsioMailingListsUpdatedSinceProjectUIDFlag  = mailingListListGroupsioMailingListsUpdatedSinceFlags.String("project-uid", "REQUIRED", "")
+		mailingListListGroupsioMailingListsUpdatedSinceSinceFlag       = mailingListListGroupsioMailingListsUpdatedSinceFlags.String("since", "REQUIRED", "")
+		mailingListListGroupsioMailingListsUpdatedSinceBearerTokenFlag = mailingListListGroupsioMailingListsUpdatedSinceFlags.String("bearer-token", "", "")
+
 		mailingListCreateGroupsioMailingListFlags           = flag.NewFlagSet("create-groupsio-mailing-list", flag.ExitOnError)
 		mailingListCreateGroupsioMailingListBodyFlag        = mailingListCreateGroupsioMailingListFlags.String("body", "REQUIRED", "")
 		mailingListCreateGroupsioMailingListBearerTokenFlag = mailingListCreateGroupsioMailingListFlags.String("bearer-token", "", "")
@@ -111,11 +133,40 @@ func ParseEndpoint(
 		mailingListListGroupsioMembersSubgroupIDFlag  = mailingListListGroupsioMembersFlags.String("subgroup-id", "REQUIRED", "Subgroup ID")
 		mailingListListGroupsioMembersBearerTokenFlag = mailingListListGroupsioMembersFlags.String("bearer-token", "", "")
 
+		mailingListListGroupsioMembersNeedingReviewFlags           = flag.NewFlagSet("list-groupsio-members-needing-review", flag.ExitOnError)
+		mailingListListGroupsioMembersNeedingReviewSubgroupIDFlag  = mailingListListGroupsioMembersNeedingReviewFlags.String("subgroup-id", "REQUIRED", "Subgroup ID")
+		mailingListListGroupsioMembersNeedingReviewOlderThanFlag   = mailingListListGroupsioMembersNeedingReviewFlags.String("older-than", "REQUIRED", "")
+		mailingListListGroupsioMembersNeedingReviewBearerTokenFlag = mailingListListGroupsioMembersNeedingReviewFlags.String("bearer-token", "", "")
+
+		mailingListListGroupsioMembersModifiedSinceFlags           = flag.NewFlagSet("list-groupsio-members-modified-since", flag.ExitOnError)
+		mailingListListGroupsioMembersModifiedSinceSubgroupIDFlag  = mailingListListGroupsioMembersModifiedSinceFlags.String("subgroup-id", "REQUIRED", "Subgroup ID")
+		mailingListListGroupsioMembersModifiedSinceSinceFlag       = mailingListListGroupsioMembersModifiedSinceFlags.String("since", "REQUIRED", "")
+		mailingListListGroupsioMembersModifiedSinceBearerTokenFlag = mailingListListGroupsioMembersModifiedSinceFlags.String("bearer-token", "", "")
+
+		mailingListListGroupsioMembersByOrganizationFlags            = flag.NewFlagSet("list-groupsio-members-by-organization", flag.ExitOnError)
+		mailingListListGroupsioMembersByOrganizationSubgroupIDFlag   = mailingListListGroupsioMembersByOrganizationFlags.String("subgroup-id", "REQUIRED", "Subgroup ID")
+		mailingListListGroupsioMembersByOrganizationOrganizationFlag = mailingListListGroupsioMembersByOrganizationFlags.String("organization", "REQUIRED", "")
+		mailingListListGroupsioMembersByOrganizationBearerTokenFlag  = mailingListListGroupsioMembersByOrganizationFlags.String("bearer-token", "", "")
+
+		mailingListListGroupsioDuplicateMembersFlags           = flag.NewFlagSet("list-groupsio-duplicate-members", flag.ExitOnError)
+		mailingListListGroupsioDuplicateMembersSubgroupIDFlag  = mailingListListGroupsioDuplicateMembersFlags.String("subgroup-id", "REQUIRED", "Subgroup ID")
+		mailingListListGroupsioDuplicateMembersBearerTokenFlag = mailingListListGroupsioDuplicateMembersFlags.String("bearer-token", "", "")
+
+		mailingListPreviewGroupsioDeliveryModeChangeFlags           = flag.NewFlagSet("preview-groupsio-delivery-mode-change", flag.ExitOnError)
+		mailingListPreviewGroupsioDeliveryModeChangeSubgroupIDFlag  = mailingListPreviewGroupsioDeliveryModeChangeFlags.String("subgroup-id", "REQUIRED", "Subgroup ID")
+		mailingListPreviewGroupsioDeliveryModeChangeTargetModeFlag  = mailingListPreviewGroupsioDeliveryModeChangeFlags.String("target-mode", "REQUIRED", "")
+		mailingListPreviewGroupsioDeliveryModeChangeBearerTokenFlag = mailingListPreviewGroupsioDeliveryModeChangeFlags.String("bearer-token", "", "")
+
 		mailingListAddGroupsioMemberFlags           = flag.NewFlagSet("add-groupsio-member", flag.ExitOnError)
 		mailingListAddGroupsioMemberBodyFlag        = mailingListAddGroupsioMemberFlags.String("body", "REQUIRED", "")
 		mailingListAddGroupsioMemberSubgroupIDFlag  = mailingListAddGroupsioMemberFlags.String("subgroup-id", "REQUIRED", "Subgroup ID")
 		mailingListAddGroupsioMemberBearerTokenFlag = mailingListAddGroupsioMemberFlags.String("bearer-token", "", "")
 
+		mailingListUpsertGroupsioMemberFlags           = flag.NewFlagSet("upsert-groupsio-member", flag.ExitOnError)
+		mailingListUpsertGroupsioMemberBodyFlag        = mailingListUpsertGroupsioMemberFlags.String("body", "REQUIRED", "")
+		mailingListUpsertGroupsioMemberSubgroupIDFlag  = mailingListUpsertGroupsioMemberFlags.String("subgroup-id", "REQUIRED", "Subgroup ID")
+		mailingListUpsertGroupsioMemberBearerTokenFlag = mailingListUpsertGroupsioMemberFlags.String("bearer-token", "", "")
+
 		mailingListGetGroupsioMemberFlags           = flag.NewFlagSet("get-groupsio-member", flag.ExitOnError)
 		mailingListGetGroupsioMemberSubgroupIDFlag  = mailingListGetGroupsioMemberFlags.String("subgroup-id", "REQUIRED", "Subgroup ID")
 		mailingListGetGroupsioMemberMemberIDFlag    = mailingListGetGroupsioMemberFlags.String("member-id", "REQUIRED", "Member ID")
@@ -137,6 +188,22 @@ func ParseEndpoint(
 		mailingListInviteGroupsioMembersSubgroupIDFlag  = mailingListInviteGroupsioMembersFlags.String("subgroup-id", "REQUIRED", "Subgroup ID")
 		mailingListInviteGroupsioMembersBearerTokenFlag = mailingListInviteGroupsioMembersFlags.String("bearer-token", "", "")
 
+		mailingListImportGroupsioMembersFlags           = flag.NewFlagSet("import-groupsio-members", flag.ExitOnError)
+		mailingListImportGroupsioMembersBodyFlag        = mailingListImportGroupsioMembersFlags.String("body", "REQUIRED", "")
+		mailingListImportGroupsioMembersSubgroupIDFlag  = mailingListImportGroupsioMembersFlags.String("subgroup-id", "REQUIRED", "Subgroup ID")
+		mailingListImportGroupsioMembersBearerTokenFlag = mailingListImportGroupsioMembersFlags.String("bearer-token", "", "")
+
+		mailingListMergeGroupsioMembersFlags           = flag.NewFlagSet("merge-groupsio-members", flag.ExitOnError)
+		mailingListMergeGroupsioMembersBodyFlag        = mailingListMergeGroupsioMembersFlags.String("body", "REQUIRED", "")
+		mailingListMergeGroupsioMembersSubgroupIDFlag  = mailingListMergeGroupsioMembersFlags.String("subgroup-id", "REQUIRED", "Subgroup ID")
+		mailingListMergeGroupsioMembersBearerTokenFlag = mailingListMergeGroupsioMembersFlags.String("bearer-token", "", "")
+
+		mailingListChangeGroupsioMemberEmailFlags           = flag.NewFlagSet("change-groupsio-member-email", flag.ExitOnError)
+		mailingListChangeGroupsioMemberEmailBodyFlag        = mailingListChangeGroupsioMemberEmailFlags.String("body", "REQUIRED", "")
+		mailingListChangeGroupsioMemberEmailSubgroupIDFlag  = mailingListChangeGroupsioMemberEmailFlags.String("subgroup-id", "REQUIRED", "Subgroup ID")
+		mailingListChangeGroupsioMemberEmailMemberIDFlag    = mailingListChangeGroupsioMemberEmailFlags.String("member-id", "REQUIRED", "Member ID")
+		mailingListChangeGroupsioMemberEmailBearerTokenFlag = mailingListChangeGroupsioMemberEmailFlags.String("bearer-token", "", "")
+
 		mailingListCheckGroupsioSubscriberFlags           = flag.NewFlagSet("check-groupsio-subscriber", flag.ExitOnError)
 		mailingListCheckGroupsioSubscriberBodyFlag        = mailingListCheckGroupsioSubscriberFlags.String("body", "REQUIRED", "")
 		mailingListCheckGroupsioSubscriberBearerTokenFlag = mailingListCheckGroupsioSubscriberFlags.String("bearer-token", "", "")
@@ -150,6 +217,39 @@ func ParseEndpoint(
 		mailingListGetGroupsioArtifactDownloadSubgroupIDFlag  = mailingListGetGroupsioArtifactDownloadFlags.String("subgroup-id", "REQUIRED", "Subgroup ID (GroupsIO group ID)")
 		mailingListGetGroupsioArtifactDownloadArtifactIDFlag  = mailingListGetGroupsioArtifactDownloadFlags.String("artifact-id", "REQUIRED", "Artifact UUID")
 		mailingListGetGroupsioArtifactDownloadBearerTokenFlag = mailingListGetGroupsioArtifactDownloadFlags.String("bearer-token", "", "")
+
+		mailingListGetGroupsioProjectSummaryFlags           = flag.NewFlagSet("get-groupsio-project-summary", flag.ExitOnError)
+		mailingListGetGroupsioProjectSummaryProjectUIDFlag  = mailingListGetGroupsioProjectSummaryFlags.String("project-uid", "REQUIRED", "LFX v2 project UID")
+		mailingListGetGroupsioProjectSummaryBearerTokenFlag = mailingListGetGroupsioProjectSummaryFlags.String("bearer-token", "", "")
+
+		mailingListListGroupsioOrphanedMailingListsFlags           = flag.NewFlagSet("list-groupsio-orphaned-mailing-lists", flag.ExitOnError)
+		mailingListListGroupsioOrphanedMailingListsProjectUIDFlag  = mailingListListGroupsioOrphanedMailingListsFlags.String("project-uid", "REQUIRED", "LFX v2 project UID")
+		mailingListListGroupsioOrphanedMailingListsBearerTokenFlag = mailingListListGroupsioOrphanedMailingListsFlags.String("bearer-token", "", "")
+
+		mailingListRepublishGroupsioProjectIndexFlags           = flag.NewFlagSet("republish-groupsio-project-index", flag.ExitOnError)
+		mailingListRepublishGroupsioProjectIndexProjectUIDFlag  = mailingListRepublishGroupsioProjectIndexFlags.String("project-uid", "REQUIRED", "LFX v2 project UID")
+		mailingListRepublishGroupsioProjectIndexBearerTokenFlag = mailingListRepublishGroupsioProjectIndexFlags.String("bearer-token", "", "")
+
+		mailingListGetGroupsioMailingListStatsFlags           = flag.NewFlagSet("get-groupsio-mailing-list-stats", flag.ExitOnError)
+		mailingListGetGroupsioMailingListStatsSubgroupIDFlag  = mailingListGetGroupsioMailingListStatsFlags.String("subgroup-id", "REQUIRED", "Subgroup ID")
+		mailingListGetGroupsioMailingListStatsBearerTokenFlag = mailingListGetGroupsioMailingListStatsFlags.String("bearer-token", "", "")
+
+		mailingListGetGroupsioServiceTreeFlags                   = flag.NewFlagSet("get-groupsio-service-tree", flag.ExitOnError)
+		mailingListGetGroupsioServiceTreeServiceIDFlag           = mailingListGetGroupsioServiceTreeFlags.String("service-id", "REQUIRED", "Service ID")
+		mailingListGetGroupsioServiceTreeIncludeMemberCountsFlag = mailingListGetGroupsioServiceTreeFlags.String("include-member-counts", "", "")
+		mailingListGetGroupsioServiceTreeBearerTokenFlag         = mailingListGetGroupsioServiceTreeFlags.String("bearer-token", "", "")
+
+		mailingListGetGroupsioMemberHierarchyFlags           = flag.NewFlagSet("get-groupsio-member-hierarchy", flag.ExitOnError)
+		mailingListGetGroupsioMemberHierarchySubgroupIDFlag  = mailingListGetGroupsioMemberHierarchyFlags.String("subgroup-id", "REQUIRED", "Subgroup ID")
+		mailingListGetGroupsioMemberHierarchyMemberIDFlag    = mailingListGetGroupsioMemberHierarchyFlags.String("member-id", "REQUIRED", "Member ID")
+		mailingListGetGroupsioMemberHierarchyBearerTokenFlag = mailingListGetGroupsioMemberHierarchyFlags.String("bearer-token", "", "")
+
+		mailingListListGroupsioProjectMembersFlags           = flag.NewFlagSet("list-groupsio-project-members", flag.ExitOnError)
+		mailingListListGroupsioProjectMembersProjectUIDFlag  = mailingListListGroupsioProjectMembersFlags.String("project-uid", "REQUIRED", "LFX v2 project UID")
+		mailingListListGroupsioProjectMembersLimitFlag       = mailingListListGroupsioProjectMembersFlags.String("limit", "", "")
+		mailingListListGroupsioProjectMembersCursorFlag      = mailingListListGroupsioProjectMembersFlags.String("cursor", "", "")
+		mailingListListGroupsioProjectMembersDedupeFlag      = mailingListListGroupsioProjectMembersFlags.String("dedupe", "", "")
+		mailingListListGroupsioProjectMembersBearerTokenFlag = mailingListListGroupsioProjectMembersFlags.String("bearer-token", "", "")
 	)
 	mailingListFlags.Usage = mailingListUsage
 	mailingListLivezFlags.Usage = mailingListLivezUsage
@@ -158,10 +258,15 @@ func ParseEndpoint(
 	mailingListCreateGroupsioServiceFlags.Usage = mailingListCreateGroupsioServiceUsage
 	mailingListGetGroupsioServiceFlags.Usage = mailingListGetGroupsioServiceUsage
 	mailingListUpdateGroupsioServiceFlags.Usage = mailingListUpdateGroupsioServiceUsage
+	mailingListPromoteGroupsioServiceFlags.Usage = mailingListPromoteGroupsioServiceUsage
 	mailingListDeleteGroupsioServiceFlags.Usage = mailingListDeleteGroupsioServiceUsage
 	mailingListGetGroupsioServiceProjectsFlags.Usage = mailingListGetGroupsioServiceProjectsUsage
+	mailingListListGroupsioServicesByStatusFlags.Usage = mailingListListGroupsioServicesByStatusUsage
+	mailingListListUnprovisionedGroupsioServicesFlags.Usage = mailingListListUnprovisionedGroupsioServicesUsage
 	mailingListFindParentGroupsioServiceFlags.Usage = mailingListFindParentGroupsioServiceUsage
 	mailingListListGroupsioMailingListsFlags.Usage = mailingListListGroupsioMailingListsUsage
+	mailingListListGroupsioMailingListsByVisibilityFlags.Usage = mailingListListGroupsioMailingListsByVisibilityUsage
+	mailingListListGroupsioMailingListsUpdatedSinceFlags.Usage = mailingListListGroupsioMailingListsUpdatedSinceUsage
 	mailingListCreateGroupsioMailingListFlags.Usage = mailingListCreateGroupsioMailingListUsage
 	mailingListGetGroupsioMailingListFlags.Usage = mailingListGetGroupsioMailingListUsage
 	mailingListUpdateGroupsioMailingListFlags.Usage = mailingListUpdateGroupsioMailingListUsage
@@ -169,14 +274,30 @@ func ParseEndpoint(
 	mailingListGetGroupsioMailingListCountFlags.Usage = mailingListGetGroupsioMailingListCountUsage
 	mailingListGetGroupsioMailingListMemberCountFlags.Usage = mailingListGetGroupsioMailingListMemberCountUsage
 	mailingListListGroupsioMembersFlags.Usage = mailingListListGroupsioMembersUsage
+	mailingListListGroupsioMembersNeedingReviewFlags.Usage = mailingListListGroupsioMembersNeedingReviewUsage
+	mailingListListGroupsioMembersModifiedSinceFlags.Usage = mailingListListGroupsioMembersModifiedSinceUsage
+	mailingListListGroupsioMembersByOrganizationFlags.Usage = mailingListListGroupsioMembersByOrganizationUsage
+	mailingListListGroupsioDuplicateMembersFlags.Usage = mailingListListGroupsioDuplicateMembersUsage
+	mailingListPreviewGroupsioDeliveryModeChangeFlags.Usage = mailingListPreviewGroupsioDeliveryModeChangeUsage
 	mailingListAddGroupsioMemberFlags.Usage = mailingListAddGroupsioMemberUsage
+	mailingListUpsertGroupsioMemberFlags.Usage = mailingListUpsertGroupsioMemberUsage
 	mailingListGetGroupsioMemberFlags.Usage = mailingListGetGroupsioMemberUsage
 	mailingListUpdateGroupsioMemberFlags.Usage = mailingListUpdateGroupsioMemberUsage
 	mailingListDeleteGroupsioMemberFlags.Usage = mailingListDeleteGroupsioMemberUsage
 	mailingListInviteGroupsioMembersFlags.Usage = mailingListInviteGroupsioMembersUsage
+	mailingListImportGroupsioMembersFlags.Usage = mailingListImportGroupsioMembersUsage
+	mailingListMergeGroupsioMembersFlags.Usage = mailingListMergeGroupsioMembersUsage
+	mailingListChangeGroupsioMemberEmailFlags.Usage = mailingListChangeGroupsioMemberEmailUsage
 	mailingListCheckGroupsioSubscriberFlags.Usage = mailingListCheckGroupsioSubscriberUsage
 	mailingListGetGroupsioArtifactFlags.Usage = mailingListGetGroupsioArtifactUsage
 	mailingListGetGroupsioArtifactDownloadFlags.Usage = mailingListGetGroupsioArtifactDownloadUsage
+	mailingListGetGroupsioProjectSummaryFlags.Usage = mailingListGetGroupsioProjectSummaryUsage
+	mailingListListGroupsioOrphanedMailingListsFlags.Usage = mailingListListGroupsioOrphanedMailingListsUsage
+	mailingListRepublishGroupsioProjectIndexFlags.Usage = mailingListRepublishGroupsioProjectIndexUsage
+	mailingListGetGroupsioMailingListStatsFlags.Usage = mailingListGetGroupsioMailingListStatsUsage
+	mailingListGetGroupsioServiceTreeFlags.Usage = mailingListGetGroupsioServiceTreeUsage
+	mailingListGetGroupsioMemberHierarchyFlags.Usage = mailingListGetGroupsioMemberHierarchyUsage
+	mailingListListGroupsioProjectMembersFlags.Usage = mailingListListGroupsioProjectMembersUsage
 
 	if err := flag.CommandLine.Parse(os.Args[1:]); err != nil {
 		return nil, nil, err
@@ -230,18 +351,33 @@ func ParseEndpoint(
 			case "update-groupsio-service":
 				epf = mailingListUpdateGroupsioServiceFlags
 
+			case "promote-groupsio-service":
+				epf = mailingListPromoteGroupsioServiceFlags
+
 			case "delete-groupsio-service":
 				epf = mailingListDeleteGroupsioServiceFlags
 
 			case "get-groupsio-service-projects":
 				epf = mailingListGetGroupsioServiceProjectsFlags
 
+			case "list-groupsio-services-by-status":
+				epf = mailingListListGroupsioServicesByStatusFlags
+
+			case "list-unprovisioned-groupsio-services":
+				epf = mailingListListUnprovisionedGroupsioServicesFlags
+
 			case "find-parent-groupsio-service":
 				epf = mailingListFindParentGroupsioServiceFlags
 
 			case "list-groupsio-mailing-lists":
 				epf = mailingListListGroupsioMailingListsFlags
 
+			case "list-groupsio-mailing-lists-by-visibility":
+				epf = mailingListListGroupsioMailingListsByVisibilityFlags
+
+			case "list-groupsio-mailing-lists-updated-since":
+				epf = mailingListListGroupsioMailingListsUpdatedSinceFlags
+
 			case "create-groupsio-mailing-list":
 				epf = mailingListCreateGroupsioMailingListFlags
 
@@ -263,9 +399,27 @@ func ParseEndpoint(
 			case "list-groupsio-members":
 				epf = mailingListListGroupsioMembersFlags
 
+			case "list-groupsio-members-needing-review":
+				epf = mailingListListGroupsioMembersNeedingReviewFlags
+
+			case "list-groupsio-members-modified-since":
+				epf = mailingListListGroupsioMembersModifiedSinceFlags
+
+			case "list-groupsio-members-by-organization":
+				epf = mailingListListGroupsioMembersByOrganizationFlags
+
+			case "list-groupsio-duplicate-members":
+				epf = mailingListListGroupsioDuplicateMembersFlags
+
+			case "preview-groupsio-delivery-mode-change":
+				epf = mailingListPreviewGroupsioDeliveryModeChangeFlags
+
 			case "add-groupsio-member":
 				epf = mailingListAddGroupsioMemberFlags
 
+			case "upsert-groupsio-member":
+				epf = mailingListUpsertGroupsioMemberFlags
+
 			case "get-groupsio-member":
 				epf = mailingListGetGroupsioMemberFlags
 
@@ -278,6 +432,15 @@ func ParseEndpoint(
 			case "invite-groupsio-members":
 				epf = mailingListInviteGroupsioMembersFlags
 
+			case "import-groupsio-members":
+				epf = mailingListImportGroupsioMembersFlags
+
+			case "merge-groupsio-members":
+				epf = mailingListMergeGroupsioMembersFlags
+
+			case "change-groupsio-member-email":
+				epf = mailingListChangeGroupsioMemberEmailFlags
+
 			case "check-groupsio-subscriber":
 				epf = mailingListCheckGroupsioSubscriberFlags
 
@@ -287,6 +450,27 @@ func ParseEndpoint(
 			case "get-groupsio-artifact-download":
 				epf = mailingListGetGroupsioArtifactDownloadFlags
 
+			case "get-groupsio-project-summary":
+				epf = mailingListGetGroupsioProjectSummaryFlags
+
+			case "list-groupsio-orphaned-mailing-lists":
+				epf = mailingListListGroupsioOrphanedMailingListsFlags
+
+			case "republish-groupsio-project-index":
+				epf = mailingListRepublishGroupsioProjectIndexFlags
+
+			case "get-groupsio-mailing-list-stats":
+				epf = mailingListGetGroupsioMailingListStatsFlags
+
+			case "get-groupsio-service-tree":
+				epf = mailingListGetGroupsioServiceTreeFlags
+
+			case "get-groupsio-member-hierarchy":
+				epf = mailingListGetGroupsioMemberHierarchyFlags
+
+			case "list-groupsio-project-members":
+				epf = mailingListListGroupsioProjectMembersFlags
+
 			}
 
 		}
@@ -328,18 +512,33 @@ func ParseEndpoint(
 			case "update-groupsio-service":
 				endpoint = c.UpdateGroupsioService()
 				data, err = mailinglistc.BuildUpdateGroupsioServicePayload(*mailingListUpdateGroupsioServiceBodyFlag, *mailingListUpdateGroupsioServiceServiceIDFlag, *mailingListUpdateGroupsioServiceBearerTokenFlag)
+			case "promote-groupsio-service":
+				endpoint = c.PromoteGroupsioService()
+				data, err = mailinglistc.BuildPromoteGroupsioServicePayload(*mailingListPromoteGroupsioServiceServiceIDFlag, *mailingListPromoteGroupsioServiceBearerTokenFlag)
 			case "delete-groupsio-service":
 				endpoint = c.DeleteGroupsioService()
 				data, err = mailinglistc.BuildDeleteGroupsioServicePayload(*mailingListDeleteGroupsioServiceServiceIDFlag, *mailingListDeleteGroupsioServiceBearerTokenFlag)
 			case "get-groupsio-service-projects":
 				endpoint = c.GetGroupsioServiceProjects()
 				data, err = mailinglistc.BuildGetGroupsioServiceProjectsPayload(*mailingListGetGroupsioServiceProjectsBearerTokenFlag)
+			case "list-groupsio-services-by-status":
+				endpoint = c.ListGroupsioServicesByStatus()
+				data, err = mailinglistc.BuildListGroupsioServicesByStatusPayload(*mailingListListGroupsioServicesByStatusStatusFlag, *mailingListListGroupsioServicesByStatusBearerTokenFlag)
+			case "list-unprovisioned-groupsio-services":
+				endpoint = c.ListUnprovisionedGroupsioServices()
+				data, err = mailinglistc.BuildListUnprovisionedGroupsioServicesPayload(*mailingListListUnprovisionedGroupsioServicesProjectUIDFlag, *mailingListListUnprovisionedGroupsioServicesBearerTokenFlag)
 			case "find-parent-groupsio-service":
 				endpoint = c.FindParentGroupsioService()
 				data, err = mailinglistc.BuildFindParentGroupsioServicePayload(*mailingListFindParentGroupsioServiceProjectUIDFlag, *mailingListFindParentGroupsioServiceBearerTokenFlag)
 			case "list-groupsio-mailing-lists":
 				endpoint = c.ListGroupsioMailingLists()
 				data, err = mailinglistc.BuildListGroupsioMailingListsPayload(*mailingListListGroupsioMailingListsProjectUIDFlag, *mailingListListGroupsioMailingListsCommitteeUIDFlag, *mailingListListGroupsioMailingListsBearerTokenFlag)
+			case "list-groupsio-mailing-lists-by-visibility":
+				endpoint = c.ListGroupsioMailingListsByVisibility()
+				data, err = mailinglistc.BuildListGroupsioMailingListsByVisibilityPayload(*mailingListListGroupsioMailingListsByVisibilityProjectUIDFlag, *mailingListListGroupsioMailingListsByVisibilityPublicFlag, *mailingListListGroupsioMailingListsByVisibilityBearerTokenFlag)
+			case "list-groupsio-mailing-lists-updated-since":
+				endpoint = c.ListGroupsioMailingListsUpdatedSince()
+				data, err = mailinglistc.BuildListGroupsioMailingListsUpdatedSincePayload(*mailingListListGroupsioMailingListsUpdatedSinceProjectUIDFlag, *mailingListListGroupsioMailingListsUpdatedSinceSinceFlag, *mailingListListGroupsioMailingListsUpdatedSinceBearerTokenFlag)
 			case "create-groupsio-mailing-list":
 				endpoint = c.CreateGroupsioMailingList()
 				data, err = mailinglistc.BuildCreateGroupsioMailingListPayload(*mailingListCreateGroupsioMailingListBodyFlag, *mailingListCreateGroupsioMailingListBearerTokenFlag)
@@ -361,9 +560,27 @@ func ParseEndpoint(
 			case "list-groupsio-members":
 				endpoint = c.ListGroupsioMembers()
 				data, err = mailinglistc.BuildListGroupsioMembersPayload(*mailingListListGroupsioMembersSubgroupIDFlag, *mailingListListGroupsioMembersBearerTokenFlag)
+			case "list-groupsio-members-needing-review":
+				endpoint = c.ListGroupsioMembersNeedingReview()
+				data, err = mailinglistc.BuildListGroupsioMembersNeedingReviewPayload(*mailingListListGroupsioMembersNeedingReviewSubgroupIDFlag, *mailingListListGroupsioMembersNeedingReviewOlderThanFlag, *mailingListListGroupsioMembersNeedingReviewBearerTokenFlag)
+			case "list-groupsio-members-modified-since":
+				endpoint = c.ListGroupsioMembersModifiedSince()
+				data, err = mailinglistc.BuildListGroupsioMembersModifiedSincePayload(*mailingListListGroupsioMembersModifiedSinceSubgroupIDFlag, *mailingListListGroupsioMembersModifiedSinceSinceFlag, *mailingListListGroupsioMembersModifiedSinceBearerTokenFlag)
+			case "list-groupsio-members-by-organization":
+				endpoint = c.ListGroupsioMembersByOrganization()
+				data, err = mailinglistc.BuildListGroupsioMembersByOrganizationPayload(*mailingListListGroupsioMembersByOrganizationSubgroupIDFlag, *mailingListListGroupsioMembersByOrganizationOrganizationFlag, *mailingListListGroupsioMembersByOrganizationBearerTokenFlag)
+			case "list-groupsio-duplicate-members":
+				endpoint = c.ListGroupsioDuplicateMembers()
+				data, err = mailinglistc.BuildListGroupsioDuplicateMembersPayload(*mailingListListGroupsioDuplicateMembersSubgroupIDFlag, *mailingListListGroupsioDuplicateMembersBearerTokenFlag)
+			case "preview-groupsio-delivery-mode-change":
+				endpoint = c.PreviewGroupsioDeliveryModeChange()
+				data, err = mailinglistc.BuildPreviewGroupsioDeliveryModeChangePayload(*mailingListPreviewGroupsioDeliveryModeChangeSubgroupIDFlag, *mailingListPreviewGroupsioDeliveryModeChangeTargetModeFlag, *mailingListPreviewGroupsioDeliveryModeChangeBearerTokenFlag)
 			case "add-groupsio-member":
 				endpoint = c.AddGroupsioMember()
 				data, err = mailinglistc.BuildAddGroupsioMemberPayload(*mailingListAddGroupsioMemberBodyFlag, *mailingListAddGroupsioMemberSubgroupIDFlag, *mailingListAddGroupsioMemberBearerTokenFlag)
+			case "upsert-groupsio-member":
+				endpoint = c.UpsertGroupsioMember()
+				data, err = mailinglistc.BuildUpsertGroupsioMemberPayload(*mailingListUpsertGroupsioMemberBodyFlag, *mailingListUpsertGroupsioMemberSubgroupIDFlag, *mailingListUpsertGroupsioMemberBearerTokenFlag)
 			case "get-groupsio-member":
 				endpoint = c.GetGroupsioMember()
 				data, err = mailinglistc.BuildGetGroupsioMemberPayload(*mailingListGetGroupsioMemberSubgroupIDFlag, *mailingListGetGroupsioMemberMemberIDFlag, *mailingListGetGroupsioMemberBearerTokenFlag)
@@ -376,6 +593,15 @@ func ParseEndpoint(
 			case "invite-groupsio-members":
 				endpoint = c.InviteGroupsioMembers()
 				data, err = mailinglistc.BuildInviteGroupsioMembersPayload(*mailingListInviteGroupsioMembersBodyFlag, *mailingListInviteGroupsioMembersSubgroupIDFlag, *mailingListInviteGroupsioMembersBearerTokenFlag)
+			case "import-groupsio-members":
+				endpoint = c.ImportGroupsioMembers()
+				data, err = mailinglistc.BuildImportGroupsioMembersPayload(*mailingListImportGroupsioMembersBodyFlag, *mailingListImportGroupsioMembersSubgroupIDFlag, *mailingListImportGroupsioMembersBearerTokenFlag)
+			case "merge-groupsio-members":
+				endpoint = c.MergeGroupsioMembers()
+				data, err = mailinglistc.BuildMergeGroupsioMembersPayload(*mailingListMergeGroupsioMembersBodyFlag, *mailingListMergeGroupsioMembersSubgroupIDFlag, *mailingListMergeGroupsioMembersBearerTokenFlag)
+			case "change-groupsio-member-email":
+				endpoint = c.ChangeGroupsioMemberEmail()
+				data, err = mailinglistc.BuildChangeGroupsioMemberEmailPayload(*mailingListChangeGroupsioMemberEmailBodyFlag, *mailingListChangeGroupsioMemberEmailSubgroupIDFlag, *mailingListChangeGroupsioMemberEmailMemberIDFlag, *mailingListChangeGroupsioMemberEmailBearerTokenFlag)
 			case "check-groupsio-subscriber":
 				endpoint = c.CheckGroupsioSubscriber()
 				data, err = mailinglistc.BuildCheckGroupsioSubscriberPayload(*mailingListCheckGroupsioSubscriberBodyFlag, *mailingListCheckGroupsioSubscriberBearerTokenFlag)
@@ -385,6 +611,27 @@ func ParseEndpoint(
 			case "get-groupsio-artifact-download":
 				endpoint = c.GetGroupsioArtifactDownload()
 				data, err = mailinglistc.BuildGetGroupsioArtifactDownloadPayload(*mailingListGetGroupsioArtifactDownloadSubgroupIDFlag, *mailingListGetGroupsioArtifactDownloadArtifactIDFlag, *mailingListGetGroupsioArtifactDownloadBearerTokenFlag)
+			case "get-groupsio-project-summary":
+				endpoint = c.GetGroupsioProjectSummary()
+				data, err = mailinglistc.BuildGetGroupsioProjectSummaryPayload(*mailingListGetGroupsioProjectSummaryProjectUIDFlag, *mailingListGetGroupsioProjectSummaryBearerTokenFlag)
+			case "list-groupsio-orphaned-mailing-lists":
+				endpoint = c.ListGroupsioOrphanedMailingLists()
+				data, err = mailinglistc.BuildListGroupsioOrphanedMailingListsPayload(*mailingListListGroupsioOrphanedMailingListsProjectUIDFlag, *mailingListListGroupsioOrphanedMailingListsBearerTokenFlag)
+			case "republish-groupsio-project-index":
+				endpoint = c.RepublishGroupsioProjectIndex()
+				data, err = mailinglistc.BuildRepublishGroupsioProjectIndexPayload(*mailingListRepublishGroupsioProjectIndexProjectUIDFlag, *mailingListRepublishGroupsioProjectIndexBearerTokenFlag)
+			case "get-groupsio-mailing-list-stats":
+				endpoint = c.GetGroupsioMailingListStats()
+				data, err = mailinglistc.BuildGetGroupsioMailingListStatsPayload(*mailingListGetGroupsioMailingListStatsSubgroupIDFlag, *mailingListGetGroupsioMailingListStatsBearerTokenFlag)
+			case "get-groupsio-service-tree":
+				endpoint = c.GetGroupsioServiceTree()
+				data, err = mailinglistc.BuildGetGroupsioServiceTreePayload(*mailingListGetGroupsioServiceTreeServiceIDFlag, *mailingListGetGroupsioServiceTreeIncludeMemberCountsFlag, *mailingListGetGroupsioServiceTreeBearerTokenFlag)
+			case "get-groupsio-member-hierarchy":
+				endpoint = c.GetGroupsioMemberHierarchy()
+				data, err = mailinglistc.BuildGetGroupsioMemberHierarchyPayload(*mailingListGetGroupsioMemberHierarchySubgroupIDFlag, *mailingListGetGroupsioMemberHierarchyMemberIDFlag, *mailingListGetGroupsioMemberHierarchyBearerTokenFlag)
+			case "list-groupsio-project-members":
+				endpoint = c.ListGroupsioProjectMembers()
+				data, err = mailinglistc.BuildListGroupsioProjectMembersPayload(*mailingListListGroupsioProjectMembersProjectUIDFlag, *mailingListListGroupsioProjectMembersLimitFlag, *mailingListListGroupsioProjectMembersCursorFlag, *mailingListListGroupsioProjectMembersDedupeFlag, *mailingListListGroupsioProjectMembersBearerTokenFlag)
 			}
 		}
 	}
@@ -404,15 +651,20 @@ Usage:
 
 COMMAND:
     livez: Check if the service is alive.
-    readyz: Check if the service is able to take inbound requests.
+    readyz: Check if the service is able to take inbound requests. Reports the status of each dependency; non-critical dependencies being down degrade the status without failing the probe.
     list-groupsio-services: List GroupsIO services, optionally filtered by project UID
     create-groupsio-service: Create a GroupsIO service
     get-groupsio-service: Get a GroupsIO service by ID
     update-groupsio-service: Update a GroupsIO service
+    promote-groupsio-service: Promote a formation GroupsIO service to its project's primary service, clearing its prefix
     delete-groupsio-service: Delete a GroupsIO service
     get-groupsio-service-projects: Get projects that have GroupsIO services
+    list-groupsio-services-by-status: List GroupsIO services across all projects that have the given status
+    list-unprovisioned-groupsio-services: List a project's GroupsIO services that have no Groups.io group ID yet
     find-parent-groupsio-service: Find the parent GroupsIO service for a project
     list-groupsio-mailing-lists: List GroupsIO subgroups, optionally filtered by project UID and/or committee UID
+    list-groupsio-mailing-lists-by-visibility: List a project's public or private GroupsIO subgroups
+    list-groupsio-mailing-lists-updated-since: List a project's GroupsIO subgroups updated after a cutoff, for incremental sync
     create-groupsio-mailing-list: Create a GroupsIO subgroup
     get-groupsio-mailing-list: Get a GroupsIO subgroup by ID
     update-groupsio-mailing-list: Update a GroupsIO subgroup
@@ -420,14 +672,30 @@ COMMAND:
     get-groupsio-mailing-list-count: Get count of GroupsIO subgroups for a project
     get-groupsio-mailing-list-member-count: Get count of members in a GroupsIO subgroup
     list-groupsio-members: List members of a GroupsIO subgroup
+    list-groupsio-members-needing-review: List members of a GroupsIO subgroup not reviewed since a cutoff, including members never reviewed
+    list-groupsio-members-modified-since: List members of a GroupsIO subgroup updated after a cutoff, for incremental sync
+    list-groupsio-members-by-organization: List members of a GroupsIO subgroup whose organization matches, ignoring case
+    list-groupsio-duplicate-members: List groups of members of a GroupsIO subgroup that share an email address
+    preview-groupsio-delivery-mode-change: Preview, without writing anything, which members of a GroupsIO subgroup a delivery mode change would affect
     add-groupsio-member: Add a member to a GroupsIO subgroup
+    upsert-groupsio-member: Add a member to a GroupsIO subgroup, or update the member with the same email if there is one
     get-groupsio-member: Get a member of a GroupsIO subgroup by ID
     update-groupsio-member: Update a member of a GroupsIO subgroup
     delete-groupsio-member: Delete a member from a GroupsIO subgroup
     invite-groupsio-members: Invite members to a GroupsIO subgroup by email
+    import-groupsio-members: Add many members to a GroupsIO subgroup, skipping those that already exist
+    merge-groupsio-members: Merge duplicate members of a GroupsIO subgroup by keeping one and removing the others
+    change-groupsio-member-email: Change a member's email address in place, keeping the member record and its history
     check-groupsio-subscriber: Check if an email address is subscribed to a GroupsIO subgroup
     get-groupsio-artifact: Get a GroupsIO subgroup artifact by ID
     get-groupsio-artifact-download: Get a presigned S3 download URL for a GroupsIO subgroup artifact
+    get-groupsio-project-summary: Get the number of GroupsIO services, subgroups and members in a project
+    list-groupsio-orphaned-mailing-lists: List a project's GroupsIO subgroups whose parent service no longer exists
+    republish-groupsio-project-index: Re-publish indexer messages for every GroupsIO service, subgroup and member of a project, e.g. after the search index is rebuilt. Safe to retry: a retry after a failure skips the subgroups whose members were already republished
+    get-groupsio-mailing-list-stats: Get member statistics for a GroupsIO subgroup
+    get-groupsio-service-tree: Get a GroupsIO service with its subgroups nested beneath it
+    get-groupsio-member-hierarchy: Get a GroupsIO member with the subgroup, service and project above it
+    list-groupsio-project-members: Page through the members of every GroupsIO subgroup in a project
 
 Additional help:
     %[1]s mailing-list COMMAND --help
@@ -446,7 +714,7 @@ Example:
 func mailingListReadyzUsage() {
 	fmt.Fprintf(os.Stderr, `%[1]s [flags] mailing-list readyz
 
-Check if the service is able to take inbound requests.
+Check if the service is able to take inbound requests. Reports the status of each dependency; non-critical dependencies being down degrade the status without failing the probe.
 
 Example:
     %[1]s mailing-list readyz
@@ -461,7 +729,7 @@ List GroupsIO services, optionally filtered by project UID
     -bearer-token STRING: 
 
 Example:
-    %[1]s mailing-list list-groupsio-services --project-uid "e4208c3b-e749-452a-9230-ea8d29aeb1d6" --bearer-token "eyJhbGci..."
+    %[1]s mailing-list list-groupsio-services --project-uid "f06eda6e-229e-427e-9c65-17925d0729ed" --bearer-token "eyJhbGci..."
 `, os.Args[0])
 }
 
@@ -474,11 +742,11 @@ Create a GroupsIO service
 
 Example:
     %[1]s mailing-list create-groupsio-service --body '{
-      "domain": "Consectetur accusantium ut aut ut.",
-      "group_id": 8879252608711124236,
-      "prefix": "Alias ad in eveniet nesciunt.",
+      "domain": "Sint id ea et.",
+      "group_id": 4925895175083449246,
+      "prefix": "Veritatis ea aut eos recusandae architecto.",
       "project_uid": "7cad5a8d-19d0-41a4-81a6-043453daf9ee",
-      "status": "Ab qui architecto rerum ducimus dolorum.",
+      "status": "Quisquam consequuntur tenetur eius assumenda.",
       "type": "v2_primary"
    }' --bearer-token "eyJhbGci..."
 `, os.Args[0])
@@ -492,7 +760,7 @@ Get a GroupsIO service by ID
     -bearer-token STRING: 
 
 Example:
-    %[1]s mailing-list get-groupsio-service --service-id "Ullam consequatur." --bearer-token "eyJhbGci..."
+    %[1]s mailing-list get-groupsio-service --service-id "Quia blanditiis unde porro qui commodi." --bearer-token "eyJhbGci..."
 `, os.Args[0])
 }
 
@@ -506,13 +774,25 @@ Update a GroupsIO service
 
 Example:
     %[1]s mailing-list update-groupsio-service --body '{
-      "domain": "Autem voluptatem cupiditate iusto consectetur.",
-      "group_id": 511445430138960221,
-      "prefix": "Nihil qui facilis veniam omnis.",
+      "domain": "Minima est veritatis pariatur.",
+      "group_id": 3036518474151360591,
+      "prefix": "Distinctio aut repellat velit.",
       "project_uid": "7cad5a8d-19d0-41a4-81a6-043453daf9ee",
-      "status": "Aut magnam nisi aspernatur.",
+      "status": "Numquam ipsam.",
       "type": "v2_primary"
-   }' --service-id "Et reiciendis." --bearer-token "eyJhbGci..."
+   }' --service-id "Temporibus est facilis exercitationem non." --bearer-token "eyJhbGci..."
+`, os.Args[0])
+}
+
+func mailingListPromoteGroupsioServiceUsage() {
+	fmt.Fprintf(os.Stderr, `%[1]s [flags] mailing-list promote-groupsio-service -service-id STRING -bearer-token STRING
+
+Promote a formation GroupsIO service to its project's primary service, clearing its prefix
+    -service-id STRING: Service ID
+    -bearer-token STRING: 
+
+Example:
+    %[1]s mailing-list promote-groupsio-service --service-id "Omnis quidem iste deserunt voluptas." --bearer-token "eyJhbGci..."
 `, os.Args[0])
 }
 
@@ -524,7 +804,7 @@ Delete a GroupsIO service
     -bearer-token STRING: 
 
 Example:
-    %[1]s mailing-list delete-groupsio-service --service-id "Inventore est exercitationem." --bearer-token "eyJhbGci..."
+    %[1]s mailing-list delete-groupsio-service --service-id "Corporis doloribus omnis adipisci qui deleniti dolores." --bearer-token "eyJhbGci..."
 `, os.Args[0])
 }
 
@@ -539,6 +819,30 @@ Example:
 `, os.Args[0])
 }
 
+func mailingListListGroupsioServicesByStatusUsage() {
+	fmt.Fprintf(os.Stderr, `%[1]s [flags] mailing-list list-groupsio-services-by-status -status STRING -bearer-token STRING
+
+List GroupsIO services across all projects that have the given status
+    -status STRING: 
+    -bearer-token STRING: 
+
+Example:
+    %[1]s mailing-list list-groupsio-services-by-status --status "Qui eius minus est." --bearer-token "eyJhbGci..."
+`, os.Args[0])
+}
+
+func mailingListListUnprovisionedGroupsioServicesUsage() {
+	fmt.Fprintf(os.Stderr, `%[1]s [flags] mailing-list list-unprovisioned-groupsio-services -project-uid STRING -bearer-token STRING
+
+List a project's GroupsIO services that have no Groups.io group ID yet
+    -project-uid STRING: 
+    -bearer-token STRING: 
+
+Example:
+    %[1]s mailing-list list-unprovisioned-groupsio-services --project-uid "bc3a01ed-d28c-44f9-ba98-eb7c114bcbad" --bearer-token "eyJhbGci..."
+`, os.Args[0])
+}
+
 func mailingListFindParentGroupsioServiceUsage() {
 	fmt.Fprintf(os.Stderr, `%[1]s [flags] mailing-list find-parent-groupsio-service -project-uid STRING -bearer-token STRING
 
@@ -547,7 +851,7 @@ Find the parent GroupsIO service for a project
     -bearer-token STRING: 
 
 Example:
-    %[1]s mailing-list find-parent-groupsio-service --project-uid "e8825cbc-30cb-47b2-85d7-ed4ea5f20b78" --bearer-token "eyJhbGci..."
+    %[1]s mailing-list find-parent-groupsio-service --project-uid "e5a9b9e1-1615-4164-9aaa-6020bd8bcf8e" --bearer-token "eyJhbGci..."
 `, os.Args[0])
 }
 
@@ -560,7 +864,33 @@ List GroupsIO subgroups, optionally filtered by project UID and/or committee UID
     -bearer-token STRING: 
 
 Example:
-    %[1]s mailing-list list-groupsio-mailing-lists --project-uid "5d46212b-15dd-4bea-9dc4-47f547315109" --committee-uid "7669b2d8-4204-4ed5-a636-ba1f302b8247" --bearer-token "eyJhbGci..."
+    %[1]s mailing-list list-groupsio-mailing-lists --project-uid "bf1a61c5-7d0c-44c0-a46f-03d43af41dcf" --committee-uid "c876aef9-bcef-4e21-b89c-97a510ba2505" --bearer-token "eyJhbGci..."
+`, os.Args[0])
+}
+
+func mailingListListGroupsioMailingListsByVisibilityUsage() {
+	fmt.Fprintf(os.Stderr, `%[1]s [flags] mailing-list list-groupsio-mailing-lists-by-visibility -project-uid STRING -public BOOL -bearer-token STRING
+
+List a project's public or private GroupsIO subgroups
+    -project-uid STRING: 
+    -public BOOL: 
+    -bearer-token STRING: 
+
+Example:
+    %[1]s mailing-list list-groupsio-mailing-lists-by-visibility --project-uid "d0bc182b-352c-4d44-8adc-6a1a5a47c372" --public false --bearer-token "eyJhbGci..."
+`, os.Args[0])
+}
+
+func mailingListListGroupsioMailingListsUpdatedSinceUsage() {
+	fmt.Fprintf(os.Stderr, `%[1]s [flags] mailing-list list-groupsio-mailing-lists-updated-since -project-uid STRING -since STRING -bearer-token STRING
+
+List a project's GroupsIO subgroups updated after a cutoff, for incremental sync
+    -project-uid STRING: 
+    -since STRING: 
+    -bearer-token STRING: 
+
+Example:
+    %[1]s mailing-list list-groupsio-mailing-lists-updated-since --project-uid "728897af-88ae-4714-8b5d-7d0ed3bda034" --since "1973-03-05T21:41:02Z" --bearer-token "eyJhbGci..."
 `, os.Args[0])
 }
 
@@ -573,14 +903,14 @@ Create a GroupsIO subgroup
 
 Example:
     %[1]s mailing-list create-groupsio-mailing-list --body '{
-      "audience_access": "Voluptatem atque impedit.",
+      "audience_access": "Voluptatem voluptas est recusandae.",
       "committee_uid": "7cad5a8d-19d0-41a4-81a6-043453daf9ee",
-      "description": "Autem praesentium.",
-      "group_id": 2860080953652289021,
-      "name": "Dignissimos modi libero quas.",
+      "description": "Sed quos ad.",
+      "group_id": 7793018130066140165,
+      "name": "Qui fugit libero.",
       "project_uid": "7cad5a8d-19d0-41a4-81a6-043453daf9ee",
-      "service_id": "Minus consectetur repudiandae eaque adipisci optio.",
-      "type": "Consequatur impedit esse."
+      "service_id": "Commodi in porro.",
+      "type": "Aut laudantium vero iure praesentium."
    }' --bearer-token "eyJhbGci..."
 `, os.Args[0])
 }
@@ -593,7 +923,7 @@ Get a GroupsIO subgroup by ID
     -bearer-token STRING: 
 
 Example:
-    %[1]s mailing-list get-groupsio-mailing-list --subgroup-id "Earum doloremque iure neque." --bearer-token "eyJhbGci..."
+    %[1]s mailing-list get-groupsio-mailing-list --subgroup-id "Error nihil." --bearer-token "eyJhbGci..."
 `, os.Args[0])
 }
 
@@ -607,15 +937,15 @@ Update a GroupsIO subgroup
 
 Example:
     %[1]s mailing-list update-groupsio-mailing-list --body '{
-      "audience_access": "Aliquid tempora accusamus possimus et saepe rerum.",
+      "audience_access": "Pariatur accusamus itaque consectetur aspernatur.",
       "committee_uid": "7cad5a8d-19d0-41a4-81a6-043453daf9ee",
-      "description": "Quia cupiditate aut alias repellat nisi provident.",
-      "group_id": 929355777463497743,
-      "name": "Eligendi est tenetur maxime repellat.",
+      "description": "Non nesciunt expedita ducimus.",
+      "group_id": 6020483179422235611,
+      "name": "Quod harum exercitationem quasi quam iste.",
       "project_uid": "7cad5a8d-19d0-41a4-81a6-043453daf9ee",
-      "service_id": "Voluptates reiciendis cum iste.",
-      "type": "Tempore itaque rerum doloremque."
-   }' --subgroup-id "Magni aut." --bearer-token "eyJhbGci..."
+      "service_id": "Dolorem pariatur quaerat.",
+      "type": "Laboriosam id suscipit est error."
+   }' --subgroup-id "Magni quia nulla ea fugiat quos repellat." --bearer-token "eyJhbGci..."
 `, os.Args[0])
 }
 
@@ -627,7 +957,7 @@ Delete a GroupsIO subgroup
     -bearer-token STRING: 
 
 Example:
-    %[1]s mailing-list delete-groupsio-mailing-list --subgroup-id "Aut tempora." --bearer-token "eyJhbGci..."
+    %[1]s mailing-list delete-groupsio-mailing-list --subgroup-id "Aliquid pariatur." --bearer-token "eyJhbGci..."
 `, os.Args[0])
 }
 
@@ -639,7 +969,7 @@ Get count of GroupsIO subgroups for a project
     -bearer-token STRING: 
 
 Example:
-    %[1]s mailing-list get-groupsio-mailing-list-count --project-uid "133208ff-9bfb-42d8-902c-e1f7b206a120" --bearer-token "eyJhbGci..."
+    %[1]s mailing-list get-groupsio-mailing-list-count --project-uid "d2af42ca-e2b5-47db-aa9c-37b6af3f43ba" --bearer-token "eyJhbGci..."
 `, os.Args[0])
 }
 
@@ -651,7 +981,7 @@ Get count of members in a GroupsIO subgroup
     -bearer-token STRING: 
 
 Example:
-    %[1]s mailing-list get-groupsio-mailing-list-member-count --subgroup-id "Nihil voluptates maiores." --bearer-token "eyJhbGci..."
+    %[1]s mailing-list get-groupsio-mailing-list-member-count --subgroup-id "Velit eveniet enim repudiandae." --bearer-token "eyJhbGci..."
 `, os.Args[0])
 }
 
@@ -663,7 +993,71 @@ List members of a GroupsIO subgroup
     -bearer-token STRING: 
 
 Example:
-    %[1]s mailing-list list-groupsio-members --subgroup-id "Autem cum consequatur rerum blanditiis mollitia." --bearer-token "eyJhbGci..."
+    %[1]s mailing-list list-groupsio-members --subgroup-id "Ut repudiandae dicta." --bearer-token "eyJhbGci..."
+`, os.Args[0])
+}
+
+func mailingListListGroupsioMembersNeedingReviewUsage() {
+	fmt.Fprintf(os.Stderr, `%[1]s [flags] mailing-list list-groupsio-members-needing-review -subgroup-id STRING -older-than STRING -bearer-token STRING
+
+List members of a GroupsIO subgroup not reviewed since a cutoff, including members never reviewed
+    -subgroup-id STRING: Subgroup ID
+    -older-than STRING: 
+    -bearer-token STRING: 
+
+Example:
+    %[1]s mailing-list list-groupsio-members-needing-review --subgroup-id "Voluptatem quia." --older-than "2009-12-02T14:55:03Z" --bearer-token "eyJhbGci..."
+`, os.Args[0])
+}
+
+func mailingListListGroupsioMembersModifiedSinceUsage() {
+	fmt.Fprintf(os.Stderr, `%[1]s [flags] mailing-list list-groupsio-members-modified-since -subgroup-id STRING -since STRING -bearer-token STRING
+
+List members of a GroupsIO subgroup updated after a cutoff, for incremental sync
+    -subgroup-id STRING: Subgroup ID
+    -since STRING: 
+    -bearer-token STRING: 
+
+Example:
+    %[1]s mailing-list list-groupsio-members-modified-since --subgroup-id "Iusto ad." --since "1973-05-23T03:02:40Z" --bearer-token "eyJhbGci..."
+`, os.Args[0])
+}
+
+func mailingListListGroupsioMembersByOrganizationUsage() {
+	fmt.Fprintf(os.Stderr, `%[1]s [flags] mailing-list list-groupsio-members-by-organization -subgroup-id STRING -organization STRING -bearer-token STRING
+
+List members of a GroupsIO subgroup whose organization matches, ignoring case
+    -subgroup-id STRING: Subgroup ID
+    -organization STRING: 
+    -bearer-token STRING: 
+
+Example:
+    %[1]s mailing-list list-groupsio-members-by-organization --subgroup-id "Sit voluptas iste ut odit nisi et." --organization "A similique aspernatur velit omnis adipisci ea." --bearer-token "eyJhbGci..."
+`, os.Args[0])
+}
+
+func mailingListListGroupsioDuplicateMembersUsage() {
+	fmt.Fprintf(os.Stderr, `%[1]s [flags] mailing-list list-groupsio-duplicate-members -subgroup-id STRING -bearer-token STRING
+
+List groups of members of a GroupsIO subgroup that share an email address
+    -subgroup-id STRING: Subgroup ID
+    -bearer-token STRING: 
+
+Example:
+    %[1]s mailing-list list-groupsio-duplicate-members --subgroup-id "Autem incidunt." --bearer-token "eyJhbGci..."
+`, os.Args[0])
+}
+
+func mailingListPreviewGroupsioDeliveryModeChangeUsage() {
+	fmt.Fprintf(os.Stderr, `%[1]s [flags] mailing-list preview-groupsio-delivery-mode-change -subgroup-id STRING -target-mode STRING -bearer-token STRING
+
+Preview, without writing anything, which members of a GroupsIO subgroup a delivery mode change would affect
+    -subgroup-id STRING: Subgroup ID
+    -target-mode STRING: 
+    -bearer-token STRING: 
+
+Example:
+    %[1]s mailing-list preview-groupsio-delivery-mode-change --subgroup-id "Sit aut cum temporibus non porro debitis." --target-mode "Nihil unde ullam ut facilis." --bearer-token "eyJhbGci..."
 `, os.Args[0])
 }
 
@@ -677,14 +1071,35 @@ Add a member to a GroupsIO subgroup
 
 Example:
     %[1]s mailing-list add-groupsio-member --body '{
-      "delivery_mode": "email_delivery_single",
-      "email": "nelson.lang@krisadams.org",
-      "job_title": "Laboriosam voluptates blanditiis pariatur est.",
+      "delivery_mode": "email_delivery_none",
+      "email": "allene.prohaska@mooreoberbrunner.info",
+      "job_title": "Molestias alias fugit quod velit.",
       "member_type": "direct",
-      "mod_status": "none",
-      "name": "Aut eveniet provident laboriosam.",
-      "organization": "Deserunt eos illum ut sit."
-   }' --subgroup-id "Beatae tempore id rerum." --bearer-token "eyJhbGci..."
+      "mod_status": "owner",
+      "name": "Et quisquam autem dolorem.",
+      "organization": "Quidem ab voluptas error placeat explicabo facere."
+   }' --subgroup-id "Maiores ea omnis dolores et." --bearer-token "eyJhbGci..."
+`, os.Args[0])
+}
+
+func mailingListUpsertGroupsioMemberUsage() {
+	fmt.Fprintf(os.Stderr, `%[1]s [flags] mailing-list upsert-groupsio-member -body JSON -subgroup-id STRING -bearer-token STRING
+
+Add a member to a GroupsIO subgroup, or update the member with the same email if there is one
+    -body JSON: 
+    -subgroup-id STRING: Subgroup ID
+    -bearer-token STRING: 
+
+Example:
+    %[1]s mailing-list upsert-groupsio-member --body '{
+      "delivery_mode": "email_delivery_none",
+      "email": "jarrell@swaniawski.org",
+      "job_title": "Dolore quisquam ipsum rerum et.",
+      "member_type": "direct",
+      "mod_status": "owner",
+      "name": "Maxime saepe ut aliquid repudiandae aut architecto.",
+      "organization": "Harum aut incidunt optio consequatur voluptate sit."
+   }' --subgroup-id "Quia soluta in ut nobis aut." --bearer-token "eyJhbGci..."
 `, os.Args[0])
 }
 
@@ -697,7 +1112,7 @@ Get a member of a GroupsIO subgroup by ID
     -bearer-token STRING: 
 
 Example:
-    %[1]s mailing-list get-groupsio-member --subgroup-id "Doloribus natus sed aperiam laboriosam." --member-id "Nemo consequuntur harum deleniti vel quidem." --bearer-token "eyJhbGci..."
+    %[1]s mailing-list get-groupsio-member --subgroup-id "Laborum quibusdam explicabo possimus." --member-id "Eaque rerum quaerat officia." --bearer-token "eyJhbGci..."
 `, os.Args[0])
 }
 
@@ -712,14 +1127,15 @@ Update a member of a GroupsIO subgroup
 
 Example:
     %[1]s mailing-list update-groupsio-member --body '{
-      "delivery_mode": "email_delivery_special",
-      "email": "mikel_bayer@harberoberbrunner.biz",
-      "job_title": "Sit ab est quasi repellendus.",
+      "delivery_mode": "email_delivery_none",
+      "email": "jerry@kuhlmanthiel.net",
+      "job_title": "Eius nihil quos repellendus.",
+      "mailing_list_uid": "Odit delectus.",
       "member_type": "direct",
-      "mod_status": "moderator",
-      "name": "Magnam blanditiis voluptates et culpa itaque.",
-      "organization": "Voluptates animi totam."
-   }' --subgroup-id "Qui qui." --member-id "Enim et ut in nobis ea ipsum." --bearer-token "eyJhbGci..."
+      "mod_status": "none",
+      "name": "Voluptatem quo quis et possimus corrupti molestiae.",
+      "organization": "Itaque id necessitatibus quasi qui ullam."
+   }' --subgroup-id "Et laboriosam consequatur necessitatibus." --member-id "Quis dolorem voluptate saepe itaque beatae." --bearer-token "eyJhbGci..."
 `, os.Args[0])
 }
 
@@ -732,7 +1148,7 @@ Delete a member from a GroupsIO subgroup
     -bearer-token STRING: 
 
 Example:
-    %[1]s mailing-list delete-groupsio-member --subgroup-id "Ut aut." --member-id "Rerum vero exercitationem." --bearer-token "eyJhbGci..."
+    %[1]s mailing-list delete-groupsio-member --subgroup-id "Architecto eum consectetur omnis placeat vero." --member-id "Quia reprehenderit quo dicta." --bearer-token "eyJhbGci..."
 `, os.Args[0])
 }
 
@@ -747,10 +1163,80 @@ Invite members to a GroupsIO subgroup by email
 Example:
     %[1]s mailing-list invite-groupsio-members --body '{
       "emails": [
-         "Qui architecto.",
-         "Quibusdam et quis repellendus voluptatem."
+         "Ut sint.",
+         "Ut repellendus minus quisquam laudantium et modi.",
+         "Placeat et molestias at iure.",
+         "Voluptatem laudantium."
       ]
-   }' --subgroup-id "Necessitatibus et a rerum." --bearer-token "eyJhbGci..."
+   }' --subgroup-id "Perspiciatis voluptate qui reprehenderit." --bearer-token "eyJhbGci..."
+`, os.Args[0])
+}
+
+func mailingListImportGroupsioMembersUsage() {
+	fmt.Fprintf(os.Stderr, `%[1]s [flags] mailing-list import-groupsio-members -body JSON -subgroup-id STRING -bearer-token STRING
+
+Add many members to a GroupsIO subgroup, skipping those that already exist
+    -body JSON: 
+    -subgroup-id STRING: Subgroup ID
+    -bearer-token STRING: 
+
+Example:
+    %[1]s mailing-list import-groupsio-members --body '{
+      "members": [
+         {
+            "delivery_mode": "email_delivery_digest",
+            "email": "hollis@goodwindurgan.org",
+            "job_title": "Dolor a nam.",
+            "member_type": "direct",
+            "mod_status": "moderator",
+            "name": "Est consequuntur quod occaecati ipsa.",
+            "organization": "Assumenda nisi occaecati dolor quia consectetur repudiandae."
+         },
+         {
+            "delivery_mode": "email_delivery_digest",
+            "email": "hollis@goodwindurgan.org",
+            "job_title": "Dolor a nam.",
+            "member_type": "direct",
+            "mod_status": "moderator",
+            "name": "Est consequuntur quod occaecati ipsa.",
+            "organization": "Assumenda nisi occaecati dolor quia consectetur repudiandae."
+         }
+      ]
+   }' --subgroup-id "Doloribus dolorem vitae et hic voluptatem." --bearer-token "eyJhbGci..."
+`, os.Args[0])
+}
+
+func mailingListMergeGroupsioMembersUsage() {
+	fmt.Fprintf(os.Stderr, `%[1]s [flags] mailing-list merge-groupsio-members -body JSON -subgroup-id STRING -bearer-token STRING
+
+Merge duplicate members of a GroupsIO subgroup by keeping one and removing the others
+    -body JSON: 
+    -subgroup-id STRING: Subgroup ID
+    -bearer-token STRING: 
+
+Example:
+    %[1]s mailing-list merge-groupsio-members --body '{
+      "keep_member_id": "Harum cupiditate doloribus.",
+      "merge_member_ids": [
+         "Exercitationem neque voluptatibus."
+      ]
+   }' --subgroup-id "Ipsum porro." --bearer-token "eyJhbGci..."
+`, os.Args[0])
+}
+
+func mailingListChangeGroupsioMemberEmailUsage() {
+	fmt.Fprintf(os.Stderr, `%[1]s [flags] mailing-list change-groupsio-member-email -body JSON -subgroup-id STRING -member-id STRING -bearer-token STRING
+
+Change a member's email address in place, keeping the member record and its history
+    -body JSON: 
+    -subgroup-id STRING: Subgroup ID
+    -member-id STRING: Member ID
+    -bearer-token STRING: 
+
+Example:
+    %[1]s mailing-list change-groupsio-member-email --body '{
+      "email": "carmelo@wizaoberbrunner.com"
+   }' --subgroup-id "Et dicta." --member-id "Eaque magni molestias quam." --bearer-token "eyJhbGci..."
 `, os.Args[0])
 }
 
@@ -763,8 +1249,8 @@ Check if an email address is subscribed to a GroupsIO subgroup
 
 Example:
     %[1]s mailing-list check-groupsio-subscriber --body '{
-      "email": "alysa@morar.name",
-      "subgroup_id": "Quis ab quia in inventore atque officia."
+      "email": "asha@kassulke.com",
+      "subgroup_id": "Quas pariatur quia et aut dolores."
    }' --bearer-token "eyJhbGci..."
 `, os.Args[0])
 }
@@ -778,7 +1264,7 @@ Get a GroupsIO subgroup artifact by ID
     -bearer-token STRING: 
 
 Example:
-    %[1]s mailing-list get-groupsio-artifact --subgroup-id "Nisi illum et omnis omnis." --artifact-id "Dolor odio incidunt expedita quia enim." --bearer-token "eyJhbGci..."
+    %[1]s mailing-list get-groupsio-artifact --subgroup-id "Ea et dolorum et qui rerum." --artifact-id "Est aut praesentium cupiditate." --bearer-token "eyJhbGci..."
 `, os.Args[0])
 }
 
@@ -791,6 +1277,95 @@ Get a presigned S3 download URL for a GroupsIO subgroup artifact
     -bearer-token STRING: 
 
 Example:
-    %[1]s mailing-list get-groupsio-artifact-download --subgroup-id "Ut doloremque." --artifact-id "Fugiat porro." --bearer-token "eyJhbGci..."
+    %[1]s mailing-list get-groupsio-artifact-download --subgroup-id "Natus nisi." --artifact-id "Qui cupiditate vel soluta quos quis." --bearer-token "eyJhbGci..."
+`, os.Args[0])
+}
+
+func mailingListGetGroupsioProjectSummaryUsage() {
+	fmt.Fprintf(os.Stderr, `%[1]s [flags] mailing-list get-groupsio-project-summary -project-uid STRING -bearer-token STRING
+
+Get the number of GroupsIO services, subgroups and members in a project
+    -project-uid STRING: LFX v2 project UID
+    -bearer-token STRING: 
+
+Example:
+    %[1]s mailing-list get-groupsio-project-summary --project-uid "bcbf2eb6-96c2-4cb8-b3cc-c53be10ba815" --bearer-token "eyJhbGci..."
+`, os.Args[0])
+}
+
+func mailingListListGroupsioOrphanedMailingListsUsage() {
+	fmt.Fprintf(os.Stderr, `%[1]s [flags] mailing-list list-groupsio-orphaned-mailing-lists -project-uid STRING -bearer-token STRING
+
+List a project's GroupsIO subgroups whose parent service no longer exists
+    -project-uid STRING: LFX v2 project UID
+    -bearer-token STRING: 
+
+Example:
+    %[1]s mailing-list list-groupsio-orphaned-mailing-lists --project-uid "54bdca72-3928-4939-8d0e-4fd38edb0ba4" --bearer-token "eyJhbGci..."
+`, os.Args[0])
+}
+
+func mailingListRepublishGroupsioProjectIndexUsage() {
+	fmt.Fprintf(os.Stderr, `%[1]s [flags] mailing-list republish-groupsio-project-index -project-uid STRING -bearer-token STRING
+
+Re-publish indexer messages for every GroupsIO service, subgroup and member of a project, e.g. after the search index is rebuilt. Safe to retry: a retry after a failure skips the subgroups whose members were already republished
+    -project-uid STRING: LFX v2 project UID
+    -bearer-token STRING: 
+
+Example:
+    %[1]s mailing-list republish-groupsio-project-index --project-uid "e76989cb-f19c-4ece-b410-f8285f5172ee" --bearer-token "eyJhbGci..."
+`, os.Args[0])
+}
+
+func mailingListGetGroupsioMailingListStatsUsage() {
+	fmt.Fprintf(os.Stderr, `%[1]s [flags] mailing-list get-groupsio-mailing-list-stats -subgroup-id STRING -bearer-token STRING
+
+Get member statistics for a GroupsIO subgroup
+    -subgroup-id STRING: Subgroup ID
+    -bearer-token STRING: 
+
+Example:
+    %[1]s mailing-list get-groupsio-mailing-list-stats --subgroup-id "Eius voluptatum aut non est eveniet est." --bearer-token "eyJhbGci..."
+`, os.Args[0])
+}
+
+func mailingListGetGroupsioServiceTreeUsage() {
+	fmt.Fprintf(os.Stderr, `%[1]s [flags] mailing-list get-groupsio-service-tree -service-id STRING -include-member-counts BOOL -bearer-token STRING
+
+Get a GroupsIO service with its subgroups nested beneath it
+    -service-id STRING: Service ID
+    -include-member-counts BOOL: 
+    -bearer-token STRING: 
+
+Example:
+    %[1]s mailing-list get-groupsio-service-tree --service-id "Provident aut officia consequatur." --include-member-counts true --bearer-token "eyJhbGci..."
+`, os.Args[0])
+}
+
+func mailingListGetGroupsioMemberHierarchyUsage() {
+	fmt.Fprintf(os.Stderr, `%[1]s [flags] mailing-list get-groupsio-member-hierarchy -subgroup-id STRING -member-id STRING -bearer-token STRING
+
+Get a GroupsIO member with the subgroup, service and project above it
+    -subgroup-id STRING: Subgroup ID
+    -member-id STRING: Member ID
+    -bearer-token STRING: 
+
+Example:
+    %[1]s mailing-list get-groupsio-member-hierarchy --subgroup-id "Dolorem ea facere odit in." --member-id "Similique perspiciatis occaecati aut." --bearer-token "eyJhbGci..."
+`, os.Args[0])
+}
+
+func mailingListListGroupsioProjectMembersUsage() {
+	fmt.Fprintf(os.Stderr, `%[1]s [flags] mailing-list list-groupsio-project-members -project-uid STRING -limit INT -cursor STRING -dedupe BOOL -bearer-token STRING
+
+Page through the members of every GroupsIO subgroup in a project
+    -project-uid STRING: LFX v2 project UID
+    -limit INT: 
+    -cursor STRING: 
+    -dedupe BOOL: 
+    -bearer-token STRING: 
+
+Example:
+    %[1]s mailing-list list-groupsio-project-members --project-uid "2a1a9a5b-2811-41ff-895a-68e87956a850" --limit 457419160090517382 --cursor "Ipsa ut aliquid molestiae sint atque." --dedupe false --bearer-token "eyJhbGci..."
 `, os.Args[0])
 }
@@ -11,6 +11,7 @@ package client
 import (
 	"encoding/json"
 	"fmt"
+	"strconv"
 
 	mailinglist "github.com/linuxfoundation/lfx-v2-mailing-list-service/gen/mailing_list"
 	goa "goa.design/goa/v3/pkg"
@@ -51,7 +52,7 @@ func BuildCreateGroupsioServicePayload(mailingListCreateGroupsioServiceBody stri
 	{
 		err = json.Unmarshal([]byte(mailingListCreateGroupsioServiceBody), &body)
 		if err != nil {
-			return nil, fmt.Errorf("invalid JSON for body, \nerror: %s, \nexample of valid JSON:\n%s", err, "'{\n      \"domain\": \"Consectetur accusantium ut aut ut.\",\n      \"group_id\": 8879252608711124236,\n      \"prefix\": \"Alias ad in eveniet nesciunt.\",\n      \"project_uid\": \"7cad5a8d-19d0-41a4-81a6-043453daf9ee\",\n      \"status\": \"Ab qui architecto rerum ducimus dolorum.\",\n      \"type\": \"v2_primary\"\n   }'")
+			return nil, fmt.Errorf("invalid JSON for body, \nerror: %s, \nexample of valid JSON:\n%s", err, "'{\n      \"domain\": \"Sint id ea et.\",\n      \"group_id\": 4925895175083449246,\n      \"prefix\": \"Veritatis ea aut eos recusandae architecto.\",\n      \"project_uid\": \"7cad5a8d-19d0-41a4-81a6-043453daf9ee\",\n      \"status\": \"Quisquam consequuntur tenetur eius assumenda.\",\n      \"type\": \"v2_primary\"\n   }'")
 		}
 	}
 	var bearerToken *string
@@ -101,7 +102,7 @@ func BuildUpdateGroupsioServicePayload(mailingListUpdateGroupsioServiceBody stri
 	{
 		err = json.Unmarshal([]byte(mailingListUpdateGroupsioServiceBody), &body)
 		if err != nil {
-			return nil, fmt.Errorf("invalid JSON for body, \nerror: %s, \nexample of valid JSON:\n%s", err, "'{\n      \"domain\": \"Autem voluptatem cupiditate iusto consectetur.\",\n      \"group_id\": 511445430138960221,\n      \"prefix\": \"Nihil qui facilis veniam omnis.\",\n      \"project_uid\": \"7cad5a8d-19d0-41a4-81a6-043453daf9ee\",\n      \"status\": \"Aut magnam nisi aspernatur.\",\n      \"type\": \"v2_primary\"\n   }'")
+			return nil, fmt.Errorf("invalid JSON for body, \nerror: %s, \nexample of valid JSON:\n%s", err, "'{\n      \"domain\": \"Minima est veritatis pariatur.\",\n      \"group_id\": 3036518474151360591,\n      \"prefix\": \"Distinctio aut repellat velit.\",\n      \"project_uid\": \"7cad5a8d-19d0-41a4-81a6-043453daf9ee\",\n      \"status\": \"Numquam ipsam.\",\n      \"type\": \"v2_primary\"\n   }'")
 		}
 		if body.ProjectUID != nil {
 			err = goa.MergeErrors(err, goa.ValidateFormat("body.project_uid", *body.ProjectUID, goa.FormatUUID))
@@ -139,6 +140,26 @@ func BuildUpdateGroupsioServicePayload(mailingListUpdateGroupsioServiceBody stri
 	return v, nil
 }
 
+// BuildPromoteGroupsioServicePayload builds the payload for the mailing-list
+// promote-groupsio-service endpoint from CLI flags.
+func BuildPromoteGroupsioServicePayload(mailingListPromoteGroupsioServiceServiceID string, mailingListPromoteGroupsioServiceBearerToken string) (*mailinglist.PromoteGroupsioServicePayload, error) {
+	var serviceID string
+	{
+		serviceID = mailingListPromoteGroupsioServiceServiceID
+	}
+	var bearerToken *string
+	{
+		if mailingListPromoteGroupsioServiceBearerToken != "" {
+			bearerToken = &mailingListPromoteGroupsioServiceBearerToken
+		}
+	}
+	v := &mailinglist.PromoteGroupsioServicePayload{}
+	v.ServiceID = serviceID
+	v.BearerToken = bearerToken
+
+	return v, nil
+}
+
 // BuildDeleteGroupsioServicePayload builds the payload for the mailing-list
 // delete-groupsio-service endpoint from CLI flags.
 func BuildDeleteGroupsioServicePayload(mailingListDeleteGroupsioServiceServiceID string, mailingListDeleteGroupsioServiceBearerToken string) (*mailinglist.DeleteGroupsioServicePayload, error) {
@@ -174,6 +195,51 @@ func BuildGetGroupsioServiceProjectsPayload(mailingListGetGroupsioServiceProject
 	return v, nil
 }
 
+// BuildListGroupsioServicesByStatusPayload builds the payload for the
+// mailing-list list-groupsio-services-by-status endpoint from CLI flags.
+func BuildListGroupsioServicesByStatusPayload(mailingListListGroupsioServicesByStatusStatus string, mailingListListGroupsioServicesByStatusBearerToken string) (*mailinglist.ListGroupsioServicesByStatusPayload, error) {
+	var status string
+	{
+		status = mailingListListGroupsioServicesByStatusStatus
+	}
+	var bearerToken *string
+	{
+		if mailingListListGroupsioServicesByStatusBearerToken != "" {
+			bearerToken = &mailingListListGroupsioServicesByStatusBearerToken
+		}
+	}
+	v := &mailinglist.ListGroupsioServicesByStatusPayload{}
+	v.Status = status
+	v.BearerToken = bearerToken
+
+	return v, nil
+}
+
+// BuildListUnprovisionedGroupsioServicesPayload builds the payload for the
+// mailing-list list-unprovisioned-groupsio-services endpoint from CLI flags.
+func BuildListUnprovisionedGroupsioServicesPayload(mailingListListUnprovisionedGroupsioServicesProjectUID string, mailingListListUnprovisionedGroupsioServicesBearerToken string) (*mailinglist.ListUnprovisionedGroupsioServicesPayload, error) {
+	var err error
+	var projectUID string
+	{
+		projectUID = mailingListListUnprovisionedGroupsioServicesProjectUID
+		err = goa.MergeErrors(err, goa.ValidateFormat("project_uid", projectUID, goa.FormatUUID))
+		if err != nil {
+			return nil, err
+		}
+	}
+	var bearerToken *string
+	{
+		if mailingListListUnprovisionedGroupsioServicesBearerToken != "" {
+			bearerToken = &mailingListListUnprovisionedGroupsioServicesBearerToken
+		}
+	}
+	v := &mailinglist.ListUnprovisionedGroupsioServicesPayload{}
+	v.ProjectUID = projectUID
+	v.BearerToken = bearerToken
+
+	return v, nil
+}
+
 // BuildFindParentGroupsioServicePayload builds the payload for the
 // mailing-list find-parent-groupsio-service endpoint from CLI flags.
 func BuildFindParentGroupsioServicePayload(mailingListFindParentGroupsioServiceProjectUID string, mailingListFindParentGroupsioServiceBearerToken string) (*mailinglist.FindParentGroupsioServicePayload, error) {
@@ -237,6 +303,75 @@ func BuildListGroupsioMailingListsPayload(mailingListListGroupsioMailingListsPro
 	return v, nil
 }
 
+// BuildListGroupsioMailingListsByVisibilityPayload builds the payload for the
+// mailing-list list-groupsio-mailing-lists-by-visibility endpoint from CLI
+// flags.
+func BuildListGroupsioMailingListsByVisibilityPayload(mailingListListGroupsioMailingListsByVisibilityProjectUID string, mailingListListGroupsioMailingListsByVisibilityPublic string, mailingListListGroupsioMailingListsByVisibilityBearerToken string) (*mailinglist.ListGroupsioMailingListsByVisibilityPayload, error) {
+	var err error
+	var projectUID string
+	{
+		projectUID = mailingListListGroupsioMailingListsByVisibilityProjectUID
+		err = goa.MergeErrors(err, goa.ValidateFormat("project_uid", projectUID, goa.FormatUUID))
+		if err != nil {
+			return nil, err
+		}
+	}
+	var public bool
+	{
+		public, err = strconv.ParseBool(mailingListListGroupsioMailingListsByVisibilityPublic)
+		if err != nil {
+			return nil, fmt.Errorf("invalid value for public, must be BOOL")
+		}
+	}
+	var bearerToken *string
+	{
+		if mailingListListGroupsioMailingListsByVisibilityBearerToken != "" {
+			bearerToken = &mailingListListGroupsioMailingListsByVisibilityBearerToken
+		}
+	}
+	v := &mailinglist.ListGroupsioMailingListsByVisibilityPayload{}
+	v.ProjectUID = projectUID
+	v.Public = public
+	v.BearerToken = bearerToken
+
+	return v, nil
+}
+
+// BuildListGroupsioMailingListsUpdatedSincePayload builds the payload for the
+// mailing-list list-groupsio-mailing-lists-updated-since endpoint from CLI
+// flags.
+func BuildListGroupsioMailingListsUpdatedSincePayload(mailingListListGroupsioMailingListsUpdatedSinceProjectUID string, mailingListListGroupsioMailingListsUpdatedSinceSince string, mailingListListGroupsioMailingListsUpdatedSinceBearerToken string) (*mailinglist.ListGroupsioMailingListsUpdatedSincePayload, error) {
+	var err error
+	var projectUID string
+	{
+		projectUID = mailingListListGroupsioMailingListsUpdatedSinceProjectUID
+		err = goa.MergeErrors(err, goa.ValidateFormat("project_uid", projectUID, goa.FormatUUID))
+		if err != nil {
+			return nil, err
+		}
+	}
+	var since string
+	{
+		since = mailingListListGroupsioMailingListsUpdatedSinceSince
+		err = goa.MergeErrors(err, goa.ValidateFormat("since", since, goa.FormatDateTime))
+		if err != nil {
+			return nil, err
+		}
+	}
+	var bearerToken *string
+	{
+		if mailingListListGroupsioMailingListsUpdatedSinceBearerToken != "" {
+			bearerToken = &mailingListListGroupsioMailingListsUpdatedSinceBearerToken
+		}
+	}
+	v := &mailinglist.ListGroupsioMailingListsUpdatedSincePayload{}
+	v.ProjectUID = projectUID
+	v.Since = since
+	v.BearerToken = bearerToken
+
+	return v, nil
+}
+
 // BuildCreateGroupsioMailingListPayload builds the payload for the
 // mailing-list create-groupsio-mailing-list endpoint from CLI flags.
 func BuildCreateGroupsioMailingListPayload(mailingListCreateGroupsioMailingListBody string, mailingListCreateGroupsioMailingListBearerToken string) (*mailinglist.CreateGroupsioMailingListPayload, error) {
@@ -245,7 +380,7 @@ func BuildCreateGroupsioMailingListPayload(mailingListCreateGroupsioMailingListB
 	{
 		err = json.Unmarshal([]byte(mailingListCreateGroupsioMailingListBody), &body)
 		if err != nil {
-			return nil, fmt.Errorf("invalid JSON for body, \nerror: %s, \nexample of valid JSON:\n%s", err, "'{\n      \"audience_access\": \"Voluptatem atque impedit.\",\n      \"committee_uid\": \"7cad5a8d-19d0-41a4-81a6-043453daf9ee\",\n      \"description\": \"Autem praesentium.\",\n      \"group_id\": 2860080953652289021,\n      \"name\": \"Dignissimos modi libero quas.\",\n      \"project_uid\": \"7cad5a8d-19d0-41a4-81a6-043453daf9ee\",\n      \"service_id\": \"Minus consectetur repudiandae eaque adipisci optio.\",\n      \"type\": \"Consequatur impedit esse.\"\n   }'")
+			return nil, fmt.Errorf("invalid JSON for body, \nerror: %s, \nexample of valid JSON:\n%s", err, "'{\n      \"audience_access\": \"Voluptatem voluptas est recusandae.\",\n      \"committee_uid\": \"7cad5a8d-19d0-41a4-81a6-043453daf9ee\",\n      \"description\": \"Sed quos ad.\",\n      \"group_id\": 7793018130066140165,\n      \"name\": \"Qui fugit libero.\",\n      \"project_uid\": \"7cad5a8d-19d0-41a4-81a6-043453daf9ee\",\n      \"service_id\": \"Commodi in porro.\",\n      \"type\": \"Aut laudantium vero iure praesentium.\"\n   }'")
 		}
 	}
 	var bearerToken *string
@@ -297,7 +432,7 @@ func BuildUpdateGroupsioMailingListPayload(mailingListUpdateGroupsioMailingListB
 	{
 		err = json.Unmarshal([]byte(mailingListUpdateGroupsioMailingListBody), &body)
 		if err != nil {
-			return nil, fmt.Errorf("invalid JSON for body, \nerror: %s, \nexample of valid JSON:\n%s", err, "'{\n      \"audience_access\": \"Aliquid tempora accusamus possimus et saepe rerum.\",\n      \"committee_uid\": \"7cad5a8d-19d0-41a4-81a6-043453daf9ee\",\n      \"description\": \"Quia cupiditate aut alias repellat nisi provident.\",\n      \"group_id\": 929355777463497743,\n      \"name\": \"Eligendi est tenetur maxime repellat.\",\n      \"project_uid\": \"7cad5a8d-19d0-41a4-81a6-043453daf9ee\",\n      \"service_id\": \"Voluptates reiciendis cum iste.\",\n      \"type\": \"Tempore itaque rerum doloremque.\"\n   }'")
+			return nil, fmt.Errorf("invalid JSON for body, \nerror: %s, \nexample of valid JSON:\n%s", err, "'{\n      \"audience_access\": \"Pariatur accusamus itaque consectetur aspernatur.\",\n      \"committee_uid\": \"7cad5a8d-19d0-41a4-81a6-043453daf9ee\",\n      \"description\": \"Non nesciunt expedita ducimus.\",\n      \"group_id\": 6020483179422235611,\n      \"name\": \"Quod harum exercitationem quasi quam iste.\",\n      \"project_uid\": \"7cad5a8d-19d0-41a4-81a6-043453daf9ee\",\n      \"service_id\": \"Dolorem pariatur quaerat.\",\n      \"type\": \"Laboriosam id suscipit est error.\"\n   }'")
 		}
 		if body.ProjectUID != nil {
 			err = goa.MergeErrors(err, goa.ValidateFormat("body.project_uid", *body.ProjectUID, goa.FormatUUID))
@@ -420,6 +555,136 @@ func BuildListGroupsioMembersPayload(mailingListListGroupsioMembersSubgroupID st
 	return v, nil
 }
 
+// BuildListGroupsioMembersNeedingReviewPayload builds the payload for the
+// mailing-list list-groupsio-members-needing-review endpoint from CLI flags.
+func BuildListGroupsioMembersNeedingReviewPayload(mailingListListGroupsioMembersNeedingReviewSubgroupID string, mailingListListGroupsioMembersNeedingReviewOlderThan string, mailingListListGroupsioMembersNeedingReviewBearerToken string) (*mailinglist.ListGroupsioMembersNeedingReviewPayload, error) {
+	var err error
+	var subgroupID string
+	{
+		subgroupID = mailingListListGroupsioMembersNeedingReviewSubgroupID
+	}
+	var olderThan string
+	{
+		olderThan = mailingListListGroupsioMembersNeedingReviewOlderThan
+		err = goa.MergeErrors(err, goa.ValidateFormat("older_than", olderThan, goa.FormatDateTime))
+		if err != nil {
+			return nil, err
+		}
+	}
+	var bearerToken *string
+	{
+		if mailingListListGroupsioMembersNeedingReviewBearerToken != "" {
+			bearerToken = &mailingListListGroupsioMembersNeedingReviewBearerToken
+		}
+	}
+	v := &mailinglist.ListGroupsioMembersNeedingReviewPayload{}
+	v.SubgroupID = subgroupID
+	v.OlderThan = olderThan
+	v.BearerToken = bearerToken
+
+	return v, nil
+}
+
+// BuildListGroupsioMembersModifiedSincePayload builds the payload for the
+// mailing-list list-groupsio-members-modified-since endpoint from CLI flags.
+func BuildListGroupsioMembersModifiedSincePayload(mailingListListGroupsioMembersModifiedSinceSubgroupID string, mailingListListGroupsioMembersModifiedSinceSince string, mailingListListGroupsioMembersModifiedSinceBearerToken string) (*mailinglist.ListGroupsioMembersModifiedSincePayload, error) {
+	var err error
+	var subgroupID string
+	{
+		subgroupID = mailingListListGroupsioMembersModifiedSinceSubgroupID
+	}
+	var since string
+	{
+		since = mailingListListGroupsioMembersModifiedSinceSince
+		err = goa.MergeErrors(err, goa.ValidateFormat("since", since, goa.FormatDateTime))
+		if err != nil {
+			return nil, err
+		}
+	}
+	var bearerToken *string
+	{
+		if mailingListListGroupsioMembersModifiedSinceBearerToken != "" {
+			bearerToken = &mailingListListGroupsioMembersModifiedSinceBearerToken
+		}
+	}
+	v := &mailinglist.ListGroupsioMembersModifiedSincePayload{}
+	v.SubgroupID = subgroupID
+	v.Since = since
+	v.BearerToken = bearerToken
+
+	return v, nil
+}
+
+// BuildListGroupsioMembersByOrganizationPayload builds the payload for the
+// mailing-list list-groupsio-members-by-organization endpoint from CLI flags.
+func BuildListGroupsioMembersByOrganizationPayload(mailingListListGroupsioMembersByOrganizationSubgroupID string, mailingListListGroupsioMembersByOrganizationOrganization string, mailingListListGroupsioMembersByOrganizationBearerToken string) (*mailinglist.ListGroupsioMembersByOrganizationPayload, error) {
+	var subgroupID string
+	{
+		subgroupID = mailingListListGroupsioMembersByOrganizationSubgroupID
+	}
+	var organization string
+	{
+		organization = mailingListListGroupsioMembersByOrganizationOrganization
+	}
+	var bearerToken *string
+	{
+		if mailingListListGroupsioMembersByOrganizationBearerToken != "" {
+			bearerToken = &mailingListListGroupsioMembersByOrganizationBearerToken
+		}
+	}
+	v := &mailinglist.ListGroupsioMembersByOrganizationPayload{}
+	v.SubgroupID = subgroupID
+	v.Organization = organization
+	v.BearerToken = bearerToken
+
+	return v, nil
+}
+
+// BuildListGroupsioDuplicateMembersPayload builds the payload for the
+// mailing-list list-groupsio-duplicate-members endpoint from CLI flags.
+func BuildListGroupsioDuplicateMembersPayload(mailingListListGroupsioDuplicateMembersSubgroupID string, mailingListListGroupsioDuplicateMembersBearerToken string) (*mailinglist.ListGroupsioDuplicateMembersPayload, error) {
+	var subgroupID string
+	{
+		subgroupID = mailingListListGroupsioDuplicateMembersSubgroupID
+	}
+	var bearerToken *string
+	{
+		if mailingListListGroupsioDuplicateMembersBearerToken != "" {
+			bearerToken = &mailingListListGroupsioDuplicateMembersBearerToken
+		}
+	}
+	v := &mailinglist.ListGroupsioDuplicateMembersPayload{}
+	v.SubgroupID = subgroupID
+	v.BearerToken = bearerToken
+
+	return v, nil
+}
+
+// BuildPreviewGroupsioDeliveryModeChangePayload builds the payload for the
+// mailing-list preview-groupsio-delivery-mode-change endpoint from CLI flags.
+func BuildPreviewGroupsioDeliveryModeChangePayload(mailingListPreviewGroupsioDeliveryModeChangeSubgroupID string, mailingListPreviewGroupsioDeliveryModeChangeTargetMode string, mailingListPreviewGroupsioDeliveryModeChangeBearerToken string) (*mailinglist.PreviewGroupsioDeliveryModeChangePayload, error) {
+	var subgroupID string
+	{
+		subgroupID = mailingListPreviewGroupsioDeliveryModeChangeSubgroupID
+	}
+	var targetMode string
+	{
+		targetMode = mailingListPreviewGroupsioDeliveryModeChangeTargetMode
+	}
+	var bearerToken *string
+	{
+		if mailingListPreviewGroupsioDeliveryModeChangeBearerToken != "" {
+			bearerToken = &mailingListPreviewGroupsioDeliveryModeChangeBearerToken
+		}
+	}
+	v := &mailinglist.PreviewGroupsioDeliveryModeChangePayload{}
+	v.SubgroupID = subgroupID
+	v.TargetMode = targetMode
+	v.BearerToken = bearerToken
+
+	return v, nil
+}
+
 // BuildAddGroupsioMemberPayload builds the payload for the mailing-list
 // add-groupsio-member endpoint from CLI flags.
 func BuildAddGroupsioMemberPayload(mailingListAddGroupsioMemberBody string, mailingListAddGroupsioMemberSubgroupID string, mailingListAddGroupsioMemberBearerToken string) (*mailinglist.AddGroupsioMemberPayload, error) {
@@ -428,7 +693,7 @@ func BuildAddGroupsioMemberPayload(mailingListAddGroupsioMemberBody string, mail
 	{
 		err = json.Unmarshal([]byte(mailingListAddGroupsioMemberBody), &body)
 		if err != nil {
-			return nil, fmt.Errorf("invalid JSON for body, \nerror: %s, \nexample of valid JSON:\n%s", err, "'{\n      \"delivery_mode\": \"email_delivery_single\",\n      \"email\": \"nelson.lang@krisadams.org\",\n      \"job_title\": \"Laboriosam voluptates blanditiis pariatur est.\",\n      \"member_type\": \"direct\",\n      \"mod_status\": \"none\",\n      \"name\": \"Aut eveniet provident laboriosam.\",\n      \"organization\": \"Deserunt eos illum ut sit.\"\n   }'")
+			return nil, fmt.Errorf("invalid JSON for body, \nerror: %s, \nexample of valid JSON:\n%s", err, "'{\n      \"delivery_mode\": \"email_delivery_none\",\n      \"email\": \"allene.prohaska@mooreoberbrunner.info\",\n      \"job_title\": \"Molestias alias fugit quod velit.\",\n      \"member_type\": \"direct\",\n      \"mod_status\": \"owner\",\n      \"name\": \"Et quisquam autem dolorem.\",\n      \"organization\": \"Quidem ab voluptas error placeat explicabo facere.\"\n   }'")
 		}
 		if body.Email != nil {
 			err = goa.MergeErrors(err, goa.ValidateFormat("body.email", *body.Email, goa.FormatEmail))
@@ -477,6 +742,61 @@ func BuildAddGroupsioMemberPayload(mailingListAddGroupsioMemberBody string, mail
 	return v, nil
 }
 
+// BuildUpsertGroupsioMemberPayload builds the payload for the mailing-list
+// upsert-groupsio-member endpoint from CLI flags.
+func BuildUpsertGroupsioMemberPayload(mailingListUpsertGroupsioMemberBody string, mailingListUpsertGroupsioMemberSubgroupID string, mailingListUpsertGroupsioMemberBearerToken string) (*mailinglist.UpsertGroupsioMemberPayload, error) {
+	var err error
+	var body UpsertGroupsioMemberRequestBody
+	{
+		err = json.Unmarshal([]byte(mailingListUpsertGroupsioMemberBody), &body)
+		if err != nil {
+			return nil, fmt.Errorf("invalid JSON for body, \nerror: %s, \nexample of valid JSON:\n%s", err, "'{\n      \"delivery_mode\": \"email_delivery_none\",\n      \"email\": \"jarrell@swaniawski.org\",\n      \"job_title\": \"Dolore quisquam ipsum rerum et.\",\n      \"member_type\": \"direct\",\n      \"mod_status\": \"owner\",\n      \"name\": \"Maxime saepe ut aliquid repudiandae aut architecto.\",\n      \"organization\": \"Harum aut incidunt optio consequatur voluptate sit.\"\n   }'")
+		}
+		err = goa.MergeErrors(err, goa.ValidateFormat("body.email", body.Email, goa.FormatEmail))
+		if body.MemberType != nil {
+			if !(*body.MemberType == "direct") {
+				err = goa.MergeErrors(err, goa.InvalidEnumValueError("body.member_type", *body.MemberType, []any{"direct"}))
+			}
+		}
+		if body.ModStatus != nil {
+			if !(*body.ModStatus == "none" || *body.ModStatus == "moderator" || *body.ModStatus == "owner") {
+				err = goa.MergeErrors(err, goa.InvalidEnumValueError("body.mod_status", *body.ModStatus, []any{"none", "moderator", "owner"}))
+			}
+		}
+		if body.DeliveryMode != nil {
+			if !(*body.DeliveryMode == "email_delivery_single" || *body.DeliveryMode == "email_delivery_digest" || *body.DeliveryMode == "email_delivery_none" || *body.DeliveryMode == "email_delivery_special" || *body.DeliveryMode == "email_delivery_html_digest" || *body.DeliveryMode == "email_delivery_summary") {
+				err = goa.MergeErrors(err, goa.InvalidEnumValueError("body.delivery_mode", *body.DeliveryMode, []any{"email_delivery_single", "email_delivery_digest", "email_delivery_none", "email_delivery_special", "email_delivery_html_digest", "email_delivery_summary"}))
+			}
+		}
+		if err != nil {
+			return nil, err
+		}
+	}
+	var subgroupID string
+	{
+		subgroupID = mailingListUpsertGroupsioMemberSubgroupID
+	}
+	var bearerToken *string
+	{
+		if mailingListUpsertGroupsioMemberBearerToken != "" {
+			bearerToken = &mailingListUpsertGroupsioMemberBearerToken
+		}
+	}
+	v := &mailinglist.UpsertGroupsioMemberPayload{
+		Email:        body.Email,
+		Name:         body.Name,
+		MemberType:   body.MemberType,
+		ModStatus:    body.ModStatus,
+		DeliveryMode: body.DeliveryMode,
+		Organization: body.Organization,
+		JobTitle:     body.JobTitle,
+	}
+	v.SubgroupID = subgroupID
+	v.BearerToken = bearerToken
+
+	return v, nil
+}
+
 // BuildGetGroupsioMemberPayload builds the payload for the mailing-list
 // get-groupsio-member endpoint from CLI flags.
 func BuildGetGroupsioMemberPayload(mailingListGetGroupsioMemberSubgroupID string, mailingListGetGroupsioMemberMemberID string, mailingListGetGroupsioMemberBearerToken string) (*mailinglist.GetGroupsioMemberPayload, error) {
@@ -510,7 +830,7 @@ func BuildUpdateGroupsioMemberPayload(mailingListUpdateGroupsioMemberBody string
 	{
 		err = json.Unmarshal([]byte(mailingListUpdateGroupsioMemberBody), &body)
 		if err != nil {
-			return nil, fmt.Errorf("invalid JSON for body, \nerror: %s, \nexample of valid JSON:\n%s", err, "'{\n      \"delivery_mode\": \"email_delivery_special\",\n      \"email\": \"mikel_bayer@harberoberbrunner.biz\",\n      \"job_title\": \"Sit ab est quasi repellendus.\",\n      \"member_type\": \"direct\",\n      \"mod_status\": \"moderator\",\n      \"name\": \"Magnam blanditiis voluptates et culpa itaque.\",\n      \"organization\": \"Voluptates animi totam.\"\n   }'")
+			return nil, fmt.Errorf("invalid JSON for body, \nerror: %s, \nexample of valid JSON:\n%s", err, "'{\n      \"delivery_mode\": \"email_delivery_none\",\n      \"email\": \"jerry@kuhlmanthiel.net\",\n      \"job_title\": \"Eius nihil quos repellendus.\",\n      \"mailing_list_uid\": \"Odit delectus.\",\n      \"member_type\": \"direct\",\n      \"mod_status\": \"none\",\n      \"name\": \"Voluptatem quo quis et possimus corrupti molestiae.\",\n      \"organization\": \"Itaque id necessitatibus quasi qui ullam.\"\n   }'")
 		}
 		if body.Email != nil {
 			err = goa.MergeErrors(err, goa.ValidateFormat("body.email", *body.Email, goa.FormatEmail))
@@ -549,13 +869,14 @@ func BuildUpdateGroupsioMemberPayload(mailingListUpdateGroupsioMemberBody string
 		}
 	}
 	v := &mailinglist.UpdateGroupsioMemberPayload{
-		Email:        body.Email,
-		Name:         body.Name,
-		MemberType:   body.MemberType,
-		ModStatus:    body.ModStatus,
-		DeliveryMode: body.DeliveryMode,
-		Organization: body.Organization,
-		JobTitle:     body.JobTitle,
+		MailingListUID: body.MailingListUID,
+		Email:          body.Email,
+		Name:           body.Name,
+		MemberType:     body.MemberType,
+		ModStatus:      body.ModStatus,
+		DeliveryMode:   body.DeliveryMode,
+		Organization:   body.Organization,
+		JobTitle:       body.JobTitle,
 	}
 	v.SubgroupID = subgroupID
 	v.MemberID = memberID
@@ -597,7 +918,7 @@ func BuildInviteGroupsioMembersPayload(mailingListInviteGroupsioMembersBody stri
 	{
 		err = json.Unmarshal([]byte(mailingListInviteGroupsioMembersBody), &body)
 		if err != nil {
-			return nil, fmt.Errorf("invalid JSON for body, \nerror: %s, \nexample of valid JSON:\n%s", err, "'{\n      \"emails\": [\n         \"Qui architecto.\",\n         \"Quibusdam et quis repellendus voluptatem.\"\n      ]\n   }'")
+			return nil, fmt.Errorf("invalid JSON for body, \nerror: %s, \nexample of valid JSON:\n%s", err, "'{\n      \"emails\": [\n         \"Ut sint.\",\n         \"Ut repellendus minus quisquam laudantium et modi.\",\n         \"Placeat et molestias at iure.\",\n         \"Voluptatem laudantium.\"\n      ]\n   }'")
 		}
 		if body.Emails == nil {
 			err = goa.MergeErrors(err, goa.MissingFieldError("emails", "body"))
@@ -631,6 +952,141 @@ func BuildInviteGroupsioMembersPayload(mailingListInviteGroupsioMembersBody stri
 	return v, nil
 }
 
+// BuildImportGroupsioMembersPayload builds the payload for the mailing-list
+// import-groupsio-members endpoint from CLI flags.
+func BuildImportGroupsioMembersPayload(mailingListImportGroupsioMembersBody string, mailingListImportGroupsioMembersSubgroupID string, mailingListImportGroupsioMembersBearerToken string) (*mailinglist.ImportGroupsioMembersPayload, error) {
+	var err error
+	var body ImportGroupsioMembersRequestBody
+	{
+		err = json.Unmarshal([]byte(mailingListImportGroupsioMembersBody), &body)
+		if err != nil {
+			return nil, fmt.Errorf("invalid JSON for body, \nerror: %s, \nexample of valid JSON:\n%s", err, "'{\n      \"members\": [\n         {\n            \"delivery_mode\": \"email_delivery_digest\",\n            \"email\": \"hollis@goodwindurgan.org\",\n            \"job_title\": \"Dolor a nam.\",\n            \"member_type\": \"direct\",\n            \"mod_status\": \"moderator\",\n            \"name\": \"Est consequuntur quod occaecati ipsa.\",\n            \"organization\": \"Assumenda nisi occaecati dolor quia consectetur repudiandae.\"\n         },\n         {\n            \"delivery_mode\": \"email_delivery_digest\",\n            \"email\": \"hollis@goodwindurgan.org\",\n            \"job_title\": \"Dolor a nam.\",\n            \"member_type\": \"direct\",\n            \"mod_status\": \"moderator\",\n            \"name\": \"Est consequuntur quod occaecati ipsa.\",\n            \"organization\": \"Assumenda nisi occaecati dolor quia consectetur repudiandae.\"\n         }\n      ]\n   }'")
+		}
+		if body.Members == nil {
+			err = goa.MergeErrors(err, goa.MissingFieldError("members", "body"))
+		}
+		for _, e := range body.Members {
+			if e != nil {
+				if err2 := ValidateGroupsioMemberRequestRequestBody(e); err2 != nil {
+					err = goa.MergeErrors(err, err2)
+				}
+			}
+		}
+		if err != nil {
+			return nil, err
+		}
+	}
+	var subgroupID string
+	{
+		subgroupID = mailingListImportGroupsioMembersSubgroupID
+	}
+	var bearerToken *string
+	{
+		if mailingListImportGroupsioMembersBearerToken != "" {
+			bearerToken = &mailingListImportGroupsioMembersBearerToken
+		}
+	}
+	v := &mailinglist.ImportGroupsioMembersPayload{}
+	if body.Members != nil {
+		v.Members = make([]*mailinglist.GroupsioMemberRequest, len(body.Members))
+		for i, val := range body.Members {
+			v.Members[i] = marshalGroupsioMemberRequestRequestBodyToMailinglistGroupsioMemberRequest(val)
+		}
+	} else {
+		v.Members = []*mailinglist.GroupsioMemberRequest{}
+	}
+	v.SubgroupID = subgroupID
+	v.BearerToken = bearerToken
+
+	return v, nil
+}
+
+// BuildMergeGroupsioMembersPayload builds the payload for the mailing-list
+// merge-groupsio-members endpoint from CLI flags.
+func BuildMergeGroupsioMembersPayload(mailingListMergeGroupsioMembersBody string, mailingListMergeGroupsioMembersSubgroupID string, mailingListMergeGroupsioMembersBearerToken string) (*mailinglist.MergeGroupsioMembersPayload, error) {
+	var err error
+	var body MergeGroupsioMembersRequestBody
+	{
+		err = json.Unmarshal([]byte(mailingListMergeGroupsioMembersBody), &body)
+		if err != nil {
+			return nil, fmt.Errorf("invalid JSON for body, \nerror: %s, \nexample of valid JSON:\n%s", err, "'{\n      \"keep_member_id\": \"Harum cupiditate doloribus.\",\n      \"merge_member_ids\": [\n         \"Exercitationem neque voluptatibus.\"\n      ]\n   }'")
+		}
+		if body.MergeMemberIds == nil {
+			err = goa.MergeErrors(err, goa.MissingFieldError("merge_member_ids", "body"))
+		}
+		if len(body.MergeMemberIds) < 1 {
+			err = goa.MergeErrors(err, goa.InvalidLengthError("body.merge_member_ids", body.MergeMemberIds, len(body.MergeMemberIds), 1, true))
+		}
+		if err != nil {
+			return nil, err
+		}
+	}
+	var subgroupID string
+	{
+		subgroupID = mailingListMergeGroupsioMembersSubgroupID
+	}
+	var bearerToken *string
+	{
+		if mailingListMergeGroupsioMembersBearerToken != "" {
+			bearerToken = &mailingListMergeGroupsioMembersBearerToken
+		}
+	}
+	v := &mailinglist.MergeGroupsioMembersPayload{
+		KeepMemberID: body.KeepMemberID,
+	}
+	if body.MergeMemberIds != nil {
+		v.MergeMemberIds = make([]string, len(body.MergeMemberIds))
+		for i, val := range body.MergeMemberIds {
+			v.MergeMemberIds[i] = val
+		}
+	} else {
+		v.MergeMemberIds = []string{}
+	}
+	v.SubgroupID = subgroupID
+	v.BearerToken = bearerToken
+
+	return v, nil
+}
+
+// BuildChangeGroupsioMemberEmailPayload builds the payload for the
+// mailing-list change-groupsio-member-email endpoint from CLI flags.
+func BuildChangeGroupsioMemberEmailPayload(mailingListChangeGroupsioMemberEmailBody string, mailingListChangeGroupsioMemberEmailSubgroupID string, mailingListChangeGroupsioMemberEmailMemberID string, mailingListChangeGroupsioMemberEmailBearerToken string) (*mailinglist.ChangeGroupsioMemberEmailPayload, error) {
+	var err error
+	var body ChangeGroupsioMemberEmailRequestBody
+	{
+		err = json.Unmarshal([]byte(mailingListChangeGroupsioMemberEmailBody), &body)
+		if err != nil {
+			return nil, fmt.Errorf("invalid JSON for body, \nerror: %s, \nexample of valid JSON:\n%s", err, "'{\n      \"email\": \"carmelo@wizaoberbrunner.com\"\n   }'")
+		}
+		err = goa.MergeErrors(err, goa.ValidateFormat("body.email", body.Email, goa.FormatEmail))
+		if err != nil {
+			return nil, err
+		}
+	}
+	var subgroupID string
+	{
+		subgroupID = mailingListChangeGroupsioMemberEmailSubgroupID
+	}
+	var memberID string
+	{
+		memberID = mailingListChangeGroupsioMemberEmailMemberID
+	}
+	var bearerToken *string
+	{
+		if mailingListChangeGroupsioMemberEmailBearerToken != "" {
+			bearerToken = &mailingListChangeGroupsioMemberEmailBearerToken
+		}
+	}
+	v := &mailinglist.ChangeGroupsioMemberEmailPayload{
+		Email: body.Email,
+	}
+	v.SubgroupID = subgroupID
+	v.MemberID = memberID
+	v.BearerToken = bearerToken
+
+	return v, nil
+}
+
 // BuildCheckGroupsioSubscriberPayload builds the payload for the mailing-list
 // check-groupsio-subscriber endpoint from CLI flags.
 func BuildCheckGroupsioSubscriberPayload(mailingListCheckGroupsioSubscriberBody string, mailingListCheckGroupsioSubscriberBearerToken string) (*mailinglist.CheckGroupsioSubscriberPayload, error) {
@@ -639,7 +1095,7 @@ func BuildCheckGroupsioSubscriberPayload(mailingListCheckGroupsioSubscriberBody
 	{
 		err = json.Unmarshal([]byte(mailingListCheckGroupsioSubscriberBody), &body)
 		if err != nil {
-			return nil, fmt.Errorf("invalid JSON for body, \nerror: %s, \nexample of valid JSON:\n%s", err, "'{\n      \"email\": \"alysa@morar.name\",\n      \"subgroup_id\": \"Quis ab quia in inventore atque officia.\"\n   }'")
+			return nil, fmt.Errorf("invalid JSON for body, \nerror: %s, \nexample of valid JSON:\n%s", err, "'{\n      \"email\": \"asha@kassulke.com\",\n      \"subgroup_id\": \"Quas pariatur quia et aut dolores.\"\n   }'")
 		}
 		err = goa.MergeErrors(err, goa.ValidateFormat("body.email", body.Email, goa.FormatEmail))
 		if err != nil {
@@ -710,3 +1166,214 @@ func BuildGetGroupsioArtifactDownloadPayload(mailingListGetGroupsioArtifactDownl
 
 	return v, nil
 }
+
+// BuildGetGroupsioProjectSummaryPayload builds the payload for the
+// mailing-list get-groupsio-project-summary endpoint from CLI flags.
+func BuildGetGroupsioProjectSummaryPayload(mailingListGetGroupsioProjectSummaryProjectUID string, mailingListGetGroupsioProjectSummaryBearerToken string) (*mailinglist.GetGroupsioProjectSummaryPayload, error) {
+	var err error
+	var projectUID string
+	{
+		projectUID = mailingListGetGroupsioProjectSummaryProjectUID
+		err = goa.MergeErrors(err, goa.ValidateFormat("project_uid", projectUID, goa.FormatUUID))
+		if err != nil {
+			return nil, err
+		}
+	}
+	var bearerToken *string
+	{
+		if mailingListGetGroupsioProjectSummaryBearerToken != "" {
+			bearerToken = &mailingListGetGroupsioProjectSummaryBearerToken
+		}
+	}
+	v := &mailinglist.GetGroupsioProjectSummaryPayload{}
+	v.ProjectUID = projectUID
+	v.BearerToken = bearerToken
+
+	return v, nil
+}
+
+// BuildListGroupsioOrphanedMailingListsPayload builds the payload for the
+// mailing-list list-groupsio-orphaned-mailing-lists endpoint from CLI flags.
+func BuildListGroupsioOrphanedMailingListsPayload(mailingListListGroupsioOrphanedMailingListsProjectUID string, mailingListListGroupsioOrphanedMailingListsBearerToken string) (*mailinglist.ListGroupsioOrphanedMailingListsPayload, error) {
+	var err error
+	var projectUID string
+	{
+		projectUID = mailingListListGroupsioOrphanedMailingListsProjectUID
+		err = goa.MergeErrors(err, goa.ValidateFormat("project_uid", projectUID, goa.FormatUUID))
+		if err != nil {
+			return nil, err
+		}
+	}
+	var bearerToken *string
+	{
+		if mailingListListGroupsioOrphanedMailingListsBearerToken != "" {
+			bearerToken = &mailingListListGroupsioOrphanedMailingListsBearerToken
+		}
+	}
+	v := &mailinglist.ListGroupsioOrphanedMailingListsPayload{}
+	v.ProjectUID = projectUID
+	v.BearerToken = bearerToken
+
+	return v, nil
+}
+
+// BuildRepublishGroupsioProjectIndexPayload builds the payload for the
+// mailing-list republish-groupsio-project-index endpoint from CLI flags.
+func BuildRepublishGroupsioProjectIndexPayload(mailingListRepublishGroupsioProjectIndexProjectUID string, mailingListRepublishGroupsioProjectIndexBearerToken string) (*mailinglist.RepublishGroupsioProjectIndexPayload, error) {
+	var err error
+	var projectUID string
+	{
+		projectUID = mailingListRepublishGroupsioProjectIndexProjectUID
+		err = goa.MergeErrors(err, goa.ValidateFormat("project_uid", projectUID, goa.FormatUUID))
+		if err != nil {
+			return nil, err
+		}
+	}
+	var bearerToken *string
+	{
+		if mailingListRepublishGroupsioProjectIndexBearerToken != "" {
+			bearerToken = &mailingListRepublishGroupsioProjectIndexBearerToken
+		}
+	}
+	v := &mailinglist.RepublishGroupsioProjectIndexPayload{}
+	v.ProjectUID = projectUID
+	v.BearerToken = bearerToken
+
+	return v, nil
+}
+
+// BuildGetGroupsioMailingListStatsPayload builds the payload for the
+// mailing-list get-groupsio-mailing-list-stats endpoint from CLI flags.
+func BuildGetGroupsioMailingListStatsPayload(mailingListGetGroupsioMailingListStatsSubgroupID string, mailingListGetGroupsioMailingListStatsBearerToken string) (*mailinglist.GetGroupsioMailingListStatsPayload, error) {
+	var subgroupID string
+	{
+		subgroupID = mailingListGetGroupsioMailingListStatsSubgroupID
+	}
+	var bearerToken *string
+	{
+		if mailingListGetGroupsioMailingListStatsBearerToken != "" {
+			bearerToken = &mailingListGetGroupsioMailingListStatsBearerToken
+		}
+	}
+	v := &mailinglist.GetGroupsioMailingListStatsPayload{}
+	v.SubgroupID = subgroupID
+	v.BearerToken = bearerToken
+
+	return v, nil
+}
+
+// BuildGetGroupsioServiceTreePayload builds the payload for the mailing-list
+// get-groupsio-service-tree endpoint from CLI flags.
+func BuildGetGroupsioServiceTreePayload(mailingListGetGroupsioServiceTreeServiceID string, mailingListGetGroupsioServiceTreeIncludeMemberCounts string, mailingListGetGroupsioServiceTreeBearerToken string) (*mailinglist.GetGroupsioServiceTreePayload, error) {
+	var err error
+	var serviceID string
+	{
+		serviceID = mailingListGetGroupsioServiceTreeServiceID
+	}
+	var includeMemberCounts bool
+	{
+		if mailingListGetGroupsioServiceTreeIncludeMemberCounts != "" {
+			includeMemberCounts, err = strconv.ParseBool(mailingListGetGroupsioServiceTreeIncludeMemberCounts)
+			if err != nil {
+				return nil, fmt.Errorf("invalid value for includeMemberCounts, must be BOOL")
+			}
+		}
+	}
+	var bearerToken *string
+	{
+		if mailingListGetGroupsioServiceTreeBearerToken != "" {
+			bearerToken = &mailingListGetGroupsioServiceTreeBearerToken
+		}
+	}
+	v := &mailinglist.GetGroupsioServiceTreePayload{}
+	v.ServiceID = serviceID
+	v.IncludeMemberCounts = includeMemberCounts
+	v.BearerToken = bearerToken
+
+	return v, nil
+}
+
+// BuildGetGroupsioMemberHierarchyPayload builds the payload for the
+// mailing-list get-groupsio-member-hierarchy endpoint from CLI flags.
+func BuildGetGroupsioMemberHierarchyPayload(mailingListGetGroupsioMemberHierarchySubgroupID string, mailingListGetGroupsioMemberHierarchyMemberID string, mailingListGetGroupsioMemberHierarchyBearerToken string) (*mailinglist.GetGroupsioMemberHierarchyPayload, error) {
+	var subgroupID string
+	{
+		subgroupID = mailingListGetGroupsioMemberHierarchySubgroupID
+	}
+	var memberID string
+	{
+		memberID = mailingListGetGroupsioMemberHierarchyMemberID
+	}
+	var bearerToken *string
+	{
+		if mailingListGetGroupsioMemberHierarchyBearerToken != "" {
+			bearerToken = &mailingListGetGroupsioMemberHierarchyBearerToken
+		}
+	}
+	v := &mailinglist.GetGroupsioMemberHierarchyPayload{}
+	v.SubgroupID = subgroupID
+	v.MemberID = memberID
+	v.BearerToken = bearerToken
+
+	return v, nil
+}
+
+// BuildListGroupsioProjectMembersPayload builds the payload for the
+// mailing-list list-groupsio-project-members endpoint from CLI flags.
+func BuildListGroupsioProjectMembersPayload(mailingListListGroupsioProjectMembersProjectUID string, mailingListListGroupsioProjectMembersLimit string, mailingListListGroupsioProjectMembersCursor string, mailingListListGroupsioProjectMembersDedupe string, mailingListListGroupsioProjectMembersBearerToken string) (*mailinglist.ListGroupsioProjectMembersPayload, error) {
+	var err error
+	var projectUID string
+	{
+		projectUID = mailingListListGroupsioProjectMembersProjectUID
+		err = goa.MergeErrors(err, goa.ValidateFormat("project_uid", projectUID, goa.FormatUUID))
+		if err != nil {
+			return nil, err
+		}
+	}
+	var limit int
+	{
+		if mailingListListGroupsioProjectMembersLimit != "" {
+			var v int64
+			v, err = strconv.ParseInt(mailingListListGroupsioProjectMembersLimit, 10, strconv.IntSize)
+			limit = int(v)
+			if err != nil {
+				return nil, fmt.Errorf("invalid value for limit, must be INT")
+			}
+			if limit < 0 {
+				err = goa.MergeErrors(err, goa.InvalidRangeError("limit", limit, 0, true))
+			}
+			if err != nil {
+				return nil, err
+			}
+		}
+	}
+	var cursor *string
+	{
+		if mailingListListGroupsioProjectMembersCursor != "" {
+			cursor = &mailingListListGroupsioProjectMembersCursor
+		}
+	}
+	var dedupe bool
+	{
+		if mailingListListGroupsioProjectMembersDedupe != "" {
+			dedupe, err = strconv.ParseBool(mailingListListGroupsioProjectMembersDedupe)
+			if err != nil {
+				return nil, fmt.Errorf("invalid value for dedupe, must be BOOL")
+			}
+		}
+	}
+	var bearerToken *string
+	{
+		if mailingListListGroupsioProjectMembersBearerToken != "" {
+			bearerToken = &mailingListListGroupsioProjectMembersBearerToken
+		}
+	}
+	v := &mailinglist.ListGroupsioProjectMembersPayload{}
+	v.ProjectUID = projectUID
+	v.Limit = limit
+	v.Cursor = cursor
+	v.Dedupe = dedupe
+	v.BearerToken = bearerToken
+
+	return v, nil
+}
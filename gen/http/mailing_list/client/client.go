@@ -40,6 +40,10 @@ type Client struct {
 	// update-groupsio-service endpoint.
 	UpdateGroupsioServiceDoer goahttp.Doer
 
+	// PromoteGroupsioService Doer is the HTTP client used to make requests to the
+	// promote-groupsio-service endpoint.
+	PromoteGroupsioServiceDoer goahttp.Doer
+
 	// DeleteGroupsioService Doer is the HTTP client used to make requests to the
 	// delete-groupsio-service endpoint.
 	DeleteGroupsioServiceDoer goahttp.Doer
@@ -48,6 +52,14 @@ type Client struct {
 	// the get-groupsio-service-projects endpoint.
 	GetGroupsioServiceProjectsDoer goahttp.Doer
 
+	// ListGroupsioServicesByStatus Doer is the HTTP client used to make requests
+	// to the list-groupsio-services-by-status endpoint.
+	ListGroupsioServicesByStatusDoer goahttp.Doer
+
+	// ListUnprovisionedGroupsioServices Doer is the HTTP client used to make
+	// requests to the list-unprovisioned-groupsio-services endpoint.
+	ListUnprovisionedGroupsioServicesDoer goahttp.Doer
+
 	// FindParentGroupsioService Doer is the HTTP client used to make requests to
 	// the find-parent-groupsio-service endpoint.
 	FindParentGroupsioServiceDoer goahttp.Doer
@@ -56,6 +68,14 @@ type Client struct {
 	// the list-groupsio-mailing-lists endpoint.
 	ListGroupsioMailingListsDoer goahttp.Doer
 
+	// ListGroupsioMailingListsByVisibility Doer is the HTTP client used to make
+	// requests to the list-groupsio-mailing-lists-by-visibility endpoint.
+	ListGroupsioMailingListsByVisibilityDoer goahttp.Doer
+
+	// ListGroupsioMailingListsUpdatedSince Doer is the HTTP client used to make
+	// requests to the list-groupsio-mailing-lists-updated-since endpoint.
+	ListGroupsioMailingListsUpdatedSinceDoer goahttp.Doer
+
 	// CreateGroupsioMailingList Doer is the HTTP client used to make requests to
 	// the create-groupsio-mailing-list endpoint.
 	CreateGroupsioMailingListDoer goahttp.Doer
@@ -84,10 +104,34 @@ type Client struct {
 	// list-groupsio-members endpoint.
 	ListGroupsioMembersDoer goahttp.Doer
 
+	// ListGroupsioMembersNeedingReview Doer is the HTTP client used to make
+	// requests to the list-groupsio-members-needing-review endpoint.
+	ListGroupsioMembersNeedingReviewDoer goahttp.Doer
+
+	// ListGroupsioMembersModifiedSince Doer is the HTTP client used to make
+	// requests to the list-groupsio-members-modified-since endpoint.
+	ListGroupsioMembersModifiedSinceDoer goahttp.Doer
+
+	// ListGroupsioMembersByOrganization Doer is the HTTP client used to make
+	// requests to the list-groupsio-members-by-organization endpoint.
+	ListGroupsioMembersByOrganizationDoer goahttp.Doer
+
+	// ListGroupsioDuplicateMembers Doer is the HTTP client used to make requests
+	// to the list-groupsio-duplicate-members endpoint.
+	ListGroupsioDuplicateMembersDoer goahttp.Doer
+
+	// PreviewGroupsioDeliveryModeChange Doer is the HTTP client used to make
+	// requests to the preview-groupsio-delivery-mode-change endpoint.
+	PreviewGroupsioDeliveryModeChangeDoer goahttp.Doer
+
 	// AddGroupsioMember Doer is the HTTP client used to make requests to the
 	// add-groupsio-member endpoint.
 	AddGroupsioMemberDoer goahttp.Doer
 
+	// UpsertGroupsioMember Doer is the HTTP client used to make requests to the
+	// upsert-groupsio-member endpoint.
+	UpsertGroupsioMemberDoer goahttp.Doer
+
 	// GetGroupsioMember Doer is the HTTP client used to make requests to the
 	// get-groupsio-member endpoint.
 	GetGroupsioMemberDoer goahttp.Doer
@@ -104,6 +148,18 @@ type Client struct {
 	// invite-groupsio-members endpoint.
 	InviteGroupsioMembersDoer goahttp.Doer
 
+	// ImportGroupsioMembers Doer is the HTTP client used to make requests to the
+	// import-groupsio-members endpoint.
+	ImportGroupsioMembersDoer goahttp.Doer
+
+	// MergeGroupsioMembers Doer is the HTTP client used to make requests to the
+	// merge-groupsio-members endpoint.
+	MergeGroupsioMembersDoer goahttp.Doer
+
+	// ChangeGroupsioMemberEmail Doer is the HTTP client used to make requests to
+	// the change-groupsio-member-email endpoint.
+	ChangeGroupsioMemberEmailDoer goahttp.Doer
+
 	// CheckGroupsioSubscriber Doer is the HTTP client used to make requests to the
 	// check-groupsio-subscriber endpoint.
 	CheckGroupsioSubscriberDoer goahttp.Doer
@@ -116,6 +172,34 @@ type Client struct {
 	// the get-groupsio-artifact-download endpoint.
 	GetGroupsioArtifactDownloadDoer goahttp.Doer
 
+	// GetGroupsioProjectSummary Doer is the HTTP client used to make requests to
+	// the get-groupsio-project-summary endpoint.
+	GetGroupsioProjectSummaryDoer goahttp.Doer
+
+	// ListGroupsioOrphanedMailingLists Doer is the HTTP client used to make
+	// requests to the list-groupsio-orphaned-mailing-lists endpoint.
+	ListGroupsioOrphanedMailingListsDoer goahttp.Doer
+
+	// RepublishGroupsioProjectIndex Doer is the HTTP client used to make requests
+	// to the republish-groupsio-project-index endpoint.
+	RepublishGroupsioProjectIndexDoer goahttp.Doer
+
+	// GetGroupsioMailingListStats Doer is the HTTP client used to make requests to
+	// the get-groupsio-mailing-list-stats endpoint.
+	GetGroupsioMailingListStatsDoer goahttp.Doer
+
+	// GetGroupsioServiceTree Doer is the HTTP client used to make requests to the
+	// get-groupsio-service-tree endpoint.
+	GetGroupsioServiceTreeDoer goahttp.Doer
+
+	// GetGroupsioMemberHierarchy Doer is the HTTP client used to make requests to
+	// the get-groupsio-member-hierarchy endpoint.
+	GetGroupsioMemberHierarchyDoer goahttp.Doer
+
+	// ListGroupsioProjectMembers Doer is the HTTP client used to make requests to
+	// the list-groupsio-project-members endpoint.
+	ListGroupsioProjectMembersDoer goahttp.Doer
+
 	// RestoreResponseBody controls whether the response bodies are reset after
 	// decoding so they can be read again.
 	RestoreResponseBody bool
@@ -136,36 +220,57 @@ func NewClient(
 	restoreBody bool,
 ) *Client {
 	return &Client{
-		LivezDoer:                             doer,
-		ReadyzDoer:                            doer,
-		ListGroupsioServicesDoer:              doer,
-		CreateGroupsioServiceDoer:             doer,
-		GetGroupsioServiceDoer:                doer,
-		UpdateGroupsioServiceDoer:             doer,
-		DeleteGroupsioServiceDoer:             doer,
-		GetGroupsioServiceProjectsDoer:        doer,
-		FindParentGroupsioServiceDoer:         doer,
-		ListGroupsioMailingListsDoer:          doer,
-		CreateGroupsioMailingListDoer:         doer,
-		GetGroupsioMailingListDoer:            doer,
-		UpdateGroupsioMailingListDoer:         doer,
-		DeleteGroupsioMailingListDoer:         doer,
-		GetGroupsioMailingListCountDoer:       doer,
-		GetGroupsioMailingListMemberCountDoer: doer,
-		ListGroupsioMembersDoer:               doer,
-		AddGroupsioMemberDoer:                 doer,
-		GetGroupsioMemberDoer:                 doer,
-		UpdateGroupsioMemberDoer:              doer,
-		DeleteGroupsioMemberDoer:              doer,
-		InviteGroupsioMembersDoer:             doer,
-		CheckGroupsioSubscriberDoer:           doer,
-		GetGroupsioArtifactDoer:               doer,
-		GetGroupsioArtifactDownloadDoer:       doer,
-		RestoreResponseBody:                   restoreBody,
-		scheme:                                scheme,
-		host:                                  host,
-		decoder:                               dec,
-		encoder:                               enc,
+		LivezDoer:                                doer,
+		ReadyzDoer:                               doer,
+		ListGroupsioServicesDoer:                 doer,
+		CreateGroupsioServiceDoer:                doer,
+		GetGroupsioServiceDoer:                   doer,
+		UpdateGroupsioServiceDoer:                doer,
+		PromoteGroupsioServiceDoer:               doer,
+		DeleteGroupsioServiceDoer:                doer,
+		GetGroupsioServiceProjectsDoer:           doer,
+		ListGroupsioServicesByStatusDoer:         doer,
+		ListUnprovisionedGroupsioServicesDoer:    doer,
+		FindParentGroupsioServiceDoer:            doer,
+		ListGroupsioMailingListsDoer:             doer,
+		ListGroupsioMailingListsByVisibilityDoer: doer,
+		ListGroupsioMailingListsUpdatedSinceDoer: doer,
+		CreateGroupsioMailingListDoer:            doer,
+		GetGroupsioMailingListDoer:               doer,
+		UpdateGroupsioMailingListDoer:            doer,
+		DeleteGroupsioMailingListDoer:            doer,
+		GetGroupsioMailingListCountDoer:          doer,
+		GetGroupsioMailingListMemberCountDoer:    doer,
+		ListGroupsioMembersDoer:                  doer,
+		ListGroupsioMembersNeedingReviewDoer:     doer,
+		ListGroupsioMembersModifiedSinceDoer:     doer,
+		ListGroupsioMembersByOrganizationDoer:    doer,
+		ListGroupsioDuplicateMembersDoer:         doer,
+		PreviewGroupsioDeliveryModeChangeDoer:    doer,
+		AddGroupsioMemberDoer:                    doer,
+		UpsertGroupsioMemberDoer:                 doer,
+		GetGroupsioMemberDoer:                    doer,
+		UpdateGroupsioMemberDoer:                 doer,
+		DeleteGroupsioMemberDoer:                 doer,
+		InviteGroupsioMembersDoer:                doer,
+		ImportGroupsioMembersDoer:                doer,
+		MergeGroupsioMembersDoer:                 doer,
+		ChangeGroupsioMemberEmailDoer:            doer,
+		CheckGroupsioSubscriberDoer:              doer,
+		GetGroupsioArtifactDoer:                  doer,
+		GetGroupsioArtifactDownloadDoer:          doer,
+		GetGroupsioProjectSummaryDoer:            doer,
+		ListGroupsioOrphanedMailingListsDoer:     doer,
+		RepublishGroupsioProjectIndexDoer:        doer,
+		GetGroupsioMailingListStatsDoer:          doer,
+		GetGroupsioServiceTreeDoer:               doer,
+		GetGroupsioMemberHierarchyDoer:           doer,
+		ListGroupsioProjectMembersDoer:           doer,
+		RestoreResponseBody:                      restoreBody,
+		scheme:                                   scheme,
+		host:                                     host,
+		decoder:                                  dec,
+		encoder:                                  enc,
 	}
 }
 
@@ -303,6 +408,30 @@ func (c *Client) UpdateGroupsioService() goa.Endpoint {
 	}
 }
 
+// PromoteGroupsioService returns an endpoint that makes HTTP requests to the
+// mailing-list service promote-groupsio-service server.
+func (c *Client) PromoteGroupsioService() goa.Endpoint {
+	var (
+		encodeRequest  = EncodePromoteGroupsioServiceRequest(c.encoder)
+		decodeResponse = DecodePromoteGroupsioServiceResponse(c.decoder, c.RestoreResponseBody)
+	)
+	return func(ctx context.Context, v any) (any, error) {
+		req, err := c.BuildPromoteGroupsioServiceRequest(ctx, v)
+		if err != nil {
+			return nil, err
+		}
+		err = encodeRequest(req, v)
+		if err != nil {
+			return nil, err
+		}
+		resp, err := c.PromoteGroupsioServiceDoer.Do(req)
+		if err != nil {
+			return nil, goahttp.ErrRequestError("mailing-list", "promote-groupsio-service", err)
+		}
+		return decodeResponse(resp)
+	}
+}
+
 // DeleteGroupsioService returns an endpoint that makes HTTP requests to the
 // mailing-list service delete-groupsio-service server.
 func (c *Client) DeleteGroupsioService() goa.Endpoint {
@@ -351,6 +480,55 @@ func (c *Client) GetGroupsioServiceProjects() goa.Endpoint {
 	}
 }
 
+// ListGroupsioServicesByStatus returns an endpoint that makes HTTP requests to
+// the mailing-list service list-groupsio-services-by-status server.
+func (c *Client) ListGroupsioServicesByStatus() goa.Endpoint {
+	var (
+		encodeRequest  = EncodeListGroupsioServicesByStatusRequest(c.encoder)
+		decodeResponse = DecodeListGroupsioServicesByStatusResponse(c.decoder, c.RestoreResponseBody)
+	)
+	return func(ctx context.Context, v any) (any, error) {
+		req, err := c.BuildListGroupsioServicesByStatusRequest(ctx, v)
+		if err != nil {
+			return nil, err
+		}
+		err = encodeRequest(req, v)
+		if err != nil {
+			return nil, err
+		}
+		resp, err := c.ListGroupsioServicesByStatusDoer.Do(req)
+		if err != nil {
+			return nil, goahttp.ErrRequestError("mailing-list", "list-groupsio-services-by-status", err)
+		}
+		return decodeResponse(resp)
+	}
+}
+
+// ListUnprovisionedGroupsioServices returns an endpoint that makes HTTP
+// requests to the mailing-list service list-unprovisioned-groupsio-services
+// server.
+func (c *Client) ListUnprovisionedGroupsioServices() goa.Endpoint {
+	var (
+		encodeRequest  = EncodeListUnprovisionedGroupsioServicesRequest(c.encoder)
+		decodeResponse = DecodeListUnprovisionedGroupsioServicesResponse(c.decoder, c.RestoreResponseBody)
+	)
+	return func(ctx context.Context, v any) (any, error) {
+		req, err := c.BuildListUnprovisionedGroupsioServicesRequest(ctx, v)
+		if err != nil {
+			return nil, err
+		}
+		err = encodeRequest(req, v)
+		if err != nil {
+			return nil, err
+		}
+		resp, err := c.ListUnprovisionedGroupsioServicesDoer.Do(req)
+		if err != nil {
+			return nil, goahttp.ErrRequestError("mailing-list", "list-unprovisioned-groupsio-services", err)
+		}
+		return decodeResponse(resp)
+	}
+}
+
 // FindParentGroupsioService returns an endpoint that makes HTTP requests to
 // the mailing-list service find-parent-groupsio-service server.
 func (c *Client) FindParentGroupsioService() goa.Endpoint {
@@ -399,6 +577,56 @@ func (c *Client) ListGroupsioMailingLists() goa.Endpoint {
 	}
 }
 
+// ListGroupsioMailingListsByVisibility returns an endpoint that makes HTTP
+// requests to the mailing-list service
+// list-groupsio-mailing-lists-by-visibility server.
+func (c *Client) ListGroupsioMailingListsByVisibility() goa.Endpoint {
+	var (
+		encodeRequest  = EncodeListGroupsioMailingListsByVisibilityRequest(c.encoder)
+		decodeResponse = DecodeListGroupsioMailingListsByVisibilityResponse(c.decoder, c.RestoreResponseBody)
+	)
+	return func(ctx context.Context, v any) (any, error) {
+		req, err := c.BuildListGroupsioMailingListsByVisibilityRequest(ctx, v)
+		if err != nil {
+			return nil, err
+		}
+		err = encodeRequest(req, v)
+		if err != nil {
+			return nil, err
+		}
+		resp, err := c.ListGroupsioMailingListsByVisibilityDoer.Do(req)
+		if err != nil {
+			return nil, goahttp.ErrRequestError("mailing-list", "list-groupsio-mailing-lists-by-visibility", err)
+		}
+		return decodeResponse(resp)
+	}
+}
+
+// ListGroupsioMailingListsUpdatedSince returns an endpoint that makes HTTP
+// requests to the mailing-list service
+// list-groupsio-mailing-lists-updated-since server.
+func (c *Client) ListGroupsioMailingListsUpdatedSince() goa.Endpoint {
+	var (
+		encodeRequest  = EncodeListGroupsioMailingListsUpdatedSinceRequest(c.encoder)
+		decodeResponse = DecodeListGroupsioMailingListsUpdatedSinceResponse(c.decoder, c.RestoreResponseBody)
+	)
+	return func(ctx context.Context, v any) (any, error) {
+		req, err := c.BuildListGroupsioMailingListsUpdatedSinceRequest(ctx, v)
+		if err != nil {
+			return nil, err
+		}
+		err = encodeRequest(req, v)
+		if err != nil {
+			return nil, err
+		}
+		resp, err := c.ListGroupsioMailingListsUpdatedSinceDoer.Do(req)
+		if err != nil {
+			return nil, goahttp.ErrRequestError("mailing-list", "list-groupsio-mailing-lists-updated-since", err)
+		}
+		return decodeResponse(resp)
+	}
+}
+
 // CreateGroupsioMailingList returns an endpoint that makes HTTP requests to
 // the mailing-list service create-groupsio-mailing-list server.
 func (c *Client) CreateGroupsioMailingList() goa.Endpoint {
@@ -568,6 +796,130 @@ func (c *Client) ListGroupsioMembers() goa.Endpoint {
 	}
 }
 
+// ListGroupsioMembersNeedingReview returns an endpoint that makes HTTP
+// requests to the mailing-list service list-groupsio-members-needing-review
+// server.
+func (c *Client) ListGroupsioMembersNeedingReview() goa.Endpoint {
+	var (
+		encodeRequest  = EncodeListGroupsioMembersNeedingReviewRequest(c.encoder)
+		decodeResponse = DecodeListGroupsioMembersNeedingReviewResponse(c.decoder, c.RestoreResponseBody)
+	)
+	return func(ctx context.Context, v any) (any, error) {
+		req, err := c.BuildListGroupsioMembersNeedingReviewRequest(ctx, v)
+		if err != nil {
+			return nil, err
+		}
+		err = encodeRequest(req, v)
+		if err != nil {
+			return nil, err
+		}
+		resp, err := c.ListGroupsioMembersNeedingReviewDoer.Do(req)
+		if err != nil {
+			return nil, goahttp.ErrRequestError("mailing-list", "list-groupsio-members-needing-review", err)
+		}
+		return decodeResponse(resp)
+	}
+}
+
+// ListGroupsioMembersModifiedSince returns an endpoint that makes HTTP
+// requests to the mailing-list service list-groupsio-members-modified-since
+// server.
+func (c *Client) ListGroupsioMembersModifiedSince() goa.Endpoint {
+	var (
+		encodeRequest  = EncodeListGroupsioMembersModifiedSinceRequest(c.encoder)
+		decodeResponse = DecodeListGroupsioMembersModifiedSinceResponse(c.decoder, c.RestoreResponseBody)
+	)
+	return func(ctx context.Context, v any) (any, error) {
+		req, err := c.BuildListGroupsioMembersModifiedSinceRequest(ctx, v)
+		if err != nil {
+			return nil, err
+		}
+		err = encodeRequest(req, v)
+		if err != nil {
+			return nil, err
+		}
+		resp, err := c.ListGroupsioMembersModifiedSinceDoer.Do(req)
+		if err != nil {
+			return nil, goahttp.ErrRequestError("mailing-list", "list-groupsio-members-modified-since", err)
+		}
+		return decodeResponse(resp)
+	}
+}
+
+// ListGroupsioMembersByOrganization returns an endpoint that makes HTTP
+// requests to the mailing-list service list-groupsio-members-by-organization
+// server.
+func (c *Client) ListGroupsioMembersByOrganization() goa.Endpoint {
+	var (
+		encodeRequest  = EncodeListGroupsioMembersByOrganizationRequest(c.encoder)
+		decodeResponse = DecodeListGroupsioMembersByOrganizationResponse(c.decoder, c.RestoreResponseBody)
+	)
+	return func(ctx context.Context, v any) (any, error) {
+		req, err := c.BuildListGroupsioMembersByOrganizationRequest(ctx, v)
+		if err != nil {
+			return nil, err
+		}
+		err = encodeRequest(req, v)
+		if err != nil {
+			return nil, err
+		}
+		resp, err := c.ListGroupsioMembersByOrganizationDoer.Do(req)
+		if err != nil {
+			return nil, goahttp.ErrRequestError("mailing-list", "list-groupsio-members-by-organization", err)
+		}
+		return decodeResponse(resp)
+	}
+}
+
+// ListGroupsioDuplicateMembers returns an endpoint that makes HTTP requests to
+// the mailing-list service list-groupsio-duplicate-members server.
+func (c *Client) ListGroupsioDuplicateMembers() goa.Endpoint {
+	var (
+		encodeRequest  = EncodeListGroupsioDuplicateMembersRequest(c.encoder)
+		decodeResponse = DecodeListGroupsioDuplicateMembersResponse(c.decoder, c.RestoreResponseBody)
+	)
+	return func(ctx context.Context, v any) (any, error) {
+		req, err := c.BuildListGroupsioDuplicateMembersRequest(ctx, v)
+		if err != nil {
+			return nil, err
+		}
+		err = encodeRequest(req, v)
+		if err != nil {
+			return nil, err
+		}
+		resp, err := c.ListGroupsioDuplicateMembersDoer.Do(req)
+		if err != nil {
+			return nil, goahttp.ErrRequestError("mailing-list", "list-groupsio-duplicate-members", err)
+		}
+		return decodeResponse(resp)
+	}
+}
+
+// PreviewGroupsioDeliveryModeChange returns an endpoint that makes HTTP
+// requests to the mailing-list service preview-groupsio-delivery-mode-change
+// server.
+func (c *Client) PreviewGroupsioDeliveryModeChange() goa.Endpoint {
+	var (
+		encodeRequest  = EncodePreviewGroupsioDeliveryModeChangeRequest(c.encoder)
+		decodeResponse = DecodePreviewGroupsioDeliveryModeChangeResponse(c.decoder, c.RestoreResponseBody)
+	)
+	return func(ctx context.Context, v any) (any, error) {
+		req, err := c.BuildPreviewGroupsioDeliveryModeChangeRequest(ctx, v)
+		if err != nil {
+			return nil, err
+		}
+		err = encodeRequest(req, v)
+		if err != nil {
+			return nil, err
+		}
+		resp, err := c.PreviewGroupsioDeliveryModeChangeDoer.Do(req)
+		if err != nil {
+			return nil, goahttp.ErrRequestError("mailing-list", "preview-groupsio-delivery-mode-change", err)
+		}
+		return decodeResponse(resp)
+	}
+}
+
 // AddGroupsioMember returns an endpoint that makes HTTP requests to the
 // mailing-list service add-groupsio-member server.
 func (c *Client) AddGroupsioMember() goa.Endpoint {
@@ -592,6 +944,30 @@ func (c *Client) AddGroupsioMember() goa.Endpoint {
 	}
 }
 
+// UpsertGroupsioMember returns an endpoint that makes HTTP requests to the
+// mailing-list service upsert-groupsio-member server.
+func (c *Client) UpsertGroupsioMember() goa.Endpoint {
+	var (
+		encodeRequest  = EncodeUpsertGroupsioMemberRequest(c.encoder)
+		decodeResponse = DecodeUpsertGroupsioMemberResponse(c.decoder, c.RestoreResponseBody)
+	)
+	return func(ctx context.Context, v any) (any, error) {
+		req, err := c.BuildUpsertGroupsioMemberRequest(ctx, v)
+		if err != nil {
+			return nil, err
+		}
+		err = encodeRequest(req, v)
+		if err != nil {
+			return nil, err
+		}
+		resp, err := c.UpsertGroupsioMemberDoer.Do(req)
+		if err != nil {
+			return nil, goahttp.ErrRequestError("mailing-list", "upsert-groupsio-member", err)
+		}
+		return decodeResponse(resp)
+	}
+}
+
 // GetGroupsioMember returns an endpoint that makes HTTP requests to the
 // mailing-list service get-groupsio-member server.
 func (c *Client) GetGroupsioMember() goa.Endpoint {
@@ -688,6 +1064,78 @@ func (c *Client) InviteGroupsioMembers() goa.Endpoint {
 	}
 }
 
+// ImportGroupsioMembers returns an endpoint that makes HTTP requests to the
+// mailing-list service import-groupsio-members server.
+func (c *Client) ImportGroupsioMembers() goa.Endpoint {
+	var (
+		encodeRequest  = EncodeImportGroupsioMembersRequest(c.encoder)
+		decodeResponse = DecodeImportGroupsioMembersResponse(c.decoder, c.RestoreResponseBody)
+	)
+	return func(ctx context.Context, v any) (any, error) {
+		req, err := c.BuildImportGroupsioMembersRequest(ctx, v)
+		if err != nil {
+			return nil, err
+		}
+		err = encodeRequest(req, v)
+		if err != nil {
+			return nil, err
+		}
+		resp, err := c.ImportGroupsioMembersDoer.Do(req)
+		if err != nil {
+			return nil, goahttp.ErrRequestError("mailing-list", "import-groupsio-members", err)
+		}
+		return decodeResponse(resp)
+	}
+}
+
+// MergeGroupsioMembers returns an endpoint that makes HTTP requests to the
+// mailing-list service merge-groupsio-members server.
+func (c *Client) MergeGroupsioMembers() goa.Endpoint {
+	var (
+		encodeRequest  = EncodeMergeGroupsioMembersRequest(c.encoder)
+		decodeResponse = DecodeMergeGroupsioMembersResponse(c.decoder, c.RestoreResponseBody)
+	)
+	return func(ctx context.Context, v any) (any, error) {
+		req, err := c.BuildMergeGroupsioMembersRequest(ctx, v)
+		if err != nil {
+			return nil, err
+		}
+		err = encodeRequest(req, v)
+		if err != nil {
+			return nil, err
+		}
+		resp, err := c.MergeGroupsioMembersDoer.Do(req)
+		if err != nil {
+			return nil, goahttp.ErrRequestError("mailing-list", "merge-groupsio-members", err)
+		}
+		return decodeResponse(resp)
+	}
+}
+
+// ChangeGroupsioMemberEmail returns an endpoint that makes HTTP requests to
+// the mailing-list service change-groupsio-member-email server.
+func (c *Client) ChangeGroupsioMemberEmail() goa.Endpoint {
+	var (
+		encodeRequest  = EncodeChangeGroupsioMemberEmailRequest(c.encoder)
+		decodeResponse = DecodeChangeGroupsioMemberEmailResponse(c.decoder, c.RestoreResponseBody)
+	)
+	return func(ctx context.Context, v any) (any, error) {
+		req, err := c.BuildChangeGroupsioMemberEmailRequest(ctx, v)
+		if err != nil {
+			return nil, err
+		}
+		err = encodeRequest(req, v)
+		if err != nil {
+			return nil, err
+		}
+		resp, err := c.ChangeGroupsioMemberEmailDoer.Do(req)
+		if err != nil {
+			return nil, goahttp.ErrRequestError("mailing-list", "change-groupsio-member-email", err)
+		}
+		return decodeResponse(resp)
+	}
+}
+
 // CheckGroupsioSubscriber returns an endpoint that makes HTTP requests to the
 // mailing-list service check-groupsio-subscriber server.
 func (c *Client) CheckGroupsioSubscriber() goa.Endpoint {
@@ -759,3 +1207,172 @@ func (c *Client) GetGroupsioArtifactDownload() goa.Endpoint {
 		return decodeResponse(resp)
 	}
 }
+
+// GetGroupsioProjectSummary returns an endpoint that makes HTTP requests to
+// the mailing-list service get-groupsio-project-summary server.
+func (c *Client) GetGroupsioProjectSummary() goa.Endpoint {
+	var (
+		encodeRequest  = EncodeGetGroupsioProjectSummaryRequest(c.encoder)
+		decodeResponse = DecodeGetGroupsioProjectSummaryResponse(c.decoder, c.RestoreResponseBody)
+	)
+	return func(ctx context.Context, v any) (any, error) {
+		req, err := c.BuildGetGroupsioProjectSummaryRequest(ctx, v)
+		if err != nil {
+			return nil, err
+		}
+		err = encodeRequest(req, v)
+		if err != nil {
+			return nil, err
+		}
+		resp, err := c.GetGroupsioProjectSummaryDoer.Do(req)
+		if err != nil {
+			return nil, goahttp.ErrRequestError("mailing-list", "get-groupsio-project-summary", err)
+		}
+		return decodeResponse(resp)
+	}
+}
+
+// ListGroupsioOrphanedMailingLists returns an endpoint that makes HTTP
+// requests to the mailing-list service list-groupsio-orphaned-mailing-lists
+// server.
+func (c *Client) ListGroupsioOrphanedMailingLists() goa.Endpoint {
+	var (
+		encodeRequest  = EncodeListGroupsioOrphanedMailingListsRequest(c.encoder)
+		decodeResponse = DecodeListGroupsioOrphanedMailingListsResponse(c.decoder, c.RestoreResponseBody)
+	)
+	return func(ctx context.Context, v any) (any, error) {
+		req, err := c.BuildListGroupsioOrphanedMailingListsRequest(ctx, v)
+		if err != nil {
+			return nil, err
+		}
+		err = encodeRequest(req, v)
+		if err != nil {
+			return nil, err
+		}
+		resp, err := c.ListGroupsioOrphanedMailingListsDoer.Do(req)
+		if err != nil {
+			return nil, goahttp.ErrRequestError("mailing-list", "list-groupsio-orphaned-mailing-lists", err)
+		}
+		return decodeResponse(resp)
+	}
+}
+
+// RepublishGroupsioProjectIndex returns an endpoint that makes HTTP requests
+// to the mailing-list service republish-groupsio-project-index server.
+func (c *Client) RepublishGroupsioProjectIndex() goa.Endpoint {
+	var (
+		encodeRequest  = EncodeRepublishGroupsioProjectIndexRequest(c.encoder)
+		decodeResponse = DecodeRepublishGroupsioProjectIndexResponse(c.decoder, c.RestoreResponseBody)
+	)
+	return func(ctx context.Context, v any) (any, error) {
+		req, err := c.BuildRepublishGroupsioProjectIndexRequest(ctx, v)
+		if err != nil {
+			return nil, err
+		}
+		err = encodeRequest(req, v)
+		if err != nil {
+			return nil, err
+		}
+		resp, err := c.RepublishGroupsioProjectIndexDoer.Do(req)
+		if err != nil {
+			return nil, goahttp.ErrRequestError("mailing-list", "republish-groupsio-project-index", err)
+		}
+		return decodeResponse(resp)
+	}
+}
+
+// GetGroupsioMailingListStats returns an endpoint that makes HTTP requests to
+// the mailing-list service get-groupsio-mailing-list-stats server.
+func (c *Client) GetGroupsioMailingListStats() goa.Endpoint {
+	var (
+		encodeRequest  = EncodeGetGroupsioMailingListStatsRequest(c.encoder)
+		decodeResponse = DecodeGetGroupsioMailingListStatsResponse(c.decoder, c.RestoreResponseBody)
+	)
+	return func(ctx context.Context, v any) (any, error) {
+		req, err := c.BuildGetGroupsioMailingListStatsRequest(ctx, v)
+		if err != nil {
+			return nil, err
+		}
+		err = encodeRequest(req, v)
+		if err != nil {
+			return nil, err
+		}
+		resp, err := c.GetGroupsioMailingListStatsDoer.Do(req)
+		if err != nil {
+			return nil, goahttp.ErrRequestError("mailing-list", "get-groupsio-mailing-list-stats", err)
+		}
+		return decodeResponse(resp)
+	}
+}
+
+// GetGroupsioServiceTree returns an endpoint that makes HTTP requests to the
+// mailing-list service get-groupsio-service-tree server.
+func (c *Client) GetGroupsioServiceTree() goa.Endpoint {
+	var (
+		encodeRequest  = EncodeGetGroupsioServiceTreeRequest(c.encoder)
+		decodeResponse = DecodeGetGroupsioServiceTreeResponse(c.decoder, c.RestoreResponseBody)
+	)
+	return func(ctx context.Context, v any) (any, error) {
+		req, err := c.BuildGetGroupsioServiceTreeRequest(ctx, v)
+		if err != nil {
+			return nil, err
+		}
+		err = encodeRequest(req, v)
+		if err != nil {
+			return nil, err
+		}
+		resp, err := c.GetGroupsioServiceTreeDoer.Do(req)
+		if err != nil {
+			return nil, goahttp.ErrRequestError("mailing-list", "get-groupsio-service-tree", err)
+		}
+		return decodeResponse(resp)
+	}
+}
+
+// GetGroupsioMemberHierarchy returns an endpoint that makes HTTP requests to
+// the mailing-list service get-groupsio-member-hierarchy server.
+func (c *Client) GetGroupsioMemberHierarchy() goa.Endpoint {
+	var (
+		encodeRequest  = EncodeGetGroupsioMemberHierarchyRequest(c.encoder)
+		decodeResponse = DecodeGetGroupsioMemberHierarchyResponse(c.decoder, c.RestoreResponseBody)
+	)
+	return func(ctx context.Context, v any) (any, error) {
+		req, err := c.BuildGetGroupsioMemberHierarchyRequest(ctx, v)
+		if err != nil {
+			return nil, err
+		}
+		err = encodeRequest(req, v)
+		if err != nil {
+			return nil, err
+		}
+		resp, err := c.GetGroupsioMemberHierarchyDoer.Do(req)
+		if err != nil {
+			return nil, goahttp.ErrRequestError("mailing-list", "get-groupsio-member-hierarchy", err)
+		}
+		return decodeResponse(resp)
+	}
+}
+
+// ListGroupsioProjectMembers returns an endpoint that makes HTTP requests to
+// the mailing-list service list-groupsio-project-members server.
+func (c *Client) ListGroupsioProjectMembers() goa.Endpoint {
+	var (
+		encodeRequest  = EncodeListGroupsioProjectMembersRequest(c.encoder)
+		decodeResponse = DecodeListGroupsioProjectMembersResponse(c.decoder, c.RestoreResponseBody)
+	)
+	return func(ctx context.Context, v any) (any, error) {
+		req, err := c.BuildListGroupsioProjectMembersRequest(ctx, v)
+		if err != nil {
+			return nil, err
+		}
+		err = encodeRequest(req, v)
+		if err != nil {
+			return nil, err
+		}
+		resp, err := c.ListGroupsioProjectMembersDoer.Do(req)
+		if err != nil {
+			return nil, goahttp.ErrRequestError("mailing-list", "list-groupsio-project-members", err)
+		}
+		return decodeResponse(resp)
+	}
+}
@@ -11,6 +11,7 @@ package client
 import (
 	"bytes"
 	"context"
+	"fmt"
 	"io"
 	"net/http"
 	"net/url"
@@ -108,14 +109,19 @@ func DecodeReadyzResponse(decoder func(*http.Response) goahttp.Decoder, restoreB
 		switch resp.StatusCode {
 		case http.StatusOK:
 			var (
-				body []byte
+				body ReadyzResponseBody
 				err  error
 			)
 			err = decoder(resp).Decode(&body)
 			if err != nil {
 				return nil, goahttp.ErrDecodingError("mailing-list", "readyz", err)
 			}
-			return body, nil
+			err = ValidateReadyzResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("mailing-list", "readyz", err)
+			}
+			res := NewReadyzReadinessOK(&body)
+			return res, nil
 		case http.StatusServiceUnavailable:
 			var (
 				body ReadyzServiceUnavailableResponseBody
@@ -692,6 +698,169 @@ func DecodeUpdateGroupsioServiceResponse(decoder func(*http.Response) goahttp.De
 	}
 }
 
+// BuildPromoteGroupsioServiceRequest instantiates a HTTP request object with
+// method and path set to call the "mailing-list" service
+// "promote-groupsio-service" endpoint
+func (c *Client) BuildPromoteGroupsioServiceRequest(ctx context.Context, v any) (*http.Request, error) {
+	var (
+		serviceID string
+	)
+	{
+		p, ok := v.(*mailinglist.PromoteGroupsioServicePayload)
+		if !ok {
+			return nil, goahttp.ErrInvalidType("mailing-list", "promote-groupsio-service", "*mailinglist.PromoteGroupsioServicePayload", v)
+		}
+		serviceID = p.ServiceID
+	}
+	u := &url.URL{Scheme: c.scheme, Host: c.host, Path: PromoteGroupsioServiceMailingListPath(serviceID)}
+	req, err := http.NewRequest("POST", u.String(), nil)
+	if err != nil {
+		return nil, goahttp.ErrInvalidURL("mailing-list", "promote-groupsio-service", u.String(), err)
+	}
+	if ctx != nil {
+		req = req.WithContext(ctx)
+	}
+
+	return req, nil
+}
+
+// EncodePromoteGroupsioServiceRequest returns an encoder for requests sent to
+// the mailing-list promote-groupsio-service server.
+func EncodePromoteGroupsioServiceRequest(encoder func(*http.Request) goahttp.Encoder) func(*http.Request, any) error {
+	return func(req *http.Request, v any) error {
+		p, ok := v.(*mailinglist.PromoteGroupsioServicePayload)
+		if !ok {
+			return goahttp.ErrInvalidType("mailing-list", "promote-groupsio-service", "*mailinglist.PromoteGroupsioServicePayload", v)
+		}
+		if p.BearerToken != nil {
+			head := *p.BearerToken
+			if !strings.Contains(head, " ") {
+				req.Header.Set("Authorization", "Bearer "+head)
+			} else {
+				req.Header.Set("Authorization", head)
+			}
+		}
+		return nil
+	}
+}
+
+// DecodePromoteGroupsioServiceResponse returns a decoder for responses
+// returned by the mailing-list promote-groupsio-service endpoint. restoreBody
+// controls whether the response body should be restored after having been read.
+// DecodePromoteGroupsioServiceResponse may return the following errors:
+//   - "BadRequest" (type *mailinglist.BadRequestError): http.StatusBadRequest
+//   - "Conflict" (type *mailinglist.ConflictError): http.StatusConflict
+//   - "InternalServerError" (type *mailinglist.InternalServerError): http.StatusInternalServerError
+//   - "NotFound" (type *mailinglist.NotFoundError): http.StatusNotFound
+//   - "ServiceUnavailable" (type *mailinglist.ServiceUnavailableError): http.StatusServiceUnavailable
+//   - error: internal error
+func DecodePromoteGroupsioServiceResponse(decoder func(*http.Response) goahttp.Decoder, restoreBody bool) func(*http.Response) (any, error) {
+	return func(resp *http.Response) (any, error) {
+		if restoreBody {
+			b, err := io.ReadAll(resp.Body)
+			if err != nil {
+				return nil, err
+			}
+			resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			defer func() {
+				resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			}()
+		} else {
+			defer resp.Body.Close()
+		}
+		switch resp.StatusCode {
+		case http.StatusOK:
+			var (
+				body PromoteGroupsioServiceResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("mailing-list", "promote-groupsio-service", err)
+			}
+			err = ValidatePromoteGroupsioServiceResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("mailing-list", "promote-groupsio-service", err)
+			}
+			res := NewPromoteGroupsioServiceGroupsioServicePromotionOK(&body)
+			return res, nil
+		case http.StatusBadRequest:
+			var (
+				body PromoteGroupsioServiceBadRequestResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("mailing-list", "promote-groupsio-service", err)
+			}
+			err = ValidatePromoteGroupsioServiceBadRequestResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("mailing-list", "promote-groupsio-service", err)
+			}
+			return nil, NewPromoteGroupsioServiceBadRequest(&body)
+		case http.StatusConflict:
+			var (
+				body PromoteGroupsioServiceConflictResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("mailing-list", "promote-groupsio-service", err)
+			}
+			err = ValidatePromoteGroupsioServiceConflictResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("mailing-list", "promote-groupsio-service", err)
+			}
+			return nil, NewPromoteGroupsioServiceConflict(&body)
+		case http.StatusInternalServerError:
+			var (
+				body PromoteGroupsioServiceInternalServerErrorResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("mailing-list", "promote-groupsio-service", err)
+			}
+			err = ValidatePromoteGroupsioServiceInternalServerErrorResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("mailing-list", "promote-groupsio-service", err)
+			}
+			return nil, NewPromoteGroupsioServiceInternalServerError(&body)
+		case http.StatusNotFound:
+			var (
+				body PromoteGroupsioServiceNotFoundResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("mailing-list", "promote-groupsio-service", err)
+			}
+			err = ValidatePromoteGroupsioServiceNotFoundResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("mailing-list", "promote-groupsio-service", err)
+			}
+			return nil, NewPromoteGroupsioServiceNotFound(&body)
+		case http.StatusServiceUnavailable:
+			var (
+				body PromoteGroupsioServiceServiceUnavailableResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("mailing-list", "promote-groupsio-service", err)
+			}
+			err = ValidatePromoteGroupsioServiceServiceUnavailableResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("mailing-list", "promote-groupsio-service", err)
+			}
+			return nil, NewPromoteGroupsioServiceServiceUnavailable(&body)
+		default:
+			body, _ := io.ReadAll(resp.Body)
+			return nil, goahttp.ErrInvalidResponse("mailing-list", "promote-groupsio-service", resp.StatusCode, string(body))
+		}
+	}
+}
+
 // BuildDeleteGroupsioServiceRequest instantiates a HTTP request object with
 // method and path set to call the "mailing-list" service
 // "delete-groupsio-service" endpoint
@@ -917,14 +1086,14 @@ func DecodeGetGroupsioServiceProjectsResponse(decoder func(*http.Response) goaht
 	}
 }
 
-// BuildFindParentGroupsioServiceRequest instantiates a HTTP request object
+// BuildListGroupsioServicesByStatusRequest instantiates a HTTP request object
 // with method and path set to call the "mailing-list" service
-// "find-parent-groupsio-service" endpoint
-func (c *Client) BuildFindParentGroupsioServiceRequest(ctx context.Context, v any) (*http.Request, error) {
-	u := &url.URL{Scheme: c.scheme, Host: c.host, Path: FindParentGroupsioServiceMailingListPath()}
+// "list-groupsio-services-by-status" endpoint
+func (c *Client) BuildListGroupsioServicesByStatusRequest(ctx context.Context, v any) (*http.Request, error) {
+	u := &url.URL{Scheme: c.scheme, Host: c.host, Path: ListGroupsioServicesByStatusMailingListPath()}
 	req, err := http.NewRequest("GET", u.String(), nil)
 	if err != nil {
-		return nil, goahttp.ErrInvalidURL("mailing-list", "find-parent-groupsio-service", u.String(), err)
+		return nil, goahttp.ErrInvalidURL("mailing-list", "list-groupsio-services-by-status", u.String(), err)
 	}
 	if ctx != nil {
 		req = req.WithContext(ctx)
@@ -933,13 +1102,13 @@ func (c *Client) BuildFindParentGroupsioServiceRequest(ctx context.Context, v an
 	return req, nil
 }
 
-// EncodeFindParentGroupsioServiceRequest returns an encoder for requests sent
-// to the mailing-list find-parent-groupsio-service server.
-func EncodeFindParentGroupsioServiceRequest(encoder func(*http.Request) goahttp.Encoder) func(*http.Request, any) error {
+// EncodeListGroupsioServicesByStatusRequest returns an encoder for requests
+// sent to the mailing-list list-groupsio-services-by-status server.
+func EncodeListGroupsioServicesByStatusRequest(encoder func(*http.Request) goahttp.Encoder) func(*http.Request, any) error {
 	return func(req *http.Request, v any) error {
-		p, ok := v.(*mailinglist.FindParentGroupsioServicePayload)
+		p, ok := v.(*mailinglist.ListGroupsioServicesByStatusPayload)
 		if !ok {
-			return goahttp.ErrInvalidType("mailing-list", "find-parent-groupsio-service", "*mailinglist.FindParentGroupsioServicePayload", v)
+			return goahttp.ErrInvalidType("mailing-list", "list-groupsio-services-by-status", "*mailinglist.ListGroupsioServicesByStatusPayload", v)
 		}
 		if p.BearerToken != nil {
 			head := *p.BearerToken
@@ -950,23 +1119,22 @@ func EncodeFindParentGroupsioServiceRequest(encoder func(*http.Request) goahttp.
 			}
 		}
 		values := req.URL.Query()
-		values.Add("project_uid", p.ProjectUID)
+		values.Add("status", p.Status)
 		req.URL.RawQuery = values.Encode()
 		return nil
 	}
 }
 
-// DecodeFindParentGroupsioServiceResponse returns a decoder for responses
-// returned by the mailing-list find-parent-groupsio-service endpoint.
+// DecodeListGroupsioServicesByStatusResponse returns a decoder for responses
+// returned by the mailing-list list-groupsio-services-by-status endpoint.
 // restoreBody controls whether the response body should be restored after
 // having been read.
-// DecodeFindParentGroupsioServiceResponse may return the following errors:
+// DecodeListGroupsioServicesByStatusResponse may return the following errors:
 //   - "BadRequest" (type *mailinglist.BadRequestError): http.StatusBadRequest
 //   - "InternalServerError" (type *mailinglist.InternalServerError): http.StatusInternalServerError
-//   - "NotFound" (type *mailinglist.NotFoundError): http.StatusNotFound
 //   - "ServiceUnavailable" (type *mailinglist.ServiceUnavailableError): http.StatusServiceUnavailable
 //   - error: internal error
-func DecodeFindParentGroupsioServiceResponse(decoder func(*http.Response) goahttp.Decoder, restoreBody bool) func(*http.Response) (any, error) {
+func DecodeListGroupsioServicesByStatusResponse(decoder func(*http.Response) goahttp.Decoder, restoreBody bool) func(*http.Response) (any, error) {
 	return func(resp *http.Response) (any, error) {
 		if restoreBody {
 			b, err := io.ReadAll(resp.Body)
@@ -983,90 +1151,76 @@ func DecodeFindParentGroupsioServiceResponse(decoder func(*http.Response) goahtt
 		switch resp.StatusCode {
 		case http.StatusOK:
 			var (
-				body FindParentGroupsioServiceResponseBody
+				body ListGroupsioServicesByStatusResponseBody
 				err  error
 			)
 			err = decoder(resp).Decode(&body)
 			if err != nil {
-				return nil, goahttp.ErrDecodingError("mailing-list", "find-parent-groupsio-service", err)
+				return nil, goahttp.ErrDecodingError("mailing-list", "list-groupsio-services-by-status", err)
 			}
-			err = ValidateFindParentGroupsioServiceResponseBody(&body)
+			err = ValidateListGroupsioServicesByStatusResponseBody(&body)
 			if err != nil {
-				return nil, goahttp.ErrValidationError("mailing-list", "find-parent-groupsio-service", err)
+				return nil, goahttp.ErrValidationError("mailing-list", "list-groupsio-services-by-status", err)
 			}
-			res := NewFindParentGroupsioServiceGroupsioServiceOK(&body)
+			res := NewListGroupsioServicesByStatusGroupsioServiceListOK(&body)
 			return res, nil
 		case http.StatusBadRequest:
 			var (
-				body FindParentGroupsioServiceBadRequestResponseBody
+				body ListGroupsioServicesByStatusBadRequestResponseBody
 				err  error
 			)
 			err = decoder(resp).Decode(&body)
 			if err != nil {
-				return nil, goahttp.ErrDecodingError("mailing-list", "find-parent-groupsio-service", err)
+				return nil, goahttp.ErrDecodingError("mailing-list", "list-groupsio-services-by-status", err)
 			}
-			err = ValidateFindParentGroupsioServiceBadRequestResponseBody(&body)
+			err = ValidateListGroupsioServicesByStatusBadRequestResponseBody(&body)
 			if err != nil {
-				return nil, goahttp.ErrValidationError("mailing-list", "find-parent-groupsio-service", err)
+				return nil, goahttp.ErrValidationError("mailing-list", "list-groupsio-services-by-status", err)
 			}
-			return nil, NewFindParentGroupsioServiceBadRequest(&body)
+			return nil, NewListGroupsioServicesByStatusBadRequest(&body)
 		case http.StatusInternalServerError:
 			var (
-				body FindParentGroupsioServiceInternalServerErrorResponseBody
-				err  error
-			)
-			err = decoder(resp).Decode(&body)
-			if err != nil {
-				return nil, goahttp.ErrDecodingError("mailing-list", "find-parent-groupsio-service", err)
-			}
-			err = ValidateFindParentGroupsioServiceInternalServerErrorResponseBody(&body)
-			if err != nil {
-				return nil, goahttp.ErrValidationError("mailing-list", "find-parent-groupsio-service", err)
-			}
-			return nil, NewFindParentGroupsioServiceInternalServerError(&body)
-		case http.StatusNotFound:
-			var (
-				body FindParentGroupsioServiceNotFoundResponseBody
+				body ListGroupsioServicesByStatusInternalServerErrorResponseBody
 				err  error
 			)
 			err = decoder(resp).Decode(&body)
 			if err != nil {
-				return nil, goahttp.ErrDecodingError("mailing-list", "find-parent-groupsio-service", err)
+				return nil, goahttp.ErrDecodingError("mailing-list", "list-groupsio-services-by-status", err)
 			}
-			err = ValidateFindParentGroupsioServiceNotFoundResponseBody(&body)
+			err = ValidateListGroupsioServicesByStatusInternalServerErrorResponseBody(&body)
 			if err != nil {
-				return nil, goahttp.ErrValidationError("mailing-list", "find-parent-groupsio-service", err)
+				return nil, goahttp.ErrValidationError("mailing-list", "list-groupsio-services-by-status", err)
 			}
-			return nil, NewFindParentGroupsioServiceNotFound(&body)
+			return nil, NewListGroupsioServicesByStatusInternalServerError(&body)
 		case http.StatusServiceUnavailable:
 			var (
-				body FindParentGroupsioServiceServiceUnavailableResponseBody
+				body ListGroupsioServicesByStatusServiceUnavailableResponseBody
 				err  error
 			)
 			err = decoder(resp).Decode(&body)
 			if err != nil {
-				return nil, goahttp.ErrDecodingError("mailing-list", "find-parent-groupsio-service", err)
+				return nil, goahttp.ErrDecodingError("mailing-list", "list-groupsio-services-by-status", err)
 			}
-			err = ValidateFindParentGroupsioServiceServiceUnavailableResponseBody(&body)
+			err = ValidateListGroupsioServicesByStatusServiceUnavailableResponseBody(&body)
 			if err != nil {
-				return nil, goahttp.ErrValidationError("mailing-list", "find-parent-groupsio-service", err)
+				return nil, goahttp.ErrValidationError("mailing-list", "list-groupsio-services-by-status", err)
 			}
-			return nil, NewFindParentGroupsioServiceServiceUnavailable(&body)
+			return nil, NewListGroupsioServicesByStatusServiceUnavailable(&body)
 		default:
 			body, _ := io.ReadAll(resp.Body)
-			return nil, goahttp.ErrInvalidResponse("mailing-list", "find-parent-groupsio-service", resp.StatusCode, string(body))
+			return nil, goahttp.ErrInvalidResponse("mailing-list", "list-groupsio-services-by-status", resp.StatusCode, string(body))
 		}
 	}
 }
 
-// BuildListGroupsioMailingListsRequest instantiates a HTTP request object with
-// method and path set to call the "mailing-list" service
-// "list-groupsio-mailing-lists" endpoint
-func (c *Client) BuildListGroupsioMailingListsRequest(ctx context.Context, v any) (*http.Request, error) {
-	u := &url.URL{Scheme: c.scheme, Host: c.host, Path: ListGroupsioMailingListsMailingListPath()}
+// BuildListUnprovisionedGroupsioServicesRequest instantiates a HTTP request
+// object with method and path set to call the "mailing-list" service
+// "list-unprovisioned-groupsio-services" endpoint
+func (c *Client) BuildListUnprovisionedGroupsioServicesRequest(ctx context.Context, v any) (*http.Request, error) {
+	u := &url.URL{Scheme: c.scheme, Host: c.host, Path: ListUnprovisionedGroupsioServicesMailingListPath()}
 	req, err := http.NewRequest("GET", u.String(), nil)
 	if err != nil {
-		return nil, goahttp.ErrInvalidURL("mailing-list", "list-groupsio-mailing-lists", u.String(), err)
+		return nil, goahttp.ErrInvalidURL("mailing-list", "list-unprovisioned-groupsio-services", u.String(), err)
 	}
 	if ctx != nil {
 		req = req.WithContext(ctx)
@@ -1075,13 +1229,14 @@ func (c *Client) BuildListGroupsioMailingListsRequest(ctx context.Context, v any
 	return req, nil
 }
 
-// EncodeListGroupsioMailingListsRequest returns an encoder for requests sent
-// to the mailing-list list-groupsio-mailing-lists server.
-func EncodeListGroupsioMailingListsRequest(encoder func(*http.Request) goahttp.Encoder) func(*http.Request, any) error {
+// EncodeListUnprovisionedGroupsioServicesRequest returns an encoder for
+// requests sent to the mailing-list list-unprovisioned-groupsio-services
+// server.
+func EncodeListUnprovisionedGroupsioServicesRequest(encoder func(*http.Request) goahttp.Encoder) func(*http.Request, any) error {
 	return func(req *http.Request, v any) error {
-		p, ok := v.(*mailinglist.ListGroupsioMailingListsPayload)
+		p, ok := v.(*mailinglist.ListUnprovisionedGroupsioServicesPayload)
 		if !ok {
-			return goahttp.ErrInvalidType("mailing-list", "list-groupsio-mailing-lists", "*mailinglist.ListGroupsioMailingListsPayload", v)
+			return goahttp.ErrInvalidType("mailing-list", "list-unprovisioned-groupsio-services", "*mailinglist.ListUnprovisionedGroupsioServicesPayload", v)
 		}
 		if p.BearerToken != nil {
 			head := *p.BearerToken
@@ -1092,27 +1247,23 @@ func EncodeListGroupsioMailingListsRequest(encoder func(*http.Request) goahttp.E
 			}
 		}
 		values := req.URL.Query()
-		if p.ProjectUID != nil {
-			values.Add("project_uid", *p.ProjectUID)
-		}
-		if p.CommitteeUID != nil {
-			values.Add("committee_uid", *p.CommitteeUID)
-		}
+		values.Add("project_uid", p.ProjectUID)
 		req.URL.RawQuery = values.Encode()
 		return nil
 	}
 }
 
-// DecodeListGroupsioMailingListsResponse returns a decoder for responses
-// returned by the mailing-list list-groupsio-mailing-lists endpoint.
-// restoreBody controls whether the response body should be restored after
-// having been read.
-// DecodeListGroupsioMailingListsResponse may return the following errors:
+// DecodeListUnprovisionedGroupsioServicesResponse returns a decoder for
+// responses returned by the mailing-list list-unprovisioned-groupsio-services
+// endpoint. restoreBody controls whether the response body should be restored
+// after having been read.
+// DecodeListUnprovisionedGroupsioServicesResponse may return the following
+// errors:
 //   - "BadRequest" (type *mailinglist.BadRequestError): http.StatusBadRequest
 //   - "InternalServerError" (type *mailinglist.InternalServerError): http.StatusInternalServerError
 //   - "ServiceUnavailable" (type *mailinglist.ServiceUnavailableError): http.StatusServiceUnavailable
 //   - error: internal error
-func DecodeListGroupsioMailingListsResponse(decoder func(*http.Response) goahttp.Decoder, restoreBody bool) func(*http.Response) (any, error) {
+func DecodeListUnprovisionedGroupsioServicesResponse(decoder func(*http.Response) goahttp.Decoder, restoreBody bool) func(*http.Response) (any, error) {
 	return func(resp *http.Response) (any, error) {
 		if restoreBody {
 			b, err := io.ReadAll(resp.Body)
@@ -1129,76 +1280,76 @@ func DecodeListGroupsioMailingListsResponse(decoder func(*http.Response) goahttp
 		switch resp.StatusCode {
 		case http.StatusOK:
 			var (
-				body ListGroupsioMailingListsResponseBody
+				body ListUnprovisionedGroupsioServicesResponseBody
 				err  error
 			)
 			err = decoder(resp).Decode(&body)
 			if err != nil {
-				return nil, goahttp.ErrDecodingError("mailing-list", "list-groupsio-mailing-lists", err)
+				return nil, goahttp.ErrDecodingError("mailing-list", "list-unprovisioned-groupsio-services", err)
 			}
-			err = ValidateListGroupsioMailingListsResponseBody(&body)
+			err = ValidateListUnprovisionedGroupsioServicesResponseBody(&body)
 			if err != nil {
-				return nil, goahttp.ErrValidationError("mailing-list", "list-groupsio-mailing-lists", err)
+				return nil, goahttp.ErrValidationError("mailing-list", "list-unprovisioned-groupsio-services", err)
 			}
-			res := NewListGroupsioMailingListsGroupsioSubgroupListOK(&body)
+			res := NewListUnprovisionedGroupsioServicesGroupsioServiceListOK(&body)
 			return res, nil
 		case http.StatusBadRequest:
 			var (
-				body ListGroupsioMailingListsBadRequestResponseBody
+				body ListUnprovisionedGroupsioServicesBadRequestResponseBody
 				err  error
 			)
 			err = decoder(resp).Decode(&body)
 			if err != nil {
-				return nil, goahttp.ErrDecodingError("mailing-list", "list-groupsio-mailing-lists", err)
+				return nil, goahttp.ErrDecodingError("mailing-list", "list-unprovisioned-groupsio-services", err)
 			}
-			err = ValidateListGroupsioMailingListsBadRequestResponseBody(&body)
+			err = ValidateListUnprovisionedGroupsioServicesBadRequestResponseBody(&body)
 			if err != nil {
-				return nil, goahttp.ErrValidationError("mailing-list", "list-groupsio-mailing-lists", err)
+				return nil, goahttp.ErrValidationError("mailing-list", "list-unprovisioned-groupsio-services", err)
 			}
-			return nil, NewListGroupsioMailingListsBadRequest(&body)
+			return nil, NewListUnprovisionedGroupsioServicesBadRequest(&body)
 		case http.StatusInternalServerError:
 			var (
-				body ListGroupsioMailingListsInternalServerErrorResponseBody
+				body ListUnprovisionedGroupsioServicesInternalServerErrorResponseBody
 				err  error
 			)
 			err = decoder(resp).Decode(&body)
 			if err != nil {
-				return nil, goahttp.ErrDecodingError("mailing-list", "list-groupsio-mailing-lists", err)
+				return nil, goahttp.ErrDecodingError("mailing-list", "list-unprovisioned-groupsio-services", err)
 			}
-			err = ValidateListGroupsioMailingListsInternalServerErrorResponseBody(&body)
+			err = ValidateListUnprovisionedGroupsioServicesInternalServerErrorResponseBody(&body)
 			if err != nil {
-				return nil, goahttp.ErrValidationError("mailing-list", "list-groupsio-mailing-lists", err)
+				return nil, goahttp.ErrValidationError("mailing-list", "list-unprovisioned-groupsio-services", err)
 			}
-			return nil, NewListGroupsioMailingListsInternalServerError(&body)
+			return nil, NewListUnprovisionedGroupsioServicesInternalServerError(&body)
 		case http.StatusServiceUnavailable:
 			var (
-				body ListGroupsioMailingListsServiceUnavailableResponseBody
+				body ListUnprovisionedGroupsioServicesServiceUnavailableResponseBody
 				err  error
 			)
 			err = decoder(resp).Decode(&body)
 			if err != nil {
-				return nil, goahttp.ErrDecodingError("mailing-list", "list-groupsio-mailing-lists", err)
+				return nil, goahttp.ErrDecodingError("mailing-list", "list-unprovisioned-groupsio-services", err)
 			}
-			err = ValidateListGroupsioMailingListsServiceUnavailableResponseBody(&body)
+			err = ValidateListUnprovisionedGroupsioServicesServiceUnavailableResponseBody(&body)
 			if err != nil {
-				return nil, goahttp.ErrValidationError("mailing-list", "list-groupsio-mailing-lists", err)
+				return nil, goahttp.ErrValidationError("mailing-list", "list-unprovisioned-groupsio-services", err)
 			}
-			return nil, NewListGroupsioMailingListsServiceUnavailable(&body)
+			return nil, NewListUnprovisionedGroupsioServicesServiceUnavailable(&body)
 		default:
 			body, _ := io.ReadAll(resp.Body)
-			return nil, goahttp.ErrInvalidResponse("mailing-list", "list-groupsio-mailing-lists", resp.StatusCode, string(body))
+			return nil, goahttp.ErrInvalidResponse("mailing-list", "list-unprovisioned-groupsio-services", resp.StatusCode, string(body))
 		}
 	}
 }
 
-// BuildCreateGroupsioMailingListRequest instantiates a HTTP request object
+// BuildFindParentGroupsioServiceRequest instantiates a HTTP request object
 // with method and path set to call the "mailing-list" service
-// "create-groupsio-mailing-list" endpoint
-func (c *Client) BuildCreateGroupsioMailingListRequest(ctx context.Context, v any) (*http.Request, error) {
-	u := &url.URL{Scheme: c.scheme, Host: c.host, Path: CreateGroupsioMailingListMailingListPath()}
-	req, err := http.NewRequest("POST", u.String(), nil)
+// "find-parent-groupsio-service" endpoint
+func (c *Client) BuildFindParentGroupsioServiceRequest(ctx context.Context, v any) (*http.Request, error) {
+	u := &url.URL{Scheme: c.scheme, Host: c.host, Path: FindParentGroupsioServiceMailingListPath()}
+	req, err := http.NewRequest("GET", u.String(), nil)
 	if err != nil {
-		return nil, goahttp.ErrInvalidURL("mailing-list", "create-groupsio-mailing-list", u.String(), err)
+		return nil, goahttp.ErrInvalidURL("mailing-list", "find-parent-groupsio-service", u.String(), err)
 	}
 	if ctx != nil {
 		req = req.WithContext(ctx)
@@ -1207,13 +1358,13 @@ func (c *Client) BuildCreateGroupsioMailingListRequest(ctx context.Context, v an
 	return req, nil
 }
 
-// EncodeCreateGroupsioMailingListRequest returns an encoder for requests sent
-// to the mailing-list create-groupsio-mailing-list server.
-func EncodeCreateGroupsioMailingListRequest(encoder func(*http.Request) goahttp.Encoder) func(*http.Request, any) error {
+// EncodeFindParentGroupsioServiceRequest returns an encoder for requests sent
+// to the mailing-list find-parent-groupsio-service server.
+func EncodeFindParentGroupsioServiceRequest(encoder func(*http.Request) goahttp.Encoder) func(*http.Request, any) error {
 	return func(req *http.Request, v any) error {
-		p, ok := v.(*mailinglist.CreateGroupsioMailingListPayload)
+		p, ok := v.(*mailinglist.FindParentGroupsioServicePayload)
 		if !ok {
-			return goahttp.ErrInvalidType("mailing-list", "create-groupsio-mailing-list", "*mailinglist.CreateGroupsioMailingListPayload", v)
+			return goahttp.ErrInvalidType("mailing-list", "find-parent-groupsio-service", "*mailinglist.FindParentGroupsioServicePayload", v)
 		}
 		if p.BearerToken != nil {
 			head := *p.BearerToken
@@ -1223,25 +1374,2735 @@ func EncodeCreateGroupsioMailingListRequest(encoder func(*http.Request) goahttp.
 				req.Header.Set("Authorization", head)
 			}
 		}
-		body := NewCreateGroupsioMailingListRequestBody(p)
-		if err := encoder(req).Encode(&body); err != nil {
-			return goahttp.ErrEncodingError("mailing-list", "create-groupsio-mailing-list", err)
-		}
+		values := req.URL.Query()
+		values.Add("project_uid", p.ProjectUID)
+		req.URL.RawQuery = values.Encode()
 		return nil
 	}
 }
 
-// DecodeCreateGroupsioMailingListResponse returns a decoder for responses
-// returned by the mailing-list create-groupsio-mailing-list endpoint.
+// DecodeFindParentGroupsioServiceResponse returns a decoder for responses
+// returned by the mailing-list find-parent-groupsio-service endpoint.
 // restoreBody controls whether the response body should be restored after
 // having been read.
-// DecodeCreateGroupsioMailingListResponse may return the following errors:
+// DecodeFindParentGroupsioServiceResponse may return the following errors:
+//   - "BadRequest" (type *mailinglist.BadRequestError): http.StatusBadRequest
+//   - "InternalServerError" (type *mailinglist.InternalServerError): http.StatusInternalServerError
+//   - "NotFound" (type *mailinglist.NotFoundError): http.StatusNotFound
+//   - "ServiceUnavailable" (type *mailinglist.ServiceUnavailableError): http.StatusServiceUnavailable
+//   - error: internal error
+func DecodeFindParentGroupsioServiceResponse(decoder func(*http.Response) goahttp.Decoder, restoreBody bool) func(*http.Response) (any, error) {
+	return func(resp *http.Response) (any, error) {
+		if restoreBody {
+			b, err := io.ReadAll(resp.Body)
+			if err != nil {
+				return nil, err
+			}
+			resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			defer func() {
+				resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			}()
+		} else {
+			defer resp.Body.Close()
+		}
+		switch resp.StatusCode {
+		case http.StatusOK:
+			var (
+				body FindParentGroupsioServiceResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("mailing-list", "find-parent-groupsio-service", err)
+			}
+			err = ValidateFindParentGroupsioServiceResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("mailing-list", "find-parent-groupsio-service", err)
+			}
+			res := NewFindParentGroupsioServiceGroupsioServiceOK(&body)
+			return res, nil
+		case http.StatusBadRequest:
+			var (
+				body FindParentGroupsioServiceBadRequestResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("mailing-list", "find-parent-groupsio-service", err)
+			}
+			err = ValidateFindParentGroupsioServiceBadRequestResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("mailing-list", "find-parent-groupsio-service", err)
+			}
+			return nil, NewFindParentGroupsioServiceBadRequest(&body)
+		case http.StatusInternalServerError:
+			var (
+				body FindParentGroupsioServiceInternalServerErrorResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("mailing-list", "find-parent-groupsio-service", err)
+			}
+			err = ValidateFindParentGroupsioServiceInternalServerErrorResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("mailing-list", "find-parent-groupsio-service", err)
+			}
+			return nil, NewFindParentGroupsioServiceInternalServerError(&body)
+		case http.StatusNotFound:
+			var (
+				body FindParentGroupsioServiceNotFoundResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("mailing-list", "find-parent-groupsio-service", err)
+			}
+			err = ValidateFindParentGroupsioServiceNotFoundResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("mailing-list", "find-parent-groupsio-service", err)
+			}
+			return nil, NewFindParentGroupsioServiceNotFound(&body)
+		case http.StatusServiceUnavailable:
+			var (
+				body FindParentGroupsioServiceServiceUnavailableResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("mailing-list", "find-parent-groupsio-service", err)
+			}
+			err = ValidateFindParentGroupsioServiceServiceUnavailableResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("mailing-list", "find-parent-groupsio-service", err)
+			}
+			return nil, NewFindParentGroupsioServiceServiceUnavailable(&body)
+		default:
+			body, _ := io.ReadAll(resp.Body)
+			return nil, goahttp.ErrInvalidResponse("mailing-list", "find-parent-groupsio-service", resp.StatusCode, string(body))
+		}
+	}
+}
+
+// BuildListGroupsioMailingListsRequest instantiates a HTTP request object with
+// method and path set to call the "mailing-list" service
+// "list-groupsio-mailing-lists" endpoint
+func (c *Client) BuildListGroupsioMailingListsRequest(ctx context.Context, v any) (*http.Request, error) {
+	u := &url.URL{Scheme: c.scheme, Host: c.host, Path: ListGroupsioMailingListsMailingListPath()}
+	req, err := http.NewRequest("GET", u.String(), nil)
+	if err != nil {
+		return nil, goahttp.ErrInvalidURL("mailing-list", "list-groupsio-mailing-lists", u.String(), err)
+	}
+	if ctx != nil {
+		req = req.WithContext(ctx)
+	}
+
+	return req, nil
+}
+
+// EncodeListGroupsioMailingListsRequest returns an encoder for requests sent
+// to the mailing-list list-groupsio-mailing-lists server.
+func EncodeListGroupsioMailingListsRequest(encoder func(*http.Request) goahttp.Encoder) func(*http.Request, any) error {
+	return func(req *http.Request, v any) error {
+		p, ok := v.(*mailinglist.ListGroupsioMailingListsPayload)
+		if !ok {
+			return goahttp.ErrInvalidType("mailing-list", "list-groupsio-mailing-lists", "*mailinglist.ListGroupsioMailingListsPayload", v)
+		}
+		if p.BearerToken != nil {
+			head := *p.BearerToken
+			if !strings.Contains(head, " ") {
+				req.Header.Set("Authorization", "Bearer "+head)
+			} else {
+				req.Header.Set("Authorization", head)
+			}
+		}
+		values := req.URL.Query()
+		if p.ProjectUID != nil {
+			values.Add("project_uid", *p.ProjectUID)
+		}
+		if p.CommitteeUID != nil {
+			values.Add("committee_uid", *p.CommitteeUID)
+		}
+		req.URL.RawQuery = values.Encode()
+		return nil
+	}
+}
+
+// DecodeListGroupsioMailingListsResponse returns a decoder for responses
+// returned by the mailing-list list-groupsio-mailing-lists endpoint.
+// restoreBody controls whether the response body should be restored after
+// having been read.
+// DecodeListGroupsioMailingListsResponse may return the following errors:
+//   - "BadRequest" (type *mailinglist.BadRequestError): http.StatusBadRequest
+//   - "InternalServerError" (type *mailinglist.InternalServerError): http.StatusInternalServerError
+//   - "ServiceUnavailable" (type *mailinglist.ServiceUnavailableError): http.StatusServiceUnavailable
+//   - error: internal error
+func DecodeListGroupsioMailingListsResponse(decoder func(*http.Response) goahttp.Decoder, restoreBody bool) func(*http.Response) (any, error) {
+	return func(resp *http.Response) (any, error) {
+		if restoreBody {
+			b, err := io.ReadAll(resp.Body)
+			if err != nil {
+				return nil, err
+			}
+			resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			defer func() {
+				resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			}()
+		} else {
+			defer resp.Body.Close()
+		}
+		switch resp.StatusCode {
+		case http.StatusOK:
+			var (
+				body ListGroupsioMailingListsResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("mailing-list", "list-groupsio-mailing-lists", err)
+			}
+			err = ValidateListGroupsioMailingListsResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("mailing-list", "list-groupsio-mailing-lists", err)
+			}
+			res := NewListGroupsioMailingListsGroupsioSubgroupListOK(&body)
+			return res, nil
+		case http.StatusBadRequest:
+			var (
+				body ListGroupsioMailingListsBadRequestResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("mailing-list", "list-groupsio-mailing-lists", err)
+			}
+			err = ValidateListGroupsioMailingListsBadRequestResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("mailing-list", "list-groupsio-mailing-lists", err)
+			}
+			return nil, NewListGroupsioMailingListsBadRequest(&body)
+		case http.StatusInternalServerError:
+			var (
+				body ListGroupsioMailingListsInternalServerErrorResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("mailing-list", "list-groupsio-mailing-lists", err)
+			}
+			err = ValidateListGroupsioMailingListsInternalServerErrorResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("mailing-list", "list-groupsio-mailing-lists", err)
+			}
+			return nil, NewListGroupsioMailingListsInternalServerError(&body)
+		case http.StatusServiceUnavailable:
+			var (
+				body ListGroupsioMailingListsServiceUnavailableResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("mailing-list", "list-groupsio-mailing-lists", err)
+			}
+			err = ValidateListGroupsioMailingListsServiceUnavailableResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("mailing-list", "list-groupsio-mailing-lists", err)
+			}
+			return nil, NewListGroupsioMailingListsServiceUnavailable(&body)
+		default:
+			body, _ := io.ReadAll(resp.Body)
+			return nil, goahttp.ErrInvalidResponse("mailing-list", "list-groupsio-mailing-lists", resp.StatusCode, string(body))
+		}
+	}
+}
+
+// BuildListGroupsioMailingListsByVisibilityRequest instantiates a HTTP request
+// object with method and path set to call the "mailing-list" service
+// "list-groupsio-mailing-lists-by-visibility" endpoint
+func (c *Client) BuildListGroupsioMailingListsByVisibilityRequest(ctx context.Context, v any) (*http.Request, error) {
+	u := &url.URL{Scheme: c.scheme, Host: c.host, Path: ListGroupsioMailingListsByVisibilityMailingListPath()}
+	req, err := http.NewRequest("GET", u.String(), nil)
+	if err != nil {
+		return nil, goahttp.ErrInvalidURL("mailing-list", "list-groupsio-mailing-lists-by-visibility", u.String(), err)
+	}
+	if ctx != nil {
+		req = req.WithContext(ctx)
+	}
+
+	return req, nil
+}
+
+// EncodeListGroupsioMailingListsByVisibilityRequest returns an encoder for
+// requests sent to the mailing-list list-groupsio-mailing-lists-by-visibility
+// server.
+func EncodeListGroupsioMailingListsByVisibilityRequest(encoder func(*http.Request) goahttp.Encoder) func(*http.Request, any) error {
+	return func(req *http.Request, v any) error {
+		p, ok := v.(*mailinglist.ListGroupsioMailingListsByVisibilityPayload)
+		if !ok {
+			return goahttp.ErrInvalidType("mailing-list", "list-groupsio-mailing-lists-by-visibility", "*mailinglist.ListGroupsioMailingListsByVisibilityPayload", v)
+		}
+		if p.BearerToken != nil {
+			head := *p.BearerToken
+			if !strings.Contains(head, " ") {
+				req.Header.Set("Authorization", "Bearer "+head)
+			} else {
+				req.Header.Set("Authorization", head)
+			}
+		}
+		values := req.URL.Query()
+		values.Add("project_uid", p.ProjectUID)
+		values.Add("public", fmt.Sprintf("%v", p.Public))
+		req.URL.RawQuery = values.Encode()
+		return nil
+	}
+}
+
+// DecodeListGroupsioMailingListsByVisibilityResponse returns a decoder for
+// responses returned by the mailing-list
+// list-groupsio-mailing-lists-by-visibility endpoint. restoreBody controls
+// whether the response body should be restored after having been read.
+// DecodeListGroupsioMailingListsByVisibilityResponse may return the following
+// errors:
+//   - "BadRequest" (type *mailinglist.BadRequestError): http.StatusBadRequest
+//   - "InternalServerError" (type *mailinglist.InternalServerError): http.StatusInternalServerError
+//   - "ServiceUnavailable" (type *mailinglist.ServiceUnavailableError): http.StatusServiceUnavailable
+//   - error: internal error
+func DecodeListGroupsioMailingListsByVisibilityResponse(decoder func(*http.Response) goahttp.Decoder, restoreBody bool) func(*http.Response) (any, error) {
+	return func(resp *http.Response) (any, error) {
+		if restoreBody {
+			b, err := io.ReadAll(resp.Body)
+			if err != nil {
+				return nil, err
+			}
+			resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			defer func() {
+				resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			}()
+		} else {
+			defer resp.Body.Close()
+		}
+		switch resp.StatusCode {
+		case http.StatusOK:
+			var (
+				body ListGroupsioMailingListsByVisibilityResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("mailing-list", "list-groupsio-mailing-lists-by-visibility", err)
+			}
+			err = ValidateListGroupsioMailingListsByVisibilityResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("mailing-list", "list-groupsio-mailing-lists-by-visibility", err)
+			}
+			res := NewListGroupsioMailingListsByVisibilityGroupsioSubgroupListOK(&body)
+			return res, nil
+		case http.StatusBadRequest:
+			var (
+				body ListGroupsioMailingListsByVisibilityBadRequestResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("mailing-list", "list-groupsio-mailing-lists-by-visibility", err)
+			}
+			err = ValidateListGroupsioMailingListsByVisibilityBadRequestResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("mailing-list", "list-groupsio-mailing-lists-by-visibility", err)
+			}
+			return nil, NewListGroupsioMailingListsByVisibilityBadRequest(&body)
+		case http.StatusInternalServerError:
+			var (
+				body ListGroupsioMailingListsByVisibilityInternalServerErrorResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("mailing-list", "list-groupsio-mailing-lists-by-visibility", err)
+			}
+			err = ValidateListGroupsioMailingListsByVisibilityInternalServerErrorResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("mailing-list", "list-groupsio-mailing-lists-by-visibility", err)
+			}
+			return nil, NewListGroupsioMailingListsByVisibilityInternalServerError(&body)
+		case http.StatusServiceUnavailable:
+			var (
+				body ListGroupsioMailingListsByVisibilityServiceUnavailableResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("mailing-list", "list-groupsio-mailing-lists-by-visibility", err)
+			}
+			err = ValidateListGroupsioMailingListsByVisibilityServiceUnavailableResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("mailing-list", "list-groupsio-mailing-lists-by-visibility", err)
+			}
+			return nil, NewListGroupsioMailingListsByVisibilityServiceUnavailable(&body)
+		default:
+			body, _ := io.ReadAll(resp.Body)
+			return nil, goahttp.ErrInvalidResponse("mailing-list", "list-groupsio-mailing-lists-by-visibility", resp.StatusCode, string(body))
+		}
+	}
+}
+
+// BuildListGroupsioMailingListsUpdatedSinceRequest instantiates a HTTP request
+// object with method and path set to call the "mailing-list" service
+// "list-groupsio-mailing-lists-updated-since" endpoint
+func (c *Client) BuildListGroupsioMailingListsUpdatedSinceRequest(ctx context.Context, v any) (*http.Request, error) {
+	u := &url.URL{Scheme: c.scheme, Host: c.host, Path: ListGroupsioMailingListsUpdatedSinceMailingListPath()}
+	req, err := http.NewRequest("GET", u.String(), nil)
+	if err != nil {
+		return nil, goahttp.ErrInvalidURL("mailing-list", "list-groupsio-mailing-lists-updated-since", u.String(), err)
+	}
+	if ctx != nil {
+		req = req.WithContext(ctx)
+	}
+
+	return req, nil
+}
+
+// EncodeListGroupsioMailingListsUpdatedSinceRequest returns an encoder for
+// requests sent to the mailing-list list-groupsio-mailing-lists-updated-since
+// server.
+func EncodeListGroupsioMailingListsUpdatedSinceRequest(encoder func(*http.Request) goahttp.Encoder) func(*http.Request, any) error {
+	return func(req *http.Request, v any) error {
+		p, ok := v.(*mailinglist.ListGroupsioMailingListsUpdatedSincePayload)
+		if !ok {
+			return goahttp.ErrInvalidType("mailing-list", "list-groupsio-mailing-lists-updated-since", "*mailinglist.ListGroupsioMailingListsUpdatedSincePayload", v)
+		}
+		if p.BearerToken != nil {
+			head := *p.BearerToken
+			if !strings.Contains(head, " ") {
+				req.Header.Set("Authorization", "Bearer "+head)
+			} else {
+				req.Header.Set("Authorization", head)
+			}
+		}
+		values := req.URL.Query()
+		values.Add("project_uid", p.ProjectUID)
+		values.Add("since", p.Since)
+		req.URL.RawQuery = values.Encode()
+		return nil
+	}
+}
+
+// DecodeListGroupsioMailingListsUpdatedSinceResponse returns a decoder for
+// responses returned by the mailing-list
+// list-groupsio-mailing-lists-updated-since endpoint. restoreBody controls
+// whether the response body should be restored after having been read.
+// DecodeListGroupsioMailingListsUpdatedSinceResponse may return the following
+// errors:
+//   - "BadRequest" (type *mailinglist.BadRequestError): http.StatusBadRequest
+//   - "InternalServerError" (type *mailinglist.InternalServerError): http.StatusInternalServerError
+//   - "ServiceUnavailable" (type *mailinglist.ServiceUnavailableError): http.StatusServiceUnavailable
+//   - error: internal error
+func DecodeListGroupsioMailingListsUpdatedSinceResponse(decoder func(*http.Response) goahttp.Decoder, restoreBody bool) func(*http.Response) (any, error) {
+	return func(resp *http.Response) (any, error) {
+		if restoreBody {
+			b, err := io.ReadAll(resp.Body)
+			if err != nil {
+				return nil, err
+			}
+			resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			defer func() {
+				resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			}()
+		} else {
+			defer resp.Body.Close()
+		}
+		switch resp.StatusCode {
+		case http.StatusOK:
+			var (
+				body ListGroupsioMailingListsUpdatedSinceResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("mailing-list", "list-groupsio-mailing-lists-updated-since", err)
+			}
+			err = ValidateListGroupsioMailingListsUpdatedSinceResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("mailing-list", "list-groupsio-mailing-lists-updated-since", err)
+			}
+			res := NewListGroupsioMailingListsUpdatedSinceGroupsioSubgroupListOK(&body)
+			return res, nil
+		case http.StatusBadRequest:
+			var (
+				body ListGroupsioMailingListsUpdatedSinceBadRequestResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("mailing-list", "list-groupsio-mailing-lists-updated-since", err)
+			}
+			err = ValidateListGroupsioMailingListsUpdatedSinceBadRequestResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("mailing-list", "list-groupsio-mailing-lists-updated-since", err)
+			}
+			return nil, NewListGroupsioMailingListsUpdatedSinceBadRequest(&body)
+		case http.StatusInternalServerError:
+			var (
+				body ListGroupsioMailingListsUpdatedSinceInternalServerErrorResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("mailing-list", "list-groupsio-mailing-lists-updated-since", err)
+			}
+			err = ValidateListGroupsioMailingListsUpdatedSinceInternalServerErrorResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("mailing-list", "list-groupsio-mailing-lists-updated-since", err)
+			}
+			return nil, NewListGroupsioMailingListsUpdatedSinceInternalServerError(&body)
+		case http.StatusServiceUnavailable:
+			var (
+				body ListGroupsioMailingListsUpdatedSinceServiceUnavailableResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("mailing-list", "list-groupsio-mailing-lists-updated-since", err)
+			}
+			err = ValidateListGroupsioMailingListsUpdatedSinceServiceUnavailableResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("mailing-list", "list-groupsio-mailing-lists-updated-since", err)
+			}
+			return nil, NewListGroupsioMailingListsUpdatedSinceServiceUnavailable(&body)
+		default:
+			body, _ := io.ReadAll(resp.Body)
+			return nil, goahttp.ErrInvalidResponse("mailing-list", "list-groupsio-mailing-lists-updated-since", resp.StatusCode, string(body))
+		}
+	}
+}
+
+// BuildCreateGroupsioMailingListRequest instantiates a HTTP request object
+// with method and path set to call the "mailing-list" service
+// "create-groupsio-mailing-list" endpoint
+func (c *Client) BuildCreateGroupsioMailingListRequest(ctx context.Context, v any) (*http.Request, error) {
+	u := &url.URL{Scheme: c.scheme, Host: c.host, Path: CreateGroupsioMailingListMailingListPath()}
+	req, err := http.NewRequest("POST", u.String(), nil)
+	if err != nil {
+		return nil, goahttp.ErrInvalidURL("mailing-list", "create-groupsio-mailing-list", u.String(), err)
+	}
+	if ctx != nil {
+		req = req.WithContext(ctx)
+	}
+
+	return req, nil
+}
+
+// EncodeCreateGroupsioMailingListRequest returns an encoder for requests sent
+// to the mailing-list create-groupsio-mailing-list server.
+func EncodeCreateGroupsioMailingListRequest(encoder func(*http.Request) goahttp.Encoder) func(*http.Request, any) error {
+	return func(req *http.Request, v any) error {
+		p, ok := v.(*mailinglist.CreateGroupsioMailingListPayload)
+		if !ok {
+			return goahttp.ErrInvalidType("mailing-list", "create-groupsio-mailing-list", "*mailinglist.CreateGroupsioMailingListPayload", v)
+		}
+		if p.BearerToken != nil {
+			head := *p.BearerToken
+			if !strings.Contains(head, " ") {
+				req.Header.Set("Authorization", "Bearer "+head)
+			} else {
+				req.Header.Set("Authorization", head)
+			}
+		}
+		body := NewCreateGroupsioMailingListRequestBody(p)
+		if err := encoder(req).Encode(&body); err != nil {
+			return goahttp.ErrEncodingError("mailing-list", "create-groupsio-mailing-list", err)
+		}
+		return nil
+	}
+}
+
+// DecodeCreateGroupsioMailingListResponse returns a decoder for responses
+// returned by the mailing-list create-groupsio-mailing-list endpoint.
+// restoreBody controls whether the response body should be restored after
+// having been read.
+// DecodeCreateGroupsioMailingListResponse may return the following errors:
+//   - "BadRequest" (type *mailinglist.BadRequestError): http.StatusBadRequest
+//   - "Conflict" (type *mailinglist.ConflictError): http.StatusConflict
+//   - "InternalServerError" (type *mailinglist.InternalServerError): http.StatusInternalServerError
+//   - "ServiceUnavailable" (type *mailinglist.ServiceUnavailableError): http.StatusServiceUnavailable
+//   - error: internal error
+func DecodeCreateGroupsioMailingListResponse(decoder func(*http.Response) goahttp.Decoder, restoreBody bool) func(*http.Response) (any, error) {
+	return func(resp *http.Response) (any, error) {
+		if restoreBody {
+			b, err := io.ReadAll(resp.Body)
+			if err != nil {
+				return nil, err
+			}
+			resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			defer func() {
+				resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			}()
+		} else {
+			defer resp.Body.Close()
+		}
+		switch resp.StatusCode {
+		case http.StatusCreated:
+			var (
+				body CreateGroupsioMailingListResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("mailing-list", "create-groupsio-mailing-list", err)
+			}
+			err = ValidateCreateGroupsioMailingListResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("mailing-list", "create-groupsio-mailing-list", err)
+			}
+			res := NewCreateGroupsioMailingListGroupsioSubgroupCreated(&body)
+			return res, nil
+		case http.StatusBadRequest:
+			var (
+				body CreateGroupsioMailingListBadRequestResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("mailing-list", "create-groupsio-mailing-list", err)
+			}
+			err = ValidateCreateGroupsioMailingListBadRequestResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("mailing-list", "create-groupsio-mailing-list", err)
+			}
+			return nil, NewCreateGroupsioMailingListBadRequest(&body)
+		case http.StatusConflict:
+			var (
+				body CreateGroupsioMailingListConflictResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("mailing-list", "create-groupsio-mailing-list", err)
+			}
+			err = ValidateCreateGroupsioMailingListConflictResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("mailing-list", "create-groupsio-mailing-list", err)
+			}
+			return nil, NewCreateGroupsioMailingListConflict(&body)
+		case http.StatusInternalServerError:
+			var (
+				body CreateGroupsioMailingListInternalServerErrorResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("mailing-list", "create-groupsio-mailing-list", err)
+			}
+			err = ValidateCreateGroupsioMailingListInternalServerErrorResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("mailing-list", "create-groupsio-mailing-list", err)
+			}
+			return nil, NewCreateGroupsioMailingListInternalServerError(&body)
+		case http.StatusServiceUnavailable:
+			var (
+				body CreateGroupsioMailingListServiceUnavailableResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("mailing-list", "create-groupsio-mailing-list", err)
+			}
+			err = ValidateCreateGroupsioMailingListServiceUnavailableResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("mailing-list", "create-groupsio-mailing-list", err)
+			}
+			return nil, NewCreateGroupsioMailingListServiceUnavailable(&body)
+		default:
+			body, _ := io.ReadAll(resp.Body)
+			return nil, goahttp.ErrInvalidResponse("mailing-list", "create-groupsio-mailing-list", resp.StatusCode, string(body))
+		}
+	}
+}
+
+// BuildGetGroupsioMailingListRequest instantiates a HTTP request object with
+// method and path set to call the "mailing-list" service
+// "get-groupsio-mailing-list" endpoint
+func (c *Client) BuildGetGroupsioMailingListRequest(ctx context.Context, v any) (*http.Request, error) {
+	var (
+		subgroupID string
+	)
+	{
+		p, ok := v.(*mailinglist.GetGroupsioMailingListPayload)
+		if !ok {
+			return nil, goahttp.ErrInvalidType("mailing-list", "get-groupsio-mailing-list", "*mailinglist.GetGroupsioMailingListPayload", v)
+		}
+		subgroupID = p.SubgroupID
+	}
+	u := &url.URL{Scheme: c.scheme, Host: c.host, Path: GetGroupsioMailingListMailingListPath(subgroupID)}
+	req, err := http.NewRequest("GET", u.String(), nil)
+	if err != nil {
+		return nil, goahttp.ErrInvalidURL("mailing-list", "get-groupsio-mailing-list", u.String(), err)
+	}
+	if ctx != nil {
+		req = req.WithContext(ctx)
+	}
+
+	return req, nil
+}
+
+// EncodeGetGroupsioMailingListRequest returns an encoder for requests sent to
+// the mailing-list get-groupsio-mailing-list server.
+func EncodeGetGroupsioMailingListRequest(encoder func(*http.Request) goahttp.Encoder) func(*http.Request, any) error {
+	return func(req *http.Request, v any) error {
+		p, ok := v.(*mailinglist.GetGroupsioMailingListPayload)
+		if !ok {
+			return goahttp.ErrInvalidType("mailing-list", "get-groupsio-mailing-list", "*mailinglist.GetGroupsioMailingListPayload", v)
+		}
+		if p.BearerToken != nil {
+			head := *p.BearerToken
+			if !strings.Contains(head, " ") {
+				req.Header.Set("Authorization", "Bearer "+head)
+			} else {
+				req.Header.Set("Authorization", head)
+			}
+		}
+		return nil
+	}
+}
+
+// DecodeGetGroupsioMailingListResponse returns a decoder for responses
+// returned by the mailing-list get-groupsio-mailing-list endpoint. restoreBody
+// controls whether the response body should be restored after having been read.
+// DecodeGetGroupsioMailingListResponse may return the following errors:
+//   - "InternalServerError" (type *mailinglist.InternalServerError): http.StatusInternalServerError
+//   - "NotFound" (type *mailinglist.NotFoundError): http.StatusNotFound
+//   - "ServiceUnavailable" (type *mailinglist.ServiceUnavailableError): http.StatusServiceUnavailable
+//   - error: internal error
+func DecodeGetGroupsioMailingListResponse(decoder func(*http.Response) goahttp.Decoder, restoreBody bool) func(*http.Response) (any, error) {
+	return func(resp *http.Response) (any, error) {
+		if restoreBody {
+			b, err := io.ReadAll(resp.Body)
+			if err != nil {
+				return nil, err
+			}
+			resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			defer func() {
+				resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			}()
+		} else {
+			defer resp.Body.Close()
+		}
+		switch resp.StatusCode {
+		case http.StatusOK:
+			var (
+				body GetGroupsioMailingListResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("mailing-list", "get-groupsio-mailing-list", err)
+			}
+			err = ValidateGetGroupsioMailingListResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("mailing-list", "get-groupsio-mailing-list", err)
+			}
+			res := NewGetGroupsioMailingListGroupsioSubgroupOK(&body)
+			return res, nil
+		case http.StatusInternalServerError:
+			var (
+				body GetGroupsioMailingListInternalServerErrorResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("mailing-list", "get-groupsio-mailing-list", err)
+			}
+			err = ValidateGetGroupsioMailingListInternalServerErrorResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("mailing-list", "get-groupsio-mailing-list", err)
+			}
+			return nil, NewGetGroupsioMailingListInternalServerError(&body)
+		case http.StatusNotFound:
+			var (
+				body GetGroupsioMailingListNotFoundResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("mailing-list", "get-groupsio-mailing-list", err)
+			}
+			err = ValidateGetGroupsioMailingListNotFoundResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("mailing-list", "get-groupsio-mailing-list", err)
+			}
+			return nil, NewGetGroupsioMailingListNotFound(&body)
+		case http.StatusServiceUnavailable:
+			var (
+				body GetGroupsioMailingListServiceUnavailableResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("mailing-list", "get-groupsio-mailing-list", err)
+			}
+			err = ValidateGetGroupsioMailingListServiceUnavailableResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("mailing-list", "get-groupsio-mailing-list", err)
+			}
+			return nil, NewGetGroupsioMailingListServiceUnavailable(&body)
+		default:
+			body, _ := io.ReadAll(resp.Body)
+			return nil, goahttp.ErrInvalidResponse("mailing-list", "get-groupsio-mailing-list", resp.StatusCode, string(body))
+		}
+	}
+}
+
+// BuildUpdateGroupsioMailingListRequest instantiates a HTTP request object
+// with method and path set to call the "mailing-list" service
+// "update-groupsio-mailing-list" endpoint
+func (c *Client) BuildUpdateGroupsioMailingListRequest(ctx context.Context, v any) (*http.Request, error) {
+	var (
+		subgroupID string
+	)
+	{
+		p, ok := v.(*mailinglist.UpdateGroupsioMailingListPayload)
+		if !ok {
+			return nil, goahttp.ErrInvalidType("mailing-list", "update-groupsio-mailing-list", "*mailinglist.UpdateGroupsioMailingListPayload", v)
+		}
+		subgroupID = p.SubgroupID
+	}
+	u := &url.URL{Scheme: c.scheme, Host: c.host, Path: UpdateGroupsioMailingListMailingListPath(subgroupID)}
+	req, err := http.NewRequest("PUT", u.String(), nil)
+	if err != nil {
+		return nil, goahttp.ErrInvalidURL("mailing-list", "update-groupsio-mailing-list", u.String(), err)
+	}
+	if ctx != nil {
+		req = req.WithContext(ctx)
+	}
+
+	return req, nil
+}
+
+// EncodeUpdateGroupsioMailingListRequest returns an encoder for requests sent
+// to the mailing-list update-groupsio-mailing-list server.
+func EncodeUpdateGroupsioMailingListRequest(encoder func(*http.Request) goahttp.Encoder) func(*http.Request, any) error {
+	return func(req *http.Request, v any) error {
+		p, ok := v.(*mailinglist.UpdateGroupsioMailingListPayload)
+		if !ok {
+			return goahttp.ErrInvalidType("mailing-list", "update-groupsio-mailing-list", "*mailinglist.UpdateGroupsioMailingListPayload", v)
+		}
+		if p.BearerToken != nil {
+			head := *p.BearerToken
+			if !strings.Contains(head, " ") {
+				req.Header.Set("Authorization", "Bearer "+head)
+			} else {
+				req.Header.Set("Authorization", head)
+			}
+		}
+		body := NewUpdateGroupsioMailingListRequestBody(p)
+		if err := encoder(req).Encode(&body); err != nil {
+			return goahttp.ErrEncodingError("mailing-list", "update-groupsio-mailing-list", err)
+		}
+		return nil
+	}
+}
+
+// DecodeUpdateGroupsioMailingListResponse returns a decoder for responses
+// returned by the mailing-list update-groupsio-mailing-list endpoint.
+// restoreBody controls whether the response body should be restored after
+// having been read.
+// DecodeUpdateGroupsioMailingListResponse may return the following errors:
+//   - "BadRequest" (type *mailinglist.BadRequestError): http.StatusBadRequest
+//   - "InternalServerError" (type *mailinglist.InternalServerError): http.StatusInternalServerError
+//   - "NotFound" (type *mailinglist.NotFoundError): http.StatusNotFound
+//   - "ServiceUnavailable" (type *mailinglist.ServiceUnavailableError): http.StatusServiceUnavailable
+//   - error: internal error
+func DecodeUpdateGroupsioMailingListResponse(decoder func(*http.Response) goahttp.Decoder, restoreBody bool) func(*http.Response) (any, error) {
+	return func(resp *http.Response) (any, error) {
+		if restoreBody {
+			b, err := io.ReadAll(resp.Body)
+			if err != nil {
+				return nil, err
+			}
+			resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			defer func() {
+				resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			}()
+		} else {
+			defer resp.Body.Close()
+		}
+		switch resp.StatusCode {
+		case http.StatusOK:
+			var (
+				body UpdateGroupsioMailingListResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("mailing-list", "update-groupsio-mailing-list", err)
+			}
+			err = ValidateUpdateGroupsioMailingListResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("mailing-list", "update-groupsio-mailing-list", err)
+			}
+			res := NewUpdateGroupsioMailingListGroupsioSubgroupOK(&body)
+			return res, nil
+		case http.StatusBadRequest:
+			var (
+				body UpdateGroupsioMailingListBadRequestResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("mailing-list", "update-groupsio-mailing-list", err)
+			}
+			err = ValidateUpdateGroupsioMailingListBadRequestResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("mailing-list", "update-groupsio-mailing-list", err)
+			}
+			return nil, NewUpdateGroupsioMailingListBadRequest(&body)
+		case http.StatusInternalServerError:
+			var (
+				body UpdateGroupsioMailingListInternalServerErrorResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("mailing-list", "update-groupsio-mailing-list", err)
+			}
+			err = ValidateUpdateGroupsioMailingListInternalServerErrorResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("mailing-list", "update-groupsio-mailing-list", err)
+			}
+			return nil, NewUpdateGroupsioMailingListInternalServerError(&body)
+		case http.StatusNotFound:
+			var (
+				body UpdateGroupsioMailingListNotFoundResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("mailing-list", "update-groupsio-mailing-list", err)
+			}
+			err = ValidateUpdateGroupsioMailingListNotFoundResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("mailing-list", "update-groupsio-mailing-list", err)
+			}
+			return nil, NewUpdateGroupsioMailingListNotFound(&body)
+		case http.StatusServiceUnavailable:
+			var (
+				body UpdateGroupsioMailingListServiceUnavailableResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("mailing-list", "update-groupsio-mailing-list", err)
+			}
+			err = ValidateUpdateGroupsioMailingListServiceUnavailableResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("mailing-list", "update-groupsio-mailing-list", err)
+			}
+			return nil, NewUpdateGroupsioMailingListServiceUnavailable(&body)
+		default:
+			body, _ := io.ReadAll(resp.Body)
+			return nil, goahttp.ErrInvalidResponse("mailing-list", "update-groupsio-mailing-list", resp.StatusCode, string(body))
+		}
+	}
+}
+
+// BuildDeleteGroupsioMailingListRequest instantiates a HTTP request object
+// with method and path set to call the "mailing-list" service
+// "delete-groupsio-mailing-list" endpoint
+func (c *Client) BuildDeleteGroupsioMailingListRequest(ctx context.Context, v any) (*http.Request, error) {
+	var (
+		subgroupID string
+	)
+	{
+		p, ok := v.(*mailinglist.DeleteGroupsioMailingListPayload)
+		if !ok {
+			return nil, goahttp.ErrInvalidType("mailing-list", "delete-groupsio-mailing-list", "*mailinglist.DeleteGroupsioMailingListPayload", v)
+		}
+		subgroupID = p.SubgroupID
+	}
+	u := &url.URL{Scheme: c.scheme, Host: c.host, Path: DeleteGroupsioMailingListMailingListPath(subgroupID)}
+	req, err := http.NewRequest("DELETE", u.String(), nil)
+	if err != nil {
+		return nil, goahttp.ErrInvalidURL("mailing-list", "delete-groupsio-mailing-list", u.String(), err)
+	}
+	if ctx != nil {
+		req = req.WithContext(ctx)
+	}
+
+	return req, nil
+}
+
+// EncodeDeleteGroupsioMailingListRequest returns an encoder for requests sent
+// to the mailing-list delete-groupsio-mailing-list server.
+func EncodeDeleteGroupsioMailingListRequest(encoder func(*http.Request) goahttp.Encoder) func(*http.Request, any) error {
+	return func(req *http.Request, v any) error {
+		p, ok := v.(*mailinglist.DeleteGroupsioMailingListPayload)
+		if !ok {
+			return goahttp.ErrInvalidType("mailing-list", "delete-groupsio-mailing-list", "*mailinglist.DeleteGroupsioMailingListPayload", v)
+		}
+		if p.BearerToken != nil {
+			head := *p.BearerToken
+			if !strings.Contains(head, " ") {
+				req.Header.Set("Authorization", "Bearer "+head)
+			} else {
+				req.Header.Set("Authorization", head)
+			}
+		}
+		return nil
+	}
+}
+
+// DecodeDeleteGroupsioMailingListResponse returns a decoder for responses
+// returned by the mailing-list delete-groupsio-mailing-list endpoint.
+// restoreBody controls whether the response body should be restored after
+// having been read.
+// DecodeDeleteGroupsioMailingListResponse may return the following errors:
+//   - "InternalServerError" (type *mailinglist.InternalServerError): http.StatusInternalServerError
+//   - "NotFound" (type *mailinglist.NotFoundError): http.StatusNotFound
+//   - "ServiceUnavailable" (type *mailinglist.ServiceUnavailableError): http.StatusServiceUnavailable
+//   - error: internal error
+func DecodeDeleteGroupsioMailingListResponse(decoder func(*http.Response) goahttp.Decoder, restoreBody bool) func(*http.Response) (any, error) {
+	return func(resp *http.Response) (any, error) {
+		if restoreBody {
+			b, err := io.ReadAll(resp.Body)
+			if err != nil {
+				return nil, err
+			}
+			resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			defer func() {
+				resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			}()
+		} else {
+			defer resp.Body.Close()
+		}
+		switch resp.StatusCode {
+		case http.StatusNoContent:
+			return nil, nil
+		case http.StatusInternalServerError:
+			var (
+				body DeleteGroupsioMailingListInternalServerErrorResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("mailing-list", "delete-groupsio-mailing-list", err)
+			}
+			err = ValidateDeleteGroupsioMailingListInternalServerErrorResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("mailing-list", "delete-groupsio-mailing-list", err)
+			}
+			return nil, NewDeleteGroupsioMailingListInternalServerError(&body)
+		case http.StatusNotFound:
+			var (
+				body DeleteGroupsioMailingListNotFoundResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("mailing-list", "delete-groupsio-mailing-list", err)
+			}
+			err = ValidateDeleteGroupsioMailingListNotFoundResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("mailing-list", "delete-groupsio-mailing-list", err)
+			}
+			return nil, NewDeleteGroupsioMailingListNotFound(&body)
+		case http.StatusServiceUnavailable:
+			var (
+				body DeleteGroupsioMailingListServiceUnavailableResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("mailing-list", "delete-groupsio-mailing-list", err)
+			}
+			err = ValidateDeleteGroupsioMailingListServiceUnavailableResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("mailing-list", "delete-groupsio-mailing-list", err)
+			}
+			return nil, NewDeleteGroupsioMailingListServiceUnavailable(&body)
+		default:
+			body, _ := io.ReadAll(resp.Body)
+			return nil, goahttp.ErrInvalidResponse("mailing-list", "delete-groupsio-mailing-list", resp.StatusCode, string(body))
+		}
+	}
+}
+
+// BuildGetGroupsioMailingListCountRequest instantiates a HTTP request object
+// with method and path set to call the "mailing-list" service
+// "get-groupsio-mailing-list-count" endpoint
+func (c *Client) BuildGetGroupsioMailingListCountRequest(ctx context.Context, v any) (*http.Request, error) {
+	u := &url.URL{Scheme: c.scheme, Host: c.host, Path: GetGroupsioMailingListCountMailingListPath()}
+	req, err := http.NewRequest("GET", u.String(), nil)
+	if err != nil {
+		return nil, goahttp.ErrInvalidURL("mailing-list", "get-groupsio-mailing-list-count", u.String(), err)
+	}
+	if ctx != nil {
+		req = req.WithContext(ctx)
+	}
+
+	return req, nil
+}
+
+// EncodeGetGroupsioMailingListCountRequest returns an encoder for requests
+// sent to the mailing-list get-groupsio-mailing-list-count server.
+func EncodeGetGroupsioMailingListCountRequest(encoder func(*http.Request) goahttp.Encoder) func(*http.Request, any) error {
+	return func(req *http.Request, v any) error {
+		p, ok := v.(*mailinglist.GetGroupsioMailingListCountPayload)
+		if !ok {
+			return goahttp.ErrInvalidType("mailing-list", "get-groupsio-mailing-list-count", "*mailinglist.GetGroupsioMailingListCountPayload", v)
+		}
+		if p.BearerToken != nil {
+			head := *p.BearerToken
+			if !strings.Contains(head, " ") {
+				req.Header.Set("Authorization", "Bearer "+head)
+			} else {
+				req.Header.Set("Authorization", head)
+			}
+		}
+		values := req.URL.Query()
+		values.Add("project_uid", p.ProjectUID)
+		req.URL.RawQuery = values.Encode()
+		return nil
+	}
+}
+
+// DecodeGetGroupsioMailingListCountResponse returns a decoder for responses
+// returned by the mailing-list get-groupsio-mailing-list-count endpoint.
+// restoreBody controls whether the response body should be restored after
+// having been read.
+// DecodeGetGroupsioMailingListCountResponse may return the following errors:
+//   - "BadRequest" (type *mailinglist.BadRequestError): http.StatusBadRequest
+//   - "InternalServerError" (type *mailinglist.InternalServerError): http.StatusInternalServerError
+//   - "ServiceUnavailable" (type *mailinglist.ServiceUnavailableError): http.StatusServiceUnavailable
+//   - error: internal error
+func DecodeGetGroupsioMailingListCountResponse(decoder func(*http.Response) goahttp.Decoder, restoreBody bool) func(*http.Response) (any, error) {
+	return func(resp *http.Response) (any, error) {
+		if restoreBody {
+			b, err := io.ReadAll(resp.Body)
+			if err != nil {
+				return nil, err
+			}
+			resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			defer func() {
+				resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			}()
+		} else {
+			defer resp.Body.Close()
+		}
+		switch resp.StatusCode {
+		case http.StatusOK:
+			var (
+				body GetGroupsioMailingListCountResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("mailing-list", "get-groupsio-mailing-list-count", err)
+			}
+			err = ValidateGetGroupsioMailingListCountResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("mailing-list", "get-groupsio-mailing-list-count", err)
+			}
+			res := NewGetGroupsioMailingListCountGroupsioCountOK(&body)
+			return res, nil
+		case http.StatusBadRequest:
+			var (
+				body GetGroupsioMailingListCountBadRequestResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("mailing-list", "get-groupsio-mailing-list-count", err)
+			}
+			err = ValidateGetGroupsioMailingListCountBadRequestResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("mailing-list", "get-groupsio-mailing-list-count", err)
+			}
+			return nil, NewGetGroupsioMailingListCountBadRequest(&body)
+		case http.StatusInternalServerError:
+			var (
+				body GetGroupsioMailingListCountInternalServerErrorResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("mailing-list", "get-groupsio-mailing-list-count", err)
+			}
+			err = ValidateGetGroupsioMailingListCountInternalServerErrorResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("mailing-list", "get-groupsio-mailing-list-count", err)
+			}
+			return nil, NewGetGroupsioMailingListCountInternalServerError(&body)
+		case http.StatusServiceUnavailable:
+			var (
+				body GetGroupsioMailingListCountServiceUnavailableResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("mailing-list", "get-groupsio-mailing-list-count", err)
+			}
+			err = ValidateGetGroupsioMailingListCountServiceUnavailableResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("mailing-list", "get-groupsio-mailing-list-count", err)
+			}
+			return nil, NewGetGroupsioMailingListCountServiceUnavailable(&body)
+		default:
+			body, _ := io.ReadAll(resp.Body)
+			return nil, goahttp.ErrInvalidResponse("mailing-list", "get-groupsio-mailing-list-count", resp.StatusCode, string(body))
+		}
+	}
+}
+
+// BuildGetGroupsioMailingListMemberCountRequest instantiates a HTTP request
+// object with method and path set to call the "mailing-list" service
+// "get-groupsio-mailing-list-member-count" endpoint
+func (c *Client) BuildGetGroupsioMailingListMemberCountRequest(ctx context.Context, v any) (*http.Request, error) {
+	var (
+		subgroupID string
+	)
+	{
+		p, ok := v.(*mailinglist.GetGroupsioMailingListMemberCountPayload)
+		if !ok {
+			return nil, goahttp.ErrInvalidType("mailing-list", "get-groupsio-mailing-list-member-count", "*mailinglist.GetGroupsioMailingListMemberCountPayload", v)
+		}
+		subgroupID = p.SubgroupID
+	}
+	u := &url.URL{Scheme: c.scheme, Host: c.host, Path: GetGroupsioMailingListMemberCountMailingListPath(subgroupID)}
+	req, err := http.NewRequest("GET", u.String(), nil)
+	if err != nil {
+		return nil, goahttp.ErrInvalidURL("mailing-list", "get-groupsio-mailing-list-member-count", u.String(), err)
+	}
+	if ctx != nil {
+		req = req.WithContext(ctx)
+	}
+
+	return req, nil
+}
+
+// EncodeGetGroupsioMailingListMemberCountRequest returns an encoder for
+// requests sent to the mailing-list get-groupsio-mailing-list-member-count
+// server.
+func EncodeGetGroupsioMailingListMemberCountRequest(encoder func(*http.Request) goahttp.Encoder) func(*http.Request, any) error {
+	return func(req *http.Request, v any) error {
+		p, ok := v.(*mailinglist.GetGroupsioMailingListMemberCountPayload)
+		if !ok {
+			return goahttp.ErrInvalidType("mailing-list", "get-groupsio-mailing-list-member-count", "*mailinglist.GetGroupsioMailingListMemberCountPayload", v)
+		}
+		if p.BearerToken != nil {
+			head := *p.BearerToken
+			if !strings.Contains(head, " ") {
+				req.Header.Set("Authorization", "Bearer "+head)
+			} else {
+				req.Header.Set("Authorization", head)
+			}
+		}
+		return nil
+	}
+}
+
+// DecodeGetGroupsioMailingListMemberCountResponse returns a decoder for
+// responses returned by the mailing-list
+// get-groupsio-mailing-list-member-count endpoint. restoreBody controls
+// whether the response body should be restored after having been read.
+// DecodeGetGroupsioMailingListMemberCountResponse may return the following
+// errors:
+//   - "InternalServerError" (type *mailinglist.InternalServerError): http.StatusInternalServerError
+//   - "NotFound" (type *mailinglist.NotFoundError): http.StatusNotFound
+//   - "ServiceUnavailable" (type *mailinglist.ServiceUnavailableError): http.StatusServiceUnavailable
+//   - error: internal error
+func DecodeGetGroupsioMailingListMemberCountResponse(decoder func(*http.Response) goahttp.Decoder, restoreBody bool) func(*http.Response) (any, error) {
+	return func(resp *http.Response) (any, error) {
+		if restoreBody {
+			b, err := io.ReadAll(resp.Body)
+			if err != nil {
+				return nil, err
+			}
+			resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			defer func() {
+				resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			}()
+		} else {
+			defer resp.Body.Close()
+		}
+		switch resp.StatusCode {
+		case http.StatusOK:
+			var (
+				body GetGroupsioMailingListMemberCountResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("mailing-list", "get-groupsio-mailing-list-member-count", err)
+			}
+			err = ValidateGetGroupsioMailingListMemberCountResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("mailing-list", "get-groupsio-mailing-list-member-count", err)
+			}
+			res := NewGetGroupsioMailingListMemberCountGroupsioCountOK(&body)
+			return res, nil
+		case http.StatusInternalServerError:
+			var (
+				body GetGroupsioMailingListMemberCountInternalServerErrorResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("mailing-list", "get-groupsio-mailing-list-member-count", err)
+			}
+			err = ValidateGetGroupsioMailingListMemberCountInternalServerErrorResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("mailing-list", "get-groupsio-mailing-list-member-count", err)
+			}
+			return nil, NewGetGroupsioMailingListMemberCountInternalServerError(&body)
+		case http.StatusNotFound:
+			var (
+				body GetGroupsioMailingListMemberCountNotFoundResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("mailing-list", "get-groupsio-mailing-list-member-count", err)
+			}
+			err = ValidateGetGroupsioMailingListMemberCountNotFoundResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("mailing-list", "get-groupsio-mailing-list-member-count", err)
+			}
+			return nil, NewGetGroupsioMailingListMemberCountNotFound(&body)
+		case http.StatusServiceUnavailable:
+			var (
+				body GetGroupsioMailingListMemberCountServiceUnavailableResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("mailing-list", "get-groupsio-mailing-list-member-count", err)
+			}
+			err = ValidateGetGroupsioMailingListMemberCountServiceUnavailableResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("mailing-list", "get-groupsio-mailing-list-member-count", err)
+			}
+			return nil, NewGetGroupsioMailingListMemberCountServiceUnavailable(&body)
+		default:
+			body, _ := io.ReadAll(resp.Body)
+			return nil, goahttp.ErrInvalidResponse("mailing-list", "get-groupsio-mailing-list-member-count", resp.StatusCode, string(body))
+		}
+	}
+}
+
+// BuildListGroupsioMembersRequest instantiates a HTTP request object with
+// method and path set to call the "mailing-list" service
+// "list-groupsio-members" endpoint
+func (c *Client) BuildListGroupsioMembersRequest(ctx context.Context, v any) (*http.Request, error) {
+	var (
+		subgroupID string
+	)
+	{
+		p, ok := v.(*mailinglist.ListGroupsioMembersPayload)
+		if !ok {
+			return nil, goahttp.ErrInvalidType("mailing-list", "list-groupsio-members", "*mailinglist.ListGroupsioMembersPayload", v)
+		}
+		subgroupID = p.SubgroupID
+	}
+	u := &url.URL{Scheme: c.scheme, Host: c.host, Path: ListGroupsioMembersMailingListPath(subgroupID)}
+	req, err := http.NewRequest("GET", u.String(), nil)
+	if err != nil {
+		return nil, goahttp.ErrInvalidURL("mailing-list", "list-groupsio-members", u.String(), err)
+	}
+	if ctx != nil {
+		req = req.WithContext(ctx)
+	}
+
+	return req, nil
+}
+
+// EncodeListGroupsioMembersRequest returns an encoder for requests sent to the
+// mailing-list list-groupsio-members server.
+func EncodeListGroupsioMembersRequest(encoder func(*http.Request) goahttp.Encoder) func(*http.Request, any) error {
+	return func(req *http.Request, v any) error {
+		p, ok := v.(*mailinglist.ListGroupsioMembersPayload)
+		if !ok {
+			return goahttp.ErrInvalidType("mailing-list", "list-groupsio-members", "*mailinglist.ListGroupsioMembersPayload", v)
+		}
+		if p.BearerToken != nil {
+			head := *p.BearerToken
+			if !strings.Contains(head, " ") {
+				req.Header.Set("Authorization", "Bearer "+head)
+			} else {
+				req.Header.Set("Authorization", head)
+			}
+		}
+		return nil
+	}
+}
+
+// DecodeListGroupsioMembersResponse returns a decoder for responses returned
+// by the mailing-list list-groupsio-members endpoint. restoreBody controls
+// whether the response body should be restored after having been read.
+// DecodeListGroupsioMembersResponse may return the following errors:
+//   - "InternalServerError" (type *mailinglist.InternalServerError): http.StatusInternalServerError
+//   - "NotFound" (type *mailinglist.NotFoundError): http.StatusNotFound
+//   - "ServiceUnavailable" (type *mailinglist.ServiceUnavailableError): http.StatusServiceUnavailable
+//   - error: internal error
+func DecodeListGroupsioMembersResponse(decoder func(*http.Response) goahttp.Decoder, restoreBody bool) func(*http.Response) (any, error) {
+	return func(resp *http.Response) (any, error) {
+		if restoreBody {
+			b, err := io.ReadAll(resp.Body)
+			if err != nil {
+				return nil, err
+			}
+			resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			defer func() {
+				resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			}()
+		} else {
+			defer resp.Body.Close()
+		}
+		switch resp.StatusCode {
+		case http.StatusOK:
+			var (
+				body ListGroupsioMembersResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("mailing-list", "list-groupsio-members", err)
+			}
+			err = ValidateListGroupsioMembersResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("mailing-list", "list-groupsio-members", err)
+			}
+			res := NewListGroupsioMembersGroupsioMemberListOK(&body)
+			return res, nil
+		case http.StatusInternalServerError:
+			var (
+				body ListGroupsioMembersInternalServerErrorResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("mailing-list", "list-groupsio-members", err)
+			}
+			err = ValidateListGroupsioMembersInternalServerErrorResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("mailing-list", "list-groupsio-members", err)
+			}
+			return nil, NewListGroupsioMembersInternalServerError(&body)
+		case http.StatusNotFound:
+			var (
+				body ListGroupsioMembersNotFoundResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("mailing-list", "list-groupsio-members", err)
+			}
+			err = ValidateListGroupsioMembersNotFoundResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("mailing-list", "list-groupsio-members", err)
+			}
+			return nil, NewListGroupsioMembersNotFound(&body)
+		case http.StatusServiceUnavailable:
+			var (
+				body ListGroupsioMembersServiceUnavailableResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("mailing-list", "list-groupsio-members", err)
+			}
+			err = ValidateListGroupsioMembersServiceUnavailableResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("mailing-list", "list-groupsio-members", err)
+			}
+			return nil, NewListGroupsioMembersServiceUnavailable(&body)
+		default:
+			body, _ := io.ReadAll(resp.Body)
+			return nil, goahttp.ErrInvalidResponse("mailing-list", "list-groupsio-members", resp.StatusCode, string(body))
+		}
+	}
+}
+
+// BuildListGroupsioMembersNeedingReviewRequest instantiates a HTTP request
+// object with method and path set to call the "mailing-list" service
+// "list-groupsio-members-needing-review" endpoint
+func (c *Client) BuildListGroupsioMembersNeedingReviewRequest(ctx context.Context, v any) (*http.Request, error) {
+	var (
+		subgroupID string
+	)
+	{
+		p, ok := v.(*mailinglist.ListGroupsioMembersNeedingReviewPayload)
+		if !ok {
+			return nil, goahttp.ErrInvalidType("mailing-list", "list-groupsio-members-needing-review", "*mailinglist.ListGroupsioMembersNeedingReviewPayload", v)
+		}
+		subgroupID = p.SubgroupID
+	}
+	u := &url.URL{Scheme: c.scheme, Host: c.host, Path: ListGroupsioMembersNeedingReviewMailingListPath(subgroupID)}
+	req, err := http.NewRequest("GET", u.String(), nil)
+	if err != nil {
+		return nil, goahttp.ErrInvalidURL("mailing-list", "list-groupsio-members-needing-review", u.String(), err)
+	}
+	if ctx != nil {
+		req = req.WithContext(ctx)
+	}
+
+	return req, nil
+}
+
+// EncodeListGroupsioMembersNeedingReviewRequest returns an encoder for
+// requests sent to the mailing-list list-groupsio-members-needing-review
+// server.
+func EncodeListGroupsioMembersNeedingReviewRequest(encoder func(*http.Request) goahttp.Encoder) func(*http.Request, any) error {
+	return func(req *http.Request, v any) error {
+		p, ok := v.(*mailinglist.ListGroupsioMembersNeedingReviewPayload)
+		if !ok {
+			return goahttp.ErrInvalidType("mailing-list", "list-groupsio-members-needing-review", "*mailinglist.ListGroupsioMembersNeedingReviewPayload", v)
+		}
+		if p.BearerToken != nil {
+			head := *p.BearerToken
+			if !strings.Contains(head, " ") {
+				req.Header.Set("Authorization", "Bearer "+head)
+			} else {
+				req.Header.Set("Authorization", head)
+			}
+		}
+		values := req.URL.Query()
+		values.Add("older_than", p.OlderThan)
+		req.URL.RawQuery = values.Encode()
+		return nil
+	}
+}
+
+// DecodeListGroupsioMembersNeedingReviewResponse returns a decoder for
+// responses returned by the mailing-list list-groupsio-members-needing-review
+// endpoint. restoreBody controls whether the response body should be restored
+// after having been read.
+// DecodeListGroupsioMembersNeedingReviewResponse may return the following
+// errors:
+//   - "BadRequest" (type *mailinglist.BadRequestError): http.StatusBadRequest
+//   - "InternalServerError" (type *mailinglist.InternalServerError): http.StatusInternalServerError
+//   - "NotFound" (type *mailinglist.NotFoundError): http.StatusNotFound
+//   - "ServiceUnavailable" (type *mailinglist.ServiceUnavailableError): http.StatusServiceUnavailable
+//   - error: internal error
+func DecodeListGroupsioMembersNeedingReviewResponse(decoder func(*http.Response) goahttp.Decoder, restoreBody bool) func(*http.Response) (any, error) {
+	return func(resp *http.Response) (any, error) {
+		if restoreBody {
+			b, err := io.ReadAll(resp.Body)
+			if err != nil {
+				return nil, err
+			}
+			resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			defer func() {
+				resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			}()
+		} else {
+			defer resp.Body.Close()
+		}
+		switch resp.StatusCode {
+		case http.StatusOK:
+			var (
+				body ListGroupsioMembersNeedingReviewResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("mailing-list", "list-groupsio-members-needing-review", err)
+			}
+			err = ValidateListGroupsioMembersNeedingReviewResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("mailing-list", "list-groupsio-members-needing-review", err)
+			}
+			res := NewListGroupsioMembersNeedingReviewGroupsioMemberListOK(&body)
+			return res, nil
+		case http.StatusBadRequest:
+			var (
+				body ListGroupsioMembersNeedingReviewBadRequestResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("mailing-list", "list-groupsio-members-needing-review", err)
+			}
+			err = ValidateListGroupsioMembersNeedingReviewBadRequestResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("mailing-list", "list-groupsio-members-needing-review", err)
+			}
+			return nil, NewListGroupsioMembersNeedingReviewBadRequest(&body)
+		case http.StatusInternalServerError:
+			var (
+				body ListGroupsioMembersNeedingReviewInternalServerErrorResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("mailing-list", "list-groupsio-members-needing-review", err)
+			}
+			err = ValidateListGroupsioMembersNeedingReviewInternalServerErrorResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("mailing-list", "list-groupsio-members-needing-review", err)
+			}
+			return nil, NewListGroupsioMembersNeedingReviewInternalServerError(&body)
+		case http.StatusNotFound:
+			var (
+				body ListGroupsioMembersNeedingReviewNotFoundResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("mailing-list", "list-groupsio-members-needing-review", err)
+			}
+			err = ValidateListGroupsioMembersNeedingReviewNotFoundResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("mailing-list", "list-groupsio-members-needing-review", err)
+			}
+			return nil, NewListGroupsioMembersNeedingReviewNotFound(&body)
+		case http.StatusServiceUnavailable:
+			var (
+				body ListGroupsioMembersNeedingReviewServiceUnavailableResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("mailing-list", "list-groupsio-members-needing-review", err)
+			}
+			err = ValidateListGroupsioMembersNeedingReviewServiceUnavailableResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("mailing-list", "list-groupsio-members-needing-review", err)
+			}
+			return nil, NewListGroupsioMembersNeedingReviewServiceUnavailable(&body)
+		default:
+			body, _ := io.ReadAll(resp.Body)
+			return nil, goahttp.ErrInvalidResponse("mailing-list", "list-groupsio-members-needing-review", resp.StatusCode, string(body))
+		}
+	}
+}
+
+// BuildListGroupsioMembersModifiedSinceRequest instantiates a HTTP request
+// object with method and path set to call the "mailing-list" service
+// "list-groupsio-members-modified-since" endpoint
+func (c *Client) BuildListGroupsioMembersModifiedSinceRequest(ctx context.Context, v any) (*http.Request, error) {
+	var (
+		subgroupID string
+	)
+	{
+		p, ok := v.(*mailinglist.ListGroupsioMembersModifiedSincePayload)
+		if !ok {
+			return nil, goahttp.ErrInvalidType("mailing-list", "list-groupsio-members-modified-since", "*mailinglist.ListGroupsioMembersModifiedSincePayload", v)
+		}
+		subgroupID = p.SubgroupID
+	}
+	u := &url.URL{Scheme: c.scheme, Host: c.host, Path: ListGroupsioMembersModifiedSinceMailingListPath(subgroupID)}
+	req, err := http.NewRequest("GET", u.String(), nil)
+	if err != nil {
+		return nil, goahttp.ErrInvalidURL("mailing-list", "list-groupsio-members-modified-since", u.String(), err)
+	}
+	if ctx != nil {
+		req = req.WithContext(ctx)
+	}
+
+	return req, nil
+}
+
+// EncodeListGroupsioMembersModifiedSinceRequest returns an encoder for
+// requests sent to the mailing-list list-groupsio-members-modified-since
+// server.
+func EncodeListGroupsioMembersModifiedSinceRequest(encoder func(*http.Request) goahttp.Encoder) func(*http.Request, any) error {
+	return func(req *http.Request, v any) error {
+		p, ok := v.(*mailinglist.ListGroupsioMembersModifiedSincePayload)
+		if !ok {
+			return goahttp.ErrInvalidType("mailing-list", "list-groupsio-members-modified-since", "*mailinglist.ListGroupsioMembersModifiedSincePayload", v)
+		}
+		if p.BearerToken != nil {
+			head := *p.BearerToken
+			if !strings.Contains(head, " ") {
+				req.Header.Set("Authorization", "Bearer "+head)
+			} else {
+				req.Header.Set("Authorization", head)
+			}
+		}
+		values := req.URL.Query()
+		values.Add("since", p.Since)
+		req.URL.RawQuery = values.Encode()
+		return nil
+	}
+}
+
+// DecodeListGroupsioMembersModifiedSinceResponse returns a decoder for
+// responses returned by the mailing-list list-groupsio-members-modified-since
+// endpoint. restoreBody controls whether the response body should be restored
+// after having been read.
+// DecodeListGroupsioMembersModifiedSinceResponse may return the following
+// errors:
+//   - "BadRequest" (type *mailinglist.BadRequestError): http.StatusBadRequest
+//   - "InternalServerError" (type *mailinglist.InternalServerError): http.StatusInternalServerError
+//   - "NotFound" (type *mailinglist.NotFoundError): http.StatusNotFound
+//   - "ServiceUnavailable" (type *mailinglist.ServiceUnavailableError): http.StatusServiceUnavailable
+//   - error: internal error
+func DecodeListGroupsioMembersModifiedSinceResponse(decoder func(*http.Response) goahttp.Decoder, restoreBody bool) func(*http.Response) (any, error) {
+	return func(resp *http.Response) (any, error) {
+		if restoreBody {
+			b, err := io.ReadAll(resp.Body)
+			if err != nil {
+				return nil, err
+			}
+			resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			defer func() {
+				resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			}()
+		} else {
+			defer resp.Body.Close()
+		}
+		switch resp.StatusCode {
+		case http.StatusOK:
+			var (
+				body ListGroupsioMembersModifiedSinceResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("mailing-list", "list-groupsio-members-modified-since", err)
+			}
+			err = ValidateListGroupsioMembersModifiedSinceResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("mailing-list", "list-groupsio-members-modified-since", err)
+			}
+			res := NewListGroupsioMembersModifiedSinceGroupsioMemberListOK(&body)
+			return res, nil
+		case http.StatusBadRequest:
+			var (
+				body ListGroupsioMembersModifiedSinceBadRequestResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("mailing-list", "list-groupsio-members-modified-since", err)
+			}
+			err = ValidateListGroupsioMembersModifiedSinceBadRequestResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("mailing-list", "list-groupsio-members-modified-since", err)
+			}
+			return nil, NewListGroupsioMembersModifiedSinceBadRequest(&body)
+		case http.StatusInternalServerError:
+			var (
+				body ListGroupsioMembersModifiedSinceInternalServerErrorResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("mailing-list", "list-groupsio-members-modified-since", err)
+			}
+			err = ValidateListGroupsioMembersModifiedSinceInternalServerErrorResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("mailing-list", "list-groupsio-members-modified-since", err)
+			}
+			return nil, NewListGroupsioMembersModifiedSinceInternalServerError(&body)
+		case http.StatusNotFound:
+			var (
+				body ListGroupsioMembersModifiedSinceNotFoundResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("mailing-list", "list-groupsio-members-modified-since", err)
+			}
+			err = ValidateListGroupsioMembersModifiedSinceNotFoundResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("mailing-list", "list-groupsio-members-modified-since", err)
+			}
+			return nil, NewListGroupsioMembersModifiedSinceNotFound(&body)
+		case http.StatusServiceUnavailable:
+			var (
+				body ListGroupsioMembersModifiedSinceServiceUnavailableResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("mailing-list", "list-groupsio-members-modified-since", err)
+			}
+			err = ValidateListGroupsioMembersModifiedSinceServiceUnavailableResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("mailing-list", "list-groupsio-members-modified-since", err)
+			}
+			return nil, NewListGroupsioMembersModifiedSinceServiceUnavailable(&body)
+		default:
+			body, _ := io.ReadAll(resp.Body)
+			return nil, goahttp.ErrInvalidResponse("mailing-list", "list-groupsio-members-modified-since", resp.StatusCode, string(body))
+		}
+	}
+}
+
+// BuildListGroupsioMembersByOrganizationRequest instantiates a HTTP request
+// object with method and path set to call the "mailing-list" service
+// "list-groupsio-members-by-organization" endpoint
+func (c *Client) BuildListGroupsioMembersByOrganizationRequest(ctx context.Context, v any) (*http.Request, error) {
+	var (
+		subgroupID string
+	)
+	{
+		p, ok := v.(*mailinglist.ListGroupsioMembersByOrganizationPayload)
+		if !ok {
+			return nil, goahttp.ErrInvalidType("mailing-list", "list-groupsio-members-by-organization", "*mailinglist.ListGroupsioMembersByOrganizationPayload", v)
+		}
+		subgroupID = p.SubgroupID
+	}
+	u := &url.URL{Scheme: c.scheme, Host: c.host, Path: ListGroupsioMembersByOrganizationMailingListPath(subgroupID)}
+	req, err := http.NewRequest("GET", u.String(), nil)
+	if err != nil {
+		return nil, goahttp.ErrInvalidURL("mailing-list", "list-groupsio-members-by-organization", u.String(), err)
+	}
+	if ctx != nil {
+		req = req.WithContext(ctx)
+	}
+
+	return req, nil
+}
+
+// EncodeListGroupsioMembersByOrganizationRequest returns an encoder for
+// requests sent to the mailing-list list-groupsio-members-by-organization
+// server.
+func EncodeListGroupsioMembersByOrganizationRequest(encoder func(*http.Request) goahttp.Encoder) func(*http.Request, any) error {
+	return func(req *http.Request, v any) error {
+		p, ok := v.(*mailinglist.ListGroupsioMembersByOrganizationPayload)
+		if !ok {
+			return goahttp.ErrInvalidType("mailing-list", "list-groupsio-members-by-organization", "*mailinglist.ListGroupsioMembersByOrganizationPayload", v)
+		}
+		if p.BearerToken != nil {
+			head := *p.BearerToken
+			if !strings.Contains(head, " ") {
+				req.Header.Set("Authorization", "Bearer "+head)
+			} else {
+				req.Header.Set("Authorization", head)
+			}
+		}
+		values := req.URL.Query()
+		values.Add("organization", p.Organization)
+		req.URL.RawQuery = values.Encode()
+		return nil
+	}
+}
+
+// DecodeListGroupsioMembersByOrganizationResponse returns a decoder for
+// responses returned by the mailing-list list-groupsio-members-by-organization
+// endpoint. restoreBody controls whether the response body should be restored
+// after having been read.
+// DecodeListGroupsioMembersByOrganizationResponse may return the following
+// errors:
+//   - "BadRequest" (type *mailinglist.BadRequestError): http.StatusBadRequest
+//   - "InternalServerError" (type *mailinglist.InternalServerError): http.StatusInternalServerError
+//   - "NotFound" (type *mailinglist.NotFoundError): http.StatusNotFound
+//   - "ServiceUnavailable" (type *mailinglist.ServiceUnavailableError): http.StatusServiceUnavailable
+//   - error: internal error
+func DecodeListGroupsioMembersByOrganizationResponse(decoder func(*http.Response) goahttp.Decoder, restoreBody bool) func(*http.Response) (any, error) {
+	return func(resp *http.Response) (any, error) {
+		if restoreBody {
+			b, err := io.ReadAll(resp.Body)
+			if err != nil {
+				return nil, err
+			}
+			resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			defer func() {
+				resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			}()
+		} else {
+			defer resp.Body.Close()
+		}
+		switch resp.StatusCode {
+		case http.StatusOK:
+			var (
+				body ListGroupsioMembersByOrganizationResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("mailing-list", "list-groupsio-members-by-organization", err)
+			}
+			err = ValidateListGroupsioMembersByOrganizationResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("mailing-list", "list-groupsio-members-by-organization", err)
+			}
+			res := NewListGroupsioMembersByOrganizationGroupsioMemberListOK(&body)
+			return res, nil
+		case http.StatusBadRequest:
+			var (
+				body ListGroupsioMembersByOrganizationBadRequestResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("mailing-list", "list-groupsio-members-by-organization", err)
+			}
+			err = ValidateListGroupsioMembersByOrganizationBadRequestResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("mailing-list", "list-groupsio-members-by-organization", err)
+			}
+			return nil, NewListGroupsioMembersByOrganizationBadRequest(&body)
+		case http.StatusInternalServerError:
+			var (
+				body ListGroupsioMembersByOrganizationInternalServerErrorResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("mailing-list", "list-groupsio-members-by-organization", err)
+			}
+			err = ValidateListGroupsioMembersByOrganizationInternalServerErrorResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("mailing-list", "list-groupsio-members-by-organization", err)
+			}
+			return nil, NewListGroupsioMembersByOrganizationInternalServerError(&body)
+		case http.StatusNotFound:
+			var (
+				body ListGroupsioMembersByOrganizationNotFoundResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("mailing-list", "list-groupsio-members-by-organization", err)
+			}
+			err = ValidateListGroupsioMembersByOrganizationNotFoundResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("mailing-list", "list-groupsio-members-by-organization", err)
+			}
+			return nil, NewListGroupsioMembersByOrganizationNotFound(&body)
+		case http.StatusServiceUnavailable:
+			var (
+				body ListGroupsioMembersByOrganizationServiceUnavailableResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("mailing-list", "list-groupsio-members-by-organization", err)
+			}
+			err = ValidateListGroupsioMembersByOrganizationServiceUnavailableResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("mailing-list", "list-groupsio-members-by-organization", err)
+			}
+			return nil, NewListGroupsioMembersByOrganizationServiceUnavailable(&body)
+		default:
+			body, _ := io.ReadAll(resp.Body)
+			return nil, goahttp.ErrInvalidResponse("mailing-list", "list-groupsio-members-by-organization", resp.StatusCode, string(body))
+		}
+	}
+}
+
+// BuildListGroupsioDuplicateMembersRequest instantiates a HTTP request object
+// with method and path set to call the "mailing-list" service
+// "list-groupsio-duplicate-members" endpoint
+func (c *Client) BuildListGroupsioDuplicateMembersRequest(ctx context.Context, v any) (*http.Request, error) {
+	var (
+		subgroupID string
+	)
+	{
+		p, ok := v.(*mailinglist.ListGroupsioDuplicateMembersPayload)
+		if !ok {
+			return nil, goahttp.ErrInvalidType("mailing-list", "list-groupsio-duplicate-members", "*mailinglist.ListGroupsioDuplicateMembersPayload", v)
+		}
+		subgroupID = p.SubgroupID
+	}
+	u := &url.URL{Scheme: c.scheme, Host: c.host, Path: ListGroupsioDuplicateMembersMailingListPath(subgroupID)}
+	req, err := http.NewRequest("GET", u.String(), nil)
+	if err != nil {
+		return nil, goahttp.ErrInvalidURL("mailing-list", "list-groupsio-duplicate-members", u.String(), err)
+	}
+	if ctx != nil {
+		req = req.WithContext(ctx)
+	}
+
+	return req, nil
+}
+
+// EncodeListGroupsioDuplicateMembersRequest returns an encoder for requests
+// sent to the mailing-list list-groupsio-duplicate-members server.
+func EncodeListGroupsioDuplicateMembersRequest(encoder func(*http.Request) goahttp.Encoder) func(*http.Request, any) error {
+	return func(req *http.Request, v any) error {
+		p, ok := v.(*mailinglist.ListGroupsioDuplicateMembersPayload)
+		if !ok {
+			return goahttp.ErrInvalidType("mailing-list", "list-groupsio-duplicate-members", "*mailinglist.ListGroupsioDuplicateMembersPayload", v)
+		}
+		if p.BearerToken != nil {
+			head := *p.BearerToken
+			if !strings.Contains(head, " ") {
+				req.Header.Set("Authorization", "Bearer "+head)
+			} else {
+				req.Header.Set("Authorization", head)
+			}
+		}
+		return nil
+	}
+}
+
+// DecodeListGroupsioDuplicateMembersResponse returns a decoder for responses
+// returned by the mailing-list list-groupsio-duplicate-members endpoint.
+// restoreBody controls whether the response body should be restored after
+// having been read.
+// DecodeListGroupsioDuplicateMembersResponse may return the following errors:
+//   - "InternalServerError" (type *mailinglist.InternalServerError): http.StatusInternalServerError
+//   - "NotFound" (type *mailinglist.NotFoundError): http.StatusNotFound
+//   - "ServiceUnavailable" (type *mailinglist.ServiceUnavailableError): http.StatusServiceUnavailable
+//   - error: internal error
+func DecodeListGroupsioDuplicateMembersResponse(decoder func(*http.Response) goahttp.Decoder, restoreBody bool) func(*http.Response) (any, error) {
+	return func(resp *http.Response) (any, error) {
+		if restoreBody {
+			b, err := io.ReadAll(resp.Body)
+			if err != nil {
+				return nil, err
+			}
+			resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			defer func() {
+				resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			}()
+		} else {
+			defer resp.Body.Close()
+		}
+		switch resp.StatusCode {
+		case http.StatusOK:
+			var (
+				body ListGroupsioDuplicateMembersResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("mailing-list", "list-groupsio-duplicate-members", err)
+			}
+			err = ValidateListGroupsioDuplicateMembersResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("mailing-list", "list-groupsio-duplicate-members", err)
+			}
+			res := NewListGroupsioDuplicateMembersGroupsioMemberDuplicatesOK(&body)
+			return res, nil
+		case http.StatusInternalServerError:
+			var (
+				body ListGroupsioDuplicateMembersInternalServerErrorResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("mailing-list", "list-groupsio-duplicate-members", err)
+			}
+			err = ValidateListGroupsioDuplicateMembersInternalServerErrorResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("mailing-list", "list-groupsio-duplicate-members", err)
+			}
+			return nil, NewListGroupsioDuplicateMembersInternalServerError(&body)
+		case http.StatusNotFound:
+			var (
+				body ListGroupsioDuplicateMembersNotFoundResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("mailing-list", "list-groupsio-duplicate-members", err)
+			}
+			err = ValidateListGroupsioDuplicateMembersNotFoundResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("mailing-list", "list-groupsio-duplicate-members", err)
+			}
+			return nil, NewListGroupsioDuplicateMembersNotFound(&body)
+		case http.StatusServiceUnavailable:
+			var (
+				body ListGroupsioDuplicateMembersServiceUnavailableResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("mailing-list", "list-groupsio-duplicate-members", err)
+			}
+			err = ValidateListGroupsioDuplicateMembersServiceUnavailableResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("mailing-list", "list-groupsio-duplicate-members", err)
+			}
+			return nil, NewListGroupsioDuplicateMembersServiceUnavailable(&body)
+		default:
+			body, _ := io.ReadAll(resp.Body)
+			return nil, goahttp.ErrInvalidResponse("mailing-list", "list-groupsio-duplicate-members", resp.StatusCode, string(body))
+		}
+	}
+}
+
+// BuildPreviewGroupsioDeliveryModeChangeRequest instantiates a HTTP request
+// object with method and path set to call the "mailing-list" service
+// "preview-groupsio-delivery-mode-change" endpoint
+func (c *Client) BuildPreviewGroupsioDeliveryModeChangeRequest(ctx context.Context, v any) (*http.Request, error) {
+	var (
+		subgroupID string
+	)
+	{
+		p, ok := v.(*mailinglist.PreviewGroupsioDeliveryModeChangePayload)
+		if !ok {
+			return nil, goahttp.ErrInvalidType("mailing-list", "preview-groupsio-delivery-mode-change", "*mailinglist.PreviewGroupsioDeliveryModeChangePayload", v)
+		}
+		subgroupID = p.SubgroupID
+	}
+	u := &url.URL{Scheme: c.scheme, Host: c.host, Path: PreviewGroupsioDeliveryModeChangeMailingListPath(subgroupID)}
+	req, err := http.NewRequest("GET", u.String(), nil)
+	if err != nil {
+		return nil, goahttp.ErrInvalidURL("mailing-list", "preview-groupsio-delivery-mode-change", u.String(), err)
+	}
+	if ctx != nil {
+		req = req.WithContext(ctx)
+	}
+
+	return req, nil
+}
+
+// EncodePreviewGroupsioDeliveryModeChangeRequest returns an encoder for
+// requests sent to the mailing-list preview-groupsio-delivery-mode-change
+// server.
+func EncodePreviewGroupsioDeliveryModeChangeRequest(encoder func(*http.Request) goahttp.Encoder) func(*http.Request, any) error {
+	return func(req *http.Request, v any) error {
+		p, ok := v.(*mailinglist.PreviewGroupsioDeliveryModeChangePayload)
+		if !ok {
+			return goahttp.ErrInvalidType("mailing-list", "preview-groupsio-delivery-mode-change", "*mailinglist.PreviewGroupsioDeliveryModeChangePayload", v)
+		}
+		if p.BearerToken != nil {
+			head := *p.BearerToken
+			if !strings.Contains(head, " ") {
+				req.Header.Set("Authorization", "Bearer "+head)
+			} else {
+				req.Header.Set("Authorization", head)
+			}
+		}
+		values := req.URL.Query()
+		values.Add("target_mode", p.TargetMode)
+		req.URL.RawQuery = values.Encode()
+		return nil
+	}
+}
+
+// DecodePreviewGroupsioDeliveryModeChangeResponse returns a decoder for
+// responses returned by the mailing-list preview-groupsio-delivery-mode-change
+// endpoint. restoreBody controls whether the response body should be restored
+// after having been read.
+// DecodePreviewGroupsioDeliveryModeChangeResponse may return the following
+// errors:
+//   - "BadRequest" (type *mailinglist.BadRequestError): http.StatusBadRequest
+//   - "InternalServerError" (type *mailinglist.InternalServerError): http.StatusInternalServerError
+//   - "NotFound" (type *mailinglist.NotFoundError): http.StatusNotFound
+//   - "ServiceUnavailable" (type *mailinglist.ServiceUnavailableError): http.StatusServiceUnavailable
+//   - error: internal error
+func DecodePreviewGroupsioDeliveryModeChangeResponse(decoder func(*http.Response) goahttp.Decoder, restoreBody bool) func(*http.Response) (any, error) {
+	return func(resp *http.Response) (any, error) {
+		if restoreBody {
+			b, err := io.ReadAll(resp.Body)
+			if err != nil {
+				return nil, err
+			}
+			resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			defer func() {
+				resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			}()
+		} else {
+			defer resp.Body.Close()
+		}
+		switch resp.StatusCode {
+		case http.StatusOK:
+			var (
+				body PreviewGroupsioDeliveryModeChangeResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("mailing-list", "preview-groupsio-delivery-mode-change", err)
+			}
+			err = ValidatePreviewGroupsioDeliveryModeChangeResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("mailing-list", "preview-groupsio-delivery-mode-change", err)
+			}
+			res := NewPreviewGroupsioDeliveryModeChangeGroupsioDeliveryModePreviewOK(&body)
+			return res, nil
+		case http.StatusBadRequest:
+			var (
+				body PreviewGroupsioDeliveryModeChangeBadRequestResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("mailing-list", "preview-groupsio-delivery-mode-change", err)
+			}
+			err = ValidatePreviewGroupsioDeliveryModeChangeBadRequestResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("mailing-list", "preview-groupsio-delivery-mode-change", err)
+			}
+			return nil, NewPreviewGroupsioDeliveryModeChangeBadRequest(&body)
+		case http.StatusInternalServerError:
+			var (
+				body PreviewGroupsioDeliveryModeChangeInternalServerErrorResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("mailing-list", "preview-groupsio-delivery-mode-change", err)
+			}
+			err = ValidatePreviewGroupsioDeliveryModeChangeInternalServerErrorResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("mailing-list", "preview-groupsio-delivery-mode-change", err)
+			}
+			return nil, NewPreviewGroupsioDeliveryModeChangeInternalServerError(&body)
+		case http.StatusNotFound:
+			var (
+				body PreviewGroupsioDeliveryModeChangeNotFoundResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("mailing-list", "preview-groupsio-delivery-mode-change", err)
+			}
+			err = ValidatePreviewGroupsioDeliveryModeChangeNotFoundResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("mailing-list", "preview-groupsio-delivery-mode-change", err)
+			}
+			return nil, NewPreviewGroupsioDeliveryModeChangeNotFound(&body)
+		case http.StatusServiceUnavailable:
+			var (
+				body PreviewGroupsioDeliveryModeChangeServiceUnavailableResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("mailing-list", "preview-groupsio-delivery-mode-change", err)
+			}
+			err = ValidatePreviewGroupsioDeliveryModeChangeServiceUnavailableResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("mailing-list", "preview-groupsio-delivery-mode-change", err)
+			}
+			return nil, NewPreviewGroupsioDeliveryModeChangeServiceUnavailable(&body)
+		default:
+			body, _ := io.ReadAll(resp.Body)
+			return nil, goahttp.ErrInvalidResponse("mailing-list", "preview-groupsio-delivery-mode-change", resp.StatusCode, string(body))
+		}
+	}
+}
+
+// BuildAddGroupsioMemberRequest instantiates a HTTP request object with method
+// and path set to call the "mailing-list" service "add-groupsio-member"
+// endpoint
+func (c *Client) BuildAddGroupsioMemberRequest(ctx context.Context, v any) (*http.Request, error) {
+	var (
+		subgroupID string
+	)
+	{
+		p, ok := v.(*mailinglist.AddGroupsioMemberPayload)
+		if !ok {
+			return nil, goahttp.ErrInvalidType("mailing-list", "add-groupsio-member", "*mailinglist.AddGroupsioMemberPayload", v)
+		}
+		subgroupID = p.SubgroupID
+	}
+	u := &url.URL{Scheme: c.scheme, Host: c.host, Path: AddGroupsioMemberMailingListPath(subgroupID)}
+	req, err := http.NewRequest("POST", u.String(), nil)
+	if err != nil {
+		return nil, goahttp.ErrInvalidURL("mailing-list", "add-groupsio-member", u.String(), err)
+	}
+	if ctx != nil {
+		req = req.WithContext(ctx)
+	}
+
+	return req, nil
+}
+
+// EncodeAddGroupsioMemberRequest returns an encoder for requests sent to the
+// mailing-list add-groupsio-member server.
+func EncodeAddGroupsioMemberRequest(encoder func(*http.Request) goahttp.Encoder) func(*http.Request, any) error {
+	return func(req *http.Request, v any) error {
+		p, ok := v.(*mailinglist.AddGroupsioMemberPayload)
+		if !ok {
+			return goahttp.ErrInvalidType("mailing-list", "add-groupsio-member", "*mailinglist.AddGroupsioMemberPayload", v)
+		}
+		if p.BearerToken != nil {
+			head := *p.BearerToken
+			if !strings.Contains(head, " ") {
+				req.Header.Set("Authorization", "Bearer "+head)
+			} else {
+				req.Header.Set("Authorization", head)
+			}
+		}
+		body := NewAddGroupsioMemberRequestBody(p)
+		if err := encoder(req).Encode(&body); err != nil {
+			return goahttp.ErrEncodingError("mailing-list", "add-groupsio-member", err)
+		}
+		return nil
+	}
+}
+
+// DecodeAddGroupsioMemberResponse returns a decoder for responses returned by
+// the mailing-list add-groupsio-member endpoint. restoreBody controls whether
+// the response body should be restored after having been read.
+// DecodeAddGroupsioMemberResponse may return the following errors:
+//   - "BadRequest" (type *mailinglist.BadRequestError): http.StatusBadRequest
+//   - "Conflict" (type *mailinglist.ConflictError): http.StatusConflict
+//   - "InternalServerError" (type *mailinglist.InternalServerError): http.StatusInternalServerError
+//   - "NotFound" (type *mailinglist.NotFoundError): http.StatusNotFound
+//   - "ServiceUnavailable" (type *mailinglist.ServiceUnavailableError): http.StatusServiceUnavailable
+//   - error: internal error
+func DecodeAddGroupsioMemberResponse(decoder func(*http.Response) goahttp.Decoder, restoreBody bool) func(*http.Response) (any, error) {
+	return func(resp *http.Response) (any, error) {
+		if restoreBody {
+			b, err := io.ReadAll(resp.Body)
+			if err != nil {
+				return nil, err
+			}
+			resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			defer func() {
+				resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			}()
+		} else {
+			defer resp.Body.Close()
+		}
+		switch resp.StatusCode {
+		case http.StatusCreated:
+			var (
+				body AddGroupsioMemberResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("mailing-list", "add-groupsio-member", err)
+			}
+			err = ValidateAddGroupsioMemberResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("mailing-list", "add-groupsio-member", err)
+			}
+			res := NewAddGroupsioMemberGroupsioMemberCreated(&body)
+			return res, nil
+		case http.StatusBadRequest:
+			var (
+				body AddGroupsioMemberBadRequestResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("mailing-list", "add-groupsio-member", err)
+			}
+			err = ValidateAddGroupsioMemberBadRequestResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("mailing-list", "add-groupsio-member", err)
+			}
+			return nil, NewAddGroupsioMemberBadRequest(&body)
+		case http.StatusConflict:
+			var (
+				body AddGroupsioMemberConflictResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("mailing-list", "add-groupsio-member", err)
+			}
+			err = ValidateAddGroupsioMemberConflictResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("mailing-list", "add-groupsio-member", err)
+			}
+			return nil, NewAddGroupsioMemberConflict(&body)
+		case http.StatusInternalServerError:
+			var (
+				body AddGroupsioMemberInternalServerErrorResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("mailing-list", "add-groupsio-member", err)
+			}
+			err = ValidateAddGroupsioMemberInternalServerErrorResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("mailing-list", "add-groupsio-member", err)
+			}
+			return nil, NewAddGroupsioMemberInternalServerError(&body)
+		case http.StatusNotFound:
+			var (
+				body AddGroupsioMemberNotFoundResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("mailing-list", "add-groupsio-member", err)
+			}
+			err = ValidateAddGroupsioMemberNotFoundResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("mailing-list", "add-groupsio-member", err)
+			}
+			return nil, NewAddGroupsioMemberNotFound(&body)
+		case http.StatusServiceUnavailable:
+			var (
+				body AddGroupsioMemberServiceUnavailableResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("mailing-list", "add-groupsio-member", err)
+			}
+			err = ValidateAddGroupsioMemberServiceUnavailableResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("mailing-list", "add-groupsio-member", err)
+			}
+			return nil, NewAddGroupsioMemberServiceUnavailable(&body)
+		default:
+			body, _ := io.ReadAll(resp.Body)
+			return nil, goahttp.ErrInvalidResponse("mailing-list", "add-groupsio-member", resp.StatusCode, string(body))
+		}
+	}
+}
+
+// BuildUpsertGroupsioMemberRequest instantiates a HTTP request object with
+// method and path set to call the "mailing-list" service
+// "upsert-groupsio-member" endpoint
+func (c *Client) BuildUpsertGroupsioMemberRequest(ctx context.Context, v any) (*http.Request, error) {
+	var (
+		subgroupID string
+	)
+	{
+		p, ok := v.(*mailinglist.UpsertGroupsioMemberPayload)
+		if !ok {
+			return nil, goahttp.ErrInvalidType("mailing-list", "upsert-groupsio-member", "*mailinglist.UpsertGroupsioMemberPayload", v)
+		}
+		subgroupID = p.SubgroupID
+	}
+	u := &url.URL{Scheme: c.scheme, Host: c.host, Path: UpsertGroupsioMemberMailingListPath(subgroupID)}
+	req, err := http.NewRequest("PUT", u.String(), nil)
+	if err != nil {
+		return nil, goahttp.ErrInvalidURL("mailing-list", "upsert-groupsio-member", u.String(), err)
+	}
+	if ctx != nil {
+		req = req.WithContext(ctx)
+	}
+
+	return req, nil
+}
+
+// EncodeUpsertGroupsioMemberRequest returns an encoder for requests sent to
+// the mailing-list upsert-groupsio-member server.
+func EncodeUpsertGroupsioMemberRequest(encoder func(*http.Request) goahttp.Encoder) func(*http.Request, any) error {
+	return func(req *http.Request, v any) error {
+		p, ok := v.(*mailinglist.UpsertGroupsioMemberPayload)
+		if !ok {
+			return goahttp.ErrInvalidType("mailing-list", "upsert-groupsio-member", "*mailinglist.UpsertGroupsioMemberPayload", v)
+		}
+		if p.BearerToken != nil {
+			head := *p.BearerToken
+			if !strings.Contains(head, " ") {
+				req.Header.Set("Authorization", "Bearer "+head)
+			} else {
+				req.Header.Set("Authorization", head)
+			}
+		}
+		body := NewUpsertGroupsioMemberRequestBody(p)
+		if err := encoder(req).Encode(&body); err != nil {
+			return goahttp.ErrEncodingError("mailing-list", "upsert-groupsio-member", err)
+		}
+		return nil
+	}
+}
+
+// DecodeUpsertGroupsioMemberResponse returns a decoder for responses returned
+// by the mailing-list upsert-groupsio-member endpoint. restoreBody controls
+// whether the response body should be restored after having been read.
+// DecodeUpsertGroupsioMemberResponse may return the following errors:
 //   - "BadRequest" (type *mailinglist.BadRequestError): http.StatusBadRequest
 //   - "Conflict" (type *mailinglist.ConflictError): http.StatusConflict
 //   - "InternalServerError" (type *mailinglist.InternalServerError): http.StatusInternalServerError
+//   - "NotFound" (type *mailinglist.NotFoundError): http.StatusNotFound
+//   - "ServiceUnavailable" (type *mailinglist.ServiceUnavailableError): http.StatusServiceUnavailable
+//   - error: internal error
+func DecodeUpsertGroupsioMemberResponse(decoder func(*http.Response) goahttp.Decoder, restoreBody bool) func(*http.Response) (any, error) {
+	return func(resp *http.Response) (any, error) {
+		if restoreBody {
+			b, err := io.ReadAll(resp.Body)
+			if err != nil {
+				return nil, err
+			}
+			resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			defer func() {
+				resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			}()
+		} else {
+			defer resp.Body.Close()
+		}
+		switch resp.StatusCode {
+		case http.StatusOK:
+			var (
+				body UpsertGroupsioMemberResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("mailing-list", "upsert-groupsio-member", err)
+			}
+			err = ValidateUpsertGroupsioMemberResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("mailing-list", "upsert-groupsio-member", err)
+			}
+			res := NewUpsertGroupsioMemberGroupsioMemberUpsertResultOK(&body)
+			return res, nil
+		case http.StatusBadRequest:
+			var (
+				body UpsertGroupsioMemberBadRequestResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("mailing-list", "upsert-groupsio-member", err)
+			}
+			err = ValidateUpsertGroupsioMemberBadRequestResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("mailing-list", "upsert-groupsio-member", err)
+			}
+			return nil, NewUpsertGroupsioMemberBadRequest(&body)
+		case http.StatusConflict:
+			var (
+				body UpsertGroupsioMemberConflictResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("mailing-list", "upsert-groupsio-member", err)
+			}
+			err = ValidateUpsertGroupsioMemberConflictResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("mailing-list", "upsert-groupsio-member", err)
+			}
+			return nil, NewUpsertGroupsioMemberConflict(&body)
+		case http.StatusInternalServerError:
+			var (
+				body UpsertGroupsioMemberInternalServerErrorResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("mailing-list", "upsert-groupsio-member", err)
+			}
+			err = ValidateUpsertGroupsioMemberInternalServerErrorResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("mailing-list", "upsert-groupsio-member", err)
+			}
+			return nil, NewUpsertGroupsioMemberInternalServerError(&body)
+		case http.StatusNotFound:
+			var (
+				body UpsertGroupsioMemberNotFoundResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("mailing-list", "upsert-groupsio-member", err)
+			}
+			err = ValidateUpsertGroupsioMemberNotFoundResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("mailing-list", "upsert-groupsio-member", err)
+			}
+			return nil, NewUpsertGroupsioMemberNotFound(&body)
+		case http.StatusServiceUnavailable:
+			var (
+				body UpsertGroupsioMemberServiceUnavailableResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("mailing-list", "upsert-groupsio-member", err)
+			}
+			err = ValidateUpsertGroupsioMemberServiceUnavailableResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("mailing-list", "upsert-groupsio-member", err)
+			}
+			return nil, NewUpsertGroupsioMemberServiceUnavailable(&body)
+		default:
+			body, _ := io.ReadAll(resp.Body)
+			return nil, goahttp.ErrInvalidResponse("mailing-list", "upsert-groupsio-member", resp.StatusCode, string(body))
+		}
+	}
+}
+
+// BuildGetGroupsioMemberRequest instantiates a HTTP request object with method
+// and path set to call the "mailing-list" service "get-groupsio-member"
+// endpoint
+func (c *Client) BuildGetGroupsioMemberRequest(ctx context.Context, v any) (*http.Request, error) {
+	var (
+		subgroupID string
+		memberID   string
+	)
+	{
+		p, ok := v.(*mailinglist.GetGroupsioMemberPayload)
+		if !ok {
+			return nil, goahttp.ErrInvalidType("mailing-list", "get-groupsio-member", "*mailinglist.GetGroupsioMemberPayload", v)
+		}
+		subgroupID = p.SubgroupID
+		memberID = p.MemberID
+	}
+	u := &url.URL{Scheme: c.scheme, Host: c.host, Path: GetGroupsioMemberMailingListPath(subgroupID, memberID)}
+	req, err := http.NewRequest("GET", u.String(), nil)
+	if err != nil {
+		return nil, goahttp.ErrInvalidURL("mailing-list", "get-groupsio-member", u.String(), err)
+	}
+	if ctx != nil {
+		req = req.WithContext(ctx)
+	}
+
+	return req, nil
+}
+
+// EncodeGetGroupsioMemberRequest returns an encoder for requests sent to the
+// mailing-list get-groupsio-member server.
+func EncodeGetGroupsioMemberRequest(encoder func(*http.Request) goahttp.Encoder) func(*http.Request, any) error {
+	return func(req *http.Request, v any) error {
+		p, ok := v.(*mailinglist.GetGroupsioMemberPayload)
+		if !ok {
+			return goahttp.ErrInvalidType("mailing-list", "get-groupsio-member", "*mailinglist.GetGroupsioMemberPayload", v)
+		}
+		if p.BearerToken != nil {
+			head := *p.BearerToken
+			if !strings.Contains(head, " ") {
+				req.Header.Set("Authorization", "Bearer "+head)
+			} else {
+				req.Header.Set("Authorization", head)
+			}
+		}
+		return nil
+	}
+}
+
+// DecodeGetGroupsioMemberResponse returns a decoder for responses returned by
+// the mailing-list get-groupsio-member endpoint. restoreBody controls whether
+// the response body should be restored after having been read.
+// DecodeGetGroupsioMemberResponse may return the following errors:
+//   - "InternalServerError" (type *mailinglist.InternalServerError): http.StatusInternalServerError
+//   - "NotFound" (type *mailinglist.NotFoundError): http.StatusNotFound
+//   - "ServiceUnavailable" (type *mailinglist.ServiceUnavailableError): http.StatusServiceUnavailable
+//   - error: internal error
+func DecodeGetGroupsioMemberResponse(decoder func(*http.Response) goahttp.Decoder, restoreBody bool) func(*http.Response) (any, error) {
+	return func(resp *http.Response) (any, error) {
+		if restoreBody {
+			b, err := io.ReadAll(resp.Body)
+			if err != nil {
+				return nil, err
+			}
+			resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			defer func() {
+				resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			}()
+		} else {
+			defer resp.Body.Close()
+		}
+		switch resp.StatusCode {
+		case http.StatusOK:
+			var (
+				body GetGroupsioMemberResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("mailing-list", "get-groupsio-member", err)
+			}
+			err = ValidateGetGroupsioMemberResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("mailing-list", "get-groupsio-member", err)
+			}
+			res := NewGetGroupsioMemberGroupsioMemberOK(&body)
+			return res, nil
+		case http.StatusInternalServerError:
+			var (
+				body GetGroupsioMemberInternalServerErrorResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("mailing-list", "get-groupsio-member", err)
+			}
+			err = ValidateGetGroupsioMemberInternalServerErrorResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("mailing-list", "get-groupsio-member", err)
+			}
+			return nil, NewGetGroupsioMemberInternalServerError(&body)
+		case http.StatusNotFound:
+			var (
+				body GetGroupsioMemberNotFoundResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("mailing-list", "get-groupsio-member", err)
+			}
+			err = ValidateGetGroupsioMemberNotFoundResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("mailing-list", "get-groupsio-member", err)
+			}
+			return nil, NewGetGroupsioMemberNotFound(&body)
+		case http.StatusServiceUnavailable:
+			var (
+				body GetGroupsioMemberServiceUnavailableResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("mailing-list", "get-groupsio-member", err)
+			}
+			err = ValidateGetGroupsioMemberServiceUnavailableResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("mailing-list", "get-groupsio-member", err)
+			}
+			return nil, NewGetGroupsioMemberServiceUnavailable(&body)
+		default:
+			body, _ := io.ReadAll(resp.Body)
+			return nil, goahttp.ErrInvalidResponse("mailing-list", "get-groupsio-member", resp.StatusCode, string(body))
+		}
+	}
+}
+
+// BuildUpdateGroupsioMemberRequest instantiates a HTTP request object with
+// method and path set to call the "mailing-list" service
+// "update-groupsio-member" endpoint
+func (c *Client) BuildUpdateGroupsioMemberRequest(ctx context.Context, v any) (*http.Request, error) {
+	var (
+		subgroupID string
+		memberID   string
+	)
+	{
+		p, ok := v.(*mailinglist.UpdateGroupsioMemberPayload)
+		if !ok {
+			return nil, goahttp.ErrInvalidType("mailing-list", "update-groupsio-member", "*mailinglist.UpdateGroupsioMemberPayload", v)
+		}
+		subgroupID = p.SubgroupID
+		memberID = p.MemberID
+	}
+	u := &url.URL{Scheme: c.scheme, Host: c.host, Path: UpdateGroupsioMemberMailingListPath(subgroupID, memberID)}
+	req, err := http.NewRequest("PUT", u.String(), nil)
+	if err != nil {
+		return nil, goahttp.ErrInvalidURL("mailing-list", "update-groupsio-member", u.String(), err)
+	}
+	if ctx != nil {
+		req = req.WithContext(ctx)
+	}
+
+	return req, nil
+}
+
+// EncodeUpdateGroupsioMemberRequest returns an encoder for requests sent to
+// the mailing-list update-groupsio-member server.
+func EncodeUpdateGroupsioMemberRequest(encoder func(*http.Request) goahttp.Encoder) func(*http.Request, any) error {
+	return func(req *http.Request, v any) error {
+		p, ok := v.(*mailinglist.UpdateGroupsioMemberPayload)
+		if !ok {
+			return goahttp.ErrInvalidType("mailing-list", "update-groupsio-member", "*mailinglist.UpdateGroupsioMemberPayload", v)
+		}
+		if p.BearerToken != nil {
+			head := *p.BearerToken
+			if !strings.Contains(head, " ") {
+				req.Header.Set("Authorization", "Bearer "+head)
+			} else {
+				req.Header.Set("Authorization", head)
+			}
+		}
+		body := NewUpdateGroupsioMemberRequestBody(p)
+		if err := encoder(req).Encode(&body); err != nil {
+			return goahttp.ErrEncodingError("mailing-list", "update-groupsio-member", err)
+		}
+		return nil
+	}
+}
+
+// DecodeUpdateGroupsioMemberResponse returns a decoder for responses returned
+// by the mailing-list update-groupsio-member endpoint. restoreBody controls
+// whether the response body should be restored after having been read.
+// DecodeUpdateGroupsioMemberResponse may return the following errors:
+//   - "BadRequest" (type *mailinglist.BadRequestError): http.StatusBadRequest
+//   - "InternalServerError" (type *mailinglist.InternalServerError): http.StatusInternalServerError
+//   - "NotFound" (type *mailinglist.NotFoundError): http.StatusNotFound
 //   - "ServiceUnavailable" (type *mailinglist.ServiceUnavailableError): http.StatusServiceUnavailable
 //   - error: internal error
-func DecodeCreateGroupsioMailingListResponse(decoder func(*http.Response) goahttp.Decoder, restoreBody bool) func(*http.Response) (any, error) {
+func DecodeUpdateGroupsioMemberResponse(decoder func(*http.Response) goahttp.Decoder, restoreBody bool) func(*http.Response) (any, error) {
 	return func(resp *http.Response) (any, error) {
 		if restoreBody {
 			b, err := io.ReadAll(resp.Body)
@@ -1256,102 +4117,224 @@ func DecodeCreateGroupsioMailingListResponse(decoder func(*http.Response) goahtt
 			defer resp.Body.Close()
 		}
 		switch resp.StatusCode {
-		case http.StatusCreated:
+		case http.StatusOK:
 			var (
-				body CreateGroupsioMailingListResponseBody
+				body UpdateGroupsioMemberResponseBody
 				err  error
 			)
 			err = decoder(resp).Decode(&body)
 			if err != nil {
-				return nil, goahttp.ErrDecodingError("mailing-list", "create-groupsio-mailing-list", err)
+				return nil, goahttp.ErrDecodingError("mailing-list", "update-groupsio-member", err)
 			}
-			err = ValidateCreateGroupsioMailingListResponseBody(&body)
+			err = ValidateUpdateGroupsioMemberResponseBody(&body)
 			if err != nil {
-				return nil, goahttp.ErrValidationError("mailing-list", "create-groupsio-mailing-list", err)
+				return nil, goahttp.ErrValidationError("mailing-list", "update-groupsio-member", err)
 			}
-			res := NewCreateGroupsioMailingListGroupsioSubgroupCreated(&body)
+			res := NewUpdateGroupsioMemberGroupsioMemberOK(&body)
 			return res, nil
 		case http.StatusBadRequest:
 			var (
-				body CreateGroupsioMailingListBadRequestResponseBody
+				body UpdateGroupsioMemberBadRequestResponseBody
 				err  error
 			)
 			err = decoder(resp).Decode(&body)
 			if err != nil {
-				return nil, goahttp.ErrDecodingError("mailing-list", "create-groupsio-mailing-list", err)
+				return nil, goahttp.ErrDecodingError("mailing-list", "update-groupsio-member", err)
 			}
-			err = ValidateCreateGroupsioMailingListBadRequestResponseBody(&body)
+			err = ValidateUpdateGroupsioMemberBadRequestResponseBody(&body)
 			if err != nil {
-				return nil, goahttp.ErrValidationError("mailing-list", "create-groupsio-mailing-list", err)
+				return nil, goahttp.ErrValidationError("mailing-list", "update-groupsio-member", err)
 			}
-			return nil, NewCreateGroupsioMailingListBadRequest(&body)
-		case http.StatusConflict:
+			return nil, NewUpdateGroupsioMemberBadRequest(&body)
+		case http.StatusInternalServerError:
 			var (
-				body CreateGroupsioMailingListConflictResponseBody
+				body UpdateGroupsioMemberInternalServerErrorResponseBody
 				err  error
 			)
 			err = decoder(resp).Decode(&body)
 			if err != nil {
-				return nil, goahttp.ErrDecodingError("mailing-list", "create-groupsio-mailing-list", err)
+				return nil, goahttp.ErrDecodingError("mailing-list", "update-groupsio-member", err)
+			}
+			err = ValidateUpdateGroupsioMemberInternalServerErrorResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("mailing-list", "update-groupsio-member", err)
+			}
+			return nil, NewUpdateGroupsioMemberInternalServerError(&body)
+		case http.StatusNotFound:
+			var (
+				body UpdateGroupsioMemberNotFoundResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("mailing-list", "update-groupsio-member", err)
+			}
+			err = ValidateUpdateGroupsioMemberNotFoundResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("mailing-list", "update-groupsio-member", err)
+			}
+			return nil, NewUpdateGroupsioMemberNotFound(&body)
+		case http.StatusServiceUnavailable:
+			var (
+				body UpdateGroupsioMemberServiceUnavailableResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("mailing-list", "update-groupsio-member", err)
+			}
+			err = ValidateUpdateGroupsioMemberServiceUnavailableResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("mailing-list", "update-groupsio-member", err)
+			}
+			return nil, NewUpdateGroupsioMemberServiceUnavailable(&body)
+		default:
+			body, _ := io.ReadAll(resp.Body)
+			return nil, goahttp.ErrInvalidResponse("mailing-list", "update-groupsio-member", resp.StatusCode, string(body))
+		}
+	}
+}
+
+// BuildDeleteGroupsioMemberRequest instantiates a HTTP request object with
+// method and path set to call the "mailing-list" service
+// "delete-groupsio-member" endpoint
+func (c *Client) BuildDeleteGroupsioMemberRequest(ctx context.Context, v any) (*http.Request, error) {
+	var (
+		subgroupID string
+		memberID   string
+	)
+	{
+		p, ok := v.(*mailinglist.DeleteGroupsioMemberPayload)
+		if !ok {
+			return nil, goahttp.ErrInvalidType("mailing-list", "delete-groupsio-member", "*mailinglist.DeleteGroupsioMemberPayload", v)
+		}
+		subgroupID = p.SubgroupID
+		memberID = p.MemberID
+	}
+	u := &url.URL{Scheme: c.scheme, Host: c.host, Path: DeleteGroupsioMemberMailingListPath(subgroupID, memberID)}
+	req, err := http.NewRequest("DELETE", u.String(), nil)
+	if err != nil {
+		return nil, goahttp.ErrInvalidURL("mailing-list", "delete-groupsio-member", u.String(), err)
+	}
+	if ctx != nil {
+		req = req.WithContext(ctx)
+	}
+
+	return req, nil
+}
+
+// EncodeDeleteGroupsioMemberRequest returns an encoder for requests sent to
+// the mailing-list delete-groupsio-member server.
+func EncodeDeleteGroupsioMemberRequest(encoder func(*http.Request) goahttp.Encoder) func(*http.Request, any) error {
+	return func(req *http.Request, v any) error {
+		p, ok := v.(*mailinglist.DeleteGroupsioMemberPayload)
+		if !ok {
+			return goahttp.ErrInvalidType("mailing-list", "delete-groupsio-member", "*mailinglist.DeleteGroupsioMemberPayload", v)
+		}
+		if p.BearerToken != nil {
+			head := *p.BearerToken
+			if !strings.Contains(head, " ") {
+				req.Header.Set("Authorization", "Bearer "+head)
+			} else {
+				req.Header.Set("Authorization", head)
+			}
+		}
+		return nil
+	}
+}
+
+// DecodeDeleteGroupsioMemberResponse returns a decoder for responses returned
+// by the mailing-list delete-groupsio-member endpoint. restoreBody controls
+// whether the response body should be restored after having been read.
+// DecodeDeleteGroupsioMemberResponse may return the following errors:
+//   - "InternalServerError" (type *mailinglist.InternalServerError): http.StatusInternalServerError
+//   - "NotFound" (type *mailinglist.NotFoundError): http.StatusNotFound
+//   - "ServiceUnavailable" (type *mailinglist.ServiceUnavailableError): http.StatusServiceUnavailable
+//   - error: internal error
+func DecodeDeleteGroupsioMemberResponse(decoder func(*http.Response) goahttp.Decoder, restoreBody bool) func(*http.Response) (any, error) {
+	return func(resp *http.Response) (any, error) {
+		if restoreBody {
+			b, err := io.ReadAll(resp.Body)
+			if err != nil {
+				return nil, err
+			}
+			resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			defer func() {
+				resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			}()
+		} else {
+			defer resp.Body.Close()
+		}
+		switch resp.StatusCode {
+		case http.StatusNoContent:
+			return nil, nil
+		case http.StatusInternalServerError:
+			var (
+				body DeleteGroupsioMemberInternalServerErrorResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("mailing-list", "delete-groupsio-member", err)
 			}
-			err = ValidateCreateGroupsioMailingListConflictResponseBody(&body)
+			err = ValidateDeleteGroupsioMemberInternalServerErrorResponseBody(&body)
 			if err != nil {
-				return nil, goahttp.ErrValidationError("mailing-list", "create-groupsio-mailing-list", err)
+				return nil, goahttp.ErrValidationError("mailing-list", "delete-groupsio-member", err)
 			}
-			return nil, NewCreateGroupsioMailingListConflict(&body)
-		case http.StatusInternalServerError:
+			return nil, NewDeleteGroupsioMemberInternalServerError(&body)
+		case http.StatusNotFound:
 			var (
-				body CreateGroupsioMailingListInternalServerErrorResponseBody
+				body DeleteGroupsioMemberNotFoundResponseBody
 				err  error
 			)
 			err = decoder(resp).Decode(&body)
 			if err != nil {
-				return nil, goahttp.ErrDecodingError("mailing-list", "create-groupsio-mailing-list", err)
+				return nil, goahttp.ErrDecodingError("mailing-list", "delete-groupsio-member", err)
 			}
-			err = ValidateCreateGroupsioMailingListInternalServerErrorResponseBody(&body)
+			err = ValidateDeleteGroupsioMemberNotFoundResponseBody(&body)
 			if err != nil {
-				return nil, goahttp.ErrValidationError("mailing-list", "create-groupsio-mailing-list", err)
+				return nil, goahttp.ErrValidationError("mailing-list", "delete-groupsio-member", err)
 			}
-			return nil, NewCreateGroupsioMailingListInternalServerError(&body)
+			return nil, NewDeleteGroupsioMemberNotFound(&body)
 		case http.StatusServiceUnavailable:
 			var (
-				body CreateGroupsioMailingListServiceUnavailableResponseBody
+				body DeleteGroupsioMemberServiceUnavailableResponseBody
 				err  error
 			)
 			err = decoder(resp).Decode(&body)
 			if err != nil {
-				return nil, goahttp.ErrDecodingError("mailing-list", "create-groupsio-mailing-list", err)
+				return nil, goahttp.ErrDecodingError("mailing-list", "delete-groupsio-member", err)
 			}
-			err = ValidateCreateGroupsioMailingListServiceUnavailableResponseBody(&body)
+			err = ValidateDeleteGroupsioMemberServiceUnavailableResponseBody(&body)
 			if err != nil {
-				return nil, goahttp.ErrValidationError("mailing-list", "create-groupsio-mailing-list", err)
+				return nil, goahttp.ErrValidationError("mailing-list", "delete-groupsio-member", err)
 			}
-			return nil, NewCreateGroupsioMailingListServiceUnavailable(&body)
+			return nil, NewDeleteGroupsioMemberServiceUnavailable(&body)
 		default:
 			body, _ := io.ReadAll(resp.Body)
-			return nil, goahttp.ErrInvalidResponse("mailing-list", "create-groupsio-mailing-list", resp.StatusCode, string(body))
+			return nil, goahttp.ErrInvalidResponse("mailing-list", "delete-groupsio-member", resp.StatusCode, string(body))
 		}
 	}
 }
 
-// BuildGetGroupsioMailingListRequest instantiates a HTTP request object with
+// BuildInviteGroupsioMembersRequest instantiates a HTTP request object with
 // method and path set to call the "mailing-list" service
-// "get-groupsio-mailing-list" endpoint
-func (c *Client) BuildGetGroupsioMailingListRequest(ctx context.Context, v any) (*http.Request, error) {
+// "invite-groupsio-members" endpoint
+func (c *Client) BuildInviteGroupsioMembersRequest(ctx context.Context, v any) (*http.Request, error) {
 	var (
 		subgroupID string
 	)
 	{
-		p, ok := v.(*mailinglist.GetGroupsioMailingListPayload)
+		p, ok := v.(*mailinglist.InviteGroupsioMembersPayload)
 		if !ok {
-			return nil, goahttp.ErrInvalidType("mailing-list", "get-groupsio-mailing-list", "*mailinglist.GetGroupsioMailingListPayload", v)
+			return nil, goahttp.ErrInvalidType("mailing-list", "invite-groupsio-members", "*mailinglist.InviteGroupsioMembersPayload", v)
 		}
 		subgroupID = p.SubgroupID
 	}
-	u := &url.URL{Scheme: c.scheme, Host: c.host, Path: GetGroupsioMailingListMailingListPath(subgroupID)}
-	req, err := http.NewRequest("GET", u.String(), nil)
+	u := &url.URL{Scheme: c.scheme, Host: c.host, Path: InviteGroupsioMembersMailingListPath(subgroupID)}
+	req, err := http.NewRequest("POST", u.String(), nil)
 	if err != nil {
-		return nil, goahttp.ErrInvalidURL("mailing-list", "get-groupsio-mailing-list", u.String(), err)
+		return nil, goahttp.ErrInvalidURL("mailing-list", "invite-groupsio-members", u.String(), err)
 	}
 	if ctx != nil {
 		req = req.WithContext(ctx)
@@ -1360,13 +4343,13 @@ func (c *Client) BuildGetGroupsioMailingListRequest(ctx context.Context, v any)
 	return req, nil
 }
 
-// EncodeGetGroupsioMailingListRequest returns an encoder for requests sent to
-// the mailing-list get-groupsio-mailing-list server.
-func EncodeGetGroupsioMailingListRequest(encoder func(*http.Request) goahttp.Encoder) func(*http.Request, any) error {
+// EncodeInviteGroupsioMembersRequest returns an encoder for requests sent to
+// the mailing-list invite-groupsio-members server.
+func EncodeInviteGroupsioMembersRequest(encoder func(*http.Request) goahttp.Encoder) func(*http.Request, any) error {
 	return func(req *http.Request, v any) error {
-		p, ok := v.(*mailinglist.GetGroupsioMailingListPayload)
+		p, ok := v.(*mailinglist.InviteGroupsioMembersPayload)
 		if !ok {
-			return goahttp.ErrInvalidType("mailing-list", "get-groupsio-mailing-list", "*mailinglist.GetGroupsioMailingListPayload", v)
+			return goahttp.ErrInvalidType("mailing-list", "invite-groupsio-members", "*mailinglist.InviteGroupsioMembersPayload", v)
 		}
 		if p.BearerToken != nil {
 			head := *p.BearerToken
@@ -1376,19 +4359,24 @@ func EncodeGetGroupsioMailingListRequest(encoder func(*http.Request) goahttp.Enc
 				req.Header.Set("Authorization", head)
 			}
 		}
+		body := NewInviteGroupsioMembersRequestBody(p)
+		if err := encoder(req).Encode(&body); err != nil {
+			return goahttp.ErrEncodingError("mailing-list", "invite-groupsio-members", err)
+		}
 		return nil
 	}
 }
 
-// DecodeGetGroupsioMailingListResponse returns a decoder for responses
-// returned by the mailing-list get-groupsio-mailing-list endpoint. restoreBody
-// controls whether the response body should be restored after having been read.
-// DecodeGetGroupsioMailingListResponse may return the following errors:
+// DecodeInviteGroupsioMembersResponse returns a decoder for responses returned
+// by the mailing-list invite-groupsio-members endpoint. restoreBody controls
+// whether the response body should be restored after having been read.
+// DecodeInviteGroupsioMembersResponse may return the following errors:
+//   - "BadRequest" (type *mailinglist.BadRequestError): http.StatusBadRequest
 //   - "InternalServerError" (type *mailinglist.InternalServerError): http.StatusInternalServerError
 //   - "NotFound" (type *mailinglist.NotFoundError): http.StatusNotFound
 //   - "ServiceUnavailable" (type *mailinglist.ServiceUnavailableError): http.StatusServiceUnavailable
 //   - error: internal error
-func DecodeGetGroupsioMailingListResponse(decoder func(*http.Response) goahttp.Decoder, restoreBody bool) func(*http.Response) (any, error) {
+func DecodeInviteGroupsioMembersResponse(decoder func(*http.Response) goahttp.Decoder, restoreBody bool) func(*http.Response) (any, error) {
 	return func(resp *http.Response) (any, error) {
 		if restoreBody {
 			b, err := io.ReadAll(resp.Body)
@@ -1403,88 +4391,89 @@ func DecodeGetGroupsioMailingListResponse(decoder func(*http.Response) goahttp.D
 			defer resp.Body.Close()
 		}
 		switch resp.StatusCode {
-		case http.StatusOK:
+		case http.StatusNoContent:
+			return nil, nil
+		case http.StatusBadRequest:
 			var (
-				body GetGroupsioMailingListResponseBody
+				body InviteGroupsioMembersBadRequestResponseBody
 				err  error
 			)
 			err = decoder(resp).Decode(&body)
 			if err != nil {
-				return nil, goahttp.ErrDecodingError("mailing-list", "get-groupsio-mailing-list", err)
+				return nil, goahttp.ErrDecodingError("mailing-list", "invite-groupsio-members", err)
 			}
-			err = ValidateGetGroupsioMailingListResponseBody(&body)
+			err = ValidateInviteGroupsioMembersBadRequestResponseBody(&body)
 			if err != nil {
-				return nil, goahttp.ErrValidationError("mailing-list", "get-groupsio-mailing-list", err)
+				return nil, goahttp.ErrValidationError("mailing-list", "invite-groupsio-members", err)
 			}
-			res := NewGetGroupsioMailingListGroupsioSubgroupOK(&body)
-			return res, nil
+			return nil, NewInviteGroupsioMembersBadRequest(&body)
 		case http.StatusInternalServerError:
 			var (
-				body GetGroupsioMailingListInternalServerErrorResponseBody
+				body InviteGroupsioMembersInternalServerErrorResponseBody
 				err  error
 			)
 			err = decoder(resp).Decode(&body)
 			if err != nil {
-				return nil, goahttp.ErrDecodingError("mailing-list", "get-groupsio-mailing-list", err)
+				return nil, goahttp.ErrDecodingError("mailing-list", "invite-groupsio-members", err)
 			}
-			err = ValidateGetGroupsioMailingListInternalServerErrorResponseBody(&body)
+			err = ValidateInviteGroupsioMembersInternalServerErrorResponseBody(&body)
 			if err != nil {
-				return nil, goahttp.ErrValidationError("mailing-list", "get-groupsio-mailing-list", err)
+				return nil, goahttp.ErrValidationError("mailing-list", "invite-groupsio-members", err)
 			}
-			return nil, NewGetGroupsioMailingListInternalServerError(&body)
+			return nil, NewInviteGroupsioMembersInternalServerError(&body)
 		case http.StatusNotFound:
 			var (
-				body GetGroupsioMailingListNotFoundResponseBody
+				body InviteGroupsioMembersNotFoundResponseBody
 				err  error
 			)
 			err = decoder(resp).Decode(&body)
 			if err != nil {
-				return nil, goahttp.ErrDecodingError("mailing-list", "get-groupsio-mailing-list", err)
+				return nil, goahttp.ErrDecodingError("mailing-list", "invite-groupsio-members", err)
 			}
-			err = ValidateGetGroupsioMailingListNotFoundResponseBody(&body)
+			err = ValidateInviteGroupsioMembersNotFoundResponseBody(&body)
 			if err != nil {
-				return nil, goahttp.ErrValidationError("mailing-list", "get-groupsio-mailing-list", err)
+				return nil, goahttp.ErrValidationError("mailing-list", "invite-groupsio-members", err)
 			}
-			return nil, NewGetGroupsioMailingListNotFound(&body)
+			return nil, NewInviteGroupsioMembersNotFound(&body)
 		case http.StatusServiceUnavailable:
 			var (
-				body GetGroupsioMailingListServiceUnavailableResponseBody
+				body InviteGroupsioMembersServiceUnavailableResponseBody
 				err  error
 			)
 			err = decoder(resp).Decode(&body)
 			if err != nil {
-				return nil, goahttp.ErrDecodingError("mailing-list", "get-groupsio-mailing-list", err)
+				return nil, goahttp.ErrDecodingError("mailing-list", "invite-groupsio-members", err)
 			}
-			err = ValidateGetGroupsioMailingListServiceUnavailableResponseBody(&body)
+			err = ValidateInviteGroupsioMembersServiceUnavailableResponseBody(&body)
 			if err != nil {
-				return nil, goahttp.ErrValidationError("mailing-list", "get-groupsio-mailing-list", err)
+				return nil, goahttp.ErrValidationError("mailing-list", "invite-groupsio-members", err)
 			}
-			return nil, NewGetGroupsioMailingListServiceUnavailable(&body)
+			return nil, NewInviteGroupsioMembersServiceUnavailable(&body)
 		default:
 			body, _ := io.ReadAll(resp.Body)
-			return nil, goahttp.ErrInvalidResponse("mailing-list", "get-groupsio-mailing-list", resp.StatusCode, string(body))
+			return nil, goahttp.ErrInvalidResponse("mailing-list", "invite-groupsio-members", resp.StatusCode, string(body))
 		}
 	}
 }
 
-// BuildUpdateGroupsioMailingListRequest instantiates a HTTP request object
-// with method and path set to call the "mailing-list" service
-// "update-groupsio-mailing-list" endpoint
-func (c *Client) BuildUpdateGroupsioMailingListRequest(ctx context.Context, v any) (*http.Request, error) {
+// BuildImportGroupsioMembersRequest instantiates a HTTP request object with
+// method and path set to call the "mailing-list" service
+// "import-groupsio-members" endpoint
+func (c *Client) BuildImportGroupsioMembersRequest(ctx context.Context, v any) (*http.Request, error) {
 	var (
 		subgroupID string
 	)
 	{
-		p, ok := v.(*mailinglist.UpdateGroupsioMailingListPayload)
+		p, ok := v.(*mailinglist.ImportGroupsioMembersPayload)
 		if !ok {
-			return nil, goahttp.ErrInvalidType("mailing-list", "update-groupsio-mailing-list", "*mailinglist.UpdateGroupsioMailingListPayload", v)
+			return nil, goahttp.ErrInvalidType("mailing-list", "import-groupsio-members", "*mailinglist.ImportGroupsioMembersPayload", v)
 		}
 		subgroupID = p.SubgroupID
 	}
-	u := &url.URL{Scheme: c.scheme, Host: c.host, Path: UpdateGroupsioMailingListMailingListPath(subgroupID)}
-	req, err := http.NewRequest("PUT", u.String(), nil)
+	u := &url.URL{Scheme: c.scheme, Host: c.host, Path: ImportGroupsioMembersMailingListPath(subgroupID)}
+	req, err := http.NewRequest("POST", u.String(), nil)
 	if err != nil {
-		return nil, goahttp.ErrInvalidURL("mailing-list", "update-groupsio-mailing-list", u.String(), err)
+		return nil, goahttp.ErrInvalidURL("mailing-list", "import-groupsio-members", u.String(), err)
 	}
 	if ctx != nil {
 		req = req.WithContext(ctx)
@@ -1493,13 +4482,13 @@ func (c *Client) BuildUpdateGroupsioMailingListRequest(ctx context.Context, v an
 	return req, nil
 }
 
-// EncodeUpdateGroupsioMailingListRequest returns an encoder for requests sent
-// to the mailing-list update-groupsio-mailing-list server.
-func EncodeUpdateGroupsioMailingListRequest(encoder func(*http.Request) goahttp.Encoder) func(*http.Request, any) error {
+// EncodeImportGroupsioMembersRequest returns an encoder for requests sent to
+// the mailing-list import-groupsio-members server.
+func EncodeImportGroupsioMembersRequest(encoder func(*http.Request) goahttp.Encoder) func(*http.Request, any) error {
 	return func(req *http.Request, v any) error {
-		p, ok := v.(*mailinglist.UpdateGroupsioMailingListPayload)
+		p, ok := v.(*mailinglist.ImportGroupsioMembersPayload)
 		if !ok {
-			return goahttp.ErrInvalidType("mailing-list", "update-groupsio-mailing-list", "*mailinglist.UpdateGroupsioMailingListPayload", v)
+			return goahttp.ErrInvalidType("mailing-list", "import-groupsio-members", "*mailinglist.ImportGroupsioMembersPayload", v)
 		}
 		if p.BearerToken != nil {
 			head := *p.BearerToken
@@ -1509,25 +4498,25 @@ func EncodeUpdateGroupsioMailingListRequest(encoder func(*http.Request) goahttp.
 				req.Header.Set("Authorization", head)
 			}
 		}
-		body := NewUpdateGroupsioMailingListRequestBody(p)
+		body := NewImportGroupsioMembersRequestBody(p)
 		if err := encoder(req).Encode(&body); err != nil {
-			return goahttp.ErrEncodingError("mailing-list", "update-groupsio-mailing-list", err)
+			return goahttp.ErrEncodingError("mailing-list", "import-groupsio-members", err)
 		}
 		return nil
 	}
 }
 
-// DecodeUpdateGroupsioMailingListResponse returns a decoder for responses
-// returned by the mailing-list update-groupsio-mailing-list endpoint.
-// restoreBody controls whether the response body should be restored after
-// having been read.
-// DecodeUpdateGroupsioMailingListResponse may return the following errors:
+// DecodeImportGroupsioMembersResponse returns a decoder for responses returned
+// by the mailing-list import-groupsio-members endpoint. restoreBody controls
+// whether the response body should be restored after having been read.
+// DecodeImportGroupsioMembersResponse may return the following errors:
 //   - "BadRequest" (type *mailinglist.BadRequestError): http.StatusBadRequest
+//   - "Conflict" (type *mailinglist.ConflictError): http.StatusConflict
 //   - "InternalServerError" (type *mailinglist.InternalServerError): http.StatusInternalServerError
 //   - "NotFound" (type *mailinglist.NotFoundError): http.StatusNotFound
 //   - "ServiceUnavailable" (type *mailinglist.ServiceUnavailableError): http.StatusServiceUnavailable
 //   - error: internal error
-func DecodeUpdateGroupsioMailingListResponse(decoder func(*http.Response) goahttp.Decoder, restoreBody bool) func(*http.Response) (any, error) {
+func DecodeImportGroupsioMembersResponse(decoder func(*http.Response) goahttp.Decoder, restoreBody bool) func(*http.Response) (any, error) {
 	return func(resp *http.Response) (any, error) {
 		if restoreBody {
 			b, err := io.ReadAll(resp.Body)
@@ -1544,100 +4533,114 @@ func DecodeUpdateGroupsioMailingListResponse(decoder func(*http.Response) goahtt
 		switch resp.StatusCode {
 		case http.StatusOK:
 			var (
-				body UpdateGroupsioMailingListResponseBody
+				body ImportGroupsioMembersResponseBody
 				err  error
 			)
 			err = decoder(resp).Decode(&body)
 			if err != nil {
-				return nil, goahttp.ErrDecodingError("mailing-list", "update-groupsio-mailing-list", err)
+				return nil, goahttp.ErrDecodingError("mailing-list", "import-groupsio-members", err)
 			}
-			err = ValidateUpdateGroupsioMailingListResponseBody(&body)
+			err = ValidateImportGroupsioMembersResponseBody(&body)
 			if err != nil {
-				return nil, goahttp.ErrValidationError("mailing-list", "update-groupsio-mailing-list", err)
+				return nil, goahttp.ErrValidationError("mailing-list", "import-groupsio-members", err)
 			}
-			res := NewUpdateGroupsioMailingListGroupsioSubgroupOK(&body)
+			res := NewImportGroupsioMembersGroupsioImportSummaryOK(&body)
 			return res, nil
 		case http.StatusBadRequest:
 			var (
-				body UpdateGroupsioMailingListBadRequestResponseBody
+				body ImportGroupsioMembersBadRequestResponseBody
 				err  error
 			)
 			err = decoder(resp).Decode(&body)
 			if err != nil {
-				return nil, goahttp.ErrDecodingError("mailing-list", "update-groupsio-mailing-list", err)
+				return nil, goahttp.ErrDecodingError("mailing-list", "import-groupsio-members", err)
 			}
-			err = ValidateUpdateGroupsioMailingListBadRequestResponseBody(&body)
+			err = ValidateImportGroupsioMembersBadRequestResponseBody(&body)
 			if err != nil {
-				return nil, goahttp.ErrValidationError("mailing-list", "update-groupsio-mailing-list", err)
+				return nil, goahttp.ErrValidationError("mailing-list", "import-groupsio-members", err)
 			}
-			return nil, NewUpdateGroupsioMailingListBadRequest(&body)
+			return nil, NewImportGroupsioMembersBadRequest(&body)
+		case http.StatusConflict:
+			var (
+				body ImportGroupsioMembersConflictResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("mailing-list", "import-groupsio-members", err)
+			}
+			err = ValidateImportGroupsioMembersConflictResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("mailing-list", "import-groupsio-members", err)
+			}
+			return nil, NewImportGroupsioMembersConflict(&body)
 		case http.StatusInternalServerError:
 			var (
-				body UpdateGroupsioMailingListInternalServerErrorResponseBody
+				body ImportGroupsioMembersInternalServerErrorResponseBody
 				err  error
 			)
 			err = decoder(resp).Decode(&body)
 			if err != nil {
-				return nil, goahttp.ErrDecodingError("mailing-list", "update-groupsio-mailing-list", err)
+				return nil, goahttp.ErrDecodingError("mailing-list", "import-groupsio-members", err)
 			}
-			err = ValidateUpdateGroupsioMailingListInternalServerErrorResponseBody(&body)
+			err = ValidateImportGroupsioMembersInternalServerErrorResponseBody(&body)
 			if err != nil {
-				return nil, goahttp.ErrValidationError("mailing-list", "update-groupsio-mailing-list", err)
+				return nil, goahttp.ErrValidationError("mailing-list", "import-groupsio-members", err)
 			}
-			return nil, NewUpdateGroupsioMailingListInternalServerError(&body)
+			return nil, NewImportGroupsioMembersInternalServerError(&body)
 		case http.StatusNotFound:
 			var (
-				body UpdateGroupsioMailingListNotFoundResponseBody
+				body ImportGroupsioMembersNotFoundResponseBody
 				err  error
 			)
 			err = decoder(resp).Decode(&body)
 			if err != nil {
-				return nil, goahttp.ErrDecodingError("mailing-list", "update-groupsio-mailing-list", err)
+				return nil, goahttp.ErrDecodingError("mailing-list", "import-groupsio-members", err)
 			}
-			err = ValidateUpdateGroupsioMailingListNotFoundResponseBody(&body)
+			err = ValidateImportGroupsioMembersNotFoundResponseBody(&body)
 			if err != nil {
-				return nil, goahttp.ErrValidationError("mailing-list", "update-groupsio-mailing-list", err)
+				return nil, goahttp.ErrValidationError("mailing-list", "import-groupsio-members", err)
 			}
-			return nil, NewUpdateGroupsioMailingListNotFound(&body)
+			return nil, NewImportGroupsioMembersNotFound(&body)
 		case http.StatusServiceUnavailable:
 			var (
-				body UpdateGroupsioMailingListServiceUnavailableResponseBody
+				body ImportGroupsioMembersServiceUnavailableResponseBody
 				err  error
 			)
 			err = decoder(resp).Decode(&body)
 			if err != nil {
-				return nil, goahttp.ErrDecodingError("mailing-list", "update-groupsio-mailing-list", err)
+				return nil, goahttp.ErrDecodingError("mailing-list", "import-groupsio-members", err)
 			}
-			err = ValidateUpdateGroupsioMailingListServiceUnavailableResponseBody(&body)
+			err = ValidateImportGroupsioMembersServiceUnavailableResponseBody(&body)
 			if err != nil {
-				return nil, goahttp.ErrValidationError("mailing-list", "update-groupsio-mailing-list", err)
+				return nil, goahttp.ErrValidationError("mailing-list", "import-groupsio-members", err)
 			}
-			return nil, NewUpdateGroupsioMailingListServiceUnavailable(&body)
+			return nil, NewImportGroupsioMembersServiceUnavailable(&body)
 		default:
 			body, _ := io.ReadAll(resp.Body)
-			return nil, goahttp.ErrInvalidResponse("mailing-list", "update-groupsio-mailing-list", resp.StatusCode, string(body))
+			return nil, goahttp.ErrInvalidResponse("mailing-list", "import-groupsio-members", resp.StatusCode, string(body))
 		}
 	}
 }
 
-// BuildDeleteGroupsioMailingListRequest instantiates a HTTP request object
-// with method and path set to call the "mailing-list" service
-// "delete-groupsio-mailing-list" endpoint
-func (c *Client) BuildDeleteGroupsioMailingListRequest(ctx context.Context, v any) (*http.Request, error) {
+// BuildMergeGroupsioMembersRequest instantiates a HTTP request object with
+// method and path set to call the "mailing-list" service
+// "merge-groupsio-members" endpoint
+func (c *Client) BuildMergeGroupsioMembersRequest(ctx context.Context, v any) (*http.Request, error) {
 	var (
 		subgroupID string
 	)
 	{
-		p, ok := v.(*mailinglist.DeleteGroupsioMailingListPayload)
+		p, ok := v.(*mailinglist.MergeGroupsioMembersPayload)
 		if !ok {
-			return nil, goahttp.ErrInvalidType("mailing-list", "delete-groupsio-mailing-list", "*mailinglist.DeleteGroupsioMailingListPayload", v)
+			return nil, goahttp.ErrInvalidType("mailing-list", "merge-groupsio-members", "*mailinglist.MergeGroupsioMembersPayload", v)
 		}
 		subgroupID = p.SubgroupID
 	}
-	u := &url.URL{Scheme: c.scheme, Host: c.host, Path: DeleteGroupsioMailingListMailingListPath(subgroupID)}
-	req, err := http.NewRequest("DELETE", u.String(), nil)
+	u := &url.URL{Scheme: c.scheme, Host: c.host, Path: MergeGroupsioMembersMailingListPath(subgroupID)}
+	req, err := http.NewRequest("POST", u.String(), nil)
 	if err != nil {
-		return nil, goahttp.ErrInvalidURL("mailing-list", "delete-groupsio-mailing-list", u.String(), err)
+		return nil, goahttp.ErrInvalidURL("mailing-list", "merge-groupsio-members", u.String(), err)
 	}
 	if ctx != nil {
 		req = req.WithContext(ctx)
@@ -1646,13 +4649,13 @@ func (c *Client) BuildDeleteGroupsioMailingListRequest(ctx context.Context, v an
 	return req, nil
 }
 
-// EncodeDeleteGroupsioMailingListRequest returns an encoder for requests sent
-// to the mailing-list delete-groupsio-mailing-list server.
-func EncodeDeleteGroupsioMailingListRequest(encoder func(*http.Request) goahttp.Encoder) func(*http.Request, any) error {
+// EncodeMergeGroupsioMembersRequest returns an encoder for requests sent to
+// the mailing-list merge-groupsio-members server.
+func EncodeMergeGroupsioMembersRequest(encoder func(*http.Request) goahttp.Encoder) func(*http.Request, any) error {
 	return func(req *http.Request, v any) error {
-		p, ok := v.(*mailinglist.DeleteGroupsioMailingListPayload)
+		p, ok := v.(*mailinglist.MergeGroupsioMembersPayload)
 		if !ok {
-			return goahttp.ErrInvalidType("mailing-list", "delete-groupsio-mailing-list", "*mailinglist.DeleteGroupsioMailingListPayload", v)
+			return goahttp.ErrInvalidType("mailing-list", "merge-groupsio-members", "*mailinglist.MergeGroupsioMembersPayload", v)
 		}
 		if p.BearerToken != nil {
 			head := *p.BearerToken
@@ -1662,20 +4665,24 @@ func EncodeDeleteGroupsioMailingListRequest(encoder func(*http.Request) goahttp.
 				req.Header.Set("Authorization", head)
 			}
 		}
+		body := NewMergeGroupsioMembersRequestBody(p)
+		if err := encoder(req).Encode(&body); err != nil {
+			return goahttp.ErrEncodingError("mailing-list", "merge-groupsio-members", err)
+		}
 		return nil
 	}
 }
 
-// DecodeDeleteGroupsioMailingListResponse returns a decoder for responses
-// returned by the mailing-list delete-groupsio-mailing-list endpoint.
-// restoreBody controls whether the response body should be restored after
-// having been read.
-// DecodeDeleteGroupsioMailingListResponse may return the following errors:
+// DecodeMergeGroupsioMembersResponse returns a decoder for responses returned
+// by the mailing-list merge-groupsio-members endpoint. restoreBody controls
+// whether the response body should be restored after having been read.
+// DecodeMergeGroupsioMembersResponse may return the following errors:
+//   - "BadRequest" (type *mailinglist.BadRequestError): http.StatusBadRequest
 //   - "InternalServerError" (type *mailinglist.InternalServerError): http.StatusInternalServerError
 //   - "NotFound" (type *mailinglist.NotFoundError): http.StatusNotFound
 //   - "ServiceUnavailable" (type *mailinglist.ServiceUnavailableError): http.StatusServiceUnavailable
 //   - error: internal error
-func DecodeDeleteGroupsioMailingListResponse(decoder func(*http.Response) goahttp.Decoder, restoreBody bool) func(*http.Response) (any, error) {
+func DecodeMergeGroupsioMembersResponse(decoder func(*http.Response) goahttp.Decoder, restoreBody bool) func(*http.Response) (any, error) {
 	return func(resp *http.Response) (any, error) {
 		if restoreBody {
 			b, err := io.ReadAll(resp.Body)
@@ -1692,63 +4699,89 @@ func DecodeDeleteGroupsioMailingListResponse(decoder func(*http.Response) goahtt
 		switch resp.StatusCode {
 		case http.StatusNoContent:
 			return nil, nil
+		case http.StatusBadRequest:
+			var (
+				body MergeGroupsioMembersBadRequestResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("mailing-list", "merge-groupsio-members", err)
+			}
+			err = ValidateMergeGroupsioMembersBadRequestResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("mailing-list", "merge-groupsio-members", err)
+			}
+			return nil, NewMergeGroupsioMembersBadRequest(&body)
 		case http.StatusInternalServerError:
 			var (
-				body DeleteGroupsioMailingListInternalServerErrorResponseBody
+				body MergeGroupsioMembersInternalServerErrorResponseBody
 				err  error
 			)
 			err = decoder(resp).Decode(&body)
 			if err != nil {
-				return nil, goahttp.ErrDecodingError("mailing-list", "delete-groupsio-mailing-list", err)
+				return nil, goahttp.ErrDecodingError("mailing-list", "merge-groupsio-members", err)
 			}
-			err = ValidateDeleteGroupsioMailingListInternalServerErrorResponseBody(&body)
+			err = ValidateMergeGroupsioMembersInternalServerErrorResponseBody(&body)
 			if err != nil {
-				return nil, goahttp.ErrValidationError("mailing-list", "delete-groupsio-mailing-list", err)
+				return nil, goahttp.ErrValidationError("mailing-list", "merge-groupsio-members", err)
 			}
-			return nil, NewDeleteGroupsioMailingListInternalServerError(&body)
+			return nil, NewMergeGroupsioMembersInternalServerError(&body)
 		case http.StatusNotFound:
 			var (
-				body DeleteGroupsioMailingListNotFoundResponseBody
+				body MergeGroupsioMembersNotFoundResponseBody
 				err  error
 			)
 			err = decoder(resp).Decode(&body)
 			if err != nil {
-				return nil, goahttp.ErrDecodingError("mailing-list", "delete-groupsio-mailing-list", err)
+				return nil, goahttp.ErrDecodingError("mailing-list", "merge-groupsio-members", err)
 			}
-			err = ValidateDeleteGroupsioMailingListNotFoundResponseBody(&body)
+			err = ValidateMergeGroupsioMembersNotFoundResponseBody(&body)
 			if err != nil {
-				return nil, goahttp.ErrValidationError("mailing-list", "delete-groupsio-mailing-list", err)
+				return nil, goahttp.ErrValidationError("mailing-list", "merge-groupsio-members", err)
 			}
-			return nil, NewDeleteGroupsioMailingListNotFound(&body)
+			return nil, NewMergeGroupsioMembersNotFound(&body)
 		case http.StatusServiceUnavailable:
 			var (
-				body DeleteGroupsioMailingListServiceUnavailableResponseBody
+				body MergeGroupsioMembersServiceUnavailableResponseBody
 				err  error
 			)
 			err = decoder(resp).Decode(&body)
 			if err != nil {
-				return nil, goahttp.ErrDecodingError("mailing-list", "delete-groupsio-mailing-list", err)
+				return nil, goahttp.ErrDecodingError("mailing-list", "merge-groupsio-members", err)
 			}
-			err = ValidateDeleteGroupsioMailingListServiceUnavailableResponseBody(&body)
+			err = ValidateMergeGroupsioMembersServiceUnavailableResponseBody(&body)
 			if err != nil {
-				return nil, goahttp.ErrValidationError("mailing-list", "delete-groupsio-mailing-list", err)
+				return nil, goahttp.ErrValidationError("mailing-list", "merge-groupsio-members", err)
 			}
-			return nil, NewDeleteGroupsioMailingListServiceUnavailable(&body)
+			return nil, NewMergeGroupsioMembersServiceUnavailable(&body)
 		default:
 			body, _ := io.ReadAll(resp.Body)
-			return nil, goahttp.ErrInvalidResponse("mailing-list", "delete-groupsio-mailing-list", resp.StatusCode, string(body))
+			return nil, goahttp.ErrInvalidResponse("mailing-list", "merge-groupsio-members", resp.StatusCode, string(body))
 		}
 	}
 }
 
-// BuildGetGroupsioMailingListCountRequest instantiates a HTTP request object
+// BuildChangeGroupsioMemberEmailRequest instantiates a HTTP request object
 // with method and path set to call the "mailing-list" service
-// "get-groupsio-mailing-list-count" endpoint
-func (c *Client) BuildGetGroupsioMailingListCountRequest(ctx context.Context, v any) (*http.Request, error) {
-	u := &url.URL{Scheme: c.scheme, Host: c.host, Path: GetGroupsioMailingListCountMailingListPath()}
-	req, err := http.NewRequest("GET", u.String(), nil)
+// "change-groupsio-member-email" endpoint
+func (c *Client) BuildChangeGroupsioMemberEmailRequest(ctx context.Context, v any) (*http.Request, error) {
+	var (
+		subgroupID string
+		memberID   string
+	)
+	{
+		p, ok := v.(*mailinglist.ChangeGroupsioMemberEmailPayload)
+		if !ok {
+			return nil, goahttp.ErrInvalidType("mailing-list", "change-groupsio-member-email", "*mailinglist.ChangeGroupsioMemberEmailPayload", v)
+		}
+		subgroupID = p.SubgroupID
+		memberID = p.MemberID
+	}
+	u := &url.URL{Scheme: c.scheme, Host: c.host, Path: ChangeGroupsioMemberEmailMailingListPath(subgroupID, memberID)}
+	req, err := http.NewRequest("PUT", u.String(), nil)
 	if err != nil {
-		return nil, goahttp.ErrInvalidURL("mailing-list", "get-groupsio-mailing-list-count", u.String(), err)
+		return nil, goahttp.ErrInvalidURL("mailing-list", "change-groupsio-member-email", u.String(), err)
 	}
 	if ctx != nil {
 		req = req.WithContext(ctx)
@@ -1757,13 +4790,13 @@ func (c *Client) BuildGetGroupsioMailingListCountRequest(ctx context.Context, v
 	return req, nil
 }
 
-// EncodeGetGroupsioMailingListCountRequest returns an encoder for requests
-// sent to the mailing-list get-groupsio-mailing-list-count server.
-func EncodeGetGroupsioMailingListCountRequest(encoder func(*http.Request) goahttp.Encoder) func(*http.Request, any) error {
+// EncodeChangeGroupsioMemberEmailRequest returns an encoder for requests sent
+// to the mailing-list change-groupsio-member-email server.
+func EncodeChangeGroupsioMemberEmailRequest(encoder func(*http.Request) goahttp.Encoder) func(*http.Request, any) error {
 	return func(req *http.Request, v any) error {
-		p, ok := v.(*mailinglist.GetGroupsioMailingListCountPayload)
+		p, ok := v.(*mailinglist.ChangeGroupsioMemberEmailPayload)
 		if !ok {
-			return goahttp.ErrInvalidType("mailing-list", "get-groupsio-mailing-list-count", "*mailinglist.GetGroupsioMailingListCountPayload", v)
+			return goahttp.ErrInvalidType("mailing-list", "change-groupsio-member-email", "*mailinglist.ChangeGroupsioMemberEmailPayload", v)
 		}
 		if p.BearerToken != nil {
 			head := *p.BearerToken
@@ -1773,23 +4806,26 @@ func EncodeGetGroupsioMailingListCountRequest(encoder func(*http.Request) goahtt
 				req.Header.Set("Authorization", head)
 			}
 		}
-		values := req.URL.Query()
-		values.Add("project_uid", p.ProjectUID)
-		req.URL.RawQuery = values.Encode()
+		body := NewChangeGroupsioMemberEmailRequestBody(p)
+		if err := encoder(req).Encode(&body); err != nil {
+			return goahttp.ErrEncodingError("mailing-list", "change-groupsio-member-email", err)
+		}
 		return nil
 	}
 }
 
-// DecodeGetGroupsioMailingListCountResponse returns a decoder for responses
-// returned by the mailing-list get-groupsio-mailing-list-count endpoint.
+// DecodeChangeGroupsioMemberEmailResponse returns a decoder for responses
+// returned by the mailing-list change-groupsio-member-email endpoint.
 // restoreBody controls whether the response body should be restored after
 // having been read.
-// DecodeGetGroupsioMailingListCountResponse may return the following errors:
+// DecodeChangeGroupsioMemberEmailResponse may return the following errors:
 //   - "BadRequest" (type *mailinglist.BadRequestError): http.StatusBadRequest
+//   - "Conflict" (type *mailinglist.ConflictError): http.StatusConflict
 //   - "InternalServerError" (type *mailinglist.InternalServerError): http.StatusInternalServerError
+//   - "NotFound" (type *mailinglist.NotFoundError): http.StatusNotFound
 //   - "ServiceUnavailable" (type *mailinglist.ServiceUnavailableError): http.StatusServiceUnavailable
 //   - error: internal error
-func DecodeGetGroupsioMailingListCountResponse(decoder func(*http.Response) goahttp.Decoder, restoreBody bool) func(*http.Response) (any, error) {
+func DecodeChangeGroupsioMemberEmailResponse(decoder func(*http.Response) goahttp.Decoder, restoreBody bool) func(*http.Response) (any, error) {
 	return func(resp *http.Response) (any, error) {
 		if restoreBody {
 			b, err := io.ReadAll(resp.Body)
@@ -1806,86 +4842,104 @@ func DecodeGetGroupsioMailingListCountResponse(decoder func(*http.Response) goah
 		switch resp.StatusCode {
 		case http.StatusOK:
 			var (
-				body GetGroupsioMailingListCountResponseBody
+				body ChangeGroupsioMemberEmailResponseBody
 				err  error
 			)
 			err = decoder(resp).Decode(&body)
 			if err != nil {
-				return nil, goahttp.ErrDecodingError("mailing-list", "get-groupsio-mailing-list-count", err)
+				return nil, goahttp.ErrDecodingError("mailing-list", "change-groupsio-member-email", err)
 			}
-			err = ValidateGetGroupsioMailingListCountResponseBody(&body)
+			err = ValidateChangeGroupsioMemberEmailResponseBody(&body)
 			if err != nil {
-				return nil, goahttp.ErrValidationError("mailing-list", "get-groupsio-mailing-list-count", err)
+				return nil, goahttp.ErrValidationError("mailing-list", "change-groupsio-member-email", err)
 			}
-			res := NewGetGroupsioMailingListCountGroupsioCountOK(&body)
+			res := NewChangeGroupsioMemberEmailGroupsioMemberOK(&body)
 			return res, nil
 		case http.StatusBadRequest:
 			var (
-				body GetGroupsioMailingListCountBadRequestResponseBody
+				body ChangeGroupsioMemberEmailBadRequestResponseBody
 				err  error
 			)
 			err = decoder(resp).Decode(&body)
 			if err != nil {
-				return nil, goahttp.ErrDecodingError("mailing-list", "get-groupsio-mailing-list-count", err)
+				return nil, goahttp.ErrDecodingError("mailing-list", "change-groupsio-member-email", err)
 			}
-			err = ValidateGetGroupsioMailingListCountBadRequestResponseBody(&body)
+			err = ValidateChangeGroupsioMemberEmailBadRequestResponseBody(&body)
 			if err != nil {
-				return nil, goahttp.ErrValidationError("mailing-list", "get-groupsio-mailing-list-count", err)
+				return nil, goahttp.ErrValidationError("mailing-list", "change-groupsio-member-email", err)
 			}
-			return nil, NewGetGroupsioMailingListCountBadRequest(&body)
+			return nil, NewChangeGroupsioMemberEmailBadRequest(&body)
+		case http.StatusConflict:
+			var (
+				body ChangeGroupsioMemberEmailConflictResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("mailing-list", "change-groupsio-member-email", err)
+			}
+			err = ValidateChangeGroupsioMemberEmailConflictResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("mailing-list", "change-groupsio-member-email", err)
+			}
+			return nil, NewChangeGroupsioMemberEmailConflict(&body)
 		case http.StatusInternalServerError:
 			var (
-				body GetGroupsioMailingListCountInternalServerErrorResponseBody
+				body ChangeGroupsioMemberEmailInternalServerErrorResponseBody
 				err  error
 			)
 			err = decoder(resp).Decode(&body)
 			if err != nil {
-				return nil, goahttp.ErrDecodingError("mailing-list", "get-groupsio-mailing-list-count", err)
+				return nil, goahttp.ErrDecodingError("mailing-list", "change-groupsio-member-email", err)
 			}
-			err = ValidateGetGroupsioMailingListCountInternalServerErrorResponseBody(&body)
+			err = ValidateChangeGroupsioMemberEmailInternalServerErrorResponseBody(&body)
 			if err != nil {
-				return nil, goahttp.ErrValidationError("mailing-list", "get-groupsio-mailing-list-count", err)
+				return nil, goahttp.ErrValidationError("mailing-list", "change-groupsio-member-email", err)
 			}
-			return nil, NewGetGroupsioMailingListCountInternalServerError(&body)
+			return nil, NewChangeGroupsioMemberEmailInternalServerError(&body)
+		case http.StatusNotFound:
+			var (
+				body ChangeGroupsioMemberEmailNotFoundResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("mailing-list", "change-groupsio-member-email", err)
+			}
+			err = ValidateChangeGroupsioMemberEmailNotFoundResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("mailing-list", "change-groupsio-member-email", err)
+			}
+			return nil, NewChangeGroupsioMemberEmailNotFound(&body)
 		case http.StatusServiceUnavailable:
 			var (
-				body GetGroupsioMailingListCountServiceUnavailableResponseBody
+				body ChangeGroupsioMemberEmailServiceUnavailableResponseBody
 				err  error
 			)
 			err = decoder(resp).Decode(&body)
 			if err != nil {
-				return nil, goahttp.ErrDecodingError("mailing-list", "get-groupsio-mailing-list-count", err)
+				return nil, goahttp.ErrDecodingError("mailing-list", "change-groupsio-member-email", err)
 			}
-			err = ValidateGetGroupsioMailingListCountServiceUnavailableResponseBody(&body)
+			err = ValidateChangeGroupsioMemberEmailServiceUnavailableResponseBody(&body)
 			if err != nil {
-				return nil, goahttp.ErrValidationError("mailing-list", "get-groupsio-mailing-list-count", err)
+				return nil, goahttp.ErrValidationError("mailing-list", "change-groupsio-member-email", err)
 			}
-			return nil, NewGetGroupsioMailingListCountServiceUnavailable(&body)
+			return nil, NewChangeGroupsioMemberEmailServiceUnavailable(&body)
 		default:
 			body, _ := io.ReadAll(resp.Body)
-			return nil, goahttp.ErrInvalidResponse("mailing-list", "get-groupsio-mailing-list-count", resp.StatusCode, string(body))
+			return nil, goahttp.ErrInvalidResponse("mailing-list", "change-groupsio-member-email", resp.StatusCode, string(body))
 		}
 	}
 }
 
-// BuildGetGroupsioMailingListMemberCountRequest instantiates a HTTP request
-// object with method and path set to call the "mailing-list" service
-// "get-groupsio-mailing-list-member-count" endpoint
-func (c *Client) BuildGetGroupsioMailingListMemberCountRequest(ctx context.Context, v any) (*http.Request, error) {
-	var (
-		subgroupID string
-	)
-	{
-		p, ok := v.(*mailinglist.GetGroupsioMailingListMemberCountPayload)
-		if !ok {
-			return nil, goahttp.ErrInvalidType("mailing-list", "get-groupsio-mailing-list-member-count", "*mailinglist.GetGroupsioMailingListMemberCountPayload", v)
-		}
-		subgroupID = p.SubgroupID
-	}
-	u := &url.URL{Scheme: c.scheme, Host: c.host, Path: GetGroupsioMailingListMemberCountMailingListPath(subgroupID)}
-	req, err := http.NewRequest("GET", u.String(), nil)
+// BuildCheckGroupsioSubscriberRequest instantiates a HTTP request object with
+// method and path set to call the "mailing-list" service
+// "check-groupsio-subscriber" endpoint
+func (c *Client) BuildCheckGroupsioSubscriberRequest(ctx context.Context, v any) (*http.Request, error) {
+	u := &url.URL{Scheme: c.scheme, Host: c.host, Path: CheckGroupsioSubscriberMailingListPath()}
+	req, err := http.NewRequest("POST", u.String(), nil)
 	if err != nil {
-		return nil, goahttp.ErrInvalidURL("mailing-list", "get-groupsio-mailing-list-member-count", u.String(), err)
+		return nil, goahttp.ErrInvalidURL("mailing-list", "check-groupsio-subscriber", u.String(), err)
 	}
 	if ctx != nil {
 		req = req.WithContext(ctx)
@@ -1894,14 +4948,13 @@ func (c *Client) BuildGetGroupsioMailingListMemberCountRequest(ctx context.Conte
 	return req, nil
 }
 
-// EncodeGetGroupsioMailingListMemberCountRequest returns an encoder for
-// requests sent to the mailing-list get-groupsio-mailing-list-member-count
-// server.
-func EncodeGetGroupsioMailingListMemberCountRequest(encoder func(*http.Request) goahttp.Encoder) func(*http.Request, any) error {
+// EncodeCheckGroupsioSubscriberRequest returns an encoder for requests sent to
+// the mailing-list check-groupsio-subscriber server.
+func EncodeCheckGroupsioSubscriberRequest(encoder func(*http.Request) goahttp.Encoder) func(*http.Request, any) error {
 	return func(req *http.Request, v any) error {
-		p, ok := v.(*mailinglist.GetGroupsioMailingListMemberCountPayload)
+		p, ok := v.(*mailinglist.CheckGroupsioSubscriberPayload)
 		if !ok {
-			return goahttp.ErrInvalidType("mailing-list", "get-groupsio-mailing-list-member-count", "*mailinglist.GetGroupsioMailingListMemberCountPayload", v)
+			return goahttp.ErrInvalidType("mailing-list", "check-groupsio-subscriber", "*mailinglist.CheckGroupsioSubscriberPayload", v)
 		}
 		if p.BearerToken != nil {
 			head := *p.BearerToken
@@ -1911,21 +4964,23 @@ func EncodeGetGroupsioMailingListMemberCountRequest(encoder func(*http.Request)
 				req.Header.Set("Authorization", head)
 			}
 		}
+		body := NewCheckGroupsioSubscriberRequestBody(p)
+		if err := encoder(req).Encode(&body); err != nil {
+			return goahttp.ErrEncodingError("mailing-list", "check-groupsio-subscriber", err)
+		}
 		return nil
 	}
 }
 
-// DecodeGetGroupsioMailingListMemberCountResponse returns a decoder for
-// responses returned by the mailing-list
-// get-groupsio-mailing-list-member-count endpoint. restoreBody controls
-// whether the response body should be restored after having been read.
-// DecodeGetGroupsioMailingListMemberCountResponse may return the following
-// errors:
+// DecodeCheckGroupsioSubscriberResponse returns a decoder for responses
+// returned by the mailing-list check-groupsio-subscriber endpoint. restoreBody
+// controls whether the response body should be restored after having been read.
+// DecodeCheckGroupsioSubscriberResponse may return the following errors:
+//   - "BadRequest" (type *mailinglist.BadRequestError): http.StatusBadRequest
 //   - "InternalServerError" (type *mailinglist.InternalServerError): http.StatusInternalServerError
-//   - "NotFound" (type *mailinglist.NotFoundError): http.StatusNotFound
 //   - "ServiceUnavailable" (type *mailinglist.ServiceUnavailableError): http.StatusServiceUnavailable
 //   - error: internal error
-func DecodeGetGroupsioMailingListMemberCountResponse(decoder func(*http.Response) goahttp.Decoder, restoreBody bool) func(*http.Response) (any, error) {
+func DecodeCheckGroupsioSubscriberResponse(decoder func(*http.Response) goahttp.Decoder, restoreBody bool) func(*http.Response) (any, error) {
 	return func(resp *http.Response) (any, error) {
 		if restoreBody {
 			b, err := io.ReadAll(resp.Body)
@@ -1942,86 +4997,88 @@ func DecodeGetGroupsioMailingListMemberCountResponse(decoder func(*http.Response
 		switch resp.StatusCode {
 		case http.StatusOK:
 			var (
-				body GetGroupsioMailingListMemberCountResponseBody
+				body CheckGroupsioSubscriberResponseBody
 				err  error
 			)
 			err = decoder(resp).Decode(&body)
 			if err != nil {
-				return nil, goahttp.ErrDecodingError("mailing-list", "get-groupsio-mailing-list-member-count", err)
+				return nil, goahttp.ErrDecodingError("mailing-list", "check-groupsio-subscriber", err)
 			}
-			err = ValidateGetGroupsioMailingListMemberCountResponseBody(&body)
+			err = ValidateCheckGroupsioSubscriberResponseBody(&body)
 			if err != nil {
-				return nil, goahttp.ErrValidationError("mailing-list", "get-groupsio-mailing-list-member-count", err)
+				return nil, goahttp.ErrValidationError("mailing-list", "check-groupsio-subscriber", err)
 			}
-			res := NewGetGroupsioMailingListMemberCountGroupsioCountOK(&body)
+			res := NewCheckGroupsioSubscriberGroupsioCheckSubscriberResponseOK(&body)
 			return res, nil
-		case http.StatusInternalServerError:
+		case http.StatusBadRequest:
 			var (
-				body GetGroupsioMailingListMemberCountInternalServerErrorResponseBody
+				body CheckGroupsioSubscriberBadRequestResponseBody
 				err  error
 			)
 			err = decoder(resp).Decode(&body)
 			if err != nil {
-				return nil, goahttp.ErrDecodingError("mailing-list", "get-groupsio-mailing-list-member-count", err)
+				return nil, goahttp.ErrDecodingError("mailing-list", "check-groupsio-subscriber", err)
 			}
-			err = ValidateGetGroupsioMailingListMemberCountInternalServerErrorResponseBody(&body)
+			err = ValidateCheckGroupsioSubscriberBadRequestResponseBody(&body)
 			if err != nil {
-				return nil, goahttp.ErrValidationError("mailing-list", "get-groupsio-mailing-list-member-count", err)
+				return nil, goahttp.ErrValidationError("mailing-list", "check-groupsio-subscriber", err)
 			}
-			return nil, NewGetGroupsioMailingListMemberCountInternalServerError(&body)
-		case http.StatusNotFound:
+			return nil, NewCheckGroupsioSubscriberBadRequest(&body)
+		case http.StatusInternalServerError:
 			var (
-				body GetGroupsioMailingListMemberCountNotFoundResponseBody
+				body CheckGroupsioSubscriberInternalServerErrorResponseBody
 				err  error
 			)
 			err = decoder(resp).Decode(&body)
 			if err != nil {
-				return nil, goahttp.ErrDecodingError("mailing-list", "get-groupsio-mailing-list-member-count", err)
+				return nil, goahttp.ErrDecodingError("mailing-list", "check-groupsio-subscriber", err)
 			}
-			err = ValidateGetGroupsioMailingListMemberCountNotFoundResponseBody(&body)
+			err = ValidateCheckGroupsioSubscriberInternalServerErrorResponseBody(&body)
 			if err != nil {
-				return nil, goahttp.ErrValidationError("mailing-list", "get-groupsio-mailing-list-member-count", err)
+				return nil, goahttp.ErrValidationError("mailing-list", "check-groupsio-subscriber", err)
 			}
-			return nil, NewGetGroupsioMailingListMemberCountNotFound(&body)
+			return nil, NewCheckGroupsioSubscriberInternalServerError(&body)
 		case http.StatusServiceUnavailable:
 			var (
-				body GetGroupsioMailingListMemberCountServiceUnavailableResponseBody
+				body CheckGroupsioSubscriberServiceUnavailableResponseBody
 				err  error
 			)
 			err = decoder(resp).Decode(&body)
 			if err != nil {
-				return nil, goahttp.ErrDecodingError("mailing-list", "get-groupsio-mailing-list-member-count", err)
+				return nil, goahttp.ErrDecodingError("mailing-list", "check-groupsio-subscriber", err)
 			}
-			err = ValidateGetGroupsioMailingListMemberCountServiceUnavailableResponseBody(&body)
+			err = ValidateCheckGroupsioSubscriberServiceUnavailableResponseBody(&body)
 			if err != nil {
-				return nil, goahttp.ErrValidationError("mailing-list", "get-groupsio-mailing-list-member-count", err)
+				return nil, goahttp.ErrValidationError("mailing-list", "check-groupsio-subscriber", err)
 			}
-			return nil, NewGetGroupsioMailingListMemberCountServiceUnavailable(&body)
+			return nil, NewCheckGroupsioSubscriberServiceUnavailable(&body)
 		default:
 			body, _ := io.ReadAll(resp.Body)
-			return nil, goahttp.ErrInvalidResponse("mailing-list", "get-groupsio-mailing-list-member-count", resp.StatusCode, string(body))
+			return nil, goahttp.ErrInvalidResponse("mailing-list", "check-groupsio-subscriber", resp.StatusCode, string(body))
 		}
 	}
 }
 
-// BuildListGroupsioMembersRequest instantiates a HTTP request object with
+// BuildGetGroupsioArtifactRequest instantiates a HTTP request object with
 // method and path set to call the "mailing-list" service
-// "list-groupsio-members" endpoint
-func (c *Client) BuildListGroupsioMembersRequest(ctx context.Context, v any) (*http.Request, error) {
+// "get-groupsio-artifact" endpoint
+func (c *Client) BuildGetGroupsioArtifactRequest(ctx context.Context, v any) (*http.Request, error) {
 	var (
 		subgroupID string
+		artifactID string
 	)
 	{
-		p, ok := v.(*mailinglist.ListGroupsioMembersPayload)
+		p, ok := v.(*mailinglist.GetGroupsioArtifactPayload)
 		if !ok {
-			return nil, goahttp.ErrInvalidType("mailing-list", "list-groupsio-members", "*mailinglist.ListGroupsioMembersPayload", v)
+			return nil, goahttp.ErrInvalidType("mailing-list", "get-groupsio-artifact", "*mailinglist.GetGroupsioArtifactPayload", v)
 		}
 		subgroupID = p.SubgroupID
+		artifactID = p.ArtifactID
 	}
-	u := &url.URL{Scheme: c.scheme, Host: c.host, Path: ListGroupsioMembersMailingListPath(subgroupID)}
+	u := &url.URL{Scheme: c.scheme, Host: c.host, Path: GetGroupsioArtifactMailingListPath(subgroupID, artifactID)}
 	req, err := http.NewRequest("GET", u.String(), nil)
 	if err != nil {
-		return nil, goahttp.ErrInvalidURL("mailing-list", "list-groupsio-members", u.String(), err)
+		return nil, goahttp.ErrInvalidURL("mailing-list", "get-groupsio-artifact", u.String(), err)
 	}
 	if ctx != nil {
 		req = req.WithContext(ctx)
@@ -2030,13 +5087,13 @@ func (c *Client) BuildListGroupsioMembersRequest(ctx context.Context, v any) (*h
 	return req, nil
 }
 
-// EncodeListGroupsioMembersRequest returns an encoder for requests sent to the
-// mailing-list list-groupsio-members server.
-func EncodeListGroupsioMembersRequest(encoder func(*http.Request) goahttp.Encoder) func(*http.Request, any) error {
+// EncodeGetGroupsioArtifactRequest returns an encoder for requests sent to the
+// mailing-list get-groupsio-artifact server.
+func EncodeGetGroupsioArtifactRequest(encoder func(*http.Request) goahttp.Encoder) func(*http.Request, any) error {
 	return func(req *http.Request, v any) error {
-		p, ok := v.(*mailinglist.ListGroupsioMembersPayload)
+		p, ok := v.(*mailinglist.GetGroupsioArtifactPayload)
 		if !ok {
-			return goahttp.ErrInvalidType("mailing-list", "list-groupsio-members", "*mailinglist.ListGroupsioMembersPayload", v)
+			return goahttp.ErrInvalidType("mailing-list", "get-groupsio-artifact", "*mailinglist.GetGroupsioArtifactPayload", v)
 		}
 		if p.BearerToken != nil {
 			head := *p.BearerToken
@@ -2050,15 +5107,15 @@ func EncodeListGroupsioMembersRequest(encoder func(*http.Request) goahttp.Encode
 	}
 }
 
-// DecodeListGroupsioMembersResponse returns a decoder for responses returned
-// by the mailing-list list-groupsio-members endpoint. restoreBody controls
+// DecodeGetGroupsioArtifactResponse returns a decoder for responses returned
+// by the mailing-list get-groupsio-artifact endpoint. restoreBody controls
 // whether the response body should be restored after having been read.
-// DecodeListGroupsioMembersResponse may return the following errors:
+// DecodeGetGroupsioArtifactResponse may return the following errors:
 //   - "InternalServerError" (type *mailinglist.InternalServerError): http.StatusInternalServerError
 //   - "NotFound" (type *mailinglist.NotFoundError): http.StatusNotFound
 //   - "ServiceUnavailable" (type *mailinglist.ServiceUnavailableError): http.StatusServiceUnavailable
 //   - error: internal error
-func DecodeListGroupsioMembersResponse(decoder func(*http.Response) goahttp.Decoder, restoreBody bool) func(*http.Response) (any, error) {
+func DecodeGetGroupsioArtifactResponse(decoder func(*http.Response) goahttp.Decoder, restoreBody bool) func(*http.Response) (any, error) {
 	return func(resp *http.Response) (any, error) {
 		if restoreBody {
 			b, err := io.ReadAll(resp.Body)
@@ -2075,86 +5132,84 @@ func DecodeListGroupsioMembersResponse(decoder func(*http.Response) goahttp.Deco
 		switch resp.StatusCode {
 		case http.StatusOK:
 			var (
-				body ListGroupsioMembersResponseBody
+				body GetGroupsioArtifactResponseBody
 				err  error
 			)
 			err = decoder(resp).Decode(&body)
 			if err != nil {
-				return nil, goahttp.ErrDecodingError("mailing-list", "list-groupsio-members", err)
-			}
-			err = ValidateListGroupsioMembersResponseBody(&body)
-			if err != nil {
-				return nil, goahttp.ErrValidationError("mailing-list", "list-groupsio-members", err)
+				return nil, goahttp.ErrDecodingError("mailing-list", "get-groupsio-artifact", err)
 			}
-			res := NewListGroupsioMembersGroupsioMemberListOK(&body)
+			res := NewGetGroupsioArtifactGroupsioArtifactOK(&body)
 			return res, nil
 		case http.StatusInternalServerError:
 			var (
-				body ListGroupsioMembersInternalServerErrorResponseBody
+				body GetGroupsioArtifactInternalServerErrorResponseBody
 				err  error
 			)
 			err = decoder(resp).Decode(&body)
 			if err != nil {
-				return nil, goahttp.ErrDecodingError("mailing-list", "list-groupsio-members", err)
+				return nil, goahttp.ErrDecodingError("mailing-list", "get-groupsio-artifact", err)
 			}
-			err = ValidateListGroupsioMembersInternalServerErrorResponseBody(&body)
+			err = ValidateGetGroupsioArtifactInternalServerErrorResponseBody(&body)
 			if err != nil {
-				return nil, goahttp.ErrValidationError("mailing-list", "list-groupsio-members", err)
+				return nil, goahttp.ErrValidationError("mailing-list", "get-groupsio-artifact", err)
 			}
-			return nil, NewListGroupsioMembersInternalServerError(&body)
+			return nil, NewGetGroupsioArtifactInternalServerError(&body)
 		case http.StatusNotFound:
 			var (
-				body ListGroupsioMembersNotFoundResponseBody
+				body GetGroupsioArtifactNotFoundResponseBody
 				err  error
 			)
 			err = decoder(resp).Decode(&body)
 			if err != nil {
-				return nil, goahttp.ErrDecodingError("mailing-list", "list-groupsio-members", err)
+				return nil, goahttp.ErrDecodingError("mailing-list", "get-groupsio-artifact", err)
 			}
-			err = ValidateListGroupsioMembersNotFoundResponseBody(&body)
+			err = ValidateGetGroupsioArtifactNotFoundResponseBody(&body)
 			if err != nil {
-				return nil, goahttp.ErrValidationError("mailing-list", "list-groupsio-members", err)
+				return nil, goahttp.ErrValidationError("mailing-list", "get-groupsio-artifact", err)
 			}
-			return nil, NewListGroupsioMembersNotFound(&body)
+			return nil, NewGetGroupsioArtifactNotFound(&body)
 		case http.StatusServiceUnavailable:
 			var (
-				body ListGroupsioMembersServiceUnavailableResponseBody
+				body GetGroupsioArtifactServiceUnavailableResponseBody
 				err  error
 			)
 			err = decoder(resp).Decode(&body)
 			if err != nil {
-				return nil, goahttp.ErrDecodingError("mailing-list", "list-groupsio-members", err)
+				return nil, goahttp.ErrDecodingError("mailing-list", "get-groupsio-artifact", err)
 			}
-			err = ValidateListGroupsioMembersServiceUnavailableResponseBody(&body)
+			err = ValidateGetGroupsioArtifactServiceUnavailableResponseBody(&body)
 			if err != nil {
-				return nil, goahttp.ErrValidationError("mailing-list", "list-groupsio-members", err)
+				return nil, goahttp.ErrValidationError("mailing-list", "get-groupsio-artifact", err)
 			}
-			return nil, NewListGroupsioMembersServiceUnavailable(&body)
+			return nil, NewGetGroupsioArtifactServiceUnavailable(&body)
 		default:
 			body, _ := io.ReadAll(resp.Body)
-			return nil, goahttp.ErrInvalidResponse("mailing-list", "list-groupsio-members", resp.StatusCode, string(body))
+			return nil, goahttp.ErrInvalidResponse("mailing-list", "get-groupsio-artifact", resp.StatusCode, string(body))
 		}
 	}
 }
 
-// BuildAddGroupsioMemberRequest instantiates a HTTP request object with method
-// and path set to call the "mailing-list" service "add-groupsio-member"
-// endpoint
-func (c *Client) BuildAddGroupsioMemberRequest(ctx context.Context, v any) (*http.Request, error) {
+// BuildGetGroupsioArtifactDownloadRequest instantiates a HTTP request object
+// with method and path set to call the "mailing-list" service
+// "get-groupsio-artifact-download" endpoint
+func (c *Client) BuildGetGroupsioArtifactDownloadRequest(ctx context.Context, v any) (*http.Request, error) {
 	var (
 		subgroupID string
+		artifactID string
 	)
 	{
-		p, ok := v.(*mailinglist.AddGroupsioMemberPayload)
+		p, ok := v.(*mailinglist.GetGroupsioArtifactDownloadPayload)
 		if !ok {
-			return nil, goahttp.ErrInvalidType("mailing-list", "add-groupsio-member", "*mailinglist.AddGroupsioMemberPayload", v)
+			return nil, goahttp.ErrInvalidType("mailing-list", "get-groupsio-artifact-download", "*mailinglist.GetGroupsioArtifactDownloadPayload", v)
 		}
 		subgroupID = p.SubgroupID
+		artifactID = p.ArtifactID
 	}
-	u := &url.URL{Scheme: c.scheme, Host: c.host, Path: AddGroupsioMemberMailingListPath(subgroupID)}
-	req, err := http.NewRequest("POST", u.String(), nil)
+	u := &url.URL{Scheme: c.scheme, Host: c.host, Path: GetGroupsioArtifactDownloadMailingListPath(subgroupID, artifactID)}
+	req, err := http.NewRequest("GET", u.String(), nil)
 	if err != nil {
-		return nil, goahttp.ErrInvalidURL("mailing-list", "add-groupsio-member", u.String(), err)
+		return nil, goahttp.ErrInvalidURL("mailing-list", "get-groupsio-artifact-download", u.String(), err)
 	}
 	if ctx != nil {
 		req = req.WithContext(ctx)
@@ -2163,13 +5218,13 @@ func (c *Client) BuildAddGroupsioMemberRequest(ctx context.Context, v any) (*htt
 	return req, nil
 }
 
-// EncodeAddGroupsioMemberRequest returns an encoder for requests sent to the
-// mailing-list add-groupsio-member server.
-func EncodeAddGroupsioMemberRequest(encoder func(*http.Request) goahttp.Encoder) func(*http.Request, any) error {
+// EncodeGetGroupsioArtifactDownloadRequest returns an encoder for requests
+// sent to the mailing-list get-groupsio-artifact-download server.
+func EncodeGetGroupsioArtifactDownloadRequest(encoder func(*http.Request) goahttp.Encoder) func(*http.Request, any) error {
 	return func(req *http.Request, v any) error {
-		p, ok := v.(*mailinglist.AddGroupsioMemberPayload)
+		p, ok := v.(*mailinglist.GetGroupsioArtifactDownloadPayload)
 		if !ok {
-			return goahttp.ErrInvalidType("mailing-list", "add-groupsio-member", "*mailinglist.AddGroupsioMemberPayload", v)
+			return goahttp.ErrInvalidType("mailing-list", "get-groupsio-artifact-download", "*mailinglist.GetGroupsioArtifactDownloadPayload", v)
 		}
 		if p.BearerToken != nil {
 			head := *p.BearerToken
@@ -2177,27 +5232,22 @@ func EncodeAddGroupsioMemberRequest(encoder func(*http.Request) goahttp.Encoder)
 				req.Header.Set("Authorization", "Bearer "+head)
 			} else {
 				req.Header.Set("Authorization", head)
-			}
-		}
-		body := NewAddGroupsioMemberRequestBody(p)
-		if err := encoder(req).Encode(&body); err != nil {
-			return goahttp.ErrEncodingError("mailing-list", "add-groupsio-member", err)
+			}
 		}
 		return nil
 	}
 }
 
-// DecodeAddGroupsioMemberResponse returns a decoder for responses returned by
-// the mailing-list add-groupsio-member endpoint. restoreBody controls whether
-// the response body should be restored after having been read.
-// DecodeAddGroupsioMemberResponse may return the following errors:
-//   - "BadRequest" (type *mailinglist.BadRequestError): http.StatusBadRequest
-//   - "Conflict" (type *mailinglist.ConflictError): http.StatusConflict
+// DecodeGetGroupsioArtifactDownloadResponse returns a decoder for responses
+// returned by the mailing-list get-groupsio-artifact-download endpoint.
+// restoreBody controls whether the response body should be restored after
+// having been read.
+// DecodeGetGroupsioArtifactDownloadResponse may return the following errors:
 //   - "InternalServerError" (type *mailinglist.InternalServerError): http.StatusInternalServerError
 //   - "NotFound" (type *mailinglist.NotFoundError): http.StatusNotFound
 //   - "ServiceUnavailable" (type *mailinglist.ServiceUnavailableError): http.StatusServiceUnavailable
 //   - error: internal error
-func DecodeAddGroupsioMemberResponse(decoder func(*http.Response) goahttp.Decoder, restoreBody bool) func(*http.Response) (any, error) {
+func DecodeGetGroupsioArtifactDownloadResponse(decoder func(*http.Response) goahttp.Decoder, restoreBody bool) func(*http.Response) (any, error) {
 	return func(resp *http.Response) (any, error) {
 		if restoreBody {
 			b, err := io.ReadAll(resp.Body)
@@ -2212,118 +5262,88 @@ func DecodeAddGroupsioMemberResponse(decoder func(*http.Response) goahttp.Decode
 			defer resp.Body.Close()
 		}
 		switch resp.StatusCode {
-		case http.StatusCreated:
+		case http.StatusOK:
 			var (
-				body AddGroupsioMemberResponseBody
+				body GetGroupsioArtifactDownloadResponseBody
 				err  error
 			)
 			err = decoder(resp).Decode(&body)
 			if err != nil {
-				return nil, goahttp.ErrDecodingError("mailing-list", "add-groupsio-member", err)
+				return nil, goahttp.ErrDecodingError("mailing-list", "get-groupsio-artifact-download", err)
 			}
-			err = ValidateAddGroupsioMemberResponseBody(&body)
+			err = ValidateGetGroupsioArtifactDownloadResponseBody(&body)
 			if err != nil {
-				return nil, goahttp.ErrValidationError("mailing-list", "add-groupsio-member", err)
+				return nil, goahttp.ErrValidationError("mailing-list", "get-groupsio-artifact-download", err)
 			}
-			res := NewAddGroupsioMemberGroupsioMemberCreated(&body)
+			res := NewGetGroupsioArtifactDownloadGroupsioArtifactDownloadOK(&body)
 			return res, nil
-		case http.StatusBadRequest:
-			var (
-				body AddGroupsioMemberBadRequestResponseBody
-				err  error
-			)
-			err = decoder(resp).Decode(&body)
-			if err != nil {
-				return nil, goahttp.ErrDecodingError("mailing-list", "add-groupsio-member", err)
-			}
-			err = ValidateAddGroupsioMemberBadRequestResponseBody(&body)
-			if err != nil {
-				return nil, goahttp.ErrValidationError("mailing-list", "add-groupsio-member", err)
-			}
-			return nil, NewAddGroupsioMemberBadRequest(&body)
-		case http.StatusConflict:
-			var (
-				body AddGroupsioMemberConflictResponseBody
-				err  error
-			)
-			err = decoder(resp).Decode(&body)
-			if err != nil {
-				return nil, goahttp.ErrDecodingError("mailing-list", "add-groupsio-member", err)
-			}
-			err = ValidateAddGroupsioMemberConflictResponseBody(&body)
-			if err != nil {
-				return nil, goahttp.ErrValidationError("mailing-list", "add-groupsio-member", err)
-			}
-			return nil, NewAddGroupsioMemberConflict(&body)
 		case http.StatusInternalServerError:
 			var (
-				body AddGroupsioMemberInternalServerErrorResponseBody
+				body GetGroupsioArtifactDownloadInternalServerErrorResponseBody
 				err  error
 			)
 			err = decoder(resp).Decode(&body)
 			if err != nil {
-				return nil, goahttp.ErrDecodingError("mailing-list", "add-groupsio-member", err)
+				return nil, goahttp.ErrDecodingError("mailing-list", "get-groupsio-artifact-download", err)
 			}
-			err = ValidateAddGroupsioMemberInternalServerErrorResponseBody(&body)
+			err = ValidateGetGroupsioArtifactDownloadInternalServerErrorResponseBody(&body)
 			if err != nil {
-				return nil, goahttp.ErrValidationError("mailing-list", "add-groupsio-member", err)
+				return nil, goahttp.ErrValidationError("mailing-list", "get-groupsio-artifact-download", err)
 			}
-			return nil, NewAddGroupsioMemberInternalServerError(&body)
+			return nil, NewGetGroupsioArtifactDownloadInternalServerError(&body)
 		case http.StatusNotFound:
 			var (
-				body AddGroupsioMemberNotFoundResponseBody
+				body GetGroupsioArtifactDownloadNotFoundResponseBody
 				err  error
 			)
 			err = decoder(resp).Decode(&body)
 			if err != nil {
-				return nil, goahttp.ErrDecodingError("mailing-list", "add-groupsio-member", err)
+				return nil, goahttp.ErrDecodingError("mailing-list", "get-groupsio-artifact-download", err)
 			}
-			err = ValidateAddGroupsioMemberNotFoundResponseBody(&body)
+			err = ValidateGetGroupsioArtifactDownloadNotFoundResponseBody(&body)
 			if err != nil {
-				return nil, goahttp.ErrValidationError("mailing-list", "add-groupsio-member", err)
+				return nil, goahttp.ErrValidationError("mailing-list", "get-groupsio-artifact-download", err)
 			}
-			return nil, NewAddGroupsioMemberNotFound(&body)
+			return nil, NewGetGroupsioArtifactDownloadNotFound(&body)
 		case http.StatusServiceUnavailable:
 			var (
-				body AddGroupsioMemberServiceUnavailableResponseBody
+				body GetGroupsioArtifactDownloadServiceUnavailableResponseBody
 				err  error
 			)
 			err = decoder(resp).Decode(&body)
 			if err != nil {
-				return nil, goahttp.ErrDecodingError("mailing-list", "add-groupsio-member", err)
+				return nil, goahttp.ErrDecodingError("mailing-list", "get-groupsio-artifact-download", err)
 			}
-			err = ValidateAddGroupsioMemberServiceUnavailableResponseBody(&body)
+			err = ValidateGetGroupsioArtifactDownloadServiceUnavailableResponseBody(&body)
 			if err != nil {
-				return nil, goahttp.ErrValidationError("mailing-list", "add-groupsio-member", err)
+				return nil, goahttp.ErrValidationError("mailing-list", "get-groupsio-artifact-download", err)
 			}
-			return nil, NewAddGroupsioMemberServiceUnavailable(&body)
+			return nil, NewGetGroupsioArtifactDownloadServiceUnavailable(&body)
 		default:
 			body, _ := io.ReadAll(resp.Body)
-			return nil, goahttp.ErrInvalidResponse("mailing-list", "add-groupsio-member", resp.StatusCode, string(body))
+			return nil, goahttp.ErrInvalidResponse("mailing-list", "get-groupsio-artifact-download", resp.StatusCode, string(body))
 		}
 	}
 }
 
-// BuildGetGroupsioMemberRequest instantiates a HTTP request object with method
-// and path set to call the "mailing-list" service "get-groupsio-member"
-// endpoint
-func (c *Client) BuildGetGroupsioMemberRequest(ctx context.Context, v any) (*http.Request, error) {
+// BuildGetGroupsioProjectSummaryRequest instantiates a HTTP request object
+// with method and path set to call the "mailing-list" service
+// "get-groupsio-project-summary" endpoint
+func (c *Client) BuildGetGroupsioProjectSummaryRequest(ctx context.Context, v any) (*http.Request, error) {
 	var (
-		subgroupID string
-		memberID   string
+		projectUID string
 	)
 	{
-		p, ok := v.(*mailinglist.GetGroupsioMemberPayload)
+		p, ok := v.(*mailinglist.GetGroupsioProjectSummaryPayload)
 		if !ok {
-			return nil, goahttp.ErrInvalidType("mailing-list", "get-groupsio-member", "*mailinglist.GetGroupsioMemberPayload", v)
+			return nil, goahttp.ErrInvalidType("mailing-list", "get-groupsio-project-summary", "*mailinglist.GetGroupsioProjectSummaryPayload", v)
 		}
-		subgroupID = p.SubgroupID
-		memberID = p.MemberID
+		projectUID = p.ProjectUID
 	}
-	u := &url.URL{Scheme: c.scheme, Host: c.host, Path: GetGroupsioMemberMailingListPath(subgroupID, memberID)}
+	u := &url.URL{Scheme: c.scheme, Host: c.host, Path: GetGroupsioProjectSummaryMailingListPath(projectUID)}
 	req, err := http.NewRequest("GET", u.String(), nil)
 	if err != nil {
-		return nil, goahttp.ErrInvalidURL("mailing-list", "get-groupsio-member", u.String(), err)
+		return nil, goahttp.ErrInvalidURL("mailing-list", "get-groupsio-project-summary", u.String(), err)
 	}
 	if ctx != nil {
 		req = req.WithContext(ctx)
@@ -2332,13 +5352,13 @@ func (c *Client) BuildGetGroupsioMemberRequest(ctx context.Context, v any) (*htt
 	return req, nil
 }
 
-// EncodeGetGroupsioMemberRequest returns an encoder for requests sent to the
-// mailing-list get-groupsio-member server.
-func EncodeGetGroupsioMemberRequest(encoder func(*http.Request) goahttp.Encoder) func(*http.Request, any) error {
+// EncodeGetGroupsioProjectSummaryRequest returns an encoder for requests sent
+// to the mailing-list get-groupsio-project-summary server.
+func EncodeGetGroupsioProjectSummaryRequest(encoder func(*http.Request) goahttp.Encoder) func(*http.Request, any) error {
 	return func(req *http.Request, v any) error {
-		p, ok := v.(*mailinglist.GetGroupsioMemberPayload)
+		p, ok := v.(*mailinglist.GetGroupsioProjectSummaryPayload)
 		if !ok {
-			return goahttp.ErrInvalidType("mailing-list", "get-groupsio-member", "*mailinglist.GetGroupsioMemberPayload", v)
+			return goahttp.ErrInvalidType("mailing-list", "get-groupsio-project-summary", "*mailinglist.GetGroupsioProjectSummaryPayload", v)
 		}
 		if p.BearerToken != nil {
 			head := *p.BearerToken
@@ -2352,15 +5372,16 @@ func EncodeGetGroupsioMemberRequest(encoder func(*http.Request) goahttp.Encoder)
 	}
 }
 
-// DecodeGetGroupsioMemberResponse returns a decoder for responses returned by
-// the mailing-list get-groupsio-member endpoint. restoreBody controls whether
-// the response body should be restored after having been read.
-// DecodeGetGroupsioMemberResponse may return the following errors:
+// DecodeGetGroupsioProjectSummaryResponse returns a decoder for responses
+// returned by the mailing-list get-groupsio-project-summary endpoint.
+// restoreBody controls whether the response body should be restored after
+// having been read.
+// DecodeGetGroupsioProjectSummaryResponse may return the following errors:
+//   - "BadRequest" (type *mailinglist.BadRequestError): http.StatusBadRequest
 //   - "InternalServerError" (type *mailinglist.InternalServerError): http.StatusInternalServerError
-//   - "NotFound" (type *mailinglist.NotFoundError): http.StatusNotFound
 //   - "ServiceUnavailable" (type *mailinglist.ServiceUnavailableError): http.StatusServiceUnavailable
 //   - error: internal error
-func DecodeGetGroupsioMemberResponse(decoder func(*http.Response) goahttp.Decoder, restoreBody bool) func(*http.Response) (any, error) {
+func DecodeGetGroupsioProjectSummaryResponse(decoder func(*http.Response) goahttp.Decoder, restoreBody bool) func(*http.Response) (any, error) {
 	return func(resp *http.Response) (any, error) {
 		if restoreBody {
 			b, err := io.ReadAll(resp.Body)
@@ -2377,88 +5398,86 @@ func DecodeGetGroupsioMemberResponse(decoder func(*http.Response) goahttp.Decode
 		switch resp.StatusCode {
 		case http.StatusOK:
 			var (
-				body GetGroupsioMemberResponseBody
+				body GetGroupsioProjectSummaryResponseBody
 				err  error
 			)
 			err = decoder(resp).Decode(&body)
 			if err != nil {
-				return nil, goahttp.ErrDecodingError("mailing-list", "get-groupsio-member", err)
+				return nil, goahttp.ErrDecodingError("mailing-list", "get-groupsio-project-summary", err)
 			}
-			err = ValidateGetGroupsioMemberResponseBody(&body)
+			err = ValidateGetGroupsioProjectSummaryResponseBody(&body)
 			if err != nil {
-				return nil, goahttp.ErrValidationError("mailing-list", "get-groupsio-member", err)
+				return nil, goahttp.ErrValidationError("mailing-list", "get-groupsio-project-summary", err)
 			}
-			res := NewGetGroupsioMemberGroupsioMemberOK(&body)
+			res := NewGetGroupsioProjectSummaryGroupsioProjectSummaryOK(&body)
 			return res, nil
-		case http.StatusInternalServerError:
+		case http.StatusBadRequest:
 			var (
-				body GetGroupsioMemberInternalServerErrorResponseBody
+				body GetGroupsioProjectSummaryBadRequestResponseBody
 				err  error
 			)
 			err = decoder(resp).Decode(&body)
 			if err != nil {
-				return nil, goahttp.ErrDecodingError("mailing-list", "get-groupsio-member", err)
+				return nil, goahttp.ErrDecodingError("mailing-list", "get-groupsio-project-summary", err)
 			}
-			err = ValidateGetGroupsioMemberInternalServerErrorResponseBody(&body)
+			err = ValidateGetGroupsioProjectSummaryBadRequestResponseBody(&body)
 			if err != nil {
-				return nil, goahttp.ErrValidationError("mailing-list", "get-groupsio-member", err)
+				return nil, goahttp.ErrValidationError("mailing-list", "get-groupsio-project-summary", err)
 			}
-			return nil, NewGetGroupsioMemberInternalServerError(&body)
-		case http.StatusNotFound:
+			return nil, NewGetGroupsioProjectSummaryBadRequest(&body)
+		case http.StatusInternalServerError:
 			var (
-				body GetGroupsioMemberNotFoundResponseBody
+				body GetGroupsioProjectSummaryInternalServerErrorResponseBody
 				err  error
 			)
 			err = decoder(resp).Decode(&body)
 			if err != nil {
-				return nil, goahttp.ErrDecodingError("mailing-list", "get-groupsio-member", err)
+				return nil, goahttp.ErrDecodingError("mailing-list", "get-groupsio-project-summary", err)
 			}
-			err = ValidateGetGroupsioMemberNotFoundResponseBody(&body)
+			err = ValidateGetGroupsioProjectSummaryInternalServerErrorResponseBody(&body)
 			if err != nil {
-				return nil, goahttp.ErrValidationError("mailing-list", "get-groupsio-member", err)
+				return nil, goahttp.ErrValidationError("mailing-list", "get-groupsio-project-summary", err)
 			}
-			return nil, NewGetGroupsioMemberNotFound(&body)
+			return nil, NewGetGroupsioProjectSummaryInternalServerError(&body)
 		case http.StatusServiceUnavailable:
 			var (
-				body GetGroupsioMemberServiceUnavailableResponseBody
+				body GetGroupsioProjectSummaryServiceUnavailableResponseBody
 				err  error
 			)
 			err = decoder(resp).Decode(&body)
 			if err != nil {
-				return nil, goahttp.ErrDecodingError("mailing-list", "get-groupsio-member", err)
+				return nil, goahttp.ErrDecodingError("mailing-list", "get-groupsio-project-summary", err)
 			}
-			err = ValidateGetGroupsioMemberServiceUnavailableResponseBody(&body)
+			err = ValidateGetGroupsioProjectSummaryServiceUnavailableResponseBody(&body)
 			if err != nil {
-				return nil, goahttp.ErrValidationError("mailing-list", "get-groupsio-member", err)
+				return nil, goahttp.ErrValidationError("mailing-list", "get-groupsio-project-summary", err)
 			}
-			return nil, NewGetGroupsioMemberServiceUnavailable(&body)
+			return nil, NewGetGroupsioProjectSummaryServiceUnavailable(&body)
 		default:
 			body, _ := io.ReadAll(resp.Body)
-			return nil, goahttp.ErrInvalidResponse("mailing-list", "get-groupsio-member", resp.StatusCode, string(body))
+			return nil, goahttp.ErrInvalidResponse("mailing-list", "get-groupsio-project-summary", resp.StatusCode, string(body))
 		}
 	}
 }
 
-// BuildUpdateGroupsioMemberRequest instantiates a HTTP request object with
-// method and path set to call the "mailing-list" service
-// "update-groupsio-member" endpoint
-func (c *Client) BuildUpdateGroupsioMemberRequest(ctx context.Context, v any) (*http.Request, error) {
+// BuildListGroupsioOrphanedMailingListsRequest instantiates a HTTP request
+// object with method and path set to call the "mailing-list" service
+// "list-groupsio-orphaned-mailing-lists" endpoint
+func (c *Client) BuildListGroupsioOrphanedMailingListsRequest(ctx context.Context, v any) (*http.Request, error) {
 	var (
-		subgroupID string
-		memberID   string
+		projectUID string
 	)
 	{
-		p, ok := v.(*mailinglist.UpdateGroupsioMemberPayload)
+		p, ok := v.(*mailinglist.ListGroupsioOrphanedMailingListsPayload)
 		if !ok {
-			return nil, goahttp.ErrInvalidType("mailing-list", "update-groupsio-member", "*mailinglist.UpdateGroupsioMemberPayload", v)
+			return nil, goahttp.ErrInvalidType("mailing-list", "list-groupsio-orphaned-mailing-lists", "*mailinglist.ListGroupsioOrphanedMailingListsPayload", v)
 		}
-		subgroupID = p.SubgroupID
-		memberID = p.MemberID
+		projectUID = p.ProjectUID
 	}
-	u := &url.URL{Scheme: c.scheme, Host: c.host, Path: UpdateGroupsioMemberMailingListPath(subgroupID, memberID)}
-	req, err := http.NewRequest("PUT", u.String(), nil)
+	u := &url.URL{Scheme: c.scheme, Host: c.host, Path: ListGroupsioOrphanedMailingListsMailingListPath(projectUID)}
+	req, err := http.NewRequest("GET", u.String(), nil)
 	if err != nil {
-		return nil, goahttp.ErrInvalidURL("mailing-list", "update-groupsio-member", u.String(), err)
+		return nil, goahttp.ErrInvalidURL("mailing-list", "list-groupsio-orphaned-mailing-lists", u.String(), err)
 	}
 	if ctx != nil {
 		req = req.WithContext(ctx)
@@ -2467,13 +5486,14 @@ func (c *Client) BuildUpdateGroupsioMemberRequest(ctx context.Context, v any) (*
 	return req, nil
 }
 
-// EncodeUpdateGroupsioMemberRequest returns an encoder for requests sent to
-// the mailing-list update-groupsio-member server.
-func EncodeUpdateGroupsioMemberRequest(encoder func(*http.Request) goahttp.Encoder) func(*http.Request, any) error {
+// EncodeListGroupsioOrphanedMailingListsRequest returns an encoder for
+// requests sent to the mailing-list list-groupsio-orphaned-mailing-lists
+// server.
+func EncodeListGroupsioOrphanedMailingListsRequest(encoder func(*http.Request) goahttp.Encoder) func(*http.Request, any) error {
 	return func(req *http.Request, v any) error {
-		p, ok := v.(*mailinglist.UpdateGroupsioMemberPayload)
+		p, ok := v.(*mailinglist.ListGroupsioOrphanedMailingListsPayload)
 		if !ok {
-			return goahttp.ErrInvalidType("mailing-list", "update-groupsio-member", "*mailinglist.UpdateGroupsioMemberPayload", v)
+			return goahttp.ErrInvalidType("mailing-list", "list-groupsio-orphaned-mailing-lists", "*mailinglist.ListGroupsioOrphanedMailingListsPayload", v)
 		}
 		if p.BearerToken != nil {
 			head := *p.BearerToken
@@ -2483,24 +5503,21 @@ func EncodeUpdateGroupsioMemberRequest(encoder func(*http.Request) goahttp.Encod
 				req.Header.Set("Authorization", head)
 			}
 		}
-		body := NewUpdateGroupsioMemberRequestBody(p)
-		if err := encoder(req).Encode(&body); err != nil {
-			return goahttp.ErrEncodingError("mailing-list", "update-groupsio-member", err)
-		}
 		return nil
 	}
 }
 
-// DecodeUpdateGroupsioMemberResponse returns a decoder for responses returned
-// by the mailing-list update-groupsio-member endpoint. restoreBody controls
-// whether the response body should be restored after having been read.
-// DecodeUpdateGroupsioMemberResponse may return the following errors:
+// DecodeListGroupsioOrphanedMailingListsResponse returns a decoder for
+// responses returned by the mailing-list list-groupsio-orphaned-mailing-lists
+// endpoint. restoreBody controls whether the response body should be restored
+// after having been read.
+// DecodeListGroupsioOrphanedMailingListsResponse may return the following
+// errors:
 //   - "BadRequest" (type *mailinglist.BadRequestError): http.StatusBadRequest
 //   - "InternalServerError" (type *mailinglist.InternalServerError): http.StatusInternalServerError
-//   - "NotFound" (type *mailinglist.NotFoundError): http.StatusNotFound
 //   - "ServiceUnavailable" (type *mailinglist.ServiceUnavailableError): http.StatusServiceUnavailable
 //   - error: internal error
-func DecodeUpdateGroupsioMemberResponse(decoder func(*http.Response) goahttp.Decoder, restoreBody bool) func(*http.Response) (any, error) {
+func DecodeListGroupsioOrphanedMailingListsResponse(decoder func(*http.Response) goahttp.Decoder, restoreBody bool) func(*http.Response) (any, error) {
 	return func(resp *http.Response) (any, error) {
 		if restoreBody {
 			b, err := io.ReadAll(resp.Body)
@@ -2517,102 +5534,86 @@ func DecodeUpdateGroupsioMemberResponse(decoder func(*http.Response) goahttp.Dec
 		switch resp.StatusCode {
 		case http.StatusOK:
 			var (
-				body UpdateGroupsioMemberResponseBody
+				body ListGroupsioOrphanedMailingListsResponseBody
 				err  error
 			)
 			err = decoder(resp).Decode(&body)
 			if err != nil {
-				return nil, goahttp.ErrDecodingError("mailing-list", "update-groupsio-member", err)
+				return nil, goahttp.ErrDecodingError("mailing-list", "list-groupsio-orphaned-mailing-lists", err)
 			}
-			err = ValidateUpdateGroupsioMemberResponseBody(&body)
+			err = ValidateListGroupsioOrphanedMailingListsResponseBody(&body)
 			if err != nil {
-				return nil, goahttp.ErrValidationError("mailing-list", "update-groupsio-member", err)
+				return nil, goahttp.ErrValidationError("mailing-list", "list-groupsio-orphaned-mailing-lists", err)
 			}
-			res := NewUpdateGroupsioMemberGroupsioMemberOK(&body)
+			res := NewListGroupsioOrphanedMailingListsGroupsioSubgroupListOK(&body)
 			return res, nil
 		case http.StatusBadRequest:
 			var (
-				body UpdateGroupsioMemberBadRequestResponseBody
+				body ListGroupsioOrphanedMailingListsBadRequestResponseBody
 				err  error
 			)
 			err = decoder(resp).Decode(&body)
 			if err != nil {
-				return nil, goahttp.ErrDecodingError("mailing-list", "update-groupsio-member", err)
+				return nil, goahttp.ErrDecodingError("mailing-list", "list-groupsio-orphaned-mailing-lists", err)
 			}
-			err = ValidateUpdateGroupsioMemberBadRequestResponseBody(&body)
+			err = ValidateListGroupsioOrphanedMailingListsBadRequestResponseBody(&body)
 			if err != nil {
-				return nil, goahttp.ErrValidationError("mailing-list", "update-groupsio-member", err)
+				return nil, goahttp.ErrValidationError("mailing-list", "list-groupsio-orphaned-mailing-lists", err)
 			}
-			return nil, NewUpdateGroupsioMemberBadRequest(&body)
+			return nil, NewListGroupsioOrphanedMailingListsBadRequest(&body)
 		case http.StatusInternalServerError:
 			var (
-				body UpdateGroupsioMemberInternalServerErrorResponseBody
-				err  error
-			)
-			err = decoder(resp).Decode(&body)
-			if err != nil {
-				return nil, goahttp.ErrDecodingError("mailing-list", "update-groupsio-member", err)
-			}
-			err = ValidateUpdateGroupsioMemberInternalServerErrorResponseBody(&body)
-			if err != nil {
-				return nil, goahttp.ErrValidationError("mailing-list", "update-groupsio-member", err)
-			}
-			return nil, NewUpdateGroupsioMemberInternalServerError(&body)
-		case http.StatusNotFound:
-			var (
-				body UpdateGroupsioMemberNotFoundResponseBody
+				body ListGroupsioOrphanedMailingListsInternalServerErrorResponseBody
 				err  error
 			)
 			err = decoder(resp).Decode(&body)
 			if err != nil {
-				return nil, goahttp.ErrDecodingError("mailing-list", "update-groupsio-member", err)
+				return nil, goahttp.ErrDecodingError("mailing-list", "list-groupsio-orphaned-mailing-lists", err)
 			}
-			err = ValidateUpdateGroupsioMemberNotFoundResponseBody(&body)
+			err = ValidateListGroupsioOrphanedMailingListsInternalServerErrorResponseBody(&body)
 			if err != nil {
-				return nil, goahttp.ErrValidationError("mailing-list", "update-groupsio-member", err)
+				return nil, goahttp.ErrValidationError("mailing-list", "list-groupsio-orphaned-mailing-lists", err)
 			}
-			return nil, NewUpdateGroupsioMemberNotFound(&body)
+			return nil, NewListGroupsioOrphanedMailingListsInternalServerError(&body)
 		case http.StatusServiceUnavailable:
 			var (
-				body UpdateGroupsioMemberServiceUnavailableResponseBody
+				body ListGroupsioOrphanedMailingListsServiceUnavailableResponseBody
 				err  error
 			)
 			err = decoder(resp).Decode(&body)
 			if err != nil {
-				return nil, goahttp.ErrDecodingError("mailing-list", "update-groupsio-member", err)
+				return nil, goahttp.ErrDecodingError("mailing-list", "list-groupsio-orphaned-mailing-lists", err)
 			}
-			err = ValidateUpdateGroupsioMemberServiceUnavailableResponseBody(&body)
+			err = ValidateListGroupsioOrphanedMailingListsServiceUnavailableResponseBody(&body)
 			if err != nil {
-				return nil, goahttp.ErrValidationError("mailing-list", "update-groupsio-member", err)
+				return nil, goahttp.ErrValidationError("mailing-list", "list-groupsio-orphaned-mailing-lists", err)
 			}
-			return nil, NewUpdateGroupsioMemberServiceUnavailable(&body)
+			return nil, NewListGroupsioOrphanedMailingListsServiceUnavailable(&body)
 		default:
 			body, _ := io.ReadAll(resp.Body)
-			return nil, goahttp.ErrInvalidResponse("mailing-list", "update-groupsio-member", resp.StatusCode, string(body))
+			return nil, goahttp.ErrInvalidResponse("mailing-list", "list-groupsio-orphaned-mailing-lists", resp.StatusCode, string(body))
 		}
 	}
 }
 
-// BuildDeleteGroupsioMemberRequest instantiates a HTTP request object with
-// method and path set to call the "mailing-list" service
-// "delete-groupsio-member" endpoint
-func (c *Client) BuildDeleteGroupsioMemberRequest(ctx context.Context, v any) (*http.Request, error) {
+// BuildRepublishGroupsioProjectIndexRequest instantiates a HTTP request object
+// with method and path set to call the "mailing-list" service
+// "republish-groupsio-project-index" endpoint
+func (c *Client) BuildRepublishGroupsioProjectIndexRequest(ctx context.Context, v any) (*http.Request, error) {
 	var (
-		subgroupID string
-		memberID   string
+		projectUID string
 	)
 	{
-		p, ok := v.(*mailinglist.DeleteGroupsioMemberPayload)
+		p, ok := v.(*mailinglist.RepublishGroupsioProjectIndexPayload)
 		if !ok {
-			return nil, goahttp.ErrInvalidType("mailing-list", "delete-groupsio-member", "*mailinglist.DeleteGroupsioMemberPayload", v)
+			return nil, goahttp.ErrInvalidType("mailing-list", "republish-groupsio-project-index", "*mailinglist.RepublishGroupsioProjectIndexPayload", v)
 		}
-		subgroupID = p.SubgroupID
-		memberID = p.MemberID
+		projectUID = p.ProjectUID
 	}
-	u := &url.URL{Scheme: c.scheme, Host: c.host, Path: DeleteGroupsioMemberMailingListPath(subgroupID, memberID)}
-	req, err := http.NewRequest("DELETE", u.String(), nil)
+	u := &url.URL{Scheme: c.scheme, Host: c.host, Path: RepublishGroupsioProjectIndexMailingListPath(projectUID)}
+	req, err := http.NewRequest("POST", u.String(), nil)
 	if err != nil {
-		return nil, goahttp.ErrInvalidURL("mailing-list", "delete-groupsio-member", u.String(), err)
+		return nil, goahttp.ErrInvalidURL("mailing-list", "republish-groupsio-project-index", u.String(), err)
 	}
 	if ctx != nil {
 		req = req.WithContext(ctx)
@@ -2621,13 +5622,13 @@ func (c *Client) BuildDeleteGroupsioMemberRequest(ctx context.Context, v any) (*
 	return req, nil
 }
 
-// EncodeDeleteGroupsioMemberRequest returns an encoder for requests sent to
-// the mailing-list delete-groupsio-member server.
-func EncodeDeleteGroupsioMemberRequest(encoder func(*http.Request) goahttp.Encoder) func(*http.Request, any) error {
+// EncodeRepublishGroupsioProjectIndexRequest returns an encoder for requests
+// sent to the mailing-list republish-groupsio-project-index server.
+func EncodeRepublishGroupsioProjectIndexRequest(encoder func(*http.Request) goahttp.Encoder) func(*http.Request, any) error {
 	return func(req *http.Request, v any) error {
-		p, ok := v.(*mailinglist.DeleteGroupsioMemberPayload)
+		p, ok := v.(*mailinglist.RepublishGroupsioProjectIndexPayload)
 		if !ok {
-			return goahttp.ErrInvalidType("mailing-list", "delete-groupsio-member", "*mailinglist.DeleteGroupsioMemberPayload", v)
+			return goahttp.ErrInvalidType("mailing-list", "republish-groupsio-project-index", "*mailinglist.RepublishGroupsioProjectIndexPayload", v)
 		}
 		if p.BearerToken != nil {
 			head := *p.BearerToken
@@ -2641,15 +5642,16 @@ func EncodeDeleteGroupsioMemberRequest(encoder func(*http.Request) goahttp.Encod
 	}
 }
 
-// DecodeDeleteGroupsioMemberResponse returns a decoder for responses returned
-// by the mailing-list delete-groupsio-member endpoint. restoreBody controls
-// whether the response body should be restored after having been read.
-// DecodeDeleteGroupsioMemberResponse may return the following errors:
+// DecodeRepublishGroupsioProjectIndexResponse returns a decoder for responses
+// returned by the mailing-list republish-groupsio-project-index endpoint.
+// restoreBody controls whether the response body should be restored after
+// having been read.
+// DecodeRepublishGroupsioProjectIndexResponse may return the following errors:
+//   - "BadRequest" (type *mailinglist.BadRequestError): http.StatusBadRequest
 //   - "InternalServerError" (type *mailinglist.InternalServerError): http.StatusInternalServerError
-//   - "NotFound" (type *mailinglist.NotFoundError): http.StatusNotFound
 //   - "ServiceUnavailable" (type *mailinglist.ServiceUnavailableError): http.StatusServiceUnavailable
 //   - error: internal error
-func DecodeDeleteGroupsioMemberResponse(decoder func(*http.Response) goahttp.Decoder, restoreBody bool) func(*http.Response) (any, error) {
+func DecodeRepublishGroupsioProjectIndexResponse(decoder func(*http.Response) goahttp.Decoder, restoreBody bool) func(*http.Response) (any, error) {
 	return func(resp *http.Response) (any, error) {
 		if restoreBody {
 			b, err := io.ReadAll(resp.Body)
@@ -2664,75 +5666,88 @@ func DecodeDeleteGroupsioMemberResponse(decoder func(*http.Response) goahttp.Dec
 			defer resp.Body.Close()
 		}
 		switch resp.StatusCode {
-		case http.StatusNoContent:
-			return nil, nil
-		case http.StatusInternalServerError:
+		case http.StatusOK:
 			var (
-				body DeleteGroupsioMemberInternalServerErrorResponseBody
+				body RepublishGroupsioProjectIndexResponseBody
 				err  error
 			)
 			err = decoder(resp).Decode(&body)
 			if err != nil {
-				return nil, goahttp.ErrDecodingError("mailing-list", "delete-groupsio-member", err)
+				return nil, goahttp.ErrDecodingError("mailing-list", "republish-groupsio-project-index", err)
 			}
-			err = ValidateDeleteGroupsioMemberInternalServerErrorResponseBody(&body)
+			err = ValidateRepublishGroupsioProjectIndexResponseBody(&body)
 			if err != nil {
-				return nil, goahttp.ErrValidationError("mailing-list", "delete-groupsio-member", err)
+				return nil, goahttp.ErrValidationError("mailing-list", "republish-groupsio-project-index", err)
 			}
-			return nil, NewDeleteGroupsioMemberInternalServerError(&body)
-		case http.StatusNotFound:
+			res := NewRepublishGroupsioProjectIndexGroupsioRepublishSummaryOK(&body)
+			return res, nil
+		case http.StatusBadRequest:
 			var (
-				body DeleteGroupsioMemberNotFoundResponseBody
+				body RepublishGroupsioProjectIndexBadRequestResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("mailing-list", "republish-groupsio-project-index", err)
+			}
+			err = ValidateRepublishGroupsioProjectIndexBadRequestResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("mailing-list", "republish-groupsio-project-index", err)
+			}
+			return nil, NewRepublishGroupsioProjectIndexBadRequest(&body)
+		case http.StatusInternalServerError:
+			var (
+				body RepublishGroupsioProjectIndexInternalServerErrorResponseBody
 				err  error
 			)
 			err = decoder(resp).Decode(&body)
 			if err != nil {
-				return nil, goahttp.ErrDecodingError("mailing-list", "delete-groupsio-member", err)
+				return nil, goahttp.ErrDecodingError("mailing-list", "republish-groupsio-project-index", err)
 			}
-			err = ValidateDeleteGroupsioMemberNotFoundResponseBody(&body)
+			err = ValidateRepublishGroupsioProjectIndexInternalServerErrorResponseBody(&body)
 			if err != nil {
-				return nil, goahttp.ErrValidationError("mailing-list", "delete-groupsio-member", err)
+				return nil, goahttp.ErrValidationError("mailing-list", "republish-groupsio-project-index", err)
 			}
-			return nil, NewDeleteGroupsioMemberNotFound(&body)
+			return nil, NewRepublishGroupsioProjectIndexInternalServerError(&body)
 		case http.StatusServiceUnavailable:
 			var (
-				body DeleteGroupsioMemberServiceUnavailableResponseBody
+				body RepublishGroupsioProjectIndexServiceUnavailableResponseBody
 				err  error
 			)
 			err = decoder(resp).Decode(&body)
 			if err != nil {
-				return nil, goahttp.ErrDecodingError("mailing-list", "delete-groupsio-member", err)
+				return nil, goahttp.ErrDecodingError("mailing-list", "republish-groupsio-project-index", err)
 			}
-			err = ValidateDeleteGroupsioMemberServiceUnavailableResponseBody(&body)
+			err = ValidateRepublishGroupsioProjectIndexServiceUnavailableResponseBody(&body)
 			if err != nil {
-				return nil, goahttp.ErrValidationError("mailing-list", "delete-groupsio-member", err)
+				return nil, goahttp.ErrValidationError("mailing-list", "republish-groupsio-project-index", err)
 			}
-			return nil, NewDeleteGroupsioMemberServiceUnavailable(&body)
+			return nil, NewRepublishGroupsioProjectIndexServiceUnavailable(&body)
 		default:
 			body, _ := io.ReadAll(resp.Body)
-			return nil, goahttp.ErrInvalidResponse("mailing-list", "delete-groupsio-member", resp.StatusCode, string(body))
+			return nil, goahttp.ErrInvalidResponse("mailing-list", "republish-groupsio-project-index", resp.StatusCode, string(body))
 		}
 	}
 }
 
-// BuildInviteGroupsioMembersRequest instantiates a HTTP request object with
-// method and path set to call the "mailing-list" service
-// "invite-groupsio-members" endpoint
-func (c *Client) BuildInviteGroupsioMembersRequest(ctx context.Context, v any) (*http.Request, error) {
+// BuildGetGroupsioMailingListStatsRequest instantiates a HTTP request object
+// with method and path set to call the "mailing-list" service
+// "get-groupsio-mailing-list-stats" endpoint
+func (c *Client) BuildGetGroupsioMailingListStatsRequest(ctx context.Context, v any) (*http.Request, error) {
 	var (
 		subgroupID string
 	)
 	{
-		p, ok := v.(*mailinglist.InviteGroupsioMembersPayload)
+		p, ok := v.(*mailinglist.GetGroupsioMailingListStatsPayload)
 		if !ok {
-			return nil, goahttp.ErrInvalidType("mailing-list", "invite-groupsio-members", "*mailinglist.InviteGroupsioMembersPayload", v)
+			return nil, goahttp.ErrInvalidType("mailing-list", "get-groupsio-mailing-list-stats", "*mailinglist.GetGroupsioMailingListStatsPayload", v)
 		}
 		subgroupID = p.SubgroupID
 	}
-	u := &url.URL{Scheme: c.scheme, Host: c.host, Path: InviteGroupsioMembersMailingListPath(subgroupID)}
-	req, err := http.NewRequest("POST", u.String(), nil)
+	u := &url.URL{Scheme: c.scheme, Host: c.host, Path: GetGroupsioMailingListStatsMailingListPath(subgroupID)}
+	req, err := http.NewRequest("GET", u.String(), nil)
 	if err != nil {
-		return nil, goahttp.ErrInvalidURL("mailing-list", "invite-groupsio-members", u.String(), err)
+		return nil, goahttp.ErrInvalidURL("mailing-list", "get-groupsio-mailing-list-stats", u.String(), err)
 	}
 	if ctx != nil {
 		req = req.WithContext(ctx)
@@ -2741,13 +5756,13 @@ func (c *Client) BuildInviteGroupsioMembersRequest(ctx context.Context, v any) (
 	return req, nil
 }
 
-// EncodeInviteGroupsioMembersRequest returns an encoder for requests sent to
-// the mailing-list invite-groupsio-members server.
-func EncodeInviteGroupsioMembersRequest(encoder func(*http.Request) goahttp.Encoder) func(*http.Request, any) error {
+// EncodeGetGroupsioMailingListStatsRequest returns an encoder for requests
+// sent to the mailing-list get-groupsio-mailing-list-stats server.
+func EncodeGetGroupsioMailingListStatsRequest(encoder func(*http.Request) goahttp.Encoder) func(*http.Request, any) error {
 	return func(req *http.Request, v any) error {
-		p, ok := v.(*mailinglist.InviteGroupsioMembersPayload)
+		p, ok := v.(*mailinglist.GetGroupsioMailingListStatsPayload)
 		if !ok {
-			return goahttp.ErrInvalidType("mailing-list", "invite-groupsio-members", "*mailinglist.InviteGroupsioMembersPayload", v)
+			return goahttp.ErrInvalidType("mailing-list", "get-groupsio-mailing-list-stats", "*mailinglist.GetGroupsioMailingListStatsPayload", v)
 		}
 		if p.BearerToken != nil {
 			head := *p.BearerToken
@@ -2757,24 +5772,20 @@ func EncodeInviteGroupsioMembersRequest(encoder func(*http.Request) goahttp.Enco
 				req.Header.Set("Authorization", head)
 			}
 		}
-		body := NewInviteGroupsioMembersRequestBody(p)
-		if err := encoder(req).Encode(&body); err != nil {
-			return goahttp.ErrEncodingError("mailing-list", "invite-groupsio-members", err)
-		}
 		return nil
 	}
 }
 
-// DecodeInviteGroupsioMembersResponse returns a decoder for responses returned
-// by the mailing-list invite-groupsio-members endpoint. restoreBody controls
-// whether the response body should be restored after having been read.
-// DecodeInviteGroupsioMembersResponse may return the following errors:
-//   - "BadRequest" (type *mailinglist.BadRequestError): http.StatusBadRequest
+// DecodeGetGroupsioMailingListStatsResponse returns a decoder for responses
+// returned by the mailing-list get-groupsio-mailing-list-stats endpoint.
+// restoreBody controls whether the response body should be restored after
+// having been read.
+// DecodeGetGroupsioMailingListStatsResponse may return the following errors:
 //   - "InternalServerError" (type *mailinglist.InternalServerError): http.StatusInternalServerError
 //   - "NotFound" (type *mailinglist.NotFoundError): http.StatusNotFound
 //   - "ServiceUnavailable" (type *mailinglist.ServiceUnavailableError): http.StatusServiceUnavailable
 //   - error: internal error
-func DecodeInviteGroupsioMembersResponse(decoder func(*http.Response) goahttp.Decoder, restoreBody bool) func(*http.Response) (any, error) {
+func DecodeGetGroupsioMailingListStatsResponse(decoder func(*http.Response) goahttp.Decoder, restoreBody bool) func(*http.Response) (any, error) {
 	return func(resp *http.Response) (any, error) {
 		if restoreBody {
 			b, err := io.ReadAll(resp.Body)
@@ -2789,79 +5800,88 @@ func DecodeInviteGroupsioMembersResponse(decoder func(*http.Response) goahttp.De
 			defer resp.Body.Close()
 		}
 		switch resp.StatusCode {
-		case http.StatusNoContent:
-			return nil, nil
-		case http.StatusBadRequest:
+		case http.StatusOK:
 			var (
-				body InviteGroupsioMembersBadRequestResponseBody
+				body GetGroupsioMailingListStatsResponseBody
 				err  error
 			)
 			err = decoder(resp).Decode(&body)
 			if err != nil {
-				return nil, goahttp.ErrDecodingError("mailing-list", "invite-groupsio-members", err)
+				return nil, goahttp.ErrDecodingError("mailing-list", "get-groupsio-mailing-list-stats", err)
 			}
-			err = ValidateInviteGroupsioMembersBadRequestResponseBody(&body)
+			err = ValidateGetGroupsioMailingListStatsResponseBody(&body)
 			if err != nil {
-				return nil, goahttp.ErrValidationError("mailing-list", "invite-groupsio-members", err)
+				return nil, goahttp.ErrValidationError("mailing-list", "get-groupsio-mailing-list-stats", err)
 			}
-			return nil, NewInviteGroupsioMembersBadRequest(&body)
+			res := NewGetGroupsioMailingListStatsGroupsioSubgroupStatsOK(&body)
+			return res, nil
 		case http.StatusInternalServerError:
 			var (
-				body InviteGroupsioMembersInternalServerErrorResponseBody
+				body GetGroupsioMailingListStatsInternalServerErrorResponseBody
 				err  error
 			)
 			err = decoder(resp).Decode(&body)
 			if err != nil {
-				return nil, goahttp.ErrDecodingError("mailing-list", "invite-groupsio-members", err)
+				return nil, goahttp.ErrDecodingError("mailing-list", "get-groupsio-mailing-list-stats", err)
 			}
-			err = ValidateInviteGroupsioMembersInternalServerErrorResponseBody(&body)
+			err = ValidateGetGroupsioMailingListStatsInternalServerErrorResponseBody(&body)
 			if err != nil {
-				return nil, goahttp.ErrValidationError("mailing-list", "invite-groupsio-members", err)
+				return nil, goahttp.ErrValidationError("mailing-list", "get-groupsio-mailing-list-stats", err)
 			}
-			return nil, NewInviteGroupsioMembersInternalServerError(&body)
+			return nil, NewGetGroupsioMailingListStatsInternalServerError(&body)
 		case http.StatusNotFound:
 			var (
-				body InviteGroupsioMembersNotFoundResponseBody
+				body GetGroupsioMailingListStatsNotFoundResponseBody
 				err  error
 			)
 			err = decoder(resp).Decode(&body)
 			if err != nil {
-				return nil, goahttp.ErrDecodingError("mailing-list", "invite-groupsio-members", err)
+				return nil, goahttp.ErrDecodingError("mailing-list", "get-groupsio-mailing-list-stats", err)
 			}
-			err = ValidateInviteGroupsioMembersNotFoundResponseBody(&body)
+			err = ValidateGetGroupsioMailingListStatsNotFoundResponseBody(&body)
 			if err != nil {
-				return nil, goahttp.ErrValidationError("mailing-list", "invite-groupsio-members", err)
+				return nil, goahttp.ErrValidationError("mailing-list", "get-groupsio-mailing-list-stats", err)
 			}
-			return nil, NewInviteGroupsioMembersNotFound(&body)
+			return nil, NewGetGroupsioMailingListStatsNotFound(&body)
 		case http.StatusServiceUnavailable:
 			var (
-				body InviteGroupsioMembersServiceUnavailableResponseBody
+				body GetGroupsioMailingListStatsServiceUnavailableResponseBody
 				err  error
 			)
 			err = decoder(resp).Decode(&body)
 			if err != nil {
-				return nil, goahttp.ErrDecodingError("mailing-list", "invite-groupsio-members", err)
+				return nil, goahttp.ErrDecodingError("mailing-list", "get-groupsio-mailing-list-stats", err)
 			}
-			err = ValidateInviteGroupsioMembersServiceUnavailableResponseBody(&body)
+			err = ValidateGetGroupsioMailingListStatsServiceUnavailableResponseBody(&body)
 			if err != nil {
-				return nil, goahttp.ErrValidationError("mailing-list", "invite-groupsio-members", err)
+				return nil, goahttp.ErrValidationError("mailing-list", "get-groupsio-mailing-list-stats", err)
 			}
-			return nil, NewInviteGroupsioMembersServiceUnavailable(&body)
+			return nil, NewGetGroupsioMailingListStatsServiceUnavailable(&body)
 		default:
 			body, _ := io.ReadAll(resp.Body)
-			return nil, goahttp.ErrInvalidResponse("mailing-list", "invite-groupsio-members", resp.StatusCode, string(body))
+			return nil, goahttp.ErrInvalidResponse("mailing-list", "get-groupsio-mailing-list-stats", resp.StatusCode, string(body))
 		}
 	}
 }
 
-// BuildCheckGroupsioSubscriberRequest instantiates a HTTP request object with
+// BuildGetGroupsioServiceTreeRequest instantiates a HTTP request object with
 // method and path set to call the "mailing-list" service
-// "check-groupsio-subscriber" endpoint
-func (c *Client) BuildCheckGroupsioSubscriberRequest(ctx context.Context, v any) (*http.Request, error) {
-	u := &url.URL{Scheme: c.scheme, Host: c.host, Path: CheckGroupsioSubscriberMailingListPath()}
-	req, err := http.NewRequest("POST", u.String(), nil)
+// "get-groupsio-service-tree" endpoint
+func (c *Client) BuildGetGroupsioServiceTreeRequest(ctx context.Context, v any) (*http.Request, error) {
+	var (
+		serviceID string
+	)
+	{
+		p, ok := v.(*mailinglist.GetGroupsioServiceTreePayload)
+		if !ok {
+			return nil, goahttp.ErrInvalidType("mailing-list", "get-groupsio-service-tree", "*mailinglist.GetGroupsioServiceTreePayload", v)
+		}
+		serviceID = p.ServiceID
+	}
+	u := &url.URL{Scheme: c.scheme, Host: c.host, Path: GetGroupsioServiceTreeMailingListPath(serviceID)}
+	req, err := http.NewRequest("GET", u.String(), nil)
 	if err != nil {
-		return nil, goahttp.ErrInvalidURL("mailing-list", "check-groupsio-subscriber", u.String(), err)
+		return nil, goahttp.ErrInvalidURL("mailing-list", "get-groupsio-service-tree", u.String(), err)
 	}
 	if ctx != nil {
 		req = req.WithContext(ctx)
@@ -2870,13 +5890,13 @@ func (c *Client) BuildCheckGroupsioSubscriberRequest(ctx context.Context, v any)
 	return req, nil
 }
 
-// EncodeCheckGroupsioSubscriberRequest returns an encoder for requests sent to
-// the mailing-list check-groupsio-subscriber server.
-func EncodeCheckGroupsioSubscriberRequest(encoder func(*http.Request) goahttp.Encoder) func(*http.Request, any) error {
+// EncodeGetGroupsioServiceTreeRequest returns an encoder for requests sent to
+// the mailing-list get-groupsio-service-tree server.
+func EncodeGetGroupsioServiceTreeRequest(encoder func(*http.Request) goahttp.Encoder) func(*http.Request, any) error {
 	return func(req *http.Request, v any) error {
-		p, ok := v.(*mailinglist.CheckGroupsioSubscriberPayload)
+		p, ok := v.(*mailinglist.GetGroupsioServiceTreePayload)
 		if !ok {
-			return goahttp.ErrInvalidType("mailing-list", "check-groupsio-subscriber", "*mailinglist.CheckGroupsioSubscriberPayload", v)
+			return goahttp.ErrInvalidType("mailing-list", "get-groupsio-service-tree", "*mailinglist.GetGroupsioServiceTreePayload", v)
 		}
 		if p.BearerToken != nil {
 			head := *p.BearerToken
@@ -2886,23 +5906,22 @@ func EncodeCheckGroupsioSubscriberRequest(encoder func(*http.Request) goahttp.En
 				req.Header.Set("Authorization", head)
 			}
 		}
-		body := NewCheckGroupsioSubscriberRequestBody(p)
-		if err := encoder(req).Encode(&body); err != nil {
-			return goahttp.ErrEncodingError("mailing-list", "check-groupsio-subscriber", err)
-		}
+		values := req.URL.Query()
+		values.Add("include_member_counts", fmt.Sprintf("%v", p.IncludeMemberCounts))
+		req.URL.RawQuery = values.Encode()
 		return nil
 	}
 }
 
-// DecodeCheckGroupsioSubscriberResponse returns a decoder for responses
-// returned by the mailing-list check-groupsio-subscriber endpoint. restoreBody
+// DecodeGetGroupsioServiceTreeResponse returns a decoder for responses
+// returned by the mailing-list get-groupsio-service-tree endpoint. restoreBody
 // controls whether the response body should be restored after having been read.
-// DecodeCheckGroupsioSubscriberResponse may return the following errors:
-//   - "BadRequest" (type *mailinglist.BadRequestError): http.StatusBadRequest
+// DecodeGetGroupsioServiceTreeResponse may return the following errors:
 //   - "InternalServerError" (type *mailinglist.InternalServerError): http.StatusInternalServerError
+//   - "NotFound" (type *mailinglist.NotFoundError): http.StatusNotFound
 //   - "ServiceUnavailable" (type *mailinglist.ServiceUnavailableError): http.StatusServiceUnavailable
 //   - error: internal error
-func DecodeCheckGroupsioSubscriberResponse(decoder func(*http.Response) goahttp.Decoder, restoreBody bool) func(*http.Response) (any, error) {
+func DecodeGetGroupsioServiceTreeResponse(decoder func(*http.Response) goahttp.Decoder, restoreBody bool) func(*http.Response) (any, error) {
 	return func(resp *http.Response) (any, error) {
 		if restoreBody {
 			b, err := io.ReadAll(resp.Body)
@@ -2919,88 +5938,88 @@ func DecodeCheckGroupsioSubscriberResponse(decoder func(*http.Response) goahttp.
 		switch resp.StatusCode {
 		case http.StatusOK:
 			var (
-				body CheckGroupsioSubscriberResponseBody
+				body GetGroupsioServiceTreeResponseBody
 				err  error
 			)
 			err = decoder(resp).Decode(&body)
 			if err != nil {
-				return nil, goahttp.ErrDecodingError("mailing-list", "check-groupsio-subscriber", err)
+				return nil, goahttp.ErrDecodingError("mailing-list", "get-groupsio-service-tree", err)
 			}
-			err = ValidateCheckGroupsioSubscriberResponseBody(&body)
+			err = ValidateGetGroupsioServiceTreeResponseBody(&body)
 			if err != nil {
-				return nil, goahttp.ErrValidationError("mailing-list", "check-groupsio-subscriber", err)
+				return nil, goahttp.ErrValidationError("mailing-list", "get-groupsio-service-tree", err)
 			}
-			res := NewCheckGroupsioSubscriberGroupsioCheckSubscriberResponseOK(&body)
+			res := NewGetGroupsioServiceTreeGroupsioServiceTreeOK(&body)
 			return res, nil
-		case http.StatusBadRequest:
+		case http.StatusInternalServerError:
 			var (
-				body CheckGroupsioSubscriberBadRequestResponseBody
+				body GetGroupsioServiceTreeInternalServerErrorResponseBody
 				err  error
 			)
 			err = decoder(resp).Decode(&body)
 			if err != nil {
-				return nil, goahttp.ErrDecodingError("mailing-list", "check-groupsio-subscriber", err)
+				return nil, goahttp.ErrDecodingError("mailing-list", "get-groupsio-service-tree", err)
 			}
-			err = ValidateCheckGroupsioSubscriberBadRequestResponseBody(&body)
+			err = ValidateGetGroupsioServiceTreeInternalServerErrorResponseBody(&body)
 			if err != nil {
-				return nil, goahttp.ErrValidationError("mailing-list", "check-groupsio-subscriber", err)
+				return nil, goahttp.ErrValidationError("mailing-list", "get-groupsio-service-tree", err)
 			}
-			return nil, NewCheckGroupsioSubscriberBadRequest(&body)
-		case http.StatusInternalServerError:
+			return nil, NewGetGroupsioServiceTreeInternalServerError(&body)
+		case http.StatusNotFound:
 			var (
-				body CheckGroupsioSubscriberInternalServerErrorResponseBody
+				body GetGroupsioServiceTreeNotFoundResponseBody
 				err  error
 			)
 			err = decoder(resp).Decode(&body)
 			if err != nil {
-				return nil, goahttp.ErrDecodingError("mailing-list", "check-groupsio-subscriber", err)
+				return nil, goahttp.ErrDecodingError("mailing-list", "get-groupsio-service-tree", err)
 			}
-			err = ValidateCheckGroupsioSubscriberInternalServerErrorResponseBody(&body)
+			err = ValidateGetGroupsioServiceTreeNotFoundResponseBody(&body)
 			if err != nil {
-				return nil, goahttp.ErrValidationError("mailing-list", "check-groupsio-subscriber", err)
+				return nil, goahttp.ErrValidationError("mailing-list", "get-groupsio-service-tree", err)
 			}
-			return nil, NewCheckGroupsioSubscriberInternalServerError(&body)
+			return nil, NewGetGroupsioServiceTreeNotFound(&body)
 		case http.StatusServiceUnavailable:
 			var (
-				body CheckGroupsioSubscriberServiceUnavailableResponseBody
+				body GetGroupsioServiceTreeServiceUnavailableResponseBody
 				err  error
 			)
 			err = decoder(resp).Decode(&body)
 			if err != nil {
-				return nil, goahttp.ErrDecodingError("mailing-list", "check-groupsio-subscriber", err)
+				return nil, goahttp.ErrDecodingError("mailing-list", "get-groupsio-service-tree", err)
 			}
-			err = ValidateCheckGroupsioSubscriberServiceUnavailableResponseBody(&body)
+			err = ValidateGetGroupsioServiceTreeServiceUnavailableResponseBody(&body)
 			if err != nil {
-				return nil, goahttp.ErrValidationError("mailing-list", "check-groupsio-subscriber", err)
+				return nil, goahttp.ErrValidationError("mailing-list", "get-groupsio-service-tree", err)
 			}
-			return nil, NewCheckGroupsioSubscriberServiceUnavailable(&body)
+			return nil, NewGetGroupsioServiceTreeServiceUnavailable(&body)
 		default:
 			body, _ := io.ReadAll(resp.Body)
-			return nil, goahttp.ErrInvalidResponse("mailing-list", "check-groupsio-subscriber", resp.StatusCode, string(body))
+			return nil, goahttp.ErrInvalidResponse("mailing-list", "get-groupsio-service-tree", resp.StatusCode, string(body))
 		}
 	}
 }
 
-// BuildGetGroupsioArtifactRequest instantiates a HTTP request object with
-// method and path set to call the "mailing-list" service
-// "get-groupsio-artifact" endpoint
-func (c *Client) BuildGetGroupsioArtifactRequest(ctx context.Context, v any) (*http.Request, error) {
+// BuildGetGroupsioMemberHierarchyRequest instantiates a HTTP request object
+// with method and path set to call the "mailing-list" service
+// "get-groupsio-member-hierarchy" endpoint
+func (c *Client) BuildGetGroupsioMemberHierarchyRequest(ctx context.Context, v any) (*http.Request, error) {
 	var (
 		subgroupID string
-		artifactID string
+		memberID   string
 	)
 	{
-		p, ok := v.(*mailinglist.GetGroupsioArtifactPayload)
+		p, ok := v.(*mailinglist.GetGroupsioMemberHierarchyPayload)
 		if !ok {
-			return nil, goahttp.ErrInvalidType("mailing-list", "get-groupsio-artifact", "*mailinglist.GetGroupsioArtifactPayload", v)
+			return nil, goahttp.ErrInvalidType("mailing-list", "get-groupsio-member-hierarchy", "*mailinglist.GetGroupsioMemberHierarchyPayload", v)
 		}
 		subgroupID = p.SubgroupID
-		artifactID = p.ArtifactID
+		memberID = p.MemberID
 	}
-	u := &url.URL{Scheme: c.scheme, Host: c.host, Path: GetGroupsioArtifactMailingListPath(subgroupID, artifactID)}
+	u := &url.URL{Scheme: c.scheme, Host: c.host, Path: GetGroupsioMemberHierarchyMailingListPath(subgroupID, memberID)}
 	req, err := http.NewRequest("GET", u.String(), nil)
 	if err != nil {
-		return nil, goahttp.ErrInvalidURL("mailing-list", "get-groupsio-artifact", u.String(), err)
+		return nil, goahttp.ErrInvalidURL("mailing-list", "get-groupsio-member-hierarchy", u.String(), err)
 	}
 	if ctx != nil {
 		req = req.WithContext(ctx)
@@ -3009,13 +6028,13 @@ func (c *Client) BuildGetGroupsioArtifactRequest(ctx context.Context, v any) (*h
 	return req, nil
 }
 
-// EncodeGetGroupsioArtifactRequest returns an encoder for requests sent to the
-// mailing-list get-groupsio-artifact server.
-func EncodeGetGroupsioArtifactRequest(encoder func(*http.Request) goahttp.Encoder) func(*http.Request, any) error {
+// EncodeGetGroupsioMemberHierarchyRequest returns an encoder for requests sent
+// to the mailing-list get-groupsio-member-hierarchy server.
+func EncodeGetGroupsioMemberHierarchyRequest(encoder func(*http.Request) goahttp.Encoder) func(*http.Request, any) error {
 	return func(req *http.Request, v any) error {
-		p, ok := v.(*mailinglist.GetGroupsioArtifactPayload)
+		p, ok := v.(*mailinglist.GetGroupsioMemberHierarchyPayload)
 		if !ok {
-			return goahttp.ErrInvalidType("mailing-list", "get-groupsio-artifact", "*mailinglist.GetGroupsioArtifactPayload", v)
+			return goahttp.ErrInvalidType("mailing-list", "get-groupsio-member-hierarchy", "*mailinglist.GetGroupsioMemberHierarchyPayload", v)
 		}
 		if p.BearerToken != nil {
 			head := *p.BearerToken
@@ -3029,15 +6048,16 @@ func EncodeGetGroupsioArtifactRequest(encoder func(*http.Request) goahttp.Encode
 	}
 }
 
-// DecodeGetGroupsioArtifactResponse returns a decoder for responses returned
-// by the mailing-list get-groupsio-artifact endpoint. restoreBody controls
-// whether the response body should be restored after having been read.
-// DecodeGetGroupsioArtifactResponse may return the following errors:
+// DecodeGetGroupsioMemberHierarchyResponse returns a decoder for responses
+// returned by the mailing-list get-groupsio-member-hierarchy endpoint.
+// restoreBody controls whether the response body should be restored after
+// having been read.
+// DecodeGetGroupsioMemberHierarchyResponse may return the following errors:
 //   - "InternalServerError" (type *mailinglist.InternalServerError): http.StatusInternalServerError
 //   - "NotFound" (type *mailinglist.NotFoundError): http.StatusNotFound
 //   - "ServiceUnavailable" (type *mailinglist.ServiceUnavailableError): http.StatusServiceUnavailable
 //   - error: internal error
-func DecodeGetGroupsioArtifactResponse(decoder func(*http.Response) goahttp.Decoder, restoreBody bool) func(*http.Response) (any, error) {
+func DecodeGetGroupsioMemberHierarchyResponse(decoder func(*http.Response) goahttp.Decoder, restoreBody bool) func(*http.Response) (any, error) {
 	return func(resp *http.Response) (any, error) {
 		if restoreBody {
 			b, err := io.ReadAll(resp.Body)
@@ -3054,84 +6074,86 @@ func DecodeGetGroupsioArtifactResponse(decoder func(*http.Response) goahttp.Deco
 		switch resp.StatusCode {
 		case http.StatusOK:
 			var (
-				body GetGroupsioArtifactResponseBody
+				body GetGroupsioMemberHierarchyResponseBody
 				err  error
 			)
 			err = decoder(resp).Decode(&body)
 			if err != nil {
-				return nil, goahttp.ErrDecodingError("mailing-list", "get-groupsio-artifact", err)
+				return nil, goahttp.ErrDecodingError("mailing-list", "get-groupsio-member-hierarchy", err)
 			}
-			res := NewGetGroupsioArtifactGroupsioArtifactOK(&body)
+			err = ValidateGetGroupsioMemberHierarchyResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("mailing-list", "get-groupsio-member-hierarchy", err)
+			}
+			res := NewGetGroupsioMemberHierarchyGroupsioMemberHierarchyOK(&body)
 			return res, nil
 		case http.StatusInternalServerError:
 			var (
-				body GetGroupsioArtifactInternalServerErrorResponseBody
+				body GetGroupsioMemberHierarchyInternalServerErrorResponseBody
 				err  error
 			)
 			err = decoder(resp).Decode(&body)
 			if err != nil {
-				return nil, goahttp.ErrDecodingError("mailing-list", "get-groupsio-artifact", err)
+				return nil, goahttp.ErrDecodingError("mailing-list", "get-groupsio-member-hierarchy", err)
 			}
-			err = ValidateGetGroupsioArtifactInternalServerErrorResponseBody(&body)
+			err = ValidateGetGroupsioMemberHierarchyInternalServerErrorResponseBody(&body)
 			if err != nil {
-				return nil, goahttp.ErrValidationError("mailing-list", "get-groupsio-artifact", err)
+				return nil, goahttp.ErrValidationError("mailing-list", "get-groupsio-member-hierarchy", err)
 			}
-			return nil, NewGetGroupsioArtifactInternalServerError(&body)
+			return nil, NewGetGroupsioMemberHierarchyInternalServerError(&body)
 		case http.StatusNotFound:
 			var (
-				body GetGroupsioArtifactNotFoundResponseBody
+				body GetGroupsioMemberHierarchyNotFoundResponseBody
 				err  error
 			)
 			err = decoder(resp).Decode(&body)
 			if err != nil {
-				return nil, goahttp.ErrDecodingError("mailing-list", "get-groupsio-artifact", err)
+				return nil, goahttp.ErrDecodingError("mailing-list", "get-groupsio-member-hierarchy", err)
 			}
-			err = ValidateGetGroupsioArtifactNotFoundResponseBody(&body)
+			err = ValidateGetGroupsioMemberHierarchyNotFoundResponseBody(&body)
 			if err != nil {
-				return nil, goahttp.ErrValidationError("mailing-list", "get-groupsio-artifact", err)
+				return nil, goahttp.ErrValidationError("mailing-list", "get-groupsio-member-hierarchy", err)
 			}
-			return nil, NewGetGroupsioArtifactNotFound(&body)
+			return nil, NewGetGroupsioMemberHierarchyNotFound(&body)
 		case http.StatusServiceUnavailable:
 			var (
-				body GetGroupsioArtifactServiceUnavailableResponseBody
+				body GetGroupsioMemberHierarchyServiceUnavailableResponseBody
 				err  error
 			)
 			err = decoder(resp).Decode(&body)
 			if err != nil {
-				return nil, goahttp.ErrDecodingError("mailing-list", "get-groupsio-artifact", err)
+				return nil, goahttp.ErrDecodingError("mailing-list", "get-groupsio-member-hierarchy", err)
 			}
-			err = ValidateGetGroupsioArtifactServiceUnavailableResponseBody(&body)
+			err = ValidateGetGroupsioMemberHierarchyServiceUnavailableResponseBody(&body)
 			if err != nil {
-				return nil, goahttp.ErrValidationError("mailing-list", "get-groupsio-artifact", err)
+				return nil, goahttp.ErrValidationError("mailing-list", "get-groupsio-member-hierarchy", err)
 			}
-			return nil, NewGetGroupsioArtifactServiceUnavailable(&body)
+			return nil, NewGetGroupsioMemberHierarchyServiceUnavailable(&body)
 		default:
 			body, _ := io.ReadAll(resp.Body)
-			return nil, goahttp.ErrInvalidResponse("mailing-list", "get-groupsio-artifact", resp.StatusCode, string(body))
+			return nil, goahttp.ErrInvalidResponse("mailing-list", "get-groupsio-member-hierarchy", resp.StatusCode, string(body))
 		}
 	}
 }
 
-// BuildGetGroupsioArtifactDownloadRequest instantiates a HTTP request object
+// BuildListGroupsioProjectMembersRequest instantiates a HTTP request object
 // with method and path set to call the "mailing-list" service
-// "get-groupsio-artifact-download" endpoint
-func (c *Client) BuildGetGroupsioArtifactDownloadRequest(ctx context.Context, v any) (*http.Request, error) {
+// "list-groupsio-project-members" endpoint
+func (c *Client) BuildListGroupsioProjectMembersRequest(ctx context.Context, v any) (*http.Request, error) {
 	var (
-		subgroupID string
-		artifactID string
+		projectUID string
 	)
 	{
-		p, ok := v.(*mailinglist.GetGroupsioArtifactDownloadPayload)
+		p, ok := v.(*mailinglist.ListGroupsioProjectMembersPayload)
 		if !ok {
-			return nil, goahttp.ErrInvalidType("mailing-list", "get-groupsio-artifact-download", "*mailinglist.GetGroupsioArtifactDownloadPayload", v)
+			return nil, goahttp.ErrInvalidType("mailing-list", "list-groupsio-project-members", "*mailinglist.ListGroupsioProjectMembersPayload", v)
 		}
-		subgroupID = p.SubgroupID
-		artifactID = p.ArtifactID
+		projectUID = p.ProjectUID
 	}
-	u := &url.URL{Scheme: c.scheme, Host: c.host, Path: GetGroupsioArtifactDownloadMailingListPath(subgroupID, artifactID)}
+	u := &url.URL{Scheme: c.scheme, Host: c.host, Path: ListGroupsioProjectMembersMailingListPath(projectUID)}
 	req, err := http.NewRequest("GET", u.String(), nil)
 	if err != nil {
-		return nil, goahttp.ErrInvalidURL("mailing-list", "get-groupsio-artifact-download", u.String(), err)
+		return nil, goahttp.ErrInvalidURL("mailing-list", "list-groupsio-project-members", u.String(), err)
 	}
 	if ctx != nil {
 		req = req.WithContext(ctx)
@@ -3140,13 +6162,13 @@ func (c *Client) BuildGetGroupsioArtifactDownloadRequest(ctx context.Context, v
 	return req, nil
 }
 
-// EncodeGetGroupsioArtifactDownloadRequest returns an encoder for requests
-// sent to the mailing-list get-groupsio-artifact-download server.
-func EncodeGetGroupsioArtifactDownloadRequest(encoder func(*http.Request) goahttp.Encoder) func(*http.Request, any) error {
+// EncodeListGroupsioProjectMembersRequest returns an encoder for requests sent
+// to the mailing-list list-groupsio-project-members server.
+func EncodeListGroupsioProjectMembersRequest(encoder func(*http.Request) goahttp.Encoder) func(*http.Request, any) error {
 	return func(req *http.Request, v any) error {
-		p, ok := v.(*mailinglist.GetGroupsioArtifactDownloadPayload)
+		p, ok := v.(*mailinglist.ListGroupsioProjectMembersPayload)
 		if !ok {
-			return goahttp.ErrInvalidType("mailing-list", "get-groupsio-artifact-download", "*mailinglist.GetGroupsioArtifactDownloadPayload", v)
+			return goahttp.ErrInvalidType("mailing-list", "list-groupsio-project-members", "*mailinglist.ListGroupsioProjectMembersPayload", v)
 		}
 		if p.BearerToken != nil {
 			head := *p.BearerToken
@@ -3156,20 +6178,27 @@ func EncodeGetGroupsioArtifactDownloadRequest(encoder func(*http.Request) goahtt
 				req.Header.Set("Authorization", head)
 			}
 		}
+		values := req.URL.Query()
+		values.Add("limit", fmt.Sprintf("%v", p.Limit))
+		if p.Cursor != nil {
+			values.Add("cursor", *p.Cursor)
+		}
+		values.Add("dedupe", fmt.Sprintf("%v", p.Dedupe))
+		req.URL.RawQuery = values.Encode()
 		return nil
 	}
 }
 
-// DecodeGetGroupsioArtifactDownloadResponse returns a decoder for responses
-// returned by the mailing-list get-groupsio-artifact-download endpoint.
+// DecodeListGroupsioProjectMembersResponse returns a decoder for responses
+// returned by the mailing-list list-groupsio-project-members endpoint.
 // restoreBody controls whether the response body should be restored after
 // having been read.
-// DecodeGetGroupsioArtifactDownloadResponse may return the following errors:
+// DecodeListGroupsioProjectMembersResponse may return the following errors:
+//   - "BadRequest" (type *mailinglist.BadRequestError): http.StatusBadRequest
 //   - "InternalServerError" (type *mailinglist.InternalServerError): http.StatusInternalServerError
-//   - "NotFound" (type *mailinglist.NotFoundError): http.StatusNotFound
 //   - "ServiceUnavailable" (type *mailinglist.ServiceUnavailableError): http.StatusServiceUnavailable
 //   - error: internal error
-func DecodeGetGroupsioArtifactDownloadResponse(decoder func(*http.Response) goahttp.Decoder, restoreBody bool) func(*http.Response) (any, error) {
+func DecodeListGroupsioProjectMembersResponse(decoder func(*http.Response) goahttp.Decoder, restoreBody bool) func(*http.Response) (any, error) {
 	return func(resp *http.Response) (any, error) {
 		if restoreBody {
 			b, err := io.ReadAll(resp.Body)
@@ -3186,64 +6215,64 @@ func DecodeGetGroupsioArtifactDownloadResponse(decoder func(*http.Response) goah
 		switch resp.StatusCode {
 		case http.StatusOK:
 			var (
-				body GetGroupsioArtifactDownloadResponseBody
+				body ListGroupsioProjectMembersResponseBody
 				err  error
 			)
 			err = decoder(resp).Decode(&body)
 			if err != nil {
-				return nil, goahttp.ErrDecodingError("mailing-list", "get-groupsio-artifact-download", err)
+				return nil, goahttp.ErrDecodingError("mailing-list", "list-groupsio-project-members", err)
 			}
-			err = ValidateGetGroupsioArtifactDownloadResponseBody(&body)
+			err = ValidateListGroupsioProjectMembersResponseBody(&body)
 			if err != nil {
-				return nil, goahttp.ErrValidationError("mailing-list", "get-groupsio-artifact-download", err)
+				return nil, goahttp.ErrValidationError("mailing-list", "list-groupsio-project-members", err)
 			}
-			res := NewGetGroupsioArtifactDownloadGroupsioArtifactDownloadOK(&body)
+			res := NewListGroupsioProjectMembersGroupsioMemberPageOK(&body)
 			return res, nil
-		case http.StatusInternalServerError:
+		case http.StatusBadRequest:
 			var (
-				body GetGroupsioArtifactDownloadInternalServerErrorResponseBody
+				body ListGroupsioProjectMembersBadRequestResponseBody
 				err  error
 			)
 			err = decoder(resp).Decode(&body)
 			if err != nil {
-				return nil, goahttp.ErrDecodingError("mailing-list", "get-groupsio-artifact-download", err)
+				return nil, goahttp.ErrDecodingError("mailing-list", "list-groupsio-project-members", err)
 			}
-			err = ValidateGetGroupsioArtifactDownloadInternalServerErrorResponseBody(&body)
+			err = ValidateListGroupsioProjectMembersBadRequestResponseBody(&body)
 			if err != nil {
-				return nil, goahttp.ErrValidationError("mailing-list", "get-groupsio-artifact-download", err)
+				return nil, goahttp.ErrValidationError("mailing-list", "list-groupsio-project-members", err)
 			}
-			return nil, NewGetGroupsioArtifactDownloadInternalServerError(&body)
-		case http.StatusNotFound:
+			return nil, NewListGroupsioProjectMembersBadRequest(&body)
+		case http.StatusInternalServerError:
 			var (
-				body GetGroupsioArtifactDownloadNotFoundResponseBody
+				body ListGroupsioProjectMembersInternalServerErrorResponseBody
 				err  error
 			)
 			err = decoder(resp).Decode(&body)
 			if err != nil {
-				return nil, goahttp.ErrDecodingError("mailing-list", "get-groupsio-artifact-download", err)
+				return nil, goahttp.ErrDecodingError("mailing-list", "list-groupsio-project-members", err)
 			}
-			err = ValidateGetGroupsioArtifactDownloadNotFoundResponseBody(&body)
+			err = ValidateListGroupsioProjectMembersInternalServerErrorResponseBody(&body)
 			if err != nil {
-				return nil, goahttp.ErrValidationError("mailing-list", "get-groupsio-artifact-download", err)
+				return nil, goahttp.ErrValidationError("mailing-list", "list-groupsio-project-members", err)
 			}
-			return nil, NewGetGroupsioArtifactDownloadNotFound(&body)
+			return nil, NewListGroupsioProjectMembersInternalServerError(&body)
 		case http.StatusServiceUnavailable:
 			var (
-				body GetGroupsioArtifactDownloadServiceUnavailableResponseBody
+				body ListGroupsioProjectMembersServiceUnavailableResponseBody
 				err  error
 			)
 			err = decoder(resp).Decode(&body)
 			if err != nil {
-				return nil, goahttp.ErrDecodingError("mailing-list", "get-groupsio-artifact-download", err)
+				return nil, goahttp.ErrDecodingError("mailing-list", "list-groupsio-project-members", err)
 			}
-			err = ValidateGetGroupsioArtifactDownloadServiceUnavailableResponseBody(&body)
+			err = ValidateListGroupsioProjectMembersServiceUnavailableResponseBody(&body)
 			if err != nil {
-				return nil, goahttp.ErrValidationError("mailing-list", "get-groupsio-artifact-download", err)
+				return nil, goahttp.ErrValidationError("mailing-list", "list-groupsio-project-members", err)
 			}
-			return nil, NewGetGroupsioArtifactDownloadServiceUnavailable(&body)
+			return nil, NewListGroupsioProjectMembersServiceUnavailable(&body)
 		default:
 			body, _ := io.ReadAll(resp.Body)
-			return nil, goahttp.ErrInvalidResponse("mailing-list", "get-groupsio-artifact-download", resp.StatusCode, string(body))
+			return nil, goahttp.ErrInvalidResponse("mailing-list", "list-groupsio-project-members", resp.StatusCode, string(body))
 		}
 	}
 }
@@ -3290,6 +6319,12 @@ func unmarshalGroupsioSubgroupResponseBodyToMailinglistGroupsioSubgroup(v *Group
 		CreatedAt:      v.CreatedAt,
 		UpdatedAt:      v.UpdatedAt,
 	}
+	if v.Warnings != nil {
+		res.Warnings = make([]string, len(v.Warnings))
+		for i, val := range v.Warnings {
+			res.Warnings[i] = val
+		}
+	}
 
 	return res
 }
@@ -3321,6 +6356,40 @@ func unmarshalGroupsioMemberResponseBodyToMailinglistGroupsioMember(v *GroupsioM
 	return res
 }
 
+// marshalMailinglistGroupsioMemberRequestToGroupsioMemberRequestRequestBody
+// builds a value of type *GroupsioMemberRequestRequestBody from a value of
+// type *mailinglist.GroupsioMemberRequest.
+func marshalMailinglistGroupsioMemberRequestToGroupsioMemberRequestRequestBody(v *mailinglist.GroupsioMemberRequest) *GroupsioMemberRequestRequestBody {
+	res := &GroupsioMemberRequestRequestBody{
+		Email:        v.Email,
+		Name:         v.Name,
+		MemberType:   v.MemberType,
+		ModStatus:    v.ModStatus,
+		DeliveryMode: v.DeliveryMode,
+		Organization: v.Organization,
+		JobTitle:     v.JobTitle,
+	}
+
+	return res
+}
+
+// marshalGroupsioMemberRequestRequestBodyToMailinglistGroupsioMemberRequest
+// builds a value of type *mailinglist.GroupsioMemberRequest from a value of
+// type *GroupsioMemberRequestRequestBody.
+func marshalGroupsioMemberRequestRequestBodyToMailinglistGroupsioMemberRequest(v *GroupsioMemberRequestRequestBody) *mailinglist.GroupsioMemberRequest {
+	res := &mailinglist.GroupsioMemberRequest{
+		Email:        v.Email,
+		Name:         v.Name,
+		MemberType:   v.MemberType,
+		ModStatus:    v.ModStatus,
+		DeliveryMode: v.DeliveryMode,
+		Organization: v.Organization,
+		JobTitle:     v.JobTitle,
+	}
+
+	return res
+}
+
 // unmarshalGroupsioArtifactUserResponseBodyToMailinglistGroupsioArtifactUser
 // builds a value of type *mailinglist.GroupsioArtifactUser from a value of
 // type *GroupsioArtifactUserResponseBody.
@@ -3338,3 +6407,31 @@ func unmarshalGroupsioArtifactUserResponseBodyToMailinglistGroupsioArtifactUser(
 
 	return res
 }
+
+// unmarshalGroupsioServiceTreeSubgroupResponseBodyToMailinglistGroupsioServiceTreeSubgroup
+// builds a value of type *mailinglist.GroupsioServiceTreeSubgroup from a value
+// of type *GroupsioServiceTreeSubgroupResponseBody.
+func unmarshalGroupsioServiceTreeSubgroupResponseBodyToMailinglistGroupsioServiceTreeSubgroup(v *GroupsioServiceTreeSubgroupResponseBody) *mailinglist.GroupsioServiceTreeSubgroup {
+	res := &mailinglist.GroupsioServiceTreeSubgroup{
+		MemberCount: v.MemberCount,
+	}
+	res.Subgroup = unmarshalGroupsioSubgroupResponseBodyToMailinglistGroupsioSubgroup(v.Subgroup)
+
+	return res
+}
+
+// unmarshalGroupsioMemberHierarchyProjectResponseBodyToMailinglistGroupsioMemberHierarchyProject
+// builds a value of type *mailinglist.GroupsioMemberHierarchyProject from a
+// value of type *GroupsioMemberHierarchyProjectResponseBody.
+func unmarshalGroupsioMemberHierarchyProjectResponseBodyToMailinglistGroupsioMemberHierarchyProject(v *GroupsioMemberHierarchyProjectResponseBody) *mailinglist.GroupsioMemberHierarchyProject {
+	if v == nil {
+		return nil
+	}
+	res := &mailinglist.GroupsioMemberHierarchyProject{
+		UID:  *v.UID,
+		Name: v.Name,
+		Slug: v.Slug,
+	}
+
+	return res
+}
@@ -108,9 +108,31 @@ type AddGroupsioMemberRequestBody struct {
 	JobTitle *string `form:"job_title,omitempty" json:"job_title,omitempty" xml:"job_title,omitempty"`
 }
 
+// UpsertGroupsioMemberRequestBody is the type of the "mailing-list" service
+// "upsert-groupsio-member" endpoint HTTP request body.
+type UpsertGroupsioMemberRequestBody struct {
+	// Member email address
+	Email string `form:"email" json:"email" xml:"email"`
+	// Member display name
+	Name *string `form:"name,omitempty" json:"name,omitempty" xml:"name,omitempty"`
+	// Member type; only 'direct' is accepted for API-managed members
+	MemberType *string `form:"member_type,omitempty" json:"member_type,omitempty" xml:"member_type,omitempty"`
+	// Moderation status
+	ModStatus *string `form:"mod_status,omitempty" json:"mod_status,omitempty" xml:"mod_status,omitempty"`
+	// Email delivery mode
+	DeliveryMode *string `form:"delivery_mode,omitempty" json:"delivery_mode,omitempty" xml:"delivery_mode,omitempty"`
+	// Member organization
+	Organization *string `form:"organization,omitempty" json:"organization,omitempty" xml:"organization,omitempty"`
+	// Member job title
+	JobTitle *string `form:"job_title,omitempty" json:"job_title,omitempty" xml:"job_title,omitempty"`
+}
+
 // UpdateGroupsioMemberRequestBody is the type of the "mailing-list" service
 // "update-groupsio-member" endpoint HTTP request body.
 type UpdateGroupsioMemberRequestBody struct {
+	// Subgroup the member belongs to; a member cannot be moved, so any value other
+	// than subgroup_id is rejected
+	MailingListUID *string `form:"mailing_list_uid,omitempty" json:"mailing_list_uid,omitempty" xml:"mailing_list_uid,omitempty"`
 	// Member email address
 	Email *string `form:"email,omitempty" json:"email,omitempty" xml:"email,omitempty"`
 	// Member display name
@@ -134,6 +156,29 @@ type InviteGroupsioMembersRequestBody struct {
 	Emails []string `form:"emails" json:"emails" xml:"emails"`
 }
 
+// ImportGroupsioMembersRequestBody is the type of the "mailing-list" service
+// "import-groupsio-members" endpoint HTTP request body.
+type ImportGroupsioMembersRequestBody struct {
+	// Members to add
+	Members []*GroupsioMemberRequestRequestBody `form:"members" json:"members" xml:"members"`
+}
+
+// MergeGroupsioMembersRequestBody is the type of the "mailing-list" service
+// "merge-groupsio-members" endpoint HTTP request body.
+type MergeGroupsioMembersRequestBody struct {
+	// ID of the member to keep
+	KeepMemberID string `form:"keep_member_id" json:"keep_member_id" xml:"keep_member_id"`
+	// IDs of the members to remove; each must share the kept member's email
+	MergeMemberIds []string `form:"merge_member_ids" json:"merge_member_ids" xml:"merge_member_ids"`
+}
+
+// ChangeGroupsioMemberEmailRequestBody is the type of the "mailing-list"
+// service "change-groupsio-member-email" endpoint HTTP request body.
+type ChangeGroupsioMemberEmailRequestBody struct {
+	// New email address
+	Email string `form:"email" json:"email" xml:"email"`
+}
+
 // CheckGroupsioSubscriberRequestBody is the type of the "mailing-list" service
 // "check-groupsio-subscriber" endpoint HTTP request body.
 type CheckGroupsioSubscriberRequestBody struct {
@@ -143,6 +188,15 @@ type CheckGroupsioSubscriberRequestBody struct {
 	SubgroupID string `form:"subgroup_id" json:"subgroup_id" xml:"subgroup_id"`
 }
 
+// ReadyzResponseBody is the type of the "mailing-list" service "readyz"
+// endpoint HTTP response body.
+type ReadyzResponseBody struct {
+	// Aggregate status
+	Status *string `form:"status,omitempty" json:"status,omitempty" xml:"status,omitempty"`
+	// Status of each dependency
+	Dependencies map[string]string `form:"dependencies,omitempty" json:"dependencies,omitempty" xml:"dependencies,omitempty"`
+}
+
 // ListGroupsioServicesResponseBody is the type of the "mailing-list" service
 // "list-groupsio-services" endpoint HTTP response body.
 type ListGroupsioServicesResponseBody struct {
@@ -221,6 +275,16 @@ type UpdateGroupsioServiceResponseBody struct {
 	UpdatedAt *string `form:"updated_at,omitempty" json:"updated_at,omitempty" xml:"updated_at,omitempty"`
 }
 
+// PromoteGroupsioServiceResponseBody is the type of the "mailing-list" service
+// "promote-groupsio-service" endpoint HTTP response body.
+type PromoteGroupsioServiceResponseBody struct {
+	// The promoted service
+	Service *GroupsioServiceResponseBody `form:"service,omitempty" json:"service,omitempty" xml:"service,omitempty"`
+	// Subgroups of the service whose names still start with the former formation
+	// prefix; they are not renamed
+	PrefixedSubgroups []*GroupsioSubgroupResponseBody `form:"prefixed_subgroups,omitempty" json:"prefixed_subgroups,omitempty" xml:"prefixed_subgroups,omitempty"`
+}
+
 // GetGroupsioServiceProjectsResponseBody is the type of the "mailing-list"
 // service "get-groupsio-service-projects" endpoint HTTP response body.
 type GetGroupsioServiceProjectsResponseBody struct {
@@ -228,6 +292,25 @@ type GetGroupsioServiceProjectsResponseBody struct {
 	Projects []string `form:"projects,omitempty" json:"projects,omitempty" xml:"projects,omitempty"`
 }
 
+// ListGroupsioServicesByStatusResponseBody is the type of the "mailing-list"
+// service "list-groupsio-services-by-status" endpoint HTTP response body.
+type ListGroupsioServicesByStatusResponseBody struct {
+	// List of services
+	Items []*GroupsioServiceResponseBody `form:"items,omitempty" json:"items,omitempty" xml:"items,omitempty"`
+	// Total count
+	Total *int `form:"total,omitempty" json:"total,omitempty" xml:"total,omitempty"`
+}
+
+// ListUnprovisionedGroupsioServicesResponseBody is the type of the
+// "mailing-list" service "list-unprovisioned-groupsio-services" endpoint HTTP
+// response body.
+type ListUnprovisionedGroupsioServicesResponseBody struct {
+	// List of services
+	Items []*GroupsioServiceResponseBody `form:"items,omitempty" json:"items,omitempty" xml:"items,omitempty"`
+	// Total count
+	Total *int `form:"total,omitempty" json:"total,omitempty" xml:"total,omitempty"`
+}
+
 // FindParentGroupsioServiceResponseBody is the type of the "mailing-list"
 // service "find-parent-groupsio-service" endpoint HTTP response body.
 type FindParentGroupsioServiceResponseBody struct {
@@ -260,6 +343,26 @@ type ListGroupsioMailingListsResponseBody struct {
 	Total *int `form:"total,omitempty" json:"total,omitempty" xml:"total,omitempty"`
 }
 
+// ListGroupsioMailingListsByVisibilityResponseBody is the type of the
+// "mailing-list" service "list-groupsio-mailing-lists-by-visibility" endpoint
+// HTTP response body.
+type ListGroupsioMailingListsByVisibilityResponseBody struct {
+	// List of subgroups
+	Items []*GroupsioSubgroupResponseBody `form:"items,omitempty" json:"items,omitempty" xml:"items,omitempty"`
+	// Total count
+	Total *int `form:"total,omitempty" json:"total,omitempty" xml:"total,omitempty"`
+}
+
+// ListGroupsioMailingListsUpdatedSinceResponseBody is the type of the
+// "mailing-list" service "list-groupsio-mailing-lists-updated-since" endpoint
+// HTTP response body.
+type ListGroupsioMailingListsUpdatedSinceResponseBody struct {
+	// List of subgroups
+	Items []*GroupsioSubgroupResponseBody `form:"items,omitempty" json:"items,omitempty" xml:"items,omitempty"`
+	// Total count
+	Total *int `form:"total,omitempty" json:"total,omitempty" xml:"total,omitempty"`
+}
+
 // CreateGroupsioMailingListResponseBody is the type of the "mailing-list"
 // service "create-groupsio-mailing-list" endpoint HTTP response body.
 type CreateGroupsioMailingListResponseBody struct {
@@ -285,6 +388,8 @@ type CreateGroupsioMailingListResponseBody struct {
 	CreatedAt *string `form:"created_at,omitempty" json:"created_at,omitempty" xml:"created_at,omitempty"`
 	// Last update timestamp
 	UpdatedAt *string `form:"updated_at,omitempty" json:"updated_at,omitempty" xml:"updated_at,omitempty"`
+	// Best-effort follow-up steps that failed after the subgroup was saved
+	Warnings []string `form:"warnings,omitempty" json:"warnings,omitempty" xml:"warnings,omitempty"`
 }
 
 // GetGroupsioMailingListResponseBody is the type of the "mailing-list" service
@@ -312,6 +417,8 @@ type GetGroupsioMailingListResponseBody struct {
 	CreatedAt *string `form:"created_at,omitempty" json:"created_at,omitempty" xml:"created_at,omitempty"`
 	// Last update timestamp
 	UpdatedAt *string `form:"updated_at,omitempty" json:"updated_at,omitempty" xml:"updated_at,omitempty"`
+	// Best-effort follow-up steps that failed after the subgroup was saved
+	Warnings []string `form:"warnings,omitempty" json:"warnings,omitempty" xml:"warnings,omitempty"`
 }
 
 // UpdateGroupsioMailingListResponseBody is the type of the "mailing-list"
@@ -339,6 +446,8 @@ type UpdateGroupsioMailingListResponseBody struct {
 	CreatedAt *string `form:"created_at,omitempty" json:"created_at,omitempty" xml:"created_at,omitempty"`
 	// Last update timestamp
 	UpdatedAt *string `form:"updated_at,omitempty" json:"updated_at,omitempty" xml:"updated_at,omitempty"`
+	// Best-effort follow-up steps that failed after the subgroup was saved
+	Warnings []string `form:"warnings,omitempty" json:"warnings,omitempty" xml:"warnings,omitempty"`
 }
 
 // GetGroupsioMailingListCountResponseBody is the type of the "mailing-list"
@@ -365,6 +474,55 @@ type ListGroupsioMembersResponseBody struct {
 	Total *int `form:"total,omitempty" json:"total,omitempty" xml:"total,omitempty"`
 }
 
+// ListGroupsioMembersNeedingReviewResponseBody is the type of the
+// "mailing-list" service "list-groupsio-members-needing-review" endpoint HTTP
+// response body.
+type ListGroupsioMembersNeedingReviewResponseBody struct {
+	// List of members
+	Items []*GroupsioMemberResponseBody `form:"items,omitempty" json:"items,omitempty" xml:"items,omitempty"`
+	// Total count
+	Total *int `form:"total,omitempty" json:"total,omitempty" xml:"total,omitempty"`
+}
+
+// ListGroupsioMembersModifiedSinceResponseBody is the type of the
+// "mailing-list" service "list-groupsio-members-modified-since" endpoint HTTP
+// response body.
+type ListGroupsioMembersModifiedSinceResponseBody struct {
+	// List of members
+	Items []*GroupsioMemberResponseBody `form:"items,omitempty" json:"items,omitempty" xml:"items,omitempty"`
+	// Total count
+	Total *int `form:"total,omitempty" json:"total,omitempty" xml:"total,omitempty"`
+}
+
+// ListGroupsioMembersByOrganizationResponseBody is the type of the
+// "mailing-list" service "list-groupsio-members-by-organization" endpoint HTTP
+// response body.
+type ListGroupsioMembersByOrganizationResponseBody struct {
+	// List of members
+	Items []*GroupsioMemberResponseBody `form:"items,omitempty" json:"items,omitempty" xml:"items,omitempty"`
+	// Total count
+	Total *int `form:"total,omitempty" json:"total,omitempty" xml:"total,omitempty"`
+}
+
+// ListGroupsioDuplicateMembersResponseBody is the type of the "mailing-list"
+// service "list-groupsio-duplicate-members" endpoint HTTP response body.
+type ListGroupsioDuplicateMembersResponseBody struct {
+	// Each group lists the IDs of two or more members with the same email
+	Groups [][]string `form:"groups,omitempty" json:"groups,omitempty" xml:"groups,omitempty"`
+}
+
+// PreviewGroupsioDeliveryModeChangeResponseBody is the type of the
+// "mailing-list" service "preview-groupsio-delivery-mode-change" endpoint HTTP
+// response body.
+type PreviewGroupsioDeliveryModeChangeResponseBody struct {
+	// Delivery mode the preview was computed for
+	TargetMode *string `form:"target_mode,omitempty" json:"target_mode,omitempty" xml:"target_mode,omitempty"`
+	// Emails of members on a different delivery mode
+	Affected []string `form:"affected,omitempty" json:"affected,omitempty" xml:"affected,omitempty"`
+	// Emails of members already on target_mode
+	Unchanged []string `form:"unchanged,omitempty" json:"unchanged,omitempty" xml:"unchanged,omitempty"`
+}
+
 // AddGroupsioMemberResponseBody is the type of the "mailing-list" service
 // "add-groupsio-member" endpoint HTTP response body.
 type AddGroupsioMemberResponseBody struct {
@@ -398,6 +556,15 @@ type AddGroupsioMemberResponseBody struct {
 	UpdatedAt *string `form:"updated_at,omitempty" json:"updated_at,omitempty" xml:"updated_at,omitempty"`
 }
 
+// UpsertGroupsioMemberResponseBody is the type of the "mailing-list" service
+// "upsert-groupsio-member" endpoint HTTP response body.
+type UpsertGroupsioMemberResponseBody struct {
+	// The member after the upsert
+	Member *GroupsioMemberResponseBody `form:"member,omitempty" json:"member,omitempty" xml:"member,omitempty"`
+	// True when the member was added, false when an existing member was updated
+	Created *bool `form:"created,omitempty" json:"created,omitempty" xml:"created,omitempty"`
+}
+
 // GetGroupsioMemberResponseBody is the type of the "mailing-list" service
 // "get-groupsio-member" endpoint HTTP response body.
 type GetGroupsioMemberResponseBody struct {
@@ -464,6 +631,54 @@ type UpdateGroupsioMemberResponseBody struct {
 	UpdatedAt *string `form:"updated_at,omitempty" json:"updated_at,omitempty" xml:"updated_at,omitempty"`
 }
 
+// ImportGroupsioMembersResponseBody is the type of the "mailing-list" service
+// "import-groupsio-members" endpoint HTTP response body.
+type ImportGroupsioMembersResponseBody struct {
+	// Number of members added
+	Created *int `form:"created,omitempty" json:"created,omitempty" xml:"created,omitempty"`
+	// Number of members that already existed
+	Skipped *int `form:"skipped,omitempty" json:"skipped,omitempty" xml:"skipped,omitempty"`
+	// Number of members that could not be added
+	Errored *int `form:"errored,omitempty" json:"errored,omitempty" xml:"errored,omitempty"`
+	// Emails of the skipped members
+	SkippedEmails []string `form:"skipped_emails,omitempty" json:"skipped_emails,omitempty" xml:"skipped_emails,omitempty"`
+	// Emails of the members that could not be added
+	ErroredEmails []string `form:"errored_emails,omitempty" json:"errored_emails,omitempty" xml:"errored_emails,omitempty"`
+}
+
+// ChangeGroupsioMemberEmailResponseBody is the type of the "mailing-list"
+// service "change-groupsio-member-email" endpoint HTTP response body.
+type ChangeGroupsioMemberEmailResponseBody struct {
+	// Member ID
+	ID *string `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
+	// Member email address
+	Email *string `form:"email,omitempty" json:"email,omitempty" xml:"email,omitempty"`
+	// Member display name
+	Name *string `form:"name,omitempty" json:"name,omitempty" xml:"name,omitempty"`
+	// Member type
+	MemberType *string `form:"member_type,omitempty" json:"member_type,omitempty" xml:"member_type,omitempty"`
+	// Email delivery mode
+	DeliveryMode *string `form:"delivery_mode,omitempty" json:"delivery_mode,omitempty" xml:"delivery_mode,omitempty"`
+	// Moderation status
+	ModStatus *string `form:"mod_status,omitempty" json:"mod_status,omitempty" xml:"mod_status,omitempty"`
+	// Member status
+	Status *string `form:"status,omitempty" json:"status,omitempty" xml:"status,omitempty"`
+	// Member organization
+	Organization *string `form:"organization,omitempty" json:"organization,omitempty" xml:"organization,omitempty"`
+	// Member job title
+	JobTitle *string `form:"job_title,omitempty" json:"job_title,omitempty" xml:"job_title,omitempty"`
+	// Groups.io username
+	Username *string `form:"username,omitempty" json:"username,omitempty" xml:"username,omitempty"`
+	// Member role
+	Role *string `form:"role,omitempty" json:"role,omitempty" xml:"role,omitempty"`
+	// Voting status
+	VotingStatus *string `form:"voting_status,omitempty" json:"voting_status,omitempty" xml:"voting_status,omitempty"`
+	// Creation timestamp
+	CreatedAt *string `form:"created_at,omitempty" json:"created_at,omitempty" xml:"created_at,omitempty"`
+	// Last update timestamp
+	UpdatedAt *string `form:"updated_at,omitempty" json:"updated_at,omitempty" xml:"updated_at,omitempty"`
+}
+
 // CheckGroupsioSubscriberResponseBody is the type of the "mailing-list"
 // service "check-groupsio-subscriber" endpoint HTTP response body.
 type CheckGroupsioSubscriberResponseBody struct {
@@ -525,6 +740,87 @@ type GetGroupsioArtifactDownloadResponseBody struct {
 	URL *string `form:"url,omitempty" json:"url,omitempty" xml:"url,omitempty"`
 }
 
+// GetGroupsioProjectSummaryResponseBody is the type of the "mailing-list"
+// service "get-groupsio-project-summary" endpoint HTTP response body.
+type GetGroupsioProjectSummaryResponseBody struct {
+	// LFX v2 project UID
+	ProjectUID *string `form:"project_uid,omitempty" json:"project_uid,omitempty" xml:"project_uid,omitempty"`
+	// Number of services
+	ServiceCount *int `form:"service_count,omitempty" json:"service_count,omitempty" xml:"service_count,omitempty"`
+	// Number of subgroups
+	MailingListCount *int `form:"mailing_list_count,omitempty" json:"mailing_list_count,omitempty" xml:"mailing_list_count,omitempty"`
+	// Sum of the subgroups' member counts; a person in two subgroups is counted
+	// twice
+	MemberCount *int `form:"member_count,omitempty" json:"member_count,omitempty" xml:"member_count,omitempty"`
+}
+
+// ListGroupsioOrphanedMailingListsResponseBody is the type of the
+// "mailing-list" service "list-groupsio-orphaned-mailing-lists" endpoint HTTP
+// response body.
+type ListGroupsioOrphanedMailingListsResponseBody struct {
+	// List of subgroups
+	Items []*GroupsioSubgroupResponseBody `form:"items,omitempty" json:"items,omitempty" xml:"items,omitempty"`
+	// Total count
+	Total *int `form:"total,omitempty" json:"total,omitempty" xml:"total,omitempty"`
+}
+
+// RepublishGroupsioProjectIndexResponseBody is the type of the "mailing-list"
+// service "republish-groupsio-project-index" endpoint HTTP response body.
+type RepublishGroupsioProjectIndexResponseBody struct {
+	// Number of indexer messages published
+	Published *int `form:"published,omitempty" json:"published,omitempty" xml:"published,omitempty"`
+}
+
+// GetGroupsioMailingListStatsResponseBody is the type of the "mailing-list"
+// service "get-groupsio-mailing-list-stats" endpoint HTTP response body.
+type GetGroupsioMailingListStatsResponseBody struct {
+	// Subgroup ID
+	SubgroupID *string `form:"subgroup_id,omitempty" json:"subgroup_id,omitempty" xml:"subgroup_id,omitempty"`
+	// Number of members
+	MemberCount *int `form:"member_count,omitempty" json:"member_count,omitempty" xml:"member_count,omitempty"`
+	// Member count per delivery mode; members without one are counted under ""
+	MembersByDeliveryMode map[string]int `form:"members_by_delivery_mode,omitempty" json:"members_by_delivery_mode,omitempty" xml:"members_by_delivery_mode,omitempty"`
+	// Number of bouncing members
+	BounceCount *int `form:"bounce_count,omitempty" json:"bounce_count,omitempty" xml:"bounce_count,omitempty"`
+	// Subgroup creation timestamp
+	CreatedAt *string `form:"created_at,omitempty" json:"created_at,omitempty" xml:"created_at,omitempty"`
+	// Subgroup last update timestamp
+	UpdatedAt *string `form:"updated_at,omitempty" json:"updated_at,omitempty" xml:"updated_at,omitempty"`
+}
+
+// GetGroupsioServiceTreeResponseBody is the type of the "mailing-list" service
+// "get-groupsio-service-tree" endpoint HTTP response body.
+type GetGroupsioServiceTreeResponseBody struct {
+	// The service
+	Service *GroupsioServiceResponseBody `form:"service,omitempty" json:"service,omitempty" xml:"service,omitempty"`
+	// Subgroups of the service
+	MailingLists []*GroupsioServiceTreeSubgroupResponseBody `form:"mailing_lists,omitempty" json:"mailing_lists,omitempty" xml:"mailing_lists,omitempty"`
+}
+
+// GetGroupsioMemberHierarchyResponseBody is the type of the "mailing-list"
+// service "get-groupsio-member-hierarchy" endpoint HTTP response body.
+type GetGroupsioMemberHierarchyResponseBody struct {
+	// The member
+	Member *GroupsioMemberResponseBody `form:"member,omitempty" json:"member,omitempty" xml:"member,omitempty"`
+	// The member's subgroup
+	Subgroup *GroupsioSubgroupResponseBody `form:"subgroup,omitempty" json:"subgroup,omitempty" xml:"subgroup,omitempty"`
+	// The subgroup's service
+	Service *GroupsioServiceResponseBody `form:"service,omitempty" json:"service,omitempty" xml:"service,omitempty"`
+	// The service's project
+	Project *GroupsioMemberHierarchyProjectResponseBody `form:"project,omitempty" json:"project,omitempty" xml:"project,omitempty"`
+	// Why an entity above the member could not be resolved
+	Warnings []string `form:"warnings,omitempty" json:"warnings,omitempty" xml:"warnings,omitempty"`
+}
+
+// ListGroupsioProjectMembersResponseBody is the type of the "mailing-list"
+// service "list-groupsio-project-members" endpoint HTTP response body.
+type ListGroupsioProjectMembersResponseBody struct {
+	// Members on this page
+	Items []*GroupsioMemberResponseBody `form:"items,omitempty" json:"items,omitempty" xml:"items,omitempty"`
+	// Cursor for the next page; omitted on the last page
+	NextCursor *string `form:"next_cursor,omitempty" json:"next_cursor,omitempty" xml:"next_cursor,omitempty"`
+}
+
 // ReadyzServiceUnavailableResponseBody is the type of the "mailing-list"
 // service "readyz" endpoint HTTP response body for the "ServiceUnavailable"
 // error.
@@ -645,6 +941,46 @@ type UpdateGroupsioServiceServiceUnavailableResponseBody struct {
 	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
 }
 
+// PromoteGroupsioServiceBadRequestResponseBody is the type of the
+// "mailing-list" service "promote-groupsio-service" endpoint HTTP response
+// body for the "BadRequest" error.
+type PromoteGroupsioServiceBadRequestResponseBody struct {
+	// Error message
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+}
+
+// PromoteGroupsioServiceConflictResponseBody is the type of the "mailing-list"
+// service "promote-groupsio-service" endpoint HTTP response body for the
+// "Conflict" error.
+type PromoteGroupsioServiceConflictResponseBody struct {
+	// Error message
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+}
+
+// PromoteGroupsioServiceInternalServerErrorResponseBody is the type of the
+// "mailing-list" service "promote-groupsio-service" endpoint HTTP response
+// body for the "InternalServerError" error.
+type PromoteGroupsioServiceInternalServerErrorResponseBody struct {
+	// Error message
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+}
+
+// PromoteGroupsioServiceNotFoundResponseBody is the type of the "mailing-list"
+// service "promote-groupsio-service" endpoint HTTP response body for the
+// "NotFound" error.
+type PromoteGroupsioServiceNotFoundResponseBody struct {
+	// Error message
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+}
+
+// PromoteGroupsioServiceServiceUnavailableResponseBody is the type of the
+// "mailing-list" service "promote-groupsio-service" endpoint HTTP response
+// body for the "ServiceUnavailable" error.
+type PromoteGroupsioServiceServiceUnavailableResponseBody struct {
+	// Error message
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+}
+
 // DeleteGroupsioServiceInternalServerErrorResponseBody is the type of the
 // "mailing-list" service "delete-groupsio-service" endpoint HTTP response body
 // for the "InternalServerError" error.
@@ -685,6 +1021,54 @@ type GetGroupsioServiceProjectsServiceUnavailableResponseBody struct {
 	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
 }
 
+// ListGroupsioServicesByStatusBadRequestResponseBody is the type of the
+// "mailing-list" service "list-groupsio-services-by-status" endpoint HTTP
+// response body for the "BadRequest" error.
+type ListGroupsioServicesByStatusBadRequestResponseBody struct {
+	// Error message
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+}
+
+// ListGroupsioServicesByStatusInternalServerErrorResponseBody is the type of
+// the "mailing-list" service "list-groupsio-services-by-status" endpoint HTTP
+// response body for the "InternalServerError" error.
+type ListGroupsioServicesByStatusInternalServerErrorResponseBody struct {
+	// Error message
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+}
+
+// ListGroupsioServicesByStatusServiceUnavailableResponseBody is the type of
+// the "mailing-list" service "list-groupsio-services-by-status" endpoint HTTP
+// response body for the "ServiceUnavailable" error.
+type ListGroupsioServicesByStatusServiceUnavailableResponseBody struct {
+	// Error message
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+}
+
+// ListUnprovisionedGroupsioServicesBadRequestResponseBody is the type of the
+// "mailing-list" service "list-unprovisioned-groupsio-services" endpoint HTTP
+// response body for the "BadRequest" error.
+type ListUnprovisionedGroupsioServicesBadRequestResponseBody struct {
+	// Error message
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+}
+
+// ListUnprovisionedGroupsioServicesInternalServerErrorResponseBody is the type
+// of the "mailing-list" service "list-unprovisioned-groupsio-services"
+// endpoint HTTP response body for the "InternalServerError" error.
+type ListUnprovisionedGroupsioServicesInternalServerErrorResponseBody struct {
+	// Error message
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+}
+
+// ListUnprovisionedGroupsioServicesServiceUnavailableResponseBody is the type
+// of the "mailing-list" service "list-unprovisioned-groupsio-services"
+// endpoint HTTP response body for the "ServiceUnavailable" error.
+type ListUnprovisionedGroupsioServicesServiceUnavailableResponseBody struct {
+	// Error message
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+}
+
 // FindParentGroupsioServiceBadRequestResponseBody is the type of the
 // "mailing-list" service "find-parent-groupsio-service" endpoint HTTP response
 // body for the "BadRequest" error.
@@ -741,6 +1125,58 @@ type ListGroupsioMailingListsServiceUnavailableResponseBody struct {
 	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
 }
 
+// ListGroupsioMailingListsByVisibilityBadRequestResponseBody is the type of
+// the "mailing-list" service "list-groupsio-mailing-lists-by-visibility"
+// endpoint HTTP response body for the "BadRequest" error.
+type ListGroupsioMailingListsByVisibilityBadRequestResponseBody struct {
+	// Error message
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+}
+
+// ListGroupsioMailingListsByVisibilityInternalServerErrorResponseBody is the
+// type of the "mailing-list" service
+// "list-groupsio-mailing-lists-by-visibility" endpoint HTTP response body for
+// the "InternalServerError" error.
+type ListGroupsioMailingListsByVisibilityInternalServerErrorResponseBody struct {
+	// Error message
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+}
+
+// ListGroupsioMailingListsByVisibilityServiceUnavailableResponseBody is the
+// type of the "mailing-list" service
+// "list-groupsio-mailing-lists-by-visibility" endpoint HTTP response body for
+// the "ServiceUnavailable" error.
+type ListGroupsioMailingListsByVisibilityServiceUnavailableResponseBody struct {
+	// Error message
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+}
+
+// ListGroupsioMailingListsUpdatedSinceBadRequestResponseBody is the type of
+// the "mailing-list" service "list-groupsio-mailing-lists-updated-since"
+// endpoint HTTP response body for the "BadRequest" error.
+type ListGroupsioMailingListsUpdatedSinceBadRequestResponseBody struct {
+	// Error message
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+}
+
+// ListGroupsioMailingListsUpdatedSinceInternalServerErrorResponseBody is the
+// type of the "mailing-list" service
+// "list-groupsio-mailing-lists-updated-since" endpoint HTTP response body for
+// the "InternalServerError" error.
+type ListGroupsioMailingListsUpdatedSinceInternalServerErrorResponseBody struct {
+	// Error message
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+}
+
+// ListGroupsioMailingListsUpdatedSinceServiceUnavailableResponseBody is the
+// type of the "mailing-list" service
+// "list-groupsio-mailing-lists-updated-since" endpoint HTTP response body for
+// the "ServiceUnavailable" error.
+type ListGroupsioMailingListsUpdatedSinceServiceUnavailableResponseBody struct {
+	// Error message
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+}
+
 // CreateGroupsioMailingListBadRequestResponseBody is the type of the
 // "mailing-list" service "create-groupsio-mailing-list" endpoint HTTP response
 // body for the "BadRequest" error.
@@ -925,80 +1361,272 @@ type ListGroupsioMembersServiceUnavailableResponseBody struct {
 	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
 }
 
-// AddGroupsioMemberBadRequestResponseBody is the type of the "mailing-list"
-// service "add-groupsio-member" endpoint HTTP response body for the
-// "BadRequest" error.
-type AddGroupsioMemberBadRequestResponseBody struct {
+// ListGroupsioMembersNeedingReviewBadRequestResponseBody is the type of the
+// "mailing-list" service "list-groupsio-members-needing-review" endpoint HTTP
+// response body for the "BadRequest" error.
+type ListGroupsioMembersNeedingReviewBadRequestResponseBody struct {
 	// Error message
 	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
 }
 
-// AddGroupsioMemberConflictResponseBody is the type of the "mailing-list"
-// service "add-groupsio-member" endpoint HTTP response body for the "Conflict"
-// error.
-type AddGroupsioMemberConflictResponseBody struct {
+// ListGroupsioMembersNeedingReviewInternalServerErrorResponseBody is the type
+// of the "mailing-list" service "list-groupsio-members-needing-review"
+// endpoint HTTP response body for the "InternalServerError" error.
+type ListGroupsioMembersNeedingReviewInternalServerErrorResponseBody struct {
 	// Error message
 	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
 }
 
-// AddGroupsioMemberInternalServerErrorResponseBody is the type of the
-// "mailing-list" service "add-groupsio-member" endpoint HTTP response body for
-// the "InternalServerError" error.
-type AddGroupsioMemberInternalServerErrorResponseBody struct {
+// ListGroupsioMembersNeedingReviewNotFoundResponseBody is the type of the
+// "mailing-list" service "list-groupsio-members-needing-review" endpoint HTTP
+// response body for the "NotFound" error.
+type ListGroupsioMembersNeedingReviewNotFoundResponseBody struct {
 	// Error message
 	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
 }
 
-// AddGroupsioMemberNotFoundResponseBody is the type of the "mailing-list"
-// service "add-groupsio-member" endpoint HTTP response body for the "NotFound"
-// error.
-type AddGroupsioMemberNotFoundResponseBody struct {
+// ListGroupsioMembersNeedingReviewServiceUnavailableResponseBody is the type
+// of the "mailing-list" service "list-groupsio-members-needing-review"
+// endpoint HTTP response body for the "ServiceUnavailable" error.
+type ListGroupsioMembersNeedingReviewServiceUnavailableResponseBody struct {
 	// Error message
 	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
 }
 
-// AddGroupsioMemberServiceUnavailableResponseBody is the type of the
-// "mailing-list" service "add-groupsio-member" endpoint HTTP response body for
-// the "ServiceUnavailable" error.
-type AddGroupsioMemberServiceUnavailableResponseBody struct {
+// ListGroupsioMembersModifiedSinceBadRequestResponseBody is the type of the
+// "mailing-list" service "list-groupsio-members-modified-since" endpoint HTTP
+// response body for the "BadRequest" error.
+type ListGroupsioMembersModifiedSinceBadRequestResponseBody struct {
 	// Error message
 	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
 }
 
-// GetGroupsioMemberInternalServerErrorResponseBody is the type of the
-// "mailing-list" service "get-groupsio-member" endpoint HTTP response body for
-// the "InternalServerError" error.
-type GetGroupsioMemberInternalServerErrorResponseBody struct {
+// ListGroupsioMembersModifiedSinceInternalServerErrorResponseBody is the type
+// of the "mailing-list" service "list-groupsio-members-modified-since"
+// endpoint HTTP response body for the "InternalServerError" error.
+type ListGroupsioMembersModifiedSinceInternalServerErrorResponseBody struct {
 	// Error message
 	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
 }
 
-// GetGroupsioMemberNotFoundResponseBody is the type of the "mailing-list"
-// service "get-groupsio-member" endpoint HTTP response body for the "NotFound"
-// error.
-type GetGroupsioMemberNotFoundResponseBody struct {
+// ListGroupsioMembersModifiedSinceNotFoundResponseBody is the type of the
+// "mailing-list" service "list-groupsio-members-modified-since" endpoint HTTP
+// response body for the "NotFound" error.
+type ListGroupsioMembersModifiedSinceNotFoundResponseBody struct {
 	// Error message
 	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
 }
 
-// GetGroupsioMemberServiceUnavailableResponseBody is the type of the
-// "mailing-list" service "get-groupsio-member" endpoint HTTP response body for
-// the "ServiceUnavailable" error.
-type GetGroupsioMemberServiceUnavailableResponseBody struct {
+// ListGroupsioMembersModifiedSinceServiceUnavailableResponseBody is the type
+// of the "mailing-list" service "list-groupsio-members-modified-since"
+// endpoint HTTP response body for the "ServiceUnavailable" error.
+type ListGroupsioMembersModifiedSinceServiceUnavailableResponseBody struct {
 	// Error message
 	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
 }
 
-// UpdateGroupsioMemberBadRequestResponseBody is the type of the "mailing-list"
-// service "update-groupsio-member" endpoint HTTP response body for the
-// "BadRequest" error.
-type UpdateGroupsioMemberBadRequestResponseBody struct {
+// ListGroupsioMembersByOrganizationBadRequestResponseBody is the type of the
+// "mailing-list" service "list-groupsio-members-by-organization" endpoint HTTP
+// response body for the "BadRequest" error.
+type ListGroupsioMembersByOrganizationBadRequestResponseBody struct {
 	// Error message
 	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
 }
 
-// UpdateGroupsioMemberInternalServerErrorResponseBody is the type of the
-// "mailing-list" service "update-groupsio-member" endpoint HTTP response body
+// ListGroupsioMembersByOrganizationInternalServerErrorResponseBody is the type
+// of the "mailing-list" service "list-groupsio-members-by-organization"
+// endpoint HTTP response body for the "InternalServerError" error.
+type ListGroupsioMembersByOrganizationInternalServerErrorResponseBody struct {
+	// Error message
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+}
+
+// ListGroupsioMembersByOrganizationNotFoundResponseBody is the type of the
+// "mailing-list" service "list-groupsio-members-by-organization" endpoint HTTP
+// response body for the "NotFound" error.
+type ListGroupsioMembersByOrganizationNotFoundResponseBody struct {
+	// Error message
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+}
+
+// ListGroupsioMembersByOrganizationServiceUnavailableResponseBody is the type
+// of the "mailing-list" service "list-groupsio-members-by-organization"
+// endpoint HTTP response body for the "ServiceUnavailable" error.
+type ListGroupsioMembersByOrganizationServiceUnavailableResponseBody struct {
+	// Error message
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+}
+
+// ListGroupsioDuplicateMembersInternalServerErrorResponseBody is the type of
+// the "mailing-list" service "list-groupsio-duplicate-members" endpoint HTTP
+// response body for the "InternalServerError" error.
+type ListGroupsioDuplicateMembersInternalServerErrorResponseBody struct {
+	// Error message
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+}
+
+// ListGroupsioDuplicateMembersNotFoundResponseBody is the type of the
+// "mailing-list" service "list-groupsio-duplicate-members" endpoint HTTP
+// response body for the "NotFound" error.
+type ListGroupsioDuplicateMembersNotFoundResponseBody struct {
+	// Error message
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+}
+
+// ListGroupsioDuplicateMembersServiceUnavailableResponseBody is the type of
+// the "mailing-list" service "list-groupsio-duplicate-members" endpoint HTTP
+// response body for the "ServiceUnavailable" error.
+type ListGroupsioDuplicateMembersServiceUnavailableResponseBody struct {
+	// Error message
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+}
+
+// PreviewGroupsioDeliveryModeChangeBadRequestResponseBody is the type of the
+// "mailing-list" service "preview-groupsio-delivery-mode-change" endpoint HTTP
+// response body for the "BadRequest" error.
+type PreviewGroupsioDeliveryModeChangeBadRequestResponseBody struct {
+	// Error message
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+}
+
+// PreviewGroupsioDeliveryModeChangeInternalServerErrorResponseBody is the type
+// of the "mailing-list" service "preview-groupsio-delivery-mode-change"
+// endpoint HTTP response body for the "InternalServerError" error.
+type PreviewGroupsioDeliveryModeChangeInternalServerErrorResponseBody struct {
+	// Error message
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+}
+
+// PreviewGroupsioDeliveryModeChangeNotFoundResponseBody is the type of the
+// "mailing-list" service "preview-groupsio-delivery-mode-change" endpoint HTTP
+// response body for the "NotFound" error.
+type PreviewGroupsioDeliveryModeChangeNotFoundResponseBody struct {
+	// Error message
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+}
+
+// PreviewGroupsioDeliveryModeChangeServiceUnavailableResponseBody is the type
+// of the "mailing-list" service "preview-groupsio-delivery-mode-change"
+// endpoint HTTP response body for the "ServiceUnavailable" error.
+type PreviewGroupsioDeliveryModeChangeServiceUnavailableResponseBody struct {
+	// Error message
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+}
+
+// AddGroupsioMemberBadRequestResponseBody is the type of the "mailing-list"
+// service "add-groupsio-member" endpoint HTTP response body for the
+// "BadRequest" error.
+type AddGroupsioMemberBadRequestResponseBody struct {
+	// Error message
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+}
+
+// AddGroupsioMemberConflictResponseBody is the type of the "mailing-list"
+// service "add-groupsio-member" endpoint HTTP response body for the "Conflict"
+// error.
+type AddGroupsioMemberConflictResponseBody struct {
+	// Error message
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+}
+
+// AddGroupsioMemberInternalServerErrorResponseBody is the type of the
+// "mailing-list" service "add-groupsio-member" endpoint HTTP response body for
+// the "InternalServerError" error.
+type AddGroupsioMemberInternalServerErrorResponseBody struct {
+	// Error message
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+}
+
+// AddGroupsioMemberNotFoundResponseBody is the type of the "mailing-list"
+// service "add-groupsio-member" endpoint HTTP response body for the "NotFound"
+// error.
+type AddGroupsioMemberNotFoundResponseBody struct {
+	// Error message
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+}
+
+// AddGroupsioMemberServiceUnavailableResponseBody is the type of the
+// "mailing-list" service "add-groupsio-member" endpoint HTTP response body for
+// the "ServiceUnavailable" error.
+type AddGroupsioMemberServiceUnavailableResponseBody struct {
+	// Error message
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+}
+
+// UpsertGroupsioMemberBadRequestResponseBody is the type of the "mailing-list"
+// service "upsert-groupsio-member" endpoint HTTP response body for the
+// "BadRequest" error.
+type UpsertGroupsioMemberBadRequestResponseBody struct {
+	// Error message
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+}
+
+// UpsertGroupsioMemberConflictResponseBody is the type of the "mailing-list"
+// service "upsert-groupsio-member" endpoint HTTP response body for the
+// "Conflict" error.
+type UpsertGroupsioMemberConflictResponseBody struct {
+	// Error message
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+}
+
+// UpsertGroupsioMemberInternalServerErrorResponseBody is the type of the
+// "mailing-list" service "upsert-groupsio-member" endpoint HTTP response body
+// for the "InternalServerError" error.
+type UpsertGroupsioMemberInternalServerErrorResponseBody struct {
+	// Error message
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+}
+
+// UpsertGroupsioMemberNotFoundResponseBody is the type of the "mailing-list"
+// service "upsert-groupsio-member" endpoint HTTP response body for the
+// "NotFound" error.
+type UpsertGroupsioMemberNotFoundResponseBody struct {
+	// Error message
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+}
+
+// UpsertGroupsioMemberServiceUnavailableResponseBody is the type of the
+// "mailing-list" service "upsert-groupsio-member" endpoint HTTP response body
+// for the "ServiceUnavailable" error.
+type UpsertGroupsioMemberServiceUnavailableResponseBody struct {
+	// Error message
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+}
+
+// GetGroupsioMemberInternalServerErrorResponseBody is the type of the
+// "mailing-list" service "get-groupsio-member" endpoint HTTP response body for
+// the "InternalServerError" error.
+type GetGroupsioMemberInternalServerErrorResponseBody struct {
+	// Error message
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+}
+
+// GetGroupsioMemberNotFoundResponseBody is the type of the "mailing-list"
+// service "get-groupsio-member" endpoint HTTP response body for the "NotFound"
+// error.
+type GetGroupsioMemberNotFoundResponseBody struct {
+	// Error message
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+}
+
+// GetGroupsioMemberServiceUnavailableResponseBody is the type of the
+// "mailing-list" service "get-groupsio-member" endpoint HTTP response body for
+// the "ServiceUnavailable" error.
+type GetGroupsioMemberServiceUnavailableResponseBody struct {
+	// Error message
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+}
+
+// UpdateGroupsioMemberBadRequestResponseBody is the type of the "mailing-list"
+// service "update-groupsio-member" endpoint HTTP response body for the
+// "BadRequest" error.
+type UpdateGroupsioMemberBadRequestResponseBody struct {
+	// Error message
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+}
+
+// UpdateGroupsioMemberInternalServerErrorResponseBody is the type of the
+// "mailing-list" service "update-groupsio-member" endpoint HTTP response body
 // for the "InternalServerError" error.
 type UpdateGroupsioMemberInternalServerErrorResponseBody struct {
 	// Error message
@@ -1077,6 +1705,118 @@ type InviteGroupsioMembersServiceUnavailableResponseBody struct {
 	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
 }
 
+// ImportGroupsioMembersBadRequestResponseBody is the type of the
+// "mailing-list" service "import-groupsio-members" endpoint HTTP response body
+// for the "BadRequest" error.
+type ImportGroupsioMembersBadRequestResponseBody struct {
+	// Error message
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+}
+
+// ImportGroupsioMembersConflictResponseBody is the type of the "mailing-list"
+// service "import-groupsio-members" endpoint HTTP response body for the
+// "Conflict" error.
+type ImportGroupsioMembersConflictResponseBody struct {
+	// Error message
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+}
+
+// ImportGroupsioMembersInternalServerErrorResponseBody is the type of the
+// "mailing-list" service "import-groupsio-members" endpoint HTTP response body
+// for the "InternalServerError" error.
+type ImportGroupsioMembersInternalServerErrorResponseBody struct {
+	// Error message
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+}
+
+// ImportGroupsioMembersNotFoundResponseBody is the type of the "mailing-list"
+// service "import-groupsio-members" endpoint HTTP response body for the
+// "NotFound" error.
+type ImportGroupsioMembersNotFoundResponseBody struct {
+	// Error message
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+}
+
+// ImportGroupsioMembersServiceUnavailableResponseBody is the type of the
+// "mailing-list" service "import-groupsio-members" endpoint HTTP response body
+// for the "ServiceUnavailable" error.
+type ImportGroupsioMembersServiceUnavailableResponseBody struct {
+	// Error message
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+}
+
+// MergeGroupsioMembersBadRequestResponseBody is the type of the "mailing-list"
+// service "merge-groupsio-members" endpoint HTTP response body for the
+// "BadRequest" error.
+type MergeGroupsioMembersBadRequestResponseBody struct {
+	// Error message
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+}
+
+// MergeGroupsioMembersInternalServerErrorResponseBody is the type of the
+// "mailing-list" service "merge-groupsio-members" endpoint HTTP response body
+// for the "InternalServerError" error.
+type MergeGroupsioMembersInternalServerErrorResponseBody struct {
+	// Error message
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+}
+
+// MergeGroupsioMembersNotFoundResponseBody is the type of the "mailing-list"
+// service "merge-groupsio-members" endpoint HTTP response body for the
+// "NotFound" error.
+type MergeGroupsioMembersNotFoundResponseBody struct {
+	// Error message
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+}
+
+// MergeGroupsioMembersServiceUnavailableResponseBody is the type of the
+// "mailing-list" service "merge-groupsio-members" endpoint HTTP response body
+// for the "ServiceUnavailable" error.
+type MergeGroupsioMembersServiceUnavailableResponseBody struct {
+	// Error message
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+}
+
+// ChangeGroupsioMemberEmailBadRequestResponseBody is the type of the
+// "mailing-list" service "change-groupsio-member-email" endpoint HTTP response
+// body for the "BadRequest" error.
+type ChangeGroupsioMemberEmailBadRequestResponseBody struct {
+	// Error message
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+}
+
+// ChangeGroupsioMemberEmailConflictResponseBody is the type of the
+// "mailing-list" service "change-groupsio-member-email" endpoint HTTP response
+// body for the "Conflict" error.
+type ChangeGroupsioMemberEmailConflictResponseBody struct {
+	// Error message
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+}
+
+// ChangeGroupsioMemberEmailInternalServerErrorResponseBody is the type of the
+// "mailing-list" service "change-groupsio-member-email" endpoint HTTP response
+// body for the "InternalServerError" error.
+type ChangeGroupsioMemberEmailInternalServerErrorResponseBody struct {
+	// Error message
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+}
+
+// ChangeGroupsioMemberEmailNotFoundResponseBody is the type of the
+// "mailing-list" service "change-groupsio-member-email" endpoint HTTP response
+// body for the "NotFound" error.
+type ChangeGroupsioMemberEmailNotFoundResponseBody struct {
+	// Error message
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+}
+
+// ChangeGroupsioMemberEmailServiceUnavailableResponseBody is the type of the
+// "mailing-list" service "change-groupsio-member-email" endpoint HTTP response
+// body for the "ServiceUnavailable" error.
+type ChangeGroupsioMemberEmailServiceUnavailableResponseBody struct {
+	// Error message
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+}
+
 // CheckGroupsioSubscriberBadRequestResponseBody is the type of the
 // "mailing-list" service "check-groupsio-subscriber" endpoint HTTP response
 // body for the "BadRequest" error.
@@ -1149,42 +1889,210 @@ type GetGroupsioArtifactDownloadServiceUnavailableResponseBody struct {
 	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
 }
 
-// GroupsioServiceResponseBody is used to define fields on response body types.
-type GroupsioServiceResponseBody struct {
-	// Service ID
-	ID *string `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
-	// LFX v2 project UID
-	ProjectUID *string `form:"project_uid,omitempty" json:"project_uid,omitempty" xml:"project_uid,omitempty"`
-	// Service type
-	Type *string `form:"type,omitempty" json:"type,omitempty" xml:"type,omitempty"`
-	// GroupsIO group ID
-	GroupID *int64 `form:"group_id,omitempty" json:"group_id,omitempty" xml:"group_id,omitempty"`
-	// Service domain
-	Domain *string `form:"domain,omitempty" json:"domain,omitempty" xml:"domain,omitempty"`
-	// Email prefix
-	Prefix *string `form:"prefix,omitempty" json:"prefix,omitempty" xml:"prefix,omitempty"`
-	// Service status
-	Status *string `form:"status,omitempty" json:"status,omitempty" xml:"status,omitempty"`
-	// Creation timestamp
-	CreatedAt *string `form:"created_at,omitempty" json:"created_at,omitempty" xml:"created_at,omitempty"`
-	// Last update timestamp
-	UpdatedAt *string `form:"updated_at,omitempty" json:"updated_at,omitempty" xml:"updated_at,omitempty"`
+// GetGroupsioProjectSummaryBadRequestResponseBody is the type of the
+// "mailing-list" service "get-groupsio-project-summary" endpoint HTTP response
+// body for the "BadRequest" error.
+type GetGroupsioProjectSummaryBadRequestResponseBody struct {
+	// Error message
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
 }
 
-// GroupsioSubgroupResponseBody is used to define fields on response body types.
-type GroupsioSubgroupResponseBody struct {
-	// Subgroup ID
-	ID *string `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
-	// LFX v2 project UID
-	ProjectUID *string `form:"project_uid,omitempty" json:"project_uid,omitempty" xml:"project_uid,omitempty"`
-	// LFX v2 committee UID
-	CommitteeUID *string `form:"committee_uid,omitempty" json:"committee_uid,omitempty" xml:"committee_uid,omitempty"`
-	// Parent GroupsIO service ID
-	ServiceID *string `form:"service_id,omitempty" json:"service_id,omitempty" xml:"service_id,omitempty"`
-	// GroupsIO group ID
-	GroupID *int64 `form:"group_id,omitempty" json:"group_id,omitempty" xml:"group_id,omitempty"`
-	// Subgroup name
-	Name *string `form:"name,omitempty" json:"name,omitempty" xml:"name,omitempty"`
+// GetGroupsioProjectSummaryInternalServerErrorResponseBody is the type of the
+// "mailing-list" service "get-groupsio-project-summary" endpoint HTTP response
+// body for the "InternalServerError" error.
+type GetGroupsioProjectSummaryInternalServerErrorResponseBody struct {
+	// Error message
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+}
+
+// GetGroupsioProjectSummaryServiceUnavailableResponseBody is the type of the
+// "mailing-list" service "get-groupsio-project-summary" endpoint HTTP response
+// body for the "ServiceUnavailable" error.
+type GetGroupsioProjectSummaryServiceUnavailableResponseBody struct {
+	// Error message
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+}
+
+// ListGroupsioOrphanedMailingListsBadRequestResponseBody is the type of the
+// "mailing-list" service "list-groupsio-orphaned-mailing-lists" endpoint HTTP
+// response body for the "BadRequest" error.
+type ListGroupsioOrphanedMailingListsBadRequestResponseBody struct {
+	// Error message
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+}
+
+// ListGroupsioOrphanedMailingListsInternalServerErrorResponseBody is the type
+// of the "mailing-list" service "list-groupsio-orphaned-mailing-lists"
+// endpoint HTTP response body for the "InternalServerError" error.
+type ListGroupsioOrphanedMailingListsInternalServerErrorResponseBody struct {
+	// Error message
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+}
+
+// ListGroupsioOrphanedMailingListsServiceUnavailableResponseBody is the type
+// of the "mailing-list" service "list-groupsio-orphaned-mailing-lists"
+// endpoint HTTP response body for the "ServiceUnavailable" error.
+type ListGroupsioOrphanedMailingListsServiceUnavailableResponseBody struct {
+	// Error message
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+}
+
+// RepublishGroupsioProjectIndexBadRequestResponseBody is the type of the
+// "mailing-list" service "republish-groupsio-project-index" endpoint HTTP
+// response body for the "BadRequest" error.
+type RepublishGroupsioProjectIndexBadRequestResponseBody struct {
+	// Error message
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+}
+
+// RepublishGroupsioProjectIndexInternalServerErrorResponseBody is the type of
+// the "mailing-list" service "republish-groupsio-project-index" endpoint HTTP
+// response body for the "InternalServerError" error.
+type RepublishGroupsioProjectIndexInternalServerErrorResponseBody struct {
+	// Error message
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+}
+
+// RepublishGroupsioProjectIndexServiceUnavailableResponseBody is the type of
+// the "mailing-list" service "republish-groupsio-project-index" endpoint HTTP
+// response body for the "ServiceUnavailable" error.
+type RepublishGroupsioProjectIndexServiceUnavailableResponseBody struct {
+	// Error message
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+}
+
+// GetGroupsioMailingListStatsInternalServerErrorResponseBody is the type of
+// the "mailing-list" service "get-groupsio-mailing-list-stats" endpoint HTTP
+// response body for the "InternalServerError" error.
+type GetGroupsioMailingListStatsInternalServerErrorResponseBody struct {
+	// Error message
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+}
+
+// GetGroupsioMailingListStatsNotFoundResponseBody is the type of the
+// "mailing-list" service "get-groupsio-mailing-list-stats" endpoint HTTP
+// response body for the "NotFound" error.
+type GetGroupsioMailingListStatsNotFoundResponseBody struct {
+	// Error message
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+}
+
+// GetGroupsioMailingListStatsServiceUnavailableResponseBody is the type of the
+// "mailing-list" service "get-groupsio-mailing-list-stats" endpoint HTTP
+// response body for the "ServiceUnavailable" error.
+type GetGroupsioMailingListStatsServiceUnavailableResponseBody struct {
+	// Error message
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+}
+
+// GetGroupsioServiceTreeInternalServerErrorResponseBody is the type of the
+// "mailing-list" service "get-groupsio-service-tree" endpoint HTTP response
+// body for the "InternalServerError" error.
+type GetGroupsioServiceTreeInternalServerErrorResponseBody struct {
+	// Error message
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+}
+
+// GetGroupsioServiceTreeNotFoundResponseBody is the type of the "mailing-list"
+// service "get-groupsio-service-tree" endpoint HTTP response body for the
+// "NotFound" error.
+type GetGroupsioServiceTreeNotFoundResponseBody struct {
+	// Error message
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+}
+
+// GetGroupsioServiceTreeServiceUnavailableResponseBody is the type of the
+// "mailing-list" service "get-groupsio-service-tree" endpoint HTTP response
+// body for the "ServiceUnavailable" error.
+type GetGroupsioServiceTreeServiceUnavailableResponseBody struct {
+	// Error message
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+}
+
+// GetGroupsioMemberHierarchyInternalServerErrorResponseBody is the type of the
+// "mailing-list" service "get-groupsio-member-hierarchy" endpoint HTTP
+// response body for the "InternalServerError" error.
+type GetGroupsioMemberHierarchyInternalServerErrorResponseBody struct {
+	// Error message
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+}
+
+// GetGroupsioMemberHierarchyNotFoundResponseBody is the type of the
+// "mailing-list" service "get-groupsio-member-hierarchy" endpoint HTTP
+// response body for the "NotFound" error.
+type GetGroupsioMemberHierarchyNotFoundResponseBody struct {
+	// Error message
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+}
+
+// GetGroupsioMemberHierarchyServiceUnavailableResponseBody is the type of the
+// "mailing-list" service "get-groupsio-member-hierarchy" endpoint HTTP
+// response body for the "ServiceUnavailable" error.
+type GetGroupsioMemberHierarchyServiceUnavailableResponseBody struct {
+	// Error message
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+}
+
+// ListGroupsioProjectMembersBadRequestResponseBody is the type of the
+// "mailing-list" service "list-groupsio-project-members" endpoint HTTP
+// response body for the "BadRequest" error.
+type ListGroupsioProjectMembersBadRequestResponseBody struct {
+	// Error message
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+}
+
+// ListGroupsioProjectMembersInternalServerErrorResponseBody is the type of the
+// "mailing-list" service "list-groupsio-project-members" endpoint HTTP
+// response body for the "InternalServerError" error.
+type ListGroupsioProjectMembersInternalServerErrorResponseBody struct {
+	// Error message
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+}
+
+// ListGroupsioProjectMembersServiceUnavailableResponseBody is the type of the
+// "mailing-list" service "list-groupsio-project-members" endpoint HTTP
+// response body for the "ServiceUnavailable" error.
+type ListGroupsioProjectMembersServiceUnavailableResponseBody struct {
+	// Error message
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+}
+
+// GroupsioServiceResponseBody is used to define fields on response body types.
+type GroupsioServiceResponseBody struct {
+	// Service ID
+	ID *string `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
+	// LFX v2 project UID
+	ProjectUID *string `form:"project_uid,omitempty" json:"project_uid,omitempty" xml:"project_uid,omitempty"`
+	// Service type
+	Type *string `form:"type,omitempty" json:"type,omitempty" xml:"type,omitempty"`
+	// GroupsIO group ID
+	GroupID *int64 `form:"group_id,omitempty" json:"group_id,omitempty" xml:"group_id,omitempty"`
+	// Service domain
+	Domain *string `form:"domain,omitempty" json:"domain,omitempty" xml:"domain,omitempty"`
+	// Email prefix
+	Prefix *string `form:"prefix,omitempty" json:"prefix,omitempty" xml:"prefix,omitempty"`
+	// Service status
+	Status *string `form:"status,omitempty" json:"status,omitempty" xml:"status,omitempty"`
+	// Creation timestamp
+	CreatedAt *string `form:"created_at,omitempty" json:"created_at,omitempty" xml:"created_at,omitempty"`
+	// Last update timestamp
+	UpdatedAt *string `form:"updated_at,omitempty" json:"updated_at,omitempty" xml:"updated_at,omitempty"`
+}
+
+// GroupsioSubgroupResponseBody is used to define fields on response body types.
+type GroupsioSubgroupResponseBody struct {
+	// Subgroup ID
+	ID *string `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
+	// LFX v2 project UID
+	ProjectUID *string `form:"project_uid,omitempty" json:"project_uid,omitempty" xml:"project_uid,omitempty"`
+	// LFX v2 committee UID
+	CommitteeUID *string `form:"committee_uid,omitempty" json:"committee_uid,omitempty" xml:"committee_uid,omitempty"`
+	// Parent GroupsIO service ID
+	ServiceID *string `form:"service_id,omitempty" json:"service_id,omitempty" xml:"service_id,omitempty"`
+	// GroupsIO group ID
+	GroupID *int64 `form:"group_id,omitempty" json:"group_id,omitempty" xml:"group_id,omitempty"`
+	// Subgroup name
+	Name *string `form:"name,omitempty" json:"name,omitempty" xml:"name,omitempty"`
 	// Subgroup description
 	Description *string `form:"description,omitempty" json:"description,omitempty" xml:"description,omitempty"`
 	// Subgroup type
@@ -1195,6 +2103,8 @@ type GroupsioSubgroupResponseBody struct {
 	CreatedAt *string `form:"created_at,omitempty" json:"created_at,omitempty" xml:"created_at,omitempty"`
 	// Last update timestamp
 	UpdatedAt *string `form:"updated_at,omitempty" json:"updated_at,omitempty" xml:"updated_at,omitempty"`
+	// Best-effort follow-up steps that failed after the subgroup was saved
+	Warnings []string `form:"warnings,omitempty" json:"warnings,omitempty" xml:"warnings,omitempty"`
 }
 
 // GroupsioMemberResponseBody is used to define fields on response body types.
@@ -1229,6 +2139,25 @@ type GroupsioMemberResponseBody struct {
 	UpdatedAt *string `form:"updated_at,omitempty" json:"updated_at,omitempty" xml:"updated_at,omitempty"`
 }
 
+// GroupsioMemberRequestRequestBody is used to define fields on request body
+// types.
+type GroupsioMemberRequestRequestBody struct {
+	// Member email address
+	Email *string `form:"email,omitempty" json:"email,omitempty" xml:"email,omitempty"`
+	// Member display name
+	Name *string `form:"name,omitempty" json:"name,omitempty" xml:"name,omitempty"`
+	// Member type; only 'direct' is accepted for API-managed members
+	MemberType *string `form:"member_type,omitempty" json:"member_type,omitempty" xml:"member_type,omitempty"`
+	// Moderation status
+	ModStatus *string `form:"mod_status,omitempty" json:"mod_status,omitempty" xml:"mod_status,omitempty"`
+	// Email delivery mode
+	DeliveryMode *string `form:"delivery_mode,omitempty" json:"delivery_mode,omitempty" xml:"delivery_mode,omitempty"`
+	// Member organization
+	Organization *string `form:"organization,omitempty" json:"organization,omitempty" xml:"organization,omitempty"`
+	// Member job title
+	JobTitle *string `form:"job_title,omitempty" json:"job_title,omitempty" xml:"job_title,omitempty"`
+}
+
 // GroupsioArtifactUserResponseBody is used to define fields on response body
 // types.
 type GroupsioArtifactUserResponseBody struct {
@@ -1244,6 +2173,26 @@ type GroupsioArtifactUserResponseBody struct {
 	ProfilePicture *string `form:"profile_picture,omitempty" json:"profile_picture,omitempty" xml:"profile_picture,omitempty"`
 }
 
+// GroupsioServiceTreeSubgroupResponseBody is used to define fields on response
+// body types.
+type GroupsioServiceTreeSubgroupResponseBody struct {
+	// The subgroup
+	Subgroup *GroupsioSubgroupResponseBody `form:"subgroup,omitempty" json:"subgroup,omitempty" xml:"subgroup,omitempty"`
+	// Number of members; omitted unless member counts were requested
+	MemberCount *int `form:"member_count,omitempty" json:"member_count,omitempty" xml:"member_count,omitempty"`
+}
+
+// GroupsioMemberHierarchyProjectResponseBody is used to define fields on
+// response body types.
+type GroupsioMemberHierarchyProjectResponseBody struct {
+	// LFX v2 project UID
+	UID *string `form:"uid,omitempty" json:"uid,omitempty" xml:"uid,omitempty"`
+	// Project name
+	Name *string `form:"name,omitempty" json:"name,omitempty" xml:"name,omitempty"`
+	// Project slug
+	Slug *string `form:"slug,omitempty" json:"slug,omitempty" xml:"slug,omitempty"`
+}
+
 // NewCreateGroupsioServiceRequestBody builds the HTTP request body from the
 // payload of the "create-groupsio-service" endpoint of the "mailing-list"
 // service.
@@ -1323,11 +2272,11 @@ func NewAddGroupsioMemberRequestBody(p *mailinglist.AddGroupsioMemberPayload) *A
 	return body
 }
 
-// NewUpdateGroupsioMemberRequestBody builds the HTTP request body from the
-// payload of the "update-groupsio-member" endpoint of the "mailing-list"
+// NewUpsertGroupsioMemberRequestBody builds the HTTP request body from the
+// payload of the "upsert-groupsio-member" endpoint of the "mailing-list"
 // service.
-func NewUpdateGroupsioMemberRequestBody(p *mailinglist.UpdateGroupsioMemberPayload) *UpdateGroupsioMemberRequestBody {
-	body := &UpdateGroupsioMemberRequestBody{
+func NewUpsertGroupsioMemberRequestBody(p *mailinglist.UpsertGroupsioMemberPayload) *UpsertGroupsioMemberRequestBody {
+	body := &UpsertGroupsioMemberRequestBody{
 		Email:        p.Email,
 		Name:         p.Name,
 		MemberType:   p.MemberType,
@@ -1339,6 +2288,23 @@ func NewUpdateGroupsioMemberRequestBody(p *mailinglist.UpdateGroupsioMemberPaylo
 	return body
 }
 
+// NewUpdateGroupsioMemberRequestBody builds the HTTP request body from the
+// payload of the "update-groupsio-member" endpoint of the "mailing-list"
+// service.
+func NewUpdateGroupsioMemberRequestBody(p *mailinglist.UpdateGroupsioMemberPayload) *UpdateGroupsioMemberRequestBody {
+	body := &UpdateGroupsioMemberRequestBody{
+		MailingListUID: p.MailingListUID,
+		Email:          p.Email,
+		Name:           p.Name,
+		MemberType:     p.MemberType,
+		ModStatus:      p.ModStatus,
+		DeliveryMode:   p.DeliveryMode,
+		Organization:   p.Organization,
+		JobTitle:       p.JobTitle,
+	}
+	return body
+}
+
 // NewInviteGroupsioMembersRequestBody builds the HTTP request body from the
 // payload of the "invite-groupsio-members" endpoint of the "mailing-list"
 // service.
@@ -1355,6 +2321,50 @@ func NewInviteGroupsioMembersRequestBody(p *mailinglist.InviteGroupsioMembersPay
 	return body
 }
 
+// NewImportGroupsioMembersRequestBody builds the HTTP request body from the
+// payload of the "import-groupsio-members" endpoint of the "mailing-list"
+// service.
+func NewImportGroupsioMembersRequestBody(p *mailinglist.ImportGroupsioMembersPayload) *ImportGroupsioMembersRequestBody {
+	body := &ImportGroupsioMembersRequestBody{}
+	if p.Members != nil {
+		body.Members = make([]*GroupsioMemberRequestRequestBody, len(p.Members))
+		for i, val := range p.Members {
+			body.Members[i] = marshalMailinglistGroupsioMemberRequestToGroupsioMemberRequestRequestBody(val)
+		}
+	} else {
+		body.Members = []*GroupsioMemberRequestRequestBody{}
+	}
+	return body
+}
+
+// NewMergeGroupsioMembersRequestBody builds the HTTP request body from the
+// payload of the "merge-groupsio-members" endpoint of the "mailing-list"
+// service.
+func NewMergeGroupsioMembersRequestBody(p *mailinglist.MergeGroupsioMembersPayload) *MergeGroupsioMembersRequestBody {
+	body := &MergeGroupsioMembersRequestBody{
+		KeepMemberID: p.KeepMemberID,
+	}
+	if p.MergeMemberIds != nil {
+		body.MergeMemberIds = make([]string, len(p.MergeMemberIds))
+		for i, val := range p.MergeMemberIds {
+			body.MergeMemberIds[i] = val
+		}
+	} else {
+		body.MergeMemberIds = []string{}
+	}
+	return body
+}
+
+// NewChangeGroupsioMemberEmailRequestBody builds the HTTP request body from
+// the payload of the "change-groupsio-member-email" endpoint of the
+// "mailing-list" service.
+func NewChangeGroupsioMemberEmailRequestBody(p *mailinglist.ChangeGroupsioMemberEmailPayload) *ChangeGroupsioMemberEmailRequestBody {
+	body := &ChangeGroupsioMemberEmailRequestBody{
+		Email: p.Email,
+	}
+	return body
+}
+
 // NewCheckGroupsioSubscriberRequestBody builds the HTTP request body from the
 // payload of the "check-groupsio-subscriber" endpoint of the "mailing-list"
 // service.
@@ -1366,6 +2376,24 @@ func NewCheckGroupsioSubscriberRequestBody(p *mailinglist.CheckGroupsioSubscribe
 	return body
 }
 
+// NewReadyzReadinessOK builds a "mailing-list" service "readyz" endpoint
+// result from a HTTP "OK" response.
+func NewReadyzReadinessOK(body *ReadyzResponseBody) *mailinglist.Readiness {
+	v := &mailinglist.Readiness{
+		Status: *body.Status,
+	}
+	if body.Dependencies != nil {
+		v.Dependencies = make(map[string]string, len(body.Dependencies))
+		for key, val := range body.Dependencies {
+			tk := key
+			tv := val
+			v.Dependencies[tk] = tv
+		}
+	}
+
+	return v
+}
+
 // NewReadyzServiceUnavailable builds a mailing-list service readyz endpoint
 // ServiceUnavailable error.
 func NewReadyzServiceUnavailable(body *ReadyzServiceUnavailableResponseBody) *mailinglist.ServiceUnavailableError {
@@ -1587,6 +2615,71 @@ func NewUpdateGroupsioServiceServiceUnavailable(body *UpdateGroupsioServiceServi
 	return v
 }
 
+// NewPromoteGroupsioServiceGroupsioServicePromotionOK builds a "mailing-list"
+// service "promote-groupsio-service" endpoint result from a HTTP "OK" response.
+func NewPromoteGroupsioServiceGroupsioServicePromotionOK(body *PromoteGroupsioServiceResponseBody) *mailinglist.GroupsioServicePromotion {
+	v := &mailinglist.GroupsioServicePromotion{}
+	v.Service = unmarshalGroupsioServiceResponseBodyToMailinglistGroupsioService(body.Service)
+	if body.PrefixedSubgroups != nil {
+		v.PrefixedSubgroups = make([]*mailinglist.GroupsioSubgroup, len(body.PrefixedSubgroups))
+		for i, val := range body.PrefixedSubgroups {
+			v.PrefixedSubgroups[i] = unmarshalGroupsioSubgroupResponseBodyToMailinglistGroupsioSubgroup(val)
+		}
+	}
+
+	return v
+}
+
+// NewPromoteGroupsioServiceBadRequest builds a mailing-list service
+// promote-groupsio-service endpoint BadRequest error.
+func NewPromoteGroupsioServiceBadRequest(body *PromoteGroupsioServiceBadRequestResponseBody) *mailinglist.BadRequestError {
+	v := &mailinglist.BadRequestError{
+		Message: *body.Message,
+	}
+
+	return v
+}
+
+// NewPromoteGroupsioServiceConflict builds a mailing-list service
+// promote-groupsio-service endpoint Conflict error.
+func NewPromoteGroupsioServiceConflict(body *PromoteGroupsioServiceConflictResponseBody) *mailinglist.ConflictError {
+	v := &mailinglist.ConflictError{
+		Message: *body.Message,
+	}
+
+	return v
+}
+
+// NewPromoteGroupsioServiceInternalServerError builds a mailing-list service
+// promote-groupsio-service endpoint InternalServerError error.
+func NewPromoteGroupsioServiceInternalServerError(body *PromoteGroupsioServiceInternalServerErrorResponseBody) *mailinglist.InternalServerError {
+	v := &mailinglist.InternalServerError{
+		Message: *body.Message,
+	}
+
+	return v
+}
+
+// NewPromoteGroupsioServiceNotFound builds a mailing-list service
+// promote-groupsio-service endpoint NotFound error.
+func NewPromoteGroupsioServiceNotFound(body *PromoteGroupsioServiceNotFoundResponseBody) *mailinglist.NotFoundError {
+	v := &mailinglist.NotFoundError{
+		Message: *body.Message,
+	}
+
+	return v
+}
+
+// NewPromoteGroupsioServiceServiceUnavailable builds a mailing-list service
+// promote-groupsio-service endpoint ServiceUnavailable error.
+func NewPromoteGroupsioServiceServiceUnavailable(body *PromoteGroupsioServiceServiceUnavailableResponseBody) *mailinglist.ServiceUnavailableError {
+	v := &mailinglist.ServiceUnavailableError{
+		Message: *body.Message,
+	}
+
+	return v
+}
+
 // NewDeleteGroupsioServiceInternalServerError builds a mailing-list service
 // delete-groupsio-service endpoint InternalServerError error.
 func NewDeleteGroupsioServiceInternalServerError(body *DeleteGroupsioServiceInternalServerErrorResponseBody) *mailinglist.InternalServerError {
@@ -1652,22 +2745,118 @@ func NewGetGroupsioServiceProjectsServiceUnavailable(body *GetGroupsioServicePro
 	return v
 }
 
-// NewFindParentGroupsioServiceGroupsioServiceOK builds a "mailing-list"
-// service "find-parent-groupsio-service" endpoint result from a HTTP "OK"
+// NewListGroupsioServicesByStatusGroupsioServiceListOK builds a "mailing-list"
+// service "list-groupsio-services-by-status" endpoint result from a HTTP "OK"
 // response.
-func NewFindParentGroupsioServiceGroupsioServiceOK(body *FindParentGroupsioServiceResponseBody) *mailinglist.GroupsioService {
-	v := &mailinglist.GroupsioService{
-		ID:         body.ID,
-		ProjectUID: body.ProjectUID,
-		Type:       body.Type,
-		GroupID:    body.GroupID,
-		Domain:     body.Domain,
-		Prefix:     body.Prefix,
-		Status:     body.Status,
-		CreatedAt:  body.CreatedAt,
-		UpdatedAt:  body.UpdatedAt,
+func NewListGroupsioServicesByStatusGroupsioServiceListOK(body *ListGroupsioServicesByStatusResponseBody) *mailinglist.GroupsioServiceList {
+	v := &mailinglist.GroupsioServiceList{
+		Total: body.Total,
 	}
-
+	if body.Items != nil {
+		v.Items = make([]*mailinglist.GroupsioService, len(body.Items))
+		for i, val := range body.Items {
+			v.Items[i] = unmarshalGroupsioServiceResponseBodyToMailinglistGroupsioService(val)
+		}
+	}
+
+	return v
+}
+
+// NewListGroupsioServicesByStatusBadRequest builds a mailing-list service
+// list-groupsio-services-by-status endpoint BadRequest error.
+func NewListGroupsioServicesByStatusBadRequest(body *ListGroupsioServicesByStatusBadRequestResponseBody) *mailinglist.BadRequestError {
+	v := &mailinglist.BadRequestError{
+		Message: *body.Message,
+	}
+
+	return v
+}
+
+// NewListGroupsioServicesByStatusInternalServerError builds a mailing-list
+// service list-groupsio-services-by-status endpoint InternalServerError error.
+func NewListGroupsioServicesByStatusInternalServerError(body *ListGroupsioServicesByStatusInternalServerErrorResponseBody) *mailinglist.InternalServerError {
+	v := &mailinglist.InternalServerError{
+		Message: *body.Message,
+	}
+
+	return v
+}
+
+// NewListGroupsioServicesByStatusServiceUnavailable builds a mailing-list
+// service list-groupsio-services-by-status endpoint ServiceUnavailable error.
+func NewListGroupsioServicesByStatusServiceUnavailable(body *ListGroupsioServicesByStatusServiceUnavailableResponseBody) *mailinglist.ServiceUnavailableError {
+	v := &mailinglist.ServiceUnavailableError{
+		Message: *body.Message,
+	}
+
+	return v
+}
+
+// NewListUnprovisionedGroupsioServicesGroupsioServiceListOK builds a
+// "mailing-list" service "list-unprovisioned-groupsio-services" endpoint
+// result from a HTTP "OK" response.
+func NewListUnprovisionedGroupsioServicesGroupsioServiceListOK(body *ListUnprovisionedGroupsioServicesResponseBody) *mailinglist.GroupsioServiceList {
+	v := &mailinglist.GroupsioServiceList{
+		Total: body.Total,
+	}
+	if body.Items != nil {
+		v.Items = make([]*mailinglist.GroupsioService, len(body.Items))
+		for i, val := range body.Items {
+			v.Items[i] = unmarshalGroupsioServiceResponseBodyToMailinglistGroupsioService(val)
+		}
+	}
+
+	return v
+}
+
+// NewListUnprovisionedGroupsioServicesBadRequest builds a mailing-list service
+// list-unprovisioned-groupsio-services endpoint BadRequest error.
+func NewListUnprovisionedGroupsioServicesBadRequest(body *ListUnprovisionedGroupsioServicesBadRequestResponseBody) *mailinglist.BadRequestError {
+	v := &mailinglist.BadRequestError{
+		Message: *body.Message,
+	}
+
+	return v
+}
+
+// NewListUnprovisionedGroupsioServicesInternalServerError builds a
+// mailing-list service list-unprovisioned-groupsio-services endpoint
+// InternalServerError error.
+func NewListUnprovisionedGroupsioServicesInternalServerError(body *ListUnprovisionedGroupsioServicesInternalServerErrorResponseBody) *mailinglist.InternalServerError {
+	v := &mailinglist.InternalServerError{
+		Message: *body.Message,
+	}
+
+	return v
+}
+
+// NewListUnprovisionedGroupsioServicesServiceUnavailable builds a mailing-list
+// service list-unprovisioned-groupsio-services endpoint ServiceUnavailable
+// error.
+func NewListUnprovisionedGroupsioServicesServiceUnavailable(body *ListUnprovisionedGroupsioServicesServiceUnavailableResponseBody) *mailinglist.ServiceUnavailableError {
+	v := &mailinglist.ServiceUnavailableError{
+		Message: *body.Message,
+	}
+
+	return v
+}
+
+// NewFindParentGroupsioServiceGroupsioServiceOK builds a "mailing-list"
+// service "find-parent-groupsio-service" endpoint result from a HTTP "OK"
+// response.
+func NewFindParentGroupsioServiceGroupsioServiceOK(body *FindParentGroupsioServiceResponseBody) *mailinglist.GroupsioService {
+	v := &mailinglist.GroupsioService{
+		ID:         body.ID,
+		ProjectUID: body.ProjectUID,
+		Type:       body.Type,
+		GroupID:    body.GroupID,
+		Domain:     body.Domain,
+		Prefix:     body.Prefix,
+		Status:     body.Status,
+		CreatedAt:  body.CreatedAt,
+		UpdatedAt:  body.UpdatedAt,
+	}
+
 	return v
 }
 
@@ -1758,6 +2947,104 @@ func NewListGroupsioMailingListsServiceUnavailable(body *ListGroupsioMailingList
 	return v
 }
 
+// NewListGroupsioMailingListsByVisibilityGroupsioSubgroupListOK builds a
+// "mailing-list" service "list-groupsio-mailing-lists-by-visibility" endpoint
+// result from a HTTP "OK" response.
+func NewListGroupsioMailingListsByVisibilityGroupsioSubgroupListOK(body *ListGroupsioMailingListsByVisibilityResponseBody) *mailinglist.GroupsioSubgroupList {
+	v := &mailinglist.GroupsioSubgroupList{
+		Total: body.Total,
+	}
+	if body.Items != nil {
+		v.Items = make([]*mailinglist.GroupsioSubgroup, len(body.Items))
+		for i, val := range body.Items {
+			v.Items[i] = unmarshalGroupsioSubgroupResponseBodyToMailinglistGroupsioSubgroup(val)
+		}
+	}
+
+	return v
+}
+
+// NewListGroupsioMailingListsByVisibilityBadRequest builds a mailing-list
+// service list-groupsio-mailing-lists-by-visibility endpoint BadRequest error.
+func NewListGroupsioMailingListsByVisibilityBadRequest(body *ListGroupsioMailingListsByVisibilityBadRequestResponseBody) *mailinglist.BadRequestError {
+	v := &mailinglist.BadRequestError{
+		Message: *body.Message,
+	}
+
+	return v
+}
+
+// NewListGroupsioMailingListsByVisibilityInternalServerError builds a
+// mailing-list service list-groupsio-mailing-lists-by-visibility endpoint
+// InternalServerError error.
+func NewListGroupsioMailingListsByVisibilityInternalServerError(body *ListGroupsioMailingListsByVisibilityInternalServerErrorResponseBody) *mailinglist.InternalServerError {
+	v := &mailinglist.InternalServerError{
+		Message: *body.Message,
+	}
+
+	return v
+}
+
+// NewListGroupsioMailingListsByVisibilityServiceUnavailable builds a
+// mailing-list service list-groupsio-mailing-lists-by-visibility endpoint
+// ServiceUnavailable error.
+func NewListGroupsioMailingListsByVisibilityServiceUnavailable(body *ListGroupsioMailingListsByVisibilityServiceUnavailableResponseBody) *mailinglist.ServiceUnavailableError {
+	v := &mailinglist.ServiceUnavailableError{
+		Message: *body.Message,
+	}
+
+	return v
+}
+
+// NewListGroupsioMailingListsUpdatedSinceGroupsioSubgroupListOK builds a
+// "mailing-list" service "list-groupsio-mailing-lists-updated-since" endpoint
+// result from a HTTP "OK" response.
+func NewListGroupsioMailingListsUpdatedSinceGroupsioSubgroupListOK(body *ListGroupsioMailingListsUpdatedSinceResponseBody) *mailinglist.GroupsioSubgroupList {
+	v := &mailinglist.GroupsioSubgroupList{
+		Total: body.Total,
+	}
+	if body.Items != nil {
+		v.Items = make([]*mailinglist.GroupsioSubgroup, len(body.Items))
+		for i, val := range body.Items {
+			v.Items[i] = unmarshalGroupsioSubgroupResponseBodyToMailinglistGroupsioSubgroup(val)
+		}
+	}
+
+	return v
+}
+
+// NewListGroupsioMailingListsUpdatedSinceBadRequest builds a mailing-list
+// service list-groupsio-mailing-lists-updated-since endpoint BadRequest error.
+func NewListGroupsioMailingListsUpdatedSinceBadRequest(body *ListGroupsioMailingListsUpdatedSinceBadRequestResponseBody) *mailinglist.BadRequestError {
+	v := &mailinglist.BadRequestError{
+		Message: *body.Message,
+	}
+
+	return v
+}
+
+// NewListGroupsioMailingListsUpdatedSinceInternalServerError builds a
+// mailing-list service list-groupsio-mailing-lists-updated-since endpoint
+// InternalServerError error.
+func NewListGroupsioMailingListsUpdatedSinceInternalServerError(body *ListGroupsioMailingListsUpdatedSinceInternalServerErrorResponseBody) *mailinglist.InternalServerError {
+	v := &mailinglist.InternalServerError{
+		Message: *body.Message,
+	}
+
+	return v
+}
+
+// NewListGroupsioMailingListsUpdatedSinceServiceUnavailable builds a
+// mailing-list service list-groupsio-mailing-lists-updated-since endpoint
+// ServiceUnavailable error.
+func NewListGroupsioMailingListsUpdatedSinceServiceUnavailable(body *ListGroupsioMailingListsUpdatedSinceServiceUnavailableResponseBody) *mailinglist.ServiceUnavailableError {
+	v := &mailinglist.ServiceUnavailableError{
+		Message: *body.Message,
+	}
+
+	return v
+}
+
 // NewCreateGroupsioMailingListGroupsioSubgroupCreated builds a "mailing-list"
 // service "create-groupsio-mailing-list" endpoint result from a HTTP "Created"
 // response.
@@ -1775,6 +3062,12 @@ func NewCreateGroupsioMailingListGroupsioSubgroupCreated(body *CreateGroupsioMai
 		CreatedAt:      body.CreatedAt,
 		UpdatedAt:      body.UpdatedAt,
 	}
+	if body.Warnings != nil {
+		v.Warnings = make([]string, len(body.Warnings))
+		for i, val := range body.Warnings {
+			v.Warnings[i] = val
+		}
+	}
 
 	return v
 }
@@ -1835,6 +3128,12 @@ func NewGetGroupsioMailingListGroupsioSubgroupOK(body *GetGroupsioMailingListRes
 		CreatedAt:      body.CreatedAt,
 		UpdatedAt:      body.UpdatedAt,
 	}
+	if body.Warnings != nil {
+		v.Warnings = make([]string, len(body.Warnings))
+		for i, val := range body.Warnings {
+			v.Warnings[i] = val
+		}
+	}
 
 	return v
 }
@@ -1886,6 +3185,12 @@ func NewUpdateGroupsioMailingListGroupsioSubgroupOK(body *UpdateGroupsioMailingL
 		CreatedAt:      body.CreatedAt,
 		UpdatedAt:      body.UpdatedAt,
 	}
+	if body.Warnings != nil {
+		v.Warnings = make([]string, len(body.Warnings))
+		for i, val := range body.Warnings {
+			v.Warnings[i] = val
+		}
+	}
 
 	return v
 }
@@ -2090,32 +3395,26 @@ func NewListGroupsioMembersServiceUnavailable(body *ListGroupsioMembersServiceUn
 	return v
 }
 
-// NewAddGroupsioMemberGroupsioMemberCreated builds a "mailing-list" service
-// "add-groupsio-member" endpoint result from a HTTP "Created" response.
-func NewAddGroupsioMemberGroupsioMemberCreated(body *AddGroupsioMemberResponseBody) *mailinglist.GroupsioMember {
-	v := &mailinglist.GroupsioMember{
-		ID:           body.ID,
-		Email:        body.Email,
-		Name:         body.Name,
-		MemberType:   body.MemberType,
-		DeliveryMode: body.DeliveryMode,
-		ModStatus:    body.ModStatus,
-		Status:       body.Status,
-		Organization: body.Organization,
-		JobTitle:     body.JobTitle,
-		Username:     body.Username,
-		Role:         body.Role,
-		VotingStatus: body.VotingStatus,
-		CreatedAt:    body.CreatedAt,
-		UpdatedAt:    body.UpdatedAt,
+// NewListGroupsioMembersNeedingReviewGroupsioMemberListOK builds a
+// "mailing-list" service "list-groupsio-members-needing-review" endpoint
+// result from a HTTP "OK" response.
+func NewListGroupsioMembersNeedingReviewGroupsioMemberListOK(body *ListGroupsioMembersNeedingReviewResponseBody) *mailinglist.GroupsioMemberList {
+	v := &mailinglist.GroupsioMemberList{
+		Total: body.Total,
+	}
+	if body.Items != nil {
+		v.Items = make([]*mailinglist.GroupsioMember, len(body.Items))
+		for i, val := range body.Items {
+			v.Items[i] = unmarshalGroupsioMemberResponseBodyToMailinglistGroupsioMember(val)
+		}
 	}
 
 	return v
 }
 
-// NewAddGroupsioMemberBadRequest builds a mailing-list service
-// add-groupsio-member endpoint BadRequest error.
-func NewAddGroupsioMemberBadRequest(body *AddGroupsioMemberBadRequestResponseBody) *mailinglist.BadRequestError {
+// NewListGroupsioMembersNeedingReviewBadRequest builds a mailing-list service
+// list-groupsio-members-needing-review endpoint BadRequest error.
+func NewListGroupsioMembersNeedingReviewBadRequest(body *ListGroupsioMembersNeedingReviewBadRequestResponseBody) *mailinglist.BadRequestError {
 	v := &mailinglist.BadRequestError{
 		Message: *body.Message,
 	}
@@ -2123,72 +3422,69 @@ func NewAddGroupsioMemberBadRequest(body *AddGroupsioMemberBadRequestResponseBod
 	return v
 }
 
-// NewAddGroupsioMemberConflict builds a mailing-list service
-// add-groupsio-member endpoint Conflict error.
-func NewAddGroupsioMemberConflict(body *AddGroupsioMemberConflictResponseBody) *mailinglist.ConflictError {
-	v := &mailinglist.ConflictError{
+// NewListGroupsioMembersNeedingReviewInternalServerError builds a mailing-list
+// service list-groupsio-members-needing-review endpoint InternalServerError
+// error.
+func NewListGroupsioMembersNeedingReviewInternalServerError(body *ListGroupsioMembersNeedingReviewInternalServerErrorResponseBody) *mailinglist.InternalServerError {
+	v := &mailinglist.InternalServerError{
 		Message: *body.Message,
 	}
 
 	return v
 }
 
-// NewAddGroupsioMemberInternalServerError builds a mailing-list service
-// add-groupsio-member endpoint InternalServerError error.
-func NewAddGroupsioMemberInternalServerError(body *AddGroupsioMemberInternalServerErrorResponseBody) *mailinglist.InternalServerError {
-	v := &mailinglist.InternalServerError{
+// NewListGroupsioMembersNeedingReviewNotFound builds a mailing-list service
+// list-groupsio-members-needing-review endpoint NotFound error.
+func NewListGroupsioMembersNeedingReviewNotFound(body *ListGroupsioMembersNeedingReviewNotFoundResponseBody) *mailinglist.NotFoundError {
+	v := &mailinglist.NotFoundError{
 		Message: *body.Message,
 	}
 
 	return v
 }
 
-// NewAddGroupsioMemberNotFound builds a mailing-list service
-// add-groupsio-member endpoint NotFound error.
-func NewAddGroupsioMemberNotFound(body *AddGroupsioMemberNotFoundResponseBody) *mailinglist.NotFoundError {
-	v := &mailinglist.NotFoundError{
+// NewListGroupsioMembersNeedingReviewServiceUnavailable builds a mailing-list
+// service list-groupsio-members-needing-review endpoint ServiceUnavailable
+// error.
+func NewListGroupsioMembersNeedingReviewServiceUnavailable(body *ListGroupsioMembersNeedingReviewServiceUnavailableResponseBody) *mailinglist.ServiceUnavailableError {
+	v := &mailinglist.ServiceUnavailableError{
 		Message: *body.Message,
 	}
 
 	return v
 }
 
-// NewAddGroupsioMemberServiceUnavailable builds a mailing-list service
-// add-groupsio-member endpoint ServiceUnavailable error.
-func NewAddGroupsioMemberServiceUnavailable(body *AddGroupsioMemberServiceUnavailableResponseBody) *mailinglist.ServiceUnavailableError {
-	v := &mailinglist.ServiceUnavailableError{
-		Message: *body.Message,
+// NewListGroupsioMembersModifiedSinceGroupsioMemberListOK builds a
+// "mailing-list" service "list-groupsio-members-modified-since" endpoint
+// result from a HTTP "OK" response.
+func NewListGroupsioMembersModifiedSinceGroupsioMemberListOK(body *ListGroupsioMembersModifiedSinceResponseBody) *mailinglist.GroupsioMemberList {
+	v := &mailinglist.GroupsioMemberList{
+		Total: body.Total,
+	}
+	if body.Items != nil {
+		v.Items = make([]*mailinglist.GroupsioMember, len(body.Items))
+		for i, val := range body.Items {
+			v.Items[i] = unmarshalGroupsioMemberResponseBodyToMailinglistGroupsioMember(val)
+		}
 	}
 
 	return v
 }
 
-// NewGetGroupsioMemberGroupsioMemberOK builds a "mailing-list" service
-// "get-groupsio-member" endpoint result from a HTTP "OK" response.
-func NewGetGroupsioMemberGroupsioMemberOK(body *GetGroupsioMemberResponseBody) *mailinglist.GroupsioMember {
-	v := &mailinglist.GroupsioMember{
-		ID:           body.ID,
-		Email:        body.Email,
-		Name:         body.Name,
-		MemberType:   body.MemberType,
-		DeliveryMode: body.DeliveryMode,
-		ModStatus:    body.ModStatus,
-		Status:       body.Status,
-		Organization: body.Organization,
-		JobTitle:     body.JobTitle,
-		Username:     body.Username,
-		Role:         body.Role,
-		VotingStatus: body.VotingStatus,
-		CreatedAt:    body.CreatedAt,
-		UpdatedAt:    body.UpdatedAt,
+// NewListGroupsioMembersModifiedSinceBadRequest builds a mailing-list service
+// list-groupsio-members-modified-since endpoint BadRequest error.
+func NewListGroupsioMembersModifiedSinceBadRequest(body *ListGroupsioMembersModifiedSinceBadRequestResponseBody) *mailinglist.BadRequestError {
+	v := &mailinglist.BadRequestError{
+		Message: *body.Message,
 	}
 
 	return v
 }
 
-// NewGetGroupsioMemberInternalServerError builds a mailing-list service
-// get-groupsio-member endpoint InternalServerError error.
-func NewGetGroupsioMemberInternalServerError(body *GetGroupsioMemberInternalServerErrorResponseBody) *mailinglist.InternalServerError {
+// NewListGroupsioMembersModifiedSinceInternalServerError builds a mailing-list
+// service list-groupsio-members-modified-since endpoint InternalServerError
+// error.
+func NewListGroupsioMembersModifiedSinceInternalServerError(body *ListGroupsioMembersModifiedSinceInternalServerErrorResponseBody) *mailinglist.InternalServerError {
 	v := &mailinglist.InternalServerError{
 		Message: *body.Message,
 	}
@@ -2196,9 +3492,9 @@ func NewGetGroupsioMemberInternalServerError(body *GetGroupsioMemberInternalServ
 	return v
 }
 
-// NewGetGroupsioMemberNotFound builds a mailing-list service
-// get-groupsio-member endpoint NotFound error.
-func NewGetGroupsioMemberNotFound(body *GetGroupsioMemberNotFoundResponseBody) *mailinglist.NotFoundError {
+// NewListGroupsioMembersModifiedSinceNotFound builds a mailing-list service
+// list-groupsio-members-modified-since endpoint NotFound error.
+func NewListGroupsioMembersModifiedSinceNotFound(body *ListGroupsioMembersModifiedSinceNotFoundResponseBody) *mailinglist.NotFoundError {
 	v := &mailinglist.NotFoundError{
 		Message: *body.Message,
 	}
@@ -2206,9 +3502,10 @@ func NewGetGroupsioMemberNotFound(body *GetGroupsioMemberNotFoundResponseBody) *
 	return v
 }
 
-// NewGetGroupsioMemberServiceUnavailable builds a mailing-list service
-// get-groupsio-member endpoint ServiceUnavailable error.
-func NewGetGroupsioMemberServiceUnavailable(body *GetGroupsioMemberServiceUnavailableResponseBody) *mailinglist.ServiceUnavailableError {
+// NewListGroupsioMembersModifiedSinceServiceUnavailable builds a mailing-list
+// service list-groupsio-members-modified-since endpoint ServiceUnavailable
+// error.
+func NewListGroupsioMembersModifiedSinceServiceUnavailable(body *ListGroupsioMembersModifiedSinceServiceUnavailableResponseBody) *mailinglist.ServiceUnavailableError {
 	v := &mailinglist.ServiceUnavailableError{
 		Message: *body.Message,
 	}
@@ -2216,32 +3513,26 @@ func NewGetGroupsioMemberServiceUnavailable(body *GetGroupsioMemberServiceUnavai
 	return v
 }
 
-// NewUpdateGroupsioMemberGroupsioMemberOK builds a "mailing-list" service
-// "update-groupsio-member" endpoint result from a HTTP "OK" response.
-func NewUpdateGroupsioMemberGroupsioMemberOK(body *UpdateGroupsioMemberResponseBody) *mailinglist.GroupsioMember {
-	v := &mailinglist.GroupsioMember{
-		ID:           body.ID,
-		Email:        body.Email,
-		Name:         body.Name,
-		MemberType:   body.MemberType,
-		DeliveryMode: body.DeliveryMode,
-		ModStatus:    body.ModStatus,
-		Status:       body.Status,
-		Organization: body.Organization,
-		JobTitle:     body.JobTitle,
-		Username:     body.Username,
-		Role:         body.Role,
-		VotingStatus: body.VotingStatus,
-		CreatedAt:    body.CreatedAt,
-		UpdatedAt:    body.UpdatedAt,
+// NewListGroupsioMembersByOrganizationGroupsioMemberListOK builds a
+// "mailing-list" service "list-groupsio-members-by-organization" endpoint
+// result from a HTTP "OK" response.
+func NewListGroupsioMembersByOrganizationGroupsioMemberListOK(body *ListGroupsioMembersByOrganizationResponseBody) *mailinglist.GroupsioMemberList {
+	v := &mailinglist.GroupsioMemberList{
+		Total: body.Total,
+	}
+	if body.Items != nil {
+		v.Items = make([]*mailinglist.GroupsioMember, len(body.Items))
+		for i, val := range body.Items {
+			v.Items[i] = unmarshalGroupsioMemberResponseBodyToMailinglistGroupsioMember(val)
+		}
 	}
 
 	return v
 }
 
-// NewUpdateGroupsioMemberBadRequest builds a mailing-list service
-// update-groupsio-member endpoint BadRequest error.
-func NewUpdateGroupsioMemberBadRequest(body *UpdateGroupsioMemberBadRequestResponseBody) *mailinglist.BadRequestError {
+// NewListGroupsioMembersByOrganizationBadRequest builds a mailing-list service
+// list-groupsio-members-by-organization endpoint BadRequest error.
+func NewListGroupsioMembersByOrganizationBadRequest(body *ListGroupsioMembersByOrganizationBadRequestResponseBody) *mailinglist.BadRequestError {
 	v := &mailinglist.BadRequestError{
 		Message: *body.Message,
 	}
@@ -2249,9 +3540,10 @@ func NewUpdateGroupsioMemberBadRequest(body *UpdateGroupsioMemberBadRequestRespo
 	return v
 }
 
-// NewUpdateGroupsioMemberInternalServerError builds a mailing-list service
-// update-groupsio-member endpoint InternalServerError error.
-func NewUpdateGroupsioMemberInternalServerError(body *UpdateGroupsioMemberInternalServerErrorResponseBody) *mailinglist.InternalServerError {
+// NewListGroupsioMembersByOrganizationInternalServerError builds a
+// mailing-list service list-groupsio-members-by-organization endpoint
+// InternalServerError error.
+func NewListGroupsioMembersByOrganizationInternalServerError(body *ListGroupsioMembersByOrganizationInternalServerErrorResponseBody) *mailinglist.InternalServerError {
 	v := &mailinglist.InternalServerError{
 		Message: *body.Message,
 	}
@@ -2259,9 +3551,9 @@ func NewUpdateGroupsioMemberInternalServerError(body *UpdateGroupsioMemberIntern
 	return v
 }
 
-// NewUpdateGroupsioMemberNotFound builds a mailing-list service
-// update-groupsio-member endpoint NotFound error.
-func NewUpdateGroupsioMemberNotFound(body *UpdateGroupsioMemberNotFoundResponseBody) *mailinglist.NotFoundError {
+// NewListGroupsioMembersByOrganizationNotFound builds a mailing-list service
+// list-groupsio-members-by-organization endpoint NotFound error.
+func NewListGroupsioMembersByOrganizationNotFound(body *ListGroupsioMembersByOrganizationNotFoundResponseBody) *mailinglist.NotFoundError {
 	v := &mailinglist.NotFoundError{
 		Message: *body.Message,
 	}
@@ -2269,9 +3561,10 @@ func NewUpdateGroupsioMemberNotFound(body *UpdateGroupsioMemberNotFoundResponseB
 	return v
 }
 
-// NewUpdateGroupsioMemberServiceUnavailable builds a mailing-list service
-// update-groupsio-member endpoint ServiceUnavailable error.
-func NewUpdateGroupsioMemberServiceUnavailable(body *UpdateGroupsioMemberServiceUnavailableResponseBody) *mailinglist.ServiceUnavailableError {
+// NewListGroupsioMembersByOrganizationServiceUnavailable builds a mailing-list
+// service list-groupsio-members-by-organization endpoint ServiceUnavailable
+// error.
+func NewListGroupsioMembersByOrganizationServiceUnavailable(body *ListGroupsioMembersByOrganizationServiceUnavailableResponseBody) *mailinglist.ServiceUnavailableError {
 	v := &mailinglist.ServiceUnavailableError{
 		Message: *body.Message,
 	}
@@ -2279,9 +3572,25 @@ func NewUpdateGroupsioMemberServiceUnavailable(body *UpdateGroupsioMemberService
 	return v
 }
 
-// NewDeleteGroupsioMemberInternalServerError builds a mailing-list service
-// delete-groupsio-member endpoint InternalServerError error.
-func NewDeleteGroupsioMemberInternalServerError(body *DeleteGroupsioMemberInternalServerErrorResponseBody) *mailinglist.InternalServerError {
+// NewListGroupsioDuplicateMembersGroupsioMemberDuplicatesOK builds a
+// "mailing-list" service "list-groupsio-duplicate-members" endpoint result
+// from a HTTP "OK" response.
+func NewListGroupsioDuplicateMembersGroupsioMemberDuplicatesOK(body *ListGroupsioDuplicateMembersResponseBody) *mailinglist.GroupsioMemberDuplicates {
+	v := &mailinglist.GroupsioMemberDuplicates{}
+	v.Groups = make([][]string, len(body.Groups))
+	for i, val := range body.Groups {
+		v.Groups[i] = make([]string, len(val))
+		for j, val := range val {
+			v.Groups[i][j] = val
+		}
+	}
+
+	return v
+}
+
+// NewListGroupsioDuplicateMembersInternalServerError builds a mailing-list
+// service list-groupsio-duplicate-members endpoint InternalServerError error.
+func NewListGroupsioDuplicateMembersInternalServerError(body *ListGroupsioDuplicateMembersInternalServerErrorResponseBody) *mailinglist.InternalServerError {
 	v := &mailinglist.InternalServerError{
 		Message: *body.Message,
 	}
@@ -2289,9 +3598,9 @@ func NewDeleteGroupsioMemberInternalServerError(body *DeleteGroupsioMemberIntern
 	return v
 }
 
-// NewDeleteGroupsioMemberNotFound builds a mailing-list service
-// delete-groupsio-member endpoint NotFound error.
-func NewDeleteGroupsioMemberNotFound(body *DeleteGroupsioMemberNotFoundResponseBody) *mailinglist.NotFoundError {
+// NewListGroupsioDuplicateMembersNotFound builds a mailing-list service
+// list-groupsio-duplicate-members endpoint NotFound error.
+func NewListGroupsioDuplicateMembersNotFound(body *ListGroupsioDuplicateMembersNotFoundResponseBody) *mailinglist.NotFoundError {
 	v := &mailinglist.NotFoundError{
 		Message: *body.Message,
 	}
@@ -2299,9 +3608,9 @@ func NewDeleteGroupsioMemberNotFound(body *DeleteGroupsioMemberNotFoundResponseB
 	return v
 }
 
-// NewDeleteGroupsioMemberServiceUnavailable builds a mailing-list service
-// delete-groupsio-member endpoint ServiceUnavailable error.
-func NewDeleteGroupsioMemberServiceUnavailable(body *DeleteGroupsioMemberServiceUnavailableResponseBody) *mailinglist.ServiceUnavailableError {
+// NewListGroupsioDuplicateMembersServiceUnavailable builds a mailing-list
+// service list-groupsio-duplicate-members endpoint ServiceUnavailable error.
+func NewListGroupsioDuplicateMembersServiceUnavailable(body *ListGroupsioDuplicateMembersServiceUnavailableResponseBody) *mailinglist.ServiceUnavailableError {
 	v := &mailinglist.ServiceUnavailableError{
 		Message: *body.Message,
 	}
@@ -2309,9 +3618,28 @@ func NewDeleteGroupsioMemberServiceUnavailable(body *DeleteGroupsioMemberService
 	return v
 }
 
-// NewInviteGroupsioMembersBadRequest builds a mailing-list service
-// invite-groupsio-members endpoint BadRequest error.
-func NewInviteGroupsioMembersBadRequest(body *InviteGroupsioMembersBadRequestResponseBody) *mailinglist.BadRequestError {
+// NewPreviewGroupsioDeliveryModeChangeGroupsioDeliveryModePreviewOK builds a
+// "mailing-list" service "preview-groupsio-delivery-mode-change" endpoint
+// result from a HTTP "OK" response.
+func NewPreviewGroupsioDeliveryModeChangeGroupsioDeliveryModePreviewOK(body *PreviewGroupsioDeliveryModeChangeResponseBody) *mailinglist.GroupsioDeliveryModePreview {
+	v := &mailinglist.GroupsioDeliveryModePreview{
+		TargetMode: *body.TargetMode,
+	}
+	v.Affected = make([]string, len(body.Affected))
+	for i, val := range body.Affected {
+		v.Affected[i] = val
+	}
+	v.Unchanged = make([]string, len(body.Unchanged))
+	for i, val := range body.Unchanged {
+		v.Unchanged[i] = val
+	}
+
+	return v
+}
+
+// NewPreviewGroupsioDeliveryModeChangeBadRequest builds a mailing-list service
+// preview-groupsio-delivery-mode-change endpoint BadRequest error.
+func NewPreviewGroupsioDeliveryModeChangeBadRequest(body *PreviewGroupsioDeliveryModeChangeBadRequestResponseBody) *mailinglist.BadRequestError {
 	v := &mailinglist.BadRequestError{
 		Message: *body.Message,
 	}
@@ -2319,9 +3647,10 @@ func NewInviteGroupsioMembersBadRequest(body *InviteGroupsioMembersBadRequestRes
 	return v
 }
 
-// NewInviteGroupsioMembersInternalServerError builds a mailing-list service
-// invite-groupsio-members endpoint InternalServerError error.
-func NewInviteGroupsioMembersInternalServerError(body *InviteGroupsioMembersInternalServerErrorResponseBody) *mailinglist.InternalServerError {
+// NewPreviewGroupsioDeliveryModeChangeInternalServerError builds a
+// mailing-list service preview-groupsio-delivery-mode-change endpoint
+// InternalServerError error.
+func NewPreviewGroupsioDeliveryModeChangeInternalServerError(body *PreviewGroupsioDeliveryModeChangeInternalServerErrorResponseBody) *mailinglist.InternalServerError {
 	v := &mailinglist.InternalServerError{
 		Message: *body.Message,
 	}
@@ -2329,9 +3658,9 @@ func NewInviteGroupsioMembersInternalServerError(body *InviteGroupsioMembersInte
 	return v
 }
 
-// NewInviteGroupsioMembersNotFound builds a mailing-list service
-// invite-groupsio-members endpoint NotFound error.
-func NewInviteGroupsioMembersNotFound(body *InviteGroupsioMembersNotFoundResponseBody) *mailinglist.NotFoundError {
+// NewPreviewGroupsioDeliveryModeChangeNotFound builds a mailing-list service
+// preview-groupsio-delivery-mode-change endpoint NotFound error.
+func NewPreviewGroupsioDeliveryModeChangeNotFound(body *PreviewGroupsioDeliveryModeChangeNotFoundResponseBody) *mailinglist.NotFoundError {
 	v := &mailinglist.NotFoundError{
 		Message: *body.Message,
 	}
@@ -2339,9 +3668,10 @@ func NewInviteGroupsioMembersNotFound(body *InviteGroupsioMembersNotFoundRespons
 	return v
 }
 
-// NewInviteGroupsioMembersServiceUnavailable builds a mailing-list service
-// invite-groupsio-members endpoint ServiceUnavailable error.
-func NewInviteGroupsioMembersServiceUnavailable(body *InviteGroupsioMembersServiceUnavailableResponseBody) *mailinglist.ServiceUnavailableError {
+// NewPreviewGroupsioDeliveryModeChangeServiceUnavailable builds a mailing-list
+// service preview-groupsio-delivery-mode-change endpoint ServiceUnavailable
+// error.
+func NewPreviewGroupsioDeliveryModeChangeServiceUnavailable(body *PreviewGroupsioDeliveryModeChangeServiceUnavailableResponseBody) *mailinglist.ServiceUnavailableError {
 	v := &mailinglist.ServiceUnavailableError{
 		Message: *body.Message,
 	}
@@ -2349,20 +3679,32 @@ func NewInviteGroupsioMembersServiceUnavailable(body *InviteGroupsioMembersServi
 	return v
 }
 
-// NewCheckGroupsioSubscriberGroupsioCheckSubscriberResponseOK builds a
-// "mailing-list" service "check-groupsio-subscriber" endpoint result from a
-// HTTP "OK" response.
-func NewCheckGroupsioSubscriberGroupsioCheckSubscriberResponseOK(body *CheckGroupsioSubscriberResponseBody) *mailinglist.GroupsioCheckSubscriberResponse {
-	v := &mailinglist.GroupsioCheckSubscriberResponse{
-		Subscribed: *body.Subscribed,
+// NewAddGroupsioMemberGroupsioMemberCreated builds a "mailing-list" service
+// "add-groupsio-member" endpoint result from a HTTP "Created" response.
+func NewAddGroupsioMemberGroupsioMemberCreated(body *AddGroupsioMemberResponseBody) *mailinglist.GroupsioMember {
+	v := &mailinglist.GroupsioMember{
+		ID:           body.ID,
+		Email:        body.Email,
+		Name:         body.Name,
+		MemberType:   body.MemberType,
+		DeliveryMode: body.DeliveryMode,
+		ModStatus:    body.ModStatus,
+		Status:       body.Status,
+		Organization: body.Organization,
+		JobTitle:     body.JobTitle,
+		Username:     body.Username,
+		Role:         body.Role,
+		VotingStatus: body.VotingStatus,
+		CreatedAt:    body.CreatedAt,
+		UpdatedAt:    body.UpdatedAt,
 	}
 
 	return v
 }
 
-// NewCheckGroupsioSubscriberBadRequest builds a mailing-list service
-// check-groupsio-subscriber endpoint BadRequest error.
-func NewCheckGroupsioSubscriberBadRequest(body *CheckGroupsioSubscriberBadRequestResponseBody) *mailinglist.BadRequestError {
+// NewAddGroupsioMemberBadRequest builds a mailing-list service
+// add-groupsio-member endpoint BadRequest error.
+func NewAddGroupsioMemberBadRequest(body *AddGroupsioMemberBadRequestResponseBody) *mailinglist.BadRequestError {
 	v := &mailinglist.BadRequestError{
 		Message: *body.Message,
 	}
@@ -2370,109 +3712,80 @@ func NewCheckGroupsioSubscriberBadRequest(body *CheckGroupsioSubscriberBadReques
 	return v
 }
 
-// NewCheckGroupsioSubscriberInternalServerError builds a mailing-list service
-// check-groupsio-subscriber endpoint InternalServerError error.
-func NewCheckGroupsioSubscriberInternalServerError(body *CheckGroupsioSubscriberInternalServerErrorResponseBody) *mailinglist.InternalServerError {
-	v := &mailinglist.InternalServerError{
+// NewAddGroupsioMemberConflict builds a mailing-list service
+// add-groupsio-member endpoint Conflict error.
+func NewAddGroupsioMemberConflict(body *AddGroupsioMemberConflictResponseBody) *mailinglist.ConflictError {
+	v := &mailinglist.ConflictError{
 		Message: *body.Message,
 	}
 
 	return v
 }
 
-// NewCheckGroupsioSubscriberServiceUnavailable builds a mailing-list service
-// check-groupsio-subscriber endpoint ServiceUnavailable error.
-func NewCheckGroupsioSubscriberServiceUnavailable(body *CheckGroupsioSubscriberServiceUnavailableResponseBody) *mailinglist.ServiceUnavailableError {
-	v := &mailinglist.ServiceUnavailableError{
+// NewAddGroupsioMemberInternalServerError builds a mailing-list service
+// add-groupsio-member endpoint InternalServerError error.
+func NewAddGroupsioMemberInternalServerError(body *AddGroupsioMemberInternalServerErrorResponseBody) *mailinglist.InternalServerError {
+	v := &mailinglist.InternalServerError{
 		Message: *body.Message,
 	}
 
 	return v
 }
 
-// NewGetGroupsioArtifactGroupsioArtifactOK builds a "mailing-list" service
-// "get-groupsio-artifact" endpoint result from a HTTP "OK" response.
-func NewGetGroupsioArtifactGroupsioArtifactOK(body *GetGroupsioArtifactResponseBody) *mailinglist.GroupsioArtifact {
-	v := &mailinglist.GroupsioArtifact{
-		ArtifactID:          body.ArtifactID,
-		GroupID:             body.GroupID,
-		ProjectID:           body.ProjectID,
-		CommitteeID:         body.CommitteeID,
-		Type:                body.Type,
-		MediaType:           body.MediaType,
-		Filename:            body.Filename,
-		LinkURL:             body.LinkURL,
-		DownloadURL:         body.DownloadURL,
-		S3Key:               body.S3Key,
-		FileUploaded:        body.FileUploaded,
-		FileUploadStatus:    body.FileUploadStatus,
-		FileUploadedAt:      body.FileUploadedAt,
-		LastPostedAt:        body.LastPostedAt,
-		LastPostedMessageID: body.LastPostedMessageID,
-		Description:         body.Description,
-		CreatedAt:           body.CreatedAt,
-		UpdatedAt:           body.UpdatedAt,
-	}
-	if body.MessageIds != nil {
-		v.MessageIds = make([]uint64, len(body.MessageIds))
-		for i, val := range body.MessageIds {
-			v.MessageIds[i] = val
-		}
-	}
-	if body.CreatedBy != nil {
-		v.CreatedBy = unmarshalGroupsioArtifactUserResponseBodyToMailinglistGroupsioArtifactUser(body.CreatedBy)
-	}
-	if body.LastModifiedBy != nil {
-		v.LastModifiedBy = unmarshalGroupsioArtifactUserResponseBodyToMailinglistGroupsioArtifactUser(body.LastModifiedBy)
+// NewAddGroupsioMemberNotFound builds a mailing-list service
+// add-groupsio-member endpoint NotFound error.
+func NewAddGroupsioMemberNotFound(body *AddGroupsioMemberNotFoundResponseBody) *mailinglist.NotFoundError {
+	v := &mailinglist.NotFoundError{
+		Message: *body.Message,
 	}
 
 	return v
 }
 
-// NewGetGroupsioArtifactInternalServerError builds a mailing-list service
-// get-groupsio-artifact endpoint InternalServerError error.
-func NewGetGroupsioArtifactInternalServerError(body *GetGroupsioArtifactInternalServerErrorResponseBody) *mailinglist.InternalServerError {
-	v := &mailinglist.InternalServerError{
+// NewAddGroupsioMemberServiceUnavailable builds a mailing-list service
+// add-groupsio-member endpoint ServiceUnavailable error.
+func NewAddGroupsioMemberServiceUnavailable(body *AddGroupsioMemberServiceUnavailableResponseBody) *mailinglist.ServiceUnavailableError {
+	v := &mailinglist.ServiceUnavailableError{
 		Message: *body.Message,
 	}
 
 	return v
 }
 
-// NewGetGroupsioArtifactNotFound builds a mailing-list service
-// get-groupsio-artifact endpoint NotFound error.
-func NewGetGroupsioArtifactNotFound(body *GetGroupsioArtifactNotFoundResponseBody) *mailinglist.NotFoundError {
-	v := &mailinglist.NotFoundError{
-		Message: *body.Message,
+// NewUpsertGroupsioMemberGroupsioMemberUpsertResultOK builds a "mailing-list"
+// service "upsert-groupsio-member" endpoint result from a HTTP "OK" response.
+func NewUpsertGroupsioMemberGroupsioMemberUpsertResultOK(body *UpsertGroupsioMemberResponseBody) *mailinglist.GroupsioMemberUpsertResult {
+	v := &mailinglist.GroupsioMemberUpsertResult{
+		Created: *body.Created,
 	}
+	v.Member = unmarshalGroupsioMemberResponseBodyToMailinglistGroupsioMember(body.Member)
 
 	return v
 }
 
-// NewGetGroupsioArtifactServiceUnavailable builds a mailing-list service
-// get-groupsio-artifact endpoint ServiceUnavailable error.
-func NewGetGroupsioArtifactServiceUnavailable(body *GetGroupsioArtifactServiceUnavailableResponseBody) *mailinglist.ServiceUnavailableError {
-	v := &mailinglist.ServiceUnavailableError{
+// NewUpsertGroupsioMemberBadRequest builds a mailing-list service
+// upsert-groupsio-member endpoint BadRequest error.
+func NewUpsertGroupsioMemberBadRequest(body *UpsertGroupsioMemberBadRequestResponseBody) *mailinglist.BadRequestError {
+	v := &mailinglist.BadRequestError{
 		Message: *body.Message,
 	}
 
 	return v
 }
 
-// NewGetGroupsioArtifactDownloadGroupsioArtifactDownloadOK builds a
-// "mailing-list" service "get-groupsio-artifact-download" endpoint result from
-// a HTTP "OK" response.
-func NewGetGroupsioArtifactDownloadGroupsioArtifactDownloadOK(body *GetGroupsioArtifactDownloadResponseBody) *mailinglist.GroupsioArtifactDownload {
-	v := &mailinglist.GroupsioArtifactDownload{
-		URL: *body.URL,
+// NewUpsertGroupsioMemberConflict builds a mailing-list service
+// upsert-groupsio-member endpoint Conflict error.
+func NewUpsertGroupsioMemberConflict(body *UpsertGroupsioMemberConflictResponseBody) *mailinglist.ConflictError {
+	v := &mailinglist.ConflictError{
+		Message: *body.Message,
 	}
 
 	return v
 }
 
-// NewGetGroupsioArtifactDownloadInternalServerError builds a mailing-list
-// service get-groupsio-artifact-download endpoint InternalServerError error.
-func NewGetGroupsioArtifactDownloadInternalServerError(body *GetGroupsioArtifactDownloadInternalServerErrorResponseBody) *mailinglist.InternalServerError {
+// NewUpsertGroupsioMemberInternalServerError builds a mailing-list service
+// upsert-groupsio-member endpoint InternalServerError error.
+func NewUpsertGroupsioMemberInternalServerError(body *UpsertGroupsioMemberInternalServerErrorResponseBody) *mailinglist.InternalServerError {
 	v := &mailinglist.InternalServerError{
 		Message: *body.Message,
 	}
@@ -2480,9 +3793,9 @@ func NewGetGroupsioArtifactDownloadInternalServerError(body *GetGroupsioArtifact
 	return v
 }
 
-// NewGetGroupsioArtifactDownloadNotFound builds a mailing-list service
-// get-groupsio-artifact-download endpoint NotFound error.
-func NewGetGroupsioArtifactDownloadNotFound(body *GetGroupsioArtifactDownloadNotFoundResponseBody) *mailinglist.NotFoundError {
+// NewUpsertGroupsioMemberNotFound builds a mailing-list service
+// upsert-groupsio-member endpoint NotFound error.
+func NewUpsertGroupsioMemberNotFound(body *UpsertGroupsioMemberNotFoundResponseBody) *mailinglist.NotFoundError {
 	v := &mailinglist.NotFoundError{
 		Message: *body.Message,
 	}
@@ -2490,9 +3803,9 @@ func NewGetGroupsioArtifactDownloadNotFound(body *GetGroupsioArtifactDownloadNot
 	return v
 }
 
-// NewGetGroupsioArtifactDownloadServiceUnavailable builds a mailing-list
-// service get-groupsio-artifact-download endpoint ServiceUnavailable error.
-func NewGetGroupsioArtifactDownloadServiceUnavailable(body *GetGroupsioArtifactDownloadServiceUnavailableResponseBody) *mailinglist.ServiceUnavailableError {
+// NewUpsertGroupsioMemberServiceUnavailable builds a mailing-list service
+// upsert-groupsio-member endpoint ServiceUnavailable error.
+func NewUpsertGroupsioMemberServiceUnavailable(body *UpsertGroupsioMemberServiceUnavailableResponseBody) *mailinglist.ServiceUnavailableError {
 	v := &mailinglist.ServiceUnavailableError{
 		Message: *body.Message,
 	}
@@ -2500,48 +3813,959 @@ func NewGetGroupsioArtifactDownloadServiceUnavailable(body *GetGroupsioArtifactD
 	return v
 }
 
-// ValidateListGroupsioServicesResponseBody runs the validations defined on
-// List-Groupsio-ServicesResponseBody
-func ValidateListGroupsioServicesResponseBody(body *ListGroupsioServicesResponseBody) (err error) {
-	for _, e := range body.Items {
-		if e != nil {
-			if err2 := ValidateGroupsioServiceResponseBody(e); err2 != nil {
-				err = goa.MergeErrors(err, err2)
-			}
-		}
+// NewGetGroupsioMemberGroupsioMemberOK builds a "mailing-list" service
+// "get-groupsio-member" endpoint result from a HTTP "OK" response.
+func NewGetGroupsioMemberGroupsioMemberOK(body *GetGroupsioMemberResponseBody) *mailinglist.GroupsioMember {
+	v := &mailinglist.GroupsioMember{
+		ID:           body.ID,
+		Email:        body.Email,
+		Name:         body.Name,
+		MemberType:   body.MemberType,
+		DeliveryMode: body.DeliveryMode,
+		ModStatus:    body.ModStatus,
+		Status:       body.Status,
+		Organization: body.Organization,
+		JobTitle:     body.JobTitle,
+		Username:     body.Username,
+		Role:         body.Role,
+		VotingStatus: body.VotingStatus,
+		CreatedAt:    body.CreatedAt,
+		UpdatedAt:    body.UpdatedAt,
 	}
-	return
-}
 
-// ValidateCreateGroupsioServiceResponseBody runs the validations defined on
-// Create-Groupsio-ServiceResponseBody
-func ValidateCreateGroupsioServiceResponseBody(body *CreateGroupsioServiceResponseBody) (err error) {
-	if body.ProjectUID != nil {
-		err = goa.MergeErrors(err, goa.ValidateFormat("body.project_uid", *body.ProjectUID, goa.FormatUUID))
-	}
-	return
+	return v
 }
 
-// ValidateGetGroupsioServiceResponseBody runs the validations defined on
-// Get-Groupsio-ServiceResponseBody
-func ValidateGetGroupsioServiceResponseBody(body *GetGroupsioServiceResponseBody) (err error) {
-	if body.ProjectUID != nil {
-		err = goa.MergeErrors(err, goa.ValidateFormat("body.project_uid", *body.ProjectUID, goa.FormatUUID))
+// NewGetGroupsioMemberInternalServerError builds a mailing-list service
+// get-groupsio-member endpoint InternalServerError error.
+func NewGetGroupsioMemberInternalServerError(body *GetGroupsioMemberInternalServerErrorResponseBody) *mailinglist.InternalServerError {
+	v := &mailinglist.InternalServerError{
+		Message: *body.Message,
 	}
-	return
+
+	return v
 }
 
-// ValidateUpdateGroupsioServiceResponseBody runs the validations defined on
-// Update-Groupsio-ServiceResponseBody
-func ValidateUpdateGroupsioServiceResponseBody(body *UpdateGroupsioServiceResponseBody) (err error) {
-	if body.ProjectUID != nil {
-		err = goa.MergeErrors(err, goa.ValidateFormat("body.project_uid", *body.ProjectUID, goa.FormatUUID))
+// NewGetGroupsioMemberNotFound builds a mailing-list service
+// get-groupsio-member endpoint NotFound error.
+func NewGetGroupsioMemberNotFound(body *GetGroupsioMemberNotFoundResponseBody) *mailinglist.NotFoundError {
+	v := &mailinglist.NotFoundError{
+		Message: *body.Message,
 	}
-	return
+
+	return v
 }
 
-// ValidateFindParentGroupsioServiceResponseBody runs the validations defined
-// on Find-Parent-Groupsio-ServiceResponseBody
+// NewGetGroupsioMemberServiceUnavailable builds a mailing-list service
+// get-groupsio-member endpoint ServiceUnavailable error.
+func NewGetGroupsioMemberServiceUnavailable(body *GetGroupsioMemberServiceUnavailableResponseBody) *mailinglist.ServiceUnavailableError {
+	v := &mailinglist.ServiceUnavailableError{
+		Message: *body.Message,
+	}
+
+	return v
+}
+
+// NewUpdateGroupsioMemberGroupsioMemberOK builds a "mailing-list" service
+// "update-groupsio-member" endpoint result from a HTTP "OK" response.
+func NewUpdateGroupsioMemberGroupsioMemberOK(body *UpdateGroupsioMemberResponseBody) *mailinglist.GroupsioMember {
+	v := &mailinglist.GroupsioMember{
+		ID:           body.ID,
+		Email:        body.Email,
+		Name:         body.Name,
+		MemberType:   body.MemberType,
+		DeliveryMode: body.DeliveryMode,
+		ModStatus:    body.ModStatus,
+		Status:       body.Status,
+		Organization: body.Organization,
+		JobTitle:     body.JobTitle,
+		Username:     body.Username,
+		Role:         body.Role,
+		VotingStatus: body.VotingStatus,
+		CreatedAt:    body.CreatedAt,
+		UpdatedAt:    body.UpdatedAt,
+	}
+
+	return v
+}
+
+// NewUpdateGroupsioMemberBadRequest builds a mailing-list service
+// update-groupsio-member endpoint BadRequest error.
+func NewUpdateGroupsioMemberBadRequest(body *UpdateGroupsioMemberBadRequestResponseBody) *mailinglist.BadRequestError {
+	v := &mailinglist.BadRequestError{
+		Message: *body.Message,
+	}
+
+	return v
+}
+
+// NewUpdateGroupsioMemberInternalServerError builds a mailing-list service
+// update-groupsio-member endpoint InternalServerError error.
+func NewUpdateGroupsioMemberInternalServerError(body *UpdateGroupsioMemberInternalServerErrorResponseBody) *mailinglist.InternalServerError {
+	v := &mailinglist.InternalServerError{
+		Message: *body.Message,
+	}
+
+	return v
+}
+
+// NewUpdateGroupsioMemberNotFound builds a mailing-list service
+// update-groupsio-member endpoint NotFound error.
+func NewUpdateGroupsioMemberNotFound(body *UpdateGroupsioMemberNotFoundResponseBody) *mailinglist.NotFoundError {
+	v := &mailinglist.NotFoundError{
+		Message: *body.Message,
+	}
+
+	return v
+}
+
+// NewUpdateGroupsioMemberServiceUnavailable builds a mailing-list service
+// update-groupsio-member endpoint ServiceUnavailable error.
+func NewUpdateGroupsioMemberServiceUnavailable(body *UpdateGroupsioMemberServiceUnavailableResponseBody) *mailinglist.ServiceUnavailableError {
+	v := &mailinglist.ServiceUnavailableError{
+		Message: *body.Message,
+	}
+
+	return v
+}
+
+// NewDeleteGroupsioMemberInternalServerError builds a mailing-list service
+// delete-groupsio-member endpoint InternalServerError error.
+func NewDeleteGroupsioMemberInternalServerError(body *DeleteGroupsioMemberInternalServerErrorResponseBody) *mailinglist.InternalServerError {
+	v := &mailinglist.InternalServerError{
+		Message: *body.Message,
+	}
+
+	return v
+}
+
+// NewDeleteGroupsioMemberNotFound builds a mailing-list service
+// delete-groupsio-member endpoint NotFound error.
+func NewDeleteGroupsioMemberNotFound(body *DeleteGroupsioMemberNotFoundResponseBody) *mailinglist.NotFoundError {
+	v := &mailinglist.NotFoundError{
+		Message: *body.Message,
+	}
+
+	return v
+}
+
+// NewDeleteGroupsioMemberServiceUnavailable builds a mailing-list service
+// delete-groupsio-member endpoint ServiceUnavailable error.
+func NewDeleteGroupsioMemberServiceUnavailable(body *DeleteGroupsioMemberServiceUnavailableResponseBody) *mailinglist.ServiceUnavailableError {
+	v := &mailinglist.ServiceUnavailableError{
+		Message: *body.Message,
+	}
+
+	return v
+}
+
+// NewInviteGroupsioMembersBadRequest builds a mailing-list service
+// invite-groupsio-members endpoint BadRequest error.
+func NewInviteGroupsioMembersBadRequest(body *InviteGroupsioMembersBadRequestResponseBody) *mailinglist.BadRequestError {
+	v := &mailinglist.BadRequestError{
+		Message: *body.Message,
+	}
+
+	return v
+}
+
+// NewInviteGroupsioMembersInternalServerError builds a mailing-list service
+// invite-groupsio-members endpoint InternalServerError error.
+func NewInviteGroupsioMembersInternalServerError(body *InviteGroupsioMembersInternalServerErrorResponseBody) *mailinglist.InternalServerError {
+	v := &mailinglist.InternalServerError{
+		Message: *body.Message,
+	}
+
+	return v
+}
+
+// NewInviteGroupsioMembersNotFound builds a mailing-list service
+// invite-groupsio-members endpoint NotFound error.
+func NewInviteGroupsioMembersNotFound(body *InviteGroupsioMembersNotFoundResponseBody) *mailinglist.NotFoundError {
+	v := &mailinglist.NotFoundError{
+		Message: *body.Message,
+	}
+
+	return v
+}
+
+// NewInviteGroupsioMembersServiceUnavailable builds a mailing-list service
+// invite-groupsio-members endpoint ServiceUnavailable error.
+func NewInviteGroupsioMembersServiceUnavailable(body *InviteGroupsioMembersServiceUnavailableResponseBody) *mailinglist.ServiceUnavailableError {
+	v := &mailinglist.ServiceUnavailableError{
+		Message: *body.Message,
+	}
+
+	return v
+}
+
+// NewImportGroupsioMembersGroupsioImportSummaryOK builds a "mailing-list"
+// service "import-groupsio-members" endpoint result from a HTTP "OK" response.
+func NewImportGroupsioMembersGroupsioImportSummaryOK(body *ImportGroupsioMembersResponseBody) *mailinglist.GroupsioImportSummary {
+	v := &mailinglist.GroupsioImportSummary{
+		Created: *body.Created,
+		Skipped: *body.Skipped,
+		Errored: *body.Errored,
+	}
+	if body.SkippedEmails != nil {
+		v.SkippedEmails = make([]string, len(body.SkippedEmails))
+		for i, val := range body.SkippedEmails {
+			v.SkippedEmails[i] = val
+		}
+	}
+	if body.ErroredEmails != nil {
+		v.ErroredEmails = make([]string, len(body.ErroredEmails))
+		for i, val := range body.ErroredEmails {
+			v.ErroredEmails[i] = val
+		}
+	}
+
+	return v
+}
+
+// NewImportGroupsioMembersBadRequest builds a mailing-list service
+// import-groupsio-members endpoint BadRequest error.
+func NewImportGroupsioMembersBadRequest(body *ImportGroupsioMembersBadRequestResponseBody) *mailinglist.BadRequestError {
+	v := &mailinglist.BadRequestError{
+		Message: *body.Message,
+	}
+
+	return v
+}
+
+// NewImportGroupsioMembersConflict builds a mailing-list service
+// import-groupsio-members endpoint Conflict error.
+func NewImportGroupsioMembersConflict(body *ImportGroupsioMembersConflictResponseBody) *mailinglist.ConflictError {
+	v := &mailinglist.ConflictError{
+		Message: *body.Message,
+	}
+
+	return v
+}
+
+// NewImportGroupsioMembersInternalServerError builds a mailing-list service
+// import-groupsio-members endpoint InternalServerError error.
+func NewImportGroupsioMembersInternalServerError(body *ImportGroupsioMembersInternalServerErrorResponseBody) *mailinglist.InternalServerError {
+	v := &mailinglist.InternalServerError{
+		Message: *body.Message,
+	}
+
+	return v
+}
+
+// NewImportGroupsioMembersNotFound builds a mailing-list service
+// import-groupsio-members endpoint NotFound error.
+func NewImportGroupsioMembersNotFound(body *ImportGroupsioMembersNotFoundResponseBody) *mailinglist.NotFoundError {
+	v := &mailinglist.NotFoundError{
+		Message: *body.Message,
+	}
+
+	return v
+}
+
+// NewImportGroupsioMembersServiceUnavailable builds a mailing-list service
+// import-groupsio-members endpoint ServiceUnavailable error.
+func NewImportGroupsioMembersServiceUnavailable(body *ImportGroupsioMembersServiceUnavailableResponseBody) *mailinglist.ServiceUnavailableError {
+	v := &mailinglist.ServiceUnavailableError{
+		Message: *body.Message,
+	}
+
+	return v
+}
+
+// NewMergeGroupsioMembersBadRequest builds a mailing-list service
+// merge-groupsio-members endpoint BadRequest error.
+func NewMergeGroupsioMembersBadRequest(body *MergeGroupsioMembersBadRequestResponseBody) *mailinglist.BadRequestError {
+	v := &mailinglist.BadRequestError{
+		Message: *body.Message,
+	}
+
+	return v
+}
+
+// NewMergeGroupsioMembersInternalServerError builds a mailing-list service
+// merge-groupsio-members endpoint InternalServerError error.
+func NewMergeGroupsioMembersInternalServerError(body *MergeGroupsioMembersInternalServerErrorResponseBody) *mailinglist.InternalServerError {
+	v := &mailinglist.InternalServerError{
+		Message: *body.Message,
+	}
+
+	return v
+}
+
+// NewMergeGroupsioMembersNotFound builds a mailing-list service
+// merge-groupsio-members endpoint NotFound error.
+func NewMergeGroupsioMembersNotFound(body *MergeGroupsioMembersNotFoundResponseBody) *mailinglist.NotFoundError {
+	v := &mailinglist.NotFoundError{
+		Message: *body.Message,
+	}
+
+	return v
+}
+
+// NewMergeGroupsioMembersServiceUnavailable builds a mailing-list service
+// merge-groupsio-members endpoint ServiceUnavailable error.
+func NewMergeGroupsioMembersServiceUnavailable(body *MergeGroupsioMembersServiceUnavailableResponseBody) *mailinglist.ServiceUnavailableError {
+	v := &mailinglist.ServiceUnavailableError{
+		Message: *body.Message,
+	}
+
+	return v
+}
+
+// NewChangeGroupsioMemberEmailGroupsioMemberOK builds a "mailing-list" service
+// "change-groupsio-member-email" endpoint result from a HTTP "OK" response.
+func NewChangeGroupsioMemberEmailGroupsioMemberOK(body *ChangeGroupsioMemberEmailResponseBody) *mailinglist.GroupsioMember {
+	v := &mailinglist.GroupsioMember{
+		ID:           body.ID,
+		Email:        body.Email,
+		Name:         body.Name,
+		MemberType:   body.MemberType,
+		DeliveryMode: body.DeliveryMode,
+		ModStatus:    body.ModStatus,
+		Status:       body.Status,
+		Organization: body.Organization,
+		JobTitle:     body.JobTitle,
+		Username:     body.Username,
+		Role:         body.Role,
+		VotingStatus: body.VotingStatus,
+		CreatedAt:    body.CreatedAt,
+		UpdatedAt:    body.UpdatedAt,
+	}
+
+	return v
+}
+
+// NewChangeGroupsioMemberEmailBadRequest builds a mailing-list service
+// change-groupsio-member-email endpoint BadRequest error.
+func NewChangeGroupsioMemberEmailBadRequest(body *ChangeGroupsioMemberEmailBadRequestResponseBody) *mailinglist.BadRequestError {
+	v := &mailinglist.BadRequestError{
+		Message: *body.Message,
+	}
+
+	return v
+}
+
+// NewChangeGroupsioMemberEmailConflict builds a mailing-list service
+// change-groupsio-member-email endpoint Conflict error.
+func NewChangeGroupsioMemberEmailConflict(body *ChangeGroupsioMemberEmailConflictResponseBody) *mailinglist.ConflictError {
+	v := &mailinglist.ConflictError{
+		Message: *body.Message,
+	}
+
+	return v
+}
+
+// NewChangeGroupsioMemberEmailInternalServerError builds a mailing-list
+// service change-groupsio-member-email endpoint InternalServerError error.
+func NewChangeGroupsioMemberEmailInternalServerError(body *ChangeGroupsioMemberEmailInternalServerErrorResponseBody) *mailinglist.InternalServerError {
+	v := &mailinglist.InternalServerError{
+		Message: *body.Message,
+	}
+
+	return v
+}
+
+// NewChangeGroupsioMemberEmailNotFound builds a mailing-list service
+// change-groupsio-member-email endpoint NotFound error.
+func NewChangeGroupsioMemberEmailNotFound(body *ChangeGroupsioMemberEmailNotFoundResponseBody) *mailinglist.NotFoundError {
+	v := &mailinglist.NotFoundError{
+		Message: *body.Message,
+	}
+
+	return v
+}
+
+// NewChangeGroupsioMemberEmailServiceUnavailable builds a mailing-list service
+// change-groupsio-member-email endpoint ServiceUnavailable error.
+func NewChangeGroupsioMemberEmailServiceUnavailable(body *ChangeGroupsioMemberEmailServiceUnavailableResponseBody) *mailinglist.ServiceUnavailableError {
+	v := &mailinglist.ServiceUnavailableError{
+		Message: *body.Message,
+	}
+
+	return v
+}
+
+// NewCheckGroupsioSubscriberGroupsioCheckSubscriberResponseOK builds a
+// "mailing-list" service "check-groupsio-subscriber" endpoint result from a
+// HTTP "OK" response.
+func NewCheckGroupsioSubscriberGroupsioCheckSubscriberResponseOK(body *CheckGroupsioSubscriberResponseBody) *mailinglist.GroupsioCheckSubscriberResponse {
+	v := &mailinglist.GroupsioCheckSubscriberResponse{
+		Subscribed: *body.Subscribed,
+	}
+
+	return v
+}
+
+// NewCheckGroupsioSubscriberBadRequest builds a mailing-list service
+// check-groupsio-subscriber endpoint BadRequest error.
+func NewCheckGroupsioSubscriberBadRequest(body *CheckGroupsioSubscriberBadRequestResponseBody) *mailinglist.BadRequestError {
+	v := &mailinglist.BadRequestError{
+		Message: *body.Message,
+	}
+
+	return v
+}
+
+// NewCheckGroupsioSubscriberInternalServerError builds a mailing-list service
+// check-groupsio-subscriber endpoint InternalServerError error.
+func NewCheckGroupsioSubscriberInternalServerError(body *CheckGroupsioSubscriberInternalServerErrorResponseBody) *mailinglist.InternalServerError {
+	v := &mailinglist.InternalServerError{
+		Message: *body.Message,
+	}
+
+	return v
+}
+
+// NewCheckGroupsioSubscriberServiceUnavailable builds a mailing-list service
+// check-groupsio-subscriber endpoint ServiceUnavailable error.
+func NewCheckGroupsioSubscriberServiceUnavailable(body *CheckGroupsioSubscriberServiceUnavailableResponseBody) *mailinglist.ServiceUnavailableError {
+	v := &mailinglist.ServiceUnavailableError{
+		Message: *body.Message,
+	}
+
+	return v
+}
+
+// NewGetGroupsioArtifactGroupsioArtifactOK builds a "mailing-list" service
+// "get-groupsio-artifact" endpoint result from a HTTP "OK" response.
+func NewGetGroupsioArtifactGroupsioArtifactOK(body *GetGroupsioArtifactResponseBody) *mailinglist.GroupsioArtifact {
+	v := &mailinglist.GroupsioArtifact{
+		ArtifactID:          body.ArtifactID,
+		GroupID:             body.GroupID,
+		ProjectID:           body.ProjectID,
+		CommitteeID:         body.CommitteeID,
+		Type:                body.Type,
+		MediaType:           body.MediaType,
+		Filename:            body.Filename,
+		LinkURL:             body.LinkURL,
+		DownloadURL:         body.DownloadURL,
+		S3Key:               body.S3Key,
+		FileUploaded:        body.FileUploaded,
+		FileUploadStatus:    body.FileUploadStatus,
+		FileUploadedAt:      body.FileUploadedAt,
+		LastPostedAt:        body.LastPostedAt,
+		LastPostedMessageID: body.LastPostedMessageID,
+		Description:         body.Description,
+		CreatedAt:           body.CreatedAt,
+		UpdatedAt:           body.UpdatedAt,
+	}
+	if body.MessageIds != nil {
+		v.MessageIds = make([]uint64, len(body.MessageIds))
+		for i, val := range body.MessageIds {
+			v.MessageIds[i] = val
+		}
+	}
+	if body.CreatedBy != nil {
+		v.CreatedBy = unmarshalGroupsioArtifactUserResponseBodyToMailinglistGroupsioArtifactUser(body.CreatedBy)
+	}
+	if body.LastModifiedBy != nil {
+		v.LastModifiedBy = unmarshalGroupsioArtifactUserResponseBodyToMailinglistGroupsioArtifactUser(body.LastModifiedBy)
+	}
+
+	return v
+}
+
+// NewGetGroupsioArtifactInternalServerError builds a mailing-list service
+// get-groupsio-artifact endpoint InternalServerError error.
+func NewGetGroupsioArtifactInternalServerError(body *GetGroupsioArtifactInternalServerErrorResponseBody) *mailinglist.InternalServerError {
+	v := &mailinglist.InternalServerError{
+		Message: *body.Message,
+	}
+
+	return v
+}
+
+// NewGetGroupsioArtifactNotFound builds a mailing-list service
+// get-groupsio-artifact endpoint NotFound error.
+func NewGetGroupsioArtifactNotFound(body *GetGroupsioArtifactNotFoundResponseBody) *mailinglist.NotFoundError {
+	v := &mailinglist.NotFoundError{
+		Message: *body.Message,
+	}
+
+	return v
+}
+
+// NewGetGroupsioArtifactServiceUnavailable builds a mailing-list service
+// get-groupsio-artifact endpoint ServiceUnavailable error.
+func NewGetGroupsioArtifactServiceUnavailable(body *GetGroupsioArtifactServiceUnavailableResponseBody) *mailinglist.ServiceUnavailableError {
+	v := &mailinglist.ServiceUnavailableError{
+		Message: *body.Message,
+	}
+
+	return v
+}
+
+// NewGetGroupsioArtifactDownloadGroupsioArtifactDownloadOK builds a
+// "mailing-list" service "get-groupsio-artifact-download" endpoint result from
+// a HTTP "OK" response.
+func NewGetGroupsioArtifactDownloadGroupsioArtifactDownloadOK(body *GetGroupsioArtifactDownloadResponseBody) *mailinglist.GroupsioArtifactDownload {
+	v := &mailinglist.GroupsioArtifactDownload{
+		URL: *body.URL,
+	}
+
+	return v
+}
+
+// NewGetGroupsioArtifactDownloadInternalServerError builds a mailing-list
+// service get-groupsio-artifact-download endpoint InternalServerError error.
+func NewGetGroupsioArtifactDownloadInternalServerError(body *GetGroupsioArtifactDownloadInternalServerErrorResponseBody) *mailinglist.InternalServerError {
+	v := &mailinglist.InternalServerError{
+		Message: *body.Message,
+	}
+
+	return v
+}
+
+// NewGetGroupsioArtifactDownloadNotFound builds a mailing-list service
+// get-groupsio-artifact-download endpoint NotFound error.
+func NewGetGroupsioArtifactDownloadNotFound(body *GetGroupsioArtifactDownloadNotFoundResponseBody) *mailinglist.NotFoundError {
+	v := &mailinglist.NotFoundError{
+		Message: *body.Message,
+	}
+
+	return v
+}
+
+// NewGetGroupsioArtifactDownloadServiceUnavailable builds a mailing-list
+// service get-groupsio-artifact-download endpoint ServiceUnavailable error.
+func NewGetGroupsioArtifactDownloadServiceUnavailable(body *GetGroupsioArtifactDownloadServiceUnavailableResponseBody) *mailinglist.ServiceUnavailableError {
+	v := &mailinglist.ServiceUnavailableError{
+		Message: *body.Message,
+	}
+
+	return v
+}
+
+// NewGetGroupsioProjectSummaryGroupsioProjectSummaryOK builds a "mailing-list"
+// service "get-groupsio-project-summary" endpoint result from a HTTP "OK"
+// response.
+func NewGetGroupsioProjectSummaryGroupsioProjectSummaryOK(body *GetGroupsioProjectSummaryResponseBody) *mailinglist.GroupsioProjectSummary {
+	v := &mailinglist.GroupsioProjectSummary{
+		ProjectUID:       *body.ProjectUID,
+		ServiceCount:     *body.ServiceCount,
+		MailingListCount: *body.MailingListCount,
+		MemberCount:      *body.MemberCount,
+	}
+
+	return v
+}
+
+// NewGetGroupsioProjectSummaryBadRequest builds a mailing-list service
+// get-groupsio-project-summary endpoint BadRequest error.
+func NewGetGroupsioProjectSummaryBadRequest(body *GetGroupsioProjectSummaryBadRequestResponseBody) *mailinglist.BadRequestError {
+	v := &mailinglist.BadRequestError{
+		Message: *body.Message,
+	}
+
+	return v
+}
+
+// NewGetGroupsioProjectSummaryInternalServerError builds a mailing-list
+// service get-groupsio-project-summary endpoint InternalServerError error.
+func NewGetGroupsioProjectSummaryInternalServerError(body *GetGroupsioProjectSummaryInternalServerErrorResponseBody) *mailinglist.InternalServerError {
+	v := &mailinglist.InternalServerError{
+		Message: *body.Message,
+	}
+
+	return v
+}
+
+// NewGetGroupsioProjectSummaryServiceUnavailable builds a mailing-list service
+// get-groupsio-project-summary endpoint ServiceUnavailable error.
+func NewGetGroupsioProjectSummaryServiceUnavailable(body *GetGroupsioProjectSummaryServiceUnavailableResponseBody) *mailinglist.ServiceUnavailableError {
+	v := &mailinglist.ServiceUnavailableError{
+		Message: *body.Message,
+	}
+
+	return v
+}
+
+// NewListGroupsioOrphanedMailingListsGroupsioSubgroupListOK builds a
+// "mailing-list" service "list-groupsio-orphaned-mailing-lists" endpoint
+// result from a HTTP "OK" response.
+func NewListGroupsioOrphanedMailingListsGroupsioSubgroupListOK(body *ListGroupsioOrphanedMailingListsResponseBody) *mailinglist.GroupsioSubgroupList {
+	v := &mailinglist.GroupsioSubgroupList{
+		Total: body.Total,
+	}
+	if body.Items != nil {
+		v.Items = make([]*mailinglist.GroupsioSubgroup, len(body.Items))
+		for i, val := range body.Items {
+			v.Items[i] = unmarshalGroupsioSubgroupResponseBodyToMailinglistGroupsioSubgroup(val)
+		}
+	}
+
+	return v
+}
+
+// NewListGroupsioOrphanedMailingListsBadRequest builds a mailing-list service
+// list-groupsio-orphaned-mailing-lists endpoint BadRequest error.
+func NewListGroupsioOrphanedMailingListsBadRequest(body *ListGroupsioOrphanedMailingListsBadRequestResponseBody) *mailinglist.BadRequestError {
+	v := &mailinglist.BadRequestError{
+		Message: *body.Message,
+	}
+
+	return v
+}
+
+// NewListGroupsioOrphanedMailingListsInternalServerError builds a mailing-list
+// service list-groupsio-orphaned-mailing-lists endpoint InternalServerError
+// error.
+func NewListGroupsioOrphanedMailingListsInternalServerError(body *ListGroupsioOrphanedMailingListsInternalServerErrorResponseBody) *mailinglist.InternalServerError {
+	v := &mailinglist.InternalServerError{
+		Message: *body.Message,
+	}
+
+	return v
+}
+
+// NewListGroupsioOrphanedMailingListsServiceUnavailable builds a mailing-list
+// service list-groupsio-orphaned-mailing-lists endpoint ServiceUnavailable
+// error.
+func NewListGroupsioOrphanedMailingListsServiceUnavailable(body *ListGroupsioOrphanedMailingListsServiceUnavailableResponseBody) *mailinglist.ServiceUnavailableError {
+	v := &mailinglist.ServiceUnavailableError{
+		Message: *body.Message,
+	}
+
+	return v
+}
+
+// NewRepublishGroupsioProjectIndexGroupsioRepublishSummaryOK builds a
+// "mailing-list" service "republish-groupsio-project-index" endpoint result
+// from a HTTP "OK" response.
+func NewRepublishGroupsioProjectIndexGroupsioRepublishSummaryOK(body *RepublishGroupsioProjectIndexResponseBody) *mailinglist.GroupsioRepublishSummary {
+	v := &mailinglist.GroupsioRepublishSummary{
+		Published: *body.Published,
+	}
+
+	return v
+}
+
+// NewRepublishGroupsioProjectIndexBadRequest builds a mailing-list service
+// republish-groupsio-project-index endpoint BadRequest error.
+func NewRepublishGroupsioProjectIndexBadRequest(body *RepublishGroupsioProjectIndexBadRequestResponseBody) *mailinglist.BadRequestError {
+	v := &mailinglist.BadRequestError{
+		Message: *body.Message,
+	}
+
+	return v
+}
+
+// NewRepublishGroupsioProjectIndexInternalServerError builds a mailing-list
+// service republish-groupsio-project-index endpoint InternalServerError error.
+func NewRepublishGroupsioProjectIndexInternalServerError(body *RepublishGroupsioProjectIndexInternalServerErrorResponseBody) *mailinglist.InternalServerError {
+	v := &mailinglist.InternalServerError{
+		Message: *body.Message,
+	}
+
+	return v
+}
+
+// NewRepublishGroupsioProjectIndexServiceUnavailable builds a mailing-list
+// service republish-groupsio-project-index endpoint ServiceUnavailable error.
+func NewRepublishGroupsioProjectIndexServiceUnavailable(body *RepublishGroupsioProjectIndexServiceUnavailableResponseBody) *mailinglist.ServiceUnavailableError {
+	v := &mailinglist.ServiceUnavailableError{
+		Message: *body.Message,
+	}
+
+	return v
+}
+
+// NewGetGroupsioMailingListStatsGroupsioSubgroupStatsOK builds a
+// "mailing-list" service "get-groupsio-mailing-list-stats" endpoint result
+// from a HTTP "OK" response.
+func NewGetGroupsioMailingListStatsGroupsioSubgroupStatsOK(body *GetGroupsioMailingListStatsResponseBody) *mailinglist.GroupsioSubgroupStats {
+	v := &mailinglist.GroupsioSubgroupStats{
+		SubgroupID:  *body.SubgroupID,
+		MemberCount: *body.MemberCount,
+		BounceCount: *body.BounceCount,
+		CreatedAt:   body.CreatedAt,
+		UpdatedAt:   body.UpdatedAt,
+	}
+	v.MembersByDeliveryMode = make(map[string]int, len(body.MembersByDeliveryMode))
+	for key, val := range body.MembersByDeliveryMode {
+		tk := key
+		tv := val
+		v.MembersByDeliveryMode[tk] = tv
+	}
+
+	return v
+}
+
+// NewGetGroupsioMailingListStatsInternalServerError builds a mailing-list
+// service get-groupsio-mailing-list-stats endpoint InternalServerError error.
+func NewGetGroupsioMailingListStatsInternalServerError(body *GetGroupsioMailingListStatsInternalServerErrorResponseBody) *mailinglist.InternalServerError {
+	v := &mailinglist.InternalServerError{
+		Message: *body.Message,
+	}
+
+	return v
+}
+
+// NewGetGroupsioMailingListStatsNotFound builds a mailing-list service
+// get-groupsio-mailing-list-stats endpoint NotFound error.
+func NewGetGroupsioMailingListStatsNotFound(body *GetGroupsioMailingListStatsNotFoundResponseBody) *mailinglist.NotFoundError {
+	v := &mailinglist.NotFoundError{
+		Message: *body.Message,
+	}
+
+	return v
+}
+
+// NewGetGroupsioMailingListStatsServiceUnavailable builds a mailing-list
+// service get-groupsio-mailing-list-stats endpoint ServiceUnavailable error.
+func NewGetGroupsioMailingListStatsServiceUnavailable(body *GetGroupsioMailingListStatsServiceUnavailableResponseBody) *mailinglist.ServiceUnavailableError {
+	v := &mailinglist.ServiceUnavailableError{
+		Message: *body.Message,
+	}
+
+	return v
+}
+
+// NewGetGroupsioServiceTreeGroupsioServiceTreeOK builds a "mailing-list"
+// service "get-groupsio-service-tree" endpoint result from a HTTP "OK"
+// response.
+func NewGetGroupsioServiceTreeGroupsioServiceTreeOK(body *GetGroupsioServiceTreeResponseBody) *mailinglist.GroupsioServiceTree {
+	v := &mailinglist.GroupsioServiceTree{}
+	v.Service = unmarshalGroupsioServiceResponseBodyToMailinglistGroupsioService(body.Service)
+	v.MailingLists = make([]*mailinglist.GroupsioServiceTreeSubgroup, len(body.MailingLists))
+	for i, val := range body.MailingLists {
+		v.MailingLists[i] = unmarshalGroupsioServiceTreeSubgroupResponseBodyToMailinglistGroupsioServiceTreeSubgroup(val)
+	}
+
+	return v
+}
+
+// NewGetGroupsioServiceTreeInternalServerError builds a mailing-list service
+// get-groupsio-service-tree endpoint InternalServerError error.
+func NewGetGroupsioServiceTreeInternalServerError(body *GetGroupsioServiceTreeInternalServerErrorResponseBody) *mailinglist.InternalServerError {
+	v := &mailinglist.InternalServerError{
+		Message: *body.Message,
+	}
+
+	return v
+}
+
+// NewGetGroupsioServiceTreeNotFound builds a mailing-list service
+// get-groupsio-service-tree endpoint NotFound error.
+func NewGetGroupsioServiceTreeNotFound(body *GetGroupsioServiceTreeNotFoundResponseBody) *mailinglist.NotFoundError {
+	v := &mailinglist.NotFoundError{
+		Message: *body.Message,
+	}
+
+	return v
+}
+
+// NewGetGroupsioServiceTreeServiceUnavailable builds a mailing-list service
+// get-groupsio-service-tree endpoint ServiceUnavailable error.
+func NewGetGroupsioServiceTreeServiceUnavailable(body *GetGroupsioServiceTreeServiceUnavailableResponseBody) *mailinglist.ServiceUnavailableError {
+	v := &mailinglist.ServiceUnavailableError{
+		Message: *body.Message,
+	}
+
+	return v
+}
+
+// NewGetGroupsioMemberHierarchyGroupsioMemberHierarchyOK builds a
+// "mailing-list" service "get-groupsio-member-hierarchy" endpoint result from
+// a HTTP "OK" response.
+func NewGetGroupsioMemberHierarchyGroupsioMemberHierarchyOK(body *GetGroupsioMemberHierarchyResponseBody) *mailinglist.GroupsioMemberHierarchy {
+	v := &mailinglist.GroupsioMemberHierarchy{}
+	v.Member = unmarshalGroupsioMemberResponseBodyToMailinglistGroupsioMember(body.Member)
+	if body.Subgroup != nil {
+		v.Subgroup = unmarshalGroupsioSubgroupResponseBodyToMailinglistGroupsioSubgroup(body.Subgroup)
+	}
+	if body.Service != nil {
+		v.Service = unmarshalGroupsioServiceResponseBodyToMailinglistGroupsioService(body.Service)
+	}
+	if body.Project != nil {
+		v.Project = unmarshalGroupsioMemberHierarchyProjectResponseBodyToMailinglistGroupsioMemberHierarchyProject(body.Project)
+	}
+	v.Warnings = make([]string, len(body.Warnings))
+	for i, val := range body.Warnings {
+		v.Warnings[i] = val
+	}
+
+	return v
+}
+
+// NewGetGroupsioMemberHierarchyInternalServerError builds a mailing-list
+// service get-groupsio-member-hierarchy endpoint InternalServerError error.
+func NewGetGroupsioMemberHierarchyInternalServerError(body *GetGroupsioMemberHierarchyInternalServerErrorResponseBody) *mailinglist.InternalServerError {
+	v := &mailinglist.InternalServerError{
+		Message: *body.Message,
+	}
+
+	return v
+}
+
+// NewGetGroupsioMemberHierarchyNotFound builds a mailing-list service
+// get-groupsio-member-hierarchy endpoint NotFound error.
+func NewGetGroupsioMemberHierarchyNotFound(body *GetGroupsioMemberHierarchyNotFoundResponseBody) *mailinglist.NotFoundError {
+	v := &mailinglist.NotFoundError{
+		Message: *body.Message,
+	}
+
+	return v
+}
+
+// NewGetGroupsioMemberHierarchyServiceUnavailable builds a mailing-list
+// service get-groupsio-member-hierarchy endpoint ServiceUnavailable error.
+func NewGetGroupsioMemberHierarchyServiceUnavailable(body *GetGroupsioMemberHierarchyServiceUnavailableResponseBody) *mailinglist.ServiceUnavailableError {
+	v := &mailinglist.ServiceUnavailableError{
+		Message: *body.Message,
+	}
+
+	return v
+}
+
+// NewListGroupsioProjectMembersGroupsioMemberPageOK builds a "mailing-list"
+// service "list-groupsio-project-members" endpoint result from a HTTP "OK"
+// response.
+func NewListGroupsioProjectMembersGroupsioMemberPageOK(body *ListGroupsioProjectMembersResponseBody) *mailinglist.GroupsioMemberPage {
+	v := &mailinglist.GroupsioMemberPage{
+		NextCursor: body.NextCursor,
+	}
+	v.Items = make([]*mailinglist.GroupsioMember, len(body.Items))
+	for i, val := range body.Items {
+		v.Items[i] = unmarshalGroupsioMemberResponseBodyToMailinglistGroupsioMember(val)
+	}
+
+	return v
+}
+
+// NewListGroupsioProjectMembersBadRequest builds a mailing-list service
+// list-groupsio-project-members endpoint BadRequest error.
+func NewListGroupsioProjectMembersBadRequest(body *ListGroupsioProjectMembersBadRequestResponseBody) *mailinglist.BadRequestError {
+	v := &mailinglist.BadRequestError{
+		Message: *body.Message,
+	}
+
+	return v
+}
+
+// NewListGroupsioProjectMembersInternalServerError builds a mailing-list
+// service list-groupsio-project-members endpoint InternalServerError error.
+func NewListGroupsioProjectMembersInternalServerError(body *ListGroupsioProjectMembersInternalServerErrorResponseBody) *mailinglist.InternalServerError {
+	v := &mailinglist.InternalServerError{
+		Message: *body.Message,
+	}
+
+	return v
+}
+
+// NewListGroupsioProjectMembersServiceUnavailable builds a mailing-list
+// service list-groupsio-project-members endpoint ServiceUnavailable error.
+func NewListGroupsioProjectMembersServiceUnavailable(body *ListGroupsioProjectMembersServiceUnavailableResponseBody) *mailinglist.ServiceUnavailableError {
+	v := &mailinglist.ServiceUnavailableError{
+		Message: *body.Message,
+	}
+
+	return v
+}
+
+// ValidateReadyzResponseBody runs the validations defined on ReadyzResponseBody
+func ValidateReadyzResponseBody(body *ReadyzResponseBody) (err error) {
+	if body.Status == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("status", "body"))
+	}
+	if body.Status != nil {
+		if !(*body.Status == "ok" || *body.Status == "degraded") {
+			err = goa.MergeErrors(err, goa.InvalidEnumValueError("body.status", *body.Status, []any{"ok", "degraded"}))
+		}
+	}
+	return
+}
+
+// ValidateListGroupsioServicesResponseBody runs the validations defined on
+// List-Groupsio-ServicesResponseBody
+func ValidateListGroupsioServicesResponseBody(body *ListGroupsioServicesResponseBody) (err error) {
+	for _, e := range body.Items {
+		if e != nil {
+			if err2 := ValidateGroupsioServiceResponseBody(e); err2 != nil {
+				err = goa.MergeErrors(err, err2)
+			}
+		}
+	}
+	return
+}
+
+// ValidateCreateGroupsioServiceResponseBody runs the validations defined on
+// Create-Groupsio-ServiceResponseBody
+func ValidateCreateGroupsioServiceResponseBody(body *CreateGroupsioServiceResponseBody) (err error) {
+	if body.ProjectUID != nil {
+		err = goa.MergeErrors(err, goa.ValidateFormat("body.project_uid", *body.ProjectUID, goa.FormatUUID))
+	}
+	return
+}
+
+// ValidateGetGroupsioServiceResponseBody runs the validations defined on
+// Get-Groupsio-ServiceResponseBody
+func ValidateGetGroupsioServiceResponseBody(body *GetGroupsioServiceResponseBody) (err error) {
+	if body.ProjectUID != nil {
+		err = goa.MergeErrors(err, goa.ValidateFormat("body.project_uid", *body.ProjectUID, goa.FormatUUID))
+	}
+	return
+}
+
+// ValidateUpdateGroupsioServiceResponseBody runs the validations defined on
+// Update-Groupsio-ServiceResponseBody
+func ValidateUpdateGroupsioServiceResponseBody(body *UpdateGroupsioServiceResponseBody) (err error) {
+	if body.ProjectUID != nil {
+		err = goa.MergeErrors(err, goa.ValidateFormat("body.project_uid", *body.ProjectUID, goa.FormatUUID))
+	}
+	return
+}
+
+// ValidatePromoteGroupsioServiceResponseBody runs the validations defined on
+// Promote-Groupsio-ServiceResponseBody
+func ValidatePromoteGroupsioServiceResponseBody(body *PromoteGroupsioServiceResponseBody) (err error) {
+	if body.Service == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("service", "body"))
+	}
+	if body.Service != nil {
+		if err2 := ValidateGroupsioServiceResponseBody(body.Service); err2 != nil {
+			err = goa.MergeErrors(err, err2)
+		}
+	}
+	for _, e := range body.PrefixedSubgroups {
+		if e != nil {
+			if err2 := ValidateGroupsioSubgroupResponseBody(e); err2 != nil {
+				err = goa.MergeErrors(err, err2)
+			}
+		}
+	}
+	return
+}
+
+// ValidateListGroupsioServicesByStatusResponseBody runs the validations
+// defined on List-Groupsio-Services-By-StatusResponseBody
+func ValidateListGroupsioServicesByStatusResponseBody(body *ListGroupsioServicesByStatusResponseBody) (err error) {
+	for _, e := range body.Items {
+		if e != nil {
+			if err2 := ValidateGroupsioServiceResponseBody(e); err2 != nil {
+				err = goa.MergeErrors(err, err2)
+			}
+		}
+	}
+	return
+}
+
+// ValidateListUnprovisionedGroupsioServicesResponseBody runs the validations
+// defined on List-Unprovisioned-Groupsio-ServicesResponseBody
+func ValidateListUnprovisionedGroupsioServicesResponseBody(body *ListUnprovisionedGroupsioServicesResponseBody) (err error) {
+	for _, e := range body.Items {
+		if e != nil {
+			if err2 := ValidateGroupsioServiceResponseBody(e); err2 != nil {
+				err = goa.MergeErrors(err, err2)
+			}
+		}
+	}
+	return
+}
+
+// ValidateFindParentGroupsioServiceResponseBody runs the validations defined
+// on Find-Parent-Groupsio-ServiceResponseBody
 func ValidateFindParentGroupsioServiceResponseBody(body *FindParentGroupsioServiceResponseBody) (err error) {
 	if body.ProjectUID != nil {
 		err = goa.MergeErrors(err, goa.ValidateFormat("body.project_uid", *body.ProjectUID, goa.FormatUUID))
@@ -2549,867 +4773,1870 @@ func ValidateFindParentGroupsioServiceResponseBody(body *FindParentGroupsioServi
 	return
 }
 
-// ValidateListGroupsioMailingListsResponseBody runs the validations defined on
-// List-Groupsio-Mailing-ListsResponseBody
-func ValidateListGroupsioMailingListsResponseBody(body *ListGroupsioMailingListsResponseBody) (err error) {
-	for _, e := range body.Items {
-		if e != nil {
-			if err2 := ValidateGroupsioSubgroupResponseBody(e); err2 != nil {
-				err = goa.MergeErrors(err, err2)
-			}
-		}
+// ValidateListGroupsioMailingListsResponseBody runs the validations defined on
+// List-Groupsio-Mailing-ListsResponseBody
+func ValidateListGroupsioMailingListsResponseBody(body *ListGroupsioMailingListsResponseBody) (err error) {
+	for _, e := range body.Items {
+		if e != nil {
+			if err2 := ValidateGroupsioSubgroupResponseBody(e); err2 != nil {
+				err = goa.MergeErrors(err, err2)
+			}
+		}
+	}
+	return
+}
+
+// ValidateListGroupsioMailingListsByVisibilityResponseBody runs the
+// validations defined on List-Groupsio-Mailing-Lists-By-VisibilityResponseBody
+func ValidateListGroupsioMailingListsByVisibilityResponseBody(body *ListGroupsioMailingListsByVisibilityResponseBody) (err error) {
+	for _, e := range body.Items {
+		if e != nil {
+			if err2 := ValidateGroupsioSubgroupResponseBody(e); err2 != nil {
+				err = goa.MergeErrors(err, err2)
+			}
+		}
+	}
+	return
+}
+
+// ValidateListGroupsioMailingListsUpdatedSinceResponseBody runs the
+// validations defined on List-Groupsio-Mailing-Lists-Updated-SinceResponseBody
+func ValidateListGroupsioMailingListsUpdatedSinceResponseBody(body *ListGroupsioMailingListsUpdatedSinceResponseBody) (err error) {
+	for _, e := range body.Items {
+		if e != nil {
+			if err2 := ValidateGroupsioSubgroupResponseBody(e); err2 != nil {
+				err = goa.MergeErrors(err, err2)
+			}
+		}
+	}
+	return
+}
+
+// ValidateCreateGroupsioMailingListResponseBody runs the validations defined
+// on Create-Groupsio-Mailing-ListResponseBody
+func ValidateCreateGroupsioMailingListResponseBody(body *CreateGroupsioMailingListResponseBody) (err error) {
+	if body.ProjectUID != nil {
+		err = goa.MergeErrors(err, goa.ValidateFormat("body.project_uid", *body.ProjectUID, goa.FormatUUID))
+	}
+	if body.CommitteeUID != nil {
+		err = goa.MergeErrors(err, goa.ValidateFormat("body.committee_uid", *body.CommitteeUID, goa.FormatUUID))
+	}
+	return
+}
+
+// ValidateGetGroupsioMailingListResponseBody runs the validations defined on
+// Get-Groupsio-Mailing-ListResponseBody
+func ValidateGetGroupsioMailingListResponseBody(body *GetGroupsioMailingListResponseBody) (err error) {
+	if body.ProjectUID != nil {
+		err = goa.MergeErrors(err, goa.ValidateFormat("body.project_uid", *body.ProjectUID, goa.FormatUUID))
+	}
+	if body.CommitteeUID != nil {
+		err = goa.MergeErrors(err, goa.ValidateFormat("body.committee_uid", *body.CommitteeUID, goa.FormatUUID))
+	}
+	return
+}
+
+// ValidateUpdateGroupsioMailingListResponseBody runs the validations defined
+// on Update-Groupsio-Mailing-ListResponseBody
+func ValidateUpdateGroupsioMailingListResponseBody(body *UpdateGroupsioMailingListResponseBody) (err error) {
+	if body.ProjectUID != nil {
+		err = goa.MergeErrors(err, goa.ValidateFormat("body.project_uid", *body.ProjectUID, goa.FormatUUID))
+	}
+	if body.CommitteeUID != nil {
+		err = goa.MergeErrors(err, goa.ValidateFormat("body.committee_uid", *body.CommitteeUID, goa.FormatUUID))
+	}
+	return
+}
+
+// ValidateGetGroupsioMailingListCountResponseBody runs the validations defined
+// on Get-Groupsio-Mailing-List-CountResponseBody
+func ValidateGetGroupsioMailingListCountResponseBody(body *GetGroupsioMailingListCountResponseBody) (err error) {
+	if body.Count == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("count", "body"))
+	}
+	return
+}
+
+// ValidateGetGroupsioMailingListMemberCountResponseBody runs the validations
+// defined on Get-Groupsio-Mailing-List-Member-CountResponseBody
+func ValidateGetGroupsioMailingListMemberCountResponseBody(body *GetGroupsioMailingListMemberCountResponseBody) (err error) {
+	if body.Count == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("count", "body"))
+	}
+	return
+}
+
+// ValidateListGroupsioMembersResponseBody runs the validations defined on
+// List-Groupsio-MembersResponseBody
+func ValidateListGroupsioMembersResponseBody(body *ListGroupsioMembersResponseBody) (err error) {
+	for _, e := range body.Items {
+		if e != nil {
+			if err2 := ValidateGroupsioMemberResponseBody(e); err2 != nil {
+				err = goa.MergeErrors(err, err2)
+			}
+		}
+	}
+	return
+}
+
+// ValidateListGroupsioMembersNeedingReviewResponseBody runs the validations
+// defined on List-Groupsio-Members-Needing-ReviewResponseBody
+func ValidateListGroupsioMembersNeedingReviewResponseBody(body *ListGroupsioMembersNeedingReviewResponseBody) (err error) {
+	for _, e := range body.Items {
+		if e != nil {
+			if err2 := ValidateGroupsioMemberResponseBody(e); err2 != nil {
+				err = goa.MergeErrors(err, err2)
+			}
+		}
+	}
+	return
+}
+
+// ValidateListGroupsioMembersModifiedSinceResponseBody runs the validations
+// defined on List-Groupsio-Members-Modified-SinceResponseBody
+func ValidateListGroupsioMembersModifiedSinceResponseBody(body *ListGroupsioMembersModifiedSinceResponseBody) (err error) {
+	for _, e := range body.Items {
+		if e != nil {
+			if err2 := ValidateGroupsioMemberResponseBody(e); err2 != nil {
+				err = goa.MergeErrors(err, err2)
+			}
+		}
+	}
+	return
+}
+
+// ValidateListGroupsioMembersByOrganizationResponseBody runs the validations
+// defined on List-Groupsio-Members-By-OrganizationResponseBody
+func ValidateListGroupsioMembersByOrganizationResponseBody(body *ListGroupsioMembersByOrganizationResponseBody) (err error) {
+	for _, e := range body.Items {
+		if e != nil {
+			if err2 := ValidateGroupsioMemberResponseBody(e); err2 != nil {
+				err = goa.MergeErrors(err, err2)
+			}
+		}
+	}
+	return
+}
+
+// ValidateListGroupsioDuplicateMembersResponseBody runs the validations
+// defined on List-Groupsio-Duplicate-MembersResponseBody
+func ValidateListGroupsioDuplicateMembersResponseBody(body *ListGroupsioDuplicateMembersResponseBody) (err error) {
+	if body.Groups == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("groups", "body"))
+	}
+	return
+}
+
+// ValidatePreviewGroupsioDeliveryModeChangeResponseBody runs the validations
+// defined on Preview-Groupsio-Delivery-Mode-ChangeResponseBody
+func ValidatePreviewGroupsioDeliveryModeChangeResponseBody(body *PreviewGroupsioDeliveryModeChangeResponseBody) (err error) {
+	if body.TargetMode == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("target_mode", "body"))
+	}
+	if body.Affected == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("affected", "body"))
+	}
+	if body.Unchanged == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("unchanged", "body"))
+	}
+	return
+}
+
+// ValidateAddGroupsioMemberResponseBody runs the validations defined on
+// Add-Groupsio-MemberResponseBody
+func ValidateAddGroupsioMemberResponseBody(body *AddGroupsioMemberResponseBody) (err error) {
+	if body.Email != nil {
+		err = goa.MergeErrors(err, goa.ValidateFormat("body.email", *body.Email, goa.FormatEmail))
+	}
+	return
+}
+
+// ValidateUpsertGroupsioMemberResponseBody runs the validations defined on
+// Upsert-Groupsio-MemberResponseBody
+func ValidateUpsertGroupsioMemberResponseBody(body *UpsertGroupsioMemberResponseBody) (err error) {
+	if body.Member == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("member", "body"))
+	}
+	if body.Created == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("created", "body"))
+	}
+	if body.Member != nil {
+		if err2 := ValidateGroupsioMemberResponseBody(body.Member); err2 != nil {
+			err = goa.MergeErrors(err, err2)
+		}
+	}
+	return
+}
+
+// ValidateGetGroupsioMemberResponseBody runs the validations defined on
+// Get-Groupsio-MemberResponseBody
+func ValidateGetGroupsioMemberResponseBody(body *GetGroupsioMemberResponseBody) (err error) {
+	if body.Email != nil {
+		err = goa.MergeErrors(err, goa.ValidateFormat("body.email", *body.Email, goa.FormatEmail))
+	}
+	return
+}
+
+// ValidateUpdateGroupsioMemberResponseBody runs the validations defined on
+// Update-Groupsio-MemberResponseBody
+func ValidateUpdateGroupsioMemberResponseBody(body *UpdateGroupsioMemberResponseBody) (err error) {
+	if body.Email != nil {
+		err = goa.MergeErrors(err, goa.ValidateFormat("body.email", *body.Email, goa.FormatEmail))
+	}
+	return
+}
+
+// ValidateImportGroupsioMembersResponseBody runs the validations defined on
+// Import-Groupsio-MembersResponseBody
+func ValidateImportGroupsioMembersResponseBody(body *ImportGroupsioMembersResponseBody) (err error) {
+	if body.Created == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("created", "body"))
+	}
+	if body.Skipped == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("skipped", "body"))
+	}
+	if body.Errored == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("errored", "body"))
+	}
+	return
+}
+
+// ValidateChangeGroupsioMemberEmailResponseBody runs the validations defined
+// on Change-Groupsio-Member-EmailResponseBody
+func ValidateChangeGroupsioMemberEmailResponseBody(body *ChangeGroupsioMemberEmailResponseBody) (err error) {
+	if body.Email != nil {
+		err = goa.MergeErrors(err, goa.ValidateFormat("body.email", *body.Email, goa.FormatEmail))
+	}
+	return
+}
+
+// ValidateCheckGroupsioSubscriberResponseBody runs the validations defined on
+// Check-Groupsio-SubscriberResponseBody
+func ValidateCheckGroupsioSubscriberResponseBody(body *CheckGroupsioSubscriberResponseBody) (err error) {
+	if body.Subscribed == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("subscribed", "body"))
+	}
+	return
+}
+
+// ValidateGetGroupsioArtifactDownloadResponseBody runs the validations defined
+// on Get-Groupsio-Artifact-DownloadResponseBody
+func ValidateGetGroupsioArtifactDownloadResponseBody(body *GetGroupsioArtifactDownloadResponseBody) (err error) {
+	if body.URL == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("url", "body"))
+	}
+	return
+}
+
+// ValidateGetGroupsioProjectSummaryResponseBody runs the validations defined
+// on Get-Groupsio-Project-SummaryResponseBody
+func ValidateGetGroupsioProjectSummaryResponseBody(body *GetGroupsioProjectSummaryResponseBody) (err error) {
+	if body.ProjectUID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("project_uid", "body"))
+	}
+	if body.ServiceCount == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("service_count", "body"))
+	}
+	if body.MailingListCount == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("mailing_list_count", "body"))
+	}
+	if body.MemberCount == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("member_count", "body"))
+	}
+	if body.ProjectUID != nil {
+		err = goa.MergeErrors(err, goa.ValidateFormat("body.project_uid", *body.ProjectUID, goa.FormatUUID))
+	}
+	return
+}
+
+// ValidateListGroupsioOrphanedMailingListsResponseBody runs the validations
+// defined on List-Groupsio-Orphaned-Mailing-ListsResponseBody
+func ValidateListGroupsioOrphanedMailingListsResponseBody(body *ListGroupsioOrphanedMailingListsResponseBody) (err error) {
+	for _, e := range body.Items {
+		if e != nil {
+			if err2 := ValidateGroupsioSubgroupResponseBody(e); err2 != nil {
+				err = goa.MergeErrors(err, err2)
+			}
+		}
+	}
+	return
+}
+
+// ValidateRepublishGroupsioProjectIndexResponseBody runs the validations
+// defined on Republish-Groupsio-Project-IndexResponseBody
+func ValidateRepublishGroupsioProjectIndexResponseBody(body *RepublishGroupsioProjectIndexResponseBody) (err error) {
+	if body.Published == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("published", "body"))
+	}
+	return
+}
+
+// ValidateGetGroupsioMailingListStatsResponseBody runs the validations defined
+// on Get-Groupsio-Mailing-List-StatsResponseBody
+func ValidateGetGroupsioMailingListStatsResponseBody(body *GetGroupsioMailingListStatsResponseBody) (err error) {
+	if body.SubgroupID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("subgroup_id", "body"))
+	}
+	if body.MemberCount == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("member_count", "body"))
+	}
+	if body.MembersByDeliveryMode == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("members_by_delivery_mode", "body"))
+	}
+	if body.BounceCount == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("bounce_count", "body"))
+	}
+	return
+}
+
+// ValidateGetGroupsioServiceTreeResponseBody runs the validations defined on
+// Get-Groupsio-Service-TreeResponseBody
+func ValidateGetGroupsioServiceTreeResponseBody(body *GetGroupsioServiceTreeResponseBody) (err error) {
+	if body.Service == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("service", "body"))
+	}
+	if body.MailingLists == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("mailing_lists", "body"))
+	}
+	if body.Service != nil {
+		if err2 := ValidateGroupsioServiceResponseBody(body.Service); err2 != nil {
+			err = goa.MergeErrors(err, err2)
+		}
+	}
+	for _, e := range body.MailingLists {
+		if e != nil {
+			if err2 := ValidateGroupsioServiceTreeSubgroupResponseBody(e); err2 != nil {
+				err = goa.MergeErrors(err, err2)
+			}
+		}
+	}
+	return
+}
+
+// ValidateGetGroupsioMemberHierarchyResponseBody runs the validations defined
+// on Get-Groupsio-Member-HierarchyResponseBody
+func ValidateGetGroupsioMemberHierarchyResponseBody(body *GetGroupsioMemberHierarchyResponseBody) (err error) {
+	if body.Member == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("member", "body"))
+	}
+	if body.Warnings == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("warnings", "body"))
+	}
+	if body.Member != nil {
+		if err2 := ValidateGroupsioMemberResponseBody(body.Member); err2 != nil {
+			err = goa.MergeErrors(err, err2)
+		}
+	}
+	if body.Subgroup != nil {
+		if err2 := ValidateGroupsioSubgroupResponseBody(body.Subgroup); err2 != nil {
+			err = goa.MergeErrors(err, err2)
+		}
+	}
+	if body.Service != nil {
+		if err2 := ValidateGroupsioServiceResponseBody(body.Service); err2 != nil {
+			err = goa.MergeErrors(err, err2)
+		}
+	}
+	if body.Project != nil {
+		if err2 := ValidateGroupsioMemberHierarchyProjectResponseBody(body.Project); err2 != nil {
+			err = goa.MergeErrors(err, err2)
+		}
+	}
+	return
+}
+
+// ValidateListGroupsioProjectMembersResponseBody runs the validations defined
+// on List-Groupsio-Project-MembersResponseBody
+func ValidateListGroupsioProjectMembersResponseBody(body *ListGroupsioProjectMembersResponseBody) (err error) {
+	if body.Items == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("items", "body"))
+	}
+	for _, e := range body.Items {
+		if e != nil {
+			if err2 := ValidateGroupsioMemberResponseBody(e); err2 != nil {
+				err = goa.MergeErrors(err, err2)
+			}
+		}
+	}
+	return
+}
+
+// ValidateReadyzServiceUnavailableResponseBody runs the validations defined on
+// readyz_ServiceUnavailable_response_body
+func ValidateReadyzServiceUnavailableResponseBody(body *ReadyzServiceUnavailableResponseBody) (err error) {
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	return
+}
+
+// ValidateListGroupsioServicesBadRequestResponseBody runs the validations
+// defined on list-groupsio-services_BadRequest_response_body
+func ValidateListGroupsioServicesBadRequestResponseBody(body *ListGroupsioServicesBadRequestResponseBody) (err error) {
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	return
+}
+
+// ValidateListGroupsioServicesInternalServerErrorResponseBody runs the
+// validations defined on
+// list-groupsio-services_InternalServerError_response_body
+func ValidateListGroupsioServicesInternalServerErrorResponseBody(body *ListGroupsioServicesInternalServerErrorResponseBody) (err error) {
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	return
+}
+
+// ValidateListGroupsioServicesServiceUnavailableResponseBody runs the
+// validations defined on
+// list-groupsio-services_ServiceUnavailable_response_body
+func ValidateListGroupsioServicesServiceUnavailableResponseBody(body *ListGroupsioServicesServiceUnavailableResponseBody) (err error) {
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	return
+}
+
+// ValidateCreateGroupsioServiceBadRequestResponseBody runs the validations
+// defined on create-groupsio-service_BadRequest_response_body
+func ValidateCreateGroupsioServiceBadRequestResponseBody(body *CreateGroupsioServiceBadRequestResponseBody) (err error) {
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	return
+}
+
+// ValidateCreateGroupsioServiceConflictResponseBody runs the validations
+// defined on create-groupsio-service_Conflict_response_body
+func ValidateCreateGroupsioServiceConflictResponseBody(body *CreateGroupsioServiceConflictResponseBody) (err error) {
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	return
+}
+
+// ValidateCreateGroupsioServiceInternalServerErrorResponseBody runs the
+// validations defined on
+// create-groupsio-service_InternalServerError_response_body
+func ValidateCreateGroupsioServiceInternalServerErrorResponseBody(body *CreateGroupsioServiceInternalServerErrorResponseBody) (err error) {
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	return
+}
+
+// ValidateCreateGroupsioServiceServiceUnavailableResponseBody runs the
+// validations defined on
+// create-groupsio-service_ServiceUnavailable_response_body
+func ValidateCreateGroupsioServiceServiceUnavailableResponseBody(body *CreateGroupsioServiceServiceUnavailableResponseBody) (err error) {
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	return
+}
+
+// ValidateGetGroupsioServiceInternalServerErrorResponseBody runs the
+// validations defined on get-groupsio-service_InternalServerError_response_body
+func ValidateGetGroupsioServiceInternalServerErrorResponseBody(body *GetGroupsioServiceInternalServerErrorResponseBody) (err error) {
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	return
+}
+
+// ValidateGetGroupsioServiceNotFoundResponseBody runs the validations defined
+// on get-groupsio-service_NotFound_response_body
+func ValidateGetGroupsioServiceNotFoundResponseBody(body *GetGroupsioServiceNotFoundResponseBody) (err error) {
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	return
+}
+
+// ValidateGetGroupsioServiceServiceUnavailableResponseBody runs the
+// validations defined on get-groupsio-service_ServiceUnavailable_response_body
+func ValidateGetGroupsioServiceServiceUnavailableResponseBody(body *GetGroupsioServiceServiceUnavailableResponseBody) (err error) {
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	return
+}
+
+// ValidateUpdateGroupsioServiceBadRequestResponseBody runs the validations
+// defined on update-groupsio-service_BadRequest_response_body
+func ValidateUpdateGroupsioServiceBadRequestResponseBody(body *UpdateGroupsioServiceBadRequestResponseBody) (err error) {
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	return
+}
+
+// ValidateUpdateGroupsioServiceInternalServerErrorResponseBody runs the
+// validations defined on
+// update-groupsio-service_InternalServerError_response_body
+func ValidateUpdateGroupsioServiceInternalServerErrorResponseBody(body *UpdateGroupsioServiceInternalServerErrorResponseBody) (err error) {
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	return
+}
+
+// ValidateUpdateGroupsioServiceNotFoundResponseBody runs the validations
+// defined on update-groupsio-service_NotFound_response_body
+func ValidateUpdateGroupsioServiceNotFoundResponseBody(body *UpdateGroupsioServiceNotFoundResponseBody) (err error) {
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	return
+}
+
+// ValidateUpdateGroupsioServiceServiceUnavailableResponseBody runs the
+// validations defined on
+// update-groupsio-service_ServiceUnavailable_response_body
+func ValidateUpdateGroupsioServiceServiceUnavailableResponseBody(body *UpdateGroupsioServiceServiceUnavailableResponseBody) (err error) {
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	return
+}
+
+// ValidatePromoteGroupsioServiceBadRequestResponseBody runs the validations
+// defined on promote-groupsio-service_BadRequest_response_body
+func ValidatePromoteGroupsioServiceBadRequestResponseBody(body *PromoteGroupsioServiceBadRequestResponseBody) (err error) {
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	return
+}
+
+// ValidatePromoteGroupsioServiceConflictResponseBody runs the validations
+// defined on promote-groupsio-service_Conflict_response_body
+func ValidatePromoteGroupsioServiceConflictResponseBody(body *PromoteGroupsioServiceConflictResponseBody) (err error) {
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	return
+}
+
+// ValidatePromoteGroupsioServiceInternalServerErrorResponseBody runs the
+// validations defined on
+// promote-groupsio-service_InternalServerError_response_body
+func ValidatePromoteGroupsioServiceInternalServerErrorResponseBody(body *PromoteGroupsioServiceInternalServerErrorResponseBody) (err error) {
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	return
+}
+
+// ValidatePromoteGroupsioServiceNotFoundResponseBody runs the validations
+// defined on promote-groupsio-service_NotFound_response_body
+func ValidatePromoteGroupsioServiceNotFoundResponseBody(body *PromoteGroupsioServiceNotFoundResponseBody) (err error) {
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	return
+}
+
+// ValidatePromoteGroupsioServiceServiceUnavailableResponseBody runs the
+// validations defined on
+// promote-groupsio-service_ServiceUnavailable_response_body
+func ValidatePromoteGroupsioServiceServiceUnavailableResponseBody(body *PromoteGroupsioServiceServiceUnavailableResponseBody) (err error) {
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	return
+}
+
+// ValidateDeleteGroupsioServiceInternalServerErrorResponseBody runs the
+// validations defined on
+// delete-groupsio-service_InternalServerError_response_body
+func ValidateDeleteGroupsioServiceInternalServerErrorResponseBody(body *DeleteGroupsioServiceInternalServerErrorResponseBody) (err error) {
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	return
+}
+
+// ValidateDeleteGroupsioServiceNotFoundResponseBody runs the validations
+// defined on delete-groupsio-service_NotFound_response_body
+func ValidateDeleteGroupsioServiceNotFoundResponseBody(body *DeleteGroupsioServiceNotFoundResponseBody) (err error) {
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	return
+}
+
+// ValidateDeleteGroupsioServiceServiceUnavailableResponseBody runs the
+// validations defined on
+// delete-groupsio-service_ServiceUnavailable_response_body
+func ValidateDeleteGroupsioServiceServiceUnavailableResponseBody(body *DeleteGroupsioServiceServiceUnavailableResponseBody) (err error) {
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	return
+}
+
+// ValidateGetGroupsioServiceProjectsInternalServerErrorResponseBody runs the
+// validations defined on
+// get-groupsio-service-projects_InternalServerError_response_body
+func ValidateGetGroupsioServiceProjectsInternalServerErrorResponseBody(body *GetGroupsioServiceProjectsInternalServerErrorResponseBody) (err error) {
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	return
+}
+
+// ValidateGetGroupsioServiceProjectsServiceUnavailableResponseBody runs the
+// validations defined on
+// get-groupsio-service-projects_ServiceUnavailable_response_body
+func ValidateGetGroupsioServiceProjectsServiceUnavailableResponseBody(body *GetGroupsioServiceProjectsServiceUnavailableResponseBody) (err error) {
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	return
+}
+
+// ValidateListGroupsioServicesByStatusBadRequestResponseBody runs the
+// validations defined on
+// list-groupsio-services-by-status_BadRequest_response_body
+func ValidateListGroupsioServicesByStatusBadRequestResponseBody(body *ListGroupsioServicesByStatusBadRequestResponseBody) (err error) {
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	return
+}
+
+// ValidateListGroupsioServicesByStatusInternalServerErrorResponseBody runs the
+// validations defined on
+// list-groupsio-services-by-status_InternalServerError_response_body
+func ValidateListGroupsioServicesByStatusInternalServerErrorResponseBody(body *ListGroupsioServicesByStatusInternalServerErrorResponseBody) (err error) {
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	return
+}
+
+// ValidateListGroupsioServicesByStatusServiceUnavailableResponseBody runs the
+// validations defined on
+// list-groupsio-services-by-status_ServiceUnavailable_response_body
+func ValidateListGroupsioServicesByStatusServiceUnavailableResponseBody(body *ListGroupsioServicesByStatusServiceUnavailableResponseBody) (err error) {
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	return
+}
+
+// ValidateListUnprovisionedGroupsioServicesBadRequestResponseBody runs the
+// validations defined on
+// list-unprovisioned-groupsio-services_BadRequest_response_body
+func ValidateListUnprovisionedGroupsioServicesBadRequestResponseBody(body *ListUnprovisionedGroupsioServicesBadRequestResponseBody) (err error) {
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	return
+}
+
+// ValidateListUnprovisionedGroupsioServicesInternalServerErrorResponseBody
+// runs the validations defined on
+// list-unprovisioned-groupsio-services_InternalServerError_response_body
+func ValidateListUnprovisionedGroupsioServicesInternalServerErrorResponseBody(body *ListUnprovisionedGroupsioServicesInternalServerErrorResponseBody) (err error) {
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	return
+}
+
+// ValidateListUnprovisionedGroupsioServicesServiceUnavailableResponseBody runs
+// the validations defined on
+// list-unprovisioned-groupsio-services_ServiceUnavailable_response_body
+func ValidateListUnprovisionedGroupsioServicesServiceUnavailableResponseBody(body *ListUnprovisionedGroupsioServicesServiceUnavailableResponseBody) (err error) {
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	return
+}
+
+// ValidateFindParentGroupsioServiceBadRequestResponseBody runs the validations
+// defined on find-parent-groupsio-service_BadRequest_response_body
+func ValidateFindParentGroupsioServiceBadRequestResponseBody(body *FindParentGroupsioServiceBadRequestResponseBody) (err error) {
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	return
+}
+
+// ValidateFindParentGroupsioServiceInternalServerErrorResponseBody runs the
+// validations defined on
+// find-parent-groupsio-service_InternalServerError_response_body
+func ValidateFindParentGroupsioServiceInternalServerErrorResponseBody(body *FindParentGroupsioServiceInternalServerErrorResponseBody) (err error) {
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	return
+}
+
+// ValidateFindParentGroupsioServiceNotFoundResponseBody runs the validations
+// defined on find-parent-groupsio-service_NotFound_response_body
+func ValidateFindParentGroupsioServiceNotFoundResponseBody(body *FindParentGroupsioServiceNotFoundResponseBody) (err error) {
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	return
+}
+
+// ValidateFindParentGroupsioServiceServiceUnavailableResponseBody runs the
+// validations defined on
+// find-parent-groupsio-service_ServiceUnavailable_response_body
+func ValidateFindParentGroupsioServiceServiceUnavailableResponseBody(body *FindParentGroupsioServiceServiceUnavailableResponseBody) (err error) {
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	return
+}
+
+// ValidateListGroupsioMailingListsBadRequestResponseBody runs the validations
+// defined on list-groupsio-mailing-lists_BadRequest_response_body
+func ValidateListGroupsioMailingListsBadRequestResponseBody(body *ListGroupsioMailingListsBadRequestResponseBody) (err error) {
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	return
+}
+
+// ValidateListGroupsioMailingListsInternalServerErrorResponseBody runs the
+// validations defined on
+// list-groupsio-mailing-lists_InternalServerError_response_body
+func ValidateListGroupsioMailingListsInternalServerErrorResponseBody(body *ListGroupsioMailingListsInternalServerErrorResponseBody) (err error) {
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	return
+}
+
+// ValidateListGroupsioMailingListsServiceUnavailableResponseBody runs the
+// validations defined on
+// list-groupsio-mailing-lists_ServiceUnavailable_response_body
+func ValidateListGroupsioMailingListsServiceUnavailableResponseBody(body *ListGroupsioMailingListsServiceUnavailableResponseBody) (err error) {
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	return
+}
+
+// ValidateListGroupsioMailingListsByVisibilityBadRequestResponseBody runs the
+// validations defined on
+// list-groupsio-mailing-lists-by-visibility_BadRequest_response_body
+func ValidateListGroupsioMailingListsByVisibilityBadRequestResponseBody(body *ListGroupsioMailingListsByVisibilityBadRequestResponseBody) (err error) {
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	return
+}
+
+// ValidateListGroupsioMailingListsByVisibilityInternalServerErrorResponseBody
+// runs the validations defined on
+// list-groupsio-mailing-lists-by-visibility_InternalServerError_response_body
+func ValidateListGroupsioMailingListsByVisibilityInternalServerErrorResponseBody(body *ListGroupsioMailingListsByVisibilityInternalServerErrorResponseBody) (err error) {
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	return
+}
+
+// ValidateListGroupsioMailingListsByVisibilityServiceUnavailableResponseBody
+// runs the validations defined on
+// list-groupsio-mailing-lists-by-visibility_ServiceUnavailable_response_body
+func ValidateListGroupsioMailingListsByVisibilityServiceUnavailableResponseBody(body *ListGroupsioMailingListsByVisibilityServiceUnavailableResponseBody) (err error) {
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	return
+}
+
+// ValidateListGroupsioMailingListsUpdatedSinceBadRequestResponseBody runs the
+// validations defined on
+// list-groupsio-mailing-lists-updated-since_BadRequest_response_body
+func ValidateListGroupsioMailingListsUpdatedSinceBadRequestResponseBody(body *ListGroupsioMailingListsUpdatedSinceBadRequestResponseBody) (err error) {
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	return
+}
+
+// ValidateListGroupsioMailingListsUpdatedSinceInternalServerErrorResponseBody
+// runs the validations defined on
+// list-groupsio-mailing-lists-updated-since_InternalServerError_response_body
+func ValidateListGroupsioMailingListsUpdatedSinceInternalServerErrorResponseBody(body *ListGroupsioMailingListsUpdatedSinceInternalServerErrorResponseBody) (err error) {
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	return
+}
+
+// ValidateListGroupsioMailingListsUpdatedSinceServiceUnavailableResponseBody
+// runs the validations defined on
+// list-groupsio-mailing-lists-updated-since_ServiceUnavailable_response_body
+func ValidateListGroupsioMailingListsUpdatedSinceServiceUnavailableResponseBody(body *ListGroupsioMailingListsUpdatedSinceServiceUnavailableResponseBody) (err error) {
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	return
+}
+
+// ValidateCreateGroupsioMailingListBadRequestResponseBody runs the validations
+// defined on create-groupsio-mailing-list_BadRequest_response_body
+func ValidateCreateGroupsioMailingListBadRequestResponseBody(body *CreateGroupsioMailingListBadRequestResponseBody) (err error) {
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	return
+}
+
+// ValidateCreateGroupsioMailingListConflictResponseBody runs the validations
+// defined on create-groupsio-mailing-list_Conflict_response_body
+func ValidateCreateGroupsioMailingListConflictResponseBody(body *CreateGroupsioMailingListConflictResponseBody) (err error) {
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	return
+}
+
+// ValidateCreateGroupsioMailingListInternalServerErrorResponseBody runs the
+// validations defined on
+// create-groupsio-mailing-list_InternalServerError_response_body
+func ValidateCreateGroupsioMailingListInternalServerErrorResponseBody(body *CreateGroupsioMailingListInternalServerErrorResponseBody) (err error) {
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	return
+}
+
+// ValidateCreateGroupsioMailingListServiceUnavailableResponseBody runs the
+// validations defined on
+// create-groupsio-mailing-list_ServiceUnavailable_response_body
+func ValidateCreateGroupsioMailingListServiceUnavailableResponseBody(body *CreateGroupsioMailingListServiceUnavailableResponseBody) (err error) {
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	return
+}
+
+// ValidateGetGroupsioMailingListInternalServerErrorResponseBody runs the
+// validations defined on
+// get-groupsio-mailing-list_InternalServerError_response_body
+func ValidateGetGroupsioMailingListInternalServerErrorResponseBody(body *GetGroupsioMailingListInternalServerErrorResponseBody) (err error) {
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	return
+}
+
+// ValidateGetGroupsioMailingListNotFoundResponseBody runs the validations
+// defined on get-groupsio-mailing-list_NotFound_response_body
+func ValidateGetGroupsioMailingListNotFoundResponseBody(body *GetGroupsioMailingListNotFoundResponseBody) (err error) {
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	return
+}
+
+// ValidateGetGroupsioMailingListServiceUnavailableResponseBody runs the
+// validations defined on
+// get-groupsio-mailing-list_ServiceUnavailable_response_body
+func ValidateGetGroupsioMailingListServiceUnavailableResponseBody(body *GetGroupsioMailingListServiceUnavailableResponseBody) (err error) {
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	return
+}
+
+// ValidateUpdateGroupsioMailingListBadRequestResponseBody runs the validations
+// defined on update-groupsio-mailing-list_BadRequest_response_body
+func ValidateUpdateGroupsioMailingListBadRequestResponseBody(body *UpdateGroupsioMailingListBadRequestResponseBody) (err error) {
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	return
+}
+
+// ValidateUpdateGroupsioMailingListInternalServerErrorResponseBody runs the
+// validations defined on
+// update-groupsio-mailing-list_InternalServerError_response_body
+func ValidateUpdateGroupsioMailingListInternalServerErrorResponseBody(body *UpdateGroupsioMailingListInternalServerErrorResponseBody) (err error) {
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	return
+}
+
+// ValidateUpdateGroupsioMailingListNotFoundResponseBody runs the validations
+// defined on update-groupsio-mailing-list_NotFound_response_body
+func ValidateUpdateGroupsioMailingListNotFoundResponseBody(body *UpdateGroupsioMailingListNotFoundResponseBody) (err error) {
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	return
+}
+
+// ValidateUpdateGroupsioMailingListServiceUnavailableResponseBody runs the
+// validations defined on
+// update-groupsio-mailing-list_ServiceUnavailable_response_body
+func ValidateUpdateGroupsioMailingListServiceUnavailableResponseBody(body *UpdateGroupsioMailingListServiceUnavailableResponseBody) (err error) {
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	return
+}
+
+// ValidateDeleteGroupsioMailingListInternalServerErrorResponseBody runs the
+// validations defined on
+// delete-groupsio-mailing-list_InternalServerError_response_body
+func ValidateDeleteGroupsioMailingListInternalServerErrorResponseBody(body *DeleteGroupsioMailingListInternalServerErrorResponseBody) (err error) {
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	return
+}
+
+// ValidateDeleteGroupsioMailingListNotFoundResponseBody runs the validations
+// defined on delete-groupsio-mailing-list_NotFound_response_body
+func ValidateDeleteGroupsioMailingListNotFoundResponseBody(body *DeleteGroupsioMailingListNotFoundResponseBody) (err error) {
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	return
+}
+
+// ValidateDeleteGroupsioMailingListServiceUnavailableResponseBody runs the
+// validations defined on
+// delete-groupsio-mailing-list_ServiceUnavailable_response_body
+func ValidateDeleteGroupsioMailingListServiceUnavailableResponseBody(body *DeleteGroupsioMailingListServiceUnavailableResponseBody) (err error) {
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	return
+}
+
+// ValidateGetGroupsioMailingListCountBadRequestResponseBody runs the
+// validations defined on
+// get-groupsio-mailing-list-count_BadRequest_response_body
+func ValidateGetGroupsioMailingListCountBadRequestResponseBody(body *GetGroupsioMailingListCountBadRequestResponseBody) (err error) {
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	return
+}
+
+// ValidateGetGroupsioMailingListCountInternalServerErrorResponseBody runs the
+// validations defined on
+// get-groupsio-mailing-list-count_InternalServerError_response_body
+func ValidateGetGroupsioMailingListCountInternalServerErrorResponseBody(body *GetGroupsioMailingListCountInternalServerErrorResponseBody) (err error) {
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	return
+}
+
+// ValidateGetGroupsioMailingListCountServiceUnavailableResponseBody runs the
+// validations defined on
+// get-groupsio-mailing-list-count_ServiceUnavailable_response_body
+func ValidateGetGroupsioMailingListCountServiceUnavailableResponseBody(body *GetGroupsioMailingListCountServiceUnavailableResponseBody) (err error) {
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	return
+}
+
+// ValidateGetGroupsioMailingListMemberCountInternalServerErrorResponseBody
+// runs the validations defined on
+// get-groupsio-mailing-list-member-count_InternalServerError_response_body
+func ValidateGetGroupsioMailingListMemberCountInternalServerErrorResponseBody(body *GetGroupsioMailingListMemberCountInternalServerErrorResponseBody) (err error) {
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	return
+}
+
+// ValidateGetGroupsioMailingListMemberCountNotFoundResponseBody runs the
+// validations defined on
+// get-groupsio-mailing-list-member-count_NotFound_response_body
+func ValidateGetGroupsioMailingListMemberCountNotFoundResponseBody(body *GetGroupsioMailingListMemberCountNotFoundResponseBody) (err error) {
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	return
+}
+
+// ValidateGetGroupsioMailingListMemberCountServiceUnavailableResponseBody runs
+// the validations defined on
+// get-groupsio-mailing-list-member-count_ServiceUnavailable_response_body
+func ValidateGetGroupsioMailingListMemberCountServiceUnavailableResponseBody(body *GetGroupsioMailingListMemberCountServiceUnavailableResponseBody) (err error) {
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	return
+}
+
+// ValidateListGroupsioMembersInternalServerErrorResponseBody runs the
+// validations defined on
+// list-groupsio-members_InternalServerError_response_body
+func ValidateListGroupsioMembersInternalServerErrorResponseBody(body *ListGroupsioMembersInternalServerErrorResponseBody) (err error) {
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
 	}
 	return
 }
 
-// ValidateCreateGroupsioMailingListResponseBody runs the validations defined
-// on Create-Groupsio-Mailing-ListResponseBody
-func ValidateCreateGroupsioMailingListResponseBody(body *CreateGroupsioMailingListResponseBody) (err error) {
-	if body.ProjectUID != nil {
-		err = goa.MergeErrors(err, goa.ValidateFormat("body.project_uid", *body.ProjectUID, goa.FormatUUID))
-	}
-	if body.CommitteeUID != nil {
-		err = goa.MergeErrors(err, goa.ValidateFormat("body.committee_uid", *body.CommitteeUID, goa.FormatUUID))
+// ValidateListGroupsioMembersNotFoundResponseBody runs the validations defined
+// on list-groupsio-members_NotFound_response_body
+func ValidateListGroupsioMembersNotFoundResponseBody(body *ListGroupsioMembersNotFoundResponseBody) (err error) {
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
 	}
 	return
 }
 
-// ValidateGetGroupsioMailingListResponseBody runs the validations defined on
-// Get-Groupsio-Mailing-ListResponseBody
-func ValidateGetGroupsioMailingListResponseBody(body *GetGroupsioMailingListResponseBody) (err error) {
-	if body.ProjectUID != nil {
-		err = goa.MergeErrors(err, goa.ValidateFormat("body.project_uid", *body.ProjectUID, goa.FormatUUID))
-	}
-	if body.CommitteeUID != nil {
-		err = goa.MergeErrors(err, goa.ValidateFormat("body.committee_uid", *body.CommitteeUID, goa.FormatUUID))
+// ValidateListGroupsioMembersServiceUnavailableResponseBody runs the
+// validations defined on list-groupsio-members_ServiceUnavailable_response_body
+func ValidateListGroupsioMembersServiceUnavailableResponseBody(body *ListGroupsioMembersServiceUnavailableResponseBody) (err error) {
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
 	}
 	return
 }
 
-// ValidateUpdateGroupsioMailingListResponseBody runs the validations defined
-// on Update-Groupsio-Mailing-ListResponseBody
-func ValidateUpdateGroupsioMailingListResponseBody(body *UpdateGroupsioMailingListResponseBody) (err error) {
-	if body.ProjectUID != nil {
-		err = goa.MergeErrors(err, goa.ValidateFormat("body.project_uid", *body.ProjectUID, goa.FormatUUID))
-	}
-	if body.CommitteeUID != nil {
-		err = goa.MergeErrors(err, goa.ValidateFormat("body.committee_uid", *body.CommitteeUID, goa.FormatUUID))
+// ValidateListGroupsioMembersNeedingReviewBadRequestResponseBody runs the
+// validations defined on
+// list-groupsio-members-needing-review_BadRequest_response_body
+func ValidateListGroupsioMembersNeedingReviewBadRequestResponseBody(body *ListGroupsioMembersNeedingReviewBadRequestResponseBody) (err error) {
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
 	}
 	return
 }
 
-// ValidateGetGroupsioMailingListCountResponseBody runs the validations defined
-// on Get-Groupsio-Mailing-List-CountResponseBody
-func ValidateGetGroupsioMailingListCountResponseBody(body *GetGroupsioMailingListCountResponseBody) (err error) {
-	if body.Count == nil {
-		err = goa.MergeErrors(err, goa.MissingFieldError("count", "body"))
+// ValidateListGroupsioMembersNeedingReviewInternalServerErrorResponseBody runs
+// the validations defined on
+// list-groupsio-members-needing-review_InternalServerError_response_body
+func ValidateListGroupsioMembersNeedingReviewInternalServerErrorResponseBody(body *ListGroupsioMembersNeedingReviewInternalServerErrorResponseBody) (err error) {
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
 	}
 	return
 }
 
-// ValidateGetGroupsioMailingListMemberCountResponseBody runs the validations
-// defined on Get-Groupsio-Mailing-List-Member-CountResponseBody
-func ValidateGetGroupsioMailingListMemberCountResponseBody(body *GetGroupsioMailingListMemberCountResponseBody) (err error) {
-	if body.Count == nil {
-		err = goa.MergeErrors(err, goa.MissingFieldError("count", "body"))
+// ValidateListGroupsioMembersNeedingReviewNotFoundResponseBody runs the
+// validations defined on
+// list-groupsio-members-needing-review_NotFound_response_body
+func ValidateListGroupsioMembersNeedingReviewNotFoundResponseBody(body *ListGroupsioMembersNeedingReviewNotFoundResponseBody) (err error) {
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
 	}
 	return
 }
 
-// ValidateListGroupsioMembersResponseBody runs the validations defined on
-// List-Groupsio-MembersResponseBody
-func ValidateListGroupsioMembersResponseBody(body *ListGroupsioMembersResponseBody) (err error) {
-	for _, e := range body.Items {
-		if e != nil {
-			if err2 := ValidateGroupsioMemberResponseBody(e); err2 != nil {
-				err = goa.MergeErrors(err, err2)
-			}
-		}
+// ValidateListGroupsioMembersNeedingReviewServiceUnavailableResponseBody runs
+// the validations defined on
+// list-groupsio-members-needing-review_ServiceUnavailable_response_body
+func ValidateListGroupsioMembersNeedingReviewServiceUnavailableResponseBody(body *ListGroupsioMembersNeedingReviewServiceUnavailableResponseBody) (err error) {
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
 	}
 	return
 }
 
-// ValidateAddGroupsioMemberResponseBody runs the validations defined on
-// Add-Groupsio-MemberResponseBody
-func ValidateAddGroupsioMemberResponseBody(body *AddGroupsioMemberResponseBody) (err error) {
-	if body.Email != nil {
-		err = goa.MergeErrors(err, goa.ValidateFormat("body.email", *body.Email, goa.FormatEmail))
+// ValidateListGroupsioMembersModifiedSinceBadRequestResponseBody runs the
+// validations defined on
+// list-groupsio-members-modified-since_BadRequest_response_body
+func ValidateListGroupsioMembersModifiedSinceBadRequestResponseBody(body *ListGroupsioMembersModifiedSinceBadRequestResponseBody) (err error) {
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
 	}
 	return
 }
 
-// ValidateGetGroupsioMemberResponseBody runs the validations defined on
-// Get-Groupsio-MemberResponseBody
-func ValidateGetGroupsioMemberResponseBody(body *GetGroupsioMemberResponseBody) (err error) {
-	if body.Email != nil {
-		err = goa.MergeErrors(err, goa.ValidateFormat("body.email", *body.Email, goa.FormatEmail))
+// ValidateListGroupsioMembersModifiedSinceInternalServerErrorResponseBody runs
+// the validations defined on
+// list-groupsio-members-modified-since_InternalServerError_response_body
+func ValidateListGroupsioMembersModifiedSinceInternalServerErrorResponseBody(body *ListGroupsioMembersModifiedSinceInternalServerErrorResponseBody) (err error) {
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
 	}
 	return
 }
 
-// ValidateUpdateGroupsioMemberResponseBody runs the validations defined on
-// Update-Groupsio-MemberResponseBody
-func ValidateUpdateGroupsioMemberResponseBody(body *UpdateGroupsioMemberResponseBody) (err error) {
-	if body.Email != nil {
-		err = goa.MergeErrors(err, goa.ValidateFormat("body.email", *body.Email, goa.FormatEmail))
+// ValidateListGroupsioMembersModifiedSinceNotFoundResponseBody runs the
+// validations defined on
+// list-groupsio-members-modified-since_NotFound_response_body
+func ValidateListGroupsioMembersModifiedSinceNotFoundResponseBody(body *ListGroupsioMembersModifiedSinceNotFoundResponseBody) (err error) {
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
 	}
 	return
 }
 
-// ValidateCheckGroupsioSubscriberResponseBody runs the validations defined on
-// Check-Groupsio-SubscriberResponseBody
-func ValidateCheckGroupsioSubscriberResponseBody(body *CheckGroupsioSubscriberResponseBody) (err error) {
-	if body.Subscribed == nil {
-		err = goa.MergeErrors(err, goa.MissingFieldError("subscribed", "body"))
+// ValidateListGroupsioMembersModifiedSinceServiceUnavailableResponseBody runs
+// the validations defined on
+// list-groupsio-members-modified-since_ServiceUnavailable_response_body
+func ValidateListGroupsioMembersModifiedSinceServiceUnavailableResponseBody(body *ListGroupsioMembersModifiedSinceServiceUnavailableResponseBody) (err error) {
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
 	}
 	return
 }
 
-// ValidateGetGroupsioArtifactDownloadResponseBody runs the validations defined
-// on Get-Groupsio-Artifact-DownloadResponseBody
-func ValidateGetGroupsioArtifactDownloadResponseBody(body *GetGroupsioArtifactDownloadResponseBody) (err error) {
-	if body.URL == nil {
-		err = goa.MergeErrors(err, goa.MissingFieldError("url", "body"))
+// ValidateListGroupsioMembersByOrganizationBadRequestResponseBody runs the
+// validations defined on
+// list-groupsio-members-by-organization_BadRequest_response_body
+func ValidateListGroupsioMembersByOrganizationBadRequestResponseBody(body *ListGroupsioMembersByOrganizationBadRequestResponseBody) (err error) {
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
 	}
 	return
 }
 
-// ValidateReadyzServiceUnavailableResponseBody runs the validations defined on
-// readyz_ServiceUnavailable_response_body
-func ValidateReadyzServiceUnavailableResponseBody(body *ReadyzServiceUnavailableResponseBody) (err error) {
+// ValidateListGroupsioMembersByOrganizationInternalServerErrorResponseBody
+// runs the validations defined on
+// list-groupsio-members-by-organization_InternalServerError_response_body
+func ValidateListGroupsioMembersByOrganizationInternalServerErrorResponseBody(body *ListGroupsioMembersByOrganizationInternalServerErrorResponseBody) (err error) {
 	if body.Message == nil {
 		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
 	}
 	return
 }
 
-// ValidateListGroupsioServicesBadRequestResponseBody runs the validations
-// defined on list-groupsio-services_BadRequest_response_body
-func ValidateListGroupsioServicesBadRequestResponseBody(body *ListGroupsioServicesBadRequestResponseBody) (err error) {
+// ValidateListGroupsioMembersByOrganizationNotFoundResponseBody runs the
+// validations defined on
+// list-groupsio-members-by-organization_NotFound_response_body
+func ValidateListGroupsioMembersByOrganizationNotFoundResponseBody(body *ListGroupsioMembersByOrganizationNotFoundResponseBody) (err error) {
 	if body.Message == nil {
 		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
 	}
 	return
 }
 
-// ValidateListGroupsioServicesInternalServerErrorResponseBody runs the
-// validations defined on
-// list-groupsio-services_InternalServerError_response_body
-func ValidateListGroupsioServicesInternalServerErrorResponseBody(body *ListGroupsioServicesInternalServerErrorResponseBody) (err error) {
+// ValidateListGroupsioMembersByOrganizationServiceUnavailableResponseBody runs
+// the validations defined on
+// list-groupsio-members-by-organization_ServiceUnavailable_response_body
+func ValidateListGroupsioMembersByOrganizationServiceUnavailableResponseBody(body *ListGroupsioMembersByOrganizationServiceUnavailableResponseBody) (err error) {
 	if body.Message == nil {
 		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
 	}
 	return
 }
 
-// ValidateListGroupsioServicesServiceUnavailableResponseBody runs the
+// ValidateListGroupsioDuplicateMembersInternalServerErrorResponseBody runs the
 // validations defined on
-// list-groupsio-services_ServiceUnavailable_response_body
-func ValidateListGroupsioServicesServiceUnavailableResponseBody(body *ListGroupsioServicesServiceUnavailableResponseBody) (err error) {
+// list-groupsio-duplicate-members_InternalServerError_response_body
+func ValidateListGroupsioDuplicateMembersInternalServerErrorResponseBody(body *ListGroupsioDuplicateMembersInternalServerErrorResponseBody) (err error) {
 	if body.Message == nil {
 		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
 	}
 	return
 }
 
-// ValidateCreateGroupsioServiceBadRequestResponseBody runs the validations
-// defined on create-groupsio-service_BadRequest_response_body
-func ValidateCreateGroupsioServiceBadRequestResponseBody(body *CreateGroupsioServiceBadRequestResponseBody) (err error) {
+// ValidateListGroupsioDuplicateMembersNotFoundResponseBody runs the
+// validations defined on list-groupsio-duplicate-members_NotFound_response_body
+func ValidateListGroupsioDuplicateMembersNotFoundResponseBody(body *ListGroupsioDuplicateMembersNotFoundResponseBody) (err error) {
 	if body.Message == nil {
 		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
 	}
 	return
 }
 
-// ValidateCreateGroupsioServiceConflictResponseBody runs the validations
-// defined on create-groupsio-service_Conflict_response_body
-func ValidateCreateGroupsioServiceConflictResponseBody(body *CreateGroupsioServiceConflictResponseBody) (err error) {
+// ValidateListGroupsioDuplicateMembersServiceUnavailableResponseBody runs the
+// validations defined on
+// list-groupsio-duplicate-members_ServiceUnavailable_response_body
+func ValidateListGroupsioDuplicateMembersServiceUnavailableResponseBody(body *ListGroupsioDuplicateMembersServiceUnavailableResponseBody) (err error) {
 	if body.Message == nil {
 		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
 	}
 	return
 }
 
-// ValidateCreateGroupsioServiceInternalServerErrorResponseBody runs the
+// ValidatePreviewGroupsioDeliveryModeChangeBadRequestResponseBody runs the
 // validations defined on
-// create-groupsio-service_InternalServerError_response_body
-func ValidateCreateGroupsioServiceInternalServerErrorResponseBody(body *CreateGroupsioServiceInternalServerErrorResponseBody) (err error) {
+// preview-groupsio-delivery-mode-change_BadRequest_response_body
+func ValidatePreviewGroupsioDeliveryModeChangeBadRequestResponseBody(body *PreviewGroupsioDeliveryModeChangeBadRequestResponseBody) (err error) {
 	if body.Message == nil {
 		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
 	}
 	return
 }
 
-// ValidateCreateGroupsioServiceServiceUnavailableResponseBody runs the
-// validations defined on
-// create-groupsio-service_ServiceUnavailable_response_body
-func ValidateCreateGroupsioServiceServiceUnavailableResponseBody(body *CreateGroupsioServiceServiceUnavailableResponseBody) (err error) {
+// ValidatePreviewGroupsioDeliveryModeChangeInternalServerErrorResponseBody
+// runs the validations defined on
+// preview-groupsio-delivery-mode-change_InternalServerError_response_body
+func ValidatePreviewGroupsioDeliveryModeChangeInternalServerErrorResponseBody(body *PreviewGroupsioDeliveryModeChangeInternalServerErrorResponseBody) (err error) {
 	if body.Message == nil {
 		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
 	}
 	return
 }
 
-// ValidateGetGroupsioServiceInternalServerErrorResponseBody runs the
-// validations defined on get-groupsio-service_InternalServerError_response_body
-func ValidateGetGroupsioServiceInternalServerErrorResponseBody(body *GetGroupsioServiceInternalServerErrorResponseBody) (err error) {
+// ValidatePreviewGroupsioDeliveryModeChangeNotFoundResponseBody runs the
+// validations defined on
+// preview-groupsio-delivery-mode-change_NotFound_response_body
+func ValidatePreviewGroupsioDeliveryModeChangeNotFoundResponseBody(body *PreviewGroupsioDeliveryModeChangeNotFoundResponseBody) (err error) {
 	if body.Message == nil {
 		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
 	}
 	return
 }
 
-// ValidateGetGroupsioServiceNotFoundResponseBody runs the validations defined
-// on get-groupsio-service_NotFound_response_body
-func ValidateGetGroupsioServiceNotFoundResponseBody(body *GetGroupsioServiceNotFoundResponseBody) (err error) {
+// ValidatePreviewGroupsioDeliveryModeChangeServiceUnavailableResponseBody runs
+// the validations defined on
+// preview-groupsio-delivery-mode-change_ServiceUnavailable_response_body
+func ValidatePreviewGroupsioDeliveryModeChangeServiceUnavailableResponseBody(body *PreviewGroupsioDeliveryModeChangeServiceUnavailableResponseBody) (err error) {
 	if body.Message == nil {
 		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
 	}
 	return
 }
 
-// ValidateGetGroupsioServiceServiceUnavailableResponseBody runs the
-// validations defined on get-groupsio-service_ServiceUnavailable_response_body
-func ValidateGetGroupsioServiceServiceUnavailableResponseBody(body *GetGroupsioServiceServiceUnavailableResponseBody) (err error) {
+// ValidateAddGroupsioMemberBadRequestResponseBody runs the validations defined
+// on add-groupsio-member_BadRequest_response_body
+func ValidateAddGroupsioMemberBadRequestResponseBody(body *AddGroupsioMemberBadRequestResponseBody) (err error) {
 	if body.Message == nil {
 		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
 	}
 	return
 }
 
-// ValidateUpdateGroupsioServiceBadRequestResponseBody runs the validations
-// defined on update-groupsio-service_BadRequest_response_body
-func ValidateUpdateGroupsioServiceBadRequestResponseBody(body *UpdateGroupsioServiceBadRequestResponseBody) (err error) {
+// ValidateAddGroupsioMemberConflictResponseBody runs the validations defined
+// on add-groupsio-member_Conflict_response_body
+func ValidateAddGroupsioMemberConflictResponseBody(body *AddGroupsioMemberConflictResponseBody) (err error) {
 	if body.Message == nil {
 		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
 	}
 	return
 }
 
-// ValidateUpdateGroupsioServiceInternalServerErrorResponseBody runs the
-// validations defined on
-// update-groupsio-service_InternalServerError_response_body
-func ValidateUpdateGroupsioServiceInternalServerErrorResponseBody(body *UpdateGroupsioServiceInternalServerErrorResponseBody) (err error) {
+// ValidateAddGroupsioMemberInternalServerErrorResponseBody runs the
+// validations defined on add-groupsio-member_InternalServerError_response_body
+func ValidateAddGroupsioMemberInternalServerErrorResponseBody(body *AddGroupsioMemberInternalServerErrorResponseBody) (err error) {
 	if body.Message == nil {
 		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
 	}
 	return
 }
 
-// ValidateUpdateGroupsioServiceNotFoundResponseBody runs the validations
-// defined on update-groupsio-service_NotFound_response_body
-func ValidateUpdateGroupsioServiceNotFoundResponseBody(body *UpdateGroupsioServiceNotFoundResponseBody) (err error) {
+// ValidateAddGroupsioMemberNotFoundResponseBody runs the validations defined
+// on add-groupsio-member_NotFound_response_body
+func ValidateAddGroupsioMemberNotFoundResponseBody(body *AddGroupsioMemberNotFoundResponseBody) (err error) {
 	if body.Message == nil {
 		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
 	}
 	return
 }
 
-// ValidateUpdateGroupsioServiceServiceUnavailableResponseBody runs the
-// validations defined on
-// update-groupsio-service_ServiceUnavailable_response_body
-func ValidateUpdateGroupsioServiceServiceUnavailableResponseBody(body *UpdateGroupsioServiceServiceUnavailableResponseBody) (err error) {
+// ValidateAddGroupsioMemberServiceUnavailableResponseBody runs the validations
+// defined on add-groupsio-member_ServiceUnavailable_response_body
+func ValidateAddGroupsioMemberServiceUnavailableResponseBody(body *AddGroupsioMemberServiceUnavailableResponseBody) (err error) {
 	if body.Message == nil {
 		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
 	}
 	return
 }
 
-// ValidateDeleteGroupsioServiceInternalServerErrorResponseBody runs the
-// validations defined on
-// delete-groupsio-service_InternalServerError_response_body
-func ValidateDeleteGroupsioServiceInternalServerErrorResponseBody(body *DeleteGroupsioServiceInternalServerErrorResponseBody) (err error) {
+// ValidateUpsertGroupsioMemberBadRequestResponseBody runs the validations
+// defined on upsert-groupsio-member_BadRequest_response_body
+func ValidateUpsertGroupsioMemberBadRequestResponseBody(body *UpsertGroupsioMemberBadRequestResponseBody) (err error) {
 	if body.Message == nil {
 		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
 	}
 	return
 }
 
-// ValidateDeleteGroupsioServiceNotFoundResponseBody runs the validations
-// defined on delete-groupsio-service_NotFound_response_body
-func ValidateDeleteGroupsioServiceNotFoundResponseBody(body *DeleteGroupsioServiceNotFoundResponseBody) (err error) {
+// ValidateUpsertGroupsioMemberConflictResponseBody runs the validations
+// defined on upsert-groupsio-member_Conflict_response_body
+func ValidateUpsertGroupsioMemberConflictResponseBody(body *UpsertGroupsioMemberConflictResponseBody) (err error) {
 	if body.Message == nil {
 		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
 	}
 	return
 }
 
-// ValidateDeleteGroupsioServiceServiceUnavailableResponseBody runs the
+// ValidateUpsertGroupsioMemberInternalServerErrorResponseBody runs the
 // validations defined on
-// delete-groupsio-service_ServiceUnavailable_response_body
-func ValidateDeleteGroupsioServiceServiceUnavailableResponseBody(body *DeleteGroupsioServiceServiceUnavailableResponseBody) (err error) {
+// upsert-groupsio-member_InternalServerError_response_body
+func ValidateUpsertGroupsioMemberInternalServerErrorResponseBody(body *UpsertGroupsioMemberInternalServerErrorResponseBody) (err error) {
 	if body.Message == nil {
 		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
 	}
 	return
 }
 
-// ValidateGetGroupsioServiceProjectsInternalServerErrorResponseBody runs the
-// validations defined on
-// get-groupsio-service-projects_InternalServerError_response_body
-func ValidateGetGroupsioServiceProjectsInternalServerErrorResponseBody(body *GetGroupsioServiceProjectsInternalServerErrorResponseBody) (err error) {
+// ValidateUpsertGroupsioMemberNotFoundResponseBody runs the validations
+// defined on upsert-groupsio-member_NotFound_response_body
+func ValidateUpsertGroupsioMemberNotFoundResponseBody(body *UpsertGroupsioMemberNotFoundResponseBody) (err error) {
 	if body.Message == nil {
 		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
 	}
 	return
 }
 
-// ValidateGetGroupsioServiceProjectsServiceUnavailableResponseBody runs the
+// ValidateUpsertGroupsioMemberServiceUnavailableResponseBody runs the
 // validations defined on
-// get-groupsio-service-projects_ServiceUnavailable_response_body
-func ValidateGetGroupsioServiceProjectsServiceUnavailableResponseBody(body *GetGroupsioServiceProjectsServiceUnavailableResponseBody) (err error) {
+// upsert-groupsio-member_ServiceUnavailable_response_body
+func ValidateUpsertGroupsioMemberServiceUnavailableResponseBody(body *UpsertGroupsioMemberServiceUnavailableResponseBody) (err error) {
 	if body.Message == nil {
 		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
 	}
 	return
 }
 
-// ValidateFindParentGroupsioServiceBadRequestResponseBody runs the validations
-// defined on find-parent-groupsio-service_BadRequest_response_body
-func ValidateFindParentGroupsioServiceBadRequestResponseBody(body *FindParentGroupsioServiceBadRequestResponseBody) (err error) {
+// ValidateGetGroupsioMemberInternalServerErrorResponseBody runs the
+// validations defined on get-groupsio-member_InternalServerError_response_body
+func ValidateGetGroupsioMemberInternalServerErrorResponseBody(body *GetGroupsioMemberInternalServerErrorResponseBody) (err error) {
 	if body.Message == nil {
 		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
 	}
 	return
 }
 
-// ValidateFindParentGroupsioServiceInternalServerErrorResponseBody runs the
-// validations defined on
-// find-parent-groupsio-service_InternalServerError_response_body
-func ValidateFindParentGroupsioServiceInternalServerErrorResponseBody(body *FindParentGroupsioServiceInternalServerErrorResponseBody) (err error) {
+// ValidateGetGroupsioMemberNotFoundResponseBody runs the validations defined
+// on get-groupsio-member_NotFound_response_body
+func ValidateGetGroupsioMemberNotFoundResponseBody(body *GetGroupsioMemberNotFoundResponseBody) (err error) {
 	if body.Message == nil {
 		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
 	}
 	return
 }
 
-// ValidateFindParentGroupsioServiceNotFoundResponseBody runs the validations
-// defined on find-parent-groupsio-service_NotFound_response_body
-func ValidateFindParentGroupsioServiceNotFoundResponseBody(body *FindParentGroupsioServiceNotFoundResponseBody) (err error) {
+// ValidateGetGroupsioMemberServiceUnavailableResponseBody runs the validations
+// defined on get-groupsio-member_ServiceUnavailable_response_body
+func ValidateGetGroupsioMemberServiceUnavailableResponseBody(body *GetGroupsioMemberServiceUnavailableResponseBody) (err error) {
 	if body.Message == nil {
 		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
 	}
 	return
 }
 
-// ValidateFindParentGroupsioServiceServiceUnavailableResponseBody runs the
-// validations defined on
-// find-parent-groupsio-service_ServiceUnavailable_response_body
-func ValidateFindParentGroupsioServiceServiceUnavailableResponseBody(body *FindParentGroupsioServiceServiceUnavailableResponseBody) (err error) {
+// ValidateUpdateGroupsioMemberBadRequestResponseBody runs the validations
+// defined on update-groupsio-member_BadRequest_response_body
+func ValidateUpdateGroupsioMemberBadRequestResponseBody(body *UpdateGroupsioMemberBadRequestResponseBody) (err error) {
 	if body.Message == nil {
 		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
 	}
 	return
 }
 
-// ValidateListGroupsioMailingListsBadRequestResponseBody runs the validations
-// defined on list-groupsio-mailing-lists_BadRequest_response_body
-func ValidateListGroupsioMailingListsBadRequestResponseBody(body *ListGroupsioMailingListsBadRequestResponseBody) (err error) {
+// ValidateUpdateGroupsioMemberInternalServerErrorResponseBody runs the
+// validations defined on
+// update-groupsio-member_InternalServerError_response_body
+func ValidateUpdateGroupsioMemberInternalServerErrorResponseBody(body *UpdateGroupsioMemberInternalServerErrorResponseBody) (err error) {
 	if body.Message == nil {
 		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
 	}
 	return
 }
 
-// ValidateListGroupsioMailingListsInternalServerErrorResponseBody runs the
-// validations defined on
-// list-groupsio-mailing-lists_InternalServerError_response_body
-func ValidateListGroupsioMailingListsInternalServerErrorResponseBody(body *ListGroupsioMailingListsInternalServerErrorResponseBody) (err error) {
+// ValidateUpdateGroupsioMemberNotFoundResponseBody runs the validations
+// defined on update-groupsio-member_NotFound_response_body
+func ValidateUpdateGroupsioMemberNotFoundResponseBody(body *UpdateGroupsioMemberNotFoundResponseBody) (err error) {
 	if body.Message == nil {
 		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
 	}
 	return
 }
 
-// ValidateListGroupsioMailingListsServiceUnavailableResponseBody runs the
-// validations defined on
-// list-groupsio-mailing-lists_ServiceUnavailable_response_body
-func ValidateListGroupsioMailingListsServiceUnavailableResponseBody(body *ListGroupsioMailingListsServiceUnavailableResponseBody) (err error) {
+// ValidateUpdateGroupsioMemberServiceUnavailableResponseBody runs the
+// validations defined on
+// update-groupsio-member_ServiceUnavailable_response_body
+func ValidateUpdateGroupsioMemberServiceUnavailableResponseBody(body *UpdateGroupsioMemberServiceUnavailableResponseBody) (err error) {
 	if body.Message == nil {
 		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
 	}
 	return
 }
 
-// ValidateCreateGroupsioMailingListBadRequestResponseBody runs the validations
-// defined on create-groupsio-mailing-list_BadRequest_response_body
-func ValidateCreateGroupsioMailingListBadRequestResponseBody(body *CreateGroupsioMailingListBadRequestResponseBody) (err error) {
+// ValidateDeleteGroupsioMemberInternalServerErrorResponseBody runs the
+// validations defined on
+// delete-groupsio-member_InternalServerError_response_body
+func ValidateDeleteGroupsioMemberInternalServerErrorResponseBody(body *DeleteGroupsioMemberInternalServerErrorResponseBody) (err error) {
 	if body.Message == nil {
 		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
 	}
 	return
 }
 
-// ValidateCreateGroupsioMailingListConflictResponseBody runs the validations
-// defined on create-groupsio-mailing-list_Conflict_response_body
-func ValidateCreateGroupsioMailingListConflictResponseBody(body *CreateGroupsioMailingListConflictResponseBody) (err error) {
+// ValidateDeleteGroupsioMemberNotFoundResponseBody runs the validations
+// defined on delete-groupsio-member_NotFound_response_body
+func ValidateDeleteGroupsioMemberNotFoundResponseBody(body *DeleteGroupsioMemberNotFoundResponseBody) (err error) {
 	if body.Message == nil {
 		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
 	}
 	return
 }
 
-// ValidateCreateGroupsioMailingListInternalServerErrorResponseBody runs the
+// ValidateDeleteGroupsioMemberServiceUnavailableResponseBody runs the
 // validations defined on
-// create-groupsio-mailing-list_InternalServerError_response_body
-func ValidateCreateGroupsioMailingListInternalServerErrorResponseBody(body *CreateGroupsioMailingListInternalServerErrorResponseBody) (err error) {
+// delete-groupsio-member_ServiceUnavailable_response_body
+func ValidateDeleteGroupsioMemberServiceUnavailableResponseBody(body *DeleteGroupsioMemberServiceUnavailableResponseBody) (err error) {
 	if body.Message == nil {
 		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
 	}
 	return
 }
 
-// ValidateCreateGroupsioMailingListServiceUnavailableResponseBody runs the
-// validations defined on
-// create-groupsio-mailing-list_ServiceUnavailable_response_body
-func ValidateCreateGroupsioMailingListServiceUnavailableResponseBody(body *CreateGroupsioMailingListServiceUnavailableResponseBody) (err error) {
+// ValidateInviteGroupsioMembersBadRequestResponseBody runs the validations
+// defined on invite-groupsio-members_BadRequest_response_body
+func ValidateInviteGroupsioMembersBadRequestResponseBody(body *InviteGroupsioMembersBadRequestResponseBody) (err error) {
 	if body.Message == nil {
 		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
 	}
 	return
 }
 
-// ValidateGetGroupsioMailingListInternalServerErrorResponseBody runs the
+// ValidateInviteGroupsioMembersInternalServerErrorResponseBody runs the
 // validations defined on
-// get-groupsio-mailing-list_InternalServerError_response_body
-func ValidateGetGroupsioMailingListInternalServerErrorResponseBody(body *GetGroupsioMailingListInternalServerErrorResponseBody) (err error) {
+// invite-groupsio-members_InternalServerError_response_body
+func ValidateInviteGroupsioMembersInternalServerErrorResponseBody(body *InviteGroupsioMembersInternalServerErrorResponseBody) (err error) {
 	if body.Message == nil {
 		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
 	}
 	return
 }
 
-// ValidateGetGroupsioMailingListNotFoundResponseBody runs the validations
-// defined on get-groupsio-mailing-list_NotFound_response_body
-func ValidateGetGroupsioMailingListNotFoundResponseBody(body *GetGroupsioMailingListNotFoundResponseBody) (err error) {
+// ValidateInviteGroupsioMembersNotFoundResponseBody runs the validations
+// defined on invite-groupsio-members_NotFound_response_body
+func ValidateInviteGroupsioMembersNotFoundResponseBody(body *InviteGroupsioMembersNotFoundResponseBody) (err error) {
 	if body.Message == nil {
 		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
 	}
 	return
 }
 
-// ValidateGetGroupsioMailingListServiceUnavailableResponseBody runs the
+// ValidateInviteGroupsioMembersServiceUnavailableResponseBody runs the
 // validations defined on
-// get-groupsio-mailing-list_ServiceUnavailable_response_body
-func ValidateGetGroupsioMailingListServiceUnavailableResponseBody(body *GetGroupsioMailingListServiceUnavailableResponseBody) (err error) {
+// invite-groupsio-members_ServiceUnavailable_response_body
+func ValidateInviteGroupsioMembersServiceUnavailableResponseBody(body *InviteGroupsioMembersServiceUnavailableResponseBody) (err error) {
 	if body.Message == nil {
 		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
 	}
 	return
 }
 
-// ValidateUpdateGroupsioMailingListBadRequestResponseBody runs the validations
-// defined on update-groupsio-mailing-list_BadRequest_response_body
-func ValidateUpdateGroupsioMailingListBadRequestResponseBody(body *UpdateGroupsioMailingListBadRequestResponseBody) (err error) {
+// ValidateImportGroupsioMembersBadRequestResponseBody runs the validations
+// defined on import-groupsio-members_BadRequest_response_body
+func ValidateImportGroupsioMembersBadRequestResponseBody(body *ImportGroupsioMembersBadRequestResponseBody) (err error) {
 	if body.Message == nil {
 		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
 	}
 	return
 }
 
-// ValidateUpdateGroupsioMailingListInternalServerErrorResponseBody runs the
-// validations defined on
-// update-groupsio-mailing-list_InternalServerError_response_body
-func ValidateUpdateGroupsioMailingListInternalServerErrorResponseBody(body *UpdateGroupsioMailingListInternalServerErrorResponseBody) (err error) {
+// ValidateImportGroupsioMembersConflictResponseBody runs the validations
+// defined on import-groupsio-members_Conflict_response_body
+func ValidateImportGroupsioMembersConflictResponseBody(body *ImportGroupsioMembersConflictResponseBody) (err error) {
 	if body.Message == nil {
 		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
 	}
 	return
 }
 
-// ValidateUpdateGroupsioMailingListNotFoundResponseBody runs the validations
-// defined on update-groupsio-mailing-list_NotFound_response_body
-func ValidateUpdateGroupsioMailingListNotFoundResponseBody(body *UpdateGroupsioMailingListNotFoundResponseBody) (err error) {
+// ValidateImportGroupsioMembersInternalServerErrorResponseBody runs the
+// validations defined on
+// import-groupsio-members_InternalServerError_response_body
+func ValidateImportGroupsioMembersInternalServerErrorResponseBody(body *ImportGroupsioMembersInternalServerErrorResponseBody) (err error) {
 	if body.Message == nil {
 		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
 	}
 	return
 }
 
-// ValidateUpdateGroupsioMailingListServiceUnavailableResponseBody runs the
-// validations defined on
-// update-groupsio-mailing-list_ServiceUnavailable_response_body
-func ValidateUpdateGroupsioMailingListServiceUnavailableResponseBody(body *UpdateGroupsioMailingListServiceUnavailableResponseBody) (err error) {
+// ValidateImportGroupsioMembersNotFoundResponseBody runs the validations
+// defined on import-groupsio-members_NotFound_response_body
+func ValidateImportGroupsioMembersNotFoundResponseBody(body *ImportGroupsioMembersNotFoundResponseBody) (err error) {
 	if body.Message == nil {
 		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
 	}
 	return
 }
 
-// ValidateDeleteGroupsioMailingListInternalServerErrorResponseBody runs the
+// ValidateImportGroupsioMembersServiceUnavailableResponseBody runs the
 // validations defined on
-// delete-groupsio-mailing-list_InternalServerError_response_body
-func ValidateDeleteGroupsioMailingListInternalServerErrorResponseBody(body *DeleteGroupsioMailingListInternalServerErrorResponseBody) (err error) {
+// import-groupsio-members_ServiceUnavailable_response_body
+func ValidateImportGroupsioMembersServiceUnavailableResponseBody(body *ImportGroupsioMembersServiceUnavailableResponseBody) (err error) {
 	if body.Message == nil {
 		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
 	}
 	return
 }
 
-// ValidateDeleteGroupsioMailingListNotFoundResponseBody runs the validations
-// defined on delete-groupsio-mailing-list_NotFound_response_body
-func ValidateDeleteGroupsioMailingListNotFoundResponseBody(body *DeleteGroupsioMailingListNotFoundResponseBody) (err error) {
+// ValidateMergeGroupsioMembersBadRequestResponseBody runs the validations
+// defined on merge-groupsio-members_BadRequest_response_body
+func ValidateMergeGroupsioMembersBadRequestResponseBody(body *MergeGroupsioMembersBadRequestResponseBody) (err error) {
 	if body.Message == nil {
 		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
 	}
 	return
 }
 
-// ValidateDeleteGroupsioMailingListServiceUnavailableResponseBody runs the
+// ValidateMergeGroupsioMembersInternalServerErrorResponseBody runs the
 // validations defined on
-// delete-groupsio-mailing-list_ServiceUnavailable_response_body
-func ValidateDeleteGroupsioMailingListServiceUnavailableResponseBody(body *DeleteGroupsioMailingListServiceUnavailableResponseBody) (err error) {
+// merge-groupsio-members_InternalServerError_response_body
+func ValidateMergeGroupsioMembersInternalServerErrorResponseBody(body *MergeGroupsioMembersInternalServerErrorResponseBody) (err error) {
 	if body.Message == nil {
 		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
 	}
 	return
 }
 
-// ValidateGetGroupsioMailingListCountBadRequestResponseBody runs the
-// validations defined on
-// get-groupsio-mailing-list-count_BadRequest_response_body
-func ValidateGetGroupsioMailingListCountBadRequestResponseBody(body *GetGroupsioMailingListCountBadRequestResponseBody) (err error) {
+// ValidateMergeGroupsioMembersNotFoundResponseBody runs the validations
+// defined on merge-groupsio-members_NotFound_response_body
+func ValidateMergeGroupsioMembersNotFoundResponseBody(body *MergeGroupsioMembersNotFoundResponseBody) (err error) {
 	if body.Message == nil {
 		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
 	}
 	return
 }
 
-// ValidateGetGroupsioMailingListCountInternalServerErrorResponseBody runs the
+// ValidateMergeGroupsioMembersServiceUnavailableResponseBody runs the
 // validations defined on
-// get-groupsio-mailing-list-count_InternalServerError_response_body
-func ValidateGetGroupsioMailingListCountInternalServerErrorResponseBody(body *GetGroupsioMailingListCountInternalServerErrorResponseBody) (err error) {
+// merge-groupsio-members_ServiceUnavailable_response_body
+func ValidateMergeGroupsioMembersServiceUnavailableResponseBody(body *MergeGroupsioMembersServiceUnavailableResponseBody) (err error) {
 	if body.Message == nil {
 		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
 	}
 	return
 }
 
-// ValidateGetGroupsioMailingListCountServiceUnavailableResponseBody runs the
-// validations defined on
-// get-groupsio-mailing-list-count_ServiceUnavailable_response_body
-func ValidateGetGroupsioMailingListCountServiceUnavailableResponseBody(body *GetGroupsioMailingListCountServiceUnavailableResponseBody) (err error) {
+// ValidateChangeGroupsioMemberEmailBadRequestResponseBody runs the validations
+// defined on change-groupsio-member-email_BadRequest_response_body
+func ValidateChangeGroupsioMemberEmailBadRequestResponseBody(body *ChangeGroupsioMemberEmailBadRequestResponseBody) (err error) {
 	if body.Message == nil {
 		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
 	}
 	return
 }
 
-// ValidateGetGroupsioMailingListMemberCountInternalServerErrorResponseBody
-// runs the validations defined on
-// get-groupsio-mailing-list-member-count_InternalServerError_response_body
-func ValidateGetGroupsioMailingListMemberCountInternalServerErrorResponseBody(body *GetGroupsioMailingListMemberCountInternalServerErrorResponseBody) (err error) {
+// ValidateChangeGroupsioMemberEmailConflictResponseBody runs the validations
+// defined on change-groupsio-member-email_Conflict_response_body
+func ValidateChangeGroupsioMemberEmailConflictResponseBody(body *ChangeGroupsioMemberEmailConflictResponseBody) (err error) {
 	if body.Message == nil {
 		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
 	}
 	return
 }
 
-// ValidateGetGroupsioMailingListMemberCountNotFoundResponseBody runs the
+// ValidateChangeGroupsioMemberEmailInternalServerErrorResponseBody runs the
 // validations defined on
-// get-groupsio-mailing-list-member-count_NotFound_response_body
-func ValidateGetGroupsioMailingListMemberCountNotFoundResponseBody(body *GetGroupsioMailingListMemberCountNotFoundResponseBody) (err error) {
+// change-groupsio-member-email_InternalServerError_response_body
+func ValidateChangeGroupsioMemberEmailInternalServerErrorResponseBody(body *ChangeGroupsioMemberEmailInternalServerErrorResponseBody) (err error) {
 	if body.Message == nil {
 		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
 	}
 	return
 }
 
-// ValidateGetGroupsioMailingListMemberCountServiceUnavailableResponseBody runs
-// the validations defined on
-// get-groupsio-mailing-list-member-count_ServiceUnavailable_response_body
-func ValidateGetGroupsioMailingListMemberCountServiceUnavailableResponseBody(body *GetGroupsioMailingListMemberCountServiceUnavailableResponseBody) (err error) {
+// ValidateChangeGroupsioMemberEmailNotFoundResponseBody runs the validations
+// defined on change-groupsio-member-email_NotFound_response_body
+func ValidateChangeGroupsioMemberEmailNotFoundResponseBody(body *ChangeGroupsioMemberEmailNotFoundResponseBody) (err error) {
 	if body.Message == nil {
 		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
 	}
 	return
 }
 
-// ValidateListGroupsioMembersInternalServerErrorResponseBody runs the
+// ValidateChangeGroupsioMemberEmailServiceUnavailableResponseBody runs the
 // validations defined on
-// list-groupsio-members_InternalServerError_response_body
-func ValidateListGroupsioMembersInternalServerErrorResponseBody(body *ListGroupsioMembersInternalServerErrorResponseBody) (err error) {
+// change-groupsio-member-email_ServiceUnavailable_response_body
+func ValidateChangeGroupsioMemberEmailServiceUnavailableResponseBody(body *ChangeGroupsioMemberEmailServiceUnavailableResponseBody) (err error) {
 	if body.Message == nil {
 		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
 	}
 	return
 }
 
-// ValidateListGroupsioMembersNotFoundResponseBody runs the validations defined
-// on list-groupsio-members_NotFound_response_body
-func ValidateListGroupsioMembersNotFoundResponseBody(body *ListGroupsioMembersNotFoundResponseBody) (err error) {
+// ValidateCheckGroupsioSubscriberBadRequestResponseBody runs the validations
+// defined on check-groupsio-subscriber_BadRequest_response_body
+func ValidateCheckGroupsioSubscriberBadRequestResponseBody(body *CheckGroupsioSubscriberBadRequestResponseBody) (err error) {
 	if body.Message == nil {
 		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
 	}
 	return
 }
 
-// ValidateListGroupsioMembersServiceUnavailableResponseBody runs the
-// validations defined on list-groupsio-members_ServiceUnavailable_response_body
-func ValidateListGroupsioMembersServiceUnavailableResponseBody(body *ListGroupsioMembersServiceUnavailableResponseBody) (err error) {
+// ValidateCheckGroupsioSubscriberInternalServerErrorResponseBody runs the
+// validations defined on
+// check-groupsio-subscriber_InternalServerError_response_body
+func ValidateCheckGroupsioSubscriberInternalServerErrorResponseBody(body *CheckGroupsioSubscriberInternalServerErrorResponseBody) (err error) {
 	if body.Message == nil {
 		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
 	}
 	return
 }
 
-// ValidateAddGroupsioMemberBadRequestResponseBody runs the validations defined
-// on add-groupsio-member_BadRequest_response_body
-func ValidateAddGroupsioMemberBadRequestResponseBody(body *AddGroupsioMemberBadRequestResponseBody) (err error) {
+// ValidateCheckGroupsioSubscriberServiceUnavailableResponseBody runs the
+// validations defined on
+// check-groupsio-subscriber_ServiceUnavailable_response_body
+func ValidateCheckGroupsioSubscriberServiceUnavailableResponseBody(body *CheckGroupsioSubscriberServiceUnavailableResponseBody) (err error) {
 	if body.Message == nil {
 		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
 	}
 	return
 }
 
-// ValidateAddGroupsioMemberConflictResponseBody runs the validations defined
-// on add-groupsio-member_Conflict_response_body
-func ValidateAddGroupsioMemberConflictResponseBody(body *AddGroupsioMemberConflictResponseBody) (err error) {
+// ValidateGetGroupsioArtifactInternalServerErrorResponseBody runs the
+// validations defined on
+// get-groupsio-artifact_InternalServerError_response_body
+func ValidateGetGroupsioArtifactInternalServerErrorResponseBody(body *GetGroupsioArtifactInternalServerErrorResponseBody) (err error) {
 	if body.Message == nil {
 		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
 	}
 	return
 }
 
-// ValidateAddGroupsioMemberInternalServerErrorResponseBody runs the
-// validations defined on add-groupsio-member_InternalServerError_response_body
-func ValidateAddGroupsioMemberInternalServerErrorResponseBody(body *AddGroupsioMemberInternalServerErrorResponseBody) (err error) {
+// ValidateGetGroupsioArtifactNotFoundResponseBody runs the validations defined
+// on get-groupsio-artifact_NotFound_response_body
+func ValidateGetGroupsioArtifactNotFoundResponseBody(body *GetGroupsioArtifactNotFoundResponseBody) (err error) {
 	if body.Message == nil {
 		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
 	}
 	return
 }
 
-// ValidateAddGroupsioMemberNotFoundResponseBody runs the validations defined
-// on add-groupsio-member_NotFound_response_body
-func ValidateAddGroupsioMemberNotFoundResponseBody(body *AddGroupsioMemberNotFoundResponseBody) (err error) {
+// ValidateGetGroupsioArtifactServiceUnavailableResponseBody runs the
+// validations defined on get-groupsio-artifact_ServiceUnavailable_response_body
+func ValidateGetGroupsioArtifactServiceUnavailableResponseBody(body *GetGroupsioArtifactServiceUnavailableResponseBody) (err error) {
 	if body.Message == nil {
 		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
 	}
 	return
 }
 
-// ValidateAddGroupsioMemberServiceUnavailableResponseBody runs the validations
-// defined on add-groupsio-member_ServiceUnavailable_response_body
-func ValidateAddGroupsioMemberServiceUnavailableResponseBody(body *AddGroupsioMemberServiceUnavailableResponseBody) (err error) {
+// ValidateGetGroupsioArtifactDownloadInternalServerErrorResponseBody runs the
+// validations defined on
+// get-groupsio-artifact-download_InternalServerError_response_body
+func ValidateGetGroupsioArtifactDownloadInternalServerErrorResponseBody(body *GetGroupsioArtifactDownloadInternalServerErrorResponseBody) (err error) {
 	if body.Message == nil {
 		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
 	}
 	return
 }
 
-// ValidateGetGroupsioMemberInternalServerErrorResponseBody runs the
-// validations defined on get-groupsio-member_InternalServerError_response_body
-func ValidateGetGroupsioMemberInternalServerErrorResponseBody(body *GetGroupsioMemberInternalServerErrorResponseBody) (err error) {
+// ValidateGetGroupsioArtifactDownloadNotFoundResponseBody runs the validations
+// defined on get-groupsio-artifact-download_NotFound_response_body
+func ValidateGetGroupsioArtifactDownloadNotFoundResponseBody(body *GetGroupsioArtifactDownloadNotFoundResponseBody) (err error) {
 	if body.Message == nil {
 		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
 	}
 	return
 }
 
-// ValidateGetGroupsioMemberNotFoundResponseBody runs the validations defined
-// on get-groupsio-member_NotFound_response_body
-func ValidateGetGroupsioMemberNotFoundResponseBody(body *GetGroupsioMemberNotFoundResponseBody) (err error) {
+// ValidateGetGroupsioArtifactDownloadServiceUnavailableResponseBody runs the
+// validations defined on
+// get-groupsio-artifact-download_ServiceUnavailable_response_body
+func ValidateGetGroupsioArtifactDownloadServiceUnavailableResponseBody(body *GetGroupsioArtifactDownloadServiceUnavailableResponseBody) (err error) {
 	if body.Message == nil {
 		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
 	}
 	return
 }
 
-// ValidateGetGroupsioMemberServiceUnavailableResponseBody runs the validations
-// defined on get-groupsio-member_ServiceUnavailable_response_body
-func ValidateGetGroupsioMemberServiceUnavailableResponseBody(body *GetGroupsioMemberServiceUnavailableResponseBody) (err error) {
+// ValidateGetGroupsioProjectSummaryBadRequestResponseBody runs the validations
+// defined on get-groupsio-project-summary_BadRequest_response_body
+func ValidateGetGroupsioProjectSummaryBadRequestResponseBody(body *GetGroupsioProjectSummaryBadRequestResponseBody) (err error) {
 	if body.Message == nil {
 		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
 	}
 	return
 }
 
-// ValidateUpdateGroupsioMemberBadRequestResponseBody runs the validations
-// defined on update-groupsio-member_BadRequest_response_body
-func ValidateUpdateGroupsioMemberBadRequestResponseBody(body *UpdateGroupsioMemberBadRequestResponseBody) (err error) {
+// ValidateGetGroupsioProjectSummaryInternalServerErrorResponseBody runs the
+// validations defined on
+// get-groupsio-project-summary_InternalServerError_response_body
+func ValidateGetGroupsioProjectSummaryInternalServerErrorResponseBody(body *GetGroupsioProjectSummaryInternalServerErrorResponseBody) (err error) {
 	if body.Message == nil {
 		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
 	}
 	return
 }
 
-// ValidateUpdateGroupsioMemberInternalServerErrorResponseBody runs the
+// ValidateGetGroupsioProjectSummaryServiceUnavailableResponseBody runs the
 // validations defined on
-// update-groupsio-member_InternalServerError_response_body
-func ValidateUpdateGroupsioMemberInternalServerErrorResponseBody(body *UpdateGroupsioMemberInternalServerErrorResponseBody) (err error) {
+// get-groupsio-project-summary_ServiceUnavailable_response_body
+func ValidateGetGroupsioProjectSummaryServiceUnavailableResponseBody(body *GetGroupsioProjectSummaryServiceUnavailableResponseBody) (err error) {
 	if body.Message == nil {
 		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
 	}
 	return
 }
 
-// ValidateUpdateGroupsioMemberNotFoundResponseBody runs the validations
-// defined on update-groupsio-member_NotFound_response_body
-func ValidateUpdateGroupsioMemberNotFoundResponseBody(body *UpdateGroupsioMemberNotFoundResponseBody) (err error) {
+// ValidateListGroupsioOrphanedMailingListsBadRequestResponseBody runs the
+// validations defined on
+// list-groupsio-orphaned-mailing-lists_BadRequest_response_body
+func ValidateListGroupsioOrphanedMailingListsBadRequestResponseBody(body *ListGroupsioOrphanedMailingListsBadRequestResponseBody) (err error) {
 	if body.Message == nil {
 		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
 	}
 	return
 }
 
-// ValidateUpdateGroupsioMemberServiceUnavailableResponseBody runs the
-// validations defined on
-// update-groupsio-member_ServiceUnavailable_response_body
-func ValidateUpdateGroupsioMemberServiceUnavailableResponseBody(body *UpdateGroupsioMemberServiceUnavailableResponseBody) (err error) {
+// ValidateListGroupsioOrphanedMailingListsInternalServerErrorResponseBody runs
+// the validations defined on
+// list-groupsio-orphaned-mailing-lists_InternalServerError_response_body
+func ValidateListGroupsioOrphanedMailingListsInternalServerErrorResponseBody(body *ListGroupsioOrphanedMailingListsInternalServerErrorResponseBody) (err error) {
 	if body.Message == nil {
 		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
 	}
 	return
 }
 
-// ValidateDeleteGroupsioMemberInternalServerErrorResponseBody runs the
-// validations defined on
-// delete-groupsio-member_InternalServerError_response_body
-func ValidateDeleteGroupsioMemberInternalServerErrorResponseBody(body *DeleteGroupsioMemberInternalServerErrorResponseBody) (err error) {
+// ValidateListGroupsioOrphanedMailingListsServiceUnavailableResponseBody runs
+// the validations defined on
+// list-groupsio-orphaned-mailing-lists_ServiceUnavailable_response_body
+func ValidateListGroupsioOrphanedMailingListsServiceUnavailableResponseBody(body *ListGroupsioOrphanedMailingListsServiceUnavailableResponseBody) (err error) {
 	if body.Message == nil {
 		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
 	}
 	return
 }
 
-// ValidateDeleteGroupsioMemberNotFoundResponseBody runs the validations
-// defined on delete-groupsio-member_NotFound_response_body
-func ValidateDeleteGroupsioMemberNotFoundResponseBody(body *DeleteGroupsioMemberNotFoundResponseBody) (err error) {
+// ValidateRepublishGroupsioProjectIndexBadRequestResponseBody runs the
+// validations defined on
+// republish-groupsio-project-index_BadRequest_response_body
+func ValidateRepublishGroupsioProjectIndexBadRequestResponseBody(body *RepublishGroupsioProjectIndexBadRequestResponseBody) (err error) {
 	if body.Message == nil {
 		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
 	}
 	return
 }
 
-// ValidateDeleteGroupsioMemberServiceUnavailableResponseBody runs the
-// validations defined on
-// delete-groupsio-member_ServiceUnavailable_response_body
-func ValidateDeleteGroupsioMemberServiceUnavailableResponseBody(body *DeleteGroupsioMemberServiceUnavailableResponseBody) (err error) {
+// ValidateRepublishGroupsioProjectIndexInternalServerErrorResponseBody runs
+// the validations defined on
+// republish-groupsio-project-index_InternalServerError_response_body
+func ValidateRepublishGroupsioProjectIndexInternalServerErrorResponseBody(body *RepublishGroupsioProjectIndexInternalServerErrorResponseBody) (err error) {
 	if body.Message == nil {
 		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
 	}
 	return
 }
 
-// ValidateInviteGroupsioMembersBadRequestResponseBody runs the validations
-// defined on invite-groupsio-members_BadRequest_response_body
-func ValidateInviteGroupsioMembersBadRequestResponseBody(body *InviteGroupsioMembersBadRequestResponseBody) (err error) {
+// ValidateRepublishGroupsioProjectIndexServiceUnavailableResponseBody runs the
+// validations defined on
+// republish-groupsio-project-index_ServiceUnavailable_response_body
+func ValidateRepublishGroupsioProjectIndexServiceUnavailableResponseBody(body *RepublishGroupsioProjectIndexServiceUnavailableResponseBody) (err error) {
 	if body.Message == nil {
 		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
 	}
 	return
 }
 
-// ValidateInviteGroupsioMembersInternalServerErrorResponseBody runs the
+// ValidateGetGroupsioMailingListStatsInternalServerErrorResponseBody runs the
 // validations defined on
-// invite-groupsio-members_InternalServerError_response_body
-func ValidateInviteGroupsioMembersInternalServerErrorResponseBody(body *InviteGroupsioMembersInternalServerErrorResponseBody) (err error) {
+// get-groupsio-mailing-list-stats_InternalServerError_response_body
+func ValidateGetGroupsioMailingListStatsInternalServerErrorResponseBody(body *GetGroupsioMailingListStatsInternalServerErrorResponseBody) (err error) {
 	if body.Message == nil {
 		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
 	}
 	return
 }
 
-// ValidateInviteGroupsioMembersNotFoundResponseBody runs the validations
-// defined on invite-groupsio-members_NotFound_response_body
-func ValidateInviteGroupsioMembersNotFoundResponseBody(body *InviteGroupsioMembersNotFoundResponseBody) (err error) {
+// ValidateGetGroupsioMailingListStatsNotFoundResponseBody runs the validations
+// defined on get-groupsio-mailing-list-stats_NotFound_response_body
+func ValidateGetGroupsioMailingListStatsNotFoundResponseBody(body *GetGroupsioMailingListStatsNotFoundResponseBody) (err error) {
 	if body.Message == nil {
 		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
 	}
 	return
 }
 
-// ValidateInviteGroupsioMembersServiceUnavailableResponseBody runs the
+// ValidateGetGroupsioMailingListStatsServiceUnavailableResponseBody runs the
 // validations defined on
-// invite-groupsio-members_ServiceUnavailable_response_body
-func ValidateInviteGroupsioMembersServiceUnavailableResponseBody(body *InviteGroupsioMembersServiceUnavailableResponseBody) (err error) {
+// get-groupsio-mailing-list-stats_ServiceUnavailable_response_body
+func ValidateGetGroupsioMailingListStatsServiceUnavailableResponseBody(body *GetGroupsioMailingListStatsServiceUnavailableResponseBody) (err error) {
 	if body.Message == nil {
 		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
 	}
 	return
 }
 
-// ValidateCheckGroupsioSubscriberBadRequestResponseBody runs the validations
-// defined on check-groupsio-subscriber_BadRequest_response_body
-func ValidateCheckGroupsioSubscriberBadRequestResponseBody(body *CheckGroupsioSubscriberBadRequestResponseBody) (err error) {
+// ValidateGetGroupsioServiceTreeInternalServerErrorResponseBody runs the
+// validations defined on
+// get-groupsio-service-tree_InternalServerError_response_body
+func ValidateGetGroupsioServiceTreeInternalServerErrorResponseBody(body *GetGroupsioServiceTreeInternalServerErrorResponseBody) (err error) {
 	if body.Message == nil {
 		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
 	}
 	return
 }
 
-// ValidateCheckGroupsioSubscriberInternalServerErrorResponseBody runs the
-// validations defined on
-// check-groupsio-subscriber_InternalServerError_response_body
-func ValidateCheckGroupsioSubscriberInternalServerErrorResponseBody(body *CheckGroupsioSubscriberInternalServerErrorResponseBody) (err error) {
+// ValidateGetGroupsioServiceTreeNotFoundResponseBody runs the validations
+// defined on get-groupsio-service-tree_NotFound_response_body
+func ValidateGetGroupsioServiceTreeNotFoundResponseBody(body *GetGroupsioServiceTreeNotFoundResponseBody) (err error) {
 	if body.Message == nil {
 		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
 	}
 	return
 }
 
-// ValidateCheckGroupsioSubscriberServiceUnavailableResponseBody runs the
+// ValidateGetGroupsioServiceTreeServiceUnavailableResponseBody runs the
 // validations defined on
-// check-groupsio-subscriber_ServiceUnavailable_response_body
-func ValidateCheckGroupsioSubscriberServiceUnavailableResponseBody(body *CheckGroupsioSubscriberServiceUnavailableResponseBody) (err error) {
+// get-groupsio-service-tree_ServiceUnavailable_response_body
+func ValidateGetGroupsioServiceTreeServiceUnavailableResponseBody(body *GetGroupsioServiceTreeServiceUnavailableResponseBody) (err error) {
 	if body.Message == nil {
 		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
 	}
 	return
 }
 
-// ValidateGetGroupsioArtifactInternalServerErrorResponseBody runs the
+// ValidateGetGroupsioMemberHierarchyInternalServerErrorResponseBody runs the
 // validations defined on
-// get-groupsio-artifact_InternalServerError_response_body
-func ValidateGetGroupsioArtifactInternalServerErrorResponseBody(body *GetGroupsioArtifactInternalServerErrorResponseBody) (err error) {
+// get-groupsio-member-hierarchy_InternalServerError_response_body
+func ValidateGetGroupsioMemberHierarchyInternalServerErrorResponseBody(body *GetGroupsioMemberHierarchyInternalServerErrorResponseBody) (err error) {
 	if body.Message == nil {
 		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
 	}
 	return
 }
 
-// ValidateGetGroupsioArtifactNotFoundResponseBody runs the validations defined
-// on get-groupsio-artifact_NotFound_response_body
-func ValidateGetGroupsioArtifactNotFoundResponseBody(body *GetGroupsioArtifactNotFoundResponseBody) (err error) {
+// ValidateGetGroupsioMemberHierarchyNotFoundResponseBody runs the validations
+// defined on get-groupsio-member-hierarchy_NotFound_response_body
+func ValidateGetGroupsioMemberHierarchyNotFoundResponseBody(body *GetGroupsioMemberHierarchyNotFoundResponseBody) (err error) {
 	if body.Message == nil {
 		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
 	}
 	return
 }
 
-// ValidateGetGroupsioArtifactServiceUnavailableResponseBody runs the
-// validations defined on get-groupsio-artifact_ServiceUnavailable_response_body
-func ValidateGetGroupsioArtifactServiceUnavailableResponseBody(body *GetGroupsioArtifactServiceUnavailableResponseBody) (err error) {
+// ValidateGetGroupsioMemberHierarchyServiceUnavailableResponseBody runs the
+// validations defined on
+// get-groupsio-member-hierarchy_ServiceUnavailable_response_body
+func ValidateGetGroupsioMemberHierarchyServiceUnavailableResponseBody(body *GetGroupsioMemberHierarchyServiceUnavailableResponseBody) (err error) {
 	if body.Message == nil {
 		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
 	}
 	return
 }
 
-// ValidateGetGroupsioArtifactDownloadInternalServerErrorResponseBody runs the
-// validations defined on
-// get-groupsio-artifact-download_InternalServerError_response_body
-func ValidateGetGroupsioArtifactDownloadInternalServerErrorResponseBody(body *GetGroupsioArtifactDownloadInternalServerErrorResponseBody) (err error) {
+// ValidateListGroupsioProjectMembersBadRequestResponseBody runs the
+// validations defined on list-groupsio-project-members_BadRequest_response_body
+func ValidateListGroupsioProjectMembersBadRequestResponseBody(body *ListGroupsioProjectMembersBadRequestResponseBody) (err error) {
 	if body.Message == nil {
 		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
 	}
 	return
 }
 
-// ValidateGetGroupsioArtifactDownloadNotFoundResponseBody runs the validations
-// defined on get-groupsio-artifact-download_NotFound_response_body
-func ValidateGetGroupsioArtifactDownloadNotFoundResponseBody(body *GetGroupsioArtifactDownloadNotFoundResponseBody) (err error) {
+// ValidateListGroupsioProjectMembersInternalServerErrorResponseBody runs the
+// validations defined on
+// list-groupsio-project-members_InternalServerError_response_body
+func ValidateListGroupsioProjectMembersInternalServerErrorResponseBody(body *ListGroupsioProjectMembersInternalServerErrorResponseBody) (err error) {
 	if body.Message == nil {
 		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
 	}
 	return
 }
 
-// ValidateGetGroupsioArtifactDownloadServiceUnavailableResponseBody runs the
+// ValidateListGroupsioProjectMembersServiceUnavailableResponseBody runs the
 // validations defined on
-// get-groupsio-artifact-download_ServiceUnavailable_response_body
-func ValidateGetGroupsioArtifactDownloadServiceUnavailableResponseBody(body *GetGroupsioArtifactDownloadServiceUnavailableResponseBody) (err error) {
+// list-groupsio-project-members_ServiceUnavailable_response_body
+func ValidateListGroupsioProjectMembersServiceUnavailableResponseBody(body *ListGroupsioProjectMembersServiceUnavailableResponseBody) (err error) {
 	if body.Message == nil {
 		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
 	}
@@ -3445,3 +6672,53 @@ func ValidateGroupsioMemberResponseBody(body *GroupsioMemberResponseBody) (err e
 	}
 	return
 }
+
+// ValidateGroupsioMemberRequestRequestBody runs the validations defined on
+// groupsio-member-requestRequestBody
+func ValidateGroupsioMemberRequestRequestBody(body *GroupsioMemberRequestRequestBody) (err error) {
+	if body.Email != nil {
+		err = goa.MergeErrors(err, goa.ValidateFormat("body.email", *body.Email, goa.FormatEmail))
+	}
+	if body.MemberType != nil {
+		if !(*body.MemberType == "direct") {
+			err = goa.MergeErrors(err, goa.InvalidEnumValueError("body.member_type", *body.MemberType, []any{"direct"}))
+		}
+	}
+	if body.ModStatus != nil {
+		if !(*body.ModStatus == "none" || *body.ModStatus == "moderator" || *body.ModStatus == "owner") {
+			err = goa.MergeErrors(err, goa.InvalidEnumValueError("body.mod_status", *body.ModStatus, []any{"none", "moderator", "owner"}))
+		}
+	}
+	if body.DeliveryMode != nil {
+		if !(*body.DeliveryMode == "email_delivery_single" || *body.DeliveryMode == "email_delivery_digest" || *body.DeliveryMode == "email_delivery_none" || *body.DeliveryMode == "email_delivery_special" || *body.DeliveryMode == "email_delivery_html_digest" || *body.DeliveryMode == "email_delivery_summary") {
+			err = goa.MergeErrors(err, goa.InvalidEnumValueError("body.delivery_mode", *body.DeliveryMode, []any{"email_delivery_single", "email_delivery_digest", "email_delivery_none", "email_delivery_special", "email_delivery_html_digest", "email_delivery_summary"}))
+		}
+	}
+	return
+}
+
+// ValidateGroupsioServiceTreeSubgroupResponseBody runs the validations defined
+// on groupsio-service-tree-subgroupResponseBody
+func ValidateGroupsioServiceTreeSubgroupResponseBody(body *GroupsioServiceTreeSubgroupResponseBody) (err error) {
+	if body.Subgroup == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("subgroup", "body"))
+	}
+	if body.Subgroup != nil {
+		if err2 := ValidateGroupsioSubgroupResponseBody(body.Subgroup); err2 != nil {
+			err = goa.MergeErrors(err, err2)
+		}
+	}
+	return
+}
+
+// ValidateGroupsioMemberHierarchyProjectResponseBody runs the validations
+// defined on groupsio-member-hierarchy-projectResponseBody
+func ValidateGroupsioMemberHierarchyProjectResponseBody(body *GroupsioMemberHierarchyProjectResponseBody) (err error) {
+	if body.UID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("uid", "body"))
+	}
+	if body.UID != nil {
+		err = goa.MergeErrors(err, goa.ValidateFormat("body.uid", *body.UID, goa.FormatUUID))
+	}
+	return
+}
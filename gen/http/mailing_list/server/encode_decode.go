@@ -13,6 +13,7 @@ import (
 	"errors"
 	"io"
 	"net/http"
+	"strconv"
 	"strings"
 
 	mailinglist "github.com/linuxfoundation/lfx-v2-mailing-list-service/gen/mailing_list"
@@ -37,10 +38,9 @@ func EncodeLivezResponse(encoder func(context.Context, http.ResponseWriter) goah
 // mailing-list readyz endpoint.
 func EncodeReadyzResponse(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder) func(context.Context, http.ResponseWriter, any) error {
 	return func(ctx context.Context, w http.ResponseWriter, v any) error {
-		res, _ := v.([]byte)
-		ctx = context.WithValue(ctx, goahttp.ContentTypeKey, "text/plain")
+		res, _ := v.(*mailinglist.Readiness)
 		enc := encoder(ctx, w)
-		body := res
+		body := NewReadyzResponseBody(res)
 		w.WriteHeader(http.StatusOK)
 		return enc.Encode(body)
 	}
@@ -525,6 +525,127 @@ func EncodeUpdateGroupsioServiceError(encoder func(context.Context, http.Respons
 	}
 }
 
+// EncodePromoteGroupsioServiceResponse returns an encoder for responses
+// returned by the mailing-list promote-groupsio-service endpoint.
+func EncodePromoteGroupsioServiceResponse(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder) func(context.Context, http.ResponseWriter, any) error {
+	return func(ctx context.Context, w http.ResponseWriter, v any) error {
+		res, _ := v.(*mailinglist.GroupsioServicePromotion)
+		enc := encoder(ctx, w)
+		body := NewPromoteGroupsioServiceResponseBody(res)
+		w.WriteHeader(http.StatusOK)
+		return enc.Encode(body)
+	}
+}
+
+// DecodePromoteGroupsioServiceRequest returns a decoder for requests sent to
+// the mailing-list promote-groupsio-service endpoint.
+func DecodePromoteGroupsioServiceRequest(mux goahttp.Muxer, decoder func(*http.Request) goahttp.Decoder) func(*http.Request) (any, error) {
+	return func(r *http.Request) (any, error) {
+		var (
+			serviceID   string
+			bearerToken *string
+
+			params = mux.Vars(r)
+		)
+		serviceID = params["service_id"]
+		bearerTokenRaw := r.Header.Get("Authorization")
+		if bearerTokenRaw != "" {
+			bearerToken = &bearerTokenRaw
+		}
+		payload := NewPromoteGroupsioServicePayload(serviceID, bearerToken)
+		if payload.BearerToken != nil {
+			if strings.Contains(*payload.BearerToken, " ") {
+				// Remove authorization scheme prefix (e.g. "Bearer")
+				cred := strings.SplitN(*payload.BearerToken, " ", 2)[1]
+				payload.BearerToken = &cred
+			}
+		}
+
+		return payload, nil
+	}
+}
+
+// EncodePromoteGroupsioServiceError returns an encoder for errors returned by
+// the promote-groupsio-service mailing-list endpoint.
+func EncodePromoteGroupsioServiceError(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder, formatter func(ctx context.Context, err error) goahttp.Statuser) func(context.Context, http.ResponseWriter, error) error {
+	encodeError := goahttp.ErrorEncoder(encoder, formatter)
+	return func(ctx context.Context, w http.ResponseWriter, v error) error {
+		var en goa.GoaErrorNamer
+		if !errors.As(v, &en) {
+			return encodeError(ctx, w, v)
+		}
+		switch en.GoaErrorName() {
+		case "BadRequest":
+			var res *mailinglist.BadRequestError
+			errors.As(v, &res)
+			enc := encoder(ctx, w)
+			var body any
+			if formatter != nil {
+				body = formatter(ctx, res)
+			} else {
+				body = NewPromoteGroupsioServiceBadRequestResponseBody(res)
+			}
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusBadRequest)
+			return enc.Encode(body)
+		case "Conflict":
+			var res *mailinglist.ConflictError
+			errors.As(v, &res)
+			enc := encoder(ctx, w)
+			var body any
+			if formatter != nil {
+				body = formatter(ctx, res)
+			} else {
+				body = NewPromoteGroupsioServiceConflictResponseBody(res)
+			}
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusConflict)
+			return enc.Encode(body)
+		case "InternalServerError":
+			var res *mailinglist.InternalServerError
+			errors.As(v, &res)
+			enc := encoder(ctx, w)
+			var body any
+			if formatter != nil {
+				body = formatter(ctx, res)
+			} else {
+				body = NewPromoteGroupsioServiceInternalServerErrorResponseBody(res)
+			}
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusInternalServerError)
+			return enc.Encode(body)
+		case "NotFound":
+			var res *mailinglist.NotFoundError
+			errors.As(v, &res)
+			enc := encoder(ctx, w)
+			var body any
+			if formatter != nil {
+				body = formatter(ctx, res)
+			} else {
+				body = NewPromoteGroupsioServiceNotFoundResponseBody(res)
+			}
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusNotFound)
+			return enc.Encode(body)
+		case "ServiceUnavailable":
+			var res *mailinglist.ServiceUnavailableError
+			errors.As(v, &res)
+			enc := encoder(ctx, w)
+			var body any
+			if formatter != nil {
+				body = formatter(ctx, res)
+			} else {
+				body = NewPromoteGroupsioServiceServiceUnavailableResponseBody(res)
+			}
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return enc.Encode(body)
+		default:
+			return encodeError(ctx, w, v)
+		}
+	}
+}
+
 // EncodeDeleteGroupsioServiceResponse returns an encoder for responses
 // returned by the mailing-list delete-groupsio-service endpoint.
 func EncodeDeleteGroupsioServiceResponse(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder) func(context.Context, http.ResponseWriter, any) error {
@@ -695,32 +816,31 @@ func EncodeGetGroupsioServiceProjectsError(encoder func(context.Context, http.Re
 	}
 }
 
-// EncodeFindParentGroupsioServiceResponse returns an encoder for responses
-// returned by the mailing-list find-parent-groupsio-service endpoint.
-func EncodeFindParentGroupsioServiceResponse(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder) func(context.Context, http.ResponseWriter, any) error {
+// EncodeListGroupsioServicesByStatusResponse returns an encoder for responses
+// returned by the mailing-list list-groupsio-services-by-status endpoint.
+func EncodeListGroupsioServicesByStatusResponse(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder) func(context.Context, http.ResponseWriter, any) error {
 	return func(ctx context.Context, w http.ResponseWriter, v any) error {
-		res, _ := v.(*mailinglist.GroupsioService)
+		res, _ := v.(*mailinglist.GroupsioServiceList)
 		enc := encoder(ctx, w)
-		body := NewFindParentGroupsioServiceResponseBody(res)
+		body := NewListGroupsioServicesByStatusResponseBody(res)
 		w.WriteHeader(http.StatusOK)
 		return enc.Encode(body)
 	}
 }
 
-// DecodeFindParentGroupsioServiceRequest returns a decoder for requests sent
-// to the mailing-list find-parent-groupsio-service endpoint.
-func DecodeFindParentGroupsioServiceRequest(mux goahttp.Muxer, decoder func(*http.Request) goahttp.Decoder) func(*http.Request) (any, error) {
+// DecodeListGroupsioServicesByStatusRequest returns a decoder for requests
+// sent to the mailing-list list-groupsio-services-by-status endpoint.
+func DecodeListGroupsioServicesByStatusRequest(mux goahttp.Muxer, decoder func(*http.Request) goahttp.Decoder) func(*http.Request) (any, error) {
 	return func(r *http.Request) (any, error) {
 		var (
-			projectUID  string
+			status      string
 			bearerToken *string
 			err         error
 		)
-		projectUID = r.URL.Query().Get("project_uid")
-		if projectUID == "" {
-			err = goa.MergeErrors(err, goa.MissingFieldError("project_uid", "query string"))
+		status = r.URL.Query().Get("status")
+		if status == "" {
+			err = goa.MergeErrors(err, goa.MissingFieldError("status", "query string"))
 		}
-		err = goa.MergeErrors(err, goa.ValidateFormat("project_uid", projectUID, goa.FormatUUID))
 		bearerTokenRaw := r.Header.Get("Authorization")
 		if bearerTokenRaw != "" {
 			bearerToken = &bearerTokenRaw
@@ -728,7 +848,7 @@ func DecodeFindParentGroupsioServiceRequest(mux goahttp.Muxer, decoder func(*htt
 		if err != nil {
 			return nil, err
 		}
-		payload := NewFindParentGroupsioServicePayload(projectUID, bearerToken)
+		payload := NewListGroupsioServicesByStatusPayload(status, bearerToken)
 		if payload.BearerToken != nil {
 			if strings.Contains(*payload.BearerToken, " ") {
 				// Remove authorization scheme prefix (e.g. "Bearer")
@@ -741,9 +861,9 @@ func DecodeFindParentGroupsioServiceRequest(mux goahttp.Muxer, decoder func(*htt
 	}
 }
 
-// EncodeFindParentGroupsioServiceError returns an encoder for errors returned
-// by the find-parent-groupsio-service mailing-list endpoint.
-func EncodeFindParentGroupsioServiceError(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder, formatter func(ctx context.Context, err error) goahttp.Statuser) func(context.Context, http.ResponseWriter, error) error {
+// EncodeListGroupsioServicesByStatusError returns an encoder for errors
+// returned by the list-groupsio-services-by-status mailing-list endpoint.
+func EncodeListGroupsioServicesByStatusError(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder, formatter func(ctx context.Context, err error) goahttp.Statuser) func(context.Context, http.ResponseWriter, error) error {
 	encodeError := goahttp.ErrorEncoder(encoder, formatter)
 	return func(ctx context.Context, w http.ResponseWriter, v error) error {
 		var en goa.GoaErrorNamer
@@ -759,7 +879,7 @@ func EncodeFindParentGroupsioServiceError(encoder func(context.Context, http.Res
 			if formatter != nil {
 				body = formatter(ctx, res)
 			} else {
-				body = NewFindParentGroupsioServiceBadRequestResponseBody(res)
+				body = NewListGroupsioServicesByStatusBadRequestResponseBody(res)
 			}
 			w.Header().Set("goa-error", res.GoaErrorName())
 			w.WriteHeader(http.StatusBadRequest)
@@ -772,24 +892,11 @@ func EncodeFindParentGroupsioServiceError(encoder func(context.Context, http.Res
 			if formatter != nil {
 				body = formatter(ctx, res)
 			} else {
-				body = NewFindParentGroupsioServiceInternalServerErrorResponseBody(res)
+				body = NewListGroupsioServicesByStatusInternalServerErrorResponseBody(res)
 			}
 			w.Header().Set("goa-error", res.GoaErrorName())
 			w.WriteHeader(http.StatusInternalServerError)
 			return enc.Encode(body)
-		case "NotFound":
-			var res *mailinglist.NotFoundError
-			errors.As(v, &res)
-			enc := encoder(ctx, w)
-			var body any
-			if formatter != nil {
-				body = formatter(ctx, res)
-			} else {
-				body = NewFindParentGroupsioServiceNotFoundResponseBody(res)
-			}
-			w.Header().Set("goa-error", res.GoaErrorName())
-			w.WriteHeader(http.StatusNotFound)
-			return enc.Encode(body)
 		case "ServiceUnavailable":
 			var res *mailinglist.ServiceUnavailableError
 			errors.As(v, &res)
@@ -798,7 +905,7 @@ func EncodeFindParentGroupsioServiceError(encoder func(context.Context, http.Res
 			if formatter != nil {
 				body = formatter(ctx, res)
 			} else {
-				body = NewFindParentGroupsioServiceServiceUnavailableResponseBody(res)
+				body = NewListGroupsioServicesByStatusServiceUnavailableResponseBody(res)
 			}
 			w.Header().Set("goa-error", res.GoaErrorName())
 			w.WriteHeader(http.StatusServiceUnavailable)
@@ -809,43 +916,34 @@ func EncodeFindParentGroupsioServiceError(encoder func(context.Context, http.Res
 	}
 }
 
-// EncodeListGroupsioMailingListsResponse returns an encoder for responses
-// returned by the mailing-list list-groupsio-mailing-lists endpoint.
-func EncodeListGroupsioMailingListsResponse(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder) func(context.Context, http.ResponseWriter, any) error {
+// EncodeListUnprovisionedGroupsioServicesResponse returns an encoder for
+// responses returned by the mailing-list list-unprovisioned-groupsio-services
+// endpoint.
+func EncodeListUnprovisionedGroupsioServicesResponse(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder) func(context.Context, http.ResponseWriter, any) error {
 	return func(ctx context.Context, w http.ResponseWriter, v any) error {
-		res, _ := v.(*mailinglist.GroupsioSubgroupList)
+		res, _ := v.(*mailinglist.GroupsioServiceList)
 		enc := encoder(ctx, w)
-		body := NewListGroupsioMailingListsResponseBody(res)
+		body := NewListUnprovisionedGroupsioServicesResponseBody(res)
 		w.WriteHeader(http.StatusOK)
 		return enc.Encode(body)
 	}
 }
 
-// DecodeListGroupsioMailingListsRequest returns a decoder for requests sent to
-// the mailing-list list-groupsio-mailing-lists endpoint.
-func DecodeListGroupsioMailingListsRequest(mux goahttp.Muxer, decoder func(*http.Request) goahttp.Decoder) func(*http.Request) (any, error) {
+// DecodeListUnprovisionedGroupsioServicesRequest returns a decoder for
+// requests sent to the mailing-list list-unprovisioned-groupsio-services
+// endpoint.
+func DecodeListUnprovisionedGroupsioServicesRequest(mux goahttp.Muxer, decoder func(*http.Request) goahttp.Decoder) func(*http.Request) (any, error) {
 	return func(r *http.Request) (any, error) {
 		var (
-			projectUID   *string
-			committeeUID *string
-			bearerToken  *string
-			err          error
+			projectUID  string
+			bearerToken *string
+			err         error
 		)
-		qp := r.URL.Query()
-		projectUIDRaw := qp.Get("project_uid")
-		if projectUIDRaw != "" {
-			projectUID = &projectUIDRaw
-		}
-		if projectUID != nil {
-			err = goa.MergeErrors(err, goa.ValidateFormat("project_uid", *projectUID, goa.FormatUUID))
-		}
-		committeeUIDRaw := qp.Get("committee_uid")
-		if committeeUIDRaw != "" {
-			committeeUID = &committeeUIDRaw
-		}
-		if committeeUID != nil {
-			err = goa.MergeErrors(err, goa.ValidateFormat("committee_uid", *committeeUID, goa.FormatUUID))
+		projectUID = r.URL.Query().Get("project_uid")
+		if projectUID == "" {
+			err = goa.MergeErrors(err, goa.MissingFieldError("project_uid", "query string"))
 		}
+		err = goa.MergeErrors(err, goa.ValidateFormat("project_uid", projectUID, goa.FormatUUID))
 		bearerTokenRaw := r.Header.Get("Authorization")
 		if bearerTokenRaw != "" {
 			bearerToken = &bearerTokenRaw
@@ -853,7 +951,7 @@ func DecodeListGroupsioMailingListsRequest(mux goahttp.Muxer, decoder func(*http
 		if err != nil {
 			return nil, err
 		}
-		payload := NewListGroupsioMailingListsPayload(projectUID, committeeUID, bearerToken)
+		payload := NewListUnprovisionedGroupsioServicesPayload(projectUID, bearerToken)
 		if payload.BearerToken != nil {
 			if strings.Contains(*payload.BearerToken, " ") {
 				// Remove authorization scheme prefix (e.g. "Bearer")
@@ -866,9 +964,9 @@ func DecodeListGroupsioMailingListsRequest(mux goahttp.Muxer, decoder func(*http
 	}
 }
 
-// EncodeListGroupsioMailingListsError returns an encoder for errors returned
-// by the list-groupsio-mailing-lists mailing-list endpoint.
-func EncodeListGroupsioMailingListsError(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder, formatter func(ctx context.Context, err error) goahttp.Statuser) func(context.Context, http.ResponseWriter, error) error {
+// EncodeListUnprovisionedGroupsioServicesError returns an encoder for errors
+// returned by the list-unprovisioned-groupsio-services mailing-list endpoint.
+func EncodeListUnprovisionedGroupsioServicesError(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder, formatter func(ctx context.Context, err error) goahttp.Statuser) func(context.Context, http.ResponseWriter, error) error {
 	encodeError := goahttp.ErrorEncoder(encoder, formatter)
 	return func(ctx context.Context, w http.ResponseWriter, v error) error {
 		var en goa.GoaErrorNamer
@@ -884,7 +982,7 @@ func EncodeListGroupsioMailingListsError(encoder func(context.Context, http.Resp
 			if formatter != nil {
 				body = formatter(ctx, res)
 			} else {
-				body = NewListGroupsioMailingListsBadRequestResponseBody(res)
+				body = NewListUnprovisionedGroupsioServicesBadRequestResponseBody(res)
 			}
 			w.Header().Set("goa-error", res.GoaErrorName())
 			w.WriteHeader(http.StatusBadRequest)
@@ -897,7 +995,7 @@ func EncodeListGroupsioMailingListsError(encoder func(context.Context, http.Resp
 			if formatter != nil {
 				body = formatter(ctx, res)
 			} else {
-				body = NewListGroupsioMailingListsInternalServerErrorResponseBody(res)
+				body = NewListUnprovisionedGroupsioServicesInternalServerErrorResponseBody(res)
 			}
 			w.Header().Set("goa-error", res.GoaErrorName())
 			w.WriteHeader(http.StatusInternalServerError)
@@ -910,7 +1008,7 @@ func EncodeListGroupsioMailingListsError(encoder func(context.Context, http.Resp
 			if formatter != nil {
 				body = formatter(ctx, res)
 			} else {
-				body = NewListGroupsioMailingListsServiceUnavailableResponseBody(res)
+				body = NewListUnprovisionedGroupsioServicesServiceUnavailableResponseBody(res)
 			}
 			w.Header().Set("goa-error", res.GoaErrorName())
 			w.WriteHeader(http.StatusServiceUnavailable)
@@ -921,50 +1019,40 @@ func EncodeListGroupsioMailingListsError(encoder func(context.Context, http.Resp
 	}
 }
 
-// EncodeCreateGroupsioMailingListResponse returns an encoder for responses
-// returned by the mailing-list create-groupsio-mailing-list endpoint.
-func EncodeCreateGroupsioMailingListResponse(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder) func(context.Context, http.ResponseWriter, any) error {
+// EncodeFindParentGroupsioServiceResponse returns an encoder for responses
+// returned by the mailing-list find-parent-groupsio-service endpoint.
+func EncodeFindParentGroupsioServiceResponse(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder) func(context.Context, http.ResponseWriter, any) error {
 	return func(ctx context.Context, w http.ResponseWriter, v any) error {
-		res, _ := v.(*mailinglist.GroupsioSubgroup)
+		res, _ := v.(*mailinglist.GroupsioService)
 		enc := encoder(ctx, w)
-		body := NewCreateGroupsioMailingListResponseBody(res)
-		w.WriteHeader(http.StatusCreated)
+		body := NewFindParentGroupsioServiceResponseBody(res)
+		w.WriteHeader(http.StatusOK)
 		return enc.Encode(body)
 	}
 }
 
-// DecodeCreateGroupsioMailingListRequest returns a decoder for requests sent
-// to the mailing-list create-groupsio-mailing-list endpoint.
-func DecodeCreateGroupsioMailingListRequest(mux goahttp.Muxer, decoder func(*http.Request) goahttp.Decoder) func(*http.Request) (any, error) {
+// DecodeFindParentGroupsioServiceRequest returns a decoder for requests sent
+// to the mailing-list find-parent-groupsio-service endpoint.
+func DecodeFindParentGroupsioServiceRequest(mux goahttp.Muxer, decoder func(*http.Request) goahttp.Decoder) func(*http.Request) (any, error) {
 	return func(r *http.Request) (any, error) {
 		var (
-			body CreateGroupsioMailingListRequestBody
-			err  error
-		)
-		err = decoder(r).Decode(&body)
-		if err != nil {
-			if errors.Is(err, io.EOF) {
-				return nil, goa.MissingPayloadError()
-			}
-			var gerr *goa.ServiceError
-			if errors.As(err, &gerr) {
-				return nil, gerr
-			}
-			return nil, goa.DecodePayloadError(err.Error())
-		}
-		err = ValidateCreateGroupsioMailingListRequestBody(&body)
-		if err != nil {
-			return nil, err
-		}
-
-		var (
+			projectUID  string
 			bearerToken *string
+			err         error
 		)
+		projectUID = r.URL.Query().Get("project_uid")
+		if projectUID == "" {
+			err = goa.MergeErrors(err, goa.MissingFieldError("project_uid", "query string"))
+		}
+		err = goa.MergeErrors(err, goa.ValidateFormat("project_uid", projectUID, goa.FormatUUID))
 		bearerTokenRaw := r.Header.Get("Authorization")
 		if bearerTokenRaw != "" {
 			bearerToken = &bearerTokenRaw
 		}
-		payload := NewCreateGroupsioMailingListPayload(&body, bearerToken)
+		if err != nil {
+			return nil, err
+		}
+		payload := NewFindParentGroupsioServicePayload(projectUID, bearerToken)
 		if payload.BearerToken != nil {
 			if strings.Contains(*payload.BearerToken, " ") {
 				// Remove authorization scheme prefix (e.g. "Bearer")
@@ -977,9 +1065,9 @@ func DecodeCreateGroupsioMailingListRequest(mux goahttp.Muxer, decoder func(*htt
 	}
 }
 
-// EncodeCreateGroupsioMailingListError returns an encoder for errors returned
-// by the create-groupsio-mailing-list mailing-list endpoint.
-func EncodeCreateGroupsioMailingListError(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder, formatter func(ctx context.Context, err error) goahttp.Statuser) func(context.Context, http.ResponseWriter, error) error {
+// EncodeFindParentGroupsioServiceError returns an encoder for errors returned
+// by the find-parent-groupsio-service mailing-list endpoint.
+func EncodeFindParentGroupsioServiceError(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder, formatter func(ctx context.Context, err error) goahttp.Statuser) func(context.Context, http.ResponseWriter, error) error {
 	encodeError := goahttp.ErrorEncoder(encoder, formatter)
 	return func(ctx context.Context, w http.ResponseWriter, v error) error {
 		var en goa.GoaErrorNamer
@@ -995,37 +1083,2283 @@ func EncodeCreateGroupsioMailingListError(encoder func(context.Context, http.Res
 			if formatter != nil {
 				body = formatter(ctx, res)
 			} else {
-				body = NewCreateGroupsioMailingListBadRequestResponseBody(res)
+				body = NewFindParentGroupsioServiceBadRequestResponseBody(res)
 			}
 			w.Header().Set("goa-error", res.GoaErrorName())
 			w.WriteHeader(http.StatusBadRequest)
 			return enc.Encode(body)
-		case "Conflict":
-			var res *mailinglist.ConflictError
+		case "InternalServerError":
+			var res *mailinglist.InternalServerError
 			errors.As(v, &res)
 			enc := encoder(ctx, w)
 			var body any
 			if formatter != nil {
 				body = formatter(ctx, res)
 			} else {
-				body = NewCreateGroupsioMailingListConflictResponseBody(res)
+				body = NewFindParentGroupsioServiceInternalServerErrorResponseBody(res)
 			}
 			w.Header().Set("goa-error", res.GoaErrorName())
-			w.WriteHeader(http.StatusConflict)
+			w.WriteHeader(http.StatusInternalServerError)
 			return enc.Encode(body)
-		case "InternalServerError":
-			var res *mailinglist.InternalServerError
+		case "NotFound":
+			var res *mailinglist.NotFoundError
 			errors.As(v, &res)
 			enc := encoder(ctx, w)
 			var body any
 			if formatter != nil {
 				body = formatter(ctx, res)
 			} else {
-				body = NewCreateGroupsioMailingListInternalServerErrorResponseBody(res)
+				body = NewFindParentGroupsioServiceNotFoundResponseBody(res)
+			}
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusNotFound)
+			return enc.Encode(body)
+		case "ServiceUnavailable":
+			var res *mailinglist.ServiceUnavailableError
+			errors.As(v, &res)
+			enc := encoder(ctx, w)
+			var body any
+			if formatter != nil {
+				body = formatter(ctx, res)
+			} else {
+				body = NewFindParentGroupsioServiceServiceUnavailableResponseBody(res)
+			}
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return enc.Encode(body)
+		default:
+			return encodeError(ctx, w, v)
+		}
+	}
+}
+
+// EncodeListGroupsioMailingListsResponse returns an encoder for responses
+// returned by the mailing-list list-groupsio-mailing-lists endpoint.
+func EncodeListGroupsioMailingListsResponse(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder) func(context.Context, http.ResponseWriter, any) error {
+	return func(ctx context.Context, w http.ResponseWriter, v any) error {
+		res, _ := v.(*mailinglist.GroupsioSubgroupList)
+		enc := encoder(ctx, w)
+		body := NewListGroupsioMailingListsResponseBody(res)
+		w.WriteHeader(http.StatusOK)
+		return enc.Encode(body)
+	}
+}
+
+// DecodeListGroupsioMailingListsRequest returns a decoder for requests sent to
+// the mailing-list list-groupsio-mailing-lists endpoint.
+func DecodeListGroupsioMailingListsRequest(mux goahttp.Muxer, decoder func(*http.Request) goahttp.Decoder) func(*http.Request) (any, error) {
+	return func(r *http.Request) (any, error) {
+		var (
+			projectUID   *string
+			committeeUID *string
+			bearerToken  *string
+			err          error
+		)
+		qp := r.URL.Query()
+		projectUIDRaw := qp.Get("project_uid")
+		if projectUIDRaw != "" {
+			projectUID = &projectUIDRaw
+		}
+		if projectUID != nil {
+			err = goa.MergeErrors(err, goa.ValidateFormat("project_uid", *projectUID, goa.FormatUUID))
+		}
+		committeeUIDRaw := qp.Get("committee_uid")
+		if committeeUIDRaw != "" {
+			committeeUID = &committeeUIDRaw
+		}
+		if committeeUID != nil {
+			err = goa.MergeErrors(err, goa.ValidateFormat("committee_uid", *committeeUID, goa.FormatUUID))
+		}
+		bearerTokenRaw := r.Header.Get("Authorization")
+		if bearerTokenRaw != "" {
+			bearerToken = &bearerTokenRaw
+		}
+		if err != nil {
+			return nil, err
+		}
+		payload := NewListGroupsioMailingListsPayload(projectUID, committeeUID, bearerToken)
+		if payload.BearerToken != nil {
+			if strings.Contains(*payload.BearerToken, " ") {
+				// Remove authorization scheme prefix (e.g. "Bearer")
+				cred := strings.SplitN(*payload.BearerToken, " ", 2)[1]
+				payload.BearerToken = &cred
+			}
+		}
+
+		return payload, nil
+	}
+}
+
+// EncodeListGroupsioMailingListsError returns an encoder for errors returned
+// by the list-groupsio-mailing-lists mailing-list endpoint.
+func EncodeListGroupsioMailingListsError(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder, formatter func(ctx context.Context, err error) goahttp.Statuser) func(context.Context, http.ResponseWriter, error) error {
+	encodeError := goahttp.ErrorEncoder(encoder, formatter)
+	return func(ctx context.Context, w http.ResponseWriter, v error) error {
+		var en goa.GoaErrorNamer
+		if !errors.As(v, &en) {
+			return encodeError(ctx, w, v)
+		}
+		switch en.GoaErrorName() {
+		case "BadRequest":
+			var res *mailinglist.BadRequestError
+			errors.As(v, &res)
+			enc := encoder(ctx, w)
+			var body any
+			if formatter != nil {
+				body = formatter(ctx, res)
+			} else {
+				body = NewListGroupsioMailingListsBadRequestResponseBody(res)
+			}
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusBadRequest)
+			return enc.Encode(body)
+		case "InternalServerError":
+			var res *mailinglist.InternalServerError
+			errors.As(v, &res)
+			enc := encoder(ctx, w)
+			var body any
+			if formatter != nil {
+				body = formatter(ctx, res)
+			} else {
+				body = NewListGroupsioMailingListsInternalServerErrorResponseBody(res)
+			}
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusInternalServerError)
+			return enc.Encode(body)
+		case "ServiceUnavailable":
+			var res *mailinglist.ServiceUnavailableError
+			errors.As(v, &res)
+			enc := encoder(ctx, w)
+			var body any
+			if formatter != nil {
+				body = formatter(ctx, res)
+			} else {
+				body = NewListGroupsioMailingListsServiceUnavailableResponseBody(res)
+			}
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return enc.Encode(body)
+		default:
+			return encodeError(ctx, w, v)
+		}
+	}
+}
+
+// EncodeListGroupsioMailingListsByVisibilityResponse returns an encoder for
+// responses returned by the mailing-list
+// list-groupsio-mailing-lists-by-visibility endpoint.
+func EncodeListGroupsioMailingListsByVisibilityResponse(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder) func(context.Context, http.ResponseWriter, any) error {
+	return func(ctx context.Context, w http.ResponseWriter, v any) error {
+		res, _ := v.(*mailinglist.GroupsioSubgroupList)
+		enc := encoder(ctx, w)
+		body := NewListGroupsioMailingListsByVisibilityResponseBody(res)
+		w.WriteHeader(http.StatusOK)
+		return enc.Encode(body)
+	}
+}
+
+// DecodeListGroupsioMailingListsByVisibilityRequest returns a decoder for
+// requests sent to the mailing-list list-groupsio-mailing-lists-by-visibility
+// endpoint.
+func DecodeListGroupsioMailingListsByVisibilityRequest(mux goahttp.Muxer, decoder func(*http.Request) goahttp.Decoder) func(*http.Request) (any, error) {
+	return func(r *http.Request) (any, error) {
+		var (
+			projectUID  string
+			public      bool
+			bearerToken *string
+			err         error
+		)
+		qp := r.URL.Query()
+		projectUID = qp.Get("project_uid")
+		if projectUID == "" {
+			err = goa.MergeErrors(err, goa.MissingFieldError("project_uid", "query string"))
+		}
+		err = goa.MergeErrors(err, goa.ValidateFormat("project_uid", projectUID, goa.FormatUUID))
+		{
+			publicRaw := qp.Get("public")
+			if publicRaw == "" {
+				err = goa.MergeErrors(err, goa.MissingFieldError("public", "query string"))
+			}
+			v, err2 := strconv.ParseBool(publicRaw)
+			if err2 != nil {
+				err = goa.MergeErrors(err, goa.InvalidFieldTypeError("public", publicRaw, "boolean"))
+			}
+			public = v
+		}
+		bearerTokenRaw := r.Header.Get("Authorization")
+		if bearerTokenRaw != "" {
+			bearerToken = &bearerTokenRaw
+		}
+		if err != nil {
+			return nil, err
+		}
+		payload := NewListGroupsioMailingListsByVisibilityPayload(projectUID, public, bearerToken)
+		if payload.BearerToken != nil {
+			if strings.Contains(*payload.BearerToken, " ") {
+				// Remove authorization scheme prefix (e.g. "Bearer")
+				cred := strings.SplitN(*payload.BearerToken, " ", 2)[1]
+				payload.BearerToken = &cred
+			}
+		}
+
+		return payload, nil
+	}
+}
+
+// EncodeListGroupsioMailingListsByVisibilityError returns an encoder for
+// errors returned by the list-groupsio-mailing-lists-by-visibility
+// mailing-list endpoint.
+func EncodeListGroupsioMailingListsByVisibilityError(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder, formatter func(ctx context.Context, err error) goahttp.Statuser) func(context.Context, http.ResponseWriter, error) error {
+	encodeError := goahttp.ErrorEncoder(encoder, formatter)
+	return func(ctx context.Context, w http.ResponseWriter, v error) error {
+		var en goa.GoaErrorNamer
+		if !errors.As(v, &en) {
+			return encodeError(ctx, w, v)
+		}
+		switch en.GoaErrorName() {
+		case "BadRequest":
+			var res *mailinglist.BadRequestError
+			errors.As(v, &res)
+			enc := encoder(ctx, w)
+			var body any
+			if formatter != nil {
+				body = formatter(ctx, res)
+			} else {
+				body = NewListGroupsioMailingListsByVisibilityBadRequestResponseBody(res)
+			}
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusBadRequest)
+			return enc.Encode(body)
+		case "InternalServerError":
+			var res *mailinglist.InternalServerError
+			errors.As(v, &res)
+			enc := encoder(ctx, w)
+			var body any
+			if formatter != nil {
+				body = formatter(ctx, res)
+			} else {
+				body = NewListGroupsioMailingListsByVisibilityInternalServerErrorResponseBody(res)
+			}
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusInternalServerError)
+			return enc.Encode(body)
+		case "ServiceUnavailable":
+			var res *mailinglist.ServiceUnavailableError
+			errors.As(v, &res)
+			enc := encoder(ctx, w)
+			var body any
+			if formatter != nil {
+				body = formatter(ctx, res)
+			} else {
+				body = NewListGroupsioMailingListsByVisibilityServiceUnavailableResponseBody(res)
+			}
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return enc.Encode(body)
+		default:
+			return encodeError(ctx, w, v)
+		}
+	}
+}
+
+// EncodeListGroupsioMailingListsUpdatedSinceResponse returns an encoder for
+// responses returned by the mailing-list
+// list-groupsio-mailing-lists-updated-since endpoint.
+func EncodeListGroupsioMailingListsUpdatedSinceResponse(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder) func(context.Context, http.ResponseWriter, any) error {
+	return func(ctx context.Context, w http.ResponseWriter, v any) error {
+		res, _ := v.(*mailinglist.GroupsioSubgroupList)
+		enc := encoder(ctx, w)
+		body := NewListGroupsioMailingListsUpdatedSinceResponseBody(res)
+		w.WriteHeader(http.StatusOK)
+		return enc.Encode(body)
+	}
+}
+
+// DecodeListGroupsioMailingListsUpdatedSinceRequest returns a decoder for
+// requests sent to the mailing-list list-groupsio-mailing-lists-updated-since
+// endpoint.
+func DecodeListGroupsioMailingListsUpdatedSinceRequest(mux goahttp.Muxer, decoder func(*http.Request) goahttp.Decoder) func(*http.Request) (any, error) {
+	return func(r *http.Request) (any, error) {
+		var (
+			projectUID  string
+			since       string
+			bearerToken *string
+			err         error
+		)
+		qp := r.URL.Query()
+		projectUID = qp.Get("project_uid")
+		if projectUID == "" {
+			err = goa.MergeErrors(err, goa.MissingFieldError("project_uid", "query string"))
+		}
+		err = goa.MergeErrors(err, goa.ValidateFormat("project_uid", projectUID, goa.FormatUUID))
+		since = qp.Get("since")
+		if since == "" {
+			err = goa.MergeErrors(err, goa.MissingFieldError("since", "query string"))
+		}
+		err = goa.MergeErrors(err, goa.ValidateFormat("since", since, goa.FormatDateTime))
+		bearerTokenRaw := r.Header.Get("Authorization")
+		if bearerTokenRaw != "" {
+			bearerToken = &bearerTokenRaw
+		}
+		if err != nil {
+			return nil, err
+		}
+		payload := NewListGroupsioMailingListsUpdatedSincePayload(projectUID, since, bearerToken)
+		if payload.BearerToken != nil {
+			if strings.Contains(*payload.BearerToken, " ") {
+				// Remove authorization scheme prefix (e.g. "Bearer")
+				cred := strings.SplitN(*payload.BearerToken, " ", 2)[1]
+				payload.BearerToken = &cred
+			}
+		}
+
+		return payload, nil
+	}
+}
+
+// EncodeListGroupsioMailingListsUpdatedSinceError returns an encoder for
+// errors returned by the list-groupsio-mailing-lists-updated-since
+// mailing-list endpoint.
+func EncodeListGroupsioMailingListsUpdatedSinceError(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder, formatter func(ctx context.Context, err error) goahttp.Statuser) func(context.Context, http.ResponseWriter, error) error {
+	encodeError := goahttp.ErrorEncoder(encoder, formatter)
+	return func(ctx context.Context, w http.ResponseWriter, v error) error {
+		var en goa.GoaErrorNamer
+		if !errors.As(v, &en) {
+			return encodeError(ctx, w, v)
+		}
+		switch en.GoaErrorName() {
+		case "BadRequest":
+			var res *mailinglist.BadRequestError
+			errors.As(v, &res)
+			enc := encoder(ctx, w)
+			var body any
+			if formatter != nil {
+				body = formatter(ctx, res)
+			} else {
+				body = NewListGroupsioMailingListsUpdatedSinceBadRequestResponseBody(res)
+			}
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusBadRequest)
+			return enc.Encode(body)
+		case "InternalServerError":
+			var res *mailinglist.InternalServerError
+			errors.As(v, &res)
+			enc := encoder(ctx, w)
+			var body any
+			if formatter != nil {
+				body = formatter(ctx, res)
+			} else {
+				body = NewListGroupsioMailingListsUpdatedSinceInternalServerErrorResponseBody(res)
+			}
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusInternalServerError)
+			return enc.Encode(body)
+		case "ServiceUnavailable":
+			var res *mailinglist.ServiceUnavailableError
+			errors.As(v, &res)
+			enc := encoder(ctx, w)
+			var body any
+			if formatter != nil {
+				body = formatter(ctx, res)
+			} else {
+				body = NewListGroupsioMailingListsUpdatedSinceServiceUnavailableResponseBody(res)
+			}
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return enc.Encode(body)
+		default:
+			return encodeError(ctx, w, v)
+		}
+	}
+}
+
+// EncodeCreateGroupsioMailingListResponse returns an encoder for responses
+// returned by the mailing-list create-groupsio-mailing-list endpoint.
+func EncodeCreateGroupsioMailingListResponse(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder) func(context.Context, http.ResponseWriter, any) error {
+	return func(ctx context.Context, w http.ResponseWriter, v any) error {
+		res, _ := v.(*mailinglist.GroupsioSubgroup)
+		enc := encoder(ctx, w)
+		body := NewCreateGroupsioMailingListResponseBody(res)
+		w.WriteHeader(http.StatusCreated)
+		return enc.Encode(body)
+	}
+}
+
+// DecodeCreateGroupsioMailingListRequest returns a decoder for requests sent
+// to the mailing-list create-groupsio-mailing-list endpoint.
+func DecodeCreateGroupsioMailingListRequest(mux goahttp.Muxer, decoder func(*http.Request) goahttp.Decoder) func(*http.Request) (any, error) {
+	return func(r *http.Request) (any, error) {
+		var (
+			body CreateGroupsioMailingListRequestBody
+			err  error
+		)
+		err = decoder(r).Decode(&body)
+		if err != nil {
+			if errors.Is(err, io.EOF) {
+				return nil, goa.MissingPayloadError()
+			}
+			var gerr *goa.ServiceError
+			if errors.As(err, &gerr) {
+				return nil, gerr
+			}
+			return nil, goa.DecodePayloadError(err.Error())
+		}
+		err = ValidateCreateGroupsioMailingListRequestBody(&body)
+		if err != nil {
+			return nil, err
+		}
+
+		var (
+			bearerToken *string
+		)
+		bearerTokenRaw := r.Header.Get("Authorization")
+		if bearerTokenRaw != "" {
+			bearerToken = &bearerTokenRaw
+		}
+		payload := NewCreateGroupsioMailingListPayload(&body, bearerToken)
+		if payload.BearerToken != nil {
+			if strings.Contains(*payload.BearerToken, " ") {
+				// Remove authorization scheme prefix (e.g. "Bearer")
+				cred := strings.SplitN(*payload.BearerToken, " ", 2)[1]
+				payload.BearerToken = &cred
+			}
+		}
+
+		return payload, nil
+	}
+}
+
+// EncodeCreateGroupsioMailingListError returns an encoder for errors returned
+// by the create-groupsio-mailing-list mailing-list endpoint.
+func EncodeCreateGroupsioMailingListError(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder, formatter func(ctx context.Context, err error) goahttp.Statuser) func(context.Context, http.ResponseWriter, error) error {
+	encodeError := goahttp.ErrorEncoder(encoder, formatter)
+	return func(ctx context.Context, w http.ResponseWriter, v error) error {
+		var en goa.GoaErrorNamer
+		if !errors.As(v, &en) {
+			return encodeError(ctx, w, v)
+		}
+		switch en.GoaErrorName() {
+		case "BadRequest":
+			var res *mailinglist.BadRequestError
+			errors.As(v, &res)
+			enc := encoder(ctx, w)
+			var body any
+			if formatter != nil {
+				body = formatter(ctx, res)
+			} else {
+				body = NewCreateGroupsioMailingListBadRequestResponseBody(res)
+			}
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusBadRequest)
+			return enc.Encode(body)
+		case "Conflict":
+			var res *mailinglist.ConflictError
+			errors.As(v, &res)
+			enc := encoder(ctx, w)
+			var body any
+			if formatter != nil {
+				body = formatter(ctx, res)
+			} else {
+				body = NewCreateGroupsioMailingListConflictResponseBody(res)
+			}
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusConflict)
+			return enc.Encode(body)
+		case "InternalServerError":
+			var res *mailinglist.InternalServerError
+			errors.As(v, &res)
+			enc := encoder(ctx, w)
+			var body any
+			if formatter != nil {
+				body = formatter(ctx, res)
+			} else {
+				body = NewCreateGroupsioMailingListInternalServerErrorResponseBody(res)
+			}
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusInternalServerError)
+			return enc.Encode(body)
+		case "ServiceUnavailable":
+			var res *mailinglist.ServiceUnavailableError
+			errors.As(v, &res)
+			enc := encoder(ctx, w)
+			var body any
+			if formatter != nil {
+				body = formatter(ctx, res)
+			} else {
+				body = NewCreateGroupsioMailingListServiceUnavailableResponseBody(res)
+			}
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return enc.Encode(body)
+		default:
+			return encodeError(ctx, w, v)
+		}
+	}
+}
+
+// EncodeGetGroupsioMailingListResponse returns an encoder for responses
+// returned by the mailing-list get-groupsio-mailing-list endpoint.
+func EncodeGetGroupsioMailingListResponse(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder) func(context.Context, http.ResponseWriter, any) error {
+	return func(ctx context.Context, w http.ResponseWriter, v any) error {
+		res, _ := v.(*mailinglist.GroupsioSubgroup)
+		enc := encoder(ctx, w)
+		body := NewGetGroupsioMailingListResponseBody(res)
+		w.WriteHeader(http.StatusOK)
+		return enc.Encode(body)
+	}
+}
+
+// DecodeGetGroupsioMailingListRequest returns a decoder for requests sent to
+// the mailing-list get-groupsio-mailing-list endpoint.
+func DecodeGetGroupsioMailingListRequest(mux goahttp.Muxer, decoder func(*http.Request) goahttp.Decoder) func(*http.Request) (any, error) {
+	return func(r *http.Request) (any, error) {
+		var (
+			subgroupID  string
+			bearerToken *string
+
+			params = mux.Vars(r)
+		)
+		subgroupID = params["subgroup_id"]
+		bearerTokenRaw := r.Header.Get("Authorization")
+		if bearerTokenRaw != "" {
+			bearerToken = &bearerTokenRaw
+		}
+		payload := NewGetGroupsioMailingListPayload(subgroupID, bearerToken)
+		if payload.BearerToken != nil {
+			if strings.Contains(*payload.BearerToken, " ") {
+				// Remove authorization scheme prefix (e.g. "Bearer")
+				cred := strings.SplitN(*payload.BearerToken, " ", 2)[1]
+				payload.BearerToken = &cred
+			}
+		}
+
+		return payload, nil
+	}
+}
+
+// EncodeGetGroupsioMailingListError returns an encoder for errors returned by
+// the get-groupsio-mailing-list mailing-list endpoint.
+func EncodeGetGroupsioMailingListError(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder, formatter func(ctx context.Context, err error) goahttp.Statuser) func(context.Context, http.ResponseWriter, error) error {
+	encodeError := goahttp.ErrorEncoder(encoder, formatter)
+	return func(ctx context.Context, w http.ResponseWriter, v error) error {
+		var en goa.GoaErrorNamer
+		if !errors.As(v, &en) {
+			return encodeError(ctx, w, v)
+		}
+		switch en.GoaErrorName() {
+		case "InternalServerError":
+			var res *mailinglist.InternalServerError
+			errors.As(v, &res)
+			enc := encoder(ctx, w)
+			var body any
+			if formatter != nil {
+				body = formatter(ctx, res)
+			} else {
+				body = NewGetGroupsioMailingListInternalServerErrorResponseBody(res)
+			}
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusInternalServerError)
+			return enc.Encode(body)
+		case "NotFound":
+			var res *mailinglist.NotFoundError
+			errors.As(v, &res)
+			enc := encoder(ctx, w)
+			var body any
+			if formatter != nil {
+				body = formatter(ctx, res)
+			} else {
+				body = NewGetGroupsioMailingListNotFoundResponseBody(res)
+			}
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusNotFound)
+			return enc.Encode(body)
+		case "ServiceUnavailable":
+			var res *mailinglist.ServiceUnavailableError
+			errors.As(v, &res)
+			enc := encoder(ctx, w)
+			var body any
+			if formatter != nil {
+				body = formatter(ctx, res)
+			} else {
+				body = NewGetGroupsioMailingListServiceUnavailableResponseBody(res)
+			}
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return enc.Encode(body)
+		default:
+			return encodeError(ctx, w, v)
+		}
+	}
+}
+
+// EncodeUpdateGroupsioMailingListResponse returns an encoder for responses
+// returned by the mailing-list update-groupsio-mailing-list endpoint.
+func EncodeUpdateGroupsioMailingListResponse(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder) func(context.Context, http.ResponseWriter, any) error {
+	return func(ctx context.Context, w http.ResponseWriter, v any) error {
+		res, _ := v.(*mailinglist.GroupsioSubgroup)
+		enc := encoder(ctx, w)
+		body := NewUpdateGroupsioMailingListResponseBody(res)
+		w.WriteHeader(http.StatusOK)
+		return enc.Encode(body)
+	}
+}
+
+// DecodeUpdateGroupsioMailingListRequest returns a decoder for requests sent
+// to the mailing-list update-groupsio-mailing-list endpoint.
+func DecodeUpdateGroupsioMailingListRequest(mux goahttp.Muxer, decoder func(*http.Request) goahttp.Decoder) func(*http.Request) (any, error) {
+	return func(r *http.Request) (any, error) {
+		var (
+			body UpdateGroupsioMailingListRequestBody
+			err  error
+		)
+		err = decoder(r).Decode(&body)
+		if err != nil {
+			if errors.Is(err, io.EOF) {
+				return nil, goa.MissingPayloadError()
+			}
+			var gerr *goa.ServiceError
+			if errors.As(err, &gerr) {
+				return nil, gerr
+			}
+			return nil, goa.DecodePayloadError(err.Error())
+		}
+		err = ValidateUpdateGroupsioMailingListRequestBody(&body)
+		if err != nil {
+			return nil, err
+		}
+
+		var (
+			subgroupID  string
+			bearerToken *string
+
+			params = mux.Vars(r)
+		)
+		subgroupID = params["subgroup_id"]
+		bearerTokenRaw := r.Header.Get("Authorization")
+		if bearerTokenRaw != "" {
+			bearerToken = &bearerTokenRaw
+		}
+		payload := NewUpdateGroupsioMailingListPayload(&body, subgroupID, bearerToken)
+		if payload.BearerToken != nil {
+			if strings.Contains(*payload.BearerToken, " ") {
+				// Remove authorization scheme prefix (e.g. "Bearer")
+				cred := strings.SplitN(*payload.BearerToken, " ", 2)[1]
+				payload.BearerToken = &cred
+			}
+		}
+
+		return payload, nil
+	}
+}
+
+// EncodeUpdateGroupsioMailingListError returns an encoder for errors returned
+// by the update-groupsio-mailing-list mailing-list endpoint.
+func EncodeUpdateGroupsioMailingListError(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder, formatter func(ctx context.Context, err error) goahttp.Statuser) func(context.Context, http.ResponseWriter, error) error {
+	encodeError := goahttp.ErrorEncoder(encoder, formatter)
+	return func(ctx context.Context, w http.ResponseWriter, v error) error {
+		var en goa.GoaErrorNamer
+		if !errors.As(v, &en) {
+			return encodeError(ctx, w, v)
+		}
+		switch en.GoaErrorName() {
+		case "BadRequest":
+			var res *mailinglist.BadRequestError
+			errors.As(v, &res)
+			enc := encoder(ctx, w)
+			var body any
+			if formatter != nil {
+				body = formatter(ctx, res)
+			} else {
+				body = NewUpdateGroupsioMailingListBadRequestResponseBody(res)
+			}
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusBadRequest)
+			return enc.Encode(body)
+		case "InternalServerError":
+			var res *mailinglist.InternalServerError
+			errors.As(v, &res)
+			enc := encoder(ctx, w)
+			var body any
+			if formatter != nil {
+				body = formatter(ctx, res)
+			} else {
+				body = NewUpdateGroupsioMailingListInternalServerErrorResponseBody(res)
+			}
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusInternalServerError)
+			return enc.Encode(body)
+		case "NotFound":
+			var res *mailinglist.NotFoundError
+			errors.As(v, &res)
+			enc := encoder(ctx, w)
+			var body any
+			if formatter != nil {
+				body = formatter(ctx, res)
+			} else {
+				body = NewUpdateGroupsioMailingListNotFoundResponseBody(res)
+			}
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusNotFound)
+			return enc.Encode(body)
+		case "ServiceUnavailable":
+			var res *mailinglist.ServiceUnavailableError
+			errors.As(v, &res)
+			enc := encoder(ctx, w)
+			var body any
+			if formatter != nil {
+				body = formatter(ctx, res)
+			} else {
+				body = NewUpdateGroupsioMailingListServiceUnavailableResponseBody(res)
+			}
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return enc.Encode(body)
+		default:
+			return encodeError(ctx, w, v)
+		}
+	}
+}
+
+// EncodeDeleteGroupsioMailingListResponse returns an encoder for responses
+// returned by the mailing-list delete-groupsio-mailing-list endpoint.
+func EncodeDeleteGroupsioMailingListResponse(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder) func(context.Context, http.ResponseWriter, any) error {
+	return func(ctx context.Context, w http.ResponseWriter, v any) error {
+		w.WriteHeader(http.StatusNoContent)
+		return nil
+	}
+}
+
+// DecodeDeleteGroupsioMailingListRequest returns a decoder for requests sent
+// to the mailing-list delete-groupsio-mailing-list endpoint.
+func DecodeDeleteGroupsioMailingListRequest(mux goahttp.Muxer, decoder func(*http.Request) goahttp.Decoder) func(*http.Request) (any, error) {
+	return func(r *http.Request) (any, error) {
+		var (
+			subgroupID  string
+			bearerToken *string
+
+			params = mux.Vars(r)
+		)
+		subgroupID = params["subgroup_id"]
+		bearerTokenRaw := r.Header.Get("Authorization")
+		if bearerTokenRaw != "" {
+			bearerToken = &bearerTokenRaw
+		}
+		payload := NewDeleteGroupsioMailingListPayload(subgroupID, bearerToken)
+		if payload.BearerToken != nil {
+			if strings.Contains(*payload.BearerToken, " ") {
+				// Remove authorization scheme prefix (e.g. "Bearer")
+				cred := strings.SplitN(*payload.BearerToken, " ", 2)[1]
+				payload.BearerToken = &cred
+			}
+		}
+
+		return payload, nil
+	}
+}
+
+// EncodeDeleteGroupsioMailingListError returns an encoder for errors returned
+// by the delete-groupsio-mailing-list mailing-list endpoint.
+func EncodeDeleteGroupsioMailingListError(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder, formatter func(ctx context.Context, err error) goahttp.Statuser) func(context.Context, http.ResponseWriter, error) error {
+	encodeError := goahttp.ErrorEncoder(encoder, formatter)
+	return func(ctx context.Context, w http.ResponseWriter, v error) error {
+		var en goa.GoaErrorNamer
+		if !errors.As(v, &en) {
+			return encodeError(ctx, w, v)
+		}
+		switch en.GoaErrorName() {
+		case "InternalServerError":
+			var res *mailinglist.InternalServerError
+			errors.As(v, &res)
+			enc := encoder(ctx, w)
+			var body any
+			if formatter != nil {
+				body = formatter(ctx, res)
+			} else {
+				body = NewDeleteGroupsioMailingListInternalServerErrorResponseBody(res)
+			}
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusInternalServerError)
+			return enc.Encode(body)
+		case "NotFound":
+			var res *mailinglist.NotFoundError
+			errors.As(v, &res)
+			enc := encoder(ctx, w)
+			var body any
+			if formatter != nil {
+				body = formatter(ctx, res)
+			} else {
+				body = NewDeleteGroupsioMailingListNotFoundResponseBody(res)
+			}
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusNotFound)
+			return enc.Encode(body)
+		case "ServiceUnavailable":
+			var res *mailinglist.ServiceUnavailableError
+			errors.As(v, &res)
+			enc := encoder(ctx, w)
+			var body any
+			if formatter != nil {
+				body = formatter(ctx, res)
+			} else {
+				body = NewDeleteGroupsioMailingListServiceUnavailableResponseBody(res)
+			}
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return enc.Encode(body)
+		default:
+			return encodeError(ctx, w, v)
+		}
+	}
+}
+
+// EncodeGetGroupsioMailingListCountResponse returns an encoder for responses
+// returned by the mailing-list get-groupsio-mailing-list-count endpoint.
+func EncodeGetGroupsioMailingListCountResponse(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder) func(context.Context, http.ResponseWriter, any) error {
+	return func(ctx context.Context, w http.ResponseWriter, v any) error {
+		res, _ := v.(*mailinglist.GroupsioCount)
+		enc := encoder(ctx, w)
+		body := NewGetGroupsioMailingListCountResponseBody(res)
+		w.WriteHeader(http.StatusOK)
+		return enc.Encode(body)
+	}
+}
+
+// DecodeGetGroupsioMailingListCountRequest returns a decoder for requests sent
+// to the mailing-list get-groupsio-mailing-list-count endpoint.
+func DecodeGetGroupsioMailingListCountRequest(mux goahttp.Muxer, decoder func(*http.Request) goahttp.Decoder) func(*http.Request) (any, error) {
+	return func(r *http.Request) (any, error) {
+		var (
+			projectUID  string
+			bearerToken *string
+			err         error
+		)
+		projectUID = r.URL.Query().Get("project_uid")
+		if projectUID == "" {
+			err = goa.MergeErrors(err, goa.MissingFieldError("project_uid", "query string"))
+		}
+		err = goa.MergeErrors(err, goa.ValidateFormat("project_uid", projectUID, goa.FormatUUID))
+		bearerTokenRaw := r.Header.Get("Authorization")
+		if bearerTokenRaw != "" {
+			bearerToken = &bearerTokenRaw
+		}
+		if err != nil {
+			return nil, err
+		}
+		payload := NewGetGroupsioMailingListCountPayload(projectUID, bearerToken)
+		if payload.BearerToken != nil {
+			if strings.Contains(*payload.BearerToken, " ") {
+				// Remove authorization scheme prefix (e.g. "Bearer")
+				cred := strings.SplitN(*payload.BearerToken, " ", 2)[1]
+				payload.BearerToken = &cred
+			}
+		}
+
+		return payload, nil
+	}
+}
+
+// EncodeGetGroupsioMailingListCountError returns an encoder for errors
+// returned by the get-groupsio-mailing-list-count mailing-list endpoint.
+func EncodeGetGroupsioMailingListCountError(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder, formatter func(ctx context.Context, err error) goahttp.Statuser) func(context.Context, http.ResponseWriter, error) error {
+	encodeError := goahttp.ErrorEncoder(encoder, formatter)
+	return func(ctx context.Context, w http.ResponseWriter, v error) error {
+		var en goa.GoaErrorNamer
+		if !errors.As(v, &en) {
+			return encodeError(ctx, w, v)
+		}
+		switch en.GoaErrorName() {
+		case "BadRequest":
+			var res *mailinglist.BadRequestError
+			errors.As(v, &res)
+			enc := encoder(ctx, w)
+			var body any
+			if formatter != nil {
+				body = formatter(ctx, res)
+			} else {
+				body = NewGetGroupsioMailingListCountBadRequestResponseBody(res)
+			}
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusBadRequest)
+			return enc.Encode(body)
+		case "InternalServerError":
+			var res *mailinglist.InternalServerError
+			errors.As(v, &res)
+			enc := encoder(ctx, w)
+			var body any
+			if formatter != nil {
+				body = formatter(ctx, res)
+			} else {
+				body = NewGetGroupsioMailingListCountInternalServerErrorResponseBody(res)
+			}
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusInternalServerError)
+			return enc.Encode(body)
+		case "ServiceUnavailable":
+			var res *mailinglist.ServiceUnavailableError
+			errors.As(v, &res)
+			enc := encoder(ctx, w)
+			var body any
+			if formatter != nil {
+				body = formatter(ctx, res)
+			} else {
+				body = NewGetGroupsioMailingListCountServiceUnavailableResponseBody(res)
+			}
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return enc.Encode(body)
+		default:
+			return encodeError(ctx, w, v)
+		}
+	}
+}
+
+// EncodeGetGroupsioMailingListMemberCountResponse returns an encoder for
+// responses returned by the mailing-list
+// get-groupsio-mailing-list-member-count endpoint.
+func EncodeGetGroupsioMailingListMemberCountResponse(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder) func(context.Context, http.ResponseWriter, any) error {
+	return func(ctx context.Context, w http.ResponseWriter, v any) error {
+		res, _ := v.(*mailinglist.GroupsioCount)
+		enc := encoder(ctx, w)
+		body := NewGetGroupsioMailingListMemberCountResponseBody(res)
+		w.WriteHeader(http.StatusOK)
+		return enc.Encode(body)
+	}
+}
+
+// DecodeGetGroupsioMailingListMemberCountRequest returns a decoder for
+// requests sent to the mailing-list get-groupsio-mailing-list-member-count
+// endpoint.
+func DecodeGetGroupsioMailingListMemberCountRequest(mux goahttp.Muxer, decoder func(*http.Request) goahttp.Decoder) func(*http.Request) (any, error) {
+	return func(r *http.Request) (any, error) {
+		var (
+			subgroupID  string
+			bearerToken *string
+
+			params = mux.Vars(r)
+		)
+		subgroupID = params["subgroup_id"]
+		bearerTokenRaw := r.Header.Get("Authorization")
+		if bearerTokenRaw != "" {
+			bearerToken = &bearerTokenRaw
+		}
+		payload := NewGetGroupsioMailingListMemberCountPayload(subgroupID, bearerToken)
+		if payload.BearerToken != nil {
+			if strings.Contains(*payload.BearerToken, " ") {
+				// Remove authorization scheme prefix (e.g. "Bearer")
+				cred := strings.SplitN(*payload.BearerToken, " ", 2)[1]
+				payload.BearerToken = &cred
+			}
+		}
+
+		return payload, nil
+	}
+}
+
+// EncodeGetGroupsioMailingListMemberCountError returns an encoder for errors
+// returned by the get-groupsio-mailing-list-member-count mailing-list endpoint.
+func EncodeGetGroupsioMailingListMemberCountError(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder, formatter func(ctx context.Context, err error) goahttp.Statuser) func(context.Context, http.ResponseWriter, error) error {
+	encodeError := goahttp.ErrorEncoder(encoder, formatter)
+	return func(ctx context.Context, w http.ResponseWriter, v error) error {
+		var en goa.GoaErrorNamer
+		if !errors.As(v, &en) {
+			return encodeError(ctx, w, v)
+		}
+		switch en.GoaErrorName() {
+		case "InternalServerError":
+			var res *mailinglist.InternalServerError
+			errors.As(v, &res)
+			enc := encoder(ctx, w)
+			var body any
+			if formatter != nil {
+				body = formatter(ctx, res)
+			} else {
+				body = NewGetGroupsioMailingListMemberCountInternalServerErrorResponseBody(res)
+			}
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusInternalServerError)
+			return enc.Encode(body)
+		case "NotFound":
+			var res *mailinglist.NotFoundError
+			errors.As(v, &res)
+			enc := encoder(ctx, w)
+			var body any
+			if formatter != nil {
+				body = formatter(ctx, res)
+			} else {
+				body = NewGetGroupsioMailingListMemberCountNotFoundResponseBody(res)
+			}
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusNotFound)
+			return enc.Encode(body)
+		case "ServiceUnavailable":
+			var res *mailinglist.ServiceUnavailableError
+			errors.As(v, &res)
+			enc := encoder(ctx, w)
+			var body any
+			if formatter != nil {
+				body = formatter(ctx, res)
+			} else {
+				body = NewGetGroupsioMailingListMemberCountServiceUnavailableResponseBody(res)
+			}
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return enc.Encode(body)
+		default:
+			return encodeError(ctx, w, v)
+		}
+	}
+}
+
+// EncodeListGroupsioMembersResponse returns an encoder for responses returned
+// by the mailing-list list-groupsio-members endpoint.
+func EncodeListGroupsioMembersResponse(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder) func(context.Context, http.ResponseWriter, any) error {
+	return func(ctx context.Context, w http.ResponseWriter, v any) error {
+		res, _ := v.(*mailinglist.GroupsioMemberList)
+		enc := encoder(ctx, w)
+		body := NewListGroupsioMembersResponseBody(res)
+		w.WriteHeader(http.StatusOK)
+		return enc.Encode(body)
+	}
+}
+
+// DecodeListGroupsioMembersRequest returns a decoder for requests sent to the
+// mailing-list list-groupsio-members endpoint.
+func DecodeListGroupsioMembersRequest(mux goahttp.Muxer, decoder func(*http.Request) goahttp.Decoder) func(*http.Request) (any, error) {
+	return func(r *http.Request) (any, error) {
+		var (
+			subgroupID  string
+			bearerToken *string
+
+			params = mux.Vars(r)
+		)
+		subgroupID = params["subgroup_id"]
+		bearerTokenRaw := r.Header.Get("Authorization")
+		if bearerTokenRaw != "" {
+			bearerToken = &bearerTokenRaw
+		}
+		payload := NewListGroupsioMembersPayload(subgroupID, bearerToken)
+		if payload.BearerToken != nil {
+			if strings.Contains(*payload.BearerToken, " ") {
+				// Remove authorization scheme prefix (e.g. "Bearer")
+				cred := strings.SplitN(*payload.BearerToken, " ", 2)[1]
+				payload.BearerToken = &cred
+			}
+		}
+
+		return payload, nil
+	}
+}
+
+// EncodeListGroupsioMembersError returns an encoder for errors returned by the
+// list-groupsio-members mailing-list endpoint.
+func EncodeListGroupsioMembersError(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder, formatter func(ctx context.Context, err error) goahttp.Statuser) func(context.Context, http.ResponseWriter, error) error {
+	encodeError := goahttp.ErrorEncoder(encoder, formatter)
+	return func(ctx context.Context, w http.ResponseWriter, v error) error {
+		var en goa.GoaErrorNamer
+		if !errors.As(v, &en) {
+			return encodeError(ctx, w, v)
+		}
+		switch en.GoaErrorName() {
+		case "InternalServerError":
+			var res *mailinglist.InternalServerError
+			errors.As(v, &res)
+			enc := encoder(ctx, w)
+			var body any
+			if formatter != nil {
+				body = formatter(ctx, res)
+			} else {
+				body = NewListGroupsioMembersInternalServerErrorResponseBody(res)
+			}
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusInternalServerError)
+			return enc.Encode(body)
+		case "NotFound":
+			var res *mailinglist.NotFoundError
+			errors.As(v, &res)
+			enc := encoder(ctx, w)
+			var body any
+			if formatter != nil {
+				body = formatter(ctx, res)
+			} else {
+				body = NewListGroupsioMembersNotFoundResponseBody(res)
+			}
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusNotFound)
+			return enc.Encode(body)
+		case "ServiceUnavailable":
+			var res *mailinglist.ServiceUnavailableError
+			errors.As(v, &res)
+			enc := encoder(ctx, w)
+			var body any
+			if formatter != nil {
+				body = formatter(ctx, res)
+			} else {
+				body = NewListGroupsioMembersServiceUnavailableResponseBody(res)
+			}
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return enc.Encode(body)
+		default:
+			return encodeError(ctx, w, v)
+		}
+	}
+}
+
+// EncodeListGroupsioMembersNeedingReviewResponse returns an encoder for
+// responses returned by the mailing-list list-groupsio-members-needing-review
+// endpoint.
+func EncodeListGroupsioMembersNeedingReviewResponse(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder) func(context.Context, http.ResponseWriter, any) error {
+	return func(ctx context.Context, w http.ResponseWriter, v any) error {
+		res, _ := v.(*mailinglist.GroupsioMemberList)
+		enc := encoder(ctx, w)
+		body := NewListGroupsioMembersNeedingReviewResponseBody(res)
+		w.WriteHeader(http.StatusOK)
+		return enc.Encode(body)
+	}
+}
+
+// DecodeListGroupsioMembersNeedingReviewRequest returns a decoder for requests
+// sent to the mailing-list list-groupsio-members-needing-review endpoint.
+func DecodeListGroupsioMembersNeedingReviewRequest(mux goahttp.Muxer, decoder func(*http.Request) goahttp.Decoder) func(*http.Request) (any, error) {
+	return func(r *http.Request) (any, error) {
+		var (
+			subgroupID  string
+			olderThan   string
+			bearerToken *string
+			err         error
+
+			params = mux.Vars(r)
+		)
+		subgroupID = params["subgroup_id"]
+		olderThan = r.URL.Query().Get("older_than")
+		if olderThan == "" {
+			err = goa.MergeErrors(err, goa.MissingFieldError("older_than", "query string"))
+		}
+		err = goa.MergeErrors(err, goa.ValidateFormat("older_than", olderThan, goa.FormatDateTime))
+		bearerTokenRaw := r.Header.Get("Authorization")
+		if bearerTokenRaw != "" {
+			bearerToken = &bearerTokenRaw
+		}
+		if err != nil {
+			return nil, err
+		}
+		payload := NewListGroupsioMembersNeedingReviewPayload(subgroupID, olderThan, bearerToken)
+		if payload.BearerToken != nil {
+			if strings.Contains(*payload.BearerToken, " ") {
+				// Remove authorization scheme prefix (e.g. "Bearer")
+				cred := strings.SplitN(*payload.BearerToken, " ", 2)[1]
+				payload.BearerToken = &cred
+			}
+		}
+
+		return payload, nil
+	}
+}
+
+// EncodeListGroupsioMembersNeedingReviewError returns an encoder for errors
+// returned by the list-groupsio-members-needing-review mailing-list endpoint.
+func EncodeListGroupsioMembersNeedingReviewError(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder, formatter func(ctx context.Context, err error) goahttp.Statuser) func(context.Context, http.ResponseWriter, error) error {
+	encodeError := goahttp.ErrorEncoder(encoder, formatter)
+	return func(ctx context.Context, w http.ResponseWriter, v error) error {
+		var en goa.GoaErrorNamer
+		if !errors.As(v, &en) {
+			return encodeError(ctx, w, v)
+		}
+		switch en.GoaErrorName() {
+		case "BadRequest":
+			var res *mailinglist.BadRequestError
+			errors.As(v, &res)
+			enc := encoder(ctx, w)
+			var body any
+			if formatter != nil {
+				body = formatter(ctx, res)
+			} else {
+				body = NewListGroupsioMembersNeedingReviewBadRequestResponseBody(res)
+			}
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusBadRequest)
+			return enc.Encode(body)
+		case "InternalServerError":
+			var res *mailinglist.InternalServerError
+			errors.As(v, &res)
+			enc := encoder(ctx, w)
+			var body any
+			if formatter != nil {
+				body = formatter(ctx, res)
+			} else {
+				body = NewListGroupsioMembersNeedingReviewInternalServerErrorResponseBody(res)
+			}
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusInternalServerError)
+			return enc.Encode(body)
+		case "NotFound":
+			var res *mailinglist.NotFoundError
+			errors.As(v, &res)
+			enc := encoder(ctx, w)
+			var body any
+			if formatter != nil {
+				body = formatter(ctx, res)
+			} else {
+				body = NewListGroupsioMembersNeedingReviewNotFoundResponseBody(res)
+			}
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusNotFound)
+			return enc.Encode(body)
+		case "ServiceUnavailable":
+			var res *mailinglist.ServiceUnavailableError
+			errors.As(v, &res)
+			enc := encoder(ctx, w)
+			var body any
+			if formatter != nil {
+				body = formatter(ctx, res)
+			} else {
+				body = NewListGroupsioMembersNeedingReviewServiceUnavailableResponseBody(res)
+			}
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return enc.Encode(body)
+		default:
+			return encodeError(ctx, w, v)
+		}
+	}
+}
+
+// EncodeListGroupsioMembersModifiedSinceResponse returns an encoder for
+// responses returned by the mailing-list list-groupsio-members-modified-since
+// endpoint.
+func EncodeListGroupsioMembersModifiedSinceResponse(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder) func(context.Context, http.ResponseWriter, any) error {
+	return func(ctx context.Context, w http.ResponseWriter, v any) error {
+		res, _ := v.(*mailinglist.GroupsioMemberList)
+		enc := encoder(ctx, w)
+		body := NewListGroupsioMembersModifiedSinceResponseBody(res)
+		w.WriteHeader(http.StatusOK)
+		return enc.Encode(body)
+	}
+}
+
+// DecodeListGroupsioMembersModifiedSinceRequest returns a decoder for requests
+// sent to the mailing-list list-groupsio-members-modified-since endpoint.
+func DecodeListGroupsioMembersModifiedSinceRequest(mux goahttp.Muxer, decoder func(*http.Request) goahttp.Decoder) func(*http.Request) (any, error) {
+	return func(r *http.Request) (any, error) {
+		var (
+			subgroupID  string
+			since       string
+			bearerToken *string
+			err         error
+
+			params = mux.Vars(r)
+		)
+		subgroupID = params["subgroup_id"]
+		since = r.URL.Query().Get("since")
+		if since == "" {
+			err = goa.MergeErrors(err, goa.MissingFieldError("since", "query string"))
+		}
+		err = goa.MergeErrors(err, goa.ValidateFormat("since", since, goa.FormatDateTime))
+		bearerTokenRaw := r.Header.Get("Authorization")
+		if bearerTokenRaw != "" {
+			bearerToken = &bearerTokenRaw
+		}
+		if err != nil {
+			return nil, err
+		}
+		payload := NewListGroupsioMembersModifiedSincePayload(subgroupID, since, bearerToken)
+		if payload.BearerToken != nil {
+			if strings.Contains(*payload.BearerToken, " ") {
+				// Remove authorization scheme prefix (e.g. "Bearer")
+				cred := strings.SplitN(*payload.BearerToken, " ", 2)[1]
+				payload.BearerToken = &cred
+			}
+		}
+
+		return payload, nil
+	}
+}
+
+// EncodeListGroupsioMembersModifiedSinceError returns an encoder for errors
+// returned by the list-groupsio-members-modified-since mailing-list endpoint.
+func EncodeListGroupsioMembersModifiedSinceError(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder, formatter func(ctx context.Context, err error) goahttp.Statuser) func(context.Context, http.ResponseWriter, error) error {
+	encodeError := goahttp.ErrorEncoder(encoder, formatter)
+	return func(ctx context.Context, w http.ResponseWriter, v error) error {
+		var en goa.GoaErrorNamer
+		if !errors.As(v, &en) {
+			return encodeError(ctx, w, v)
+		}
+		switch en.GoaErrorName() {
+		case "BadRequest":
+			var res *mailinglist.BadRequestError
+			errors.As(v, &res)
+			enc := encoder(ctx, w)
+			var body any
+			if formatter != nil {
+				body = formatter(ctx, res)
+			} else {
+				body = NewListGroupsioMembersModifiedSinceBadRequestResponseBody(res)
+			}
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusBadRequest)
+			return enc.Encode(body)
+		case "InternalServerError":
+			var res *mailinglist.InternalServerError
+			errors.As(v, &res)
+			enc := encoder(ctx, w)
+			var body any
+			if formatter != nil {
+				body = formatter(ctx, res)
+			} else {
+				body = NewListGroupsioMembersModifiedSinceInternalServerErrorResponseBody(res)
+			}
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusInternalServerError)
+			return enc.Encode(body)
+		case "NotFound":
+			var res *mailinglist.NotFoundError
+			errors.As(v, &res)
+			enc := encoder(ctx, w)
+			var body any
+			if formatter != nil {
+				body = formatter(ctx, res)
+			} else {
+				body = NewListGroupsioMembersModifiedSinceNotFoundResponseBody(res)
+			}
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusNotFound)
+			return enc.Encode(body)
+		case "ServiceUnavailable":
+			var res *mailinglist.ServiceUnavailableError
+			errors.As(v, &res)
+			enc := encoder(ctx, w)
+			var body any
+			if formatter != nil {
+				body = formatter(ctx, res)
+			} else {
+				body = NewListGroupsioMembersModifiedSinceServiceUnavailableResponseBody(res)
+			}
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return enc.Encode(body)
+		default:
+			return encodeError(ctx, w, v)
+		}
+	}
+}
+
+// EncodeListGroupsioMembersByOrganizationResponse returns an encoder for
+// responses returned by the mailing-list list-groupsio-members-by-organization
+// endpoint.
+func EncodeListGroupsioMembersByOrganizationResponse(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder) func(context.Context, http.ResponseWriter, any) error {
+	return func(ctx context.Context, w http.ResponseWriter, v any) error {
+		res, _ := v.(*mailinglist.GroupsioMemberList)
+		enc := encoder(ctx, w)
+		body := NewListGroupsioMembersByOrganizationResponseBody(res)
+		w.WriteHeader(http.StatusOK)
+		return enc.Encode(body)
+	}
+}
+
+// DecodeListGroupsioMembersByOrganizationRequest returns a decoder for
+// requests sent to the mailing-list list-groupsio-members-by-organization
+// endpoint.
+func DecodeListGroupsioMembersByOrganizationRequest(mux goahttp.Muxer, decoder func(*http.Request) goahttp.Decoder) func(*http.Request) (any, error) {
+	return func(r *http.Request) (any, error) {
+		var (
+			subgroupID   string
+			organization string
+			bearerToken  *string
+			err          error
+
+			params = mux.Vars(r)
+		)
+		subgroupID = params["subgroup_id"]
+		organization = r.URL.Query().Get("organization")
+		if organization == "" {
+			err = goa.MergeErrors(err, goa.MissingFieldError("organization", "query string"))
+		}
+		bearerTokenRaw := r.Header.Get("Authorization")
+		if bearerTokenRaw != "" {
+			bearerToken = &bearerTokenRaw
+		}
+		if err != nil {
+			return nil, err
+		}
+		payload := NewListGroupsioMembersByOrganizationPayload(subgroupID, organization, bearerToken)
+		if payload.BearerToken != nil {
+			if strings.Contains(*payload.BearerToken, " ") {
+				// Remove authorization scheme prefix (e.g. "Bearer")
+				cred := strings.SplitN(*payload.BearerToken, " ", 2)[1]
+				payload.BearerToken = &cred
+			}
+		}
+
+		return payload, nil
+	}
+}
+
+// EncodeListGroupsioMembersByOrganizationError returns an encoder for errors
+// returned by the list-groupsio-members-by-organization mailing-list endpoint.
+func EncodeListGroupsioMembersByOrganizationError(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder, formatter func(ctx context.Context, err error) goahttp.Statuser) func(context.Context, http.ResponseWriter, error) error {
+	encodeError := goahttp.ErrorEncoder(encoder, formatter)
+	return func(ctx context.Context, w http.ResponseWriter, v error) error {
+		var en goa.GoaErrorNamer
+		if !errors.As(v, &en) {
+			return encodeError(ctx, w, v)
+		}
+		switch en.GoaErrorName() {
+		case "BadRequest":
+			var res *mailinglist.BadRequestError
+			errors.As(v, &res)
+			enc := encoder(ctx, w)
+			var body any
+			if formatter != nil {
+				body = formatter(ctx, res)
+			} else {
+				body = NewListGroupsioMembersByOrganizationBadRequestResponseBody(res)
+			}
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusBadRequest)
+			return enc.Encode(body)
+		case "InternalServerError":
+			var res *mailinglist.InternalServerError
+			errors.As(v, &res)
+			enc := encoder(ctx, w)
+			var body any
+			if formatter != nil {
+				body = formatter(ctx, res)
+			} else {
+				body = NewListGroupsioMembersByOrganizationInternalServerErrorResponseBody(res)
+			}
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusInternalServerError)
+			return enc.Encode(body)
+		case "NotFound":
+			var res *mailinglist.NotFoundError
+			errors.As(v, &res)
+			enc := encoder(ctx, w)
+			var body any
+			if formatter != nil {
+				body = formatter(ctx, res)
+			} else {
+				body = NewListGroupsioMembersByOrganizationNotFoundResponseBody(res)
+			}
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusNotFound)
+			return enc.Encode(body)
+		case "ServiceUnavailable":
+			var res *mailinglist.ServiceUnavailableError
+			errors.As(v, &res)
+			enc := encoder(ctx, w)
+			var body any
+			if formatter != nil {
+				body = formatter(ctx, res)
+			} else {
+				body = NewListGroupsioMembersByOrganizationServiceUnavailableResponseBody(res)
+			}
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return enc.Encode(body)
+		default:
+			return encodeError(ctx, w, v)
+		}
+	}
+}
+
+// EncodeListGroupsioDuplicateMembersResponse returns an encoder for responses
+// returned by the mailing-list list-groupsio-duplicate-members endpoint.
+func EncodeListGroupsioDuplicateMembersResponse(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder) func(context.Context, http.ResponseWriter, any) error {
+	return func(ctx context.Context, w http.ResponseWriter, v any) error {
+		res, _ := v.(*mailinglist.GroupsioMemberDuplicates)
+		enc := encoder(ctx, w)
+		body := NewListGroupsioDuplicateMembersResponseBody(res)
+		w.WriteHeader(http.StatusOK)
+		return enc.Encode(body)
+	}
+}
+
+// DecodeListGroupsioDuplicateMembersRequest returns a decoder for requests
+// sent to the mailing-list list-groupsio-duplicate-members endpoint.
+func DecodeListGroupsioDuplicateMembersRequest(mux goahttp.Muxer, decoder func(*http.Request) goahttp.Decoder) func(*http.Request) (any, error) {
+	return func(r *http.Request) (any, error) {
+		var (
+			subgroupID  string
+			bearerToken *string
+
+			params = mux.Vars(r)
+		)
+		subgroupID = params["subgroup_id"]
+		bearerTokenRaw := r.Header.Get("Authorization")
+		if bearerTokenRaw != "" {
+			bearerToken = &bearerTokenRaw
+		}
+		payload := NewListGroupsioDuplicateMembersPayload(subgroupID, bearerToken)
+		if payload.BearerToken != nil {
+			if strings.Contains(*payload.BearerToken, " ") {
+				// Remove authorization scheme prefix (e.g. "Bearer")
+				cred := strings.SplitN(*payload.BearerToken, " ", 2)[1]
+				payload.BearerToken = &cred
+			}
+		}
+
+		return payload, nil
+	}
+}
+
+// EncodeListGroupsioDuplicateMembersError returns an encoder for errors
+// returned by the list-groupsio-duplicate-members mailing-list endpoint.
+func EncodeListGroupsioDuplicateMembersError(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder, formatter func(ctx context.Context, err error) goahttp.Statuser) func(context.Context, http.ResponseWriter, error) error {
+	encodeError := goahttp.ErrorEncoder(encoder, formatter)
+	return func(ctx context.Context, w http.ResponseWriter, v error) error {
+		var en goa.GoaErrorNamer
+		if !errors.As(v, &en) {
+			return encodeError(ctx, w, v)
+		}
+		switch en.GoaErrorName() {
+		case "InternalServerError":
+			var res *mailinglist.InternalServerError
+			errors.As(v, &res)
+			enc := encoder(ctx, w)
+			var body any
+			if formatter != nil {
+				body = formatter(ctx, res)
+			} else {
+				body = NewListGroupsioDuplicateMembersInternalServerErrorResponseBody(res)
+			}
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusInternalServerError)
+			return enc.Encode(body)
+		case "NotFound":
+			var res *mailinglist.NotFoundError
+			errors.As(v, &res)
+			enc := encoder(ctx, w)
+			var body any
+			if formatter != nil {
+				body = formatter(ctx, res)
+			} else {
+				body = NewListGroupsioDuplicateMembersNotFoundResponseBody(res)
+			}
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusNotFound)
+			return enc.Encode(body)
+		case "ServiceUnavailable":
+			var res *mailinglist.ServiceUnavailableError
+			errors.As(v, &res)
+			enc := encoder(ctx, w)
+			var body any
+			if formatter != nil {
+				body = formatter(ctx, res)
+			} else {
+				body = NewListGroupsioDuplicateMembersServiceUnavailableResponseBody(res)
+			}
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return enc.Encode(body)
+		default:
+			return encodeError(ctx, w, v)
+		}
+	}
+}
+
+// EncodePreviewGroupsioDeliveryModeChangeResponse returns an encoder for
+// responses returned by the mailing-list preview-groupsio-delivery-mode-change
+// endpoint.
+func EncodePreviewGroupsioDeliveryModeChangeResponse(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder) func(context.Context, http.ResponseWriter, any) error {
+	return func(ctx context.Context, w http.ResponseWriter, v any) error {
+		res, _ := v.(*mailinglist.GroupsioDeliveryModePreview)
+		enc := encoder(ctx, w)
+		body := NewPreviewGroupsioDeliveryModeChangeResponseBody(res)
+		w.WriteHeader(http.StatusOK)
+		return enc.Encode(body)
+	}
+}
+
+// DecodePreviewGroupsioDeliveryModeChangeRequest returns a decoder for
+// requests sent to the mailing-list preview-groupsio-delivery-mode-change
+// endpoint.
+func DecodePreviewGroupsioDeliveryModeChangeRequest(mux goahttp.Muxer, decoder func(*http.Request) goahttp.Decoder) func(*http.Request) (any, error) {
+	return func(r *http.Request) (any, error) {
+		var (
+			subgroupID  string
+			targetMode  string
+			bearerToken *string
+			err         error
+
+			params = mux.Vars(r)
+		)
+		subgroupID = params["subgroup_id"]
+		targetMode = r.URL.Query().Get("target_mode")
+		if targetMode == "" {
+			err = goa.MergeErrors(err, goa.MissingFieldError("target_mode", "query string"))
+		}
+		bearerTokenRaw := r.Header.Get("Authorization")
+		if bearerTokenRaw != "" {
+			bearerToken = &bearerTokenRaw
+		}
+		if err != nil {
+			return nil, err
+		}
+		payload := NewPreviewGroupsioDeliveryModeChangePayload(subgroupID, targetMode, bearerToken)
+		if payload.BearerToken != nil {
+			if strings.Contains(*payload.BearerToken, " ") {
+				// Remove authorization scheme prefix (e.g. "Bearer")
+				cred := strings.SplitN(*payload.BearerToken, " ", 2)[1]
+				payload.BearerToken = &cred
+			}
+		}
+
+		return payload, nil
+	}
+}
+
+// EncodePreviewGroupsioDeliveryModeChangeError returns an encoder for errors
+// returned by the preview-groupsio-delivery-mode-change mailing-list endpoint.
+func EncodePreviewGroupsioDeliveryModeChangeError(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder, formatter func(ctx context.Context, err error) goahttp.Statuser) func(context.Context, http.ResponseWriter, error) error {
+	encodeError := goahttp.ErrorEncoder(encoder, formatter)
+	return func(ctx context.Context, w http.ResponseWriter, v error) error {
+		var en goa.GoaErrorNamer
+		if !errors.As(v, &en) {
+			return encodeError(ctx, w, v)
+		}
+		switch en.GoaErrorName() {
+		case "BadRequest":
+			var res *mailinglist.BadRequestError
+			errors.As(v, &res)
+			enc := encoder(ctx, w)
+			var body any
+			if formatter != nil {
+				body = formatter(ctx, res)
+			} else {
+				body = NewPreviewGroupsioDeliveryModeChangeBadRequestResponseBody(res)
+			}
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusBadRequest)
+			return enc.Encode(body)
+		case "InternalServerError":
+			var res *mailinglist.InternalServerError
+			errors.As(v, &res)
+			enc := encoder(ctx, w)
+			var body any
+			if formatter != nil {
+				body = formatter(ctx, res)
+			} else {
+				body = NewPreviewGroupsioDeliveryModeChangeInternalServerErrorResponseBody(res)
+			}
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusInternalServerError)
+			return enc.Encode(body)
+		case "NotFound":
+			var res *mailinglist.NotFoundError
+			errors.As(v, &res)
+			enc := encoder(ctx, w)
+			var body any
+			if formatter != nil {
+				body = formatter(ctx, res)
+			} else {
+				body = NewPreviewGroupsioDeliveryModeChangeNotFoundResponseBody(res)
+			}
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusNotFound)
+			return enc.Encode(body)
+		case "ServiceUnavailable":
+			var res *mailinglist.ServiceUnavailableError
+			errors.As(v, &res)
+			enc := encoder(ctx, w)
+			var body any
+			if formatter != nil {
+				body = formatter(ctx, res)
+			} else {
+				body = NewPreviewGroupsioDeliveryModeChangeServiceUnavailableResponseBody(res)
+			}
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return enc.Encode(body)
+		default:
+			return encodeError(ctx, w, v)
+		}
+	}
+}
+
+// EncodeAddGroupsioMemberResponse returns an encoder for responses returned by
+// the mailing-list add-groupsio-member endpoint.
+func EncodeAddGroupsioMemberResponse(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder) func(context.Context, http.ResponseWriter, any) error {
+	return func(ctx context.Context, w http.ResponseWriter, v any) error {
+		res, _ := v.(*mailinglist.GroupsioMember)
+		enc := encoder(ctx, w)
+		body := NewAddGroupsioMemberResponseBody(res)
+		w.WriteHeader(http.StatusCreated)
+		return enc.Encode(body)
+	}
+}
+
+// DecodeAddGroupsioMemberRequest returns a decoder for requests sent to the
+// mailing-list add-groupsio-member endpoint.
+func DecodeAddGroupsioMemberRequest(mux goahttp.Muxer, decoder func(*http.Request) goahttp.Decoder) func(*http.Request) (any, error) {
+	return func(r *http.Request) (any, error) {
+		var (
+			body AddGroupsioMemberRequestBody
+			err  error
+		)
+		err = decoder(r).Decode(&body)
+		if err != nil {
+			if errors.Is(err, io.EOF) {
+				return nil, goa.MissingPayloadError()
+			}
+			var gerr *goa.ServiceError
+			if errors.As(err, &gerr) {
+				return nil, gerr
+			}
+			return nil, goa.DecodePayloadError(err.Error())
+		}
+		err = ValidateAddGroupsioMemberRequestBody(&body)
+		if err != nil {
+			return nil, err
+		}
+
+		var (
+			subgroupID  string
+			bearerToken *string
+
+			params = mux.Vars(r)
+		)
+		subgroupID = params["subgroup_id"]
+		bearerTokenRaw := r.Header.Get("Authorization")
+		if bearerTokenRaw != "" {
+			bearerToken = &bearerTokenRaw
+		}
+		payload := NewAddGroupsioMemberPayload(&body, subgroupID, bearerToken)
+		if payload.BearerToken != nil {
+			if strings.Contains(*payload.BearerToken, " ") {
+				// Remove authorization scheme prefix (e.g. "Bearer")
+				cred := strings.SplitN(*payload.BearerToken, " ", 2)[1]
+				payload.BearerToken = &cred
+			}
+		}
+
+		return payload, nil
+	}
+}
+
+// EncodeAddGroupsioMemberError returns an encoder for errors returned by the
+// add-groupsio-member mailing-list endpoint.
+func EncodeAddGroupsioMemberError(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder, formatter func(ctx context.Context, err error) goahttp.Statuser) func(context.Context, http.ResponseWriter, error) error {
+	encodeError := goahttp.ErrorEncoder(encoder, formatter)
+	return func(ctx context.Context, w http.ResponseWriter, v error) error {
+		var en goa.GoaErrorNamer
+		if !errors.As(v, &en) {
+			return encodeError(ctx, w, v)
+		}
+		switch en.GoaErrorName() {
+		case "BadRequest":
+			var res *mailinglist.BadRequestError
+			errors.As(v, &res)
+			enc := encoder(ctx, w)
+			var body any
+			if formatter != nil {
+				body = formatter(ctx, res)
+			} else {
+				body = NewAddGroupsioMemberBadRequestResponseBody(res)
+			}
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusBadRequest)
+			return enc.Encode(body)
+		case "Conflict":
+			var res *mailinglist.ConflictError
+			errors.As(v, &res)
+			enc := encoder(ctx, w)
+			var body any
+			if formatter != nil {
+				body = formatter(ctx, res)
+			} else {
+				body = NewAddGroupsioMemberConflictResponseBody(res)
+			}
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusConflict)
+			return enc.Encode(body)
+		case "InternalServerError":
+			var res *mailinglist.InternalServerError
+			errors.As(v, &res)
+			enc := encoder(ctx, w)
+			var body any
+			if formatter != nil {
+				body = formatter(ctx, res)
+			} else {
+				body = NewAddGroupsioMemberInternalServerErrorResponseBody(res)
+			}
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusInternalServerError)
+			return enc.Encode(body)
+		case "NotFound":
+			var res *mailinglist.NotFoundError
+			errors.As(v, &res)
+			enc := encoder(ctx, w)
+			var body any
+			if formatter != nil {
+				body = formatter(ctx, res)
+			} else {
+				body = NewAddGroupsioMemberNotFoundResponseBody(res)
+			}
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusNotFound)
+			return enc.Encode(body)
+		case "ServiceUnavailable":
+			var res *mailinglist.ServiceUnavailableError
+			errors.As(v, &res)
+			enc := encoder(ctx, w)
+			var body any
+			if formatter != nil {
+				body = formatter(ctx, res)
+			} else {
+				body = NewAddGroupsioMemberServiceUnavailableResponseBody(res)
+			}
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return enc.Encode(body)
+		default:
+			return encodeError(ctx, w, v)
+		}
+	}
+}
+
+// EncodeUpsertGroupsioMemberResponse returns an encoder for responses returned
+// by the mailing-list upsert-groupsio-member endpoint.
+func EncodeUpsertGroupsioMemberResponse(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder) func(context.Context, http.ResponseWriter, any) error {
+	return func(ctx context.Context, w http.ResponseWriter, v any) error {
+		res, _ := v.(*mailinglist.GroupsioMemberUpsertResult)
+		enc := encoder(ctx, w)
+		body := NewUpsertGroupsioMemberResponseBody(res)
+		w.WriteHeader(http.StatusOK)
+		return enc.Encode(body)
+	}
+}
+
+// DecodeUpsertGroupsioMemberRequest returns a decoder for requests sent to the
+// mailing-list upsert-groupsio-member endpoint.
+func DecodeUpsertGroupsioMemberRequest(mux goahttp.Muxer, decoder func(*http.Request) goahttp.Decoder) func(*http.Request) (any, error) {
+	return func(r *http.Request) (any, error) {
+		var (
+			body UpsertGroupsioMemberRequestBody
+			err  error
+		)
+		err = decoder(r).Decode(&body)
+		if err != nil {
+			if errors.Is(err, io.EOF) {
+				return nil, goa.MissingPayloadError()
+			}
+			var gerr *goa.ServiceError
+			if errors.As(err, &gerr) {
+				return nil, gerr
+			}
+			return nil, goa.DecodePayloadError(err.Error())
+		}
+		err = ValidateUpsertGroupsioMemberRequestBody(&body)
+		if err != nil {
+			return nil, err
+		}
+
+		var (
+			subgroupID  string
+			bearerToken *string
+
+			params = mux.Vars(r)
+		)
+		subgroupID = params["subgroup_id"]
+		bearerTokenRaw := r.Header.Get("Authorization")
+		if bearerTokenRaw != "" {
+			bearerToken = &bearerTokenRaw
+		}
+		payload := NewUpsertGroupsioMemberPayload(&body, subgroupID, bearerToken)
+		if payload.BearerToken != nil {
+			if strings.Contains(*payload.BearerToken, " ") {
+				// Remove authorization scheme prefix (e.g. "Bearer")
+				cred := strings.SplitN(*payload.BearerToken, " ", 2)[1]
+				payload.BearerToken = &cred
+			}
+		}
+
+		return payload, nil
+	}
+}
+
+// EncodeUpsertGroupsioMemberError returns an encoder for errors returned by
+// the upsert-groupsio-member mailing-list endpoint.
+func EncodeUpsertGroupsioMemberError(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder, formatter func(ctx context.Context, err error) goahttp.Statuser) func(context.Context, http.ResponseWriter, error) error {
+	encodeError := goahttp.ErrorEncoder(encoder, formatter)
+	return func(ctx context.Context, w http.ResponseWriter, v error) error {
+		var en goa.GoaErrorNamer
+		if !errors.As(v, &en) {
+			return encodeError(ctx, w, v)
+		}
+		switch en.GoaErrorName() {
+		case "BadRequest":
+			var res *mailinglist.BadRequestError
+			errors.As(v, &res)
+			enc := encoder(ctx, w)
+			var body any
+			if formatter != nil {
+				body = formatter(ctx, res)
+			} else {
+				body = NewUpsertGroupsioMemberBadRequestResponseBody(res)
+			}
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusBadRequest)
+			return enc.Encode(body)
+		case "Conflict":
+			var res *mailinglist.ConflictError
+			errors.As(v, &res)
+			enc := encoder(ctx, w)
+			var body any
+			if formatter != nil {
+				body = formatter(ctx, res)
+			} else {
+				body = NewUpsertGroupsioMemberConflictResponseBody(res)
+			}
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusConflict)
+			return enc.Encode(body)
+		case "InternalServerError":
+			var res *mailinglist.InternalServerError
+			errors.As(v, &res)
+			enc := encoder(ctx, w)
+			var body any
+			if formatter != nil {
+				body = formatter(ctx, res)
+			} else {
+				body = NewUpsertGroupsioMemberInternalServerErrorResponseBody(res)
+			}
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusInternalServerError)
+			return enc.Encode(body)
+		case "NotFound":
+			var res *mailinglist.NotFoundError
+			errors.As(v, &res)
+			enc := encoder(ctx, w)
+			var body any
+			if formatter != nil {
+				body = formatter(ctx, res)
+			} else {
+				body = NewUpsertGroupsioMemberNotFoundResponseBody(res)
+			}
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusNotFound)
+			return enc.Encode(body)
+		case "ServiceUnavailable":
+			var res *mailinglist.ServiceUnavailableError
+			errors.As(v, &res)
+			enc := encoder(ctx, w)
+			var body any
+			if formatter != nil {
+				body = formatter(ctx, res)
+			} else {
+				body = NewUpsertGroupsioMemberServiceUnavailableResponseBody(res)
+			}
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return enc.Encode(body)
+		default:
+			return encodeError(ctx, w, v)
+		}
+	}
+}
+
+// EncodeGetGroupsioMemberResponse returns an encoder for responses returned by
+// the mailing-list get-groupsio-member endpoint.
+func EncodeGetGroupsioMemberResponse(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder) func(context.Context, http.ResponseWriter, any) error {
+	return func(ctx context.Context, w http.ResponseWriter, v any) error {
+		res, _ := v.(*mailinglist.GroupsioMember)
+		enc := encoder(ctx, w)
+		body := NewGetGroupsioMemberResponseBody(res)
+		w.WriteHeader(http.StatusOK)
+		return enc.Encode(body)
+	}
+}
+
+// DecodeGetGroupsioMemberRequest returns a decoder for requests sent to the
+// mailing-list get-groupsio-member endpoint.
+func DecodeGetGroupsioMemberRequest(mux goahttp.Muxer, decoder func(*http.Request) goahttp.Decoder) func(*http.Request) (any, error) {
+	return func(r *http.Request) (any, error) {
+		var (
+			subgroupID  string
+			memberID    string
+			bearerToken *string
+
+			params = mux.Vars(r)
+		)
+		subgroupID = params["subgroup_id"]
+		memberID = params["member_id"]
+		bearerTokenRaw := r.Header.Get("Authorization")
+		if bearerTokenRaw != "" {
+			bearerToken = &bearerTokenRaw
+		}
+		payload := NewGetGroupsioMemberPayload(subgroupID, memberID, bearerToken)
+		if payload.BearerToken != nil {
+			if strings.Contains(*payload.BearerToken, " ") {
+				// Remove authorization scheme prefix (e.g. "Bearer")
+				cred := strings.SplitN(*payload.BearerToken, " ", 2)[1]
+				payload.BearerToken = &cred
+			}
+		}
+
+		return payload, nil
+	}
+}
+
+// EncodeGetGroupsioMemberError returns an encoder for errors returned by the
+// get-groupsio-member mailing-list endpoint.
+func EncodeGetGroupsioMemberError(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder, formatter func(ctx context.Context, err error) goahttp.Statuser) func(context.Context, http.ResponseWriter, error) error {
+	encodeError := goahttp.ErrorEncoder(encoder, formatter)
+	return func(ctx context.Context, w http.ResponseWriter, v error) error {
+		var en goa.GoaErrorNamer
+		if !errors.As(v, &en) {
+			return encodeError(ctx, w, v)
+		}
+		switch en.GoaErrorName() {
+		case "InternalServerError":
+			var res *mailinglist.InternalServerError
+			errors.As(v, &res)
+			enc := encoder(ctx, w)
+			var body any
+			if formatter != nil {
+				body = formatter(ctx, res)
+			} else {
+				body = NewGetGroupsioMemberInternalServerErrorResponseBody(res)
+			}
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusInternalServerError)
+			return enc.Encode(body)
+		case "NotFound":
+			var res *mailinglist.NotFoundError
+			errors.As(v, &res)
+			enc := encoder(ctx, w)
+			var body any
+			if formatter != nil {
+				body = formatter(ctx, res)
+			} else {
+				body = NewGetGroupsioMemberNotFoundResponseBody(res)
+			}
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusNotFound)
+			return enc.Encode(body)
+		case "ServiceUnavailable":
+			var res *mailinglist.ServiceUnavailableError
+			errors.As(v, &res)
+			enc := encoder(ctx, w)
+			var body any
+			if formatter != nil {
+				body = formatter(ctx, res)
+			} else {
+				body = NewGetGroupsioMemberServiceUnavailableResponseBody(res)
+			}
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return enc.Encode(body)
+		default:
+			return encodeError(ctx, w, v)
+		}
+	}
+}
+
+// EncodeUpdateGroupsioMemberResponse returns an encoder for responses returned
+// by the mailing-list update-groupsio-member endpoint.
+func EncodeUpdateGroupsioMemberResponse(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder) func(context.Context, http.ResponseWriter, any) error {
+	return func(ctx context.Context, w http.ResponseWriter, v any) error {
+		res, _ := v.(*mailinglist.GroupsioMember)
+		enc := encoder(ctx, w)
+		body := NewUpdateGroupsioMemberResponseBody(res)
+		w.WriteHeader(http.StatusOK)
+		return enc.Encode(body)
+	}
+}
+
+// DecodeUpdateGroupsioMemberRequest returns a decoder for requests sent to the
+// mailing-list update-groupsio-member endpoint.
+func DecodeUpdateGroupsioMemberRequest(mux goahttp.Muxer, decoder func(*http.Request) goahttp.Decoder) func(*http.Request) (any, error) {
+	return func(r *http.Request) (any, error) {
+		var (
+			body UpdateGroupsioMemberRequestBody
+			err  error
+		)
+		err = decoder(r).Decode(&body)
+		if err != nil {
+			if errors.Is(err, io.EOF) {
+				return nil, goa.MissingPayloadError()
+			}
+			var gerr *goa.ServiceError
+			if errors.As(err, &gerr) {
+				return nil, gerr
+			}
+			return nil, goa.DecodePayloadError(err.Error())
+		}
+		err = ValidateUpdateGroupsioMemberRequestBody(&body)
+		if err != nil {
+			return nil, err
+		}
+
+		var (
+			subgroupID  string
+			memberID    string
+			bearerToken *string
+
+			params = mux.Vars(r)
+		)
+		subgroupID = params["subgroup_id"]
+		memberID = params["member_id"]
+		bearerTokenRaw := r.Header.Get("Authorization")
+		if bearerTokenRaw != "" {
+			bearerToken = &bearerTokenRaw
+		}
+		payload := NewUpdateGroupsioMemberPayload(&body, subgroupID, memberID, bearerToken)
+		if payload.BearerToken != nil {
+			if strings.Contains(*payload.BearerToken, " ") {
+				// Remove authorization scheme prefix (e.g. "Bearer")
+				cred := strings.SplitN(*payload.BearerToken, " ", 2)[1]
+				payload.BearerToken = &cred
+			}
+		}
+
+		return payload, nil
+	}
+}
+
+// EncodeUpdateGroupsioMemberError returns an encoder for errors returned by
+// the update-groupsio-member mailing-list endpoint.
+func EncodeUpdateGroupsioMemberError(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder, formatter func(ctx context.Context, err error) goahttp.Statuser) func(context.Context, http.ResponseWriter, error) error {
+	encodeError := goahttp.ErrorEncoder(encoder, formatter)
+	return func(ctx context.Context, w http.ResponseWriter, v error) error {
+		var en goa.GoaErrorNamer
+		if !errors.As(v, &en) {
+			return encodeError(ctx, w, v)
+		}
+		switch en.GoaErrorName() {
+		case "BadRequest":
+			var res *mailinglist.BadRequestError
+			errors.As(v, &res)
+			enc := encoder(ctx, w)
+			var body any
+			if formatter != nil {
+				body = formatter(ctx, res)
+			} else {
+				body = NewUpdateGroupsioMemberBadRequestResponseBody(res)
+			}
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusBadRequest)
+			return enc.Encode(body)
+		case "InternalServerError":
+			var res *mailinglist.InternalServerError
+			errors.As(v, &res)
+			enc := encoder(ctx, w)
+			var body any
+			if formatter != nil {
+				body = formatter(ctx, res)
+			} else {
+				body = NewUpdateGroupsioMemberInternalServerErrorResponseBody(res)
+			}
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusInternalServerError)
+			return enc.Encode(body)
+		case "NotFound":
+			var res *mailinglist.NotFoundError
+			errors.As(v, &res)
+			enc := encoder(ctx, w)
+			var body any
+			if formatter != nil {
+				body = formatter(ctx, res)
+			} else {
+				body = NewUpdateGroupsioMemberNotFoundResponseBody(res)
+			}
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusNotFound)
+			return enc.Encode(body)
+		case "ServiceUnavailable":
+			var res *mailinglist.ServiceUnavailableError
+			errors.As(v, &res)
+			enc := encoder(ctx, w)
+			var body any
+			if formatter != nil {
+				body = formatter(ctx, res)
+			} else {
+				body = NewUpdateGroupsioMemberServiceUnavailableResponseBody(res)
+			}
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return enc.Encode(body)
+		default:
+			return encodeError(ctx, w, v)
+		}
+	}
+}
+
+// EncodeDeleteGroupsioMemberResponse returns an encoder for responses returned
+// by the mailing-list delete-groupsio-member endpoint.
+func EncodeDeleteGroupsioMemberResponse(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder) func(context.Context, http.ResponseWriter, any) error {
+	return func(ctx context.Context, w http.ResponseWriter, v any) error {
+		w.WriteHeader(http.StatusNoContent)
+		return nil
+	}
+}
+
+// DecodeDeleteGroupsioMemberRequest returns a decoder for requests sent to the
+// mailing-list delete-groupsio-member endpoint.
+func DecodeDeleteGroupsioMemberRequest(mux goahttp.Muxer, decoder func(*http.Request) goahttp.Decoder) func(*http.Request) (any, error) {
+	return func(r *http.Request) (any, error) {
+		var (
+			subgroupID  string
+			memberID    string
+			bearerToken *string
+
+			params = mux.Vars(r)
+		)
+		subgroupID = params["subgroup_id"]
+		memberID = params["member_id"]
+		bearerTokenRaw := r.Header.Get("Authorization")
+		if bearerTokenRaw != "" {
+			bearerToken = &bearerTokenRaw
+		}
+		payload := NewDeleteGroupsioMemberPayload(subgroupID, memberID, bearerToken)
+		if payload.BearerToken != nil {
+			if strings.Contains(*payload.BearerToken, " ") {
+				// Remove authorization scheme prefix (e.g. "Bearer")
+				cred := strings.SplitN(*payload.BearerToken, " ", 2)[1]
+				payload.BearerToken = &cred
+			}
+		}
+
+		return payload, nil
+	}
+}
+
+// EncodeDeleteGroupsioMemberError returns an encoder for errors returned by
+// the delete-groupsio-member mailing-list endpoint.
+func EncodeDeleteGroupsioMemberError(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder, formatter func(ctx context.Context, err error) goahttp.Statuser) func(context.Context, http.ResponseWriter, error) error {
+	encodeError := goahttp.ErrorEncoder(encoder, formatter)
+	return func(ctx context.Context, w http.ResponseWriter, v error) error {
+		var en goa.GoaErrorNamer
+		if !errors.As(v, &en) {
+			return encodeError(ctx, w, v)
+		}
+		switch en.GoaErrorName() {
+		case "InternalServerError":
+			var res *mailinglist.InternalServerError
+			errors.As(v, &res)
+			enc := encoder(ctx, w)
+			var body any
+			if formatter != nil {
+				body = formatter(ctx, res)
+			} else {
+				body = NewDeleteGroupsioMemberInternalServerErrorResponseBody(res)
 			}
 			w.Header().Set("goa-error", res.GoaErrorName())
 			w.WriteHeader(http.StatusInternalServerError)
 			return enc.Encode(body)
+		case "NotFound":
+			var res *mailinglist.NotFoundError
+			errors.As(v, &res)
+			enc := encoder(ctx, w)
+			var body any
+			if formatter != nil {
+				body = formatter(ctx, res)
+			} else {
+				body = NewDeleteGroupsioMemberNotFoundResponseBody(res)
+			}
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusNotFound)
+			return enc.Encode(body)
 		case "ServiceUnavailable":
 			var res *mailinglist.ServiceUnavailableError
 			errors.As(v, &res)
@@ -1034,7 +3368,7 @@ func EncodeCreateGroupsioMailingListError(encoder func(context.Context, http.Res
 			if formatter != nil {
 				body = formatter(ctx, res)
 			} else {
-				body = NewCreateGroupsioMailingListServiceUnavailableResponseBody(res)
+				body = NewDeleteGroupsioMemberServiceUnavailableResponseBody(res)
 			}
 			w.Header().Set("goa-error", res.GoaErrorName())
 			w.WriteHeader(http.StatusServiceUnavailable)
@@ -1045,22 +3379,39 @@ func EncodeCreateGroupsioMailingListError(encoder func(context.Context, http.Res
 	}
 }
 
-// EncodeGetGroupsioMailingListResponse returns an encoder for responses
-// returned by the mailing-list get-groupsio-mailing-list endpoint.
-func EncodeGetGroupsioMailingListResponse(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder) func(context.Context, http.ResponseWriter, any) error {
+// EncodeInviteGroupsioMembersResponse returns an encoder for responses
+// returned by the mailing-list invite-groupsio-members endpoint.
+func EncodeInviteGroupsioMembersResponse(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder) func(context.Context, http.ResponseWriter, any) error {
 	return func(ctx context.Context, w http.ResponseWriter, v any) error {
-		res, _ := v.(*mailinglist.GroupsioSubgroup)
-		enc := encoder(ctx, w)
-		body := NewGetGroupsioMailingListResponseBody(res)
-		w.WriteHeader(http.StatusOK)
-		return enc.Encode(body)
+		w.WriteHeader(http.StatusNoContent)
+		return nil
 	}
 }
 
-// DecodeGetGroupsioMailingListRequest returns a decoder for requests sent to
-// the mailing-list get-groupsio-mailing-list endpoint.
-func DecodeGetGroupsioMailingListRequest(mux goahttp.Muxer, decoder func(*http.Request) goahttp.Decoder) func(*http.Request) (any, error) {
+// DecodeInviteGroupsioMembersRequest returns a decoder for requests sent to
+// the mailing-list invite-groupsio-members endpoint.
+func DecodeInviteGroupsioMembersRequest(mux goahttp.Muxer, decoder func(*http.Request) goahttp.Decoder) func(*http.Request) (any, error) {
 	return func(r *http.Request) (any, error) {
+		var (
+			body InviteGroupsioMembersRequestBody
+			err  error
+		)
+		err = decoder(r).Decode(&body)
+		if err != nil {
+			if errors.Is(err, io.EOF) {
+				return nil, goa.MissingPayloadError()
+			}
+			var gerr *goa.ServiceError
+			if errors.As(err, &gerr) {
+				return nil, gerr
+			}
+			return nil, goa.DecodePayloadError(err.Error())
+		}
+		err = ValidateInviteGroupsioMembersRequestBody(&body)
+		if err != nil {
+			return nil, err
+		}
+
 		var (
 			subgroupID  string
 			bearerToken *string
@@ -1072,7 +3423,7 @@ func DecodeGetGroupsioMailingListRequest(mux goahttp.Muxer, decoder func(*http.R
 		if bearerTokenRaw != "" {
 			bearerToken = &bearerTokenRaw
 		}
-		payload := NewGetGroupsioMailingListPayload(subgroupID, bearerToken)
+		payload := NewInviteGroupsioMembersPayload(&body, subgroupID, bearerToken)
 		if payload.BearerToken != nil {
 			if strings.Contains(*payload.BearerToken, " ") {
 				// Remove authorization scheme prefix (e.g. "Bearer")
@@ -1085,9 +3436,9 @@ func DecodeGetGroupsioMailingListRequest(mux goahttp.Muxer, decoder func(*http.R
 	}
 }
 
-// EncodeGetGroupsioMailingListError returns an encoder for errors returned by
-// the get-groupsio-mailing-list mailing-list endpoint.
-func EncodeGetGroupsioMailingListError(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder, formatter func(ctx context.Context, err error) goahttp.Statuser) func(context.Context, http.ResponseWriter, error) error {
+// EncodeInviteGroupsioMembersError returns an encoder for errors returned by
+// the invite-groupsio-members mailing-list endpoint.
+func EncodeInviteGroupsioMembersError(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder, formatter func(ctx context.Context, err error) goahttp.Statuser) func(context.Context, http.ResponseWriter, error) error {
 	encodeError := goahttp.ErrorEncoder(encoder, formatter)
 	return func(ctx context.Context, w http.ResponseWriter, v error) error {
 		var en goa.GoaErrorNamer
@@ -1095,6 +3446,19 @@ func EncodeGetGroupsioMailingListError(encoder func(context.Context, http.Respon
 			return encodeError(ctx, w, v)
 		}
 		switch en.GoaErrorName() {
+		case "BadRequest":
+			var res *mailinglist.BadRequestError
+			errors.As(v, &res)
+			enc := encoder(ctx, w)
+			var body any
+			if formatter != nil {
+				body = formatter(ctx, res)
+			} else {
+				body = NewInviteGroupsioMembersBadRequestResponseBody(res)
+			}
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusBadRequest)
+			return enc.Encode(body)
 		case "InternalServerError":
 			var res *mailinglist.InternalServerError
 			errors.As(v, &res)
@@ -1103,7 +3467,7 @@ func EncodeGetGroupsioMailingListError(encoder func(context.Context, http.Respon
 			if formatter != nil {
 				body = formatter(ctx, res)
 			} else {
-				body = NewGetGroupsioMailingListInternalServerErrorResponseBody(res)
+				body = NewInviteGroupsioMembersInternalServerErrorResponseBody(res)
 			}
 			w.Header().Set("goa-error", res.GoaErrorName())
 			w.WriteHeader(http.StatusInternalServerError)
@@ -1116,7 +3480,7 @@ func EncodeGetGroupsioMailingListError(encoder func(context.Context, http.Respon
 			if formatter != nil {
 				body = formatter(ctx, res)
 			} else {
-				body = NewGetGroupsioMailingListNotFoundResponseBody(res)
+				body = NewInviteGroupsioMembersNotFoundResponseBody(res)
 			}
 			w.Header().Set("goa-error", res.GoaErrorName())
 			w.WriteHeader(http.StatusNotFound)
@@ -1129,7 +3493,7 @@ func EncodeGetGroupsioMailingListError(encoder func(context.Context, http.Respon
 			if formatter != nil {
 				body = formatter(ctx, res)
 			} else {
-				body = NewGetGroupsioMailingListServiceUnavailableResponseBody(res)
+				body = NewInviteGroupsioMembersServiceUnavailableResponseBody(res)
 			}
 			w.Header().Set("goa-error", res.GoaErrorName())
 			w.WriteHeader(http.StatusServiceUnavailable)
@@ -1140,24 +3504,24 @@ func EncodeGetGroupsioMailingListError(encoder func(context.Context, http.Respon
 	}
 }
 
-// EncodeUpdateGroupsioMailingListResponse returns an encoder for responses
-// returned by the mailing-list update-groupsio-mailing-list endpoint.
-func EncodeUpdateGroupsioMailingListResponse(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder) func(context.Context, http.ResponseWriter, any) error {
+// EncodeImportGroupsioMembersResponse returns an encoder for responses
+// returned by the mailing-list import-groupsio-members endpoint.
+func EncodeImportGroupsioMembersResponse(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder) func(context.Context, http.ResponseWriter, any) error {
 	return func(ctx context.Context, w http.ResponseWriter, v any) error {
-		res, _ := v.(*mailinglist.GroupsioSubgroup)
+		res, _ := v.(*mailinglist.GroupsioImportSummary)
 		enc := encoder(ctx, w)
-		body := NewUpdateGroupsioMailingListResponseBody(res)
+		body := NewImportGroupsioMembersResponseBody(res)
 		w.WriteHeader(http.StatusOK)
 		return enc.Encode(body)
 	}
 }
 
-// DecodeUpdateGroupsioMailingListRequest returns a decoder for requests sent
-// to the mailing-list update-groupsio-mailing-list endpoint.
-func DecodeUpdateGroupsioMailingListRequest(mux goahttp.Muxer, decoder func(*http.Request) goahttp.Decoder) func(*http.Request) (any, error) {
+// DecodeImportGroupsioMembersRequest returns a decoder for requests sent to
+// the mailing-list import-groupsio-members endpoint.
+func DecodeImportGroupsioMembersRequest(mux goahttp.Muxer, decoder func(*http.Request) goahttp.Decoder) func(*http.Request) (any, error) {
 	return func(r *http.Request) (any, error) {
 		var (
-			body UpdateGroupsioMailingListRequestBody
+			body ImportGroupsioMembersRequestBody
 			err  error
 		)
 		err = decoder(r).Decode(&body)
@@ -1171,7 +3535,7 @@ func DecodeUpdateGroupsioMailingListRequest(mux goahttp.Muxer, decoder func(*htt
 			}
 			return nil, goa.DecodePayloadError(err.Error())
 		}
-		err = ValidateUpdateGroupsioMailingListRequestBody(&body)
+		err = ValidateImportGroupsioMembersRequestBody(&body)
 		if err != nil {
 			return nil, err
 		}
@@ -1187,7 +3551,7 @@ func DecodeUpdateGroupsioMailingListRequest(mux goahttp.Muxer, decoder func(*htt
 		if bearerTokenRaw != "" {
 			bearerToken = &bearerTokenRaw
 		}
-		payload := NewUpdateGroupsioMailingListPayload(&body, subgroupID, bearerToken)
+		payload := NewImportGroupsioMembersPayload(&body, subgroupID, bearerToken)
 		if payload.BearerToken != nil {
 			if strings.Contains(*payload.BearerToken, " ") {
 				// Remove authorization scheme prefix (e.g. "Bearer")
@@ -1200,9 +3564,9 @@ func DecodeUpdateGroupsioMailingListRequest(mux goahttp.Muxer, decoder func(*htt
 	}
 }
 
-// EncodeUpdateGroupsioMailingListError returns an encoder for errors returned
-// by the update-groupsio-mailing-list mailing-list endpoint.
-func EncodeUpdateGroupsioMailingListError(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder, formatter func(ctx context.Context, err error) goahttp.Statuser) func(context.Context, http.ResponseWriter, error) error {
+// EncodeImportGroupsioMembersError returns an encoder for errors returned by
+// the import-groupsio-members mailing-list endpoint.
+func EncodeImportGroupsioMembersError(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder, formatter func(ctx context.Context, err error) goahttp.Statuser) func(context.Context, http.ResponseWriter, error) error {
 	encodeError := goahttp.ErrorEncoder(encoder, formatter)
 	return func(ctx context.Context, w http.ResponseWriter, v error) error {
 		var en goa.GoaErrorNamer
@@ -1218,11 +3582,24 @@ func EncodeUpdateGroupsioMailingListError(encoder func(context.Context, http.Res
 			if formatter != nil {
 				body = formatter(ctx, res)
 			} else {
-				body = NewUpdateGroupsioMailingListBadRequestResponseBody(res)
+				body = NewImportGroupsioMembersBadRequestResponseBody(res)
 			}
 			w.Header().Set("goa-error", res.GoaErrorName())
 			w.WriteHeader(http.StatusBadRequest)
 			return enc.Encode(body)
+		case "Conflict":
+			var res *mailinglist.ConflictError
+			errors.As(v, &res)
+			enc := encoder(ctx, w)
+			var body any
+			if formatter != nil {
+				body = formatter(ctx, res)
+			} else {
+				body = NewImportGroupsioMembersConflictResponseBody(res)
+			}
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusConflict)
+			return enc.Encode(body)
 		case "InternalServerError":
 			var res *mailinglist.InternalServerError
 			errors.As(v, &res)
@@ -1231,7 +3608,7 @@ func EncodeUpdateGroupsioMailingListError(encoder func(context.Context, http.Res
 			if formatter != nil {
 				body = formatter(ctx, res)
 			} else {
-				body = NewUpdateGroupsioMailingListInternalServerErrorResponseBody(res)
+				body = NewImportGroupsioMembersInternalServerErrorResponseBody(res)
 			}
 			w.Header().Set("goa-error", res.GoaErrorName())
 			w.WriteHeader(http.StatusInternalServerError)
@@ -1244,7 +3621,7 @@ func EncodeUpdateGroupsioMailingListError(encoder func(context.Context, http.Res
 			if formatter != nil {
 				body = formatter(ctx, res)
 			} else {
-				body = NewUpdateGroupsioMailingListNotFoundResponseBody(res)
+				body = NewImportGroupsioMembersNotFoundResponseBody(res)
 			}
 			w.Header().Set("goa-error", res.GoaErrorName())
 			w.WriteHeader(http.StatusNotFound)
@@ -1257,7 +3634,7 @@ func EncodeUpdateGroupsioMailingListError(encoder func(context.Context, http.Res
 			if formatter != nil {
 				body = formatter(ctx, res)
 			} else {
-				body = NewUpdateGroupsioMailingListServiceUnavailableResponseBody(res)
+				body = NewImportGroupsioMembersServiceUnavailableResponseBody(res)
 			}
 			w.Header().Set("goa-error", res.GoaErrorName())
 			w.WriteHeader(http.StatusServiceUnavailable)
@@ -1268,19 +3645,39 @@ func EncodeUpdateGroupsioMailingListError(encoder func(context.Context, http.Res
 	}
 }
 
-// EncodeDeleteGroupsioMailingListResponse returns an encoder for responses
-// returned by the mailing-list delete-groupsio-mailing-list endpoint.
-func EncodeDeleteGroupsioMailingListResponse(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder) func(context.Context, http.ResponseWriter, any) error {
+// EncodeMergeGroupsioMembersResponse returns an encoder for responses returned
+// by the mailing-list merge-groupsio-members endpoint.
+func EncodeMergeGroupsioMembersResponse(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder) func(context.Context, http.ResponseWriter, any) error {
 	return func(ctx context.Context, w http.ResponseWriter, v any) error {
 		w.WriteHeader(http.StatusNoContent)
 		return nil
 	}
 }
 
-// DecodeDeleteGroupsioMailingListRequest returns a decoder for requests sent
-// to the mailing-list delete-groupsio-mailing-list endpoint.
-func DecodeDeleteGroupsioMailingListRequest(mux goahttp.Muxer, decoder func(*http.Request) goahttp.Decoder) func(*http.Request) (any, error) {
+// DecodeMergeGroupsioMembersRequest returns a decoder for requests sent to the
+// mailing-list merge-groupsio-members endpoint.
+func DecodeMergeGroupsioMembersRequest(mux goahttp.Muxer, decoder func(*http.Request) goahttp.Decoder) func(*http.Request) (any, error) {
 	return func(r *http.Request) (any, error) {
+		var (
+			body MergeGroupsioMembersRequestBody
+			err  error
+		)
+		err = decoder(r).Decode(&body)
+		if err != nil {
+			if errors.Is(err, io.EOF) {
+				return nil, goa.MissingPayloadError()
+			}
+			var gerr *goa.ServiceError
+			if errors.As(err, &gerr) {
+				return nil, gerr
+			}
+			return nil, goa.DecodePayloadError(err.Error())
+		}
+		err = ValidateMergeGroupsioMembersRequestBody(&body)
+		if err != nil {
+			return nil, err
+		}
+
 		var (
 			subgroupID  string
 			bearerToken *string
@@ -1292,7 +3689,7 @@ func DecodeDeleteGroupsioMailingListRequest(mux goahttp.Muxer, decoder func(*htt
 		if bearerTokenRaw != "" {
 			bearerToken = &bearerTokenRaw
 		}
-		payload := NewDeleteGroupsioMailingListPayload(subgroupID, bearerToken)
+		payload := NewMergeGroupsioMembersPayload(&body, subgroupID, bearerToken)
 		if payload.BearerToken != nil {
 			if strings.Contains(*payload.BearerToken, " ") {
 				// Remove authorization scheme prefix (e.g. "Bearer")
@@ -1305,9 +3702,9 @@ func DecodeDeleteGroupsioMailingListRequest(mux goahttp.Muxer, decoder func(*htt
 	}
 }
 
-// EncodeDeleteGroupsioMailingListError returns an encoder for errors returned
-// by the delete-groupsio-mailing-list mailing-list endpoint.
-func EncodeDeleteGroupsioMailingListError(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder, formatter func(ctx context.Context, err error) goahttp.Statuser) func(context.Context, http.ResponseWriter, error) error {
+// EncodeMergeGroupsioMembersError returns an encoder for errors returned by
+// the merge-groupsio-members mailing-list endpoint.
+func EncodeMergeGroupsioMembersError(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder, formatter func(ctx context.Context, err error) goahttp.Statuser) func(context.Context, http.ResponseWriter, error) error {
 	encodeError := goahttp.ErrorEncoder(encoder, formatter)
 	return func(ctx context.Context, w http.ResponseWriter, v error) error {
 		var en goa.GoaErrorNamer
@@ -1315,6 +3712,19 @@ func EncodeDeleteGroupsioMailingListError(encoder func(context.Context, http.Res
 			return encodeError(ctx, w, v)
 		}
 		switch en.GoaErrorName() {
+		case "BadRequest":
+			var res *mailinglist.BadRequestError
+			errors.As(v, &res)
+			enc := encoder(ctx, w)
+			var body any
+			if formatter != nil {
+				body = formatter(ctx, res)
+			} else {
+				body = NewMergeGroupsioMembersBadRequestResponseBody(res)
+			}
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusBadRequest)
+			return enc.Encode(body)
 		case "InternalServerError":
 			var res *mailinglist.InternalServerError
 			errors.As(v, &res)
@@ -1323,7 +3733,7 @@ func EncodeDeleteGroupsioMailingListError(encoder func(context.Context, http.Res
 			if formatter != nil {
 				body = formatter(ctx, res)
 			} else {
-				body = NewDeleteGroupsioMailingListInternalServerErrorResponseBody(res)
+				body = NewMergeGroupsioMembersInternalServerErrorResponseBody(res)
 			}
 			w.Header().Set("goa-error", res.GoaErrorName())
 			w.WriteHeader(http.StatusInternalServerError)
@@ -1336,7 +3746,7 @@ func EncodeDeleteGroupsioMailingListError(encoder func(context.Context, http.Res
 			if formatter != nil {
 				body = formatter(ctx, res)
 			} else {
-				body = NewDeleteGroupsioMailingListNotFoundResponseBody(res)
+				body = NewMergeGroupsioMembersNotFoundResponseBody(res)
 			}
 			w.Header().Set("goa-error", res.GoaErrorName())
 			w.WriteHeader(http.StatusNotFound)
@@ -1349,7 +3759,7 @@ func EncodeDeleteGroupsioMailingListError(encoder func(context.Context, http.Res
 			if formatter != nil {
 				body = formatter(ctx, res)
 			} else {
-				body = NewDeleteGroupsioMailingListServiceUnavailableResponseBody(res)
+				body = NewMergeGroupsioMembersServiceUnavailableResponseBody(res)
 			}
 			w.Header().Set("goa-error", res.GoaErrorName())
 			w.WriteHeader(http.StatusServiceUnavailable)
@@ -1360,40 +3770,56 @@ func EncodeDeleteGroupsioMailingListError(encoder func(context.Context, http.Res
 	}
 }
 
-// EncodeGetGroupsioMailingListCountResponse returns an encoder for responses
-// returned by the mailing-list get-groupsio-mailing-list-count endpoint.
-func EncodeGetGroupsioMailingListCountResponse(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder) func(context.Context, http.ResponseWriter, any) error {
+// EncodeChangeGroupsioMemberEmailResponse returns an encoder for responses
+// returned by the mailing-list change-groupsio-member-email endpoint.
+func EncodeChangeGroupsioMemberEmailResponse(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder) func(context.Context, http.ResponseWriter, any) error {
 	return func(ctx context.Context, w http.ResponseWriter, v any) error {
-		res, _ := v.(*mailinglist.GroupsioCount)
+		res, _ := v.(*mailinglist.GroupsioMember)
 		enc := encoder(ctx, w)
-		body := NewGetGroupsioMailingListCountResponseBody(res)
+		body := NewChangeGroupsioMemberEmailResponseBody(res)
 		w.WriteHeader(http.StatusOK)
 		return enc.Encode(body)
 	}
 }
 
-// DecodeGetGroupsioMailingListCountRequest returns a decoder for requests sent
-// to the mailing-list get-groupsio-mailing-list-count endpoint.
-func DecodeGetGroupsioMailingListCountRequest(mux goahttp.Muxer, decoder func(*http.Request) goahttp.Decoder) func(*http.Request) (any, error) {
+// DecodeChangeGroupsioMemberEmailRequest returns a decoder for requests sent
+// to the mailing-list change-groupsio-member-email endpoint.
+func DecodeChangeGroupsioMemberEmailRequest(mux goahttp.Muxer, decoder func(*http.Request) goahttp.Decoder) func(*http.Request) (any, error) {
 	return func(r *http.Request) (any, error) {
 		var (
-			projectUID  string
-			bearerToken *string
-			err         error
+			body ChangeGroupsioMemberEmailRequestBody
+			err  error
 		)
-		projectUID = r.URL.Query().Get("project_uid")
-		if projectUID == "" {
-			err = goa.MergeErrors(err, goa.MissingFieldError("project_uid", "query string"))
+		err = decoder(r).Decode(&body)
+		if err != nil {
+			if errors.Is(err, io.EOF) {
+				return nil, goa.MissingPayloadError()
+			}
+			var gerr *goa.ServiceError
+			if errors.As(err, &gerr) {
+				return nil, gerr
+			}
+			return nil, goa.DecodePayloadError(err.Error())
 		}
-		err = goa.MergeErrors(err, goa.ValidateFormat("project_uid", projectUID, goa.FormatUUID))
+		err = ValidateChangeGroupsioMemberEmailRequestBody(&body)
+		if err != nil {
+			return nil, err
+		}
+
+		var (
+			subgroupID  string
+			memberID    string
+			bearerToken *string
+
+			params = mux.Vars(r)
+		)
+		subgroupID = params["subgroup_id"]
+		memberID = params["member_id"]
 		bearerTokenRaw := r.Header.Get("Authorization")
 		if bearerTokenRaw != "" {
 			bearerToken = &bearerTokenRaw
 		}
-		if err != nil {
-			return nil, err
-		}
-		payload := NewGetGroupsioMailingListCountPayload(projectUID, bearerToken)
+		payload := NewChangeGroupsioMemberEmailPayload(&body, subgroupID, memberID, bearerToken)
 		if payload.BearerToken != nil {
 			if strings.Contains(*payload.BearerToken, " ") {
 				// Remove authorization scheme prefix (e.g. "Bearer")
@@ -1406,9 +3832,9 @@ func DecodeGetGroupsioMailingListCountRequest(mux goahttp.Muxer, decoder func(*h
 	}
 }
 
-// EncodeGetGroupsioMailingListCountError returns an encoder for errors
-// returned by the get-groupsio-mailing-list-count mailing-list endpoint.
-func EncodeGetGroupsioMailingListCountError(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder, formatter func(ctx context.Context, err error) goahttp.Statuser) func(context.Context, http.ResponseWriter, error) error {
+// EncodeChangeGroupsioMemberEmailError returns an encoder for errors returned
+// by the change-groupsio-member-email mailing-list endpoint.
+func EncodeChangeGroupsioMemberEmailError(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder, formatter func(ctx context.Context, err error) goahttp.Statuser) func(context.Context, http.ResponseWriter, error) error {
 	encodeError := goahttp.ErrorEncoder(encoder, formatter)
 	return func(ctx context.Context, w http.ResponseWriter, v error) error {
 		var en goa.GoaErrorNamer
@@ -1424,11 +3850,24 @@ func EncodeGetGroupsioMailingListCountError(encoder func(context.Context, http.R
 			if formatter != nil {
 				body = formatter(ctx, res)
 			} else {
-				body = NewGetGroupsioMailingListCountBadRequestResponseBody(res)
+				body = NewChangeGroupsioMemberEmailBadRequestResponseBody(res)
 			}
 			w.Header().Set("goa-error", res.GoaErrorName())
 			w.WriteHeader(http.StatusBadRequest)
 			return enc.Encode(body)
+		case "Conflict":
+			var res *mailinglist.ConflictError
+			errors.As(v, &res)
+			enc := encoder(ctx, w)
+			var body any
+			if formatter != nil {
+				body = formatter(ctx, res)
+			} else {
+				body = NewChangeGroupsioMemberEmailConflictResponseBody(res)
+			}
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusConflict)
+			return enc.Encode(body)
 		case "InternalServerError":
 			var res *mailinglist.InternalServerError
 			errors.As(v, &res)
@@ -1437,11 +3876,24 @@ func EncodeGetGroupsioMailingListCountError(encoder func(context.Context, http.R
 			if formatter != nil {
 				body = formatter(ctx, res)
 			} else {
-				body = NewGetGroupsioMailingListCountInternalServerErrorResponseBody(res)
+				body = NewChangeGroupsioMemberEmailInternalServerErrorResponseBody(res)
 			}
 			w.Header().Set("goa-error", res.GoaErrorName())
 			w.WriteHeader(http.StatusInternalServerError)
 			return enc.Encode(body)
+		case "NotFound":
+			var res *mailinglist.NotFoundError
+			errors.As(v, &res)
+			enc := encoder(ctx, w)
+			var body any
+			if formatter != nil {
+				body = formatter(ctx, res)
+			} else {
+				body = NewChangeGroupsioMemberEmailNotFoundResponseBody(res)
+			}
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusNotFound)
+			return enc.Encode(body)
 		case "ServiceUnavailable":
 			var res *mailinglist.ServiceUnavailableError
 			errors.As(v, &res)
@@ -1450,7 +3902,7 @@ func EncodeGetGroupsioMailingListCountError(encoder func(context.Context, http.R
 			if formatter != nil {
 				body = formatter(ctx, res)
 			} else {
-				body = NewGetGroupsioMailingListCountServiceUnavailableResponseBody(res)
+				body = NewChangeGroupsioMemberEmailServiceUnavailableResponseBody(res)
 			}
 			w.Header().Set("goa-error", res.GoaErrorName())
 			w.WriteHeader(http.StatusServiceUnavailable)
@@ -1461,36 +3913,50 @@ func EncodeGetGroupsioMailingListCountError(encoder func(context.Context, http.R
 	}
 }
 
-// EncodeGetGroupsioMailingListMemberCountResponse returns an encoder for
-// responses returned by the mailing-list
-// get-groupsio-mailing-list-member-count endpoint.
-func EncodeGetGroupsioMailingListMemberCountResponse(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder) func(context.Context, http.ResponseWriter, any) error {
+// EncodeCheckGroupsioSubscriberResponse returns an encoder for responses
+// returned by the mailing-list check-groupsio-subscriber endpoint.
+func EncodeCheckGroupsioSubscriberResponse(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder) func(context.Context, http.ResponseWriter, any) error {
 	return func(ctx context.Context, w http.ResponseWriter, v any) error {
-		res, _ := v.(*mailinglist.GroupsioCount)
+		res, _ := v.(*mailinglist.GroupsioCheckSubscriberResponse)
 		enc := encoder(ctx, w)
-		body := NewGetGroupsioMailingListMemberCountResponseBody(res)
+		body := NewCheckGroupsioSubscriberResponseBody(res)
 		w.WriteHeader(http.StatusOK)
 		return enc.Encode(body)
 	}
 }
 
-// DecodeGetGroupsioMailingListMemberCountRequest returns a decoder for
-// requests sent to the mailing-list get-groupsio-mailing-list-member-count
-// endpoint.
-func DecodeGetGroupsioMailingListMemberCountRequest(mux goahttp.Muxer, decoder func(*http.Request) goahttp.Decoder) func(*http.Request) (any, error) {
+// DecodeCheckGroupsioSubscriberRequest returns a decoder for requests sent to
+// the mailing-list check-groupsio-subscriber endpoint.
+func DecodeCheckGroupsioSubscriberRequest(mux goahttp.Muxer, decoder func(*http.Request) goahttp.Decoder) func(*http.Request) (any, error) {
 	return func(r *http.Request) (any, error) {
 		var (
-			subgroupID  string
-			bearerToken *string
+			body CheckGroupsioSubscriberRequestBody
+			err  error
+		)
+		err = decoder(r).Decode(&body)
+		if err != nil {
+			if errors.Is(err, io.EOF) {
+				return nil, goa.MissingPayloadError()
+			}
+			var gerr *goa.ServiceError
+			if errors.As(err, &gerr) {
+				return nil, gerr
+			}
+			return nil, goa.DecodePayloadError(err.Error())
+		}
+		err = ValidateCheckGroupsioSubscriberRequestBody(&body)
+		if err != nil {
+			return nil, err
+		}
 
-			params = mux.Vars(r)
+		var (
+			bearerToken *string
 		)
-		subgroupID = params["subgroup_id"]
 		bearerTokenRaw := r.Header.Get("Authorization")
 		if bearerTokenRaw != "" {
 			bearerToken = &bearerTokenRaw
 		}
-		payload := NewGetGroupsioMailingListMemberCountPayload(subgroupID, bearerToken)
+		payload := NewCheckGroupsioSubscriberPayload(&body, bearerToken)
 		if payload.BearerToken != nil {
 			if strings.Contains(*payload.BearerToken, " ") {
 				// Remove authorization scheme prefix (e.g. "Bearer")
@@ -1503,9 +3969,9 @@ func DecodeGetGroupsioMailingListMemberCountRequest(mux goahttp.Muxer, decoder f
 	}
 }
 
-// EncodeGetGroupsioMailingListMemberCountError returns an encoder for errors
-// returned by the get-groupsio-mailing-list-member-count mailing-list endpoint.
-func EncodeGetGroupsioMailingListMemberCountError(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder, formatter func(ctx context.Context, err error) goahttp.Statuser) func(context.Context, http.ResponseWriter, error) error {
+// EncodeCheckGroupsioSubscriberError returns an encoder for errors returned by
+// the check-groupsio-subscriber mailing-list endpoint.
+func EncodeCheckGroupsioSubscriberError(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder, formatter func(ctx context.Context, err error) goahttp.Statuser) func(context.Context, http.ResponseWriter, error) error {
 	encodeError := goahttp.ErrorEncoder(encoder, formatter)
 	return func(ctx context.Context, w http.ResponseWriter, v error) error {
 		var en goa.GoaErrorNamer
@@ -1513,31 +3979,31 @@ func EncodeGetGroupsioMailingListMemberCountError(encoder func(context.Context,
 			return encodeError(ctx, w, v)
 		}
 		switch en.GoaErrorName() {
-		case "InternalServerError":
-			var res *mailinglist.InternalServerError
+		case "BadRequest":
+			var res *mailinglist.BadRequestError
 			errors.As(v, &res)
 			enc := encoder(ctx, w)
 			var body any
 			if formatter != nil {
 				body = formatter(ctx, res)
 			} else {
-				body = NewGetGroupsioMailingListMemberCountInternalServerErrorResponseBody(res)
+				body = NewCheckGroupsioSubscriberBadRequestResponseBody(res)
 			}
 			w.Header().Set("goa-error", res.GoaErrorName())
-			w.WriteHeader(http.StatusInternalServerError)
+			w.WriteHeader(http.StatusBadRequest)
 			return enc.Encode(body)
-		case "NotFound":
-			var res *mailinglist.NotFoundError
+		case "InternalServerError":
+			var res *mailinglist.InternalServerError
 			errors.As(v, &res)
 			enc := encoder(ctx, w)
 			var body any
 			if formatter != nil {
 				body = formatter(ctx, res)
 			} else {
-				body = NewGetGroupsioMailingListMemberCountNotFoundResponseBody(res)
+				body = NewCheckGroupsioSubscriberInternalServerErrorResponseBody(res)
 			}
 			w.Header().Set("goa-error", res.GoaErrorName())
-			w.WriteHeader(http.StatusNotFound)
+			w.WriteHeader(http.StatusInternalServerError)
 			return enc.Encode(body)
 		case "ServiceUnavailable":
 			var res *mailinglist.ServiceUnavailableError
@@ -1547,7 +4013,7 @@ func EncodeGetGroupsioMailingListMemberCountError(encoder func(context.Context,
 			if formatter != nil {
 				body = formatter(ctx, res)
 			} else {
-				body = NewGetGroupsioMailingListMemberCountServiceUnavailableResponseBody(res)
+				body = NewCheckGroupsioSubscriberServiceUnavailableResponseBody(res)
 			}
 			w.Header().Set("goa-error", res.GoaErrorName())
 			w.WriteHeader(http.StatusServiceUnavailable)
@@ -1558,34 +4024,36 @@ func EncodeGetGroupsioMailingListMemberCountError(encoder func(context.Context,
 	}
 }
 
-// EncodeListGroupsioMembersResponse returns an encoder for responses returned
-// by the mailing-list list-groupsio-members endpoint.
-func EncodeListGroupsioMembersResponse(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder) func(context.Context, http.ResponseWriter, any) error {
+// EncodeGetGroupsioArtifactResponse returns an encoder for responses returned
+// by the mailing-list get-groupsio-artifact endpoint.
+func EncodeGetGroupsioArtifactResponse(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder) func(context.Context, http.ResponseWriter, any) error {
 	return func(ctx context.Context, w http.ResponseWriter, v any) error {
-		res, _ := v.(*mailinglist.GroupsioMemberList)
+		res, _ := v.(*mailinglist.GroupsioArtifact)
 		enc := encoder(ctx, w)
-		body := NewListGroupsioMembersResponseBody(res)
+		body := NewGetGroupsioArtifactResponseBody(res)
 		w.WriteHeader(http.StatusOK)
 		return enc.Encode(body)
 	}
 }
 
-// DecodeListGroupsioMembersRequest returns a decoder for requests sent to the
-// mailing-list list-groupsio-members endpoint.
-func DecodeListGroupsioMembersRequest(mux goahttp.Muxer, decoder func(*http.Request) goahttp.Decoder) func(*http.Request) (any, error) {
+// DecodeGetGroupsioArtifactRequest returns a decoder for requests sent to the
+// mailing-list get-groupsio-artifact endpoint.
+func DecodeGetGroupsioArtifactRequest(mux goahttp.Muxer, decoder func(*http.Request) goahttp.Decoder) func(*http.Request) (any, error) {
 	return func(r *http.Request) (any, error) {
 		var (
 			subgroupID  string
+			artifactID  string
 			bearerToken *string
 
 			params = mux.Vars(r)
 		)
 		subgroupID = params["subgroup_id"]
+		artifactID = params["artifact_id"]
 		bearerTokenRaw := r.Header.Get("Authorization")
 		if bearerTokenRaw != "" {
 			bearerToken = &bearerTokenRaw
 		}
-		payload := NewListGroupsioMembersPayload(subgroupID, bearerToken)
+		payload := NewGetGroupsioArtifactPayload(subgroupID, artifactID, bearerToken)
 		if payload.BearerToken != nil {
 			if strings.Contains(*payload.BearerToken, " ") {
 				// Remove authorization scheme prefix (e.g. "Bearer")
@@ -1598,9 +4066,9 @@ func DecodeListGroupsioMembersRequest(mux goahttp.Muxer, decoder func(*http.Requ
 	}
 }
 
-// EncodeListGroupsioMembersError returns an encoder for errors returned by the
-// list-groupsio-members mailing-list endpoint.
-func EncodeListGroupsioMembersError(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder, formatter func(ctx context.Context, err error) goahttp.Statuser) func(context.Context, http.ResponseWriter, error) error {
+// EncodeGetGroupsioArtifactError returns an encoder for errors returned by the
+// get-groupsio-artifact mailing-list endpoint.
+func EncodeGetGroupsioArtifactError(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder, formatter func(ctx context.Context, err error) goahttp.Statuser) func(context.Context, http.ResponseWriter, error) error {
 	encodeError := goahttp.ErrorEncoder(encoder, formatter)
 	return func(ctx context.Context, w http.ResponseWriter, v error) error {
 		var en goa.GoaErrorNamer
@@ -1616,7 +4084,7 @@ func EncodeListGroupsioMembersError(encoder func(context.Context, http.ResponseW
 			if formatter != nil {
 				body = formatter(ctx, res)
 			} else {
-				body = NewListGroupsioMembersInternalServerErrorResponseBody(res)
+				body = NewGetGroupsioArtifactInternalServerErrorResponseBody(res)
 			}
 			w.Header().Set("goa-error", res.GoaErrorName())
 			w.WriteHeader(http.StatusInternalServerError)
@@ -1629,7 +4097,7 @@ func EncodeListGroupsioMembersError(encoder func(context.Context, http.ResponseW
 			if formatter != nil {
 				body = formatter(ctx, res)
 			} else {
-				body = NewListGroupsioMembersNotFoundResponseBody(res)
+				body = NewGetGroupsioArtifactNotFoundResponseBody(res)
 			}
 			w.Header().Set("goa-error", res.GoaErrorName())
 			w.WriteHeader(http.StatusNotFound)
@@ -1642,7 +4110,7 @@ func EncodeListGroupsioMembersError(encoder func(context.Context, http.ResponseW
 			if formatter != nil {
 				body = formatter(ctx, res)
 			} else {
-				body = NewListGroupsioMembersServiceUnavailableResponseBody(res)
+				body = NewGetGroupsioArtifactServiceUnavailableResponseBody(res)
 			}
 			w.Header().Set("goa-error", res.GoaErrorName())
 			w.WriteHeader(http.StatusServiceUnavailable)
@@ -1653,54 +4121,36 @@ func EncodeListGroupsioMembersError(encoder func(context.Context, http.ResponseW
 	}
 }
 
-// EncodeAddGroupsioMemberResponse returns an encoder for responses returned by
-// the mailing-list add-groupsio-member endpoint.
-func EncodeAddGroupsioMemberResponse(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder) func(context.Context, http.ResponseWriter, any) error {
+// EncodeGetGroupsioArtifactDownloadResponse returns an encoder for responses
+// returned by the mailing-list get-groupsio-artifact-download endpoint.
+func EncodeGetGroupsioArtifactDownloadResponse(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder) func(context.Context, http.ResponseWriter, any) error {
 	return func(ctx context.Context, w http.ResponseWriter, v any) error {
-		res, _ := v.(*mailinglist.GroupsioMember)
+		res, _ := v.(*mailinglist.GroupsioArtifactDownload)
 		enc := encoder(ctx, w)
-		body := NewAddGroupsioMemberResponseBody(res)
-		w.WriteHeader(http.StatusCreated)
+		body := NewGetGroupsioArtifactDownloadResponseBody(res)
+		w.WriteHeader(http.StatusOK)
 		return enc.Encode(body)
 	}
 }
 
-// DecodeAddGroupsioMemberRequest returns a decoder for requests sent to the
-// mailing-list add-groupsio-member endpoint.
-func DecodeAddGroupsioMemberRequest(mux goahttp.Muxer, decoder func(*http.Request) goahttp.Decoder) func(*http.Request) (any, error) {
+// DecodeGetGroupsioArtifactDownloadRequest returns a decoder for requests sent
+// to the mailing-list get-groupsio-artifact-download endpoint.
+func DecodeGetGroupsioArtifactDownloadRequest(mux goahttp.Muxer, decoder func(*http.Request) goahttp.Decoder) func(*http.Request) (any, error) {
 	return func(r *http.Request) (any, error) {
-		var (
-			body AddGroupsioMemberRequestBody
-			err  error
-		)
-		err = decoder(r).Decode(&body)
-		if err != nil {
-			if errors.Is(err, io.EOF) {
-				return nil, goa.MissingPayloadError()
-			}
-			var gerr *goa.ServiceError
-			if errors.As(err, &gerr) {
-				return nil, gerr
-			}
-			return nil, goa.DecodePayloadError(err.Error())
-		}
-		err = ValidateAddGroupsioMemberRequestBody(&body)
-		if err != nil {
-			return nil, err
-		}
-
 		var (
 			subgroupID  string
+			artifactID  string
 			bearerToken *string
 
 			params = mux.Vars(r)
 		)
 		subgroupID = params["subgroup_id"]
+		artifactID = params["artifact_id"]
 		bearerTokenRaw := r.Header.Get("Authorization")
 		if bearerTokenRaw != "" {
 			bearerToken = &bearerTokenRaw
 		}
-		payload := NewAddGroupsioMemberPayload(&body, subgroupID, bearerToken)
+		payload := NewGetGroupsioArtifactDownloadPayload(subgroupID, artifactID, bearerToken)
 		if payload.BearerToken != nil {
 			if strings.Contains(*payload.BearerToken, " ") {
 				// Remove authorization scheme prefix (e.g. "Bearer")
@@ -1713,9 +4163,9 @@ func DecodeAddGroupsioMemberRequest(mux goahttp.Muxer, decoder func(*http.Reques
 	}
 }
 
-// EncodeAddGroupsioMemberError returns an encoder for errors returned by the
-// add-groupsio-member mailing-list endpoint.
-func EncodeAddGroupsioMemberError(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder, formatter func(ctx context.Context, err error) goahttp.Statuser) func(context.Context, http.ResponseWriter, error) error {
+// EncodeGetGroupsioArtifactDownloadError returns an encoder for errors
+// returned by the get-groupsio-artifact-download mailing-list endpoint.
+func EncodeGetGroupsioArtifactDownloadError(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder, formatter func(ctx context.Context, err error) goahttp.Statuser) func(context.Context, http.ResponseWriter, error) error {
 	encodeError := goahttp.ErrorEncoder(encoder, formatter)
 	return func(ctx context.Context, w http.ResponseWriter, v error) error {
 		var en goa.GoaErrorNamer
@@ -1723,32 +4173,6 @@ func EncodeAddGroupsioMemberError(encoder func(context.Context, http.ResponseWri
 			return encodeError(ctx, w, v)
 		}
 		switch en.GoaErrorName() {
-		case "BadRequest":
-			var res *mailinglist.BadRequestError
-			errors.As(v, &res)
-			enc := encoder(ctx, w)
-			var body any
-			if formatter != nil {
-				body = formatter(ctx, res)
-			} else {
-				body = NewAddGroupsioMemberBadRequestResponseBody(res)
-			}
-			w.Header().Set("goa-error", res.GoaErrorName())
-			w.WriteHeader(http.StatusBadRequest)
-			return enc.Encode(body)
-		case "Conflict":
-			var res *mailinglist.ConflictError
-			errors.As(v, &res)
-			enc := encoder(ctx, w)
-			var body any
-			if formatter != nil {
-				body = formatter(ctx, res)
-			} else {
-				body = NewAddGroupsioMemberConflictResponseBody(res)
-			}
-			w.Header().Set("goa-error", res.GoaErrorName())
-			w.WriteHeader(http.StatusConflict)
-			return enc.Encode(body)
 		case "InternalServerError":
 			var res *mailinglist.InternalServerError
 			errors.As(v, &res)
@@ -1757,7 +4181,7 @@ func EncodeAddGroupsioMemberError(encoder func(context.Context, http.ResponseWri
 			if formatter != nil {
 				body = formatter(ctx, res)
 			} else {
-				body = NewAddGroupsioMemberInternalServerErrorResponseBody(res)
+				body = NewGetGroupsioArtifactDownloadInternalServerErrorResponseBody(res)
 			}
 			w.Header().Set("goa-error", res.GoaErrorName())
 			w.WriteHeader(http.StatusInternalServerError)
@@ -1770,7 +4194,7 @@ func EncodeAddGroupsioMemberError(encoder func(context.Context, http.ResponseWri
 			if formatter != nil {
 				body = formatter(ctx, res)
 			} else {
-				body = NewAddGroupsioMemberNotFoundResponseBody(res)
+				body = NewGetGroupsioArtifactDownloadNotFoundResponseBody(res)
 			}
 			w.Header().Set("goa-error", res.GoaErrorName())
 			w.WriteHeader(http.StatusNotFound)
@@ -1783,7 +4207,7 @@ func EncodeAddGroupsioMemberError(encoder func(context.Context, http.ResponseWri
 			if formatter != nil {
 				body = formatter(ctx, res)
 			} else {
-				body = NewAddGroupsioMemberServiceUnavailableResponseBody(res)
+				body = NewGetGroupsioArtifactDownloadServiceUnavailableResponseBody(res)
 			}
 			w.Header().Set("goa-error", res.GoaErrorName())
 			w.WriteHeader(http.StatusServiceUnavailable)
@@ -1794,36 +4218,39 @@ func EncodeAddGroupsioMemberError(encoder func(context.Context, http.ResponseWri
 	}
 }
 
-// EncodeGetGroupsioMemberResponse returns an encoder for responses returned by
-// the mailing-list get-groupsio-member endpoint.
-func EncodeGetGroupsioMemberResponse(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder) func(context.Context, http.ResponseWriter, any) error {
+// EncodeGetGroupsioProjectSummaryResponse returns an encoder for responses
+// returned by the mailing-list get-groupsio-project-summary endpoint.
+func EncodeGetGroupsioProjectSummaryResponse(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder) func(context.Context, http.ResponseWriter, any) error {
 	return func(ctx context.Context, w http.ResponseWriter, v any) error {
-		res, _ := v.(*mailinglist.GroupsioMember)
+		res, _ := v.(*mailinglist.GroupsioProjectSummary)
 		enc := encoder(ctx, w)
-		body := NewGetGroupsioMemberResponseBody(res)
+		body := NewGetGroupsioProjectSummaryResponseBody(res)
 		w.WriteHeader(http.StatusOK)
 		return enc.Encode(body)
 	}
 }
 
-// DecodeGetGroupsioMemberRequest returns a decoder for requests sent to the
-// mailing-list get-groupsio-member endpoint.
-func DecodeGetGroupsioMemberRequest(mux goahttp.Muxer, decoder func(*http.Request) goahttp.Decoder) func(*http.Request) (any, error) {
+// DecodeGetGroupsioProjectSummaryRequest returns a decoder for requests sent
+// to the mailing-list get-groupsio-project-summary endpoint.
+func DecodeGetGroupsioProjectSummaryRequest(mux goahttp.Muxer, decoder func(*http.Request) goahttp.Decoder) func(*http.Request) (any, error) {
 	return func(r *http.Request) (any, error) {
 		var (
-			subgroupID  string
-			memberID    string
+			projectUID  string
 			bearerToken *string
+			err         error
 
 			params = mux.Vars(r)
 		)
-		subgroupID = params["subgroup_id"]
-		memberID = params["member_id"]
+		projectUID = params["project_uid"]
+		err = goa.MergeErrors(err, goa.ValidateFormat("project_uid", projectUID, goa.FormatUUID))
 		bearerTokenRaw := r.Header.Get("Authorization")
 		if bearerTokenRaw != "" {
 			bearerToken = &bearerTokenRaw
 		}
-		payload := NewGetGroupsioMemberPayload(subgroupID, memberID, bearerToken)
+		if err != nil {
+			return nil, err
+		}
+		payload := NewGetGroupsioProjectSummaryPayload(projectUID, bearerToken)
 		if payload.BearerToken != nil {
 			if strings.Contains(*payload.BearerToken, " ") {
 				// Remove authorization scheme prefix (e.g. "Bearer")
@@ -1836,9 +4263,9 @@ func DecodeGetGroupsioMemberRequest(mux goahttp.Muxer, decoder func(*http.Reques
 	}
 }
 
-// EncodeGetGroupsioMemberError returns an encoder for errors returned by the
-// get-groupsio-member mailing-list endpoint.
-func EncodeGetGroupsioMemberError(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder, formatter func(ctx context.Context, err error) goahttp.Statuser) func(context.Context, http.ResponseWriter, error) error {
+// EncodeGetGroupsioProjectSummaryError returns an encoder for errors returned
+// by the get-groupsio-project-summary mailing-list endpoint.
+func EncodeGetGroupsioProjectSummaryError(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder, formatter func(ctx context.Context, err error) goahttp.Statuser) func(context.Context, http.ResponseWriter, error) error {
 	encodeError := goahttp.ErrorEncoder(encoder, formatter)
 	return func(ctx context.Context, w http.ResponseWriter, v error) error {
 		var en goa.GoaErrorNamer
@@ -1846,31 +4273,31 @@ func EncodeGetGroupsioMemberError(encoder func(context.Context, http.ResponseWri
 			return encodeError(ctx, w, v)
 		}
 		switch en.GoaErrorName() {
-		case "InternalServerError":
-			var res *mailinglist.InternalServerError
+		case "BadRequest":
+			var res *mailinglist.BadRequestError
 			errors.As(v, &res)
 			enc := encoder(ctx, w)
 			var body any
 			if formatter != nil {
 				body = formatter(ctx, res)
 			} else {
-				body = NewGetGroupsioMemberInternalServerErrorResponseBody(res)
+				body = NewGetGroupsioProjectSummaryBadRequestResponseBody(res)
 			}
 			w.Header().Set("goa-error", res.GoaErrorName())
-			w.WriteHeader(http.StatusInternalServerError)
+			w.WriteHeader(http.StatusBadRequest)
 			return enc.Encode(body)
-		case "NotFound":
-			var res *mailinglist.NotFoundError
+		case "InternalServerError":
+			var res *mailinglist.InternalServerError
 			errors.As(v, &res)
 			enc := encoder(ctx, w)
 			var body any
 			if formatter != nil {
 				body = formatter(ctx, res)
 			} else {
-				body = NewGetGroupsioMemberNotFoundResponseBody(res)
+				body = NewGetGroupsioProjectSummaryInternalServerErrorResponseBody(res)
 			}
 			w.Header().Set("goa-error", res.GoaErrorName())
-			w.WriteHeader(http.StatusNotFound)
+			w.WriteHeader(http.StatusInternalServerError)
 			return enc.Encode(body)
 		case "ServiceUnavailable":
 			var res *mailinglist.ServiceUnavailableError
@@ -1880,7 +4307,7 @@ func EncodeGetGroupsioMemberError(encoder func(context.Context, http.ResponseWri
 			if formatter != nil {
 				body = formatter(ctx, res)
 			} else {
-				body = NewGetGroupsioMemberServiceUnavailableResponseBody(res)
+				body = NewGetGroupsioProjectSummaryServiceUnavailableResponseBody(res)
 			}
 			w.Header().Set("goa-error", res.GoaErrorName())
 			w.WriteHeader(http.StatusServiceUnavailable)
@@ -1891,56 +4318,40 @@ func EncodeGetGroupsioMemberError(encoder func(context.Context, http.ResponseWri
 	}
 }
 
-// EncodeUpdateGroupsioMemberResponse returns an encoder for responses returned
-// by the mailing-list update-groupsio-member endpoint.
-func EncodeUpdateGroupsioMemberResponse(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder) func(context.Context, http.ResponseWriter, any) error {
+// EncodeListGroupsioOrphanedMailingListsResponse returns an encoder for
+// responses returned by the mailing-list list-groupsio-orphaned-mailing-lists
+// endpoint.
+func EncodeListGroupsioOrphanedMailingListsResponse(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder) func(context.Context, http.ResponseWriter, any) error {
 	return func(ctx context.Context, w http.ResponseWriter, v any) error {
-		res, _ := v.(*mailinglist.GroupsioMember)
+		res, _ := v.(*mailinglist.GroupsioSubgroupList)
 		enc := encoder(ctx, w)
-		body := NewUpdateGroupsioMemberResponseBody(res)
+		body := NewListGroupsioOrphanedMailingListsResponseBody(res)
 		w.WriteHeader(http.StatusOK)
 		return enc.Encode(body)
 	}
 }
 
-// DecodeUpdateGroupsioMemberRequest returns a decoder for requests sent to the
-// mailing-list update-groupsio-member endpoint.
-func DecodeUpdateGroupsioMemberRequest(mux goahttp.Muxer, decoder func(*http.Request) goahttp.Decoder) func(*http.Request) (any, error) {
+// DecodeListGroupsioOrphanedMailingListsRequest returns a decoder for requests
+// sent to the mailing-list list-groupsio-orphaned-mailing-lists endpoint.
+func DecodeListGroupsioOrphanedMailingListsRequest(mux goahttp.Muxer, decoder func(*http.Request) goahttp.Decoder) func(*http.Request) (any, error) {
 	return func(r *http.Request) (any, error) {
 		var (
-			body UpdateGroupsioMemberRequestBody
-			err  error
-		)
-		err = decoder(r).Decode(&body)
-		if err != nil {
-			if errors.Is(err, io.EOF) {
-				return nil, goa.MissingPayloadError()
-			}
-			var gerr *goa.ServiceError
-			if errors.As(err, &gerr) {
-				return nil, gerr
-			}
-			return nil, goa.DecodePayloadError(err.Error())
-		}
-		err = ValidateUpdateGroupsioMemberRequestBody(&body)
-		if err != nil {
-			return nil, err
-		}
-
-		var (
-			subgroupID  string
-			memberID    string
+			projectUID  string
 			bearerToken *string
+			err         error
 
 			params = mux.Vars(r)
 		)
-		subgroupID = params["subgroup_id"]
-		memberID = params["member_id"]
+		projectUID = params["project_uid"]
+		err = goa.MergeErrors(err, goa.ValidateFormat("project_uid", projectUID, goa.FormatUUID))
 		bearerTokenRaw := r.Header.Get("Authorization")
 		if bearerTokenRaw != "" {
 			bearerToken = &bearerTokenRaw
 		}
-		payload := NewUpdateGroupsioMemberPayload(&body, subgroupID, memberID, bearerToken)
+		if err != nil {
+			return nil, err
+		}
+		payload := NewListGroupsioOrphanedMailingListsPayload(projectUID, bearerToken)
 		if payload.BearerToken != nil {
 			if strings.Contains(*payload.BearerToken, " ") {
 				// Remove authorization scheme prefix (e.g. "Bearer")
@@ -1953,9 +4364,9 @@ func DecodeUpdateGroupsioMemberRequest(mux goahttp.Muxer, decoder func(*http.Req
 	}
 }
 
-// EncodeUpdateGroupsioMemberError returns an encoder for errors returned by
-// the update-groupsio-member mailing-list endpoint.
-func EncodeUpdateGroupsioMemberError(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder, formatter func(ctx context.Context, err error) goahttp.Statuser) func(context.Context, http.ResponseWriter, error) error {
+// EncodeListGroupsioOrphanedMailingListsError returns an encoder for errors
+// returned by the list-groupsio-orphaned-mailing-lists mailing-list endpoint.
+func EncodeListGroupsioOrphanedMailingListsError(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder, formatter func(ctx context.Context, err error) goahttp.Statuser) func(context.Context, http.ResponseWriter, error) error {
 	encodeError := goahttp.ErrorEncoder(encoder, formatter)
 	return func(ctx context.Context, w http.ResponseWriter, v error) error {
 		var en goa.GoaErrorNamer
@@ -1971,7 +4382,7 @@ func EncodeUpdateGroupsioMemberError(encoder func(context.Context, http.Response
 			if formatter != nil {
 				body = formatter(ctx, res)
 			} else {
-				body = NewUpdateGroupsioMemberBadRequestResponseBody(res)
+				body = NewListGroupsioOrphanedMailingListsBadRequestResponseBody(res)
 			}
 			w.Header().Set("goa-error", res.GoaErrorName())
 			w.WriteHeader(http.StatusBadRequest)
@@ -1984,24 +4395,11 @@ func EncodeUpdateGroupsioMemberError(encoder func(context.Context, http.Response
 			if formatter != nil {
 				body = formatter(ctx, res)
 			} else {
-				body = NewUpdateGroupsioMemberInternalServerErrorResponseBody(res)
+				body = NewListGroupsioOrphanedMailingListsInternalServerErrorResponseBody(res)
 			}
 			w.Header().Set("goa-error", res.GoaErrorName())
 			w.WriteHeader(http.StatusInternalServerError)
 			return enc.Encode(body)
-		case "NotFound":
-			var res *mailinglist.NotFoundError
-			errors.As(v, &res)
-			enc := encoder(ctx, w)
-			var body any
-			if formatter != nil {
-				body = formatter(ctx, res)
-			} else {
-				body = NewUpdateGroupsioMemberNotFoundResponseBody(res)
-			}
-			w.Header().Set("goa-error", res.GoaErrorName())
-			w.WriteHeader(http.StatusNotFound)
-			return enc.Encode(body)
 		case "ServiceUnavailable":
 			var res *mailinglist.ServiceUnavailableError
 			errors.As(v, &res)
@@ -2010,7 +4408,7 @@ func EncodeUpdateGroupsioMemberError(encoder func(context.Context, http.Response
 			if formatter != nil {
 				body = formatter(ctx, res)
 			} else {
-				body = NewUpdateGroupsioMemberServiceUnavailableResponseBody(res)
+				body = NewListGroupsioOrphanedMailingListsServiceUnavailableResponseBody(res)
 			}
 			w.Header().Set("goa-error", res.GoaErrorName())
 			w.WriteHeader(http.StatusServiceUnavailable)
@@ -2021,33 +4419,39 @@ func EncodeUpdateGroupsioMemberError(encoder func(context.Context, http.Response
 	}
 }
 
-// EncodeDeleteGroupsioMemberResponse returns an encoder for responses returned
-// by the mailing-list delete-groupsio-member endpoint.
-func EncodeDeleteGroupsioMemberResponse(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder) func(context.Context, http.ResponseWriter, any) error {
+// EncodeRepublishGroupsioProjectIndexResponse returns an encoder for responses
+// returned by the mailing-list republish-groupsio-project-index endpoint.
+func EncodeRepublishGroupsioProjectIndexResponse(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder) func(context.Context, http.ResponseWriter, any) error {
 	return func(ctx context.Context, w http.ResponseWriter, v any) error {
-		w.WriteHeader(http.StatusNoContent)
-		return nil
+		res, _ := v.(*mailinglist.GroupsioRepublishSummary)
+		enc := encoder(ctx, w)
+		body := NewRepublishGroupsioProjectIndexResponseBody(res)
+		w.WriteHeader(http.StatusOK)
+		return enc.Encode(body)
 	}
 }
 
-// DecodeDeleteGroupsioMemberRequest returns a decoder for requests sent to the
-// mailing-list delete-groupsio-member endpoint.
-func DecodeDeleteGroupsioMemberRequest(mux goahttp.Muxer, decoder func(*http.Request) goahttp.Decoder) func(*http.Request) (any, error) {
+// DecodeRepublishGroupsioProjectIndexRequest returns a decoder for requests
+// sent to the mailing-list republish-groupsio-project-index endpoint.
+func DecodeRepublishGroupsioProjectIndexRequest(mux goahttp.Muxer, decoder func(*http.Request) goahttp.Decoder) func(*http.Request) (any, error) {
 	return func(r *http.Request) (any, error) {
 		var (
-			subgroupID  string
-			memberID    string
+			projectUID  string
 			bearerToken *string
+			err         error
 
 			params = mux.Vars(r)
 		)
-		subgroupID = params["subgroup_id"]
-		memberID = params["member_id"]
+		projectUID = params["project_uid"]
+		err = goa.MergeErrors(err, goa.ValidateFormat("project_uid", projectUID, goa.FormatUUID))
 		bearerTokenRaw := r.Header.Get("Authorization")
 		if bearerTokenRaw != "" {
 			bearerToken = &bearerTokenRaw
 		}
-		payload := NewDeleteGroupsioMemberPayload(subgroupID, memberID, bearerToken)
+		if err != nil {
+			return nil, err
+		}
+		payload := NewRepublishGroupsioProjectIndexPayload(projectUID, bearerToken)
 		if payload.BearerToken != nil {
 			if strings.Contains(*payload.BearerToken, " ") {
 				// Remove authorization scheme prefix (e.g. "Bearer")
@@ -2060,9 +4464,9 @@ func DecodeDeleteGroupsioMemberRequest(mux goahttp.Muxer, decoder func(*http.Req
 	}
 }
 
-// EncodeDeleteGroupsioMemberError returns an encoder for errors returned by
-// the delete-groupsio-member mailing-list endpoint.
-func EncodeDeleteGroupsioMemberError(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder, formatter func(ctx context.Context, err error) goahttp.Statuser) func(context.Context, http.ResponseWriter, error) error {
+// EncodeRepublishGroupsioProjectIndexError returns an encoder for errors
+// returned by the republish-groupsio-project-index mailing-list endpoint.
+func EncodeRepublishGroupsioProjectIndexError(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder, formatter func(ctx context.Context, err error) goahttp.Statuser) func(context.Context, http.ResponseWriter, error) error {
 	encodeError := goahttp.ErrorEncoder(encoder, formatter)
 	return func(ctx context.Context, w http.ResponseWriter, v error) error {
 		var en goa.GoaErrorNamer
@@ -2070,31 +4474,31 @@ func EncodeDeleteGroupsioMemberError(encoder func(context.Context, http.Response
 			return encodeError(ctx, w, v)
 		}
 		switch en.GoaErrorName() {
-		case "InternalServerError":
-			var res *mailinglist.InternalServerError
+		case "BadRequest":
+			var res *mailinglist.BadRequestError
 			errors.As(v, &res)
 			enc := encoder(ctx, w)
 			var body any
 			if formatter != nil {
 				body = formatter(ctx, res)
 			} else {
-				body = NewDeleteGroupsioMemberInternalServerErrorResponseBody(res)
+				body = NewRepublishGroupsioProjectIndexBadRequestResponseBody(res)
 			}
 			w.Header().Set("goa-error", res.GoaErrorName())
-			w.WriteHeader(http.StatusInternalServerError)
+			w.WriteHeader(http.StatusBadRequest)
 			return enc.Encode(body)
-		case "NotFound":
-			var res *mailinglist.NotFoundError
+		case "InternalServerError":
+			var res *mailinglist.InternalServerError
 			errors.As(v, &res)
 			enc := encoder(ctx, w)
 			var body any
 			if formatter != nil {
 				body = formatter(ctx, res)
 			} else {
-				body = NewDeleteGroupsioMemberNotFoundResponseBody(res)
+				body = NewRepublishGroupsioProjectIndexInternalServerErrorResponseBody(res)
 			}
 			w.Header().Set("goa-error", res.GoaErrorName())
-			w.WriteHeader(http.StatusNotFound)
+			w.WriteHeader(http.StatusInternalServerError)
 			return enc.Encode(body)
 		case "ServiceUnavailable":
 			var res *mailinglist.ServiceUnavailableError
@@ -2104,7 +4508,7 @@ func EncodeDeleteGroupsioMemberError(encoder func(context.Context, http.Response
 			if formatter != nil {
 				body = formatter(ctx, res)
 			} else {
-				body = NewDeleteGroupsioMemberServiceUnavailableResponseBody(res)
+				body = NewRepublishGroupsioProjectIndexServiceUnavailableResponseBody(res)
 			}
 			w.Header().Set("goa-error", res.GoaErrorName())
 			w.WriteHeader(http.StatusServiceUnavailable)
@@ -2115,39 +4519,22 @@ func EncodeDeleteGroupsioMemberError(encoder func(context.Context, http.Response
 	}
 }
 
-// EncodeInviteGroupsioMembersResponse returns an encoder for responses
-// returned by the mailing-list invite-groupsio-members endpoint.
-func EncodeInviteGroupsioMembersResponse(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder) func(context.Context, http.ResponseWriter, any) error {
+// EncodeGetGroupsioMailingListStatsResponse returns an encoder for responses
+// returned by the mailing-list get-groupsio-mailing-list-stats endpoint.
+func EncodeGetGroupsioMailingListStatsResponse(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder) func(context.Context, http.ResponseWriter, any) error {
 	return func(ctx context.Context, w http.ResponseWriter, v any) error {
-		w.WriteHeader(http.StatusNoContent)
-		return nil
+		res, _ := v.(*mailinglist.GroupsioSubgroupStats)
+		enc := encoder(ctx, w)
+		body := NewGetGroupsioMailingListStatsResponseBody(res)
+		w.WriteHeader(http.StatusOK)
+		return enc.Encode(body)
 	}
 }
 
-// DecodeInviteGroupsioMembersRequest returns a decoder for requests sent to
-// the mailing-list invite-groupsio-members endpoint.
-func DecodeInviteGroupsioMembersRequest(mux goahttp.Muxer, decoder func(*http.Request) goahttp.Decoder) func(*http.Request) (any, error) {
+// DecodeGetGroupsioMailingListStatsRequest returns a decoder for requests sent
+// to the mailing-list get-groupsio-mailing-list-stats endpoint.
+func DecodeGetGroupsioMailingListStatsRequest(mux goahttp.Muxer, decoder func(*http.Request) goahttp.Decoder) func(*http.Request) (any, error) {
 	return func(r *http.Request) (any, error) {
-		var (
-			body InviteGroupsioMembersRequestBody
-			err  error
-		)
-		err = decoder(r).Decode(&body)
-		if err != nil {
-			if errors.Is(err, io.EOF) {
-				return nil, goa.MissingPayloadError()
-			}
-			var gerr *goa.ServiceError
-			if errors.As(err, &gerr) {
-				return nil, gerr
-			}
-			return nil, goa.DecodePayloadError(err.Error())
-		}
-		err = ValidateInviteGroupsioMembersRequestBody(&body)
-		if err != nil {
-			return nil, err
-		}
-
 		var (
 			subgroupID  string
 			bearerToken *string
@@ -2159,7 +4546,7 @@ func DecodeInviteGroupsioMembersRequest(mux goahttp.Muxer, decoder func(*http.Re
 		if bearerTokenRaw != "" {
 			bearerToken = &bearerTokenRaw
 		}
-		payload := NewInviteGroupsioMembersPayload(&body, subgroupID, bearerToken)
+		payload := NewGetGroupsioMailingListStatsPayload(subgroupID, bearerToken)
 		if payload.BearerToken != nil {
 			if strings.Contains(*payload.BearerToken, " ") {
 				// Remove authorization scheme prefix (e.g. "Bearer")
@@ -2172,9 +4559,9 @@ func DecodeInviteGroupsioMembersRequest(mux goahttp.Muxer, decoder func(*http.Re
 	}
 }
 
-// EncodeInviteGroupsioMembersError returns an encoder for errors returned by
-// the invite-groupsio-members mailing-list endpoint.
-func EncodeInviteGroupsioMembersError(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder, formatter func(ctx context.Context, err error) goahttp.Statuser) func(context.Context, http.ResponseWriter, error) error {
+// EncodeGetGroupsioMailingListStatsError returns an encoder for errors
+// returned by the get-groupsio-mailing-list-stats mailing-list endpoint.
+func EncodeGetGroupsioMailingListStatsError(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder, formatter func(ctx context.Context, err error) goahttp.Statuser) func(context.Context, http.ResponseWriter, error) error {
 	encodeError := goahttp.ErrorEncoder(encoder, formatter)
 	return func(ctx context.Context, w http.ResponseWriter, v error) error {
 		var en goa.GoaErrorNamer
@@ -2182,19 +4569,6 @@ func EncodeInviteGroupsioMembersError(encoder func(context.Context, http.Respons
 			return encodeError(ctx, w, v)
 		}
 		switch en.GoaErrorName() {
-		case "BadRequest":
-			var res *mailinglist.BadRequestError
-			errors.As(v, &res)
-			enc := encoder(ctx, w)
-			var body any
-			if formatter != nil {
-				body = formatter(ctx, res)
-			} else {
-				body = NewInviteGroupsioMembersBadRequestResponseBody(res)
-			}
-			w.Header().Set("goa-error", res.GoaErrorName())
-			w.WriteHeader(http.StatusBadRequest)
-			return enc.Encode(body)
 		case "InternalServerError":
 			var res *mailinglist.InternalServerError
 			errors.As(v, &res)
@@ -2203,7 +4577,7 @@ func EncodeInviteGroupsioMembersError(encoder func(context.Context, http.Respons
 			if formatter != nil {
 				body = formatter(ctx, res)
 			} else {
-				body = NewInviteGroupsioMembersInternalServerErrorResponseBody(res)
+				body = NewGetGroupsioMailingListStatsInternalServerErrorResponseBody(res)
 			}
 			w.Header().Set("goa-error", res.GoaErrorName())
 			w.WriteHeader(http.StatusInternalServerError)
@@ -2216,7 +4590,7 @@ func EncodeInviteGroupsioMembersError(encoder func(context.Context, http.Respons
 			if formatter != nil {
 				body = formatter(ctx, res)
 			} else {
-				body = NewInviteGroupsioMembersNotFoundResponseBody(res)
+				body = NewGetGroupsioMailingListStatsNotFoundResponseBody(res)
 			}
 			w.Header().Set("goa-error", res.GoaErrorName())
 			w.WriteHeader(http.StatusNotFound)
@@ -2229,7 +4603,7 @@ func EncodeInviteGroupsioMembersError(encoder func(context.Context, http.Respons
 			if formatter != nil {
 				body = formatter(ctx, res)
 			} else {
-				body = NewInviteGroupsioMembersServiceUnavailableResponseBody(res)
+				body = NewGetGroupsioMailingListStatsServiceUnavailableResponseBody(res)
 			}
 			w.Header().Set("goa-error", res.GoaErrorName())
 			w.WriteHeader(http.StatusServiceUnavailable)
@@ -2240,50 +4614,49 @@ func EncodeInviteGroupsioMembersError(encoder func(context.Context, http.Respons
 	}
 }
 
-// EncodeCheckGroupsioSubscriberResponse returns an encoder for responses
-// returned by the mailing-list check-groupsio-subscriber endpoint.
-func EncodeCheckGroupsioSubscriberResponse(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder) func(context.Context, http.ResponseWriter, any) error {
+// EncodeGetGroupsioServiceTreeResponse returns an encoder for responses
+// returned by the mailing-list get-groupsio-service-tree endpoint.
+func EncodeGetGroupsioServiceTreeResponse(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder) func(context.Context, http.ResponseWriter, any) error {
 	return func(ctx context.Context, w http.ResponseWriter, v any) error {
-		res, _ := v.(*mailinglist.GroupsioCheckSubscriberResponse)
+		res, _ := v.(*mailinglist.GroupsioServiceTree)
 		enc := encoder(ctx, w)
-		body := NewCheckGroupsioSubscriberResponseBody(res)
+		body := NewGetGroupsioServiceTreeResponseBody(res)
 		w.WriteHeader(http.StatusOK)
 		return enc.Encode(body)
 	}
 }
 
-// DecodeCheckGroupsioSubscriberRequest returns a decoder for requests sent to
-// the mailing-list check-groupsio-subscriber endpoint.
-func DecodeCheckGroupsioSubscriberRequest(mux goahttp.Muxer, decoder func(*http.Request) goahttp.Decoder) func(*http.Request) (any, error) {
+// DecodeGetGroupsioServiceTreeRequest returns a decoder for requests sent to
+// the mailing-list get-groupsio-service-tree endpoint.
+func DecodeGetGroupsioServiceTreeRequest(mux goahttp.Muxer, decoder func(*http.Request) goahttp.Decoder) func(*http.Request) (any, error) {
 	return func(r *http.Request) (any, error) {
 		var (
-			body CheckGroupsioSubscriberRequestBody
-			err  error
+			serviceID           string
+			includeMemberCounts bool
+			bearerToken         *string
+			err                 error
+
+			params = mux.Vars(r)
 		)
-		err = decoder(r).Decode(&body)
-		if err != nil {
-			if errors.Is(err, io.EOF) {
-				return nil, goa.MissingPayloadError()
-			}
-			var gerr *goa.ServiceError
-			if errors.As(err, &gerr) {
-				return nil, gerr
+		serviceID = params["service_id"]
+		{
+			includeMemberCountsRaw := r.URL.Query().Get("include_member_counts")
+			if includeMemberCountsRaw != "" {
+				v, err2 := strconv.ParseBool(includeMemberCountsRaw)
+				if err2 != nil {
+					err = goa.MergeErrors(err, goa.InvalidFieldTypeError("include_member_counts", includeMemberCountsRaw, "boolean"))
+				}
+				includeMemberCounts = v
 			}
-			return nil, goa.DecodePayloadError(err.Error())
-		}
-		err = ValidateCheckGroupsioSubscriberRequestBody(&body)
-		if err != nil {
-			return nil, err
 		}
-
-		var (
-			bearerToken *string
-		)
 		bearerTokenRaw := r.Header.Get("Authorization")
 		if bearerTokenRaw != "" {
 			bearerToken = &bearerTokenRaw
 		}
-		payload := NewCheckGroupsioSubscriberPayload(&body, bearerToken)
+		if err != nil {
+			return nil, err
+		}
+		payload := NewGetGroupsioServiceTreePayload(serviceID, includeMemberCounts, bearerToken)
 		if payload.BearerToken != nil {
 			if strings.Contains(*payload.BearerToken, " ") {
 				// Remove authorization scheme prefix (e.g. "Bearer")
@@ -2296,9 +4669,9 @@ func DecodeCheckGroupsioSubscriberRequest(mux goahttp.Muxer, decoder func(*http.
 	}
 }
 
-// EncodeCheckGroupsioSubscriberError returns an encoder for errors returned by
-// the check-groupsio-subscriber mailing-list endpoint.
-func EncodeCheckGroupsioSubscriberError(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder, formatter func(ctx context.Context, err error) goahttp.Statuser) func(context.Context, http.ResponseWriter, error) error {
+// EncodeGetGroupsioServiceTreeError returns an encoder for errors returned by
+// the get-groupsio-service-tree mailing-list endpoint.
+func EncodeGetGroupsioServiceTreeError(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder, formatter func(ctx context.Context, err error) goahttp.Statuser) func(context.Context, http.ResponseWriter, error) error {
 	encodeError := goahttp.ErrorEncoder(encoder, formatter)
 	return func(ctx context.Context, w http.ResponseWriter, v error) error {
 		var en goa.GoaErrorNamer
@@ -2306,31 +4679,31 @@ func EncodeCheckGroupsioSubscriberError(encoder func(context.Context, http.Respo
 			return encodeError(ctx, w, v)
 		}
 		switch en.GoaErrorName() {
-		case "BadRequest":
-			var res *mailinglist.BadRequestError
+		case "InternalServerError":
+			var res *mailinglist.InternalServerError
 			errors.As(v, &res)
 			enc := encoder(ctx, w)
 			var body any
 			if formatter != nil {
 				body = formatter(ctx, res)
 			} else {
-				body = NewCheckGroupsioSubscriberBadRequestResponseBody(res)
+				body = NewGetGroupsioServiceTreeInternalServerErrorResponseBody(res)
 			}
 			w.Header().Set("goa-error", res.GoaErrorName())
-			w.WriteHeader(http.StatusBadRequest)
+			w.WriteHeader(http.StatusInternalServerError)
 			return enc.Encode(body)
-		case "InternalServerError":
-			var res *mailinglist.InternalServerError
+		case "NotFound":
+			var res *mailinglist.NotFoundError
 			errors.As(v, &res)
 			enc := encoder(ctx, w)
 			var body any
 			if formatter != nil {
 				body = formatter(ctx, res)
 			} else {
-				body = NewCheckGroupsioSubscriberInternalServerErrorResponseBody(res)
+				body = NewGetGroupsioServiceTreeNotFoundResponseBody(res)
 			}
 			w.Header().Set("goa-error", res.GoaErrorName())
-			w.WriteHeader(http.StatusInternalServerError)
+			w.WriteHeader(http.StatusNotFound)
 			return enc.Encode(body)
 		case "ServiceUnavailable":
 			var res *mailinglist.ServiceUnavailableError
@@ -2340,7 +4713,7 @@ func EncodeCheckGroupsioSubscriberError(encoder func(context.Context, http.Respo
 			if formatter != nil {
 				body = formatter(ctx, res)
 			} else {
-				body = NewCheckGroupsioSubscriberServiceUnavailableResponseBody(res)
+				body = NewGetGroupsioServiceTreeServiceUnavailableResponseBody(res)
 			}
 			w.Header().Set("goa-error", res.GoaErrorName())
 			w.WriteHeader(http.StatusServiceUnavailable)
@@ -2351,36 +4724,36 @@ func EncodeCheckGroupsioSubscriberError(encoder func(context.Context, http.Respo
 	}
 }
 
-// EncodeGetGroupsioArtifactResponse returns an encoder for responses returned
-// by the mailing-list get-groupsio-artifact endpoint.
-func EncodeGetGroupsioArtifactResponse(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder) func(context.Context, http.ResponseWriter, any) error {
+// EncodeGetGroupsioMemberHierarchyResponse returns an encoder for responses
+// returned by the mailing-list get-groupsio-member-hierarchy endpoint.
+func EncodeGetGroupsioMemberHierarchyResponse(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder) func(context.Context, http.ResponseWriter, any) error {
 	return func(ctx context.Context, w http.ResponseWriter, v any) error {
-		res, _ := v.(*mailinglist.GroupsioArtifact)
+		res, _ := v.(*mailinglist.GroupsioMemberHierarchy)
 		enc := encoder(ctx, w)
-		body := NewGetGroupsioArtifactResponseBody(res)
+		body := NewGetGroupsioMemberHierarchyResponseBody(res)
 		w.WriteHeader(http.StatusOK)
 		return enc.Encode(body)
 	}
 }
 
-// DecodeGetGroupsioArtifactRequest returns a decoder for requests sent to the
-// mailing-list get-groupsio-artifact endpoint.
-func DecodeGetGroupsioArtifactRequest(mux goahttp.Muxer, decoder func(*http.Request) goahttp.Decoder) func(*http.Request) (any, error) {
+// DecodeGetGroupsioMemberHierarchyRequest returns a decoder for requests sent
+// to the mailing-list get-groupsio-member-hierarchy endpoint.
+func DecodeGetGroupsioMemberHierarchyRequest(mux goahttp.Muxer, decoder func(*http.Request) goahttp.Decoder) func(*http.Request) (any, error) {
 	return func(r *http.Request) (any, error) {
 		var (
 			subgroupID  string
-			artifactID  string
+			memberID    string
 			bearerToken *string
 
 			params = mux.Vars(r)
 		)
 		subgroupID = params["subgroup_id"]
-		artifactID = params["artifact_id"]
+		memberID = params["member_id"]
 		bearerTokenRaw := r.Header.Get("Authorization")
 		if bearerTokenRaw != "" {
 			bearerToken = &bearerTokenRaw
 		}
-		payload := NewGetGroupsioArtifactPayload(subgroupID, artifactID, bearerToken)
+		payload := NewGetGroupsioMemberHierarchyPayload(subgroupID, memberID, bearerToken)
 		if payload.BearerToken != nil {
 			if strings.Contains(*payload.BearerToken, " ") {
 				// Remove authorization scheme prefix (e.g. "Bearer")
@@ -2393,9 +4766,9 @@ func DecodeGetGroupsioArtifactRequest(mux goahttp.Muxer, decoder func(*http.Requ
 	}
 }
 
-// EncodeGetGroupsioArtifactError returns an encoder for errors returned by the
-// get-groupsio-artifact mailing-list endpoint.
-func EncodeGetGroupsioArtifactError(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder, formatter func(ctx context.Context, err error) goahttp.Statuser) func(context.Context, http.ResponseWriter, error) error {
+// EncodeGetGroupsioMemberHierarchyError returns an encoder for errors returned
+// by the get-groupsio-member-hierarchy mailing-list endpoint.
+func EncodeGetGroupsioMemberHierarchyError(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder, formatter func(ctx context.Context, err error) goahttp.Statuser) func(context.Context, http.ResponseWriter, error) error {
 	encodeError := goahttp.ErrorEncoder(encoder, formatter)
 	return func(ctx context.Context, w http.ResponseWriter, v error) error {
 		var en goa.GoaErrorNamer
@@ -2411,7 +4784,7 @@ func EncodeGetGroupsioArtifactError(encoder func(context.Context, http.ResponseW
 			if formatter != nil {
 				body = formatter(ctx, res)
 			} else {
-				body = NewGetGroupsioArtifactInternalServerErrorResponseBody(res)
+				body = NewGetGroupsioMemberHierarchyInternalServerErrorResponseBody(res)
 			}
 			w.Header().Set("goa-error", res.GoaErrorName())
 			w.WriteHeader(http.StatusInternalServerError)
@@ -2424,7 +4797,7 @@ func EncodeGetGroupsioArtifactError(encoder func(context.Context, http.ResponseW
 			if formatter != nil {
 				body = formatter(ctx, res)
 			} else {
-				body = NewGetGroupsioArtifactNotFoundResponseBody(res)
+				body = NewGetGroupsioMemberHierarchyNotFoundResponseBody(res)
 			}
 			w.Header().Set("goa-error", res.GoaErrorName())
 			w.WriteHeader(http.StatusNotFound)
@@ -2437,7 +4810,7 @@ func EncodeGetGroupsioArtifactError(encoder func(context.Context, http.ResponseW
 			if formatter != nil {
 				body = formatter(ctx, res)
 			} else {
-				body = NewGetGroupsioArtifactServiceUnavailableResponseBody(res)
+				body = NewGetGroupsioMemberHierarchyServiceUnavailableResponseBody(res)
 			}
 			w.Header().Set("goa-error", res.GoaErrorName())
 			w.WriteHeader(http.StatusServiceUnavailable)
@@ -2448,36 +4821,70 @@ func EncodeGetGroupsioArtifactError(encoder func(context.Context, http.ResponseW
 	}
 }
 
-// EncodeGetGroupsioArtifactDownloadResponse returns an encoder for responses
-// returned by the mailing-list get-groupsio-artifact-download endpoint.
-func EncodeGetGroupsioArtifactDownloadResponse(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder) func(context.Context, http.ResponseWriter, any) error {
+// EncodeListGroupsioProjectMembersResponse returns an encoder for responses
+// returned by the mailing-list list-groupsio-project-members endpoint.
+func EncodeListGroupsioProjectMembersResponse(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder) func(context.Context, http.ResponseWriter, any) error {
 	return func(ctx context.Context, w http.ResponseWriter, v any) error {
-		res, _ := v.(*mailinglist.GroupsioArtifactDownload)
+		res, _ := v.(*mailinglist.GroupsioMemberPage)
 		enc := encoder(ctx, w)
-		body := NewGetGroupsioArtifactDownloadResponseBody(res)
+		body := NewListGroupsioProjectMembersResponseBody(res)
 		w.WriteHeader(http.StatusOK)
 		return enc.Encode(body)
 	}
 }
 
-// DecodeGetGroupsioArtifactDownloadRequest returns a decoder for requests sent
-// to the mailing-list get-groupsio-artifact-download endpoint.
-func DecodeGetGroupsioArtifactDownloadRequest(mux goahttp.Muxer, decoder func(*http.Request) goahttp.Decoder) func(*http.Request) (any, error) {
+// DecodeListGroupsioProjectMembersRequest returns a decoder for requests sent
+// to the mailing-list list-groupsio-project-members endpoint.
+func DecodeListGroupsioProjectMembersRequest(mux goahttp.Muxer, decoder func(*http.Request) goahttp.Decoder) func(*http.Request) (any, error) {
 	return func(r *http.Request) (any, error) {
 		var (
-			subgroupID  string
-			artifactID  string
+			projectUID  string
+			limit       int
+			cursor      *string
+			dedupe      bool
 			bearerToken *string
+			err         error
 
 			params = mux.Vars(r)
 		)
-		subgroupID = params["subgroup_id"]
-		artifactID = params["artifact_id"]
+		projectUID = params["project_uid"]
+		err = goa.MergeErrors(err, goa.ValidateFormat("project_uid", projectUID, goa.FormatUUID))
+		qp := r.URL.Query()
+		{
+			limitRaw := qp.Get("limit")
+			if limitRaw != "" {
+				v, err2 := strconv.ParseInt(limitRaw, 10, strconv.IntSize)
+				if err2 != nil {
+					err = goa.MergeErrors(err, goa.InvalidFieldTypeError("limit", limitRaw, "integer"))
+				}
+				limit = int(v)
+			}
+		}
+		if limit < 0 {
+			err = goa.MergeErrors(err, goa.InvalidRangeError("limit", limit, 0, true))
+		}
+		cursorRaw := qp.Get("cursor")
+		if cursorRaw != "" {
+			cursor = &cursorRaw
+		}
+		{
+			dedupeRaw := qp.Get("dedupe")
+			if dedupeRaw != "" {
+				v, err2 := strconv.ParseBool(dedupeRaw)
+				if err2 != nil {
+					err = goa.MergeErrors(err, goa.InvalidFieldTypeError("dedupe", dedupeRaw, "boolean"))
+				}
+				dedupe = v
+			}
+		}
 		bearerTokenRaw := r.Header.Get("Authorization")
 		if bearerTokenRaw != "" {
 			bearerToken = &bearerTokenRaw
 		}
-		payload := NewGetGroupsioArtifactDownloadPayload(subgroupID, artifactID, bearerToken)
+		if err != nil {
+			return nil, err
+		}
+		payload := NewListGroupsioProjectMembersPayload(projectUID, limit, cursor, dedupe, bearerToken)
 		if payload.BearerToken != nil {
 			if strings.Contains(*payload.BearerToken, " ") {
 				// Remove authorization scheme prefix (e.g. "Bearer")
@@ -2490,9 +4897,9 @@ func DecodeGetGroupsioArtifactDownloadRequest(mux goahttp.Muxer, decoder func(*h
 	}
 }
 
-// EncodeGetGroupsioArtifactDownloadError returns an encoder for errors
-// returned by the get-groupsio-artifact-download mailing-list endpoint.
-func EncodeGetGroupsioArtifactDownloadError(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder, formatter func(ctx context.Context, err error) goahttp.Statuser) func(context.Context, http.ResponseWriter, error) error {
+// EncodeListGroupsioProjectMembersError returns an encoder for errors returned
+// by the list-groupsio-project-members mailing-list endpoint.
+func EncodeListGroupsioProjectMembersError(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder, formatter func(ctx context.Context, err error) goahttp.Statuser) func(context.Context, http.ResponseWriter, error) error {
 	encodeError := goahttp.ErrorEncoder(encoder, formatter)
 	return func(ctx context.Context, w http.ResponseWriter, v error) error {
 		var en goa.GoaErrorNamer
@@ -2500,31 +4907,31 @@ func EncodeGetGroupsioArtifactDownloadError(encoder func(context.Context, http.R
 			return encodeError(ctx, w, v)
 		}
 		switch en.GoaErrorName() {
-		case "InternalServerError":
-			var res *mailinglist.InternalServerError
+		case "BadRequest":
+			var res *mailinglist.BadRequestError
 			errors.As(v, &res)
 			enc := encoder(ctx, w)
 			var body any
 			if formatter != nil {
 				body = formatter(ctx, res)
 			} else {
-				body = NewGetGroupsioArtifactDownloadInternalServerErrorResponseBody(res)
+				body = NewListGroupsioProjectMembersBadRequestResponseBody(res)
 			}
 			w.Header().Set("goa-error", res.GoaErrorName())
-			w.WriteHeader(http.StatusInternalServerError)
+			w.WriteHeader(http.StatusBadRequest)
 			return enc.Encode(body)
-		case "NotFound":
-			var res *mailinglist.NotFoundError
+		case "InternalServerError":
+			var res *mailinglist.InternalServerError
 			errors.As(v, &res)
 			enc := encoder(ctx, w)
 			var body any
 			if formatter != nil {
 				body = formatter(ctx, res)
 			} else {
-				body = NewGetGroupsioArtifactDownloadNotFoundResponseBody(res)
+				body = NewListGroupsioProjectMembersInternalServerErrorResponseBody(res)
 			}
 			w.Header().Set("goa-error", res.GoaErrorName())
-			w.WriteHeader(http.StatusNotFound)
+			w.WriteHeader(http.StatusInternalServerError)
 			return enc.Encode(body)
 		case "ServiceUnavailable":
 			var res *mailinglist.ServiceUnavailableError
@@ -2534,7 +4941,7 @@ func EncodeGetGroupsioArtifactDownloadError(encoder func(context.Context, http.R
 			if formatter != nil {
 				body = formatter(ctx, res)
 			} else {
-				body = NewGetGroupsioArtifactDownloadServiceUnavailableResponseBody(res)
+				body = NewListGroupsioProjectMembersServiceUnavailableResponseBody(res)
 			}
 			w.Header().Set("goa-error", res.GoaErrorName())
 			w.WriteHeader(http.StatusServiceUnavailable)
@@ -2587,6 +4994,12 @@ func marshalMailinglistGroupsioSubgroupToGroupsioSubgroupResponseBody(v *mailing
 		CreatedAt:      v.CreatedAt,
 		UpdatedAt:      v.UpdatedAt,
 	}
+	if v.Warnings != nil {
+		res.Warnings = make([]string, len(v.Warnings))
+		for i, val := range v.Warnings {
+			res.Warnings[i] = val
+		}
+	}
 
 	return res
 }
@@ -2618,6 +5031,23 @@ func marshalMailinglistGroupsioMemberToGroupsioMemberResponseBody(v *mailinglist
 	return res
 }
 
+// unmarshalGroupsioMemberRequestRequestBodyToMailinglistGroupsioMemberRequest
+// builds a value of type *mailinglist.GroupsioMemberRequest from a value of
+// type *GroupsioMemberRequestRequestBody.
+func unmarshalGroupsioMemberRequestRequestBodyToMailinglistGroupsioMemberRequest(v *GroupsioMemberRequestRequestBody) *mailinglist.GroupsioMemberRequest {
+	res := &mailinglist.GroupsioMemberRequest{
+		Email:        v.Email,
+		Name:         v.Name,
+		MemberType:   v.MemberType,
+		ModStatus:    v.ModStatus,
+		DeliveryMode: v.DeliveryMode,
+		Organization: v.Organization,
+		JobTitle:     v.JobTitle,
+	}
+
+	return res
+}
+
 // marshalMailinglistGroupsioArtifactUserToGroupsioArtifactUserResponseBody
 // builds a value of type *GroupsioArtifactUserResponseBody from a value of
 // type *mailinglist.GroupsioArtifactUser.
@@ -2635,3 +5065,33 @@ func marshalMailinglistGroupsioArtifactUserToGroupsioArtifactUserResponseBody(v
 
 	return res
 }
+
+// marshalMailinglistGroupsioServiceTreeSubgroupToGroupsioServiceTreeSubgroupResponseBody
+// builds a value of type *GroupsioServiceTreeSubgroupResponseBody from a value
+// of type *mailinglist.GroupsioServiceTreeSubgroup.
+func marshalMailinglistGroupsioServiceTreeSubgroupToGroupsioServiceTreeSubgroupResponseBody(v *mailinglist.GroupsioServiceTreeSubgroup) *GroupsioServiceTreeSubgroupResponseBody {
+	res := &GroupsioServiceTreeSubgroupResponseBody{
+		MemberCount: v.MemberCount,
+	}
+	if v.Subgroup != nil {
+		res.Subgroup = marshalMailinglistGroupsioSubgroupToGroupsioSubgroupResponseBody(v.Subgroup)
+	}
+
+	return res
+}
+
+// marshalMailinglistGroupsioMemberHierarchyProjectToGroupsioMemberHierarchyProjectResponseBody
+// builds a value of type *GroupsioMemberHierarchyProjectResponseBody from a
+// value of type *mailinglist.GroupsioMemberHierarchyProject.
+func marshalMailinglistGroupsioMemberHierarchyProjectToGroupsioMemberHierarchyProjectResponseBody(v *mailinglist.GroupsioMemberHierarchyProject) *GroupsioMemberHierarchyProjectResponseBody {
+	if v == nil {
+		return nil
+	}
+	res := &GroupsioMemberHierarchyProjectResponseBody{
+		UID:  v.UID,
+		Name: v.Name,
+		Slug: v.Slug,
+	}
+
+	return res
+}
@@ -42,6 +42,11 @@ func UpdateGroupsioServiceMailingListPath(serviceID string) string {
 	return fmt.Sprintf("/groupsio/services/%v", serviceID)
 }
 
+// PromoteGroupsioServiceMailingListPath returns the URL path to the mailing-list service promote-groupsio-service HTTP endpoint.
+func PromoteGroupsioServiceMailingListPath(serviceID string) string {
+	return fmt.Sprintf("/groupsio/services/%v/promote", serviceID)
+}
+
 // DeleteGroupsioServiceMailingListPath returns the URL path to the mailing-list service delete-groupsio-service HTTP endpoint.
 func DeleteGroupsioServiceMailingListPath(serviceID string) string {
 	return fmt.Sprintf("/groupsio/services/%v", serviceID)
@@ -52,6 +57,16 @@ func GetGroupsioServiceProjectsMailingListPath() string {
 	return "/groupsio/services/_projects"
 }
 
+// ListGroupsioServicesByStatusMailingListPath returns the URL path to the mailing-list service list-groupsio-services-by-status HTTP endpoint.
+func ListGroupsioServicesByStatusMailingListPath() string {
+	return "/groupsio/services/_by_status"
+}
+
+// ListUnprovisionedGroupsioServicesMailingListPath returns the URL path to the mailing-list service list-unprovisioned-groupsio-services HTTP endpoint.
+func ListUnprovisionedGroupsioServicesMailingListPath() string {
+	return "/groupsio/services/_unprovisioned"
+}
+
 // FindParentGroupsioServiceMailingListPath returns the URL path to the mailing-list service find-parent-groupsio-service HTTP endpoint.
 func FindParentGroupsioServiceMailingListPath() string {
 	return "/groupsio/services/find_parent"
@@ -62,6 +77,16 @@ func ListGroupsioMailingListsMailingListPath() string {
 	return "/groupsio/mailing-lists"
 }
 
+// ListGroupsioMailingListsByVisibilityMailingListPath returns the URL path to the mailing-list service list-groupsio-mailing-lists-by-visibility HTTP endpoint.
+func ListGroupsioMailingListsByVisibilityMailingListPath() string {
+	return "/groupsio/mailing-lists/_by_visibility"
+}
+
+// ListGroupsioMailingListsUpdatedSinceMailingListPath returns the URL path to the mailing-list service list-groupsio-mailing-lists-updated-since HTTP endpoint.
+func ListGroupsioMailingListsUpdatedSinceMailingListPath() string {
+	return "/groupsio/mailing-lists/_updated_since"
+}
+
 // CreateGroupsioMailingListMailingListPath returns the URL path to the mailing-list service create-groupsio-mailing-list HTTP endpoint.
 func CreateGroupsioMailingListMailingListPath() string {
 	return "/groupsio/mailing-lists"
@@ -97,11 +122,41 @@ func ListGroupsioMembersMailingListPath(subgroupID string) string {
 	return fmt.Sprintf("/groupsio/mailing-lists/%v/members", subgroupID)
 }
 
+// ListGroupsioMembersNeedingReviewMailingListPath returns the URL path to the mailing-list service list-groupsio-members-needing-review HTTP endpoint.
+func ListGroupsioMembersNeedingReviewMailingListPath(subgroupID string) string {
+	return fmt.Sprintf("/groupsio/mailing-lists/%v/members/_needing_review", subgroupID)
+}
+
+// ListGroupsioMembersModifiedSinceMailingListPath returns the URL path to the mailing-list service list-groupsio-members-modified-since HTTP endpoint.
+func ListGroupsioMembersModifiedSinceMailingListPath(subgroupID string) string {
+	return fmt.Sprintf("/groupsio/mailing-lists/%v/members/_modified_since", subgroupID)
+}
+
+// ListGroupsioMembersByOrganizationMailingListPath returns the URL path to the mailing-list service list-groupsio-members-by-organization HTTP endpoint.
+func ListGroupsioMembersByOrganizationMailingListPath(subgroupID string) string {
+	return fmt.Sprintf("/groupsio/mailing-lists/%v/members/_by_organization", subgroupID)
+}
+
+// ListGroupsioDuplicateMembersMailingListPath returns the URL path to the mailing-list service list-groupsio-duplicate-members HTTP endpoint.
+func ListGroupsioDuplicateMembersMailingListPath(subgroupID string) string {
+	return fmt.Sprintf("/groupsio/mailing-lists/%v/members/_duplicates", subgroupID)
+}
+
+// PreviewGroupsioDeliveryModeChangeMailingListPath returns the URL path to the mailing-list service preview-groupsio-delivery-mode-change HTTP endpoint.
+func PreviewGroupsioDeliveryModeChangeMailingListPath(subgroupID string) string {
+	return fmt.Sprintf("/groupsio/mailing-lists/%v/members/_delivery_mode_preview", subgroupID)
+}
+
 // AddGroupsioMemberMailingListPath returns the URL path to the mailing-list service add-groupsio-member HTTP endpoint.
 func AddGroupsioMemberMailingListPath(subgroupID string) string {
 	return fmt.Sprintf("/groupsio/mailing-lists/%v/members", subgroupID)
 }
 
+// UpsertGroupsioMemberMailingListPath returns the URL path to the mailing-list service upsert-groupsio-member HTTP endpoint.
+func UpsertGroupsioMemberMailingListPath(subgroupID string) string {
+	return fmt.Sprintf("/groupsio/mailing-lists/%v/members/_upsert", subgroupID)
+}
+
 // GetGroupsioMemberMailingListPath returns the URL path to the mailing-list service get-groupsio-member HTTP endpoint.
 func GetGroupsioMemberMailingListPath(subgroupID string, memberID string) string {
 	return fmt.Sprintf("/groupsio/mailing-lists/%v/members/%v", subgroupID, memberID)
@@ -122,6 +177,21 @@ func InviteGroupsioMembersMailingListPath(subgroupID string) string {
 	return fmt.Sprintf("/groupsio/mailing-lists/%v/invitemembers", subgroupID)
 }
 
+// ImportGroupsioMembersMailingListPath returns the URL path to the mailing-list service import-groupsio-members HTTP endpoint.
+func ImportGroupsioMembersMailingListPath(subgroupID string) string {
+	return fmt.Sprintf("/groupsio/mailing-lists/%v/members/_import", subgroupID)
+}
+
+// MergeGroupsioMembersMailingListPath returns the URL path to the mailing-list service merge-groupsio-members HTTP endpoint.
+func MergeGroupsioMembersMailingListPath(subgroupID string) string {
+	return fmt.Sprintf("/groupsio/mailing-lists/%v/members/_merge", subgroupID)
+}
+
+// ChangeGroupsioMemberEmailMailingListPath returns the URL path to the mailing-list service change-groupsio-member-email HTTP endpoint.
+func ChangeGroupsioMemberEmailMailingListPath(subgroupID string, memberID string) string {
+	return fmt.Sprintf("/groupsio/mailing-lists/%v/members/%v/email", subgroupID, memberID)
+}
+
 // CheckGroupsioSubscriberMailingListPath returns the URL path to the mailing-list service check-groupsio-subscriber HTTP endpoint.
 func CheckGroupsioSubscriberMailingListPath() string {
 	return "/groupsio/checksubscriber"
@@ -136,3 +206,38 @@ func GetGroupsioArtifactMailingListPath(subgroupID string, artifactID string) st
 func GetGroupsioArtifactDownloadMailingListPath(subgroupID string, artifactID string) string {
 	return fmt.Sprintf("/groupsio/mailing-lists/%v/artifacts/%v/download", subgroupID, artifactID)
 }
+
+// GetGroupsioProjectSummaryMailingListPath returns the URL path to the mailing-list service get-groupsio-project-summary HTTP endpoint.
+func GetGroupsioProjectSummaryMailingListPath(projectUID string) string {
+	return fmt.Sprintf("/groupsio/projects/%v/summary", projectUID)
+}
+
+// ListGroupsioOrphanedMailingListsMailingListPath returns the URL path to the mailing-list service list-groupsio-orphaned-mailing-lists HTTP endpoint.
+func ListGroupsioOrphanedMailingListsMailingListPath(projectUID string) string {
+	return fmt.Sprintf("/groupsio/projects/%v/orphaned_mailing_lists", projectUID)
+}
+
+// RepublishGroupsioProjectIndexMailingListPath returns the URL path to the mailing-list service republish-groupsio-project-index HTTP endpoint.
+func RepublishGroupsioProjectIndexMailingListPath(projectUID string) string {
+	return fmt.Sprintf("/groupsio/projects/%v/_republish_index", projectUID)
+}
+
+// GetGroupsioMailingListStatsMailingListPath returns the URL path to the mailing-list service get-groupsio-mailing-list-stats HTTP endpoint.
+func GetGroupsioMailingListStatsMailingListPath(subgroupID string) string {
+	return fmt.Sprintf("/groupsio/mailing-lists/%v/stats", subgroupID)
+}
+
+// GetGroupsioServiceTreeMailingListPath returns the URL path to the mailing-list service get-groupsio-service-tree HTTP endpoint.
+func GetGroupsioServiceTreeMailingListPath(serviceID string) string {
+	return fmt.Sprintf("/groupsio/services/%v/tree", serviceID)
+}
+
+// GetGroupsioMemberHierarchyMailingListPath returns the URL path to the mailing-list service get-groupsio-member-hierarchy HTTP endpoint.
+func GetGroupsioMemberHierarchyMailingListPath(subgroupID string, memberID string) string {
+	return fmt.Sprintf("/groupsio/mailing-lists/%v/members/%v/hierarchy", subgroupID, memberID)
+}
+
+// ListGroupsioProjectMembersMailingListPath returns the URL path to the mailing-list service list-groupsio-project-members HTTP endpoint.
+func ListGroupsioProjectMembersMailingListPath(projectUID string) string {
+	return fmt.Sprintf("/groupsio/projects/%v/members", projectUID)
+}
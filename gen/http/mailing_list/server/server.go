@@ -20,36 +20,57 @@ import (
 
 // Server lists the mailing-list service endpoint HTTP handlers.
 type Server struct {
-	Mounts                            []*MountPoint
-	Livez                             http.Handler
-	Readyz                            http.Handler
-	ListGroupsioServices              http.Handler
-	CreateGroupsioService             http.Handler
-	GetGroupsioService                http.Handler
-	UpdateGroupsioService             http.Handler
-	DeleteGroupsioService             http.Handler
-	GetGroupsioServiceProjects        http.Handler
-	FindParentGroupsioService         http.Handler
-	ListGroupsioMailingLists          http.Handler
-	CreateGroupsioMailingList         http.Handler
-	GetGroupsioMailingList            http.Handler
-	UpdateGroupsioMailingList         http.Handler
-	DeleteGroupsioMailingList         http.Handler
-	GetGroupsioMailingListCount       http.Handler
-	GetGroupsioMailingListMemberCount http.Handler
-	ListGroupsioMembers               http.Handler
-	AddGroupsioMember                 http.Handler
-	GetGroupsioMember                 http.Handler
-	UpdateGroupsioMember              http.Handler
-	DeleteGroupsioMember              http.Handler
-	InviteGroupsioMembers             http.Handler
-	CheckGroupsioSubscriber           http.Handler
-	GetGroupsioArtifact               http.Handler
-	GetGroupsioArtifactDownload       http.Handler
-	GenHTTPOpenapiJSON                http.Handler
-	GenHTTPOpenapi3JSON               http.Handler
-	GenHTTPOpenapiYaml                http.Handler
-	GenHTTPOpenapi3Yaml               http.Handler
+	Mounts                               []*MountPoint
+	Livez                                http.Handler
+	Readyz                               http.Handler
+	ListGroupsioServices                 http.Handler
+	CreateGroupsioService                http.Handler
+	GetGroupsioService                   http.Handler
+	UpdateGroupsioService                http.Handler
+	PromoteGroupsioService               http.Handler
+	DeleteGroupsioService                http.Handler
+	GetGroupsioServiceProjects           http.Handler
+	ListGroupsioServicesByStatus         http.Handler
+	ListUnprovisionedGroupsioServices    http.Handler
+	FindParentGroupsioService            http.Handler
+	ListGroupsioMailingLists             http.Handler
+	ListGroupsioMailingListsByVisibility http.Handler
+	ListGroupsioMailingListsUpdatedSince http.Handler
+	CreateGroupsioMailingList            http.Handler
+	GetGroupsioMailingList               http.Handler
+	UpdateGroupsioMailingList            http.Handler
+	DeleteGroupsioMailingList            http.Handler
+	GetGroupsioMailingListCount          http.Handler
+	GetGroupsioMailingListMemberCount    http.Handler
+	ListGroupsioMembers                  http.Handler
+	ListGroupsioMembersNeedingReview     http.Handler
+	ListGroupsioMembersModifiedSince     http.Handler
+	ListGroupsioMembersByOrganization    http.Handler
+	ListGroupsioDuplicateMembers         http.Handler
+	PreviewGroupsioDeliveryModeChange    http.Handler
+	AddGroupsioMember                    http.Handler
+	UpsertGroupsioMember                 http.Handler
+	GetGroupsioMember                    http.Handler
+	UpdateGroupsioMember                 http.Handler
+	DeleteGroupsioMember                 http.Handler
+	InviteGroupsioMembers                http.Handler
+	ImportGroupsioMembers                http.Handler
+	MergeGroupsioMembers                 http.Handler
+	ChangeGroupsioMemberEmail            http.Handler
+	CheckGroupsioSubscriber              http.Handler
+	GetGroupsioArtifact                  http.Handler
+	GetGroupsioArtifactDownload          http.Handler
+	GetGroupsioProjectSummary            http.Handler
+	ListGroupsioOrphanedMailingLists     http.Handler
+	RepublishGroupsioProjectIndex        http.Handler
+	GetGroupsioMailingListStats          http.Handler
+	GetGroupsioServiceTree               http.Handler
+	GetGroupsioMemberHierarchy           http.Handler
+	ListGroupsioProjectMembers           http.Handler
+	GenHTTPOpenapiJSON                   http.Handler
+	GenHTTPOpenapi3JSON                  http.Handler
+	GenHTTPOpenapiYaml                   http.Handler
+	GenHTTPOpenapi3Yaml                  http.Handler
 }
 
 // MountPoint holds information about the mounted endpoints.
@@ -105,10 +126,15 @@ func New(
 			{"CreateGroupsioService", "POST", "/groupsio/services"},
 			{"GetGroupsioService", "GET", "/groupsio/services/{service_id}"},
 			{"UpdateGroupsioService", "PUT", "/groupsio/services/{service_id}"},
+			{"PromoteGroupsioService", "POST", "/groupsio/services/{service_id}/promote"},
 			{"DeleteGroupsioService", "DELETE", "/groupsio/services/{service_id}"},
 			{"GetGroupsioServiceProjects", "GET", "/groupsio/services/_projects"},
+			{"ListGroupsioServicesByStatus", "GET", "/groupsio/services/_by_status"},
+			{"ListUnprovisionedGroupsioServices", "GET", "/groupsio/services/_unprovisioned"},
 			{"FindParentGroupsioService", "GET", "/groupsio/services/find_parent"},
 			{"ListGroupsioMailingLists", "GET", "/groupsio/mailing-lists"},
+			{"ListGroupsioMailingListsByVisibility", "GET", "/groupsio/mailing-lists/_by_visibility"},
+			{"ListGroupsioMailingListsUpdatedSince", "GET", "/groupsio/mailing-lists/_updated_since"},
 			{"CreateGroupsioMailingList", "POST", "/groupsio/mailing-lists"},
 			{"GetGroupsioMailingList", "GET", "/groupsio/mailing-lists/{subgroup_id}"},
 			{"UpdateGroupsioMailingList", "PUT", "/groupsio/mailing-lists/{subgroup_id}"},
@@ -116,48 +142,85 @@ func New(
 			{"GetGroupsioMailingListCount", "GET", "/groupsio/mailing-lists/count"},
 			{"GetGroupsioMailingListMemberCount", "GET", "/groupsio/mailing-lists/{subgroup_id}/member_count"},
 			{"ListGroupsioMembers", "GET", "/groupsio/mailing-lists/{subgroup_id}/members"},
+			{"ListGroupsioMembersNeedingReview", "GET", "/groupsio/mailing-lists/{subgroup_id}/members/_needing_review"},
+			{"ListGroupsioMembersModifiedSince", "GET", "/groupsio/mailing-lists/{subgroup_id}/members/_modified_since"},
+			{"ListGroupsioMembersByOrganization", "GET", "/groupsio/mailing-lists/{subgroup_id}/members/_by_organization"},
+			{"ListGroupsioDuplicateMembers", "GET", "/groupsio/mailing-lists/{subgroup_id}/members/_duplicates"},
+			{"PreviewGroupsioDeliveryModeChange", "GET", "/groupsio/mailing-lists/{subgroup_id}/members/_delivery_mode_preview"},
 			{"AddGroupsioMember", "POST", "/groupsio/mailing-lists/{subgroup_id}/members"},
+			{"UpsertGroupsioMember", "PUT", "/groupsio/mailing-lists/{subgroup_id}/members/_upsert"},
 			{"GetGroupsioMember", "GET", "/groupsio/mailing-lists/{subgroup_id}/members/{member_id}"},
 			{"UpdateGroupsioMember", "PUT", "/groupsio/mailing-lists/{subgroup_id}/members/{member_id}"},
 			{"DeleteGroupsioMember", "DELETE", "/groupsio/mailing-lists/{subgroup_id}/members/{member_id}"},
 			{"InviteGroupsioMembers", "POST", "/groupsio/mailing-lists/{subgroup_id}/invitemembers"},
+			{"ImportGroupsioMembers", "POST", "/groupsio/mailing-lists/{subgroup_id}/members/_import"},
+			{"MergeGroupsioMembers", "POST", "/groupsio/mailing-lists/{subgroup_id}/members/_merge"},
+			{"ChangeGroupsioMemberEmail", "PUT", "/groupsio/mailing-lists/{subgroup_id}/members/{member_id}/email"},
 			{"CheckGroupsioSubscriber", "POST", "/groupsio/checksubscriber"},
 			{"GetGroupsioArtifact", "GET", "/groupsio/mailing-lists/{subgroup_id}/artifacts/{artifact_id}"},
 			{"GetGroupsioArtifactDownload", "GET", "/groupsio/mailing-lists/{subgroup_id}/artifacts/{artifact_id}/download"},
+			{"GetGroupsioProjectSummary", "GET", "/groupsio/projects/{project_uid}/summary"},
+			{"ListGroupsioOrphanedMailingLists", "GET", "/groupsio/projects/{project_uid}/orphaned_mailing_lists"},
+			{"RepublishGroupsioProjectIndex", "POST", "/groupsio/projects/{project_uid}/_republish_index"},
+			{"GetGroupsioMailingListStats", "GET", "/groupsio/mailing-lists/{subgroup_id}/stats"},
+			{"GetGroupsioServiceTree", "GET", "/groupsio/services/{service_id}/tree"},
+			{"GetGroupsioMemberHierarchy", "GET", "/groupsio/mailing-lists/{subgroup_id}/members/{member_id}/hierarchy"},
+			{"ListGroupsioProjectMembers", "GET", "/groupsio/projects/{project_uid}/members"},
 			{"Serve gen/http/openapi.json", "GET", "/_groupsio/openapi.json"},
 			{"Serve gen/http/openapi3.json", "GET", "/_groupsio/openapi3.json"},
 			{"Serve gen/http/openapi.yaml", "GET", "/_groupsio/openapi.yaml"},
 			{"Serve gen/http/openapi3.yaml", "GET", "/_groupsio/openapi3.yaml"},
 		},
-		Livez:                             NewLivezHandler(e.Livez, mux, decoder, encoder, errhandler, formatter),
-		Readyz:                            NewReadyzHandler(e.Readyz, mux, decoder, encoder, errhandler, formatter),
-		ListGroupsioServices:              NewListGroupsioServicesHandler(e.ListGroupsioServices, mux, decoder, encoder, errhandler, formatter),
-		CreateGroupsioService:             NewCreateGroupsioServiceHandler(e.CreateGroupsioService, mux, decoder, encoder, errhandler, formatter),
-		GetGroupsioService:                NewGetGroupsioServiceHandler(e.GetGroupsioService, mux, decoder, encoder, errhandler, formatter),
-		UpdateGroupsioService:             NewUpdateGroupsioServiceHandler(e.UpdateGroupsioService, mux, decoder, encoder, errhandler, formatter),
-		DeleteGroupsioService:             NewDeleteGroupsioServiceHandler(e.DeleteGroupsioService, mux, decoder, encoder, errhandler, formatter),
-		GetGroupsioServiceProjects:        NewGetGroupsioServiceProjectsHandler(e.GetGroupsioServiceProjects, mux, decoder, encoder, errhandler, formatter),
-		FindParentGroupsioService:         NewFindParentGroupsioServiceHandler(e.FindParentGroupsioService, mux, decoder, encoder, errhandler, formatter),
-		ListGroupsioMailingLists:          NewListGroupsioMailingListsHandler(e.ListGroupsioMailingLists, mux, decoder, encoder, errhandler, formatter),
-		CreateGroupsioMailingList:         NewCreateGroupsioMailingListHandler(e.CreateGroupsioMailingList, mux, decoder, encoder, errhandler, formatter),
-		GetGroupsioMailingList:            NewGetGroupsioMailingListHandler(e.GetGroupsioMailingList, mux, decoder, encoder, errhandler, formatter),
-		UpdateGroupsioMailingList:         NewUpdateGroupsioMailingListHandler(e.UpdateGroupsioMailingList, mux, decoder, encoder, errhandler, formatter),
-		DeleteGroupsioMailingList:         NewDeleteGroupsioMailingListHandler(e.DeleteGroupsioMailingList, mux, decoder, encoder, errhandler, formatter),
-		GetGroupsioMailingListCount:       NewGetGroupsioMailingListCountHandler(e.GetGroupsioMailingListCount, mux, decoder, encoder, errhandler, formatter),
-		GetGroupsioMailingListMemberCount: NewGetGroupsioMailingListMemberCountHandler(e.GetGroupsioMailingListMemberCount, mux, decoder, encoder, errhandler, formatter),
-		ListGroupsioMembers:               NewListGroupsioMembersHandler(e.ListGroupsioMembers, mux, decoder, encoder, errhandler, formatter),
-		AddGroupsioMember:                 NewAddGroupsioMemberHandler(e.AddGroupsioMember, mux, decoder, encoder, errhandler, formatter),
-		GetGroupsioMember:                 NewGetGroupsioMemberHandler(e.GetGroupsioMember, mux, decoder, encoder, errhandler, formatter),
-		UpdateGroupsioMember:              NewUpdateGroupsioMemberHandler(e.UpdateGroupsioMember, mux, decoder, encoder, errhandler, formatter),
-		DeleteGroupsioMember:              NewDeleteGroupsioMemberHandler(e.DeleteGroupsioMember, mux, decoder, encoder, errhandler, formatter),
-		InviteGroupsioMembers:             NewInviteGroupsioMembersHandler(e.InviteGroupsioMembers, mux, decoder, encoder, errhandler, formatter),
-		CheckGroupsioSubscriber:           NewCheckGroupsioSubscriberHandler(e.CheckGroupsioSubscriber, mux, decoder, encoder, errhandler, formatter),
-		GetGroupsioArtifact:               NewGetGroupsioArtifactHandler(e.GetGroupsioArtifact, mux, decoder, encoder, errhandler, formatter),
-		GetGroupsioArtifactDownload:       NewGetGroupsioArtifactDownloadHandler(e.GetGroupsioArtifactDownload, mux, decoder, encoder, errhandler, formatter),
-		GenHTTPOpenapiJSON:                http.FileServer(fileSystemGenHTTPOpenapiJSON),
-		GenHTTPOpenapi3JSON:               http.FileServer(fileSystemGenHTTPOpenapi3JSON),
-		GenHTTPOpenapiYaml:                http.FileServer(fileSystemGenHTTPOpenapiYaml),
-		GenHTTPOpenapi3Yaml:               http.FileServer(fileSystemGenHTTPOpenapi3Yaml),
+		Livez:                                NewLivezHandler(e.Livez, mux, decoder, encoder, errhandler, formatter),
+		Readyz:                               NewReadyzHandler(e.Readyz, mux, decoder, encoder, errhandler, formatter),
+		ListGroupsioServices:                 NewListGroupsioServicesHandler(e.ListGroupsioServices, mux, decoder, encoder, errhandler, formatter),
+		CreateGroupsioService:                NewCreateGroupsioServiceHandler(e.CreateGroupsioService, mux, decoder, encoder, errhandler, formatter),
+		GetGroupsioService:                   NewGetGroupsioServiceHandler(e.GetGroupsioService, mux, decoder, encoder, errhandler, formatter),
+		UpdateGroupsioService:                NewUpdateGroupsioServiceHandler(e.UpdateGroupsioService, mux, decoder, encoder, errhandler, formatter),
+		PromoteGroupsioService:               NewPromoteGroupsioServiceHandler(e.PromoteGroupsioService, mux, decoder, encoder, errhandler, formatter),
+		DeleteGroupsioService:                NewDeleteGroupsioServiceHandler(e.DeleteGroupsioService, mux, decoder, encoder, errhandler, formatter),
+		GetGroupsioServiceProjects:           NewGetGroupsioServiceProjectsHandler(e.GetGroupsioServiceProjects, mux, decoder, encoder, errhandler, formatter),
+		ListGroupsioServicesByStatus:         NewListGroupsioServicesByStatusHandler(e.ListGroupsioServicesByStatus, mux, decoder, encoder, errhandler, formatter),
+		ListUnprovisionedGroupsioServices:    NewListUnprovisionedGroupsioServicesHandler(e.ListUnprovisionedGroupsioServices, mux, decoder, encoder, errhandler, formatter),
+		FindParentGroupsioService:            NewFindParentGroupsioServiceHandler(e.FindParentGroupsioService, mux, decoder, encoder, errhandler, formatter),
+		ListGroupsioMailingLists:             NewListGroupsioMailingListsHandler(e.ListGroupsioMailingLists, mux, decoder, encoder, errhandler, formatter),
+		ListGroupsioMailingListsByVisibility: NewListGroupsioMailingListsByVisibilityHandler(e.ListGroupsioMailingListsByVisibility, mux, decoder, encoder, errhandler, formatter),
+		ListGroupsioMailingListsUpdatedSince: NewListGroupsioMailingListsUpdatedSinceHandler(e.ListGroupsioMailingListsUpdatedSince, mux, decoder, encoder, errhandler, formatter),
+		CreateGroupsioMailingList:            NewCreateGroupsioMailingListHandler(e.CreateGroupsioMailingList, mux, decoder, encoder, errhandler, formatter),
+		GetGroupsioMailingList:               NewGetGroupsioMailingListHandler(e.GetGroupsioMailingList, mux, decoder, encoder, errhandler, formatter),
+		UpdateGroupsioMailingList:            NewUpdateGroupsioMailingListHandler(e.UpdateGroupsioMailingList, mux, decoder, encoder, errhandler, formatter),
+		DeleteGroupsioMailingList:            NewDeleteGroupsioMailingListHandler(e.DeleteGroupsioMailingList, mux, decoder, encoder, errhandler, formatter),
+		GetGroupsioMailingListCount:          NewGetGroupsioMailingListCountHandler(e.GetGroupsioMailingListCount, mux, decoder, encoder, errhandler, formatter),
+		GetGroupsioMailingListMemberCount:    NewGetGroupsioMailingListMemberCountHandler(e.GetGroupsioMailingListMemberCount, mux, decoder, encoder, errhandler, formatter),
+		ListGroupsioMembers:                  NewListGroupsioMembersHandler(e.ListGroupsioMembers, mux, decoder, encoder, errhandler, formatter),
+		ListGroupsioMembersNeedingReview:     NewListGroupsioMembersNeedingReviewHandler(e.ListGroupsioMembersNeedingReview, mux, decoder, encoder, errhandler, formatter),
+		ListGroupsioMembersModifiedSince:     NewListGroupsioMembersModifiedSinceHandler(e.ListGroupsioMembersModifiedSince, mux, decoder, encoder, errhandler, formatter),
+		ListGroupsioMembersByOrganization:    NewListGroupsioMembersByOrganizationHandler(e.ListGroupsioMembersByOrganization, mux, decoder, encoder, errhandler, formatter),
+		ListGroupsioDuplicateMembers:         NewListGroupsioDuplicateMembersHandler(e.ListGroupsioDuplicateMembers, mux, decoder, encoder, errhandler, formatter),
+		PreviewGroupsioDeliveryModeChange:    NewPreviewGroupsioDeliveryModeChangeHandler(e.PreviewGroupsioDeliveryModeChange, mux, decoder, encoder, errhandler, formatter),
+		AddGroupsioMember:                    NewAddGroupsioMemberHandler(e.AddGroupsioMember, mux, decoder, encoder, errhandler, formatter),
+		UpsertGroupsioMember:                 NewUpsertGroupsioMemberHandler(e.UpsertGroupsioMember, mux, decoder, encoder, errhandler, formatter),
+		GetGroupsioMember:                    NewGetGroupsioMemberHandler(e.GetGroupsioMember, mux, decoder, encoder, errhandler, formatter),
+		UpdateGroupsioMember:                 NewUpdateGroupsioMemberHandler(e.UpdateGroupsioMember, mux, decoder, encoder, errhandler, formatter),
+		DeleteGroupsioMember:                 NewDeleteGroupsioMemberHandler(e.DeleteGroupsioMember, mux, decoder, encoder, errhandler, formatter),
+		InviteGroupsioMembers:                NewInviteGroupsioMembersHandler(e.InviteGroupsioMembers, mux, decoder, encoder, errhandler, formatter),
+		ImportGroupsioMembers:                NewImportGroupsioMembersHandler(e.ImportGroupsioMembers, mux, decoder, encoder, errhandler, formatter),
+		MergeGroupsioMembers:                 NewMergeGroupsioMembersHandler(e.MergeGroupsioMembers, mux, decoder, encoder, errhandler, formatter),
+		ChangeGroupsioMemberEmail:            NewChangeGroupsioMemberEmailHandler(e.ChangeGroupsioMemberEmail, mux, decoder, encoder, errhandler, formatter),
+		CheckGroupsioSubscriber:              NewCheckGroupsioSubscriberHandler(e.CheckGroupsioSubscriber, mux, decoder, encoder, errhandler, formatter),
+		GetGroupsioArtifact:                  NewGetGroupsioArtifactHandler(e.GetGroupsioArtifact, mux, decoder, encoder, errhandler, formatter),
+		GetGroupsioArtifactDownload:          NewGetGroupsioArtifactDownloadHandler(e.GetGroupsioArtifactDownload, mux, decoder, encoder, errhandler, formatter),
+		GetGroupsioProjectSummary:            NewGetGroupsioProjectSummaryHandler(e.GetGroupsioProjectSummary, mux, decoder, encoder, errhandler, formatter),
+		ListGroupsioOrphanedMailingLists:     NewListGroupsioOrphanedMailingListsHandler(e.ListGroupsioOrphanedMailingLists, mux, decoder, encoder, errhandler, formatter),
+		RepublishGroupsioProjectIndex:        NewRepublishGroupsioProjectIndexHandler(e.RepublishGroupsioProjectIndex, mux, decoder, encoder, errhandler, formatter),
+		GetGroupsioMailingListStats:          NewGetGroupsioMailingListStatsHandler(e.GetGroupsioMailingListStats, mux, decoder, encoder, errhandler, formatter),
+		GetGroupsioServiceTree:               NewGetGroupsioServiceTreeHandler(e.GetGroupsioServiceTree, mux, decoder, encoder, errhandler, formatter),
+		GetGroupsioMemberHierarchy:           NewGetGroupsioMemberHierarchyHandler(e.GetGroupsioMemberHierarchy, mux, decoder, encoder, errhandler, formatter),
+		ListGroupsioProjectMembers:           NewListGroupsioProjectMembersHandler(e.ListGroupsioProjectMembers, mux, decoder, encoder, errhandler, formatter),
+		GenHTTPOpenapiJSON:                   http.FileServer(fileSystemGenHTTPOpenapiJSON),
+		GenHTTPOpenapi3JSON:                  http.FileServer(fileSystemGenHTTPOpenapi3JSON),
+		GenHTTPOpenapiYaml:                   http.FileServer(fileSystemGenHTTPOpenapiYaml),
+		GenHTTPOpenapi3Yaml:                  http.FileServer(fileSystemGenHTTPOpenapi3Yaml),
 	}
 }
 
@@ -172,10 +235,15 @@ func (s *Server) Use(m func(http.Handler) http.Handler) {
 	s.CreateGroupsioService = m(s.CreateGroupsioService)
 	s.GetGroupsioService = m(s.GetGroupsioService)
 	s.UpdateGroupsioService = m(s.UpdateGroupsioService)
+	s.PromoteGroupsioService = m(s.PromoteGroupsioService)
 	s.DeleteGroupsioService = m(s.DeleteGroupsioService)
 	s.GetGroupsioServiceProjects = m(s.GetGroupsioServiceProjects)
+	s.ListGroupsioServicesByStatus = m(s.ListGroupsioServicesByStatus)
+	s.ListUnprovisionedGroupsioServices = m(s.ListUnprovisionedGroupsioServices)
 	s.FindParentGroupsioService = m(s.FindParentGroupsioService)
 	s.ListGroupsioMailingLists = m(s.ListGroupsioMailingLists)
+	s.ListGroupsioMailingListsByVisibility = m(s.ListGroupsioMailingListsByVisibility)
+	s.ListGroupsioMailingListsUpdatedSince = m(s.ListGroupsioMailingListsUpdatedSince)
 	s.CreateGroupsioMailingList = m(s.CreateGroupsioMailingList)
 	s.GetGroupsioMailingList = m(s.GetGroupsioMailingList)
 	s.UpdateGroupsioMailingList = m(s.UpdateGroupsioMailingList)
@@ -183,14 +251,30 @@ func (s *Server) Use(m func(http.Handler) http.Handler) {
 	s.GetGroupsioMailingListCount = m(s.GetGroupsioMailingListCount)
 	s.GetGroupsioMailingListMemberCount = m(s.GetGroupsioMailingListMemberCount)
 	s.ListGroupsioMembers = m(s.ListGroupsioMembers)
+	s.ListGroupsioMembersNeedingReview = m(s.ListGroupsioMembersNeedingReview)
+	s.ListGroupsioMembersModifiedSince = m(s.ListGroupsioMembersModifiedSince)
+	s.ListGroupsioMembersByOrganization = m(s.ListGroupsioMembersByOrganization)
+	s.ListGroupsioDuplicateMembers = m(s.ListGroupsioDuplicateMembers)
+	s.PreviewGroupsioDeliveryModeChange = m(s.PreviewGroupsioDeliveryModeChange)
 	s.AddGroupsioMember = m(s.AddGroupsioMember)
+	s.UpsertGroupsioMember = m(s.UpsertGroupsioMember)
 	s.GetGroupsioMember = m(s.GetGroupsioMember)
 	s.UpdateGroupsioMember = m(s.UpdateGroupsioMember)
 	s.DeleteGroupsioMember = m(s.DeleteGroupsioMember)
 	s.InviteGroupsioMembers = m(s.InviteGroupsioMembers)
+	s.ImportGroupsioMembers = m(s.ImportGroupsioMembers)
+	s.MergeGroupsioMembers = m(s.MergeGroupsioMembers)
+	s.ChangeGroupsioMemberEmail = m(s.ChangeGroupsioMemberEmail)
 	s.CheckGroupsioSubscriber = m(s.CheckGroupsioSubscriber)
 	s.GetGroupsioArtifact = m(s.GetGroupsioArtifact)
 	s.GetGroupsioArtifactDownload = m(s.GetGroupsioArtifactDownload)
+	s.GetGroupsioProjectSummary = m(s.GetGroupsioProjectSummary)
+	s.ListGroupsioOrphanedMailingLists = m(s.ListGroupsioOrphanedMailingLists)
+	s.RepublishGroupsioProjectIndex = m(s.RepublishGroupsioProjectIndex)
+	s.GetGroupsioMailingListStats = m(s.GetGroupsioMailingListStats)
+	s.GetGroupsioServiceTree = m(s.GetGroupsioServiceTree)
+	s.GetGroupsioMemberHierarchy = m(s.GetGroupsioMemberHierarchy)
+	s.ListGroupsioProjectMembers = m(s.ListGroupsioProjectMembers)
 }
 
 // MethodNames returns the methods served.
@@ -204,10 +288,15 @@ func Mount(mux goahttp.Muxer, h *Server) {
 	MountCreateGroupsioServiceHandler(mux, h.CreateGroupsioService)
 	MountGetGroupsioServiceHandler(mux, h.GetGroupsioService)
 	MountUpdateGroupsioServiceHandler(mux, h.UpdateGroupsioService)
+	MountPromoteGroupsioServiceHandler(mux, h.PromoteGroupsioService)
 	MountDeleteGroupsioServiceHandler(mux, h.DeleteGroupsioService)
 	MountGetGroupsioServiceProjectsHandler(mux, h.GetGroupsioServiceProjects)
+	MountListGroupsioServicesByStatusHandler(mux, h.ListGroupsioServicesByStatus)
+	MountListUnprovisionedGroupsioServicesHandler(mux, h.ListUnprovisionedGroupsioServices)
 	MountFindParentGroupsioServiceHandler(mux, h.FindParentGroupsioService)
 	MountListGroupsioMailingListsHandler(mux, h.ListGroupsioMailingLists)
+	MountListGroupsioMailingListsByVisibilityHandler(mux, h.ListGroupsioMailingListsByVisibility)
+	MountListGroupsioMailingListsUpdatedSinceHandler(mux, h.ListGroupsioMailingListsUpdatedSince)
 	MountCreateGroupsioMailingListHandler(mux, h.CreateGroupsioMailingList)
 	MountGetGroupsioMailingListHandler(mux, h.GetGroupsioMailingList)
 	MountUpdateGroupsioMailingListHandler(mux, h.UpdateGroupsioMailingList)
@@ -215,14 +304,30 @@ func Mount(mux goahttp.Muxer, h *Server) {
 	MountGetGroupsioMailingListCountHandler(mux, h.GetGroupsioMailingListCount)
 	MountGetGroupsioMailingListMemberCountHandler(mux, h.GetGroupsioMailingListMemberCount)
 	MountListGroupsioMembersHandler(mux, h.ListGroupsioMembers)
+	MountListGroupsioMembersNeedingReviewHandler(mux, h.ListGroupsioMembersNeedingReview)
+	MountListGroupsioMembersModifiedSinceHandler(mux, h.ListGroupsioMembersModifiedSince)
+	MountListGroupsioMembersByOrganizationHandler(mux, h.ListGroupsioMembersByOrganization)
+	MountListGroupsioDuplicateMembersHandler(mux, h.ListGroupsioDuplicateMembers)
+	MountPreviewGroupsioDeliveryModeChangeHandler(mux, h.PreviewGroupsioDeliveryModeChange)
 	MountAddGroupsioMemberHandler(mux, h.AddGroupsioMember)
+	MountUpsertGroupsioMemberHandler(mux, h.UpsertGroupsioMember)
 	MountGetGroupsioMemberHandler(mux, h.GetGroupsioMember)
 	MountUpdateGroupsioMemberHandler(mux, h.UpdateGroupsioMember)
 	MountDeleteGroupsioMemberHandler(mux, h.DeleteGroupsioMember)
 	MountInviteGroupsioMembersHandler(mux, h.InviteGroupsioMembers)
+	MountImportGroupsioMembersHandler(mux, h.ImportGroupsioMembers)
+	MountMergeGroupsioMembersHandler(mux, h.MergeGroupsioMembers)
+	MountChangeGroupsioMemberEmailHandler(mux, h.ChangeGroupsioMemberEmail)
 	MountCheckGroupsioSubscriberHandler(mux, h.CheckGroupsioSubscriber)
 	MountGetGroupsioArtifactHandler(mux, h.GetGroupsioArtifact)
 	MountGetGroupsioArtifactDownloadHandler(mux, h.GetGroupsioArtifactDownload)
+	MountGetGroupsioProjectSummaryHandler(mux, h.GetGroupsioProjectSummary)
+	MountListGroupsioOrphanedMailingListsHandler(mux, h.ListGroupsioOrphanedMailingLists)
+	MountRepublishGroupsioProjectIndexHandler(mux, h.RepublishGroupsioProjectIndex)
+	MountGetGroupsioMailingListStatsHandler(mux, h.GetGroupsioMailingListStats)
+	MountGetGroupsioServiceTreeHandler(mux, h.GetGroupsioServiceTree)
+	MountGetGroupsioMemberHierarchyHandler(mux, h.GetGroupsioMemberHierarchy)
+	MountListGroupsioProjectMembersHandler(mux, h.ListGroupsioProjectMembers)
 	MountGenHTTPOpenapiJSON(mux, http.StripPrefix("/_groupsio", h.GenHTTPOpenapiJSON))
 	MountGenHTTPOpenapi3JSON(mux, http.StripPrefix("/_groupsio", h.GenHTTPOpenapi3JSON))
 	MountGenHTTPOpenapiYaml(mux, http.StripPrefix("/_groupsio", h.GenHTTPOpenapiYaml))
@@ -541,6 +646,60 @@ func NewUpdateGroupsioServiceHandler(
 	})
 }
 
+// MountPromoteGroupsioServiceHandler configures the mux to serve the
+// "mailing-list" service "promote-groupsio-service" endpoint.
+func MountPromoteGroupsioServiceHandler(mux goahttp.Muxer, h http.Handler) {
+	f, ok := h.(http.HandlerFunc)
+	if !ok {
+		f = func(w http.ResponseWriter, r *http.Request) {
+			h.ServeHTTP(w, r)
+		}
+	}
+	mux.Handle("POST", "/groupsio/services/{service_id}/promote", f)
+}
+
+// NewPromoteGroupsioServiceHandler creates a HTTP handler which loads the HTTP
+// request and calls the "mailing-list" service "promote-groupsio-service"
+// endpoint.
+func NewPromoteGroupsioServiceHandler(
+	endpoint goa.Endpoint,
+	mux goahttp.Muxer,
+	decoder func(*http.Request) goahttp.Decoder,
+	encoder func(context.Context, http.ResponseWriter) goahttp.Encoder,
+	errhandler func(context.Context, http.ResponseWriter, error),
+	formatter func(ctx context.Context, err error) goahttp.Statuser,
+) http.Handler {
+	var (
+		decodeRequest  = DecodePromoteGroupsioServiceRequest(mux, decoder)
+		encodeResponse = EncodePromoteGroupsioServiceResponse(encoder)
+		encodeError    = EncodePromoteGroupsioServiceError(encoder, formatter)
+	)
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx := context.WithValue(r.Context(), goahttp.AcceptTypeKey, r.Header.Get("Accept"))
+		ctx = context.WithValue(ctx, goa.MethodKey, "promote-groupsio-service")
+		ctx = context.WithValue(ctx, goa.ServiceKey, "mailing-list")
+		payload, err := decodeRequest(r)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil && errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		res, err := endpoint(ctx, payload)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil && errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		if err := encodeResponse(ctx, w, res); err != nil {
+			if errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+		}
+	})
+}
+
 // MountDeleteGroupsioServiceHandler configures the mux to serve the
 // "mailing-list" service "delete-groupsio-service" endpoint.
 func MountDeleteGroupsioServiceHandler(mux goahttp.Muxer, h http.Handler) {
@@ -649,6 +808,114 @@ func NewGetGroupsioServiceProjectsHandler(
 	})
 }
 
+// MountListGroupsioServicesByStatusHandler configures the mux to serve the
+// "mailing-list" service "list-groupsio-services-by-status" endpoint.
+func MountListGroupsioServicesByStatusHandler(mux goahttp.Muxer, h http.Handler) {
+	f, ok := h.(http.HandlerFunc)
+	if !ok {
+		f = func(w http.ResponseWriter, r *http.Request) {
+			h.ServeHTTP(w, r)
+		}
+	}
+	mux.Handle("GET", "/groupsio/services/_by_status", f)
+}
+
+// NewListGroupsioServicesByStatusHandler creates a HTTP handler which loads
+// the HTTP request and calls the "mailing-list" service
+// "list-groupsio-services-by-status" endpoint.
+func NewListGroupsioServicesByStatusHandler(
+	endpoint goa.Endpoint,
+	mux goahttp.Muxer,
+	decoder func(*http.Request) goahttp.Decoder,
+	encoder func(context.Context, http.ResponseWriter) goahttp.Encoder,
+	errhandler func(context.Context, http.ResponseWriter, error),
+	formatter func(ctx context.Context, err error) goahttp.Statuser,
+) http.Handler {
+	var (
+		decodeRequest  = DecodeListGroupsioServicesByStatusRequest(mux, decoder)
+		encodeResponse = EncodeListGroupsioServicesByStatusResponse(encoder)
+		encodeError    = EncodeListGroupsioServicesByStatusError(encoder, formatter)
+	)
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx := context.WithValue(r.Context(), goahttp.AcceptTypeKey, r.Header.Get("Accept"))
+		ctx = context.WithValue(ctx, goa.MethodKey, "list-groupsio-services-by-status")
+		ctx = context.WithValue(ctx, goa.ServiceKey, "mailing-list")
+		payload, err := decodeRequest(r)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil && errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		res, err := endpoint(ctx, payload)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil && errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		if err := encodeResponse(ctx, w, res); err != nil {
+			if errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+		}
+	})
+}
+
+// MountListUnprovisionedGroupsioServicesHandler configures the mux to serve
+// the "mailing-list" service "list-unprovisioned-groupsio-services" endpoint.
+func MountListUnprovisionedGroupsioServicesHandler(mux goahttp.Muxer, h http.Handler) {
+	f, ok := h.(http.HandlerFunc)
+	if !ok {
+		f = func(w http.ResponseWriter, r *http.Request) {
+			h.ServeHTTP(w, r)
+		}
+	}
+	mux.Handle("GET", "/groupsio/services/_unprovisioned", f)
+}
+
+// NewListUnprovisionedGroupsioServicesHandler creates a HTTP handler which
+// loads the HTTP request and calls the "mailing-list" service
+// "list-unprovisioned-groupsio-services" endpoint.
+func NewListUnprovisionedGroupsioServicesHandler(
+	endpoint goa.Endpoint,
+	mux goahttp.Muxer,
+	decoder func(*http.Request) goahttp.Decoder,
+	encoder func(context.Context, http.ResponseWriter) goahttp.Encoder,
+	errhandler func(context.Context, http.ResponseWriter, error),
+	formatter func(ctx context.Context, err error) goahttp.Statuser,
+) http.Handler {
+	var (
+		decodeRequest  = DecodeListUnprovisionedGroupsioServicesRequest(mux, decoder)
+		encodeResponse = EncodeListUnprovisionedGroupsioServicesResponse(encoder)
+		encodeError    = EncodeListUnprovisionedGroupsioServicesError(encoder, formatter)
+	)
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx := context.WithValue(r.Context(), goahttp.AcceptTypeKey, r.Header.Get("Accept"))
+		ctx = context.WithValue(ctx, goa.MethodKey, "list-unprovisioned-groupsio-services")
+		ctx = context.WithValue(ctx, goa.ServiceKey, "mailing-list")
+		payload, err := decodeRequest(r)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil && errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		res, err := endpoint(ctx, payload)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil && errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		if err := encodeResponse(ctx, w, res); err != nil {
+			if errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+		}
+	})
+}
+
 // MountFindParentGroupsioServiceHandler configures the mux to serve the
 // "mailing-list" service "find-parent-groupsio-service" endpoint.
 func MountFindParentGroupsioServiceHandler(mux goahttp.Muxer, h http.Handler) {
@@ -757,6 +1024,116 @@ func NewListGroupsioMailingListsHandler(
 	})
 }
 
+// MountListGroupsioMailingListsByVisibilityHandler configures the mux to serve
+// the "mailing-list" service "list-groupsio-mailing-lists-by-visibility"
+// endpoint.
+func MountListGroupsioMailingListsByVisibilityHandler(mux goahttp.Muxer, h http.Handler) {
+	f, ok := h.(http.HandlerFunc)
+	if !ok {
+		f = func(w http.ResponseWriter, r *http.Request) {
+			h.ServeHTTP(w, r)
+		}
+	}
+	mux.Handle("GET", "/groupsio/mailing-lists/_by_visibility", f)
+}
+
+// NewListGroupsioMailingListsByVisibilityHandler creates a HTTP handler which
+// loads the HTTP request and calls the "mailing-list" service
+// "list-groupsio-mailing-lists-by-visibility" endpoint.
+func NewListGroupsioMailingListsByVisibilityHandler(
+	endpoint goa.Endpoint,
+	mux goahttp.Muxer,
+	decoder func(*http.Request) goahttp.Decoder,
+	encoder func(context.Context, http.ResponseWriter) goahttp.Encoder,
+	errhandler func(context.Context, http.ResponseWriter, error),
+	formatter func(ctx context.Context, err error) goahttp.Statuser,
+) http.Handler {
+	var (
+		decodeRequest  = DecodeListGroupsioMailingListsByVisibilityRequest(mux, decoder)
+		encodeResponse = EncodeListGroupsioMailingListsByVisibilityResponse(encoder)
+		encodeError    = EncodeListGroupsioMailingListsByVisibilityError(encoder, formatter)
+	)
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx := context.WithValue(r.Context(), goahttp.AcceptTypeKey, r.Header.Get("Accept"))
+		ctx = context.WithValue(ctx, goa.MethodKey, "list-groupsio-mailing-lists-by-visibility")
+		ctx = context.WithValue(ctx, goa.ServiceKey, "mailing-list")
+		payload, err := decodeRequest(r)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil && errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		res, err := endpoint(ctx, payload)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil && errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		if err := encodeResponse(ctx, w, res); err != nil {
+			if errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+		}
+	})
+}
+
+// MountListGroupsioMailingListsUpdatedSinceHandler configures the mux to serve
+// the "mailing-list" service "list-groupsio-mailing-lists-updated-since"
+// endpoint.
+func MountListGroupsioMailingListsUpdatedSinceHandler(mux goahttp.Muxer, h http.Handler) {
+	f, ok := h.(http.HandlerFunc)
+	if !ok {
+		f = func(w http.ResponseWriter, r *http.Request) {
+			h.ServeHTTP(w, r)
+		}
+	}
+	mux.Handle("GET", "/groupsio/mailing-lists/_updated_since", f)
+}
+
+// NewListGroupsioMailingListsUpdatedSinceHandler creates a HTTP handler which
+// loads the HTTP request and calls the "mailing-list" service
+// "list-groupsio-mailing-lists-updated-since" endpoint.
+func NewListGroupsioMailingListsUpdatedSinceHandler(
+	endpoint goa.Endpoint,
+	mux goahttp.Muxer,
+	decoder func(*http.Request) goahttp.Decoder,
+	encoder func(context.Context, http.ResponseWriter) goahttp.Encoder,
+	errhandler func(context.Context, http.ResponseWriter, error),
+	formatter func(ctx context.Context, err error) goahttp.Statuser,
+) http.Handler {
+	var (
+		decodeRequest  = DecodeListGroupsioMailingListsUpdatedSinceRequest(mux, decoder)
+		encodeResponse = EncodeListGroupsioMailingListsUpdatedSinceResponse(encoder)
+		encodeError    = EncodeListGroupsioMailingListsUpdatedSinceError(encoder, formatter)
+	)
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx := context.WithValue(r.Context(), goahttp.AcceptTypeKey, r.Header.Get("Accept"))
+		ctx = context.WithValue(ctx, goa.MethodKey, "list-groupsio-mailing-lists-updated-since")
+		ctx = context.WithValue(ctx, goa.ServiceKey, "mailing-list")
+		payload, err := decodeRequest(r)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil && errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		res, err := endpoint(ctx, payload)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil && errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		if err := encodeResponse(ctx, w, res); err != nil {
+			if errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+		}
+	})
+}
+
 // MountCreateGroupsioMailingListHandler configures the mux to serve the
 // "mailing-list" service "create-groupsio-mailing-list" endpoint.
 func MountCreateGroupsioMailingListHandler(mux goahttp.Muxer, h http.Handler) {
@@ -1135,21 +1512,22 @@ func NewListGroupsioMembersHandler(
 	})
 }
 
-// MountAddGroupsioMemberHandler configures the mux to serve the "mailing-list"
-// service "add-groupsio-member" endpoint.
-func MountAddGroupsioMemberHandler(mux goahttp.Muxer, h http.Handler) {
+// MountListGroupsioMembersNeedingReviewHandler configures the mux to serve the
+// "mailing-list" service "list-groupsio-members-needing-review" endpoint.
+func MountListGroupsioMembersNeedingReviewHandler(mux goahttp.Muxer, h http.Handler) {
 	f, ok := h.(http.HandlerFunc)
 	if !ok {
 		f = func(w http.ResponseWriter, r *http.Request) {
 			h.ServeHTTP(w, r)
 		}
 	}
-	mux.Handle("POST", "/groupsio/mailing-lists/{subgroup_id}/members", f)
+	mux.Handle("GET", "/groupsio/mailing-lists/{subgroup_id}/members/_needing_review", f)
 }
 
-// NewAddGroupsioMemberHandler creates a HTTP handler which loads the HTTP
-// request and calls the "mailing-list" service "add-groupsio-member" endpoint.
-func NewAddGroupsioMemberHandler(
+// NewListGroupsioMembersNeedingReviewHandler creates a HTTP handler which
+// loads the HTTP request and calls the "mailing-list" service
+// "list-groupsio-members-needing-review" endpoint.
+func NewListGroupsioMembersNeedingReviewHandler(
 	endpoint goa.Endpoint,
 	mux goahttp.Muxer,
 	decoder func(*http.Request) goahttp.Decoder,
@@ -1158,13 +1536,13 @@ func NewAddGroupsioMemberHandler(
 	formatter func(ctx context.Context, err error) goahttp.Statuser,
 ) http.Handler {
 	var (
-		decodeRequest  = DecodeAddGroupsioMemberRequest(mux, decoder)
-		encodeResponse = EncodeAddGroupsioMemberResponse(encoder)
-		encodeError    = EncodeAddGroupsioMemberError(encoder, formatter)
+		decodeRequest  = DecodeListGroupsioMembersNeedingReviewRequest(mux, decoder)
+		encodeResponse = EncodeListGroupsioMembersNeedingReviewResponse(encoder)
+		encodeError    = EncodeListGroupsioMembersNeedingReviewError(encoder, formatter)
 	)
 	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		ctx := context.WithValue(r.Context(), goahttp.AcceptTypeKey, r.Header.Get("Accept"))
-		ctx = context.WithValue(ctx, goa.MethodKey, "add-groupsio-member")
+		ctx = context.WithValue(ctx, goa.MethodKey, "list-groupsio-members-needing-review")
 		ctx = context.WithValue(ctx, goa.ServiceKey, "mailing-list")
 		payload, err := decodeRequest(r)
 		if err != nil {
@@ -1188,21 +1566,22 @@ func NewAddGroupsioMemberHandler(
 	})
 }
 
-// MountGetGroupsioMemberHandler configures the mux to serve the "mailing-list"
-// service "get-groupsio-member" endpoint.
-func MountGetGroupsioMemberHandler(mux goahttp.Muxer, h http.Handler) {
+// MountListGroupsioMembersModifiedSinceHandler configures the mux to serve the
+// "mailing-list" service "list-groupsio-members-modified-since" endpoint.
+func MountListGroupsioMembersModifiedSinceHandler(mux goahttp.Muxer, h http.Handler) {
 	f, ok := h.(http.HandlerFunc)
 	if !ok {
 		f = func(w http.ResponseWriter, r *http.Request) {
 			h.ServeHTTP(w, r)
 		}
 	}
-	mux.Handle("GET", "/groupsio/mailing-lists/{subgroup_id}/members/{member_id}", f)
+	mux.Handle("GET", "/groupsio/mailing-lists/{subgroup_id}/members/_modified_since", f)
 }
 
-// NewGetGroupsioMemberHandler creates a HTTP handler which loads the HTTP
-// request and calls the "mailing-list" service "get-groupsio-member" endpoint.
-func NewGetGroupsioMemberHandler(
+// NewListGroupsioMembersModifiedSinceHandler creates a HTTP handler which
+// loads the HTTP request and calls the "mailing-list" service
+// "list-groupsio-members-modified-since" endpoint.
+func NewListGroupsioMembersModifiedSinceHandler(
 	endpoint goa.Endpoint,
 	mux goahttp.Muxer,
 	decoder func(*http.Request) goahttp.Decoder,
@@ -1211,13 +1590,13 @@ func NewGetGroupsioMemberHandler(
 	formatter func(ctx context.Context, err error) goahttp.Statuser,
 ) http.Handler {
 	var (
-		decodeRequest  = DecodeGetGroupsioMemberRequest(mux, decoder)
-		encodeResponse = EncodeGetGroupsioMemberResponse(encoder)
-		encodeError    = EncodeGetGroupsioMemberError(encoder, formatter)
+		decodeRequest  = DecodeListGroupsioMembersModifiedSinceRequest(mux, decoder)
+		encodeResponse = EncodeListGroupsioMembersModifiedSinceResponse(encoder)
+		encodeError    = EncodeListGroupsioMembersModifiedSinceError(encoder, formatter)
 	)
 	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		ctx := context.WithValue(r.Context(), goahttp.AcceptTypeKey, r.Header.Get("Accept"))
-		ctx = context.WithValue(ctx, goa.MethodKey, "get-groupsio-member")
+		ctx = context.WithValue(ctx, goa.MethodKey, "list-groupsio-members-modified-since")
 		ctx = context.WithValue(ctx, goa.ServiceKey, "mailing-list")
 		payload, err := decodeRequest(r)
 		if err != nil {
@@ -1241,22 +1620,22 @@ func NewGetGroupsioMemberHandler(
 	})
 }
 
-// MountUpdateGroupsioMemberHandler configures the mux to serve the
-// "mailing-list" service "update-groupsio-member" endpoint.
-func MountUpdateGroupsioMemberHandler(mux goahttp.Muxer, h http.Handler) {
+// MountListGroupsioMembersByOrganizationHandler configures the mux to serve
+// the "mailing-list" service "list-groupsio-members-by-organization" endpoint.
+func MountListGroupsioMembersByOrganizationHandler(mux goahttp.Muxer, h http.Handler) {
 	f, ok := h.(http.HandlerFunc)
 	if !ok {
 		f = func(w http.ResponseWriter, r *http.Request) {
 			h.ServeHTTP(w, r)
 		}
 	}
-	mux.Handle("PUT", "/groupsio/mailing-lists/{subgroup_id}/members/{member_id}", f)
+	mux.Handle("GET", "/groupsio/mailing-lists/{subgroup_id}/members/_by_organization", f)
 }
 
-// NewUpdateGroupsioMemberHandler creates a HTTP handler which loads the HTTP
-// request and calls the "mailing-list" service "update-groupsio-member"
-// endpoint.
-func NewUpdateGroupsioMemberHandler(
+// NewListGroupsioMembersByOrganizationHandler creates a HTTP handler which
+// loads the HTTP request and calls the "mailing-list" service
+// "list-groupsio-members-by-organization" endpoint.
+func NewListGroupsioMembersByOrganizationHandler(
 	endpoint goa.Endpoint,
 	mux goahttp.Muxer,
 	decoder func(*http.Request) goahttp.Decoder,
@@ -1265,13 +1644,13 @@ func NewUpdateGroupsioMemberHandler(
 	formatter func(ctx context.Context, err error) goahttp.Statuser,
 ) http.Handler {
 	var (
-		decodeRequest  = DecodeUpdateGroupsioMemberRequest(mux, decoder)
-		encodeResponse = EncodeUpdateGroupsioMemberResponse(encoder)
-		encodeError    = EncodeUpdateGroupsioMemberError(encoder, formatter)
+		decodeRequest  = DecodeListGroupsioMembersByOrganizationRequest(mux, decoder)
+		encodeResponse = EncodeListGroupsioMembersByOrganizationResponse(encoder)
+		encodeError    = EncodeListGroupsioMembersByOrganizationError(encoder, formatter)
 	)
 	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		ctx := context.WithValue(r.Context(), goahttp.AcceptTypeKey, r.Header.Get("Accept"))
-		ctx = context.WithValue(ctx, goa.MethodKey, "update-groupsio-member")
+		ctx = context.WithValue(ctx, goa.MethodKey, "list-groupsio-members-by-organization")
 		ctx = context.WithValue(ctx, goa.ServiceKey, "mailing-list")
 		payload, err := decodeRequest(r)
 		if err != nil {
@@ -1295,9 +1674,331 @@ func NewUpdateGroupsioMemberHandler(
 	})
 }
 
-// MountDeleteGroupsioMemberHandler configures the mux to serve the
-// "mailing-list" service "delete-groupsio-member" endpoint.
-func MountDeleteGroupsioMemberHandler(mux goahttp.Muxer, h http.Handler) {
+// MountListGroupsioDuplicateMembersHandler configures the mux to serve the
+// "mailing-list" service "list-groupsio-duplicate-members" endpoint.
+func MountListGroupsioDuplicateMembersHandler(mux goahttp.Muxer, h http.Handler) {
+	f, ok := h.(http.HandlerFunc)
+	if !ok {
+		f = func(w http.ResponseWriter, r *http.Request) {
+			h.ServeHTTP(w, r)
+		}
+	}
+	mux.Handle("GET", "/groupsio/mailing-lists/{subgroup_id}/members/_duplicates", f)
+}
+
+// NewListGroupsioDuplicateMembersHandler creates a HTTP handler which loads
+// the HTTP request and calls the "mailing-list" service
+// "list-groupsio-duplicate-members" endpoint.
+func NewListGroupsioDuplicateMembersHandler(
+	endpoint goa.Endpoint,
+	mux goahttp.Muxer,
+	decoder func(*http.Request) goahttp.Decoder,
+	encoder func(context.Context, http.ResponseWriter) goahttp.Encoder,
+	errhandler func(context.Context, http.ResponseWriter, error),
+	formatter func(ctx context.Context, err error) goahttp.Statuser,
+) http.Handler {
+	var (
+		decodeRequest  = DecodeListGroupsioDuplicateMembersRequest(mux, decoder)
+		encodeResponse = EncodeListGroupsioDuplicateMembersResponse(encoder)
+		encodeError    = EncodeListGroupsioDuplicateMembersError(encoder, formatter)
+	)
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx := context.WithValue(r.Context(), goahttp.AcceptTypeKey, r.Header.Get("Accept"))
+		ctx = context.WithValue(ctx, goa.MethodKey, "list-groupsio-duplicate-members")
+		ctx = context.WithValue(ctx, goa.ServiceKey, "mailing-list")
+		payload, err := decodeRequest(r)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil && errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		res, err := endpoint(ctx, payload)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil && errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		if err := encodeResponse(ctx, w, res); err != nil {
+			if errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+		}
+	})
+}
+
+// MountPreviewGroupsioDeliveryModeChangeHandler configures the mux to serve
+// the "mailing-list" service "preview-groupsio-delivery-mode-change" endpoint.
+func MountPreviewGroupsioDeliveryModeChangeHandler(mux goahttp.Muxer, h http.Handler) {
+	f, ok := h.(http.HandlerFunc)
+	if !ok {
+		f = func(w http.ResponseWriter, r *http.Request) {
+			h.ServeHTTP(w, r)
+		}
+	}
+	mux.Handle("GET", "/groupsio/mailing-lists/{subgroup_id}/members/_delivery_mode_preview", f)
+}
+
+// NewPreviewGroupsioDeliveryModeChangeHandler creates a HTTP handler which
+// loads the HTTP request and calls the "mailing-list" service
+// "preview-groupsio-delivery-mode-change" endpoint.
+func NewPreviewGroupsioDeliveryModeChangeHandler(
+	endpoint goa.Endpoint,
+	mux goahttp.Muxer,
+	decoder func(*http.Request) goahttp.Decoder,
+	encoder func(context.Context, http.ResponseWriter) goahttp.Encoder,
+	errhandler func(context.Context, http.ResponseWriter, error),
+	formatter func(ctx context.Context, err error) goahttp.Statuser,
+) http.Handler {
+	var (
+		decodeRequest  = DecodePreviewGroupsioDeliveryModeChangeRequest(mux, decoder)
+		encodeResponse = EncodePreviewGroupsioDeliveryModeChangeResponse(encoder)
+		encodeError    = EncodePreviewGroupsioDeliveryModeChangeError(encoder, formatter)
+	)
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx := context.WithValue(r.Context(), goahttp.AcceptTypeKey, r.Header.Get("Accept"))
+		ctx = context.WithValue(ctx, goa.MethodKey, "preview-groupsio-delivery-mode-change")
+		ctx = context.WithValue(ctx, goa.ServiceKey, "mailing-list")
+		payload, err := decodeRequest(r)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil && errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		res, err := endpoint(ctx, payload)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil && errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		if err := encodeResponse(ctx, w, res); err != nil {
+			if errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+		}
+	})
+}
+
+// MountAddGroupsioMemberHandler configures the mux to serve the "mailing-list"
+// service "add-groupsio-member" endpoint.
+func MountAddGroupsioMemberHandler(mux goahttp.Muxer, h http.Handler) {
+	f, ok := h.(http.HandlerFunc)
+	if !ok {
+		f = func(w http.ResponseWriter, r *http.Request) {
+			h.ServeHTTP(w, r)
+		}
+	}
+	mux.Handle("POST", "/groupsio/mailing-lists/{subgroup_id}/members", f)
+}
+
+// NewAddGroupsioMemberHandler creates a HTTP handler which loads the HTTP
+// request and calls the "mailing-list" service "add-groupsio-member" endpoint.
+func NewAddGroupsioMemberHandler(
+	endpoint goa.Endpoint,
+	mux goahttp.Muxer,
+	decoder func(*http.Request) goahttp.Decoder,
+	encoder func(context.Context, http.ResponseWriter) goahttp.Encoder,
+	errhandler func(context.Context, http.ResponseWriter, error),
+	formatter func(ctx context.Context, err error) goahttp.Statuser,
+) http.Handler {
+	var (
+		decodeRequest  = DecodeAddGroupsioMemberRequest(mux, decoder)
+		encodeResponse = EncodeAddGroupsioMemberResponse(encoder)
+		encodeError    = EncodeAddGroupsioMemberError(encoder, formatter)
+	)
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx := context.WithValue(r.Context(), goahttp.AcceptTypeKey, r.Header.Get("Accept"))
+		ctx = context.WithValue(ctx, goa.MethodKey, "add-groupsio-member")
+		ctx = context.WithValue(ctx, goa.ServiceKey, "mailing-list")
+		payload, err := decodeRequest(r)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil && errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		res, err := endpoint(ctx, payload)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil && errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		if err := encodeResponse(ctx, w, res); err != nil {
+			if errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+		}
+	})
+}
+
+// MountUpsertGroupsioMemberHandler configures the mux to serve the
+// "mailing-list" service "upsert-groupsio-member" endpoint.
+func MountUpsertGroupsioMemberHandler(mux goahttp.Muxer, h http.Handler) {
+	f, ok := h.(http.HandlerFunc)
+	if !ok {
+		f = func(w http.ResponseWriter, r *http.Request) {
+			h.ServeHTTP(w, r)
+		}
+	}
+	mux.Handle("PUT", "/groupsio/mailing-lists/{subgroup_id}/members/_upsert", f)
+}
+
+// NewUpsertGroupsioMemberHandler creates a HTTP handler which loads the HTTP
+// request and calls the "mailing-list" service "upsert-groupsio-member"
+// endpoint.
+func NewUpsertGroupsioMemberHandler(
+	endpoint goa.Endpoint,
+	mux goahttp.Muxer,
+	decoder func(*http.Request) goahttp.Decoder,
+	encoder func(context.Context, http.ResponseWriter) goahttp.Encoder,
+	errhandler func(context.Context, http.ResponseWriter, error),
+	formatter func(ctx context.Context, err error) goahttp.Statuser,
+) http.Handler {
+	var (
+		decodeRequest  = DecodeUpsertGroupsioMemberRequest(mux, decoder)
+		encodeResponse = EncodeUpsertGroupsioMemberResponse(encoder)
+		encodeError    = EncodeUpsertGroupsioMemberError(encoder, formatter)
+	)
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx := context.WithValue(r.Context(), goahttp.AcceptTypeKey, r.Header.Get("Accept"))
+		ctx = context.WithValue(ctx, goa.MethodKey, "upsert-groupsio-member")
+		ctx = context.WithValue(ctx, goa.ServiceKey, "mailing-list")
+		payload, err := decodeRequest(r)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil && errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		res, err := endpoint(ctx, payload)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil && errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		if err := encodeResponse(ctx, w, res); err != nil {
+			if errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+		}
+	})
+}
+
+// MountGetGroupsioMemberHandler configures the mux to serve the "mailing-list"
+// service "get-groupsio-member" endpoint.
+func MountGetGroupsioMemberHandler(mux goahttp.Muxer, h http.Handler) {
+	f, ok := h.(http.HandlerFunc)
+	if !ok {
+		f = func(w http.ResponseWriter, r *http.Request) {
+			h.ServeHTTP(w, r)
+		}
+	}
+	mux.Handle("GET", "/groupsio/mailing-lists/{subgroup_id}/members/{member_id}", f)
+}
+
+// NewGetGroupsioMemberHandler creates a HTTP handler which loads the HTTP
+// request and calls the "mailing-list" service "get-groupsio-member" endpoint.
+func NewGetGroupsioMemberHandler(
+	endpoint goa.Endpoint,
+	mux goahttp.Muxer,
+	decoder func(*http.Request) goahttp.Decoder,
+	encoder func(context.Context, http.ResponseWriter) goahttp.Encoder,
+	errhandler func(context.Context, http.ResponseWriter, error),
+	formatter func(ctx context.Context, err error) goahttp.Statuser,
+) http.Handler {
+	var (
+		decodeRequest  = DecodeGetGroupsioMemberRequest(mux, decoder)
+		encodeResponse = EncodeGetGroupsioMemberResponse(encoder)
+		encodeError    = EncodeGetGroupsioMemberError(encoder, formatter)
+	)
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx := context.WithValue(r.Context(), goahttp.AcceptTypeKey, r.Header.Get("Accept"))
+		ctx = context.WithValue(ctx, goa.MethodKey, "get-groupsio-member")
+		ctx = context.WithValue(ctx, goa.ServiceKey, "mailing-list")
+		payload, err := decodeRequest(r)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil && errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		res, err := endpoint(ctx, payload)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil && errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		if err := encodeResponse(ctx, w, res); err != nil {
+			if errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+		}
+	})
+}
+
+// MountUpdateGroupsioMemberHandler configures the mux to serve the
+// "mailing-list" service "update-groupsio-member" endpoint.
+func MountUpdateGroupsioMemberHandler(mux goahttp.Muxer, h http.Handler) {
+	f, ok := h.(http.HandlerFunc)
+	if !ok {
+		f = func(w http.ResponseWriter, r *http.Request) {
+			h.ServeHTTP(w, r)
+		}
+	}
+	mux.Handle("PUT", "/groupsio/mailing-lists/{subgroup_id}/members/{member_id}", f)
+}
+
+// NewUpdateGroupsioMemberHandler creates a HTTP handler which loads the HTTP
+// request and calls the "mailing-list" service "update-groupsio-member"
+// endpoint.
+func NewUpdateGroupsioMemberHandler(
+	endpoint goa.Endpoint,
+	mux goahttp.Muxer,
+	decoder func(*http.Request) goahttp.Decoder,
+	encoder func(context.Context, http.ResponseWriter) goahttp.Encoder,
+	errhandler func(context.Context, http.ResponseWriter, error),
+	formatter func(ctx context.Context, err error) goahttp.Statuser,
+) http.Handler {
+	var (
+		decodeRequest  = DecodeUpdateGroupsioMemberRequest(mux, decoder)
+		encodeResponse = EncodeUpdateGroupsioMemberResponse(encoder)
+		encodeError    = EncodeUpdateGroupsioMemberError(encoder, formatter)
+	)
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx := context.WithValue(r.Context(), goahttp.AcceptTypeKey, r.Header.Get("Accept"))
+		ctx = context.WithValue(ctx, goa.MethodKey, "update-groupsio-member")
+		ctx = context.WithValue(ctx, goa.ServiceKey, "mailing-list")
+		payload, err := decodeRequest(r)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil && errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		res, err := endpoint(ctx, payload)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil && errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		if err := encodeResponse(ctx, w, res); err != nil {
+			if errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+		}
+	})
+}
+
+// MountDeleteGroupsioMemberHandler configures the mux to serve the
+// "mailing-list" service "delete-groupsio-member" endpoint.
+func MountDeleteGroupsioMemberHandler(mux goahttp.Muxer, h http.Handler) {
 	f, ok := h.(http.HandlerFunc)
 	if !ok {
 		f = func(w http.ResponseWriter, r *http.Request) {
@@ -1307,10 +2008,334 @@ func MountDeleteGroupsioMemberHandler(mux goahttp.Muxer, h http.Handler) {
 	mux.Handle("DELETE", "/groupsio/mailing-lists/{subgroup_id}/members/{member_id}", f)
 }
 
-// NewDeleteGroupsioMemberHandler creates a HTTP handler which loads the HTTP
-// request and calls the "mailing-list" service "delete-groupsio-member"
+// NewDeleteGroupsioMemberHandler creates a HTTP handler which loads the HTTP
+// request and calls the "mailing-list" service "delete-groupsio-member"
+// endpoint.
+func NewDeleteGroupsioMemberHandler(
+	endpoint goa.Endpoint,
+	mux goahttp.Muxer,
+	decoder func(*http.Request) goahttp.Decoder,
+	encoder func(context.Context, http.ResponseWriter) goahttp.Encoder,
+	errhandler func(context.Context, http.ResponseWriter, error),
+	formatter func(ctx context.Context, err error) goahttp.Statuser,
+) http.Handler {
+	var (
+		decodeRequest  = DecodeDeleteGroupsioMemberRequest(mux, decoder)
+		encodeResponse = EncodeDeleteGroupsioMemberResponse(encoder)
+		encodeError    = EncodeDeleteGroupsioMemberError(encoder, formatter)
+	)
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx := context.WithValue(r.Context(), goahttp.AcceptTypeKey, r.Header.Get("Accept"))
+		ctx = context.WithValue(ctx, goa.MethodKey, "delete-groupsio-member")
+		ctx = context.WithValue(ctx, goa.ServiceKey, "mailing-list")
+		payload, err := decodeRequest(r)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil && errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		res, err := endpoint(ctx, payload)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil && errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		if err := encodeResponse(ctx, w, res); err != nil {
+			if errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+		}
+	})
+}
+
+// MountInviteGroupsioMembersHandler configures the mux to serve the
+// "mailing-list" service "invite-groupsio-members" endpoint.
+func MountInviteGroupsioMembersHandler(mux goahttp.Muxer, h http.Handler) {
+	f, ok := h.(http.HandlerFunc)
+	if !ok {
+		f = func(w http.ResponseWriter, r *http.Request) {
+			h.ServeHTTP(w, r)
+		}
+	}
+	mux.Handle("POST", "/groupsio/mailing-lists/{subgroup_id}/invitemembers", f)
+}
+
+// NewInviteGroupsioMembersHandler creates a HTTP handler which loads the HTTP
+// request and calls the "mailing-list" service "invite-groupsio-members"
+// endpoint.
+func NewInviteGroupsioMembersHandler(
+	endpoint goa.Endpoint,
+	mux goahttp.Muxer,
+	decoder func(*http.Request) goahttp.Decoder,
+	encoder func(context.Context, http.ResponseWriter) goahttp.Encoder,
+	errhandler func(context.Context, http.ResponseWriter, error),
+	formatter func(ctx context.Context, err error) goahttp.Statuser,
+) http.Handler {
+	var (
+		decodeRequest  = DecodeInviteGroupsioMembersRequest(mux, decoder)
+		encodeResponse = EncodeInviteGroupsioMembersResponse(encoder)
+		encodeError    = EncodeInviteGroupsioMembersError(encoder, formatter)
+	)
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx := context.WithValue(r.Context(), goahttp.AcceptTypeKey, r.Header.Get("Accept"))
+		ctx = context.WithValue(ctx, goa.MethodKey, "invite-groupsio-members")
+		ctx = context.WithValue(ctx, goa.ServiceKey, "mailing-list")
+		payload, err := decodeRequest(r)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil && errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		res, err := endpoint(ctx, payload)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil && errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		if err := encodeResponse(ctx, w, res); err != nil {
+			if errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+		}
+	})
+}
+
+// MountImportGroupsioMembersHandler configures the mux to serve the
+// "mailing-list" service "import-groupsio-members" endpoint.
+func MountImportGroupsioMembersHandler(mux goahttp.Muxer, h http.Handler) {
+	f, ok := h.(http.HandlerFunc)
+	if !ok {
+		f = func(w http.ResponseWriter, r *http.Request) {
+			h.ServeHTTP(w, r)
+		}
+	}
+	mux.Handle("POST", "/groupsio/mailing-lists/{subgroup_id}/members/_import", f)
+}
+
+// NewImportGroupsioMembersHandler creates a HTTP handler which loads the HTTP
+// request and calls the "mailing-list" service "import-groupsio-members"
+// endpoint.
+func NewImportGroupsioMembersHandler(
+	endpoint goa.Endpoint,
+	mux goahttp.Muxer,
+	decoder func(*http.Request) goahttp.Decoder,
+	encoder func(context.Context, http.ResponseWriter) goahttp.Encoder,
+	errhandler func(context.Context, http.ResponseWriter, error),
+	formatter func(ctx context.Context, err error) goahttp.Statuser,
+) http.Handler {
+	var (
+		decodeRequest  = DecodeImportGroupsioMembersRequest(mux, decoder)
+		encodeResponse = EncodeImportGroupsioMembersResponse(encoder)
+		encodeError    = EncodeImportGroupsioMembersError(encoder, formatter)
+	)
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx := context.WithValue(r.Context(), goahttp.AcceptTypeKey, r.Header.Get("Accept"))
+		ctx = context.WithValue(ctx, goa.MethodKey, "import-groupsio-members")
+		ctx = context.WithValue(ctx, goa.ServiceKey, "mailing-list")
+		payload, err := decodeRequest(r)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil && errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		res, err := endpoint(ctx, payload)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil && errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		if err := encodeResponse(ctx, w, res); err != nil {
+			if errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+		}
+	})
+}
+
+// MountMergeGroupsioMembersHandler configures the mux to serve the
+// "mailing-list" service "merge-groupsio-members" endpoint.
+func MountMergeGroupsioMembersHandler(mux goahttp.Muxer, h http.Handler) {
+	f, ok := h.(http.HandlerFunc)
+	if !ok {
+		f = func(w http.ResponseWriter, r *http.Request) {
+			h.ServeHTTP(w, r)
+		}
+	}
+	mux.Handle("POST", "/groupsio/mailing-lists/{subgroup_id}/members/_merge", f)
+}
+
+// NewMergeGroupsioMembersHandler creates a HTTP handler which loads the HTTP
+// request and calls the "mailing-list" service "merge-groupsio-members"
+// endpoint.
+func NewMergeGroupsioMembersHandler(
+	endpoint goa.Endpoint,
+	mux goahttp.Muxer,
+	decoder func(*http.Request) goahttp.Decoder,
+	encoder func(context.Context, http.ResponseWriter) goahttp.Encoder,
+	errhandler func(context.Context, http.ResponseWriter, error),
+	formatter func(ctx context.Context, err error) goahttp.Statuser,
+) http.Handler {
+	var (
+		decodeRequest  = DecodeMergeGroupsioMembersRequest(mux, decoder)
+		encodeResponse = EncodeMergeGroupsioMembersResponse(encoder)
+		encodeError    = EncodeMergeGroupsioMembersError(encoder, formatter)
+	)
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx := context.WithValue(r.Context(), goahttp.AcceptTypeKey, r.Header.Get("Accept"))
+		ctx = context.WithValue(ctx, goa.MethodKey, "merge-groupsio-members")
+		ctx = context.WithValue(ctx, goa.ServiceKey, "mailing-list")
+		payload, err := decodeRequest(r)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil && errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		res, err := endpoint(ctx, payload)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil && errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		if err := encodeResponse(ctx, w, res); err != nil {
+			if errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+		}
+	})
+}
+
+// MountChangeGroupsioMemberEmailHandler configures the mux to serve the
+// "mailing-list" service "change-groupsio-member-email" endpoint.
+func MountChangeGroupsioMemberEmailHandler(mux goahttp.Muxer, h http.Handler) {
+	f, ok := h.(http.HandlerFunc)
+	if !ok {
+		f = func(w http.ResponseWriter, r *http.Request) {
+			h.ServeHTTP(w, r)
+		}
+	}
+	mux.Handle("PUT", "/groupsio/mailing-lists/{subgroup_id}/members/{member_id}/email", f)
+}
+
+// NewChangeGroupsioMemberEmailHandler creates a HTTP handler which loads the
+// HTTP request and calls the "mailing-list" service
+// "change-groupsio-member-email" endpoint.
+func NewChangeGroupsioMemberEmailHandler(
+	endpoint goa.Endpoint,
+	mux goahttp.Muxer,
+	decoder func(*http.Request) goahttp.Decoder,
+	encoder func(context.Context, http.ResponseWriter) goahttp.Encoder,
+	errhandler func(context.Context, http.ResponseWriter, error),
+	formatter func(ctx context.Context, err error) goahttp.Statuser,
+) http.Handler {
+	var (
+		decodeRequest  = DecodeChangeGroupsioMemberEmailRequest(mux, decoder)
+		encodeResponse = EncodeChangeGroupsioMemberEmailResponse(encoder)
+		encodeError    = EncodeChangeGroupsioMemberEmailError(encoder, formatter)
+	)
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx := context.WithValue(r.Context(), goahttp.AcceptTypeKey, r.Header.Get("Accept"))
+		ctx = context.WithValue(ctx, goa.MethodKey, "change-groupsio-member-email")
+		ctx = context.WithValue(ctx, goa.ServiceKey, "mailing-list")
+		payload, err := decodeRequest(r)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil && errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		res, err := endpoint(ctx, payload)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil && errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		if err := encodeResponse(ctx, w, res); err != nil {
+			if errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+		}
+	})
+}
+
+// MountCheckGroupsioSubscriberHandler configures the mux to serve the
+// "mailing-list" service "check-groupsio-subscriber" endpoint.
+func MountCheckGroupsioSubscriberHandler(mux goahttp.Muxer, h http.Handler) {
+	f, ok := h.(http.HandlerFunc)
+	if !ok {
+		f = func(w http.ResponseWriter, r *http.Request) {
+			h.ServeHTTP(w, r)
+		}
+	}
+	mux.Handle("POST", "/groupsio/checksubscriber", f)
+}
+
+// NewCheckGroupsioSubscriberHandler creates a HTTP handler which loads the
+// HTTP request and calls the "mailing-list" service
+// "check-groupsio-subscriber" endpoint.
+func NewCheckGroupsioSubscriberHandler(
+	endpoint goa.Endpoint,
+	mux goahttp.Muxer,
+	decoder func(*http.Request) goahttp.Decoder,
+	encoder func(context.Context, http.ResponseWriter) goahttp.Encoder,
+	errhandler func(context.Context, http.ResponseWriter, error),
+	formatter func(ctx context.Context, err error) goahttp.Statuser,
+) http.Handler {
+	var (
+		decodeRequest  = DecodeCheckGroupsioSubscriberRequest(mux, decoder)
+		encodeResponse = EncodeCheckGroupsioSubscriberResponse(encoder)
+		encodeError    = EncodeCheckGroupsioSubscriberError(encoder, formatter)
+	)
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx := context.WithValue(r.Context(), goahttp.AcceptTypeKey, r.Header.Get("Accept"))
+		ctx = context.WithValue(ctx, goa.MethodKey, "check-groupsio-subscriber")
+		ctx = context.WithValue(ctx, goa.ServiceKey, "mailing-list")
+		payload, err := decodeRequest(r)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil && errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		res, err := endpoint(ctx, payload)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil && errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		if err := encodeResponse(ctx, w, res); err != nil {
+			if errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+		}
+	})
+}
+
+// MountGetGroupsioArtifactHandler configures the mux to serve the
+// "mailing-list" service "get-groupsio-artifact" endpoint.
+func MountGetGroupsioArtifactHandler(mux goahttp.Muxer, h http.Handler) {
+	f, ok := h.(http.HandlerFunc)
+	if !ok {
+		f = func(w http.ResponseWriter, r *http.Request) {
+			h.ServeHTTP(w, r)
+		}
+	}
+	mux.Handle("GET", "/groupsio/mailing-lists/{subgroup_id}/artifacts/{artifact_id}", f)
+}
+
+// NewGetGroupsioArtifactHandler creates a HTTP handler which loads the HTTP
+// request and calls the "mailing-list" service "get-groupsio-artifact"
 // endpoint.
-func NewDeleteGroupsioMemberHandler(
+func NewGetGroupsioArtifactHandler(
 	endpoint goa.Endpoint,
 	mux goahttp.Muxer,
 	decoder func(*http.Request) goahttp.Decoder,
@@ -1319,13 +2344,13 @@ func NewDeleteGroupsioMemberHandler(
 	formatter func(ctx context.Context, err error) goahttp.Statuser,
 ) http.Handler {
 	var (
-		decodeRequest  = DecodeDeleteGroupsioMemberRequest(mux, decoder)
-		encodeResponse = EncodeDeleteGroupsioMemberResponse(encoder)
-		encodeError    = EncodeDeleteGroupsioMemberError(encoder, formatter)
+		decodeRequest  = DecodeGetGroupsioArtifactRequest(mux, decoder)
+		encodeResponse = EncodeGetGroupsioArtifactResponse(encoder)
+		encodeError    = EncodeGetGroupsioArtifactError(encoder, formatter)
 	)
 	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		ctx := context.WithValue(r.Context(), goahttp.AcceptTypeKey, r.Header.Get("Accept"))
-		ctx = context.WithValue(ctx, goa.MethodKey, "delete-groupsio-member")
+		ctx = context.WithValue(ctx, goa.MethodKey, "get-groupsio-artifact")
 		ctx = context.WithValue(ctx, goa.ServiceKey, "mailing-list")
 		payload, err := decodeRequest(r)
 		if err != nil {
@@ -1349,22 +2374,22 @@ func NewDeleteGroupsioMemberHandler(
 	})
 }
 
-// MountInviteGroupsioMembersHandler configures the mux to serve the
-// "mailing-list" service "invite-groupsio-members" endpoint.
-func MountInviteGroupsioMembersHandler(mux goahttp.Muxer, h http.Handler) {
+// MountGetGroupsioArtifactDownloadHandler configures the mux to serve the
+// "mailing-list" service "get-groupsio-artifact-download" endpoint.
+func MountGetGroupsioArtifactDownloadHandler(mux goahttp.Muxer, h http.Handler) {
 	f, ok := h.(http.HandlerFunc)
 	if !ok {
 		f = func(w http.ResponseWriter, r *http.Request) {
 			h.ServeHTTP(w, r)
 		}
 	}
-	mux.Handle("POST", "/groupsio/mailing-lists/{subgroup_id}/invitemembers", f)
+	mux.Handle("GET", "/groupsio/mailing-lists/{subgroup_id}/artifacts/{artifact_id}/download", f)
 }
 
-// NewInviteGroupsioMembersHandler creates a HTTP handler which loads the HTTP
-// request and calls the "mailing-list" service "invite-groupsio-members"
-// endpoint.
-func NewInviteGroupsioMembersHandler(
+// NewGetGroupsioArtifactDownloadHandler creates a HTTP handler which loads the
+// HTTP request and calls the "mailing-list" service
+// "get-groupsio-artifact-download" endpoint.
+func NewGetGroupsioArtifactDownloadHandler(
 	endpoint goa.Endpoint,
 	mux goahttp.Muxer,
 	decoder func(*http.Request) goahttp.Decoder,
@@ -1373,13 +2398,13 @@ func NewInviteGroupsioMembersHandler(
 	formatter func(ctx context.Context, err error) goahttp.Statuser,
 ) http.Handler {
 	var (
-		decodeRequest  = DecodeInviteGroupsioMembersRequest(mux, decoder)
-		encodeResponse = EncodeInviteGroupsioMembersResponse(encoder)
-		encodeError    = EncodeInviteGroupsioMembersError(encoder, formatter)
+		decodeRequest  = DecodeGetGroupsioArtifactDownloadRequest(mux, decoder)
+		encodeResponse = EncodeGetGroupsioArtifactDownloadResponse(encoder)
+		encodeError    = EncodeGetGroupsioArtifactDownloadError(encoder, formatter)
 	)
 	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		ctx := context.WithValue(r.Context(), goahttp.AcceptTypeKey, r.Header.Get("Accept"))
-		ctx = context.WithValue(ctx, goa.MethodKey, "invite-groupsio-members")
+		ctx = context.WithValue(ctx, goa.MethodKey, "get-groupsio-artifact-download")
 		ctx = context.WithValue(ctx, goa.ServiceKey, "mailing-list")
 		payload, err := decodeRequest(r)
 		if err != nil {
@@ -1403,22 +2428,22 @@ func NewInviteGroupsioMembersHandler(
 	})
 }
 
-// MountCheckGroupsioSubscriberHandler configures the mux to serve the
-// "mailing-list" service "check-groupsio-subscriber" endpoint.
-func MountCheckGroupsioSubscriberHandler(mux goahttp.Muxer, h http.Handler) {
+// MountGetGroupsioProjectSummaryHandler configures the mux to serve the
+// "mailing-list" service "get-groupsio-project-summary" endpoint.
+func MountGetGroupsioProjectSummaryHandler(mux goahttp.Muxer, h http.Handler) {
 	f, ok := h.(http.HandlerFunc)
 	if !ok {
 		f = func(w http.ResponseWriter, r *http.Request) {
 			h.ServeHTTP(w, r)
 		}
 	}
-	mux.Handle("POST", "/groupsio/checksubscriber", f)
+	mux.Handle("GET", "/groupsio/projects/{project_uid}/summary", f)
 }
 
-// NewCheckGroupsioSubscriberHandler creates a HTTP handler which loads the
+// NewGetGroupsioProjectSummaryHandler creates a HTTP handler which loads the
 // HTTP request and calls the "mailing-list" service
-// "check-groupsio-subscriber" endpoint.
-func NewCheckGroupsioSubscriberHandler(
+// "get-groupsio-project-summary" endpoint.
+func NewGetGroupsioProjectSummaryHandler(
 	endpoint goa.Endpoint,
 	mux goahttp.Muxer,
 	decoder func(*http.Request) goahttp.Decoder,
@@ -1427,13 +2452,13 @@ func NewCheckGroupsioSubscriberHandler(
 	formatter func(ctx context.Context, err error) goahttp.Statuser,
 ) http.Handler {
 	var (
-		decodeRequest  = DecodeCheckGroupsioSubscriberRequest(mux, decoder)
-		encodeResponse = EncodeCheckGroupsioSubscriberResponse(encoder)
-		encodeError    = EncodeCheckGroupsioSubscriberError(encoder, formatter)
+		decodeRequest  = DecodeGetGroupsioProjectSummaryRequest(mux, decoder)
+		encodeResponse = EncodeGetGroupsioProjectSummaryResponse(encoder)
+		encodeError    = EncodeGetGroupsioProjectSummaryError(encoder, formatter)
 	)
 	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		ctx := context.WithValue(r.Context(), goahttp.AcceptTypeKey, r.Header.Get("Accept"))
-		ctx = context.WithValue(ctx, goa.MethodKey, "check-groupsio-subscriber")
+		ctx = context.WithValue(ctx, goa.MethodKey, "get-groupsio-project-summary")
 		ctx = context.WithValue(ctx, goa.ServiceKey, "mailing-list")
 		payload, err := decodeRequest(r)
 		if err != nil {
@@ -1457,22 +2482,184 @@ func NewCheckGroupsioSubscriberHandler(
 	})
 }
 
-// MountGetGroupsioArtifactHandler configures the mux to serve the
-// "mailing-list" service "get-groupsio-artifact" endpoint.
-func MountGetGroupsioArtifactHandler(mux goahttp.Muxer, h http.Handler) {
+// MountListGroupsioOrphanedMailingListsHandler configures the mux to serve the
+// "mailing-list" service "list-groupsio-orphaned-mailing-lists" endpoint.
+func MountListGroupsioOrphanedMailingListsHandler(mux goahttp.Muxer, h http.Handler) {
 	f, ok := h.(http.HandlerFunc)
 	if !ok {
 		f = func(w http.ResponseWriter, r *http.Request) {
 			h.ServeHTTP(w, r)
 		}
 	}
-	mux.Handle("GET", "/groupsio/mailing-lists/{subgroup_id}/artifacts/{artifact_id}", f)
+	mux.Handle("GET", "/groupsio/projects/{project_uid}/orphaned_mailing_lists", f)
 }
 
-// NewGetGroupsioArtifactHandler creates a HTTP handler which loads the HTTP
-// request and calls the "mailing-list" service "get-groupsio-artifact"
+// NewListGroupsioOrphanedMailingListsHandler creates a HTTP handler which
+// loads the HTTP request and calls the "mailing-list" service
+// "list-groupsio-orphaned-mailing-lists" endpoint.
+func NewListGroupsioOrphanedMailingListsHandler(
+	endpoint goa.Endpoint,
+	mux goahttp.Muxer,
+	decoder func(*http.Request) goahttp.Decoder,
+	encoder func(context.Context, http.ResponseWriter) goahttp.Encoder,
+	errhandler func(context.Context, http.ResponseWriter, error),
+	formatter func(ctx context.Context, err error) goahttp.Statuser,
+) http.Handler {
+	var (
+		decodeRequest  = DecodeListGroupsioOrphanedMailingListsRequest(mux, decoder)
+		encodeResponse = EncodeListGroupsioOrphanedMailingListsResponse(encoder)
+		encodeError    = EncodeListGroupsioOrphanedMailingListsError(encoder, formatter)
+	)
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx := context.WithValue(r.Context(), goahttp.AcceptTypeKey, r.Header.Get("Accept"))
+		ctx = context.WithValue(ctx, goa.MethodKey, "list-groupsio-orphaned-mailing-lists")
+		ctx = context.WithValue(ctx, goa.ServiceKey, "mailing-list")
+		payload, err := decodeRequest(r)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil && errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		res, err := endpoint(ctx, payload)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil && errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		if err := encodeResponse(ctx, w, res); err != nil {
+			if errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+		}
+	})
+}
+
+// MountRepublishGroupsioProjectIndexHandler configures the mux to serve the
+// "mailing-list" service "republish-groupsio-project-index" endpoint.
+func MountRepublishGroupsioProjectIndexHandler(mux goahttp.Muxer, h http.Handler) {
+	f, ok := h.(http.HandlerFunc)
+	if !ok {
+		f = func(w http.ResponseWriter, r *http.Request) {
+			h.ServeHTTP(w, r)
+		}
+	}
+	mux.Handle("POST", "/groupsio/projects/{project_uid}/_republish_index", f)
+}
+
+// NewRepublishGroupsioProjectIndexHandler creates a HTTP handler which loads
+// the HTTP request and calls the "mailing-list" service
+// "republish-groupsio-project-index" endpoint.
+func NewRepublishGroupsioProjectIndexHandler(
+	endpoint goa.Endpoint,
+	mux goahttp.Muxer,
+	decoder func(*http.Request) goahttp.Decoder,
+	encoder func(context.Context, http.ResponseWriter) goahttp.Encoder,
+	errhandler func(context.Context, http.ResponseWriter, error),
+	formatter func(ctx context.Context, err error) goahttp.Statuser,
+) http.Handler {
+	var (
+		decodeRequest  = DecodeRepublishGroupsioProjectIndexRequest(mux, decoder)
+		encodeResponse = EncodeRepublishGroupsioProjectIndexResponse(encoder)
+		encodeError    = EncodeRepublishGroupsioProjectIndexError(encoder, formatter)
+	)
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx := context.WithValue(r.Context(), goahttp.AcceptTypeKey, r.Header.Get("Accept"))
+		ctx = context.WithValue(ctx, goa.MethodKey, "republish-groupsio-project-index")
+		ctx = context.WithValue(ctx, goa.ServiceKey, "mailing-list")
+		payload, err := decodeRequest(r)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil && errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		res, err := endpoint(ctx, payload)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil && errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		if err := encodeResponse(ctx, w, res); err != nil {
+			if errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+		}
+	})
+}
+
+// MountGetGroupsioMailingListStatsHandler configures the mux to serve the
+// "mailing-list" service "get-groupsio-mailing-list-stats" endpoint.
+func MountGetGroupsioMailingListStatsHandler(mux goahttp.Muxer, h http.Handler) {
+	f, ok := h.(http.HandlerFunc)
+	if !ok {
+		f = func(w http.ResponseWriter, r *http.Request) {
+			h.ServeHTTP(w, r)
+		}
+	}
+	mux.Handle("GET", "/groupsio/mailing-lists/{subgroup_id}/stats", f)
+}
+
+// NewGetGroupsioMailingListStatsHandler creates a HTTP handler which loads the
+// HTTP request and calls the "mailing-list" service
+// "get-groupsio-mailing-list-stats" endpoint.
+func NewGetGroupsioMailingListStatsHandler(
+	endpoint goa.Endpoint,
+	mux goahttp.Muxer,
+	decoder func(*http.Request) goahttp.Decoder,
+	encoder func(context.Context, http.ResponseWriter) goahttp.Encoder,
+	errhandler func(context.Context, http.ResponseWriter, error),
+	formatter func(ctx context.Context, err error) goahttp.Statuser,
+) http.Handler {
+	var (
+		decodeRequest  = DecodeGetGroupsioMailingListStatsRequest(mux, decoder)
+		encodeResponse = EncodeGetGroupsioMailingListStatsResponse(encoder)
+		encodeError    = EncodeGetGroupsioMailingListStatsError(encoder, formatter)
+	)
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx := context.WithValue(r.Context(), goahttp.AcceptTypeKey, r.Header.Get("Accept"))
+		ctx = context.WithValue(ctx, goa.MethodKey, "get-groupsio-mailing-list-stats")
+		ctx = context.WithValue(ctx, goa.ServiceKey, "mailing-list")
+		payload, err := decodeRequest(r)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil && errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		res, err := endpoint(ctx, payload)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil && errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		if err := encodeResponse(ctx, w, res); err != nil {
+			if errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+		}
+	})
+}
+
+// MountGetGroupsioServiceTreeHandler configures the mux to serve the
+// "mailing-list" service "get-groupsio-service-tree" endpoint.
+func MountGetGroupsioServiceTreeHandler(mux goahttp.Muxer, h http.Handler) {
+	f, ok := h.(http.HandlerFunc)
+	if !ok {
+		f = func(w http.ResponseWriter, r *http.Request) {
+			h.ServeHTTP(w, r)
+		}
+	}
+	mux.Handle("GET", "/groupsio/services/{service_id}/tree", f)
+}
+
+// NewGetGroupsioServiceTreeHandler creates a HTTP handler which loads the HTTP
+// request and calls the "mailing-list" service "get-groupsio-service-tree"
 // endpoint.
-func NewGetGroupsioArtifactHandler(
+func NewGetGroupsioServiceTreeHandler(
 	endpoint goa.Endpoint,
 	mux goahttp.Muxer,
 	decoder func(*http.Request) goahttp.Decoder,
@@ -1481,13 +2668,13 @@ func NewGetGroupsioArtifactHandler(
 	formatter func(ctx context.Context, err error) goahttp.Statuser,
 ) http.Handler {
 	var (
-		decodeRequest  = DecodeGetGroupsioArtifactRequest(mux, decoder)
-		encodeResponse = EncodeGetGroupsioArtifactResponse(encoder)
-		encodeError    = EncodeGetGroupsioArtifactError(encoder, formatter)
+		decodeRequest  = DecodeGetGroupsioServiceTreeRequest(mux, decoder)
+		encodeResponse = EncodeGetGroupsioServiceTreeResponse(encoder)
+		encodeError    = EncodeGetGroupsioServiceTreeError(encoder, formatter)
 	)
 	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		ctx := context.WithValue(r.Context(), goahttp.AcceptTypeKey, r.Header.Get("Accept"))
-		ctx = context.WithValue(ctx, goa.MethodKey, "get-groupsio-artifact")
+		ctx = context.WithValue(ctx, goa.MethodKey, "get-groupsio-service-tree")
 		ctx = context.WithValue(ctx, goa.ServiceKey, "mailing-list")
 		payload, err := decodeRequest(r)
 		if err != nil {
@@ -1511,22 +2698,22 @@ func NewGetGroupsioArtifactHandler(
 	})
 }
 
-// MountGetGroupsioArtifactDownloadHandler configures the mux to serve the
-// "mailing-list" service "get-groupsio-artifact-download" endpoint.
-func MountGetGroupsioArtifactDownloadHandler(mux goahttp.Muxer, h http.Handler) {
+// MountGetGroupsioMemberHierarchyHandler configures the mux to serve the
+// "mailing-list" service "get-groupsio-member-hierarchy" endpoint.
+func MountGetGroupsioMemberHierarchyHandler(mux goahttp.Muxer, h http.Handler) {
 	f, ok := h.(http.HandlerFunc)
 	if !ok {
 		f = func(w http.ResponseWriter, r *http.Request) {
 			h.ServeHTTP(w, r)
 		}
 	}
-	mux.Handle("GET", "/groupsio/mailing-lists/{subgroup_id}/artifacts/{artifact_id}/download", f)
+	mux.Handle("GET", "/groupsio/mailing-lists/{subgroup_id}/members/{member_id}/hierarchy", f)
 }
 
-// NewGetGroupsioArtifactDownloadHandler creates a HTTP handler which loads the
+// NewGetGroupsioMemberHierarchyHandler creates a HTTP handler which loads the
 // HTTP request and calls the "mailing-list" service
-// "get-groupsio-artifact-download" endpoint.
-func NewGetGroupsioArtifactDownloadHandler(
+// "get-groupsio-member-hierarchy" endpoint.
+func NewGetGroupsioMemberHierarchyHandler(
 	endpoint goa.Endpoint,
 	mux goahttp.Muxer,
 	decoder func(*http.Request) goahttp.Decoder,
@@ -1535,13 +2722,67 @@ func NewGetGroupsioArtifactDownloadHandler(
 	formatter func(ctx context.Context, err error) goahttp.Statuser,
 ) http.Handler {
 	var (
-		decodeRequest  = DecodeGetGroupsioArtifactDownloadRequest(mux, decoder)
-		encodeResponse = EncodeGetGroupsioArtifactDownloadResponse(encoder)
-		encodeError    = EncodeGetGroupsioArtifactDownloadError(encoder, formatter)
+		decodeRequest  = DecodeGetGroupsioMemberHierarchyRequest(mux, decoder)
+		encodeResponse = EncodeGetGroupsioMemberHierarchyResponse(encoder)
+		encodeError    = EncodeGetGroupsioMemberHierarchyError(encoder, formatter)
 	)
 	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		ctx := context.WithValue(r.Context(), goahttp.AcceptTypeKey, r.Header.Get("Accept"))
-		ctx = context.WithValue(ctx, goa.MethodKey, "get-groupsio-artifact-download")
+		ctx = context.WithValue(ctx, goa.MethodKey, "get-groupsio-member-hierarchy")
+		ctx = context.WithValue(ctx, goa.ServiceKey, "mailing-list")
+		payload, err := decodeRequest(r)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil && errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		res, err := endpoint(ctx, payload)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil && errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		if err := encodeResponse(ctx, w, res); err != nil {
+			if errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+		}
+	})
+}
+
+// MountListGroupsioProjectMembersHandler configures the mux to serve the
+// "mailing-list" service "list-groupsio-project-members" endpoint.
+func MountListGroupsioProjectMembersHandler(mux goahttp.Muxer, h http.Handler) {
+	f, ok := h.(http.HandlerFunc)
+	if !ok {
+		f = func(w http.ResponseWriter, r *http.Request) {
+			h.ServeHTTP(w, r)
+		}
+	}
+	mux.Handle("GET", "/groupsio/projects/{project_uid}/members", f)
+}
+
+// NewListGroupsioProjectMembersHandler creates a HTTP handler which loads the
+// HTTP request and calls the "mailing-list" service
+// "list-groupsio-project-members" endpoint.
+func NewListGroupsioProjectMembersHandler(
+	endpoint goa.Endpoint,
+	mux goahttp.Muxer,
+	decoder func(*http.Request) goahttp.Decoder,
+	encoder func(context.Context, http.ResponseWriter) goahttp.Encoder,
+	errhandler func(context.Context, http.ResponseWriter, error),
+	formatter func(ctx context.Context, err error) goahttp.Statuser,
+) http.Handler {
+	var (
+		decodeRequest  = DecodeListGroupsioProjectMembersRequest(mux, decoder)
+		encodeResponse = EncodeListGroupsioProjectMembersResponse(encoder)
+		encodeError    = EncodeListGroupsioProjectMembersError(encoder, formatter)
+	)
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx := context.WithValue(r.Context(), goahttp.AcceptTypeKey, r.Header.Get("Accept"))
+		ctx = context.WithValue(ctx, goa.MethodKey, "list-groupsio-project-members")
 		ctx = context.WithValue(ctx, goa.ServiceKey, "mailing-list")
 		payload, err := decodeRequest(r)
 		if err != nil {
@@ -108,9 +108,31 @@ type AddGroupsioMemberRequestBody struct {
 	JobTitle *string `form:"job_title,omitempty" json:"job_title,omitempty" xml:"job_title,omitempty"`
 }
 
+// UpsertGroupsioMemberRequestBody is the type of the "mailing-list" service
+// "upsert-groupsio-member" endpoint HTTP request body.
+type UpsertGroupsioMemberRequestBody struct {
+	// Member email address
+	Email *string `form:"email,omitempty" json:"email,omitempty" xml:"email,omitempty"`
+	// Member display name
+	Name *string `form:"name,omitempty" json:"name,omitempty" xml:"name,omitempty"`
+	// Member type; only 'direct' is accepted for API-managed members
+	MemberType *string `form:"member_type,omitempty" json:"member_type,omitempty" xml:"member_type,omitempty"`
+	// Moderation status
+	ModStatus *string `form:"mod_status,omitempty" json:"mod_status,omitempty" xml:"mod_status,omitempty"`
+	// Email delivery mode
+	DeliveryMode *string `form:"delivery_mode,omitempty" json:"delivery_mode,omitempty" xml:"delivery_mode,omitempty"`
+	// Member organization
+	Organization *string `form:"organization,omitempty" json:"organization,omitempty" xml:"organization,omitempty"`
+	// Member job title
+	JobTitle *string `form:"job_title,omitempty" json:"job_title,omitempty" xml:"job_title,omitempty"`
+}
+
 // UpdateGroupsioMemberRequestBody is the type of the "mailing-list" service
 // "update-groupsio-member" endpoint HTTP request body.
 type UpdateGroupsioMemberRequestBody struct {
+	// Subgroup the member belongs to; a member cannot be moved, so any value other
+	// than subgroup_id is rejected
+	MailingListUID *string `form:"mailing_list_uid,omitempty" json:"mailing_list_uid,omitempty" xml:"mailing_list_uid,omitempty"`
 	// Member email address
 	Email *string `form:"email,omitempty" json:"email,omitempty" xml:"email,omitempty"`
 	// Member display name
@@ -134,6 +156,29 @@ type InviteGroupsioMembersRequestBody struct {
 	Emails []string `form:"emails,omitempty" json:"emails,omitempty" xml:"emails,omitempty"`
 }
 
+// ImportGroupsioMembersRequestBody is the type of the "mailing-list" service
+// "import-groupsio-members" endpoint HTTP request body.
+type ImportGroupsioMembersRequestBody struct {
+	// Members to add
+	Members []*GroupsioMemberRequestRequestBody `form:"members,omitempty" json:"members,omitempty" xml:"members,omitempty"`
+}
+
+// MergeGroupsioMembersRequestBody is the type of the "mailing-list" service
+// "merge-groupsio-members" endpoint HTTP request body.
+type MergeGroupsioMembersRequestBody struct {
+	// ID of the member to keep
+	KeepMemberID *string `form:"keep_member_id,omitempty" json:"keep_member_id,omitempty" xml:"keep_member_id,omitempty"`
+	// IDs of the members to remove; each must share the kept member's email
+	MergeMemberIds []string `form:"merge_member_ids,omitempty" json:"merge_member_ids,omitempty" xml:"merge_member_ids,omitempty"`
+}
+
+// ChangeGroupsioMemberEmailRequestBody is the type of the "mailing-list"
+// service "change-groupsio-member-email" endpoint HTTP request body.
+type ChangeGroupsioMemberEmailRequestBody struct {
+	// New email address
+	Email *string `form:"email,omitempty" json:"email,omitempty" xml:"email,omitempty"`
+}
+
 // CheckGroupsioSubscriberRequestBody is the type of the "mailing-list" service
 // "check-groupsio-subscriber" endpoint HTTP request body.
 type CheckGroupsioSubscriberRequestBody struct {
@@ -143,6 +188,15 @@ type CheckGroupsioSubscriberRequestBody struct {
 	SubgroupID *string `form:"subgroup_id,omitempty" json:"subgroup_id,omitempty" xml:"subgroup_id,omitempty"`
 }
 
+// ReadyzResponseBody is the type of the "mailing-list" service "readyz"
+// endpoint HTTP response body.
+type ReadyzResponseBody struct {
+	// Aggregate status
+	Status string `form:"status" json:"status" xml:"status"`
+	// Status of each dependency
+	Dependencies map[string]string `form:"dependencies,omitempty" json:"dependencies,omitempty" xml:"dependencies,omitempty"`
+}
+
 // ListGroupsioServicesResponseBody is the type of the "mailing-list" service
 // "list-groupsio-services" endpoint HTTP response body.
 type ListGroupsioServicesResponseBody struct {
@@ -221,6 +275,16 @@ type UpdateGroupsioServiceResponseBody struct {
 	UpdatedAt *string `form:"updated_at,omitempty" json:"updated_at,omitempty" xml:"updated_at,omitempty"`
 }
 
+// PromoteGroupsioServiceResponseBody is the type of the "mailing-list" service
+// "promote-groupsio-service" endpoint HTTP response body.
+type PromoteGroupsioServiceResponseBody struct {
+	// The promoted service
+	Service *GroupsioServiceResponseBody `form:"service" json:"service" xml:"service"`
+	// Subgroups of the service whose names still start with the former formation
+	// prefix; they are not renamed
+	PrefixedSubgroups []*GroupsioSubgroupResponseBody `form:"prefixed_subgroups,omitempty" json:"prefixed_subgroups,omitempty" xml:"prefixed_subgroups,omitempty"`
+}
+
 // GetGroupsioServiceProjectsResponseBody is the type of the "mailing-list"
 // service "get-groupsio-service-projects" endpoint HTTP response body.
 type GetGroupsioServiceProjectsResponseBody struct {
@@ -228,6 +292,25 @@ type GetGroupsioServiceProjectsResponseBody struct {
 	Projects []string `form:"projects,omitempty" json:"projects,omitempty" xml:"projects,omitempty"`
 }
 
+// ListGroupsioServicesByStatusResponseBody is the type of the "mailing-list"
+// service "list-groupsio-services-by-status" endpoint HTTP response body.
+type ListGroupsioServicesByStatusResponseBody struct {
+	// List of services
+	Items []*GroupsioServiceResponseBody `form:"items,omitempty" json:"items,omitempty" xml:"items,omitempty"`
+	// Total count
+	Total *int `form:"total,omitempty" json:"total,omitempty" xml:"total,omitempty"`
+}
+
+// ListUnprovisionedGroupsioServicesResponseBody is the type of the
+// "mailing-list" service "list-unprovisioned-groupsio-services" endpoint HTTP
+// response body.
+type ListUnprovisionedGroupsioServicesResponseBody struct {
+	// List of services
+	Items []*GroupsioServiceResponseBody `form:"items,omitempty" json:"items,omitempty" xml:"items,omitempty"`
+	// Total count
+	Total *int `form:"total,omitempty" json:"total,omitempty" xml:"total,omitempty"`
+}
+
 // FindParentGroupsioServiceResponseBody is the type of the "mailing-list"
 // service "find-parent-groupsio-service" endpoint HTTP response body.
 type FindParentGroupsioServiceResponseBody struct {
@@ -260,6 +343,26 @@ type ListGroupsioMailingListsResponseBody struct {
 	Total *int `form:"total,omitempty" json:"total,omitempty" xml:"total,omitempty"`
 }
 
+// ListGroupsioMailingListsByVisibilityResponseBody is the type of the
+// "mailing-list" service "list-groupsio-mailing-lists-by-visibility" endpoint
+// HTTP response body.
+type ListGroupsioMailingListsByVisibilityResponseBody struct {
+	// List of subgroups
+	Items []*GroupsioSubgroupResponseBody `form:"items,omitempty" json:"items,omitempty" xml:"items,omitempty"`
+	// Total count
+	Total *int `form:"total,omitempty" json:"total,omitempty" xml:"total,omitempty"`
+}
+
+// ListGroupsioMailingListsUpdatedSinceResponseBody is the type of the
+// "mailing-list" service "list-groupsio-mailing-lists-updated-since" endpoint
+// HTTP response body.
+type ListGroupsioMailingListsUpdatedSinceResponseBody struct {
+	// List of subgroups
+	Items []*GroupsioSubgroupResponseBody `form:"items,omitempty" json:"items,omitempty" xml:"items,omitempty"`
+	// Total count
+	Total *int `form:"total,omitempty" json:"total,omitempty" xml:"total,omitempty"`
+}
+
 // CreateGroupsioMailingListResponseBody is the type of the "mailing-list"
 // service "create-groupsio-mailing-list" endpoint HTTP response body.
 type CreateGroupsioMailingListResponseBody struct {
@@ -285,6 +388,8 @@ type CreateGroupsioMailingListResponseBody struct {
 	CreatedAt *string `form:"created_at,omitempty" json:"created_at,omitempty" xml:"created_at,omitempty"`
 	// Last update timestamp
 	UpdatedAt *string `form:"updated_at,omitempty" json:"updated_at,omitempty" xml:"updated_at,omitempty"`
+	// Best-effort follow-up steps that failed after the subgroup was saved
+	Warnings []string `form:"warnings,omitempty" json:"warnings,omitempty" xml:"warnings,omitempty"`
 }
 
 // GetGroupsioMailingListResponseBody is the type of the "mailing-list" service
@@ -312,6 +417,8 @@ type GetGroupsioMailingListResponseBody struct {
 	CreatedAt *string `form:"created_at,omitempty" json:"created_at,omitempty" xml:"created_at,omitempty"`
 	// Last update timestamp
 	UpdatedAt *string `form:"updated_at,omitempty" json:"updated_at,omitempty" xml:"updated_at,omitempty"`
+	// Best-effort follow-up steps that failed after the subgroup was saved
+	Warnings []string `form:"warnings,omitempty" json:"warnings,omitempty" xml:"warnings,omitempty"`
 }
 
 // UpdateGroupsioMailingListResponseBody is the type of the "mailing-list"
@@ -339,6 +446,8 @@ type UpdateGroupsioMailingListResponseBody struct {
 	CreatedAt *string `form:"created_at,omitempty" json:"created_at,omitempty" xml:"created_at,omitempty"`
 	// Last update timestamp
 	UpdatedAt *string `form:"updated_at,omitempty" json:"updated_at,omitempty" xml:"updated_at,omitempty"`
+	// Best-effort follow-up steps that failed after the subgroup was saved
+	Warnings []string `form:"warnings,omitempty" json:"warnings,omitempty" xml:"warnings,omitempty"`
 }
 
 // GetGroupsioMailingListCountResponseBody is the type of the "mailing-list"
@@ -365,6 +474,55 @@ type ListGroupsioMembersResponseBody struct {
 	Total *int `form:"total,omitempty" json:"total,omitempty" xml:"total,omitempty"`
 }
 
+// ListGroupsioMembersNeedingReviewResponseBody is the type of the
+// "mailing-list" service "list-groupsio-members-needing-review" endpoint HTTP
+// response body.
+type ListGroupsioMembersNeedingReviewResponseBody struct {
+	// List of members
+	Items []*GroupsioMemberResponseBody `form:"items,omitempty" json:"items,omitempty" xml:"items,omitempty"`
+	// Total count
+	Total *int `form:"total,omitempty" json:"total,omitempty" xml:"total,omitempty"`
+}
+
+// ListGroupsioMembersModifiedSinceResponseBody is the type of the
+// "mailing-list" service "list-groupsio-members-modified-since" endpoint HTTP
+// response body.
+type ListGroupsioMembersModifiedSinceResponseBody struct {
+	// List of members
+	Items []*GroupsioMemberResponseBody `form:"items,omitempty" json:"items,omitempty" xml:"items,omitempty"`
+	// Total count
+	Total *int `form:"total,omitempty" json:"total,omitempty" xml:"total,omitempty"`
+}
+
+// ListGroupsioMembersByOrganizationResponseBody is the type of the
+// "mailing-list" service "list-groupsio-members-by-organization" endpoint HTTP
+// response body.
+type ListGroupsioMembersByOrganizationResponseBody struct {
+	// List of members
+	Items []*GroupsioMemberResponseBody `form:"items,omitempty" json:"items,omitempty" xml:"items,omitempty"`
+	// Total count
+	Total *int `form:"total,omitempty" json:"total,omitempty" xml:"total,omitempty"`
+}
+
+// ListGroupsioDuplicateMembersResponseBody is the type of the "mailing-list"
+// service "list-groupsio-duplicate-members" endpoint HTTP response body.
+type ListGroupsioDuplicateMembersResponseBody struct {
+	// Each group lists the IDs of two or more members with the same email
+	Groups [][]string `form:"groups" json:"groups" xml:"groups"`
+}
+
+// PreviewGroupsioDeliveryModeChangeResponseBody is the type of the
+// "mailing-list" service "preview-groupsio-delivery-mode-change" endpoint HTTP
+// response body.
+type PreviewGroupsioDeliveryModeChangeResponseBody struct {
+	// Delivery mode the preview was computed for
+	TargetMode string `form:"target_mode" json:"target_mode" xml:"target_mode"`
+	// Emails of members on a different delivery mode
+	Affected []string `form:"affected" json:"affected" xml:"affected"`
+	// Emails of members already on target_mode
+	Unchanged []string `form:"unchanged" json:"unchanged" xml:"unchanged"`
+}
+
 // AddGroupsioMemberResponseBody is the type of the "mailing-list" service
 // "add-groupsio-member" endpoint HTTP response body.
 type AddGroupsioMemberResponseBody struct {
@@ -398,6 +556,15 @@ type AddGroupsioMemberResponseBody struct {
 	UpdatedAt *string `form:"updated_at,omitempty" json:"updated_at,omitempty" xml:"updated_at,omitempty"`
 }
 
+// UpsertGroupsioMemberResponseBody is the type of the "mailing-list" service
+// "upsert-groupsio-member" endpoint HTTP response body.
+type UpsertGroupsioMemberResponseBody struct {
+	// The member after the upsert
+	Member *GroupsioMemberResponseBody `form:"member" json:"member" xml:"member"`
+	// True when the member was added, false when an existing member was updated
+	Created bool `form:"created" json:"created" xml:"created"`
+}
+
 // GetGroupsioMemberResponseBody is the type of the "mailing-list" service
 // "get-groupsio-member" endpoint HTTP response body.
 type GetGroupsioMemberResponseBody struct {
@@ -464,6 +631,54 @@ type UpdateGroupsioMemberResponseBody struct {
 	UpdatedAt *string `form:"updated_at,omitempty" json:"updated_at,omitempty" xml:"updated_at,omitempty"`
 }
 
+// ImportGroupsioMembersResponseBody is the type of the "mailing-list" service
+// "import-groupsio-members" endpoint HTTP response body.
+type ImportGroupsioMembersResponseBody struct {
+	// Number of members added
+	Created int `form:"created" json:"created" xml:"created"`
+	// Number of members that already existed
+	Skipped int `form:"skipped" json:"skipped" xml:"skipped"`
+	// Number of members that could not be added
+	Errored int `form:"errored" json:"errored" xml:"errored"`
+	// Emails of the skipped members
+	SkippedEmails []string `form:"skipped_emails,omitempty" json:"skipped_emails,omitempty" xml:"skipped_emails,omitempty"`
+	// Emails of the members that could not be added
+	ErroredEmails []string `form:"errored_emails,omitempty" json:"errored_emails,omitempty" xml:"errored_emails,omitempty"`
+}
+
+// ChangeGroupsioMemberEmailResponseBody is the type of the "mailing-list"
+// service "change-groupsio-member-email" endpoint HTTP response body.
+type ChangeGroupsioMemberEmailResponseBody struct {
+	// Member ID
+	ID *string `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
+	// Member email address
+	Email *string `form:"email,omitempty" json:"email,omitempty" xml:"email,omitempty"`
+	// Member display name
+	Name *string `form:"name,omitempty" json:"name,omitempty" xml:"name,omitempty"`
+	// Member type
+	MemberType *string `form:"member_type,omitempty" json:"member_type,omitempty" xml:"member_type,omitempty"`
+	// Email delivery mode
+	DeliveryMode *string `form:"delivery_mode,omitempty" json:"delivery_mode,omitempty" xml:"delivery_mode,omitempty"`
+	// Moderation status
+	ModStatus *string `form:"mod_status,omitempty" json:"mod_status,omitempty" xml:"mod_status,omitempty"`
+	// Member status
+	Status *string `form:"status,omitempty" json:"status,omitempty" xml:"status,omitempty"`
+	// Member organization
+	Organization *string `form:"organization,omitempty" json:"organization,omitempty" xml:"organization,omitempty"`
+	// Member job title
+	JobTitle *string `form:"job_title,omitempty" json:"job_title,omitempty" xml:"job_title,omitempty"`
+	// Groups.io username
+	Username *string `form:"username,omitempty" json:"username,omitempty" xml:"username,omitempty"`
+	// Member role
+	Role *string `form:"role,omitempty" json:"role,omitempty" xml:"role,omitempty"`
+	// Voting status
+	VotingStatus *string `form:"voting_status,omitempty" json:"voting_status,omitempty" xml:"voting_status,omitempty"`
+	// Creation timestamp
+	CreatedAt *string `form:"created_at,omitempty" json:"created_at,omitempty" xml:"created_at,omitempty"`
+	// Last update timestamp
+	UpdatedAt *string `form:"updated_at,omitempty" json:"updated_at,omitempty" xml:"updated_at,omitempty"`
+}
+
 // CheckGroupsioSubscriberResponseBody is the type of the "mailing-list"
 // service "check-groupsio-subscriber" endpoint HTTP response body.
 type CheckGroupsioSubscriberResponseBody struct {
@@ -525,6 +740,87 @@ type GetGroupsioArtifactDownloadResponseBody struct {
 	URL string `form:"url" json:"url" xml:"url"`
 }
 
+// GetGroupsioProjectSummaryResponseBody is the type of the "mailing-list"
+// service "get-groupsio-project-summary" endpoint HTTP response body.
+type GetGroupsioProjectSummaryResponseBody struct {
+	// LFX v2 project UID
+	ProjectUID string `form:"project_uid" json:"project_uid" xml:"project_uid"`
+	// Number of services
+	ServiceCount int `form:"service_count" json:"service_count" xml:"service_count"`
+	// Number of subgroups
+	MailingListCount int `form:"mailing_list_count" json:"mailing_list_count" xml:"mailing_list_count"`
+	// Sum of the subgroups' member counts; a person in two subgroups is counted
+	// twice
+	MemberCount int `form:"member_count" json:"member_count" xml:"member_count"`
+}
+
+// ListGroupsioOrphanedMailingListsResponseBody is the type of the
+// "mailing-list" service "list-groupsio-orphaned-mailing-lists" endpoint HTTP
+// response body.
+type ListGroupsioOrphanedMailingListsResponseBody struct {
+	// List of subgroups
+	Items []*GroupsioSubgroupResponseBody `form:"items,omitempty" json:"items,omitempty" xml:"items,omitempty"`
+	// Total count
+	Total *int `form:"total,omitempty" json:"total,omitempty" xml:"total,omitempty"`
+}
+
+// RepublishGroupsioProjectIndexResponseBody is the type of the "mailing-list"
+// service "republish-groupsio-project-index" endpoint HTTP response body.
+type RepublishGroupsioProjectIndexResponseBody struct {
+	// Number of indexer messages published
+	Published int `form:"published" json:"published" xml:"published"`
+}
+
+// GetGroupsioMailingListStatsResponseBody is the type of the "mailing-list"
+// service "get-groupsio-mailing-list-stats" endpoint HTTP response body.
+type GetGroupsioMailingListStatsResponseBody struct {
+	// Subgroup ID
+	SubgroupID string `form:"subgroup_id" json:"subgroup_id" xml:"subgroup_id"`
+	// Number of members
+	MemberCount int `form:"member_count" json:"member_count" xml:"member_count"`
+	// Member count per delivery mode; members without one are counted under ""
+	MembersByDeliveryMode map[string]int `form:"members_by_delivery_mode" json:"members_by_delivery_mode" xml:"members_by_delivery_mode"`
+	// Number of bouncing members
+	BounceCount int `form:"bounce_count" json:"bounce_count" xml:"bounce_count"`
+	// Subgroup creation timestamp
+	CreatedAt *string `form:"created_at,omitempty" json:"created_at,omitempty" xml:"created_at,omitempty"`
+	// Subgroup last update timestamp
+	UpdatedAt *string `form:"updated_at,omitempty" json:"updated_at,omitempty" xml:"updated_at,omitempty"`
+}
+
+// GetGroupsioServiceTreeResponseBody is the type of the "mailing-list" service
+// "get-groupsio-service-tree" endpoint HTTP response body.
+type GetGroupsioServiceTreeResponseBody struct {
+	// The service
+	Service *GroupsioServiceResponseBody `form:"service" json:"service" xml:"service"`
+	// Subgroups of the service
+	MailingLists []*GroupsioServiceTreeSubgroupResponseBody `form:"mailing_lists" json:"mailing_lists" xml:"mailing_lists"`
+}
+
+// GetGroupsioMemberHierarchyResponseBody is the type of the "mailing-list"
+// service "get-groupsio-member-hierarchy" endpoint HTTP response body.
+type GetGroupsioMemberHierarchyResponseBody struct {
+	// The member
+	Member *GroupsioMemberResponseBody `form:"member" json:"member" xml:"member"`
+	// The member's subgroup
+	Subgroup *GroupsioSubgroupResponseBody `form:"subgroup,omitempty" json:"subgroup,omitempty" xml:"subgroup,omitempty"`
+	// The subgroup's service
+	Service *GroupsioServiceResponseBody `form:"service,omitempty" json:"service,omitempty" xml:"service,omitempty"`
+	// The service's project
+	Project *GroupsioMemberHierarchyProjectResponseBody `form:"project,omitempty" json:"project,omitempty" xml:"project,omitempty"`
+	// Why an entity above the member could not be resolved
+	Warnings []string `form:"warnings" json:"warnings" xml:"warnings"`
+}
+
+// ListGroupsioProjectMembersResponseBody is the type of the "mailing-list"
+// service "list-groupsio-project-members" endpoint HTTP response body.
+type ListGroupsioProjectMembersResponseBody struct {
+	// Members on this page
+	Items []*GroupsioMemberResponseBody `form:"items" json:"items" xml:"items"`
+	// Cursor for the next page; omitted on the last page
+	NextCursor *string `form:"next_cursor,omitempty" json:"next_cursor,omitempty" xml:"next_cursor,omitempty"`
+}
+
 // ReadyzServiceUnavailableResponseBody is the type of the "mailing-list"
 // service "readyz" endpoint HTTP response body for the "ServiceUnavailable"
 // error.
@@ -645,6 +941,46 @@ type UpdateGroupsioServiceServiceUnavailableResponseBody struct {
 	Message string `form:"message" json:"message" xml:"message"`
 }
 
+// PromoteGroupsioServiceBadRequestResponseBody is the type of the
+// "mailing-list" service "promote-groupsio-service" endpoint HTTP response
+// body for the "BadRequest" error.
+type PromoteGroupsioServiceBadRequestResponseBody struct {
+	// Error message
+	Message string `form:"message" json:"message" xml:"message"`
+}
+
+// PromoteGroupsioServiceConflictResponseBody is the type of the "mailing-list"
+// service "promote-groupsio-service" endpoint HTTP response body for the
+// "Conflict" error.
+type PromoteGroupsioServiceConflictResponseBody struct {
+	// Error message
+	Message string `form:"message" json:"message" xml:"message"`
+}
+
+// PromoteGroupsioServiceInternalServerErrorResponseBody is the type of the
+// "mailing-list" service "promote-groupsio-service" endpoint HTTP response
+// body for the "InternalServerError" error.
+type PromoteGroupsioServiceInternalServerErrorResponseBody struct {
+	// Error message
+	Message string `form:"message" json:"message" xml:"message"`
+}
+
+// PromoteGroupsioServiceNotFoundResponseBody is the type of the "mailing-list"
+// service "promote-groupsio-service" endpoint HTTP response body for the
+// "NotFound" error.
+type PromoteGroupsioServiceNotFoundResponseBody struct {
+	// Error message
+	Message string `form:"message" json:"message" xml:"message"`
+}
+
+// PromoteGroupsioServiceServiceUnavailableResponseBody is the type of the
+// "mailing-list" service "promote-groupsio-service" endpoint HTTP response
+// body for the "ServiceUnavailable" error.
+type PromoteGroupsioServiceServiceUnavailableResponseBody struct {
+	// Error message
+	Message string `form:"message" json:"message" xml:"message"`
+}
+
 // DeleteGroupsioServiceInternalServerErrorResponseBody is the type of the
 // "mailing-list" service "delete-groupsio-service" endpoint HTTP response body
 // for the "InternalServerError" error.
@@ -685,6 +1021,54 @@ type GetGroupsioServiceProjectsServiceUnavailableResponseBody struct {
 	Message string `form:"message" json:"message" xml:"message"`
 }
 
+// ListGroupsioServicesByStatusBadRequestResponseBody is the type of the
+// "mailing-list" service "list-groupsio-services-by-status" endpoint HTTP
+// response body for the "BadRequest" error.
+type ListGroupsioServicesByStatusBadRequestResponseBody struct {
+	// Error message
+	Message string `form:"message" json:"message" xml:"message"`
+}
+
+// ListGroupsioServicesByStatusInternalServerErrorResponseBody is the type of
+// the "mailing-list" service "list-groupsio-services-by-status" endpoint HTTP
+// response body for the "InternalServerError" error.
+type ListGroupsioServicesByStatusInternalServerErrorResponseBody struct {
+	// Error message
+	Message string `form:"message" json:"message" xml:"message"`
+}
+
+// ListGroupsioServicesByStatusServiceUnavailableResponseBody is the type of
+// the "mailing-list" service "list-groupsio-services-by-status" endpoint HTTP
+// response body for the "ServiceUnavailable" error.
+type ListGroupsioServicesByStatusServiceUnavailableResponseBody struct {
+	// Error message
+	Message string `form:"message" json:"message" xml:"message"`
+}
+
+// ListUnprovisionedGroupsioServicesBadRequestResponseBody is the type of the
+// "mailing-list" service "list-unprovisioned-groupsio-services" endpoint HTTP
+// response body for the "BadRequest" error.
+type ListUnprovisionedGroupsioServicesBadRequestResponseBody struct {
+	// Error message
+	Message string `form:"message" json:"message" xml:"message"`
+}
+
+// ListUnprovisionedGroupsioServicesInternalServerErrorResponseBody is the type
+// of the "mailing-list" service "list-unprovisioned-groupsio-services"
+// endpoint HTTP response body for the "InternalServerError" error.
+type ListUnprovisionedGroupsioServicesInternalServerErrorResponseBody struct {
+	// Error message
+	Message string `form:"message" json:"message" xml:"message"`
+}
+
+// ListUnprovisionedGroupsioServicesServiceUnavailableResponseBody is the type
+// of the "mailing-list" service "list-unprovisioned-groupsio-services"
+// endpoint HTTP response body for the "ServiceUnavailable" error.
+type ListUnprovisionedGroupsioServicesServiceUnavailableResponseBody struct {
+	// Error message
+	Message string `form:"message" json:"message" xml:"message"`
+}
+
 // FindParentGroupsioServiceBadRequestResponseBody is the type of the
 // "mailing-list" service "find-parent-groupsio-service" endpoint HTTP response
 // body for the "BadRequest" error.
@@ -741,6 +1125,58 @@ type ListGroupsioMailingListsServiceUnavailableResponseBody struct {
 	Message string `form:"message" json:"message" xml:"message"`
 }
 
+// ListGroupsioMailingListsByVisibilityBadRequestResponseBody is the type of
+// the "mailing-list" service "list-groupsio-mailing-lists-by-visibility"
+// endpoint HTTP response body for the "BadRequest" error.
+type ListGroupsioMailingListsByVisibilityBadRequestResponseBody struct {
+	// Error message
+	Message string `form:"message" json:"message" xml:"message"`
+}
+
+// ListGroupsioMailingListsByVisibilityInternalServerErrorResponseBody is the
+// type of the "mailing-list" service
+// "list-groupsio-mailing-lists-by-visibility" endpoint HTTP response body for
+// the "InternalServerError" error.
+type ListGroupsioMailingListsByVisibilityInternalServerErrorResponseBody struct {
+	// Error message
+	Message string `form:"message" json:"message" xml:"message"`
+}
+
+// ListGroupsioMailingListsByVisibilityServiceUnavailableResponseBody is the
+// type of the "mailing-list" service
+// "list-groupsio-mailing-lists-by-visibility" endpoint HTTP response body for
+// the "ServiceUnavailable" error.
+type ListGroupsioMailingListsByVisibilityServiceUnavailableResponseBody struct {
+	// Error message
+	Message string `form:"message" json:"message" xml:"message"`
+}
+
+// ListGroupsioMailingListsUpdatedSinceBadRequestResponseBody is the type of
+// the "mailing-list" service "list-groupsio-mailing-lists-updated-since"
+// endpoint HTTP response body for the "BadRequest" error.
+type ListGroupsioMailingListsUpdatedSinceBadRequestResponseBody struct {
+	// Error message
+	Message string `form:"message" json:"message" xml:"message"`
+}
+
+// ListGroupsioMailingListsUpdatedSinceInternalServerErrorResponseBody is the
+// type of the "mailing-list" service
+// "list-groupsio-mailing-lists-updated-since" endpoint HTTP response body for
+// the "InternalServerError" error.
+type ListGroupsioMailingListsUpdatedSinceInternalServerErrorResponseBody struct {
+	// Error message
+	Message string `form:"message" json:"message" xml:"message"`
+}
+
+// ListGroupsioMailingListsUpdatedSinceServiceUnavailableResponseBody is the
+// type of the "mailing-list" service
+// "list-groupsio-mailing-lists-updated-since" endpoint HTTP response body for
+// the "ServiceUnavailable" error.
+type ListGroupsioMailingListsUpdatedSinceServiceUnavailableResponseBody struct {
+	// Error message
+	Message string `form:"message" json:"message" xml:"message"`
+}
+
 // CreateGroupsioMailingListBadRequestResponseBody is the type of the
 // "mailing-list" service "create-groupsio-mailing-list" endpoint HTTP response
 // body for the "BadRequest" error.
@@ -925,79 +1361,271 @@ type ListGroupsioMembersServiceUnavailableResponseBody struct {
 	Message string `form:"message" json:"message" xml:"message"`
 }
 
-// AddGroupsioMemberBadRequestResponseBody is the type of the "mailing-list"
-// service "add-groupsio-member" endpoint HTTP response body for the
-// "BadRequest" error.
-type AddGroupsioMemberBadRequestResponseBody struct {
+// ListGroupsioMembersNeedingReviewBadRequestResponseBody is the type of the
+// "mailing-list" service "list-groupsio-members-needing-review" endpoint HTTP
+// response body for the "BadRequest" error.
+type ListGroupsioMembersNeedingReviewBadRequestResponseBody struct {
 	// Error message
 	Message string `form:"message" json:"message" xml:"message"`
 }
 
-// AddGroupsioMemberConflictResponseBody is the type of the "mailing-list"
-// service "add-groupsio-member" endpoint HTTP response body for the "Conflict"
-// error.
-type AddGroupsioMemberConflictResponseBody struct {
+// ListGroupsioMembersNeedingReviewInternalServerErrorResponseBody is the type
+// of the "mailing-list" service "list-groupsio-members-needing-review"
+// endpoint HTTP response body for the "InternalServerError" error.
+type ListGroupsioMembersNeedingReviewInternalServerErrorResponseBody struct {
 	// Error message
 	Message string `form:"message" json:"message" xml:"message"`
 }
 
-// AddGroupsioMemberInternalServerErrorResponseBody is the type of the
-// "mailing-list" service "add-groupsio-member" endpoint HTTP response body for
-// the "InternalServerError" error.
-type AddGroupsioMemberInternalServerErrorResponseBody struct {
+// ListGroupsioMembersNeedingReviewNotFoundResponseBody is the type of the
+// "mailing-list" service "list-groupsio-members-needing-review" endpoint HTTP
+// response body for the "NotFound" error.
+type ListGroupsioMembersNeedingReviewNotFoundResponseBody struct {
 	// Error message
 	Message string `form:"message" json:"message" xml:"message"`
 }
 
-// AddGroupsioMemberNotFoundResponseBody is the type of the "mailing-list"
-// service "add-groupsio-member" endpoint HTTP response body for the "NotFound"
-// error.
-type AddGroupsioMemberNotFoundResponseBody struct {
+// ListGroupsioMembersNeedingReviewServiceUnavailableResponseBody is the type
+// of the "mailing-list" service "list-groupsio-members-needing-review"
+// endpoint HTTP response body for the "ServiceUnavailable" error.
+type ListGroupsioMembersNeedingReviewServiceUnavailableResponseBody struct {
 	// Error message
 	Message string `form:"message" json:"message" xml:"message"`
 }
 
-// AddGroupsioMemberServiceUnavailableResponseBody is the type of the
-// "mailing-list" service "add-groupsio-member" endpoint HTTP response body for
-// the "ServiceUnavailable" error.
-type AddGroupsioMemberServiceUnavailableResponseBody struct {
+// ListGroupsioMembersModifiedSinceBadRequestResponseBody is the type of the
+// "mailing-list" service "list-groupsio-members-modified-since" endpoint HTTP
+// response body for the "BadRequest" error.
+type ListGroupsioMembersModifiedSinceBadRequestResponseBody struct {
 	// Error message
 	Message string `form:"message" json:"message" xml:"message"`
 }
 
-// GetGroupsioMemberInternalServerErrorResponseBody is the type of the
-// "mailing-list" service "get-groupsio-member" endpoint HTTP response body for
-// the "InternalServerError" error.
-type GetGroupsioMemberInternalServerErrorResponseBody struct {
+// ListGroupsioMembersModifiedSinceInternalServerErrorResponseBody is the type
+// of the "mailing-list" service "list-groupsio-members-modified-since"
+// endpoint HTTP response body for the "InternalServerError" error.
+type ListGroupsioMembersModifiedSinceInternalServerErrorResponseBody struct {
 	// Error message
 	Message string `form:"message" json:"message" xml:"message"`
 }
 
-// GetGroupsioMemberNotFoundResponseBody is the type of the "mailing-list"
-// service "get-groupsio-member" endpoint HTTP response body for the "NotFound"
-// error.
-type GetGroupsioMemberNotFoundResponseBody struct {
+// ListGroupsioMembersModifiedSinceNotFoundResponseBody is the type of the
+// "mailing-list" service "list-groupsio-members-modified-since" endpoint HTTP
+// response body for the "NotFound" error.
+type ListGroupsioMembersModifiedSinceNotFoundResponseBody struct {
 	// Error message
 	Message string `form:"message" json:"message" xml:"message"`
 }
 
-// GetGroupsioMemberServiceUnavailableResponseBody is the type of the
-// "mailing-list" service "get-groupsio-member" endpoint HTTP response body for
-// the "ServiceUnavailable" error.
-type GetGroupsioMemberServiceUnavailableResponseBody struct {
+// ListGroupsioMembersModifiedSinceServiceUnavailableResponseBody is the type
+// of the "mailing-list" service "list-groupsio-members-modified-since"
+// endpoint HTTP response body for the "ServiceUnavailable" error.
+type ListGroupsioMembersModifiedSinceServiceUnavailableResponseBody struct {
 	// Error message
 	Message string `form:"message" json:"message" xml:"message"`
 }
 
-// UpdateGroupsioMemberBadRequestResponseBody is the type of the "mailing-list"
-// service "update-groupsio-member" endpoint HTTP response body for the
-// "BadRequest" error.
-type UpdateGroupsioMemberBadRequestResponseBody struct {
+// ListGroupsioMembersByOrganizationBadRequestResponseBody is the type of the
+// "mailing-list" service "list-groupsio-members-by-organization" endpoint HTTP
+// response body for the "BadRequest" error.
+type ListGroupsioMembersByOrganizationBadRequestResponseBody struct {
 	// Error message
 	Message string `form:"message" json:"message" xml:"message"`
 }
 
-// UpdateGroupsioMemberInternalServerErrorResponseBody is the type of the
+// ListGroupsioMembersByOrganizationInternalServerErrorResponseBody is the type
+// of the "mailing-list" service "list-groupsio-members-by-organization"
+// endpoint HTTP response body for the "InternalServerError" error.
+type ListGroupsioMembersByOrganizationInternalServerErrorResponseBody struct {
+	// Error message
+	Message string `form:"message" json:"message" xml:"message"`
+}
+
+// ListGroupsioMembersByOrganizationNotFoundResponseBody is the type of the
+// "mailing-list" service "list-groupsio-members-by-organization" endpoint HTTP
+// response body for the "NotFound" error.
+type ListGroupsioMembersByOrganizationNotFoundResponseBody struct {
+	// Error message
+	Message string `form:"message" json:"message" xml:"message"`
+}
+
+// ListGroupsioMembersByOrganizationServiceUnavailableResponseBody is the type
+// of the "mailing-list" service "list-groupsio-members-by-organization"
+// endpoint HTTP response body for the "ServiceUnavailable" error.
+type ListGroupsioMembersByOrganizationServiceUnavailableResponseBody struct {
+	// Error message
+	Message string `form:"message" json:"message" xml:"message"`
+}
+
+// ListGroupsioDuplicateMembersInternalServerErrorResponseBody is the type of
+// the "mailing-list" service "list-groupsio-duplicate-members" endpoint HTTP
+// response body for the "InternalServerError" error.
+type ListGroupsioDuplicateMembersInternalServerErrorResponseBody struct {
+	// Error message
+	Message string `form:"message" json:"message" xml:"message"`
+}
+
+// ListGroupsioDuplicateMembersNotFoundResponseBody is the type of the
+// "mailing-list" service "list-groupsio-duplicate-members" endpoint HTTP
+// response body for the "NotFound" error.
+type ListGroupsioDuplicateMembersNotFoundResponseBody struct {
+	// Error message
+	Message string `form:"message" json:"message" xml:"message"`
+}
+
+// ListGroupsioDuplicateMembersServiceUnavailableResponseBody is the type of
+// the "mailing-list" service "list-groupsio-duplicate-members" endpoint HTTP
+// response body for the "ServiceUnavailable" error.
+type ListGroupsioDuplicateMembersServiceUnavailableResponseBody struct {
+	// Error message
+	Message string `form:"message" json:"message" xml:"message"`
+}
+
+// PreviewGroupsioDeliveryModeChangeBadRequestResponseBody is the type of the
+// "mailing-list" service "preview-groupsio-delivery-mode-change" endpoint HTTP
+// response body for the "BadRequest" error.
+type PreviewGroupsioDeliveryModeChangeBadRequestResponseBody struct {
+	// Error message
+	Message string `form:"message" json:"message" xml:"message"`
+}
+
+// PreviewGroupsioDeliveryModeChangeInternalServerErrorResponseBody is the type
+// of the "mailing-list" service "preview-groupsio-delivery-mode-change"
+// endpoint HTTP response body for the "InternalServerError" error.
+type PreviewGroupsioDeliveryModeChangeInternalServerErrorResponseBody struct {
+	// Error message
+	Message string `form:"message" json:"message" xml:"message"`
+}
+
+// PreviewGroupsioDeliveryModeChangeNotFoundResponseBody is the type of the
+// "mailing-list" service "preview-groupsio-delivery-mode-change" endpoint HTTP
+// response body for the "NotFound" error.
+type PreviewGroupsioDeliveryModeChangeNotFoundResponseBody struct {
+	// Error message
+	Message string `form:"message" json:"message" xml:"message"`
+}
+
+// PreviewGroupsioDeliveryModeChangeServiceUnavailableResponseBody is the type
+// of the "mailing-list" service "preview-groupsio-delivery-mode-change"
+// endpoint HTTP response body for the "ServiceUnavailable" error.
+type PreviewGroupsioDeliveryModeChangeServiceUnavailableResponseBody struct {
+	// Error message
+	Message string `form:"message" json:"message" xml:"message"`
+}
+
+// AddGroupsioMemberBadRequestResponseBody is the type of the "mailing-list"
+// service "add-groupsio-member" endpoint HTTP response body for the
+// "BadRequest" error.
+type AddGroupsioMemberBadRequestResponseBody struct {
+	// Error message
+	Message string `form:"message" json:"message" xml:"message"`
+}
+
+// AddGroupsioMemberConflictResponseBody is the type of the "mailing-list"
+// service "add-groupsio-member" endpoint HTTP response body for the "Conflict"
+// error.
+type AddGroupsioMemberConflictResponseBody struct {
+	// Error message
+	Message string `form:"message" json:"message" xml:"message"`
+}
+
+// AddGroupsioMemberInternalServerErrorResponseBody is the type of the
+// "mailing-list" service "add-groupsio-member" endpoint HTTP response body for
+// the "InternalServerError" error.
+type AddGroupsioMemberInternalServerErrorResponseBody struct {
+	// Error message
+	Message string `form:"message" json:"message" xml:"message"`
+}
+
+// AddGroupsioMemberNotFoundResponseBody is the type of the "mailing-list"
+// service "add-groupsio-member" endpoint HTTP response body for the "NotFound"
+// error.
+type AddGroupsioMemberNotFoundResponseBody struct {
+	// Error message
+	Message string `form:"message" json:"message" xml:"message"`
+}
+
+// AddGroupsioMemberServiceUnavailableResponseBody is the type of the
+// "mailing-list" service "add-groupsio-member" endpoint HTTP response body for
+// the "ServiceUnavailable" error.
+type AddGroupsioMemberServiceUnavailableResponseBody struct {
+	// Error message
+	Message string `form:"message" json:"message" xml:"message"`
+}
+
+// UpsertGroupsioMemberBadRequestResponseBody is the type of the "mailing-list"
+// service "upsert-groupsio-member" endpoint HTTP response body for the
+// "BadRequest" error.
+type UpsertGroupsioMemberBadRequestResponseBody struct {
+	// Error message
+	Message string `form:"message" json:"message" xml:"message"`
+}
+
+// UpsertGroupsioMemberConflictResponseBody is the type of the "mailing-list"
+// service "upsert-groupsio-member" endpoint HTTP response body for the
+// "Conflict" error.
+type UpsertGroupsioMemberConflictResponseBody struct {
+	// Error message
+	Message string `form:"message" json:"message" xml:"message"`
+}
+
+// UpsertGroupsioMemberInternalServerErrorResponseBody is the type of the
+// "mailing-list" service "upsert-groupsio-member" endpoint HTTP response body
+// for the "InternalServerError" error.
+type UpsertGroupsioMemberInternalServerErrorResponseBody struct {
+	// Error message
+	Message string `form:"message" json:"message" xml:"message"`
+}
+
+// UpsertGroupsioMemberNotFoundResponseBody is the type of the "mailing-list"
+// service "upsert-groupsio-member" endpoint HTTP response body for the
+// "NotFound" error.
+type UpsertGroupsioMemberNotFoundResponseBody struct {
+	// Error message
+	Message string `form:"message" json:"message" xml:"message"`
+}
+
+// UpsertGroupsioMemberServiceUnavailableResponseBody is the type of the
+// "mailing-list" service "upsert-groupsio-member" endpoint HTTP response body
+// for the "ServiceUnavailable" error.
+type UpsertGroupsioMemberServiceUnavailableResponseBody struct {
+	// Error message
+	Message string `form:"message" json:"message" xml:"message"`
+}
+
+// GetGroupsioMemberInternalServerErrorResponseBody is the type of the
+// "mailing-list" service "get-groupsio-member" endpoint HTTP response body for
+// the "InternalServerError" error.
+type GetGroupsioMemberInternalServerErrorResponseBody struct {
+	// Error message
+	Message string `form:"message" json:"message" xml:"message"`
+}
+
+// GetGroupsioMemberNotFoundResponseBody is the type of the "mailing-list"
+// service "get-groupsio-member" endpoint HTTP response body for the "NotFound"
+// error.
+type GetGroupsioMemberNotFoundResponseBody struct {
+	// Error message
+	Message string `form:"message" json:"message" xml:"message"`
+}
+
+// GetGroupsioMemberServiceUnavailableResponseBody is the type of the
+// "mailing-list" service "get-groupsio-member" endpoint HTTP response body for
+// the "ServiceUnavailable" error.
+type GetGroupsioMemberServiceUnavailableResponseBody struct {
+	// Error message
+	Message string `form:"message" json:"message" xml:"message"`
+}
+
+// UpdateGroupsioMemberBadRequestResponseBody is the type of the "mailing-list"
+// service "update-groupsio-member" endpoint HTTP response body for the
+// "BadRequest" error.
+type UpdateGroupsioMemberBadRequestResponseBody struct {
+	// Error message
+	Message string `form:"message" json:"message" xml:"message"`
+}
+
+// UpdateGroupsioMemberInternalServerErrorResponseBody is the type of the
 // "mailing-list" service "update-groupsio-member" endpoint HTTP response body
 // for the "InternalServerError" error.
 type UpdateGroupsioMemberInternalServerErrorResponseBody struct {
@@ -1077,6 +1705,118 @@ type InviteGroupsioMembersServiceUnavailableResponseBody struct {
 	Message string `form:"message" json:"message" xml:"message"`
 }
 
+// ImportGroupsioMembersBadRequestResponseBody is the type of the
+// "mailing-list" service "import-groupsio-members" endpoint HTTP response body
+// for the "BadRequest" error.
+type ImportGroupsioMembersBadRequestResponseBody struct {
+	// Error message
+	Message string `form:"message" json:"message" xml:"message"`
+}
+
+// ImportGroupsioMembersConflictResponseBody is the type of the "mailing-list"
+// service "import-groupsio-members" endpoint HTTP response body for the
+// "Conflict" error.
+type ImportGroupsioMembersConflictResponseBody struct {
+	// Error message
+	Message string `form:"message" json:"message" xml:"message"`
+}
+
+// ImportGroupsioMembersInternalServerErrorResponseBody is the type of the
+// "mailing-list" service "import-groupsio-members" endpoint HTTP response body
+// for the "InternalServerError" error.
+type ImportGroupsioMembersInternalServerErrorResponseBody struct {
+	// Error message
+	Message string `form:"message" json:"message" xml:"message"`
+}
+
+// ImportGroupsioMembersNotFoundResponseBody is the type of the "mailing-list"
+// service "import-groupsio-members" endpoint HTTP response body for the
+// "NotFound" error.
+type ImportGroupsioMembersNotFoundResponseBody struct {
+	// Error message
+	Message string `form:"message" json:"message" xml:"message"`
+}
+
+// ImportGroupsioMembersServiceUnavailableResponseBody is the type of the
+// "mailing-list" service "import-groupsio-members" endpoint HTTP response body
+// for the "ServiceUnavailable" error.
+type ImportGroupsioMembersServiceUnavailableResponseBody struct {
+	// Error message
+	Message string `form:"message" json:"message" xml:"message"`
+}
+
+// MergeGroupsioMembersBadRequestResponseBody is the type of the "mailing-list"
+// service "merge-groupsio-members" endpoint HTTP response body for the
+// "BadRequest" error.
+type MergeGroupsioMembersBadRequestResponseBody struct {
+	// Error message
+	Message string `form:"message" json:"message" xml:"message"`
+}
+
+// MergeGroupsioMembersInternalServerErrorResponseBody is the type of the
+// "mailing-list" service "merge-groupsio-members" endpoint HTTP response body
+// for the "InternalServerError" error.
+type MergeGroupsioMembersInternalServerErrorResponseBody struct {
+	// Error message
+	Message string `form:"message" json:"message" xml:"message"`
+}
+
+// MergeGroupsioMembersNotFoundResponseBody is the type of the "mailing-list"
+// service "merge-groupsio-members" endpoint HTTP response body for the
+// "NotFound" error.
+type MergeGroupsioMembersNotFoundResponseBody struct {
+	// Error message
+	Message string `form:"message" json:"message" xml:"message"`
+}
+
+// MergeGroupsioMembersServiceUnavailableResponseBody is the type of the
+// "mailing-list" service "merge-groupsio-members" endpoint HTTP response body
+// for the "ServiceUnavailable" error.
+type MergeGroupsioMembersServiceUnavailableResponseBody struct {
+	// Error message
+	Message string `form:"message" json:"message" xml:"message"`
+}
+
+// ChangeGroupsioMemberEmailBadRequestResponseBody is the type of the
+// "mailing-list" service "change-groupsio-member-email" endpoint HTTP response
+// body for the "BadRequest" error.
+type ChangeGroupsioMemberEmailBadRequestResponseBody struct {
+	// Error message
+	Message string `form:"message" json:"message" xml:"message"`
+}
+
+// ChangeGroupsioMemberEmailConflictResponseBody is the type of the
+// "mailing-list" service "change-groupsio-member-email" endpoint HTTP response
+// body for the "Conflict" error.
+type ChangeGroupsioMemberEmailConflictResponseBody struct {
+	// Error message
+	Message string `form:"message" json:"message" xml:"message"`
+}
+
+// ChangeGroupsioMemberEmailInternalServerErrorResponseBody is the type of the
+// "mailing-list" service "change-groupsio-member-email" endpoint HTTP response
+// body for the "InternalServerError" error.
+type ChangeGroupsioMemberEmailInternalServerErrorResponseBody struct {
+	// Error message
+	Message string `form:"message" json:"message" xml:"message"`
+}
+
+// ChangeGroupsioMemberEmailNotFoundResponseBody is the type of the
+// "mailing-list" service "change-groupsio-member-email" endpoint HTTP response
+// body for the "NotFound" error.
+type ChangeGroupsioMemberEmailNotFoundResponseBody struct {
+	// Error message
+	Message string `form:"message" json:"message" xml:"message"`
+}
+
+// ChangeGroupsioMemberEmailServiceUnavailableResponseBody is the type of the
+// "mailing-list" service "change-groupsio-member-email" endpoint HTTP response
+// body for the "ServiceUnavailable" error.
+type ChangeGroupsioMemberEmailServiceUnavailableResponseBody struct {
+	// Error message
+	Message string `form:"message" json:"message" xml:"message"`
+}
+
 // CheckGroupsioSubscriberBadRequestResponseBody is the type of the
 // "mailing-list" service "check-groupsio-subscriber" endpoint HTTP response
 // body for the "BadRequest" error.
@@ -1149,43 +1889,211 @@ type GetGroupsioArtifactDownloadServiceUnavailableResponseBody struct {
 	Message string `form:"message" json:"message" xml:"message"`
 }
 
-// GroupsioServiceResponseBody is used to define fields on response body types.
-type GroupsioServiceResponseBody struct {
-	// Service ID
-	ID *string `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
-	// LFX v2 project UID
-	ProjectUID *string `form:"project_uid,omitempty" json:"project_uid,omitempty" xml:"project_uid,omitempty"`
-	// Service type
-	Type *string `form:"type,omitempty" json:"type,omitempty" xml:"type,omitempty"`
-	// GroupsIO group ID
-	GroupID *int64 `form:"group_id,omitempty" json:"group_id,omitempty" xml:"group_id,omitempty"`
-	// Service domain
-	Domain *string `form:"domain,omitempty" json:"domain,omitempty" xml:"domain,omitempty"`
-	// Email prefix
-	Prefix *string `form:"prefix,omitempty" json:"prefix,omitempty" xml:"prefix,omitempty"`
-	// Service status
-	Status *string `form:"status,omitempty" json:"status,omitempty" xml:"status,omitempty"`
-	// Creation timestamp
-	CreatedAt *string `form:"created_at,omitempty" json:"created_at,omitempty" xml:"created_at,omitempty"`
-	// Last update timestamp
-	UpdatedAt *string `form:"updated_at,omitempty" json:"updated_at,omitempty" xml:"updated_at,omitempty"`
+// GetGroupsioProjectSummaryBadRequestResponseBody is the type of the
+// "mailing-list" service "get-groupsio-project-summary" endpoint HTTP response
+// body for the "BadRequest" error.
+type GetGroupsioProjectSummaryBadRequestResponseBody struct {
+	// Error message
+	Message string `form:"message" json:"message" xml:"message"`
 }
 
-// GroupsioSubgroupResponseBody is used to define fields on response body types.
-type GroupsioSubgroupResponseBody struct {
-	// Subgroup ID
-	ID *string `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
-	// LFX v2 project UID
-	ProjectUID *string `form:"project_uid,omitempty" json:"project_uid,omitempty" xml:"project_uid,omitempty"`
-	// LFX v2 committee UID
-	CommitteeUID *string `form:"committee_uid,omitempty" json:"committee_uid,omitempty" xml:"committee_uid,omitempty"`
-	// Parent GroupsIO service ID
-	ServiceID *string `form:"service_id,omitempty" json:"service_id,omitempty" xml:"service_id,omitempty"`
-	// GroupsIO group ID
-	GroupID *int64 `form:"group_id,omitempty" json:"group_id,omitempty" xml:"group_id,omitempty"`
-	// Subgroup name
-	Name *string `form:"name,omitempty" json:"name,omitempty" xml:"name,omitempty"`
-	// Subgroup description
+// GetGroupsioProjectSummaryInternalServerErrorResponseBody is the type of the
+// "mailing-list" service "get-groupsio-project-summary" endpoint HTTP response
+// body for the "InternalServerError" error.
+type GetGroupsioProjectSummaryInternalServerErrorResponseBody struct {
+	// Error message
+	Message string `form:"message" json:"message" xml:"message"`
+}
+
+// GetGroupsioProjectSummaryServiceUnavailableResponseBody is the type of the
+// "mailing-list" service "get-groupsio-project-summary" endpoint HTTP response
+// body for the "ServiceUnavailable" error.
+type GetGroupsioProjectSummaryServiceUnavailableResponseBody struct {
+	// Error message
+	Message string `form:"message" json:"message" xml:"message"`
+}
+
+// ListGroupsioOrphanedMailingListsBadRequestResponseBody is the type of the
+// "mailing-list" service "list-groupsio-orphaned-mailing-lists" endpoint HTTP
+// response body for the "BadRequest" error.
+type ListGroupsioOrphanedMailingListsBadRequestResponseBody struct {
+	// Error message
+	Message string `form:"message" json:"message" xml:"message"`
+}
+
+// ListGroupsioOrphanedMailingListsInternalServerErrorResponseBody is the type
+// of the "mailing-list" service "list-groupsio-orphaned-mailing-lists"
+// endpoint HTTP response body for the "InternalServerError" error.
+type ListGroupsioOrphanedMailingListsInternalServerErrorResponseBody struct {
+	// Error message
+	Message string `form:"message" json:"message" xml:"message"`
+}
+
+// ListGroupsioOrphanedMailingListsServiceUnavailableResponseBody is the type
+// of the "mailing-list" service "list-groupsio-orphaned-mailing-lists"
+// endpoint HTTP response body for the "ServiceUnavailable" error.
+type ListGroupsioOrphanedMailingListsServiceUnavailableResponseBody struct {
+	// Error message
+	Message string `form:"message" json:"message" xml:"message"`
+}
+
+// RepublishGroupsioProjectIndexBadRequestResponseBody is the type of the
+// "mailing-list" service "republish-groupsio-project-index" endpoint HTTP
+// response body for the "BadRequest" error.
+type RepublishGroupsioProjectIndexBadRequestResponseBody struct {
+	// Error message
+	Message string `form:"message" json:"message" xml:"message"`
+}
+
+// RepublishGroupsioProjectIndexInternalServerErrorResponseBody is the type of
+// the "mailing-list" service "republish-groupsio-project-index" endpoint HTTP
+// response body for the "InternalServerError" error.
+type RepublishGroupsioProjectIndexInternalServerErrorResponseBody struct {
+	// Error message
+	Message string `form:"message" json:"message" xml:"message"`
+}
+
+// RepublishGroupsioProjectIndexServiceUnavailableResponseBody is the type of
+// the "mailing-list" service "republish-groupsio-project-index" endpoint HTTP
+// response body for the "ServiceUnavailable" error.
+type RepublishGroupsioProjectIndexServiceUnavailableResponseBody struct {
+	// Error message
+	Message string `form:"message" json:"message" xml:"message"`
+}
+
+// GetGroupsioMailingListStatsInternalServerErrorResponseBody is the type of
+// the "mailing-list" service "get-groupsio-mailing-list-stats" endpoint HTTP
+// response body for the "InternalServerError" error.
+type GetGroupsioMailingListStatsInternalServerErrorResponseBody struct {
+	// Error message
+	Message string `form:"message" json:"message" xml:"message"`
+}
+
+// GetGroupsioMailingListStatsNotFoundResponseBody is the type of the
+// "mailing-list" service "get-groupsio-mailing-list-stats" endpoint HTTP
+// response body for the "NotFound" error.
+type GetGroupsioMailingListStatsNotFoundResponseBody struct {
+	// Error message
+	Message string `form:"message" json:"message" xml:"message"`
+}
+
+// GetGroupsioMailingListStatsServiceUnavailableResponseBody is the type of the
+// "mailing-list" service "get-groupsio-mailing-list-stats" endpoint HTTP
+// response body for the "ServiceUnavailable" error.
+type GetGroupsioMailingListStatsServiceUnavailableResponseBody struct {
+	// Error message
+	Message string `form:"message" json:"message" xml:"message"`
+}
+
+// GetGroupsioServiceTreeInternalServerErrorResponseBody is the type of the
+// "mailing-list" service "get-groupsio-service-tree" endpoint HTTP response
+// body for the "InternalServerError" error.
+type GetGroupsioServiceTreeInternalServerErrorResponseBody struct {
+	// Error message
+	Message string `form:"message" json:"message" xml:"message"`
+}
+
+// GetGroupsioServiceTreeNotFoundResponseBody is the type of the "mailing-list"
+// service "get-groupsio-service-tree" endpoint HTTP response body for the
+// "NotFound" error.
+type GetGroupsioServiceTreeNotFoundResponseBody struct {
+	// Error message
+	Message string `form:"message" json:"message" xml:"message"`
+}
+
+// GetGroupsioServiceTreeServiceUnavailableResponseBody is the type of the
+// "mailing-list" service "get-groupsio-service-tree" endpoint HTTP response
+// body for the "ServiceUnavailable" error.
+type GetGroupsioServiceTreeServiceUnavailableResponseBody struct {
+	// Error message
+	Message string `form:"message" json:"message" xml:"message"`
+}
+
+// GetGroupsioMemberHierarchyInternalServerErrorResponseBody is the type of the
+// "mailing-list" service "get-groupsio-member-hierarchy" endpoint HTTP
+// response body for the "InternalServerError" error.
+type GetGroupsioMemberHierarchyInternalServerErrorResponseBody struct {
+	// Error message
+	Message string `form:"message" json:"message" xml:"message"`
+}
+
+// GetGroupsioMemberHierarchyNotFoundResponseBody is the type of the
+// "mailing-list" service "get-groupsio-member-hierarchy" endpoint HTTP
+// response body for the "NotFound" error.
+type GetGroupsioMemberHierarchyNotFoundResponseBody struct {
+	// Error message
+	Message string `form:"message" json:"message" xml:"message"`
+}
+
+// GetGroupsioMemberHierarchyServiceUnavailableResponseBody is the type of the
+// "mailing-list" service "get-groupsio-member-hierarchy" endpoint HTTP
+// response body for the "ServiceUnavailable" error.
+type GetGroupsioMemberHierarchyServiceUnavailableResponseBody struct {
+	// Error message
+	Message string `form:"message" json:"message" xml:"message"`
+}
+
+// ListGroupsioProjectMembersBadRequestResponseBody is the type of the
+// "mailing-list" service "list-groupsio-project-members" endpoint HTTP
+// response body for the "BadRequest" error.
+type ListGroupsioProjectMembersBadRequestResponseBody struct {
+	// Error message
+	Message string `form:"message" json:"message" xml:"message"`
+}
+
+// ListGroupsioProjectMembersInternalServerErrorResponseBody is the type of the
+// "mailing-list" service "list-groupsio-project-members" endpoint HTTP
+// response body for the "InternalServerError" error.
+type ListGroupsioProjectMembersInternalServerErrorResponseBody struct {
+	// Error message
+	Message string `form:"message" json:"message" xml:"message"`
+}
+
+// ListGroupsioProjectMembersServiceUnavailableResponseBody is the type of the
+// "mailing-list" service "list-groupsio-project-members" endpoint HTTP
+// response body for the "ServiceUnavailable" error.
+type ListGroupsioProjectMembersServiceUnavailableResponseBody struct {
+	// Error message
+	Message string `form:"message" json:"message" xml:"message"`
+}
+
+// GroupsioServiceResponseBody is used to define fields on response body types.
+type GroupsioServiceResponseBody struct {
+	// Service ID
+	ID *string `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
+	// LFX v2 project UID
+	ProjectUID *string `form:"project_uid,omitempty" json:"project_uid,omitempty" xml:"project_uid,omitempty"`
+	// Service type
+	Type *string `form:"type,omitempty" json:"type,omitempty" xml:"type,omitempty"`
+	// GroupsIO group ID
+	GroupID *int64 `form:"group_id,omitempty" json:"group_id,omitempty" xml:"group_id,omitempty"`
+	// Service domain
+	Domain *string `form:"domain,omitempty" json:"domain,omitempty" xml:"domain,omitempty"`
+	// Email prefix
+	Prefix *string `form:"prefix,omitempty" json:"prefix,omitempty" xml:"prefix,omitempty"`
+	// Service status
+	Status *string `form:"status,omitempty" json:"status,omitempty" xml:"status,omitempty"`
+	// Creation timestamp
+	CreatedAt *string `form:"created_at,omitempty" json:"created_at,omitempty" xml:"created_at,omitempty"`
+	// Last update timestamp
+	UpdatedAt *string `form:"updated_at,omitempty" json:"updated_at,omitempty" xml:"updated_at,omitempty"`
+}
+
+// GroupsioSubgroupResponseBody is used to define fields on response body types.
+type GroupsioSubgroupResponseBody struct {
+	// Subgroup ID
+	ID *string `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
+	// LFX v2 project UID
+	ProjectUID *string `form:"project_uid,omitempty" json:"project_uid,omitempty" xml:"project_uid,omitempty"`
+	// LFX v2 committee UID
+	CommitteeUID *string `form:"committee_uid,omitempty" json:"committee_uid,omitempty" xml:"committee_uid,omitempty"`
+	// Parent GroupsIO service ID
+	ServiceID *string `form:"service_id,omitempty" json:"service_id,omitempty" xml:"service_id,omitempty"`
+	// GroupsIO group ID
+	GroupID *int64 `form:"group_id,omitempty" json:"group_id,omitempty" xml:"group_id,omitempty"`
+	// Subgroup name
+	Name *string `form:"name,omitempty" json:"name,omitempty" xml:"name,omitempty"`
+	// Subgroup description
 	Description *string `form:"description,omitempty" json:"description,omitempty" xml:"description,omitempty"`
 	// Subgroup type
 	Type *string `form:"type,omitempty" json:"type,omitempty" xml:"type,omitempty"`
@@ -1195,6 +2103,8 @@ type GroupsioSubgroupResponseBody struct {
 	CreatedAt *string `form:"created_at,omitempty" json:"created_at,omitempty" xml:"created_at,omitempty"`
 	// Last update timestamp
 	UpdatedAt *string `form:"updated_at,omitempty" json:"updated_at,omitempty" xml:"updated_at,omitempty"`
+	// Best-effort follow-up steps that failed after the subgroup was saved
+	Warnings []string `form:"warnings,omitempty" json:"warnings,omitempty" xml:"warnings,omitempty"`
 }
 
 // GroupsioMemberResponseBody is used to define fields on response body types.
@@ -1244,6 +2154,62 @@ type GroupsioArtifactUserResponseBody struct {
 	ProfilePicture *string `form:"profile_picture,omitempty" json:"profile_picture,omitempty" xml:"profile_picture,omitempty"`
 }
 
+// GroupsioServiceTreeSubgroupResponseBody is used to define fields on response
+// body types.
+type GroupsioServiceTreeSubgroupResponseBody struct {
+	// The subgroup
+	Subgroup *GroupsioSubgroupResponseBody `form:"subgroup" json:"subgroup" xml:"subgroup"`
+	// Number of members; omitted unless member counts were requested
+	MemberCount *int `form:"member_count,omitempty" json:"member_count,omitempty" xml:"member_count,omitempty"`
+}
+
+// GroupsioMemberHierarchyProjectResponseBody is used to define fields on
+// response body types.
+type GroupsioMemberHierarchyProjectResponseBody struct {
+	// LFX v2 project UID
+	UID string `form:"uid" json:"uid" xml:"uid"`
+	// Project name
+	Name *string `form:"name,omitempty" json:"name,omitempty" xml:"name,omitempty"`
+	// Project slug
+	Slug *string `form:"slug,omitempty" json:"slug,omitempty" xml:"slug,omitempty"`
+}
+
+// GroupsioMemberRequestRequestBody is used to define fields on request body
+// types.
+type GroupsioMemberRequestRequestBody struct {
+	// Member email address
+	Email *string `form:"email,omitempty" json:"email,omitempty" xml:"email,omitempty"`
+	// Member display name
+	Name *string `form:"name,omitempty" json:"name,omitempty" xml:"name,omitempty"`
+	// Member type; only 'direct' is accepted for API-managed members
+	MemberType *string `form:"member_type,omitempty" json:"member_type,omitempty" xml:"member_type,omitempty"`
+	// Moderation status
+	ModStatus *string `form:"mod_status,omitempty" json:"mod_status,omitempty" xml:"mod_status,omitempty"`
+	// Email delivery mode
+	DeliveryMode *string `form:"delivery_mode,omitempty" json:"delivery_mode,omitempty" xml:"delivery_mode,omitempty"`
+	// Member organization
+	Organization *string `form:"organization,omitempty" json:"organization,omitempty" xml:"organization,omitempty"`
+	// Member job title
+	JobTitle *string `form:"job_title,omitempty" json:"job_title,omitempty" xml:"job_title,omitempty"`
+}
+
+// NewReadyzResponseBody builds the HTTP response body from the result of the
+// "readyz" endpoint of the "mailing-list" service.
+func NewReadyzResponseBody(res *mailinglist.Readiness) *ReadyzResponseBody {
+	body := &ReadyzResponseBody{
+		Status: res.Status,
+	}
+	if res.Dependencies != nil {
+		body.Dependencies = make(map[string]string, len(res.Dependencies))
+		for key, val := range res.Dependencies {
+			tk := key
+			tv := val
+			body.Dependencies[tk] = tv
+		}
+	}
+	return body
+}
+
 // NewListGroupsioServicesResponseBody builds the HTTP response body from the
 // result of the "list-groupsio-services" endpoint of the "mailing-list"
 // service.
@@ -1313,6 +2279,23 @@ func NewUpdateGroupsioServiceResponseBody(res *mailinglist.GroupsioService) *Upd
 	return body
 }
 
+// NewPromoteGroupsioServiceResponseBody builds the HTTP response body from the
+// result of the "promote-groupsio-service" endpoint of the "mailing-list"
+// service.
+func NewPromoteGroupsioServiceResponseBody(res *mailinglist.GroupsioServicePromotion) *PromoteGroupsioServiceResponseBody {
+	body := &PromoteGroupsioServiceResponseBody{}
+	if res.Service != nil {
+		body.Service = marshalMailinglistGroupsioServiceToGroupsioServiceResponseBody(res.Service)
+	}
+	if res.PrefixedSubgroups != nil {
+		body.PrefixedSubgroups = make([]*GroupsioSubgroupResponseBody, len(res.PrefixedSubgroups))
+		for i, val := range res.PrefixedSubgroups {
+			body.PrefixedSubgroups[i] = marshalMailinglistGroupsioSubgroupToGroupsioSubgroupResponseBody(val)
+		}
+	}
+	return body
+}
+
 // NewGetGroupsioServiceProjectsResponseBody builds the HTTP response body from
 // the result of the "get-groupsio-service-projects" endpoint of the
 // "mailing-list" service.
@@ -1327,6 +2310,38 @@ func NewGetGroupsioServiceProjectsResponseBody(res *mailinglist.GroupsioProjects
 	return body
 }
 
+// NewListGroupsioServicesByStatusResponseBody builds the HTTP response body
+// from the result of the "list-groupsio-services-by-status" endpoint of the
+// "mailing-list" service.
+func NewListGroupsioServicesByStatusResponseBody(res *mailinglist.GroupsioServiceList) *ListGroupsioServicesByStatusResponseBody {
+	body := &ListGroupsioServicesByStatusResponseBody{
+		Total: res.Total,
+	}
+	if res.Items != nil {
+		body.Items = make([]*GroupsioServiceResponseBody, len(res.Items))
+		for i, val := range res.Items {
+			body.Items[i] = marshalMailinglistGroupsioServiceToGroupsioServiceResponseBody(val)
+		}
+	}
+	return body
+}
+
+// NewListUnprovisionedGroupsioServicesResponseBody builds the HTTP response
+// body from the result of the "list-unprovisioned-groupsio-services" endpoint
+// of the "mailing-list" service.
+func NewListUnprovisionedGroupsioServicesResponseBody(res *mailinglist.GroupsioServiceList) *ListUnprovisionedGroupsioServicesResponseBody {
+	body := &ListUnprovisionedGroupsioServicesResponseBody{
+		Total: res.Total,
+	}
+	if res.Items != nil {
+		body.Items = make([]*GroupsioServiceResponseBody, len(res.Items))
+		for i, val := range res.Items {
+			body.Items[i] = marshalMailinglistGroupsioServiceToGroupsioServiceResponseBody(val)
+		}
+	}
+	return body
+}
+
 // NewFindParentGroupsioServiceResponseBody builds the HTTP response body from
 // the result of the "find-parent-groupsio-service" endpoint of the
 // "mailing-list" service.
@@ -1361,6 +2376,38 @@ func NewListGroupsioMailingListsResponseBody(res *mailinglist.GroupsioSubgroupLi
 	return body
 }
 
+// NewListGroupsioMailingListsByVisibilityResponseBody builds the HTTP response
+// body from the result of the "list-groupsio-mailing-lists-by-visibility"
+// endpoint of the "mailing-list" service.
+func NewListGroupsioMailingListsByVisibilityResponseBody(res *mailinglist.GroupsioSubgroupList) *ListGroupsioMailingListsByVisibilityResponseBody {
+	body := &ListGroupsioMailingListsByVisibilityResponseBody{
+		Total: res.Total,
+	}
+	if res.Items != nil {
+		body.Items = make([]*GroupsioSubgroupResponseBody, len(res.Items))
+		for i, val := range res.Items {
+			body.Items[i] = marshalMailinglistGroupsioSubgroupToGroupsioSubgroupResponseBody(val)
+		}
+	}
+	return body
+}
+
+// NewListGroupsioMailingListsUpdatedSinceResponseBody builds the HTTP response
+// body from the result of the "list-groupsio-mailing-lists-updated-since"
+// endpoint of the "mailing-list" service.
+func NewListGroupsioMailingListsUpdatedSinceResponseBody(res *mailinglist.GroupsioSubgroupList) *ListGroupsioMailingListsUpdatedSinceResponseBody {
+	body := &ListGroupsioMailingListsUpdatedSinceResponseBody{
+		Total: res.Total,
+	}
+	if res.Items != nil {
+		body.Items = make([]*GroupsioSubgroupResponseBody, len(res.Items))
+		for i, val := range res.Items {
+			body.Items[i] = marshalMailinglistGroupsioSubgroupToGroupsioSubgroupResponseBody(val)
+		}
+	}
+	return body
+}
+
 // NewCreateGroupsioMailingListResponseBody builds the HTTP response body from
 // the result of the "create-groupsio-mailing-list" endpoint of the
 // "mailing-list" service.
@@ -1378,6 +2425,12 @@ func NewCreateGroupsioMailingListResponseBody(res *mailinglist.GroupsioSubgroup)
 		CreatedAt:      res.CreatedAt,
 		UpdatedAt:      res.UpdatedAt,
 	}
+	if res.Warnings != nil {
+		body.Warnings = make([]string, len(res.Warnings))
+		for i, val := range res.Warnings {
+			body.Warnings[i] = val
+		}
+	}
 	return body
 }
 
@@ -1398,6 +2451,12 @@ func NewGetGroupsioMailingListResponseBody(res *mailinglist.GroupsioSubgroup) *G
 		CreatedAt:      res.CreatedAt,
 		UpdatedAt:      res.UpdatedAt,
 	}
+	if res.Warnings != nil {
+		body.Warnings = make([]string, len(res.Warnings))
+		for i, val := range res.Warnings {
+			body.Warnings[i] = val
+		}
+	}
 	return body
 }
 
@@ -1418,6 +2477,12 @@ func NewUpdateGroupsioMailingListResponseBody(res *mailinglist.GroupsioSubgroup)
 		CreatedAt:      res.CreatedAt,
 		UpdatedAt:      res.UpdatedAt,
 	}
+	if res.Warnings != nil {
+		body.Warnings = make([]string, len(res.Warnings))
+		for i, val := range res.Warnings {
+			body.Warnings[i] = val
+		}
+	}
 	return body
 }
 
@@ -1456,32 +2521,138 @@ func NewListGroupsioMembersResponseBody(res *mailinglist.GroupsioMemberList) *Li
 	return body
 }
 
-// NewAddGroupsioMemberResponseBody builds the HTTP response body from the
-// result of the "add-groupsio-member" endpoint of the "mailing-list" service.
-func NewAddGroupsioMemberResponseBody(res *mailinglist.GroupsioMember) *AddGroupsioMemberResponseBody {
-	body := &AddGroupsioMemberResponseBody{
-		ID:           res.ID,
-		Email:        res.Email,
-		Name:         res.Name,
-		MemberType:   res.MemberType,
-		DeliveryMode: res.DeliveryMode,
-		ModStatus:    res.ModStatus,
-		Status:       res.Status,
-		Organization: res.Organization,
-		JobTitle:     res.JobTitle,
-		Username:     res.Username,
-		Role:         res.Role,
-		VotingStatus: res.VotingStatus,
-		CreatedAt:    res.CreatedAt,
-		UpdatedAt:    res.UpdatedAt,
+// NewListGroupsioMembersNeedingReviewResponseBody builds the HTTP response
+// body from the result of the "list-groupsio-members-needing-review" endpoint
+// of the "mailing-list" service.
+func NewListGroupsioMembersNeedingReviewResponseBody(res *mailinglist.GroupsioMemberList) *ListGroupsioMembersNeedingReviewResponseBody {
+	body := &ListGroupsioMembersNeedingReviewResponseBody{
+		Total: res.Total,
+	}
+	if res.Items != nil {
+		body.Items = make([]*GroupsioMemberResponseBody, len(res.Items))
+		for i, val := range res.Items {
+			body.Items[i] = marshalMailinglistGroupsioMemberToGroupsioMemberResponseBody(val)
+		}
 	}
 	return body
 }
 
-// NewGetGroupsioMemberResponseBody builds the HTTP response body from the
-// result of the "get-groupsio-member" endpoint of the "mailing-list" service.
-func NewGetGroupsioMemberResponseBody(res *mailinglist.GroupsioMember) *GetGroupsioMemberResponseBody {
-	body := &GetGroupsioMemberResponseBody{
+// NewListGroupsioMembersModifiedSinceResponseBody builds the HTTP response
+// body from the result of the "list-groupsio-members-modified-since" endpoint
+// of the "mailing-list" service.
+func NewListGroupsioMembersModifiedSinceResponseBody(res *mailinglist.GroupsioMemberList) *ListGroupsioMembersModifiedSinceResponseBody {
+	body := &ListGroupsioMembersModifiedSinceResponseBody{
+		Total: res.Total,
+	}
+	if res.Items != nil {
+		body.Items = make([]*GroupsioMemberResponseBody, len(res.Items))
+		for i, val := range res.Items {
+			body.Items[i] = marshalMailinglistGroupsioMemberToGroupsioMemberResponseBody(val)
+		}
+	}
+	return body
+}
+
+// NewListGroupsioMembersByOrganizationResponseBody builds the HTTP response
+// body from the result of the "list-groupsio-members-by-organization" endpoint
+// of the "mailing-list" service.
+func NewListGroupsioMembersByOrganizationResponseBody(res *mailinglist.GroupsioMemberList) *ListGroupsioMembersByOrganizationResponseBody {
+	body := &ListGroupsioMembersByOrganizationResponseBody{
+		Total: res.Total,
+	}
+	if res.Items != nil {
+		body.Items = make([]*GroupsioMemberResponseBody, len(res.Items))
+		for i, val := range res.Items {
+			body.Items[i] = marshalMailinglistGroupsioMemberToGroupsioMemberResponseBody(val)
+		}
+	}
+	return body
+}
+
+// NewListGroupsioDuplicateMembersResponseBody builds the HTTP response body
+// from the result of the "list-groupsio-duplicate-members" endpoint of the
+// "mailing-list" service.
+func NewListGroupsioDuplicateMembersResponseBody(res *mailinglist.GroupsioMemberDuplicates) *ListGroupsioDuplicateMembersResponseBody {
+	body := &ListGroupsioDuplicateMembersResponseBody{}
+	if res.Groups != nil {
+		body.Groups = make([][]string, len(res.Groups))
+		for i, val := range res.Groups {
+			body.Groups[i] = make([]string, len(val))
+			for j, val := range val {
+				body.Groups[i][j] = val
+			}
+		}
+	} else {
+		body.Groups = [][]string{}
+	}
+	return body
+}
+
+// NewPreviewGroupsioDeliveryModeChangeResponseBody builds the HTTP response
+// body from the result of the "preview-groupsio-delivery-mode-change" endpoint
+// of the "mailing-list" service.
+func NewPreviewGroupsioDeliveryModeChangeResponseBody(res *mailinglist.GroupsioDeliveryModePreview) *PreviewGroupsioDeliveryModeChangeResponseBody {
+	body := &PreviewGroupsioDeliveryModeChangeResponseBody{
+		TargetMode: res.TargetMode,
+	}
+	if res.Affected != nil {
+		body.Affected = make([]string, len(res.Affected))
+		for i, val := range res.Affected {
+			body.Affected[i] = val
+		}
+	} else {
+		body.Affected = []string{}
+	}
+	if res.Unchanged != nil {
+		body.Unchanged = make([]string, len(res.Unchanged))
+		for i, val := range res.Unchanged {
+			body.Unchanged[i] = val
+		}
+	} else {
+		body.Unchanged = []string{}
+	}
+	return body
+}
+
+// NewAddGroupsioMemberResponseBody builds the HTTP response body from the
+// result of the "add-groupsio-member" endpoint of the "mailing-list" service.
+func NewAddGroupsioMemberResponseBody(res *mailinglist.GroupsioMember) *AddGroupsioMemberResponseBody {
+	body := &AddGroupsioMemberResponseBody{
+		ID:           res.ID,
+		Email:        res.Email,
+		Name:         res.Name,
+		MemberType:   res.MemberType,
+		DeliveryMode: res.DeliveryMode,
+		ModStatus:    res.ModStatus,
+		Status:       res.Status,
+		Organization: res.Organization,
+		JobTitle:     res.JobTitle,
+		Username:     res.Username,
+		Role:         res.Role,
+		VotingStatus: res.VotingStatus,
+		CreatedAt:    res.CreatedAt,
+		UpdatedAt:    res.UpdatedAt,
+	}
+	return body
+}
+
+// NewUpsertGroupsioMemberResponseBody builds the HTTP response body from the
+// result of the "upsert-groupsio-member" endpoint of the "mailing-list"
+// service.
+func NewUpsertGroupsioMemberResponseBody(res *mailinglist.GroupsioMemberUpsertResult) *UpsertGroupsioMemberResponseBody {
+	body := &UpsertGroupsioMemberResponseBody{
+		Created: res.Created,
+	}
+	if res.Member != nil {
+		body.Member = marshalMailinglistGroupsioMemberToGroupsioMemberResponseBody(res.Member)
+	}
+	return body
+}
+
+// NewGetGroupsioMemberResponseBody builds the HTTP response body from the
+// result of the "get-groupsio-member" endpoint of the "mailing-list" service.
+func NewGetGroupsioMemberResponseBody(res *mailinglist.GroupsioMember) *GetGroupsioMemberResponseBody {
+	body := &GetGroupsioMemberResponseBody{
 		ID:           res.ID,
 		Email:        res.Email,
 		Name:         res.Name,
@@ -1523,6 +2694,53 @@ func NewUpdateGroupsioMemberResponseBody(res *mailinglist.GroupsioMember) *Updat
 	return body
 }
 
+// NewImportGroupsioMembersResponseBody builds the HTTP response body from the
+// result of the "import-groupsio-members" endpoint of the "mailing-list"
+// service.
+func NewImportGroupsioMembersResponseBody(res *mailinglist.GroupsioImportSummary) *ImportGroupsioMembersResponseBody {
+	body := &ImportGroupsioMembersResponseBody{
+		Created: res.Created,
+		Skipped: res.Skipped,
+		Errored: res.Errored,
+	}
+	if res.SkippedEmails != nil {
+		body.SkippedEmails = make([]string, len(res.SkippedEmails))
+		for i, val := range res.SkippedEmails {
+			body.SkippedEmails[i] = val
+		}
+	}
+	if res.ErroredEmails != nil {
+		body.ErroredEmails = make([]string, len(res.ErroredEmails))
+		for i, val := range res.ErroredEmails {
+			body.ErroredEmails[i] = val
+		}
+	}
+	return body
+}
+
+// NewChangeGroupsioMemberEmailResponseBody builds the HTTP response body from
+// the result of the "change-groupsio-member-email" endpoint of the
+// "mailing-list" service.
+func NewChangeGroupsioMemberEmailResponseBody(res *mailinglist.GroupsioMember) *ChangeGroupsioMemberEmailResponseBody {
+	body := &ChangeGroupsioMemberEmailResponseBody{
+		ID:           res.ID,
+		Email:        res.Email,
+		Name:         res.Name,
+		MemberType:   res.MemberType,
+		DeliveryMode: res.DeliveryMode,
+		ModStatus:    res.ModStatus,
+		Status:       res.Status,
+		Organization: res.Organization,
+		JobTitle:     res.JobTitle,
+		Username:     res.Username,
+		Role:         res.Role,
+		VotingStatus: res.VotingStatus,
+		CreatedAt:    res.CreatedAt,
+		UpdatedAt:    res.UpdatedAt,
+	}
+	return body
+}
+
 // NewCheckGroupsioSubscriberResponseBody builds the HTTP response body from
 // the result of the "check-groupsio-subscriber" endpoint of the "mailing-list"
 // service.
@@ -1581,6 +2799,132 @@ func NewGetGroupsioArtifactDownloadResponseBody(res *mailinglist.GroupsioArtifac
 	return body
 }
 
+// NewGetGroupsioProjectSummaryResponseBody builds the HTTP response body from
+// the result of the "get-groupsio-project-summary" endpoint of the
+// "mailing-list" service.
+func NewGetGroupsioProjectSummaryResponseBody(res *mailinglist.GroupsioProjectSummary) *GetGroupsioProjectSummaryResponseBody {
+	body := &GetGroupsioProjectSummaryResponseBody{
+		ProjectUID:       res.ProjectUID,
+		ServiceCount:     res.ServiceCount,
+		MailingListCount: res.MailingListCount,
+		MemberCount:      res.MemberCount,
+	}
+	return body
+}
+
+// NewListGroupsioOrphanedMailingListsResponseBody builds the HTTP response
+// body from the result of the "list-groupsio-orphaned-mailing-lists" endpoint
+// of the "mailing-list" service.
+func NewListGroupsioOrphanedMailingListsResponseBody(res *mailinglist.GroupsioSubgroupList) *ListGroupsioOrphanedMailingListsResponseBody {
+	body := &ListGroupsioOrphanedMailingListsResponseBody{
+		Total: res.Total,
+	}
+	if res.Items != nil {
+		body.Items = make([]*GroupsioSubgroupResponseBody, len(res.Items))
+		for i, val := range res.Items {
+			body.Items[i] = marshalMailinglistGroupsioSubgroupToGroupsioSubgroupResponseBody(val)
+		}
+	}
+	return body
+}
+
+// NewRepublishGroupsioProjectIndexResponseBody builds the HTTP response body
+// from the result of the "republish-groupsio-project-index" endpoint of the
+// "mailing-list" service.
+func NewRepublishGroupsioProjectIndexResponseBody(res *mailinglist.GroupsioRepublishSummary) *RepublishGroupsioProjectIndexResponseBody {
+	body := &RepublishGroupsioProjectIndexResponseBody{
+		Published: res.Published,
+	}
+	return body
+}
+
+// NewGetGroupsioMailingListStatsResponseBody builds the HTTP response body
+// from the result of the "get-groupsio-mailing-list-stats" endpoint of the
+// "mailing-list" service.
+func NewGetGroupsioMailingListStatsResponseBody(res *mailinglist.GroupsioSubgroupStats) *GetGroupsioMailingListStatsResponseBody {
+	body := &GetGroupsioMailingListStatsResponseBody{
+		SubgroupID:  res.SubgroupID,
+		MemberCount: res.MemberCount,
+		BounceCount: res.BounceCount,
+		CreatedAt:   res.CreatedAt,
+		UpdatedAt:   res.UpdatedAt,
+	}
+	if res.MembersByDeliveryMode != nil {
+		body.MembersByDeliveryMode = make(map[string]int, len(res.MembersByDeliveryMode))
+		for key, val := range res.MembersByDeliveryMode {
+			tk := key
+			tv := val
+			body.MembersByDeliveryMode[tk] = tv
+		}
+	}
+	return body
+}
+
+// NewGetGroupsioServiceTreeResponseBody builds the HTTP response body from the
+// result of the "get-groupsio-service-tree" endpoint of the "mailing-list"
+// service.
+func NewGetGroupsioServiceTreeResponseBody(res *mailinglist.GroupsioServiceTree) *GetGroupsioServiceTreeResponseBody {
+	body := &GetGroupsioServiceTreeResponseBody{}
+	if res.Service != nil {
+		body.Service = marshalMailinglistGroupsioServiceToGroupsioServiceResponseBody(res.Service)
+	}
+	if res.MailingLists != nil {
+		body.MailingLists = make([]*GroupsioServiceTreeSubgroupResponseBody, len(res.MailingLists))
+		for i, val := range res.MailingLists {
+			body.MailingLists[i] = marshalMailinglistGroupsioServiceTreeSubgroupToGroupsioServiceTreeSubgroupResponseBody(val)
+		}
+	} else {
+		body.MailingLists = []*GroupsioServiceTreeSubgroupResponseBody{}
+	}
+	return body
+}
+
+// NewGetGroupsioMemberHierarchyResponseBody builds the HTTP response body from
+// the result of the "get-groupsio-member-hierarchy" endpoint of the
+// "mailing-list" service.
+func NewGetGroupsioMemberHierarchyResponseBody(res *mailinglist.GroupsioMemberHierarchy) *GetGroupsioMemberHierarchyResponseBody {
+	body := &GetGroupsioMemberHierarchyResponseBody{}
+	if res.Member != nil {
+		body.Member = marshalMailinglistGroupsioMemberToGroupsioMemberResponseBody(res.Member)
+	}
+	if res.Subgroup != nil {
+		body.Subgroup = marshalMailinglistGroupsioSubgroupToGroupsioSubgroupResponseBody(res.Subgroup)
+	}
+	if res.Service != nil {
+		body.Service = marshalMailinglistGroupsioServiceToGroupsioServiceResponseBody(res.Service)
+	}
+	if res.Project != nil {
+		body.Project = marshalMailinglistGroupsioMemberHierarchyProjectToGroupsioMemberHierarchyProjectResponseBody(res.Project)
+	}
+	if res.Warnings != nil {
+		body.Warnings = make([]string, len(res.Warnings))
+		for i, val := range res.Warnings {
+			body.Warnings[i] = val
+		}
+	} else {
+		body.Warnings = []string{}
+	}
+	return body
+}
+
+// NewListGroupsioProjectMembersResponseBody builds the HTTP response body from
+// the result of the "list-groupsio-project-members" endpoint of the
+// "mailing-list" service.
+func NewListGroupsioProjectMembersResponseBody(res *mailinglist.GroupsioMemberPage) *ListGroupsioProjectMembersResponseBody {
+	body := &ListGroupsioProjectMembersResponseBody{
+		NextCursor: res.NextCursor,
+	}
+	if res.Items != nil {
+		body.Items = make([]*GroupsioMemberResponseBody, len(res.Items))
+		for i, val := range res.Items {
+			body.Items[i] = marshalMailinglistGroupsioMemberToGroupsioMemberResponseBody(val)
+		}
+	} else {
+		body.Items = []*GroupsioMemberResponseBody{}
+	}
+	return body
+}
+
 // NewReadyzServiceUnavailableResponseBody builds the HTTP response body from
 // the result of the "readyz" endpoint of the "mailing-list" service.
 func NewReadyzServiceUnavailableResponseBody(res *mailinglist.ServiceUnavailableError) *ReadyzServiceUnavailableResponseBody {
@@ -1590,774 +2934,1552 @@ func NewReadyzServiceUnavailableResponseBody(res *mailinglist.ServiceUnavailable
 	return body
 }
 
-// NewListGroupsioServicesBadRequestResponseBody builds the HTTP response body
-// from the result of the "list-groupsio-services" endpoint of the
-// "mailing-list" service.
-func NewListGroupsioServicesBadRequestResponseBody(res *mailinglist.BadRequestError) *ListGroupsioServicesBadRequestResponseBody {
-	body := &ListGroupsioServicesBadRequestResponseBody{
+// NewListGroupsioServicesBadRequestResponseBody builds the HTTP response body
+// from the result of the "list-groupsio-services" endpoint of the
+// "mailing-list" service.
+func NewListGroupsioServicesBadRequestResponseBody(res *mailinglist.BadRequestError) *ListGroupsioServicesBadRequestResponseBody {
+	body := &ListGroupsioServicesBadRequestResponseBody{
+		Message: res.Message,
+	}
+	return body
+}
+
+// NewListGroupsioServicesInternalServerErrorResponseBody builds the HTTP
+// response body from the result of the "list-groupsio-services" endpoint of
+// the "mailing-list" service.
+func NewListGroupsioServicesInternalServerErrorResponseBody(res *mailinglist.InternalServerError) *ListGroupsioServicesInternalServerErrorResponseBody {
+	body := &ListGroupsioServicesInternalServerErrorResponseBody{
+		Message: res.Message,
+	}
+	return body
+}
+
+// NewListGroupsioServicesServiceUnavailableResponseBody builds the HTTP
+// response body from the result of the "list-groupsio-services" endpoint of
+// the "mailing-list" service.
+func NewListGroupsioServicesServiceUnavailableResponseBody(res *mailinglist.ServiceUnavailableError) *ListGroupsioServicesServiceUnavailableResponseBody {
+	body := &ListGroupsioServicesServiceUnavailableResponseBody{
+		Message: res.Message,
+	}
+	return body
+}
+
+// NewCreateGroupsioServiceBadRequestResponseBody builds the HTTP response body
+// from the result of the "create-groupsio-service" endpoint of the
+// "mailing-list" service.
+func NewCreateGroupsioServiceBadRequestResponseBody(res *mailinglist.BadRequestError) *CreateGroupsioServiceBadRequestResponseBody {
+	body := &CreateGroupsioServiceBadRequestResponseBody{
+		Message: res.Message,
+	}
+	return body
+}
+
+// NewCreateGroupsioServiceConflictResponseBody builds the HTTP response body
+// from the result of the "create-groupsio-service" endpoint of the
+// "mailing-list" service.
+func NewCreateGroupsioServiceConflictResponseBody(res *mailinglist.ConflictError) *CreateGroupsioServiceConflictResponseBody {
+	body := &CreateGroupsioServiceConflictResponseBody{
+		Message: res.Message,
+	}
+	return body
+}
+
+// NewCreateGroupsioServiceInternalServerErrorResponseBody builds the HTTP
+// response body from the result of the "create-groupsio-service" endpoint of
+// the "mailing-list" service.
+func NewCreateGroupsioServiceInternalServerErrorResponseBody(res *mailinglist.InternalServerError) *CreateGroupsioServiceInternalServerErrorResponseBody {
+	body := &CreateGroupsioServiceInternalServerErrorResponseBody{
+		Message: res.Message,
+	}
+	return body
+}
+
+// NewCreateGroupsioServiceServiceUnavailableResponseBody builds the HTTP
+// response body from the result of the "create-groupsio-service" endpoint of
+// the "mailing-list" service.
+func NewCreateGroupsioServiceServiceUnavailableResponseBody(res *mailinglist.ServiceUnavailableError) *CreateGroupsioServiceServiceUnavailableResponseBody {
+	body := &CreateGroupsioServiceServiceUnavailableResponseBody{
+		Message: res.Message,
+	}
+	return body
+}
+
+// NewGetGroupsioServiceInternalServerErrorResponseBody builds the HTTP
+// response body from the result of the "get-groupsio-service" endpoint of the
+// "mailing-list" service.
+func NewGetGroupsioServiceInternalServerErrorResponseBody(res *mailinglist.InternalServerError) *GetGroupsioServiceInternalServerErrorResponseBody {
+	body := &GetGroupsioServiceInternalServerErrorResponseBody{
+		Message: res.Message,
+	}
+	return body
+}
+
+// NewGetGroupsioServiceNotFoundResponseBody builds the HTTP response body from
+// the result of the "get-groupsio-service" endpoint of the "mailing-list"
+// service.
+func NewGetGroupsioServiceNotFoundResponseBody(res *mailinglist.NotFoundError) *GetGroupsioServiceNotFoundResponseBody {
+	body := &GetGroupsioServiceNotFoundResponseBody{
+		Message: res.Message,
+	}
+	return body
+}
+
+// NewGetGroupsioServiceServiceUnavailableResponseBody builds the HTTP response
+// body from the result of the "get-groupsio-service" endpoint of the
+// "mailing-list" service.
+func NewGetGroupsioServiceServiceUnavailableResponseBody(res *mailinglist.ServiceUnavailableError) *GetGroupsioServiceServiceUnavailableResponseBody {
+	body := &GetGroupsioServiceServiceUnavailableResponseBody{
+		Message: res.Message,
+	}
+	return body
+}
+
+// NewUpdateGroupsioServiceBadRequestResponseBody builds the HTTP response body
+// from the result of the "update-groupsio-service" endpoint of the
+// "mailing-list" service.
+func NewUpdateGroupsioServiceBadRequestResponseBody(res *mailinglist.BadRequestError) *UpdateGroupsioServiceBadRequestResponseBody {
+	body := &UpdateGroupsioServiceBadRequestResponseBody{
+		Message: res.Message,
+	}
+	return body
+}
+
+// NewUpdateGroupsioServiceInternalServerErrorResponseBody builds the HTTP
+// response body from the result of the "update-groupsio-service" endpoint of
+// the "mailing-list" service.
+func NewUpdateGroupsioServiceInternalServerErrorResponseBody(res *mailinglist.InternalServerError) *UpdateGroupsioServiceInternalServerErrorResponseBody {
+	body := &UpdateGroupsioServiceInternalServerErrorResponseBody{
+		Message: res.Message,
+	}
+	return body
+}
+
+// NewUpdateGroupsioServiceNotFoundResponseBody builds the HTTP response body
+// from the result of the "update-groupsio-service" endpoint of the
+// "mailing-list" service.
+func NewUpdateGroupsioServiceNotFoundResponseBody(res *mailinglist.NotFoundError) *UpdateGroupsioServiceNotFoundResponseBody {
+	body := &UpdateGroupsioServiceNotFoundResponseBody{
+		Message: res.Message,
+	}
+	return body
+}
+
+// NewUpdateGroupsioServiceServiceUnavailableResponseBody builds the HTTP
+// response body from the result of the "update-groupsio-service" endpoint of
+// the "mailing-list" service.
+func NewUpdateGroupsioServiceServiceUnavailableResponseBody(res *mailinglist.ServiceUnavailableError) *UpdateGroupsioServiceServiceUnavailableResponseBody {
+	body := &UpdateGroupsioServiceServiceUnavailableResponseBody{
+		Message: res.Message,
+	}
+	return body
+}
+
+// NewPromoteGroupsioServiceBadRequestResponseBody builds the HTTP response
+// body from the result of the "promote-groupsio-service" endpoint of the
+// "mailing-list" service.
+func NewPromoteGroupsioServiceBadRequestResponseBody(res *mailinglist.BadRequestError) *PromoteGroupsioServiceBadRequestResponseBody {
+	body := &PromoteGroupsioServiceBadRequestResponseBody{
+		Message: res.Message,
+	}
+	return body
+}
+
+// NewPromoteGroupsioServiceConflictResponseBody builds the HTTP response body
+// from the result of the "promote-groupsio-service" endpoint of the
+// "mailing-list" service.
+func NewPromoteGroupsioServiceConflictResponseBody(res *mailinglist.ConflictError) *PromoteGroupsioServiceConflictResponseBody {
+	body := &PromoteGroupsioServiceConflictResponseBody{
+		Message: res.Message,
+	}
+	return body
+}
+
+// NewPromoteGroupsioServiceInternalServerErrorResponseBody builds the HTTP
+// response body from the result of the "promote-groupsio-service" endpoint of
+// the "mailing-list" service.
+func NewPromoteGroupsioServiceInternalServerErrorResponseBody(res *mailinglist.InternalServerError) *PromoteGroupsioServiceInternalServerErrorResponseBody {
+	body := &PromoteGroupsioServiceInternalServerErrorResponseBody{
+		Message: res.Message,
+	}
+	return body
+}
+
+// NewPromoteGroupsioServiceNotFoundResponseBody builds the HTTP response body
+// from the result of the "promote-groupsio-service" endpoint of the
+// "mailing-list" service.
+func NewPromoteGroupsioServiceNotFoundResponseBody(res *mailinglist.NotFoundError) *PromoteGroupsioServiceNotFoundResponseBody {
+	body := &PromoteGroupsioServiceNotFoundResponseBody{
+		Message: res.Message,
+	}
+	return body
+}
+
+// NewPromoteGroupsioServiceServiceUnavailableResponseBody builds the HTTP
+// response body from the result of the "promote-groupsio-service" endpoint of
+// the "mailing-list" service.
+func NewPromoteGroupsioServiceServiceUnavailableResponseBody(res *mailinglist.ServiceUnavailableError) *PromoteGroupsioServiceServiceUnavailableResponseBody {
+	body := &PromoteGroupsioServiceServiceUnavailableResponseBody{
+		Message: res.Message,
+	}
+	return body
+}
+
+// NewDeleteGroupsioServiceInternalServerErrorResponseBody builds the HTTP
+// response body from the result of the "delete-groupsio-service" endpoint of
+// the "mailing-list" service.
+func NewDeleteGroupsioServiceInternalServerErrorResponseBody(res *mailinglist.InternalServerError) *DeleteGroupsioServiceInternalServerErrorResponseBody {
+	body := &DeleteGroupsioServiceInternalServerErrorResponseBody{
+		Message: res.Message,
+	}
+	return body
+}
+
+// NewDeleteGroupsioServiceNotFoundResponseBody builds the HTTP response body
+// from the result of the "delete-groupsio-service" endpoint of the
+// "mailing-list" service.
+func NewDeleteGroupsioServiceNotFoundResponseBody(res *mailinglist.NotFoundError) *DeleteGroupsioServiceNotFoundResponseBody {
+	body := &DeleteGroupsioServiceNotFoundResponseBody{
+		Message: res.Message,
+	}
+	return body
+}
+
+// NewDeleteGroupsioServiceServiceUnavailableResponseBody builds the HTTP
+// response body from the result of the "delete-groupsio-service" endpoint of
+// the "mailing-list" service.
+func NewDeleteGroupsioServiceServiceUnavailableResponseBody(res *mailinglist.ServiceUnavailableError) *DeleteGroupsioServiceServiceUnavailableResponseBody {
+	body := &DeleteGroupsioServiceServiceUnavailableResponseBody{
+		Message: res.Message,
+	}
+	return body
+}
+
+// NewGetGroupsioServiceProjectsInternalServerErrorResponseBody builds the HTTP
+// response body from the result of the "get-groupsio-service-projects"
+// endpoint of the "mailing-list" service.
+func NewGetGroupsioServiceProjectsInternalServerErrorResponseBody(res *mailinglist.InternalServerError) *GetGroupsioServiceProjectsInternalServerErrorResponseBody {
+	body := &GetGroupsioServiceProjectsInternalServerErrorResponseBody{
+		Message: res.Message,
+	}
+	return body
+}
+
+// NewGetGroupsioServiceProjectsServiceUnavailableResponseBody builds the HTTP
+// response body from the result of the "get-groupsio-service-projects"
+// endpoint of the "mailing-list" service.
+func NewGetGroupsioServiceProjectsServiceUnavailableResponseBody(res *mailinglist.ServiceUnavailableError) *GetGroupsioServiceProjectsServiceUnavailableResponseBody {
+	body := &GetGroupsioServiceProjectsServiceUnavailableResponseBody{
+		Message: res.Message,
+	}
+	return body
+}
+
+// NewListGroupsioServicesByStatusBadRequestResponseBody builds the HTTP
+// response body from the result of the "list-groupsio-services-by-status"
+// endpoint of the "mailing-list" service.
+func NewListGroupsioServicesByStatusBadRequestResponseBody(res *mailinglist.BadRequestError) *ListGroupsioServicesByStatusBadRequestResponseBody {
+	body := &ListGroupsioServicesByStatusBadRequestResponseBody{
+		Message: res.Message,
+	}
+	return body
+}
+
+// NewListGroupsioServicesByStatusInternalServerErrorResponseBody builds the
+// HTTP response body from the result of the "list-groupsio-services-by-status"
+// endpoint of the "mailing-list" service.
+func NewListGroupsioServicesByStatusInternalServerErrorResponseBody(res *mailinglist.InternalServerError) *ListGroupsioServicesByStatusInternalServerErrorResponseBody {
+	body := &ListGroupsioServicesByStatusInternalServerErrorResponseBody{
+		Message: res.Message,
+	}
+	return body
+}
+
+// NewListGroupsioServicesByStatusServiceUnavailableResponseBody builds the
+// HTTP response body from the result of the "list-groupsio-services-by-status"
+// endpoint of the "mailing-list" service.
+func NewListGroupsioServicesByStatusServiceUnavailableResponseBody(res *mailinglist.ServiceUnavailableError) *ListGroupsioServicesByStatusServiceUnavailableResponseBody {
+	body := &ListGroupsioServicesByStatusServiceUnavailableResponseBody{
+		Message: res.Message,
+	}
+	return body
+}
+
+// NewListUnprovisionedGroupsioServicesBadRequestResponseBody builds the HTTP
+// response body from the result of the "list-unprovisioned-groupsio-services"
+// endpoint of the "mailing-list" service.
+func NewListUnprovisionedGroupsioServicesBadRequestResponseBody(res *mailinglist.BadRequestError) *ListUnprovisionedGroupsioServicesBadRequestResponseBody {
+	body := &ListUnprovisionedGroupsioServicesBadRequestResponseBody{
+		Message: res.Message,
+	}
+	return body
+}
+
+// NewListUnprovisionedGroupsioServicesInternalServerErrorResponseBody builds
+// the HTTP response body from the result of the
+// "list-unprovisioned-groupsio-services" endpoint of the "mailing-list"
+// service.
+func NewListUnprovisionedGroupsioServicesInternalServerErrorResponseBody(res *mailinglist.InternalServerError) *ListUnprovisionedGroupsioServicesInternalServerErrorResponseBody {
+	body := &ListUnprovisionedGroupsioServicesInternalServerErrorResponseBody{
+		Message: res.Message,
+	}
+	return body
+}
+
+// NewListUnprovisionedGroupsioServicesServiceUnavailableResponseBody builds
+// the HTTP response body from the result of the
+// "list-unprovisioned-groupsio-services" endpoint of the "mailing-list"
+// service.
+func NewListUnprovisionedGroupsioServicesServiceUnavailableResponseBody(res *mailinglist.ServiceUnavailableError) *ListUnprovisionedGroupsioServicesServiceUnavailableResponseBody {
+	body := &ListUnprovisionedGroupsioServicesServiceUnavailableResponseBody{
+		Message: res.Message,
+	}
+	return body
+}
+
+// NewFindParentGroupsioServiceBadRequestResponseBody builds the HTTP response
+// body from the result of the "find-parent-groupsio-service" endpoint of the
+// "mailing-list" service.
+func NewFindParentGroupsioServiceBadRequestResponseBody(res *mailinglist.BadRequestError) *FindParentGroupsioServiceBadRequestResponseBody {
+	body := &FindParentGroupsioServiceBadRequestResponseBody{
+		Message: res.Message,
+	}
+	return body
+}
+
+// NewFindParentGroupsioServiceInternalServerErrorResponseBody builds the HTTP
+// response body from the result of the "find-parent-groupsio-service" endpoint
+// of the "mailing-list" service.
+func NewFindParentGroupsioServiceInternalServerErrorResponseBody(res *mailinglist.InternalServerError) *FindParentGroupsioServiceInternalServerErrorResponseBody {
+	body := &FindParentGroupsioServiceInternalServerErrorResponseBody{
+		Message: res.Message,
+	}
+	return body
+}
+
+// NewFindParentGroupsioServiceNotFoundResponseBody builds the HTTP response
+// body from the result of the "find-parent-groupsio-service" endpoint of the
+// "mailing-list" service.
+func NewFindParentGroupsioServiceNotFoundResponseBody(res *mailinglist.NotFoundError) *FindParentGroupsioServiceNotFoundResponseBody {
+	body := &FindParentGroupsioServiceNotFoundResponseBody{
+		Message: res.Message,
+	}
+	return body
+}
+
+// NewFindParentGroupsioServiceServiceUnavailableResponseBody builds the HTTP
+// response body from the result of the "find-parent-groupsio-service" endpoint
+// of the "mailing-list" service.
+func NewFindParentGroupsioServiceServiceUnavailableResponseBody(res *mailinglist.ServiceUnavailableError) *FindParentGroupsioServiceServiceUnavailableResponseBody {
+	body := &FindParentGroupsioServiceServiceUnavailableResponseBody{
+		Message: res.Message,
+	}
+	return body
+}
+
+// NewListGroupsioMailingListsBadRequestResponseBody builds the HTTP response
+// body from the result of the "list-groupsio-mailing-lists" endpoint of the
+// "mailing-list" service.
+func NewListGroupsioMailingListsBadRequestResponseBody(res *mailinglist.BadRequestError) *ListGroupsioMailingListsBadRequestResponseBody {
+	body := &ListGroupsioMailingListsBadRequestResponseBody{
+		Message: res.Message,
+	}
+	return body
+}
+
+// NewListGroupsioMailingListsInternalServerErrorResponseBody builds the HTTP
+// response body from the result of the "list-groupsio-mailing-lists" endpoint
+// of the "mailing-list" service.
+func NewListGroupsioMailingListsInternalServerErrorResponseBody(res *mailinglist.InternalServerError) *ListGroupsioMailingListsInternalServerErrorResponseBody {
+	body := &ListGroupsioMailingListsInternalServerErrorResponseBody{
+		Message: res.Message,
+	}
+	return body
+}
+
+// NewListGroupsioMailingListsServiceUnavailableResponseBody builds the HTTP
+// response body from the result of the "list-groupsio-mailing-lists" endpoint
+// of the "mailing-list" service.
+func NewListGroupsioMailingListsServiceUnavailableResponseBody(res *mailinglist.ServiceUnavailableError) *ListGroupsioMailingListsServiceUnavailableResponseBody {
+	body := &ListGroupsioMailingListsServiceUnavailableResponseBody{
+		Message: res.Message,
+	}
+	return body
+}
+
+// NewListGroupsioMailingListsByVisibilityBadRequestResponseBody builds the
+// HTTP response body from the result of the
+// "list-groupsio-mailing-lists-by-visibility" endpoint of the "mailing-list"
+// service.
+func NewListGroupsioMailingListsByVisibilityBadRequestResponseBody(res *mailinglist.BadRequestError) *ListGroupsioMailingListsByVisibilityBadRequestResponseBody {
+	body := &ListGroupsioMailingListsByVisibilityBadRequestResponseBody{
+		Message: res.Message,
+	}
+	return body
+}
+
+// NewListGroupsioMailingListsByVisibilityInternalServerErrorResponseBody
+// builds the HTTP response body from the result of the
+// "list-groupsio-mailing-lists-by-visibility" endpoint of the "mailing-list"
+// service.
+func NewListGroupsioMailingListsByVisibilityInternalServerErrorResponseBody(res *mailinglist.InternalServerError) *ListGroupsioMailingListsByVisibilityInternalServerErrorResponseBody {
+	body := &ListGroupsioMailingListsByVisibilityInternalServerErrorResponseBody{
+		Message: res.Message,
+	}
+	return body
+}
+
+// NewListGroupsioMailingListsByVisibilityServiceUnavailableResponseBody builds
+// the HTTP response body from the result of the
+// "list-groupsio-mailing-lists-by-visibility" endpoint of the "mailing-list"
+// service.
+func NewListGroupsioMailingListsByVisibilityServiceUnavailableResponseBody(res *mailinglist.ServiceUnavailableError) *ListGroupsioMailingListsByVisibilityServiceUnavailableResponseBody {
+	body := &ListGroupsioMailingListsByVisibilityServiceUnavailableResponseBody{
+		Message: res.Message,
+	}
+	return body
+}
+
+// NewListGroupsioMailingListsUpdatedSinceBadRequestResponseBody builds the
+// HTTP response body from the result of the
+// "list-groupsio-mailing-lists-updated-since" endpoint of the "mailing-list"
+// service.
+func NewListGroupsioMailingListsUpdatedSinceBadRequestResponseBody(res *mailinglist.BadRequestError) *ListGroupsioMailingListsUpdatedSinceBadRequestResponseBody {
+	body := &ListGroupsioMailingListsUpdatedSinceBadRequestResponseBody{
+		Message: res.Message,
+	}
+	return body
+}
+
+// NewListGroupsioMailingListsUpdatedSinceInternalServerErrorResponseBody
+// builds the HTTP response body from the result of the
+// "list-groupsio-mailing-lists-updated-since" endpoint of the "mailing-list"
+// service.
+func NewListGroupsioMailingListsUpdatedSinceInternalServerErrorResponseBody(res *mailinglist.InternalServerError) *ListGroupsioMailingListsUpdatedSinceInternalServerErrorResponseBody {
+	body := &ListGroupsioMailingListsUpdatedSinceInternalServerErrorResponseBody{
+		Message: res.Message,
+	}
+	return body
+}
+
+// NewListGroupsioMailingListsUpdatedSinceServiceUnavailableResponseBody builds
+// the HTTP response body from the result of the
+// "list-groupsio-mailing-lists-updated-since" endpoint of the "mailing-list"
+// service.
+func NewListGroupsioMailingListsUpdatedSinceServiceUnavailableResponseBody(res *mailinglist.ServiceUnavailableError) *ListGroupsioMailingListsUpdatedSinceServiceUnavailableResponseBody {
+	body := &ListGroupsioMailingListsUpdatedSinceServiceUnavailableResponseBody{
+		Message: res.Message,
+	}
+	return body
+}
+
+// NewCreateGroupsioMailingListBadRequestResponseBody builds the HTTP response
+// body from the result of the "create-groupsio-mailing-list" endpoint of the
+// "mailing-list" service.
+func NewCreateGroupsioMailingListBadRequestResponseBody(res *mailinglist.BadRequestError) *CreateGroupsioMailingListBadRequestResponseBody {
+	body := &CreateGroupsioMailingListBadRequestResponseBody{
+		Message: res.Message,
+	}
+	return body
+}
+
+// NewCreateGroupsioMailingListConflictResponseBody builds the HTTP response
+// body from the result of the "create-groupsio-mailing-list" endpoint of the
+// "mailing-list" service.
+func NewCreateGroupsioMailingListConflictResponseBody(res *mailinglist.ConflictError) *CreateGroupsioMailingListConflictResponseBody {
+	body := &CreateGroupsioMailingListConflictResponseBody{
+		Message: res.Message,
+	}
+	return body
+}
+
+// NewCreateGroupsioMailingListInternalServerErrorResponseBody builds the HTTP
+// response body from the result of the "create-groupsio-mailing-list" endpoint
+// of the "mailing-list" service.
+func NewCreateGroupsioMailingListInternalServerErrorResponseBody(res *mailinglist.InternalServerError) *CreateGroupsioMailingListInternalServerErrorResponseBody {
+	body := &CreateGroupsioMailingListInternalServerErrorResponseBody{
+		Message: res.Message,
+	}
+	return body
+}
+
+// NewCreateGroupsioMailingListServiceUnavailableResponseBody builds the HTTP
+// response body from the result of the "create-groupsio-mailing-list" endpoint
+// of the "mailing-list" service.
+func NewCreateGroupsioMailingListServiceUnavailableResponseBody(res *mailinglist.ServiceUnavailableError) *CreateGroupsioMailingListServiceUnavailableResponseBody {
+	body := &CreateGroupsioMailingListServiceUnavailableResponseBody{
+		Message: res.Message,
+	}
+	return body
+}
+
+// NewGetGroupsioMailingListInternalServerErrorResponseBody builds the HTTP
+// response body from the result of the "get-groupsio-mailing-list" endpoint of
+// the "mailing-list" service.
+func NewGetGroupsioMailingListInternalServerErrorResponseBody(res *mailinglist.InternalServerError) *GetGroupsioMailingListInternalServerErrorResponseBody {
+	body := &GetGroupsioMailingListInternalServerErrorResponseBody{
+		Message: res.Message,
+	}
+	return body
+}
+
+// NewGetGroupsioMailingListNotFoundResponseBody builds the HTTP response body
+// from the result of the "get-groupsio-mailing-list" endpoint of the
+// "mailing-list" service.
+func NewGetGroupsioMailingListNotFoundResponseBody(res *mailinglist.NotFoundError) *GetGroupsioMailingListNotFoundResponseBody {
+	body := &GetGroupsioMailingListNotFoundResponseBody{
+		Message: res.Message,
+	}
+	return body
+}
+
+// NewGetGroupsioMailingListServiceUnavailableResponseBody builds the HTTP
+// response body from the result of the "get-groupsio-mailing-list" endpoint of
+// the "mailing-list" service.
+func NewGetGroupsioMailingListServiceUnavailableResponseBody(res *mailinglist.ServiceUnavailableError) *GetGroupsioMailingListServiceUnavailableResponseBody {
+	body := &GetGroupsioMailingListServiceUnavailableResponseBody{
+		Message: res.Message,
+	}
+	return body
+}
+
+// NewUpdateGroupsioMailingListBadRequestResponseBody builds the HTTP response
+// body from the result of the "update-groupsio-mailing-list" endpoint of the
+// "mailing-list" service.
+func NewUpdateGroupsioMailingListBadRequestResponseBody(res *mailinglist.BadRequestError) *UpdateGroupsioMailingListBadRequestResponseBody {
+	body := &UpdateGroupsioMailingListBadRequestResponseBody{
+		Message: res.Message,
+	}
+	return body
+}
+
+// NewUpdateGroupsioMailingListInternalServerErrorResponseBody builds the HTTP
+// response body from the result of the "update-groupsio-mailing-list" endpoint
+// of the "mailing-list" service.
+func NewUpdateGroupsioMailingListInternalServerErrorResponseBody(res *mailinglist.InternalServerError) *UpdateGroupsioMailingListInternalServerErrorResponseBody {
+	body := &UpdateGroupsioMailingListInternalServerErrorResponseBody{
+		Message: res.Message,
+	}
+	return body
+}
+
+// NewUpdateGroupsioMailingListNotFoundResponseBody builds the HTTP response
+// body from the result of the "update-groupsio-mailing-list" endpoint of the
+// "mailing-list" service.
+func NewUpdateGroupsioMailingListNotFoundResponseBody(res *mailinglist.NotFoundError) *UpdateGroupsioMailingListNotFoundResponseBody {
+	body := &UpdateGroupsioMailingListNotFoundResponseBody{
+		Message: res.Message,
+	}
+	return body
+}
+
+// NewUpdateGroupsioMailingListServiceUnavailableResponseBody builds the HTTP
+// response body from the result of the "update-groupsio-mailing-list" endpoint
+// of the "mailing-list" service.
+func NewUpdateGroupsioMailingListServiceUnavailableResponseBody(res *mailinglist.ServiceUnavailableError) *UpdateGroupsioMailingListServiceUnavailableResponseBody {
+	body := &UpdateGroupsioMailingListServiceUnavailableResponseBody{
+		Message: res.Message,
+	}
+	return body
+}
+
+// NewDeleteGroupsioMailingListInternalServerErrorResponseBody builds the HTTP
+// response body from the result of the "delete-groupsio-mailing-list" endpoint
+// of the "mailing-list" service.
+func NewDeleteGroupsioMailingListInternalServerErrorResponseBody(res *mailinglist.InternalServerError) *DeleteGroupsioMailingListInternalServerErrorResponseBody {
+	body := &DeleteGroupsioMailingListInternalServerErrorResponseBody{
+		Message: res.Message,
+	}
+	return body
+}
+
+// NewDeleteGroupsioMailingListNotFoundResponseBody builds the HTTP response
+// body from the result of the "delete-groupsio-mailing-list" endpoint of the
+// "mailing-list" service.
+func NewDeleteGroupsioMailingListNotFoundResponseBody(res *mailinglist.NotFoundError) *DeleteGroupsioMailingListNotFoundResponseBody {
+	body := &DeleteGroupsioMailingListNotFoundResponseBody{
+		Message: res.Message,
+	}
+	return body
+}
+
+// NewDeleteGroupsioMailingListServiceUnavailableResponseBody builds the HTTP
+// response body from the result of the "delete-groupsio-mailing-list" endpoint
+// of the "mailing-list" service.
+func NewDeleteGroupsioMailingListServiceUnavailableResponseBody(res *mailinglist.ServiceUnavailableError) *DeleteGroupsioMailingListServiceUnavailableResponseBody {
+	body := &DeleteGroupsioMailingListServiceUnavailableResponseBody{
+		Message: res.Message,
+	}
+	return body
+}
+
+// NewGetGroupsioMailingListCountBadRequestResponseBody builds the HTTP
+// response body from the result of the "get-groupsio-mailing-list-count"
+// endpoint of the "mailing-list" service.
+func NewGetGroupsioMailingListCountBadRequestResponseBody(res *mailinglist.BadRequestError) *GetGroupsioMailingListCountBadRequestResponseBody {
+	body := &GetGroupsioMailingListCountBadRequestResponseBody{
+		Message: res.Message,
+	}
+	return body
+}
+
+// NewGetGroupsioMailingListCountInternalServerErrorResponseBody builds the
+// HTTP response body from the result of the "get-groupsio-mailing-list-count"
+// endpoint of the "mailing-list" service.
+func NewGetGroupsioMailingListCountInternalServerErrorResponseBody(res *mailinglist.InternalServerError) *GetGroupsioMailingListCountInternalServerErrorResponseBody {
+	body := &GetGroupsioMailingListCountInternalServerErrorResponseBody{
+		Message: res.Message,
+	}
+	return body
+}
+
+// NewGetGroupsioMailingListCountServiceUnavailableResponseBody builds the HTTP
+// response body from the result of the "get-groupsio-mailing-list-count"
+// endpoint of the "mailing-list" service.
+func NewGetGroupsioMailingListCountServiceUnavailableResponseBody(res *mailinglist.ServiceUnavailableError) *GetGroupsioMailingListCountServiceUnavailableResponseBody {
+	body := &GetGroupsioMailingListCountServiceUnavailableResponseBody{
+		Message: res.Message,
+	}
+	return body
+}
+
+// NewGetGroupsioMailingListMemberCountInternalServerErrorResponseBody builds
+// the HTTP response body from the result of the
+// "get-groupsio-mailing-list-member-count" endpoint of the "mailing-list"
+// service.
+func NewGetGroupsioMailingListMemberCountInternalServerErrorResponseBody(res *mailinglist.InternalServerError) *GetGroupsioMailingListMemberCountInternalServerErrorResponseBody {
+	body := &GetGroupsioMailingListMemberCountInternalServerErrorResponseBody{
+		Message: res.Message,
+	}
+	return body
+}
+
+// NewGetGroupsioMailingListMemberCountNotFoundResponseBody builds the HTTP
+// response body from the result of the
+// "get-groupsio-mailing-list-member-count" endpoint of the "mailing-list"
+// service.
+func NewGetGroupsioMailingListMemberCountNotFoundResponseBody(res *mailinglist.NotFoundError) *GetGroupsioMailingListMemberCountNotFoundResponseBody {
+	body := &GetGroupsioMailingListMemberCountNotFoundResponseBody{
+		Message: res.Message,
+	}
+	return body
+}
+
+// NewGetGroupsioMailingListMemberCountServiceUnavailableResponseBody builds
+// the HTTP response body from the result of the
+// "get-groupsio-mailing-list-member-count" endpoint of the "mailing-list"
+// service.
+func NewGetGroupsioMailingListMemberCountServiceUnavailableResponseBody(res *mailinglist.ServiceUnavailableError) *GetGroupsioMailingListMemberCountServiceUnavailableResponseBody {
+	body := &GetGroupsioMailingListMemberCountServiceUnavailableResponseBody{
+		Message: res.Message,
+	}
+	return body
+}
+
+// NewListGroupsioMembersInternalServerErrorResponseBody builds the HTTP
+// response body from the result of the "list-groupsio-members" endpoint of the
+// "mailing-list" service.
+func NewListGroupsioMembersInternalServerErrorResponseBody(res *mailinglist.InternalServerError) *ListGroupsioMembersInternalServerErrorResponseBody {
+	body := &ListGroupsioMembersInternalServerErrorResponseBody{
+		Message: res.Message,
+	}
+	return body
+}
+
+// NewListGroupsioMembersNotFoundResponseBody builds the HTTP response body
+// from the result of the "list-groupsio-members" endpoint of the
+// "mailing-list" service.
+func NewListGroupsioMembersNotFoundResponseBody(res *mailinglist.NotFoundError) *ListGroupsioMembersNotFoundResponseBody {
+	body := &ListGroupsioMembersNotFoundResponseBody{
+		Message: res.Message,
+	}
+	return body
+}
+
+// NewListGroupsioMembersServiceUnavailableResponseBody builds the HTTP
+// response body from the result of the "list-groupsio-members" endpoint of the
+// "mailing-list" service.
+func NewListGroupsioMembersServiceUnavailableResponseBody(res *mailinglist.ServiceUnavailableError) *ListGroupsioMembersServiceUnavailableResponseBody {
+	body := &ListGroupsioMembersServiceUnavailableResponseBody{
+		Message: res.Message,
+	}
+	return body
+}
+
+// NewListGroupsioMembersNeedingReviewBadRequestResponseBody builds the HTTP
+// response body from the result of the "list-groupsio-members-needing-review"
+// endpoint of the "mailing-list" service.
+func NewListGroupsioMembersNeedingReviewBadRequestResponseBody(res *mailinglist.BadRequestError) *ListGroupsioMembersNeedingReviewBadRequestResponseBody {
+	body := &ListGroupsioMembersNeedingReviewBadRequestResponseBody{
+		Message: res.Message,
+	}
+	return body
+}
+
+// NewListGroupsioMembersNeedingReviewInternalServerErrorResponseBody builds
+// the HTTP response body from the result of the
+// "list-groupsio-members-needing-review" endpoint of the "mailing-list"
+// service.
+func NewListGroupsioMembersNeedingReviewInternalServerErrorResponseBody(res *mailinglist.InternalServerError) *ListGroupsioMembersNeedingReviewInternalServerErrorResponseBody {
+	body := &ListGroupsioMembersNeedingReviewInternalServerErrorResponseBody{
+		Message: res.Message,
+	}
+	return body
+}
+
+// NewListGroupsioMembersNeedingReviewNotFoundResponseBody builds the HTTP
+// response body from the result of the "list-groupsio-members-needing-review"
+// endpoint of the "mailing-list" service.
+func NewListGroupsioMembersNeedingReviewNotFoundResponseBody(res *mailinglist.NotFoundError) *ListGroupsioMembersNeedingReviewNotFoundResponseBody {
+	body := &ListGroupsioMembersNeedingReviewNotFoundResponseBody{
+		Message: res.Message,
+	}
+	return body
+}
+
+// NewListGroupsioMembersNeedingReviewServiceUnavailableResponseBody builds the
+// HTTP response body from the result of the
+// "list-groupsio-members-needing-review" endpoint of the "mailing-list"
+// service.
+func NewListGroupsioMembersNeedingReviewServiceUnavailableResponseBody(res *mailinglist.ServiceUnavailableError) *ListGroupsioMembersNeedingReviewServiceUnavailableResponseBody {
+	body := &ListGroupsioMembersNeedingReviewServiceUnavailableResponseBody{
+		Message: res.Message,
+	}
+	return body
+}
+
+// NewListGroupsioMembersModifiedSinceBadRequestResponseBody builds the HTTP
+// response body from the result of the "list-groupsio-members-modified-since"
+// endpoint of the "mailing-list" service.
+func NewListGroupsioMembersModifiedSinceBadRequestResponseBody(res *mailinglist.BadRequestError) *ListGroupsioMembersModifiedSinceBadRequestResponseBody {
+	body := &ListGroupsioMembersModifiedSinceBadRequestResponseBody{
+		Message: res.Message,
+	}
+	return body
+}
+
+// NewListGroupsioMembersModifiedSinceInternalServerErrorResponseBody builds
+// the HTTP response body from the result of the
+// "list-groupsio-members-modified-since" endpoint of the "mailing-list"
+// service.
+func NewListGroupsioMembersModifiedSinceInternalServerErrorResponseBody(res *mailinglist.InternalServerError) *ListGroupsioMembersModifiedSinceInternalServerErrorResponseBody {
+	body := &ListGroupsioMembersModifiedSinceInternalServerErrorResponseBody{
+		Message: res.Message,
+	}
+	return body
+}
+
+// NewListGroupsioMembersModifiedSinceNotFoundResponseBody builds the HTTP
+// response body from the result of the "list-groupsio-members-modified-since"
+// endpoint of the "mailing-list" service.
+func NewListGroupsioMembersModifiedSinceNotFoundResponseBody(res *mailinglist.NotFoundError) *ListGroupsioMembersModifiedSinceNotFoundResponseBody {
+	body := &ListGroupsioMembersModifiedSinceNotFoundResponseBody{
+		Message: res.Message,
+	}
+	return body
+}
+
+// NewListGroupsioMembersModifiedSinceServiceUnavailableResponseBody builds the
+// HTTP response body from the result of the
+// "list-groupsio-members-modified-since" endpoint of the "mailing-list"
+// service.
+func NewListGroupsioMembersModifiedSinceServiceUnavailableResponseBody(res *mailinglist.ServiceUnavailableError) *ListGroupsioMembersModifiedSinceServiceUnavailableResponseBody {
+	body := &ListGroupsioMembersModifiedSinceServiceUnavailableResponseBody{
+		Message: res.Message,
+	}
+	return body
+}
+
+// NewListGroupsioMembersByOrganizationBadRequestResponseBody builds the HTTP
+// response body from the result of the "list-groupsio-members-by-organization"
+// endpoint of the "mailing-list" service.
+func NewListGroupsioMembersByOrganizationBadRequestResponseBody(res *mailinglist.BadRequestError) *ListGroupsioMembersByOrganizationBadRequestResponseBody {
+	body := &ListGroupsioMembersByOrganizationBadRequestResponseBody{
 		Message: res.Message,
 	}
 	return body
 }
 
-// NewListGroupsioServicesInternalServerErrorResponseBody builds the HTTP
-// response body from the result of the "list-groupsio-services" endpoint of
-// the "mailing-list" service.
-func NewListGroupsioServicesInternalServerErrorResponseBody(res *mailinglist.InternalServerError) *ListGroupsioServicesInternalServerErrorResponseBody {
-	body := &ListGroupsioServicesInternalServerErrorResponseBody{
+// NewListGroupsioMembersByOrganizationInternalServerErrorResponseBody builds
+// the HTTP response body from the result of the
+// "list-groupsio-members-by-organization" endpoint of the "mailing-list"
+// service.
+func NewListGroupsioMembersByOrganizationInternalServerErrorResponseBody(res *mailinglist.InternalServerError) *ListGroupsioMembersByOrganizationInternalServerErrorResponseBody {
+	body := &ListGroupsioMembersByOrganizationInternalServerErrorResponseBody{
 		Message: res.Message,
 	}
 	return body
 }
 
-// NewListGroupsioServicesServiceUnavailableResponseBody builds the HTTP
-// response body from the result of the "list-groupsio-services" endpoint of
-// the "mailing-list" service.
-func NewListGroupsioServicesServiceUnavailableResponseBody(res *mailinglist.ServiceUnavailableError) *ListGroupsioServicesServiceUnavailableResponseBody {
-	body := &ListGroupsioServicesServiceUnavailableResponseBody{
+// NewListGroupsioMembersByOrganizationNotFoundResponseBody builds the HTTP
+// response body from the result of the "list-groupsio-members-by-organization"
+// endpoint of the "mailing-list" service.
+func NewListGroupsioMembersByOrganizationNotFoundResponseBody(res *mailinglist.NotFoundError) *ListGroupsioMembersByOrganizationNotFoundResponseBody {
+	body := &ListGroupsioMembersByOrganizationNotFoundResponseBody{
 		Message: res.Message,
 	}
 	return body
 }
 
-// NewCreateGroupsioServiceBadRequestResponseBody builds the HTTP response body
-// from the result of the "create-groupsio-service" endpoint of the
-// "mailing-list" service.
-func NewCreateGroupsioServiceBadRequestResponseBody(res *mailinglist.BadRequestError) *CreateGroupsioServiceBadRequestResponseBody {
-	body := &CreateGroupsioServiceBadRequestResponseBody{
+// NewListGroupsioMembersByOrganizationServiceUnavailableResponseBody builds
+// the HTTP response body from the result of the
+// "list-groupsio-members-by-organization" endpoint of the "mailing-list"
+// service.
+func NewListGroupsioMembersByOrganizationServiceUnavailableResponseBody(res *mailinglist.ServiceUnavailableError) *ListGroupsioMembersByOrganizationServiceUnavailableResponseBody {
+	body := &ListGroupsioMembersByOrganizationServiceUnavailableResponseBody{
 		Message: res.Message,
 	}
 	return body
 }
 
-// NewCreateGroupsioServiceConflictResponseBody builds the HTTP response body
-// from the result of the "create-groupsio-service" endpoint of the
-// "mailing-list" service.
-func NewCreateGroupsioServiceConflictResponseBody(res *mailinglist.ConflictError) *CreateGroupsioServiceConflictResponseBody {
-	body := &CreateGroupsioServiceConflictResponseBody{
+// NewListGroupsioDuplicateMembersInternalServerErrorResponseBody builds the
+// HTTP response body from the result of the "list-groupsio-duplicate-members"
+// endpoint of the "mailing-list" service.
+func NewListGroupsioDuplicateMembersInternalServerErrorResponseBody(res *mailinglist.InternalServerError) *ListGroupsioDuplicateMembersInternalServerErrorResponseBody {
+	body := &ListGroupsioDuplicateMembersInternalServerErrorResponseBody{
 		Message: res.Message,
 	}
 	return body
 }
 
-// NewCreateGroupsioServiceInternalServerErrorResponseBody builds the HTTP
-// response body from the result of the "create-groupsio-service" endpoint of
+// NewListGroupsioDuplicateMembersNotFoundResponseBody builds the HTTP response
+// body from the result of the "list-groupsio-duplicate-members" endpoint of
 // the "mailing-list" service.
-func NewCreateGroupsioServiceInternalServerErrorResponseBody(res *mailinglist.InternalServerError) *CreateGroupsioServiceInternalServerErrorResponseBody {
-	body := &CreateGroupsioServiceInternalServerErrorResponseBody{
+func NewListGroupsioDuplicateMembersNotFoundResponseBody(res *mailinglist.NotFoundError) *ListGroupsioDuplicateMembersNotFoundResponseBody {
+	body := &ListGroupsioDuplicateMembersNotFoundResponseBody{
 		Message: res.Message,
 	}
 	return body
 }
 
-// NewCreateGroupsioServiceServiceUnavailableResponseBody builds the HTTP
-// response body from the result of the "create-groupsio-service" endpoint of
-// the "mailing-list" service.
-func NewCreateGroupsioServiceServiceUnavailableResponseBody(res *mailinglist.ServiceUnavailableError) *CreateGroupsioServiceServiceUnavailableResponseBody {
-	body := &CreateGroupsioServiceServiceUnavailableResponseBody{
+// NewListGroupsioDuplicateMembersServiceUnavailableResponseBody builds the
+// HTTP response body from the result of the "list-groupsio-duplicate-members"
+// endpoint of the "mailing-list" service.
+func NewListGroupsioDuplicateMembersServiceUnavailableResponseBody(res *mailinglist.ServiceUnavailableError) *ListGroupsioDuplicateMembersServiceUnavailableResponseBody {
+	body := &ListGroupsioDuplicateMembersServiceUnavailableResponseBody{
 		Message: res.Message,
 	}
 	return body
 }
 
-// NewGetGroupsioServiceInternalServerErrorResponseBody builds the HTTP
-// response body from the result of the "get-groupsio-service" endpoint of the
-// "mailing-list" service.
-func NewGetGroupsioServiceInternalServerErrorResponseBody(res *mailinglist.InternalServerError) *GetGroupsioServiceInternalServerErrorResponseBody {
-	body := &GetGroupsioServiceInternalServerErrorResponseBody{
+// NewPreviewGroupsioDeliveryModeChangeBadRequestResponseBody builds the HTTP
+// response body from the result of the "preview-groupsio-delivery-mode-change"
+// endpoint of the "mailing-list" service.
+func NewPreviewGroupsioDeliveryModeChangeBadRequestResponseBody(res *mailinglist.BadRequestError) *PreviewGroupsioDeliveryModeChangeBadRequestResponseBody {
+	body := &PreviewGroupsioDeliveryModeChangeBadRequestResponseBody{
 		Message: res.Message,
 	}
 	return body
 }
 
-// NewGetGroupsioServiceNotFoundResponseBody builds the HTTP response body from
-// the result of the "get-groupsio-service" endpoint of the "mailing-list"
+// NewPreviewGroupsioDeliveryModeChangeInternalServerErrorResponseBody builds
+// the HTTP response body from the result of the
+// "preview-groupsio-delivery-mode-change" endpoint of the "mailing-list"
 // service.
-func NewGetGroupsioServiceNotFoundResponseBody(res *mailinglist.NotFoundError) *GetGroupsioServiceNotFoundResponseBody {
-	body := &GetGroupsioServiceNotFoundResponseBody{
+func NewPreviewGroupsioDeliveryModeChangeInternalServerErrorResponseBody(res *mailinglist.InternalServerError) *PreviewGroupsioDeliveryModeChangeInternalServerErrorResponseBody {
+	body := &PreviewGroupsioDeliveryModeChangeInternalServerErrorResponseBody{
 		Message: res.Message,
 	}
 	return body
 }
 
-// NewGetGroupsioServiceServiceUnavailableResponseBody builds the HTTP response
-// body from the result of the "get-groupsio-service" endpoint of the
-// "mailing-list" service.
-func NewGetGroupsioServiceServiceUnavailableResponseBody(res *mailinglist.ServiceUnavailableError) *GetGroupsioServiceServiceUnavailableResponseBody {
-	body := &GetGroupsioServiceServiceUnavailableResponseBody{
+// NewPreviewGroupsioDeliveryModeChangeNotFoundResponseBody builds the HTTP
+// response body from the result of the "preview-groupsio-delivery-mode-change"
+// endpoint of the "mailing-list" service.
+func NewPreviewGroupsioDeliveryModeChangeNotFoundResponseBody(res *mailinglist.NotFoundError) *PreviewGroupsioDeliveryModeChangeNotFoundResponseBody {
+	body := &PreviewGroupsioDeliveryModeChangeNotFoundResponseBody{
 		Message: res.Message,
 	}
 	return body
 }
 
-// NewUpdateGroupsioServiceBadRequestResponseBody builds the HTTP response body
-// from the result of the "update-groupsio-service" endpoint of the
-// "mailing-list" service.
-func NewUpdateGroupsioServiceBadRequestResponseBody(res *mailinglist.BadRequestError) *UpdateGroupsioServiceBadRequestResponseBody {
-	body := &UpdateGroupsioServiceBadRequestResponseBody{
+// NewPreviewGroupsioDeliveryModeChangeServiceUnavailableResponseBody builds
+// the HTTP response body from the result of the
+// "preview-groupsio-delivery-mode-change" endpoint of the "mailing-list"
+// service.
+func NewPreviewGroupsioDeliveryModeChangeServiceUnavailableResponseBody(res *mailinglist.ServiceUnavailableError) *PreviewGroupsioDeliveryModeChangeServiceUnavailableResponseBody {
+	body := &PreviewGroupsioDeliveryModeChangeServiceUnavailableResponseBody{
 		Message: res.Message,
 	}
 	return body
 }
 
-// NewUpdateGroupsioServiceInternalServerErrorResponseBody builds the HTTP
-// response body from the result of the "update-groupsio-service" endpoint of
-// the "mailing-list" service.
-func NewUpdateGroupsioServiceInternalServerErrorResponseBody(res *mailinglist.InternalServerError) *UpdateGroupsioServiceInternalServerErrorResponseBody {
-	body := &UpdateGroupsioServiceInternalServerErrorResponseBody{
+// NewAddGroupsioMemberBadRequestResponseBody builds the HTTP response body
+// from the result of the "add-groupsio-member" endpoint of the "mailing-list"
+// service.
+func NewAddGroupsioMemberBadRequestResponseBody(res *mailinglist.BadRequestError) *AddGroupsioMemberBadRequestResponseBody {
+	body := &AddGroupsioMemberBadRequestResponseBody{
 		Message: res.Message,
 	}
 	return body
 }
 
-// NewUpdateGroupsioServiceNotFoundResponseBody builds the HTTP response body
-// from the result of the "update-groupsio-service" endpoint of the
-// "mailing-list" service.
-func NewUpdateGroupsioServiceNotFoundResponseBody(res *mailinglist.NotFoundError) *UpdateGroupsioServiceNotFoundResponseBody {
-	body := &UpdateGroupsioServiceNotFoundResponseBody{
+// NewAddGroupsioMemberConflictResponseBody builds the HTTP response body from
+// the result of the "add-groupsio-member" endpoint of the "mailing-list"
+// service.
+func NewAddGroupsioMemberConflictResponseBody(res *mailinglist.ConflictError) *AddGroupsioMemberConflictResponseBody {
+	body := &AddGroupsioMemberConflictResponseBody{
 		Message: res.Message,
 	}
 	return body
 }
 
-// NewUpdateGroupsioServiceServiceUnavailableResponseBody builds the HTTP
-// response body from the result of the "update-groupsio-service" endpoint of
-// the "mailing-list" service.
-func NewUpdateGroupsioServiceServiceUnavailableResponseBody(res *mailinglist.ServiceUnavailableError) *UpdateGroupsioServiceServiceUnavailableResponseBody {
-	body := &UpdateGroupsioServiceServiceUnavailableResponseBody{
+// NewAddGroupsioMemberInternalServerErrorResponseBody builds the HTTP response
+// body from the result of the "add-groupsio-member" endpoint of the
+// "mailing-list" service.
+func NewAddGroupsioMemberInternalServerErrorResponseBody(res *mailinglist.InternalServerError) *AddGroupsioMemberInternalServerErrorResponseBody {
+	body := &AddGroupsioMemberInternalServerErrorResponseBody{
 		Message: res.Message,
 	}
 	return body
 }
 
-// NewDeleteGroupsioServiceInternalServerErrorResponseBody builds the HTTP
-// response body from the result of the "delete-groupsio-service" endpoint of
-// the "mailing-list" service.
-func NewDeleteGroupsioServiceInternalServerErrorResponseBody(res *mailinglist.InternalServerError) *DeleteGroupsioServiceInternalServerErrorResponseBody {
-	body := &DeleteGroupsioServiceInternalServerErrorResponseBody{
+// NewAddGroupsioMemberNotFoundResponseBody builds the HTTP response body from
+// the result of the "add-groupsio-member" endpoint of the "mailing-list"
+// service.
+func NewAddGroupsioMemberNotFoundResponseBody(res *mailinglist.NotFoundError) *AddGroupsioMemberNotFoundResponseBody {
+	body := &AddGroupsioMemberNotFoundResponseBody{
 		Message: res.Message,
 	}
 	return body
 }
 
-// NewDeleteGroupsioServiceNotFoundResponseBody builds the HTTP response body
-// from the result of the "delete-groupsio-service" endpoint of the
+// NewAddGroupsioMemberServiceUnavailableResponseBody builds the HTTP response
+// body from the result of the "add-groupsio-member" endpoint of the
 // "mailing-list" service.
-func NewDeleteGroupsioServiceNotFoundResponseBody(res *mailinglist.NotFoundError) *DeleteGroupsioServiceNotFoundResponseBody {
-	body := &DeleteGroupsioServiceNotFoundResponseBody{
+func NewAddGroupsioMemberServiceUnavailableResponseBody(res *mailinglist.ServiceUnavailableError) *AddGroupsioMemberServiceUnavailableResponseBody {
+	body := &AddGroupsioMemberServiceUnavailableResponseBody{
 		Message: res.Message,
 	}
 	return body
 }
 
-// NewDeleteGroupsioServiceServiceUnavailableResponseBody builds the HTTP
-// response body from the result of the "delete-groupsio-service" endpoint of
-// the "mailing-list" service.
-func NewDeleteGroupsioServiceServiceUnavailableResponseBody(res *mailinglist.ServiceUnavailableError) *DeleteGroupsioServiceServiceUnavailableResponseBody {
-	body := &DeleteGroupsioServiceServiceUnavailableResponseBody{
+// NewUpsertGroupsioMemberBadRequestResponseBody builds the HTTP response body
+// from the result of the "upsert-groupsio-member" endpoint of the
+// "mailing-list" service.
+func NewUpsertGroupsioMemberBadRequestResponseBody(res *mailinglist.BadRequestError) *UpsertGroupsioMemberBadRequestResponseBody {
+	body := &UpsertGroupsioMemberBadRequestResponseBody{
 		Message: res.Message,
 	}
 	return body
 }
 
-// NewGetGroupsioServiceProjectsInternalServerErrorResponseBody builds the HTTP
-// response body from the result of the "get-groupsio-service-projects"
-// endpoint of the "mailing-list" service.
-func NewGetGroupsioServiceProjectsInternalServerErrorResponseBody(res *mailinglist.InternalServerError) *GetGroupsioServiceProjectsInternalServerErrorResponseBody {
-	body := &GetGroupsioServiceProjectsInternalServerErrorResponseBody{
+// NewUpsertGroupsioMemberConflictResponseBody builds the HTTP response body
+// from the result of the "upsert-groupsio-member" endpoint of the
+// "mailing-list" service.
+func NewUpsertGroupsioMemberConflictResponseBody(res *mailinglist.ConflictError) *UpsertGroupsioMemberConflictResponseBody {
+	body := &UpsertGroupsioMemberConflictResponseBody{
 		Message: res.Message,
 	}
 	return body
 }
 
-// NewGetGroupsioServiceProjectsServiceUnavailableResponseBody builds the HTTP
-// response body from the result of the "get-groupsio-service-projects"
-// endpoint of the "mailing-list" service.
-func NewGetGroupsioServiceProjectsServiceUnavailableResponseBody(res *mailinglist.ServiceUnavailableError) *GetGroupsioServiceProjectsServiceUnavailableResponseBody {
-	body := &GetGroupsioServiceProjectsServiceUnavailableResponseBody{
+// NewUpsertGroupsioMemberInternalServerErrorResponseBody builds the HTTP
+// response body from the result of the "upsert-groupsio-member" endpoint of
+// the "mailing-list" service.
+func NewUpsertGroupsioMemberInternalServerErrorResponseBody(res *mailinglist.InternalServerError) *UpsertGroupsioMemberInternalServerErrorResponseBody {
+	body := &UpsertGroupsioMemberInternalServerErrorResponseBody{
 		Message: res.Message,
 	}
 	return body
 }
 
-// NewFindParentGroupsioServiceBadRequestResponseBody builds the HTTP response
-// body from the result of the "find-parent-groupsio-service" endpoint of the
+// NewUpsertGroupsioMemberNotFoundResponseBody builds the HTTP response body
+// from the result of the "upsert-groupsio-member" endpoint of the
 // "mailing-list" service.
-func NewFindParentGroupsioServiceBadRequestResponseBody(res *mailinglist.BadRequestError) *FindParentGroupsioServiceBadRequestResponseBody {
-	body := &FindParentGroupsioServiceBadRequestResponseBody{
+func NewUpsertGroupsioMemberNotFoundResponseBody(res *mailinglist.NotFoundError) *UpsertGroupsioMemberNotFoundResponseBody {
+	body := &UpsertGroupsioMemberNotFoundResponseBody{
 		Message: res.Message,
 	}
 	return body
 }
 
-// NewFindParentGroupsioServiceInternalServerErrorResponseBody builds the HTTP
-// response body from the result of the "find-parent-groupsio-service" endpoint
-// of the "mailing-list" service.
-func NewFindParentGroupsioServiceInternalServerErrorResponseBody(res *mailinglist.InternalServerError) *FindParentGroupsioServiceInternalServerErrorResponseBody {
-	body := &FindParentGroupsioServiceInternalServerErrorResponseBody{
+// NewUpsertGroupsioMemberServiceUnavailableResponseBody builds the HTTP
+// response body from the result of the "upsert-groupsio-member" endpoint of
+// the "mailing-list" service.
+func NewUpsertGroupsioMemberServiceUnavailableResponseBody(res *mailinglist.ServiceUnavailableError) *UpsertGroupsioMemberServiceUnavailableResponseBody {
+	body := &UpsertGroupsioMemberServiceUnavailableResponseBody{
 		Message: res.Message,
 	}
 	return body
 }
 
-// NewFindParentGroupsioServiceNotFoundResponseBody builds the HTTP response
-// body from the result of the "find-parent-groupsio-service" endpoint of the
+// NewGetGroupsioMemberInternalServerErrorResponseBody builds the HTTP response
+// body from the result of the "get-groupsio-member" endpoint of the
 // "mailing-list" service.
-func NewFindParentGroupsioServiceNotFoundResponseBody(res *mailinglist.NotFoundError) *FindParentGroupsioServiceNotFoundResponseBody {
-	body := &FindParentGroupsioServiceNotFoundResponseBody{
+func NewGetGroupsioMemberInternalServerErrorResponseBody(res *mailinglist.InternalServerError) *GetGroupsioMemberInternalServerErrorResponseBody {
+	body := &GetGroupsioMemberInternalServerErrorResponseBody{
 		Message: res.Message,
 	}
 	return body
 }
 
-// NewFindParentGroupsioServiceServiceUnavailableResponseBody builds the HTTP
-// response body from the result of the "find-parent-groupsio-service" endpoint
-// of the "mailing-list" service.
-func NewFindParentGroupsioServiceServiceUnavailableResponseBody(res *mailinglist.ServiceUnavailableError) *FindParentGroupsioServiceServiceUnavailableResponseBody {
-	body := &FindParentGroupsioServiceServiceUnavailableResponseBody{
+// NewGetGroupsioMemberNotFoundResponseBody builds the HTTP response body from
+// the result of the "get-groupsio-member" endpoint of the "mailing-list"
+// service.
+func NewGetGroupsioMemberNotFoundResponseBody(res *mailinglist.NotFoundError) *GetGroupsioMemberNotFoundResponseBody {
+	body := &GetGroupsioMemberNotFoundResponseBody{
 		Message: res.Message,
 	}
 	return body
 }
 
-// NewListGroupsioMailingListsBadRequestResponseBody builds the HTTP response
-// body from the result of the "list-groupsio-mailing-lists" endpoint of the
+// NewGetGroupsioMemberServiceUnavailableResponseBody builds the HTTP response
+// body from the result of the "get-groupsio-member" endpoint of the
 // "mailing-list" service.
-func NewListGroupsioMailingListsBadRequestResponseBody(res *mailinglist.BadRequestError) *ListGroupsioMailingListsBadRequestResponseBody {
-	body := &ListGroupsioMailingListsBadRequestResponseBody{
+func NewGetGroupsioMemberServiceUnavailableResponseBody(res *mailinglist.ServiceUnavailableError) *GetGroupsioMemberServiceUnavailableResponseBody {
+	body := &GetGroupsioMemberServiceUnavailableResponseBody{
 		Message: res.Message,
 	}
 	return body
 }
 
-// NewListGroupsioMailingListsInternalServerErrorResponseBody builds the HTTP
-// response body from the result of the "list-groupsio-mailing-lists" endpoint
-// of the "mailing-list" service.
-func NewListGroupsioMailingListsInternalServerErrorResponseBody(res *mailinglist.InternalServerError) *ListGroupsioMailingListsInternalServerErrorResponseBody {
-	body := &ListGroupsioMailingListsInternalServerErrorResponseBody{
+// NewUpdateGroupsioMemberBadRequestResponseBody builds the HTTP response body
+// from the result of the "update-groupsio-member" endpoint of the
+// "mailing-list" service.
+func NewUpdateGroupsioMemberBadRequestResponseBody(res *mailinglist.BadRequestError) *UpdateGroupsioMemberBadRequestResponseBody {
+	body := &UpdateGroupsioMemberBadRequestResponseBody{
 		Message: res.Message,
 	}
 	return body
 }
 
-// NewListGroupsioMailingListsServiceUnavailableResponseBody builds the HTTP
-// response body from the result of the "list-groupsio-mailing-lists" endpoint
-// of the "mailing-list" service.
-func NewListGroupsioMailingListsServiceUnavailableResponseBody(res *mailinglist.ServiceUnavailableError) *ListGroupsioMailingListsServiceUnavailableResponseBody {
-	body := &ListGroupsioMailingListsServiceUnavailableResponseBody{
+// NewUpdateGroupsioMemberInternalServerErrorResponseBody builds the HTTP
+// response body from the result of the "update-groupsio-member" endpoint of
+// the "mailing-list" service.
+func NewUpdateGroupsioMemberInternalServerErrorResponseBody(res *mailinglist.InternalServerError) *UpdateGroupsioMemberInternalServerErrorResponseBody {
+	body := &UpdateGroupsioMemberInternalServerErrorResponseBody{
 		Message: res.Message,
 	}
 	return body
 }
 
-// NewCreateGroupsioMailingListBadRequestResponseBody builds the HTTP response
-// body from the result of the "create-groupsio-mailing-list" endpoint of the
+// NewUpdateGroupsioMemberNotFoundResponseBody builds the HTTP response body
+// from the result of the "update-groupsio-member" endpoint of the
 // "mailing-list" service.
-func NewCreateGroupsioMailingListBadRequestResponseBody(res *mailinglist.BadRequestError) *CreateGroupsioMailingListBadRequestResponseBody {
-	body := &CreateGroupsioMailingListBadRequestResponseBody{
+func NewUpdateGroupsioMemberNotFoundResponseBody(res *mailinglist.NotFoundError) *UpdateGroupsioMemberNotFoundResponseBody {
+	body := &UpdateGroupsioMemberNotFoundResponseBody{
 		Message: res.Message,
 	}
 	return body
 }
 
-// NewCreateGroupsioMailingListConflictResponseBody builds the HTTP response
-// body from the result of the "create-groupsio-mailing-list" endpoint of the
-// "mailing-list" service.
-func NewCreateGroupsioMailingListConflictResponseBody(res *mailinglist.ConflictError) *CreateGroupsioMailingListConflictResponseBody {
-	body := &CreateGroupsioMailingListConflictResponseBody{
+// NewUpdateGroupsioMemberServiceUnavailableResponseBody builds the HTTP
+// response body from the result of the "update-groupsio-member" endpoint of
+// the "mailing-list" service.
+func NewUpdateGroupsioMemberServiceUnavailableResponseBody(res *mailinglist.ServiceUnavailableError) *UpdateGroupsioMemberServiceUnavailableResponseBody {
+	body := &UpdateGroupsioMemberServiceUnavailableResponseBody{
 		Message: res.Message,
 	}
 	return body
 }
 
-// NewCreateGroupsioMailingListInternalServerErrorResponseBody builds the HTTP
-// response body from the result of the "create-groupsio-mailing-list" endpoint
-// of the "mailing-list" service.
-func NewCreateGroupsioMailingListInternalServerErrorResponseBody(res *mailinglist.InternalServerError) *CreateGroupsioMailingListInternalServerErrorResponseBody {
-	body := &CreateGroupsioMailingListInternalServerErrorResponseBody{
+// NewDeleteGroupsioMemberInternalServerErrorResponseBody builds the HTTP
+// response body from the result of the "delete-groupsio-member" endpoint of
+// the "mailing-list" service.
+func NewDeleteGroupsioMemberInternalServerErrorResponseBody(res *mailinglist.InternalServerError) *DeleteGroupsioMemberInternalServerErrorResponseBody {
+	body := &DeleteGroupsioMemberInternalServerErrorResponseBody{
 		Message: res.Message,
 	}
 	return body
 }
 
-// NewCreateGroupsioMailingListServiceUnavailableResponseBody builds the HTTP
-// response body from the result of the "create-groupsio-mailing-list" endpoint
-// of the "mailing-list" service.
-func NewCreateGroupsioMailingListServiceUnavailableResponseBody(res *mailinglist.ServiceUnavailableError) *CreateGroupsioMailingListServiceUnavailableResponseBody {
-	body := &CreateGroupsioMailingListServiceUnavailableResponseBody{
+// NewDeleteGroupsioMemberNotFoundResponseBody builds the HTTP response body
+// from the result of the "delete-groupsio-member" endpoint of the
+// "mailing-list" service.
+func NewDeleteGroupsioMemberNotFoundResponseBody(res *mailinglist.NotFoundError) *DeleteGroupsioMemberNotFoundResponseBody {
+	body := &DeleteGroupsioMemberNotFoundResponseBody{
 		Message: res.Message,
 	}
 	return body
 }
 
-// NewGetGroupsioMailingListInternalServerErrorResponseBody builds the HTTP
-// response body from the result of the "get-groupsio-mailing-list" endpoint of
+// NewDeleteGroupsioMemberServiceUnavailableResponseBody builds the HTTP
+// response body from the result of the "delete-groupsio-member" endpoint of
 // the "mailing-list" service.
-func NewGetGroupsioMailingListInternalServerErrorResponseBody(res *mailinglist.InternalServerError) *GetGroupsioMailingListInternalServerErrorResponseBody {
-	body := &GetGroupsioMailingListInternalServerErrorResponseBody{
+func NewDeleteGroupsioMemberServiceUnavailableResponseBody(res *mailinglist.ServiceUnavailableError) *DeleteGroupsioMemberServiceUnavailableResponseBody {
+	body := &DeleteGroupsioMemberServiceUnavailableResponseBody{
 		Message: res.Message,
 	}
 	return body
 }
 
-// NewGetGroupsioMailingListNotFoundResponseBody builds the HTTP response body
-// from the result of the "get-groupsio-mailing-list" endpoint of the
+// NewInviteGroupsioMembersBadRequestResponseBody builds the HTTP response body
+// from the result of the "invite-groupsio-members" endpoint of the
 // "mailing-list" service.
-func NewGetGroupsioMailingListNotFoundResponseBody(res *mailinglist.NotFoundError) *GetGroupsioMailingListNotFoundResponseBody {
-	body := &GetGroupsioMailingListNotFoundResponseBody{
+func NewInviteGroupsioMembersBadRequestResponseBody(res *mailinglist.BadRequestError) *InviteGroupsioMembersBadRequestResponseBody {
+	body := &InviteGroupsioMembersBadRequestResponseBody{
+		Message: res.Message,
+	}
+	return body
+}
+
+// NewInviteGroupsioMembersInternalServerErrorResponseBody builds the HTTP
+// response body from the result of the "invite-groupsio-members" endpoint of
+// the "mailing-list" service.
+func NewInviteGroupsioMembersInternalServerErrorResponseBody(res *mailinglist.InternalServerError) *InviteGroupsioMembersInternalServerErrorResponseBody {
+	body := &InviteGroupsioMembersInternalServerErrorResponseBody{
 		Message: res.Message,
 	}
 	return body
 }
 
-// NewGetGroupsioMailingListServiceUnavailableResponseBody builds the HTTP
-// response body from the result of the "get-groupsio-mailing-list" endpoint of
-// the "mailing-list" service.
-func NewGetGroupsioMailingListServiceUnavailableResponseBody(res *mailinglist.ServiceUnavailableError) *GetGroupsioMailingListServiceUnavailableResponseBody {
-	body := &GetGroupsioMailingListServiceUnavailableResponseBody{
+// NewInviteGroupsioMembersNotFoundResponseBody builds the HTTP response body
+// from the result of the "invite-groupsio-members" endpoint of the
+// "mailing-list" service.
+func NewInviteGroupsioMembersNotFoundResponseBody(res *mailinglist.NotFoundError) *InviteGroupsioMembersNotFoundResponseBody {
+	body := &InviteGroupsioMembersNotFoundResponseBody{
 		Message: res.Message,
 	}
 	return body
 }
 
-// NewUpdateGroupsioMailingListBadRequestResponseBody builds the HTTP response
-// body from the result of the "update-groupsio-mailing-list" endpoint of the
-// "mailing-list" service.
-func NewUpdateGroupsioMailingListBadRequestResponseBody(res *mailinglist.BadRequestError) *UpdateGroupsioMailingListBadRequestResponseBody {
-	body := &UpdateGroupsioMailingListBadRequestResponseBody{
+// NewInviteGroupsioMembersServiceUnavailableResponseBody builds the HTTP
+// response body from the result of the "invite-groupsio-members" endpoint of
+// the "mailing-list" service.
+func NewInviteGroupsioMembersServiceUnavailableResponseBody(res *mailinglist.ServiceUnavailableError) *InviteGroupsioMembersServiceUnavailableResponseBody {
+	body := &InviteGroupsioMembersServiceUnavailableResponseBody{
 		Message: res.Message,
 	}
 	return body
 }
 
-// NewUpdateGroupsioMailingListInternalServerErrorResponseBody builds the HTTP
-// response body from the result of the "update-groupsio-mailing-list" endpoint
-// of the "mailing-list" service.
-func NewUpdateGroupsioMailingListInternalServerErrorResponseBody(res *mailinglist.InternalServerError) *UpdateGroupsioMailingListInternalServerErrorResponseBody {
-	body := &UpdateGroupsioMailingListInternalServerErrorResponseBody{
+// NewImportGroupsioMembersBadRequestResponseBody builds the HTTP response body
+// from the result of the "import-groupsio-members" endpoint of the
+// "mailing-list" service.
+func NewImportGroupsioMembersBadRequestResponseBody(res *mailinglist.BadRequestError) *ImportGroupsioMembersBadRequestResponseBody {
+	body := &ImportGroupsioMembersBadRequestResponseBody{
 		Message: res.Message,
 	}
 	return body
 }
 
-// NewUpdateGroupsioMailingListNotFoundResponseBody builds the HTTP response
-// body from the result of the "update-groupsio-mailing-list" endpoint of the
+// NewImportGroupsioMembersConflictResponseBody builds the HTTP response body
+// from the result of the "import-groupsio-members" endpoint of the
 // "mailing-list" service.
-func NewUpdateGroupsioMailingListNotFoundResponseBody(res *mailinglist.NotFoundError) *UpdateGroupsioMailingListNotFoundResponseBody {
-	body := &UpdateGroupsioMailingListNotFoundResponseBody{
+func NewImportGroupsioMembersConflictResponseBody(res *mailinglist.ConflictError) *ImportGroupsioMembersConflictResponseBody {
+	body := &ImportGroupsioMembersConflictResponseBody{
 		Message: res.Message,
 	}
 	return body
 }
 
-// NewUpdateGroupsioMailingListServiceUnavailableResponseBody builds the HTTP
-// response body from the result of the "update-groupsio-mailing-list" endpoint
-// of the "mailing-list" service.
-func NewUpdateGroupsioMailingListServiceUnavailableResponseBody(res *mailinglist.ServiceUnavailableError) *UpdateGroupsioMailingListServiceUnavailableResponseBody {
-	body := &UpdateGroupsioMailingListServiceUnavailableResponseBody{
+// NewImportGroupsioMembersInternalServerErrorResponseBody builds the HTTP
+// response body from the result of the "import-groupsio-members" endpoint of
+// the "mailing-list" service.
+func NewImportGroupsioMembersInternalServerErrorResponseBody(res *mailinglist.InternalServerError) *ImportGroupsioMembersInternalServerErrorResponseBody {
+	body := &ImportGroupsioMembersInternalServerErrorResponseBody{
 		Message: res.Message,
 	}
 	return body
 }
 
-// NewDeleteGroupsioMailingListInternalServerErrorResponseBody builds the HTTP
-// response body from the result of the "delete-groupsio-mailing-list" endpoint
-// of the "mailing-list" service.
-func NewDeleteGroupsioMailingListInternalServerErrorResponseBody(res *mailinglist.InternalServerError) *DeleteGroupsioMailingListInternalServerErrorResponseBody {
-	body := &DeleteGroupsioMailingListInternalServerErrorResponseBody{
+// NewImportGroupsioMembersNotFoundResponseBody builds the HTTP response body
+// from the result of the "import-groupsio-members" endpoint of the
+// "mailing-list" service.
+func NewImportGroupsioMembersNotFoundResponseBody(res *mailinglist.NotFoundError) *ImportGroupsioMembersNotFoundResponseBody {
+	body := &ImportGroupsioMembersNotFoundResponseBody{
 		Message: res.Message,
 	}
 	return body
 }
 
-// NewDeleteGroupsioMailingListNotFoundResponseBody builds the HTTP response
-// body from the result of the "delete-groupsio-mailing-list" endpoint of the
-// "mailing-list" service.
-func NewDeleteGroupsioMailingListNotFoundResponseBody(res *mailinglist.NotFoundError) *DeleteGroupsioMailingListNotFoundResponseBody {
-	body := &DeleteGroupsioMailingListNotFoundResponseBody{
+// NewImportGroupsioMembersServiceUnavailableResponseBody builds the HTTP
+// response body from the result of the "import-groupsio-members" endpoint of
+// the "mailing-list" service.
+func NewImportGroupsioMembersServiceUnavailableResponseBody(res *mailinglist.ServiceUnavailableError) *ImportGroupsioMembersServiceUnavailableResponseBody {
+	body := &ImportGroupsioMembersServiceUnavailableResponseBody{
 		Message: res.Message,
 	}
 	return body
 }
 
-// NewDeleteGroupsioMailingListServiceUnavailableResponseBody builds the HTTP
-// response body from the result of the "delete-groupsio-mailing-list" endpoint
-// of the "mailing-list" service.
-func NewDeleteGroupsioMailingListServiceUnavailableResponseBody(res *mailinglist.ServiceUnavailableError) *DeleteGroupsioMailingListServiceUnavailableResponseBody {
-	body := &DeleteGroupsioMailingListServiceUnavailableResponseBody{
+// NewMergeGroupsioMembersBadRequestResponseBody builds the HTTP response body
+// from the result of the "merge-groupsio-members" endpoint of the
+// "mailing-list" service.
+func NewMergeGroupsioMembersBadRequestResponseBody(res *mailinglist.BadRequestError) *MergeGroupsioMembersBadRequestResponseBody {
+	body := &MergeGroupsioMembersBadRequestResponseBody{
 		Message: res.Message,
 	}
 	return body
 }
 
-// NewGetGroupsioMailingListCountBadRequestResponseBody builds the HTTP
-// response body from the result of the "get-groupsio-mailing-list-count"
-// endpoint of the "mailing-list" service.
-func NewGetGroupsioMailingListCountBadRequestResponseBody(res *mailinglist.BadRequestError) *GetGroupsioMailingListCountBadRequestResponseBody {
-	body := &GetGroupsioMailingListCountBadRequestResponseBody{
+// NewMergeGroupsioMembersInternalServerErrorResponseBody builds the HTTP
+// response body from the result of the "merge-groupsio-members" endpoint of
+// the "mailing-list" service.
+func NewMergeGroupsioMembersInternalServerErrorResponseBody(res *mailinglist.InternalServerError) *MergeGroupsioMembersInternalServerErrorResponseBody {
+	body := &MergeGroupsioMembersInternalServerErrorResponseBody{
 		Message: res.Message,
 	}
 	return body
 }
 
-// NewGetGroupsioMailingListCountInternalServerErrorResponseBody builds the
-// HTTP response body from the result of the "get-groupsio-mailing-list-count"
-// endpoint of the "mailing-list" service.
-func NewGetGroupsioMailingListCountInternalServerErrorResponseBody(res *mailinglist.InternalServerError) *GetGroupsioMailingListCountInternalServerErrorResponseBody {
-	body := &GetGroupsioMailingListCountInternalServerErrorResponseBody{
+// NewMergeGroupsioMembersNotFoundResponseBody builds the HTTP response body
+// from the result of the "merge-groupsio-members" endpoint of the
+// "mailing-list" service.
+func NewMergeGroupsioMembersNotFoundResponseBody(res *mailinglist.NotFoundError) *MergeGroupsioMembersNotFoundResponseBody {
+	body := &MergeGroupsioMembersNotFoundResponseBody{
 		Message: res.Message,
 	}
 	return body
 }
 
-// NewGetGroupsioMailingListCountServiceUnavailableResponseBody builds the HTTP
-// response body from the result of the "get-groupsio-mailing-list-count"
-// endpoint of the "mailing-list" service.
-func NewGetGroupsioMailingListCountServiceUnavailableResponseBody(res *mailinglist.ServiceUnavailableError) *GetGroupsioMailingListCountServiceUnavailableResponseBody {
-	body := &GetGroupsioMailingListCountServiceUnavailableResponseBody{
+// NewMergeGroupsioMembersServiceUnavailableResponseBody builds the HTTP
+// response body from the result of the "merge-groupsio-members" endpoint of
+// the "mailing-list" service.
+func NewMergeGroupsioMembersServiceUnavailableResponseBody(res *mailinglist.ServiceUnavailableError) *MergeGroupsioMembersServiceUnavailableResponseBody {
+	body := &MergeGroupsioMembersServiceUnavailableResponseBody{
 		Message: res.Message,
 	}
 	return body
 }
 
-// NewGetGroupsioMailingListMemberCountInternalServerErrorResponseBody builds
-// the HTTP response body from the result of the
-// "get-groupsio-mailing-list-member-count" endpoint of the "mailing-list"
-// service.
-func NewGetGroupsioMailingListMemberCountInternalServerErrorResponseBody(res *mailinglist.InternalServerError) *GetGroupsioMailingListMemberCountInternalServerErrorResponseBody {
-	body := &GetGroupsioMailingListMemberCountInternalServerErrorResponseBody{
+// NewChangeGroupsioMemberEmailBadRequestResponseBody builds the HTTP response
+// body from the result of the "change-groupsio-member-email" endpoint of the
+// "mailing-list" service.
+func NewChangeGroupsioMemberEmailBadRequestResponseBody(res *mailinglist.BadRequestError) *ChangeGroupsioMemberEmailBadRequestResponseBody {
+	body := &ChangeGroupsioMemberEmailBadRequestResponseBody{
 		Message: res.Message,
 	}
 	return body
 }
 
-// NewGetGroupsioMailingListMemberCountNotFoundResponseBody builds the HTTP
-// response body from the result of the
-// "get-groupsio-mailing-list-member-count" endpoint of the "mailing-list"
-// service.
-func NewGetGroupsioMailingListMemberCountNotFoundResponseBody(res *mailinglist.NotFoundError) *GetGroupsioMailingListMemberCountNotFoundResponseBody {
-	body := &GetGroupsioMailingListMemberCountNotFoundResponseBody{
+// NewChangeGroupsioMemberEmailConflictResponseBody builds the HTTP response
+// body from the result of the "change-groupsio-member-email" endpoint of the
+// "mailing-list" service.
+func NewChangeGroupsioMemberEmailConflictResponseBody(res *mailinglist.ConflictError) *ChangeGroupsioMemberEmailConflictResponseBody {
+	body := &ChangeGroupsioMemberEmailConflictResponseBody{
 		Message: res.Message,
 	}
 	return body
 }
 
-// NewGetGroupsioMailingListMemberCountServiceUnavailableResponseBody builds
-// the HTTP response body from the result of the
-// "get-groupsio-mailing-list-member-count" endpoint of the "mailing-list"
-// service.
-func NewGetGroupsioMailingListMemberCountServiceUnavailableResponseBody(res *mailinglist.ServiceUnavailableError) *GetGroupsioMailingListMemberCountServiceUnavailableResponseBody {
-	body := &GetGroupsioMailingListMemberCountServiceUnavailableResponseBody{
+// NewChangeGroupsioMemberEmailInternalServerErrorResponseBody builds the HTTP
+// response body from the result of the "change-groupsio-member-email" endpoint
+// of the "mailing-list" service.
+func NewChangeGroupsioMemberEmailInternalServerErrorResponseBody(res *mailinglist.InternalServerError) *ChangeGroupsioMemberEmailInternalServerErrorResponseBody {
+	body := &ChangeGroupsioMemberEmailInternalServerErrorResponseBody{
 		Message: res.Message,
 	}
 	return body
 }
 
-// NewListGroupsioMembersInternalServerErrorResponseBody builds the HTTP
-// response body from the result of the "list-groupsio-members" endpoint of the
+// NewChangeGroupsioMemberEmailNotFoundResponseBody builds the HTTP response
+// body from the result of the "change-groupsio-member-email" endpoint of the
 // "mailing-list" service.
-func NewListGroupsioMembersInternalServerErrorResponseBody(res *mailinglist.InternalServerError) *ListGroupsioMembersInternalServerErrorResponseBody {
-	body := &ListGroupsioMembersInternalServerErrorResponseBody{
+func NewChangeGroupsioMemberEmailNotFoundResponseBody(res *mailinglist.NotFoundError) *ChangeGroupsioMemberEmailNotFoundResponseBody {
+	body := &ChangeGroupsioMemberEmailNotFoundResponseBody{
 		Message: res.Message,
 	}
 	return body
 }
 
-// NewListGroupsioMembersNotFoundResponseBody builds the HTTP response body
-// from the result of the "list-groupsio-members" endpoint of the
-// "mailing-list" service.
-func NewListGroupsioMembersNotFoundResponseBody(res *mailinglist.NotFoundError) *ListGroupsioMembersNotFoundResponseBody {
-	body := &ListGroupsioMembersNotFoundResponseBody{
+// NewChangeGroupsioMemberEmailServiceUnavailableResponseBody builds the HTTP
+// response body from the result of the "change-groupsio-member-email" endpoint
+// of the "mailing-list" service.
+func NewChangeGroupsioMemberEmailServiceUnavailableResponseBody(res *mailinglist.ServiceUnavailableError) *ChangeGroupsioMemberEmailServiceUnavailableResponseBody {
+	body := &ChangeGroupsioMemberEmailServiceUnavailableResponseBody{
 		Message: res.Message,
 	}
 	return body
 }
 
-// NewListGroupsioMembersServiceUnavailableResponseBody builds the HTTP
-// response body from the result of the "list-groupsio-members" endpoint of the
+// NewCheckGroupsioSubscriberBadRequestResponseBody builds the HTTP response
+// body from the result of the "check-groupsio-subscriber" endpoint of the
 // "mailing-list" service.
-func NewListGroupsioMembersServiceUnavailableResponseBody(res *mailinglist.ServiceUnavailableError) *ListGroupsioMembersServiceUnavailableResponseBody {
-	body := &ListGroupsioMembersServiceUnavailableResponseBody{
+func NewCheckGroupsioSubscriberBadRequestResponseBody(res *mailinglist.BadRequestError) *CheckGroupsioSubscriberBadRequestResponseBody {
+	body := &CheckGroupsioSubscriberBadRequestResponseBody{
 		Message: res.Message,
 	}
 	return body
 }
 
-// NewAddGroupsioMemberBadRequestResponseBody builds the HTTP response body
-// from the result of the "add-groupsio-member" endpoint of the "mailing-list"
-// service.
-func NewAddGroupsioMemberBadRequestResponseBody(res *mailinglist.BadRequestError) *AddGroupsioMemberBadRequestResponseBody {
-	body := &AddGroupsioMemberBadRequestResponseBody{
+// NewCheckGroupsioSubscriberInternalServerErrorResponseBody builds the HTTP
+// response body from the result of the "check-groupsio-subscriber" endpoint of
+// the "mailing-list" service.
+func NewCheckGroupsioSubscriberInternalServerErrorResponseBody(res *mailinglist.InternalServerError) *CheckGroupsioSubscriberInternalServerErrorResponseBody {
+	body := &CheckGroupsioSubscriberInternalServerErrorResponseBody{
 		Message: res.Message,
 	}
 	return body
 }
 
-// NewAddGroupsioMemberConflictResponseBody builds the HTTP response body from
-// the result of the "add-groupsio-member" endpoint of the "mailing-list"
-// service.
-func NewAddGroupsioMemberConflictResponseBody(res *mailinglist.ConflictError) *AddGroupsioMemberConflictResponseBody {
-	body := &AddGroupsioMemberConflictResponseBody{
+// NewCheckGroupsioSubscriberServiceUnavailableResponseBody builds the HTTP
+// response body from the result of the "check-groupsio-subscriber" endpoint of
+// the "mailing-list" service.
+func NewCheckGroupsioSubscriberServiceUnavailableResponseBody(res *mailinglist.ServiceUnavailableError) *CheckGroupsioSubscriberServiceUnavailableResponseBody {
+	body := &CheckGroupsioSubscriberServiceUnavailableResponseBody{
 		Message: res.Message,
 	}
 	return body
 }
 
-// NewAddGroupsioMemberInternalServerErrorResponseBody builds the HTTP response
-// body from the result of the "add-groupsio-member" endpoint of the
+// NewGetGroupsioArtifactInternalServerErrorResponseBody builds the HTTP
+// response body from the result of the "get-groupsio-artifact" endpoint of the
 // "mailing-list" service.
-func NewAddGroupsioMemberInternalServerErrorResponseBody(res *mailinglist.InternalServerError) *AddGroupsioMemberInternalServerErrorResponseBody {
-	body := &AddGroupsioMemberInternalServerErrorResponseBody{
+func NewGetGroupsioArtifactInternalServerErrorResponseBody(res *mailinglist.InternalServerError) *GetGroupsioArtifactInternalServerErrorResponseBody {
+	body := &GetGroupsioArtifactInternalServerErrorResponseBody{
 		Message: res.Message,
 	}
 	return body
 }
 
-// NewAddGroupsioMemberNotFoundResponseBody builds the HTTP response body from
-// the result of the "add-groupsio-member" endpoint of the "mailing-list"
-// service.
-func NewAddGroupsioMemberNotFoundResponseBody(res *mailinglist.NotFoundError) *AddGroupsioMemberNotFoundResponseBody {
-	body := &AddGroupsioMemberNotFoundResponseBody{
+// NewGetGroupsioArtifactNotFoundResponseBody builds the HTTP response body
+// from the result of the "get-groupsio-artifact" endpoint of the
+// "mailing-list" service.
+func NewGetGroupsioArtifactNotFoundResponseBody(res *mailinglist.NotFoundError) *GetGroupsioArtifactNotFoundResponseBody {
+	body := &GetGroupsioArtifactNotFoundResponseBody{
 		Message: res.Message,
 	}
 	return body
 }
 
-// NewAddGroupsioMemberServiceUnavailableResponseBody builds the HTTP response
-// body from the result of the "add-groupsio-member" endpoint of the
+// NewGetGroupsioArtifactServiceUnavailableResponseBody builds the HTTP
+// response body from the result of the "get-groupsio-artifact" endpoint of the
 // "mailing-list" service.
-func NewAddGroupsioMemberServiceUnavailableResponseBody(res *mailinglist.ServiceUnavailableError) *AddGroupsioMemberServiceUnavailableResponseBody {
-	body := &AddGroupsioMemberServiceUnavailableResponseBody{
+func NewGetGroupsioArtifactServiceUnavailableResponseBody(res *mailinglist.ServiceUnavailableError) *GetGroupsioArtifactServiceUnavailableResponseBody {
+	body := &GetGroupsioArtifactServiceUnavailableResponseBody{
 		Message: res.Message,
 	}
 	return body
 }
 
-// NewGetGroupsioMemberInternalServerErrorResponseBody builds the HTTP response
-// body from the result of the "get-groupsio-member" endpoint of the
-// "mailing-list" service.
-func NewGetGroupsioMemberInternalServerErrorResponseBody(res *mailinglist.InternalServerError) *GetGroupsioMemberInternalServerErrorResponseBody {
-	body := &GetGroupsioMemberInternalServerErrorResponseBody{
+// NewGetGroupsioArtifactDownloadInternalServerErrorResponseBody builds the
+// HTTP response body from the result of the "get-groupsio-artifact-download"
+// endpoint of the "mailing-list" service.
+func NewGetGroupsioArtifactDownloadInternalServerErrorResponseBody(res *mailinglist.InternalServerError) *GetGroupsioArtifactDownloadInternalServerErrorResponseBody {
+	body := &GetGroupsioArtifactDownloadInternalServerErrorResponseBody{
 		Message: res.Message,
 	}
 	return body
 }
 
-// NewGetGroupsioMemberNotFoundResponseBody builds the HTTP response body from
-// the result of the "get-groupsio-member" endpoint of the "mailing-list"
-// service.
-func NewGetGroupsioMemberNotFoundResponseBody(res *mailinglist.NotFoundError) *GetGroupsioMemberNotFoundResponseBody {
-	body := &GetGroupsioMemberNotFoundResponseBody{
+// NewGetGroupsioArtifactDownloadNotFoundResponseBody builds the HTTP response
+// body from the result of the "get-groupsio-artifact-download" endpoint of the
+// "mailing-list" service.
+func NewGetGroupsioArtifactDownloadNotFoundResponseBody(res *mailinglist.NotFoundError) *GetGroupsioArtifactDownloadNotFoundResponseBody {
+	body := &GetGroupsioArtifactDownloadNotFoundResponseBody{
 		Message: res.Message,
 	}
 	return body
 }
 
-// NewGetGroupsioMemberServiceUnavailableResponseBody builds the HTTP response
-// body from the result of the "get-groupsio-member" endpoint of the
-// "mailing-list" service.
-func NewGetGroupsioMemberServiceUnavailableResponseBody(res *mailinglist.ServiceUnavailableError) *GetGroupsioMemberServiceUnavailableResponseBody {
-	body := &GetGroupsioMemberServiceUnavailableResponseBody{
+// NewGetGroupsioArtifactDownloadServiceUnavailableResponseBody builds the HTTP
+// response body from the result of the "get-groupsio-artifact-download"
+// endpoint of the "mailing-list" service.
+func NewGetGroupsioArtifactDownloadServiceUnavailableResponseBody(res *mailinglist.ServiceUnavailableError) *GetGroupsioArtifactDownloadServiceUnavailableResponseBody {
+	body := &GetGroupsioArtifactDownloadServiceUnavailableResponseBody{
 		Message: res.Message,
 	}
 	return body
 }
 
-// NewUpdateGroupsioMemberBadRequestResponseBody builds the HTTP response body
-// from the result of the "update-groupsio-member" endpoint of the
+// NewGetGroupsioProjectSummaryBadRequestResponseBody builds the HTTP response
+// body from the result of the "get-groupsio-project-summary" endpoint of the
 // "mailing-list" service.
-func NewUpdateGroupsioMemberBadRequestResponseBody(res *mailinglist.BadRequestError) *UpdateGroupsioMemberBadRequestResponseBody {
-	body := &UpdateGroupsioMemberBadRequestResponseBody{
+func NewGetGroupsioProjectSummaryBadRequestResponseBody(res *mailinglist.BadRequestError) *GetGroupsioProjectSummaryBadRequestResponseBody {
+	body := &GetGroupsioProjectSummaryBadRequestResponseBody{
 		Message: res.Message,
 	}
 	return body
 }
 
-// NewUpdateGroupsioMemberInternalServerErrorResponseBody builds the HTTP
-// response body from the result of the "update-groupsio-member" endpoint of
-// the "mailing-list" service.
-func NewUpdateGroupsioMemberInternalServerErrorResponseBody(res *mailinglist.InternalServerError) *UpdateGroupsioMemberInternalServerErrorResponseBody {
-	body := &UpdateGroupsioMemberInternalServerErrorResponseBody{
+// NewGetGroupsioProjectSummaryInternalServerErrorResponseBody builds the HTTP
+// response body from the result of the "get-groupsio-project-summary" endpoint
+// of the "mailing-list" service.
+func NewGetGroupsioProjectSummaryInternalServerErrorResponseBody(res *mailinglist.InternalServerError) *GetGroupsioProjectSummaryInternalServerErrorResponseBody {
+	body := &GetGroupsioProjectSummaryInternalServerErrorResponseBody{
 		Message: res.Message,
 	}
 	return body
 }
 
-// NewUpdateGroupsioMemberNotFoundResponseBody builds the HTTP response body
-// from the result of the "update-groupsio-member" endpoint of the
-// "mailing-list" service.
-func NewUpdateGroupsioMemberNotFoundResponseBody(res *mailinglist.NotFoundError) *UpdateGroupsioMemberNotFoundResponseBody {
-	body := &UpdateGroupsioMemberNotFoundResponseBody{
+// NewGetGroupsioProjectSummaryServiceUnavailableResponseBody builds the HTTP
+// response body from the result of the "get-groupsio-project-summary" endpoint
+// of the "mailing-list" service.
+func NewGetGroupsioProjectSummaryServiceUnavailableResponseBody(res *mailinglist.ServiceUnavailableError) *GetGroupsioProjectSummaryServiceUnavailableResponseBody {
+	body := &GetGroupsioProjectSummaryServiceUnavailableResponseBody{
 		Message: res.Message,
 	}
 	return body
 }
 
-// NewUpdateGroupsioMemberServiceUnavailableResponseBody builds the HTTP
-// response body from the result of the "update-groupsio-member" endpoint of
-// the "mailing-list" service.
-func NewUpdateGroupsioMemberServiceUnavailableResponseBody(res *mailinglist.ServiceUnavailableError) *UpdateGroupsioMemberServiceUnavailableResponseBody {
-	body := &UpdateGroupsioMemberServiceUnavailableResponseBody{
+// NewListGroupsioOrphanedMailingListsBadRequestResponseBody builds the HTTP
+// response body from the result of the "list-groupsio-orphaned-mailing-lists"
+// endpoint of the "mailing-list" service.
+func NewListGroupsioOrphanedMailingListsBadRequestResponseBody(res *mailinglist.BadRequestError) *ListGroupsioOrphanedMailingListsBadRequestResponseBody {
+	body := &ListGroupsioOrphanedMailingListsBadRequestResponseBody{
 		Message: res.Message,
 	}
 	return body
 }
 
-// NewDeleteGroupsioMemberInternalServerErrorResponseBody builds the HTTP
-// response body from the result of the "delete-groupsio-member" endpoint of
-// the "mailing-list" service.
-func NewDeleteGroupsioMemberInternalServerErrorResponseBody(res *mailinglist.InternalServerError) *DeleteGroupsioMemberInternalServerErrorResponseBody {
-	body := &DeleteGroupsioMemberInternalServerErrorResponseBody{
+// NewListGroupsioOrphanedMailingListsInternalServerErrorResponseBody builds
+// the HTTP response body from the result of the
+// "list-groupsio-orphaned-mailing-lists" endpoint of the "mailing-list"
+// service.
+func NewListGroupsioOrphanedMailingListsInternalServerErrorResponseBody(res *mailinglist.InternalServerError) *ListGroupsioOrphanedMailingListsInternalServerErrorResponseBody {
+	body := &ListGroupsioOrphanedMailingListsInternalServerErrorResponseBody{
 		Message: res.Message,
 	}
 	return body
 }
 
-// NewDeleteGroupsioMemberNotFoundResponseBody builds the HTTP response body
-// from the result of the "delete-groupsio-member" endpoint of the
-// "mailing-list" service.
-func NewDeleteGroupsioMemberNotFoundResponseBody(res *mailinglist.NotFoundError) *DeleteGroupsioMemberNotFoundResponseBody {
-	body := &DeleteGroupsioMemberNotFoundResponseBody{
+// NewListGroupsioOrphanedMailingListsServiceUnavailableResponseBody builds the
+// HTTP response body from the result of the
+// "list-groupsio-orphaned-mailing-lists" endpoint of the "mailing-list"
+// service.
+func NewListGroupsioOrphanedMailingListsServiceUnavailableResponseBody(res *mailinglist.ServiceUnavailableError) *ListGroupsioOrphanedMailingListsServiceUnavailableResponseBody {
+	body := &ListGroupsioOrphanedMailingListsServiceUnavailableResponseBody{
 		Message: res.Message,
 	}
 	return body
 }
 
-// NewDeleteGroupsioMemberServiceUnavailableResponseBody builds the HTTP
-// response body from the result of the "delete-groupsio-member" endpoint of
-// the "mailing-list" service.
-func NewDeleteGroupsioMemberServiceUnavailableResponseBody(res *mailinglist.ServiceUnavailableError) *DeleteGroupsioMemberServiceUnavailableResponseBody {
-	body := &DeleteGroupsioMemberServiceUnavailableResponseBody{
+// NewRepublishGroupsioProjectIndexBadRequestResponseBody builds the HTTP
+// response body from the result of the "republish-groupsio-project-index"
+// endpoint of the "mailing-list" service.
+func NewRepublishGroupsioProjectIndexBadRequestResponseBody(res *mailinglist.BadRequestError) *RepublishGroupsioProjectIndexBadRequestResponseBody {
+	body := &RepublishGroupsioProjectIndexBadRequestResponseBody{
 		Message: res.Message,
 	}
 	return body
 }
 
-// NewInviteGroupsioMembersBadRequestResponseBody builds the HTTP response body
-// from the result of the "invite-groupsio-members" endpoint of the
-// "mailing-list" service.
-func NewInviteGroupsioMembersBadRequestResponseBody(res *mailinglist.BadRequestError) *InviteGroupsioMembersBadRequestResponseBody {
-	body := &InviteGroupsioMembersBadRequestResponseBody{
+// NewRepublishGroupsioProjectIndexInternalServerErrorResponseBody builds the
+// HTTP response body from the result of the "republish-groupsio-project-index"
+// endpoint of the "mailing-list" service.
+func NewRepublishGroupsioProjectIndexInternalServerErrorResponseBody(res *mailinglist.InternalServerError) *RepublishGroupsioProjectIndexInternalServerErrorResponseBody {
+	body := &RepublishGroupsioProjectIndexInternalServerErrorResponseBody{
 		Message: res.Message,
 	}
 	return body
 }
 
-// NewInviteGroupsioMembersInternalServerErrorResponseBody builds the HTTP
-// response body from the result of the "invite-groupsio-members" endpoint of
-// the "mailing-list" service.
-func NewInviteGroupsioMembersInternalServerErrorResponseBody(res *mailinglist.InternalServerError) *InviteGroupsioMembersInternalServerErrorResponseBody {
-	body := &InviteGroupsioMembersInternalServerErrorResponseBody{
+// NewRepublishGroupsioProjectIndexServiceUnavailableResponseBody builds the
+// HTTP response body from the result of the "republish-groupsio-project-index"
+// endpoint of the "mailing-list" service.
+func NewRepublishGroupsioProjectIndexServiceUnavailableResponseBody(res *mailinglist.ServiceUnavailableError) *RepublishGroupsioProjectIndexServiceUnavailableResponseBody {
+	body := &RepublishGroupsioProjectIndexServiceUnavailableResponseBody{
 		Message: res.Message,
 	}
 	return body
 }
 
-// NewInviteGroupsioMembersNotFoundResponseBody builds the HTTP response body
-// from the result of the "invite-groupsio-members" endpoint of the
-// "mailing-list" service.
-func NewInviteGroupsioMembersNotFoundResponseBody(res *mailinglist.NotFoundError) *InviteGroupsioMembersNotFoundResponseBody {
-	body := &InviteGroupsioMembersNotFoundResponseBody{
+// NewGetGroupsioMailingListStatsInternalServerErrorResponseBody builds the
+// HTTP response body from the result of the "get-groupsio-mailing-list-stats"
+// endpoint of the "mailing-list" service.
+func NewGetGroupsioMailingListStatsInternalServerErrorResponseBody(res *mailinglist.InternalServerError) *GetGroupsioMailingListStatsInternalServerErrorResponseBody {
+	body := &GetGroupsioMailingListStatsInternalServerErrorResponseBody{
 		Message: res.Message,
 	}
 	return body
 }
 
-// NewInviteGroupsioMembersServiceUnavailableResponseBody builds the HTTP
-// response body from the result of the "invite-groupsio-members" endpoint of
+// NewGetGroupsioMailingListStatsNotFoundResponseBody builds the HTTP response
+// body from the result of the "get-groupsio-mailing-list-stats" endpoint of
 // the "mailing-list" service.
-func NewInviteGroupsioMembersServiceUnavailableResponseBody(res *mailinglist.ServiceUnavailableError) *InviteGroupsioMembersServiceUnavailableResponseBody {
-	body := &InviteGroupsioMembersServiceUnavailableResponseBody{
+func NewGetGroupsioMailingListStatsNotFoundResponseBody(res *mailinglist.NotFoundError) *GetGroupsioMailingListStatsNotFoundResponseBody {
+	body := &GetGroupsioMailingListStatsNotFoundResponseBody{
 		Message: res.Message,
 	}
 	return body
 }
 
-// NewCheckGroupsioSubscriberBadRequestResponseBody builds the HTTP response
-// body from the result of the "check-groupsio-subscriber" endpoint of the
-// "mailing-list" service.
-func NewCheckGroupsioSubscriberBadRequestResponseBody(res *mailinglist.BadRequestError) *CheckGroupsioSubscriberBadRequestResponseBody {
-	body := &CheckGroupsioSubscriberBadRequestResponseBody{
+// NewGetGroupsioMailingListStatsServiceUnavailableResponseBody builds the HTTP
+// response body from the result of the "get-groupsio-mailing-list-stats"
+// endpoint of the "mailing-list" service.
+func NewGetGroupsioMailingListStatsServiceUnavailableResponseBody(res *mailinglist.ServiceUnavailableError) *GetGroupsioMailingListStatsServiceUnavailableResponseBody {
+	body := &GetGroupsioMailingListStatsServiceUnavailableResponseBody{
 		Message: res.Message,
 	}
 	return body
 }
 
-// NewCheckGroupsioSubscriberInternalServerErrorResponseBody builds the HTTP
-// response body from the result of the "check-groupsio-subscriber" endpoint of
+// NewGetGroupsioServiceTreeInternalServerErrorResponseBody builds the HTTP
+// response body from the result of the "get-groupsio-service-tree" endpoint of
 // the "mailing-list" service.
-func NewCheckGroupsioSubscriberInternalServerErrorResponseBody(res *mailinglist.InternalServerError) *CheckGroupsioSubscriberInternalServerErrorResponseBody {
-	body := &CheckGroupsioSubscriberInternalServerErrorResponseBody{
+func NewGetGroupsioServiceTreeInternalServerErrorResponseBody(res *mailinglist.InternalServerError) *GetGroupsioServiceTreeInternalServerErrorResponseBody {
+	body := &GetGroupsioServiceTreeInternalServerErrorResponseBody{
 		Message: res.Message,
 	}
 	return body
 }
 
-// NewCheckGroupsioSubscriberServiceUnavailableResponseBody builds the HTTP
-// response body from the result of the "check-groupsio-subscriber" endpoint of
-// the "mailing-list" service.
-func NewCheckGroupsioSubscriberServiceUnavailableResponseBody(res *mailinglist.ServiceUnavailableError) *CheckGroupsioSubscriberServiceUnavailableResponseBody {
-	body := &CheckGroupsioSubscriberServiceUnavailableResponseBody{
+// NewGetGroupsioServiceTreeNotFoundResponseBody builds the HTTP response body
+// from the result of the "get-groupsio-service-tree" endpoint of the
+// "mailing-list" service.
+func NewGetGroupsioServiceTreeNotFoundResponseBody(res *mailinglist.NotFoundError) *GetGroupsioServiceTreeNotFoundResponseBody {
+	body := &GetGroupsioServiceTreeNotFoundResponseBody{
 		Message: res.Message,
 	}
 	return body
 }
 
-// NewGetGroupsioArtifactInternalServerErrorResponseBody builds the HTTP
-// response body from the result of the "get-groupsio-artifact" endpoint of the
-// "mailing-list" service.
-func NewGetGroupsioArtifactInternalServerErrorResponseBody(res *mailinglist.InternalServerError) *GetGroupsioArtifactInternalServerErrorResponseBody {
-	body := &GetGroupsioArtifactInternalServerErrorResponseBody{
+// NewGetGroupsioServiceTreeServiceUnavailableResponseBody builds the HTTP
+// response body from the result of the "get-groupsio-service-tree" endpoint of
+// the "mailing-list" service.
+func NewGetGroupsioServiceTreeServiceUnavailableResponseBody(res *mailinglist.ServiceUnavailableError) *GetGroupsioServiceTreeServiceUnavailableResponseBody {
+	body := &GetGroupsioServiceTreeServiceUnavailableResponseBody{
 		Message: res.Message,
 	}
 	return body
 }
 
-// NewGetGroupsioArtifactNotFoundResponseBody builds the HTTP response body
-// from the result of the "get-groupsio-artifact" endpoint of the
-// "mailing-list" service.
-func NewGetGroupsioArtifactNotFoundResponseBody(res *mailinglist.NotFoundError) *GetGroupsioArtifactNotFoundResponseBody {
-	body := &GetGroupsioArtifactNotFoundResponseBody{
+// NewGetGroupsioMemberHierarchyInternalServerErrorResponseBody builds the HTTP
+// response body from the result of the "get-groupsio-member-hierarchy"
+// endpoint of the "mailing-list" service.
+func NewGetGroupsioMemberHierarchyInternalServerErrorResponseBody(res *mailinglist.InternalServerError) *GetGroupsioMemberHierarchyInternalServerErrorResponseBody {
+	body := &GetGroupsioMemberHierarchyInternalServerErrorResponseBody{
 		Message: res.Message,
 	}
 	return body
 }
 
-// NewGetGroupsioArtifactServiceUnavailableResponseBody builds the HTTP
-// response body from the result of the "get-groupsio-artifact" endpoint of the
+// NewGetGroupsioMemberHierarchyNotFoundResponseBody builds the HTTP response
+// body from the result of the "get-groupsio-member-hierarchy" endpoint of the
 // "mailing-list" service.
-func NewGetGroupsioArtifactServiceUnavailableResponseBody(res *mailinglist.ServiceUnavailableError) *GetGroupsioArtifactServiceUnavailableResponseBody {
-	body := &GetGroupsioArtifactServiceUnavailableResponseBody{
+func NewGetGroupsioMemberHierarchyNotFoundResponseBody(res *mailinglist.NotFoundError) *GetGroupsioMemberHierarchyNotFoundResponseBody {
+	body := &GetGroupsioMemberHierarchyNotFoundResponseBody{
 		Message: res.Message,
 	}
 	return body
 }
 
-// NewGetGroupsioArtifactDownloadInternalServerErrorResponseBody builds the
-// HTTP response body from the result of the "get-groupsio-artifact-download"
+// NewGetGroupsioMemberHierarchyServiceUnavailableResponseBody builds the HTTP
+// response body from the result of the "get-groupsio-member-hierarchy"
 // endpoint of the "mailing-list" service.
-func NewGetGroupsioArtifactDownloadInternalServerErrorResponseBody(res *mailinglist.InternalServerError) *GetGroupsioArtifactDownloadInternalServerErrorResponseBody {
-	body := &GetGroupsioArtifactDownloadInternalServerErrorResponseBody{
+func NewGetGroupsioMemberHierarchyServiceUnavailableResponseBody(res *mailinglist.ServiceUnavailableError) *GetGroupsioMemberHierarchyServiceUnavailableResponseBody {
+	body := &GetGroupsioMemberHierarchyServiceUnavailableResponseBody{
 		Message: res.Message,
 	}
 	return body
 }
 
-// NewGetGroupsioArtifactDownloadNotFoundResponseBody builds the HTTP response
-// body from the result of the "get-groupsio-artifact-download" endpoint of the
+// NewListGroupsioProjectMembersBadRequestResponseBody builds the HTTP response
+// body from the result of the "list-groupsio-project-members" endpoint of the
 // "mailing-list" service.
-func NewGetGroupsioArtifactDownloadNotFoundResponseBody(res *mailinglist.NotFoundError) *GetGroupsioArtifactDownloadNotFoundResponseBody {
-	body := &GetGroupsioArtifactDownloadNotFoundResponseBody{
+func NewListGroupsioProjectMembersBadRequestResponseBody(res *mailinglist.BadRequestError) *ListGroupsioProjectMembersBadRequestResponseBody {
+	body := &ListGroupsioProjectMembersBadRequestResponseBody{
 		Message: res.Message,
 	}
 	return body
 }
 
-// NewGetGroupsioArtifactDownloadServiceUnavailableResponseBody builds the HTTP
-// response body from the result of the "get-groupsio-artifact-download"
+// NewListGroupsioProjectMembersInternalServerErrorResponseBody builds the HTTP
+// response body from the result of the "list-groupsio-project-members"
 // endpoint of the "mailing-list" service.
-func NewGetGroupsioArtifactDownloadServiceUnavailableResponseBody(res *mailinglist.ServiceUnavailableError) *GetGroupsioArtifactDownloadServiceUnavailableResponseBody {
-	body := &GetGroupsioArtifactDownloadServiceUnavailableResponseBody{
+func NewListGroupsioProjectMembersInternalServerErrorResponseBody(res *mailinglist.InternalServerError) *ListGroupsioProjectMembersInternalServerErrorResponseBody {
+	body := &ListGroupsioProjectMembersInternalServerErrorResponseBody{
+		Message: res.Message,
+	}
+	return body
+}
+
+// NewListGroupsioProjectMembersServiceUnavailableResponseBody builds the HTTP
+// response body from the result of the "list-groupsio-project-members"
+// endpoint of the "mailing-list" service.
+func NewListGroupsioProjectMembersServiceUnavailableResponseBody(res *mailinglist.ServiceUnavailableError) *ListGroupsioProjectMembersServiceUnavailableResponseBody {
+	body := &ListGroupsioProjectMembersServiceUnavailableResponseBody{
 		Message: res.Message,
 	}
 	return body
@@ -2416,6 +4538,16 @@ func NewUpdateGroupsioServicePayload(body *UpdateGroupsioServiceRequestBody, ser
 	return v
 }
 
+// NewPromoteGroupsioServicePayload builds a mailing-list service
+// promote-groupsio-service endpoint payload.
+func NewPromoteGroupsioServicePayload(serviceID string, bearerToken *string) *mailinglist.PromoteGroupsioServicePayload {
+	v := &mailinglist.PromoteGroupsioServicePayload{}
+	v.ServiceID = serviceID
+	v.BearerToken = bearerToken
+
+	return v
+}
+
 // NewDeleteGroupsioServicePayload builds a mailing-list service
 // delete-groupsio-service endpoint payload.
 func NewDeleteGroupsioServicePayload(serviceID string, bearerToken *string) *mailinglist.DeleteGroupsioServicePayload {
@@ -2435,6 +4567,26 @@ func NewGetGroupsioServiceProjectsPayload(bearerToken *string) *mailinglist.GetG
 	return v
 }
 
+// NewListGroupsioServicesByStatusPayload builds a mailing-list service
+// list-groupsio-services-by-status endpoint payload.
+func NewListGroupsioServicesByStatusPayload(status string, bearerToken *string) *mailinglist.ListGroupsioServicesByStatusPayload {
+	v := &mailinglist.ListGroupsioServicesByStatusPayload{}
+	v.Status = status
+	v.BearerToken = bearerToken
+
+	return v
+}
+
+// NewListUnprovisionedGroupsioServicesPayload builds a mailing-list service
+// list-unprovisioned-groupsio-services endpoint payload.
+func NewListUnprovisionedGroupsioServicesPayload(projectUID string, bearerToken *string) *mailinglist.ListUnprovisionedGroupsioServicesPayload {
+	v := &mailinglist.ListUnprovisionedGroupsioServicesPayload{}
+	v.ProjectUID = projectUID
+	v.BearerToken = bearerToken
+
+	return v
+}
+
 // NewFindParentGroupsioServicePayload builds a mailing-list service
 // find-parent-groupsio-service endpoint payload.
 func NewFindParentGroupsioServicePayload(projectUID string, bearerToken *string) *mailinglist.FindParentGroupsioServicePayload {
@@ -2456,6 +4608,28 @@ func NewListGroupsioMailingListsPayload(projectUID *string, committeeUID *string
 	return v
 }
 
+// NewListGroupsioMailingListsByVisibilityPayload builds a mailing-list service
+// list-groupsio-mailing-lists-by-visibility endpoint payload.
+func NewListGroupsioMailingListsByVisibilityPayload(projectUID string, public bool, bearerToken *string) *mailinglist.ListGroupsioMailingListsByVisibilityPayload {
+	v := &mailinglist.ListGroupsioMailingListsByVisibilityPayload{}
+	v.ProjectUID = projectUID
+	v.Public = public
+	v.BearerToken = bearerToken
+
+	return v
+}
+
+// NewListGroupsioMailingListsUpdatedSincePayload builds a mailing-list service
+// list-groupsio-mailing-lists-updated-since endpoint payload.
+func NewListGroupsioMailingListsUpdatedSincePayload(projectUID string, since string, bearerToken *string) *mailinglist.ListGroupsioMailingListsUpdatedSincePayload {
+	v := &mailinglist.ListGroupsioMailingListsUpdatedSincePayload{}
+	v.ProjectUID = projectUID
+	v.Since = since
+	v.BearerToken = bearerToken
+
+	return v
+}
+
 // NewCreateGroupsioMailingListPayload builds a mailing-list service
 // create-groupsio-mailing-list endpoint payload.
 func NewCreateGroupsioMailingListPayload(body *CreateGroupsioMailingListRequestBody, bearerToken *string) *mailinglist.CreateGroupsioMailingListPayload {
@@ -2543,6 +4717,60 @@ func NewListGroupsioMembersPayload(subgroupID string, bearerToken *string) *mail
 	return v
 }
 
+// NewListGroupsioMembersNeedingReviewPayload builds a mailing-list service
+// list-groupsio-members-needing-review endpoint payload.
+func NewListGroupsioMembersNeedingReviewPayload(subgroupID string, olderThan string, bearerToken *string) *mailinglist.ListGroupsioMembersNeedingReviewPayload {
+	v := &mailinglist.ListGroupsioMembersNeedingReviewPayload{}
+	v.SubgroupID = subgroupID
+	v.OlderThan = olderThan
+	v.BearerToken = bearerToken
+
+	return v
+}
+
+// NewListGroupsioMembersModifiedSincePayload builds a mailing-list service
+// list-groupsio-members-modified-since endpoint payload.
+func NewListGroupsioMembersModifiedSincePayload(subgroupID string, since string, bearerToken *string) *mailinglist.ListGroupsioMembersModifiedSincePayload {
+	v := &mailinglist.ListGroupsioMembersModifiedSincePayload{}
+	v.SubgroupID = subgroupID
+	v.Since = since
+	v.BearerToken = bearerToken
+
+	return v
+}
+
+// NewListGroupsioMembersByOrganizationPayload builds a mailing-list service
+// list-groupsio-members-by-organization endpoint payload.
+func NewListGroupsioMembersByOrganizationPayload(subgroupID string, organization string, bearerToken *string) *mailinglist.ListGroupsioMembersByOrganizationPayload {
+	v := &mailinglist.ListGroupsioMembersByOrganizationPayload{}
+	v.SubgroupID = subgroupID
+	v.Organization = organization
+	v.BearerToken = bearerToken
+
+	return v
+}
+
+// NewListGroupsioDuplicateMembersPayload builds a mailing-list service
+// list-groupsio-duplicate-members endpoint payload.
+func NewListGroupsioDuplicateMembersPayload(subgroupID string, bearerToken *string) *mailinglist.ListGroupsioDuplicateMembersPayload {
+	v := &mailinglist.ListGroupsioDuplicateMembersPayload{}
+	v.SubgroupID = subgroupID
+	v.BearerToken = bearerToken
+
+	return v
+}
+
+// NewPreviewGroupsioDeliveryModeChangePayload builds a mailing-list service
+// preview-groupsio-delivery-mode-change endpoint payload.
+func NewPreviewGroupsioDeliveryModeChangePayload(subgroupID string, targetMode string, bearerToken *string) *mailinglist.PreviewGroupsioDeliveryModeChangePayload {
+	v := &mailinglist.PreviewGroupsioDeliveryModeChangePayload{}
+	v.SubgroupID = subgroupID
+	v.TargetMode = targetMode
+	v.BearerToken = bearerToken
+
+	return v
+}
+
 // NewAddGroupsioMemberPayload builds a mailing-list service
 // add-groupsio-member endpoint payload.
 func NewAddGroupsioMemberPayload(body *AddGroupsioMemberRequestBody, subgroupID string, bearerToken *string) *mailinglist.AddGroupsioMemberPayload {
@@ -2561,6 +4789,24 @@ func NewAddGroupsioMemberPayload(body *AddGroupsioMemberRequestBody, subgroupID
 	return v
 }
 
+// NewUpsertGroupsioMemberPayload builds a mailing-list service
+// upsert-groupsio-member endpoint payload.
+func NewUpsertGroupsioMemberPayload(body *UpsertGroupsioMemberRequestBody, subgroupID string, bearerToken *string) *mailinglist.UpsertGroupsioMemberPayload {
+	v := &mailinglist.UpsertGroupsioMemberPayload{
+		Email:        *body.Email,
+		Name:         body.Name,
+		MemberType:   body.MemberType,
+		ModStatus:    body.ModStatus,
+		DeliveryMode: body.DeliveryMode,
+		Organization: body.Organization,
+		JobTitle:     body.JobTitle,
+	}
+	v.SubgroupID = subgroupID
+	v.BearerToken = bearerToken
+
+	return v
+}
+
 // NewGetGroupsioMemberPayload builds a mailing-list service
 // get-groupsio-member endpoint payload.
 func NewGetGroupsioMemberPayload(subgroupID string, memberID string, bearerToken *string) *mailinglist.GetGroupsioMemberPayload {
@@ -2576,13 +4822,14 @@ func NewGetGroupsioMemberPayload(subgroupID string, memberID string, bearerToken
 // update-groupsio-member endpoint payload.
 func NewUpdateGroupsioMemberPayload(body *UpdateGroupsioMemberRequestBody, subgroupID string, memberID string, bearerToken *string) *mailinglist.UpdateGroupsioMemberPayload {
 	v := &mailinglist.UpdateGroupsioMemberPayload{
-		Email:        body.Email,
-		Name:         body.Name,
-		MemberType:   body.MemberType,
-		ModStatus:    body.ModStatus,
-		DeliveryMode: body.DeliveryMode,
-		Organization: body.Organization,
-		JobTitle:     body.JobTitle,
+		MailingListUID: body.MailingListUID,
+		Email:          body.Email,
+		Name:           body.Name,
+		MemberType:     body.MemberType,
+		ModStatus:      body.ModStatus,
+		DeliveryMode:   body.DeliveryMode,
+		Organization:   body.Organization,
+		JobTitle:       body.JobTitle,
 	}
 	v.SubgroupID = subgroupID
 	v.MemberID = memberID
@@ -2616,6 +4863,49 @@ func NewInviteGroupsioMembersPayload(body *InviteGroupsioMembersRequestBody, sub
 	return v
 }
 
+// NewImportGroupsioMembersPayload builds a mailing-list service
+// import-groupsio-members endpoint payload.
+func NewImportGroupsioMembersPayload(body *ImportGroupsioMembersRequestBody, subgroupID string, bearerToken *string) *mailinglist.ImportGroupsioMembersPayload {
+	v := &mailinglist.ImportGroupsioMembersPayload{}
+	v.Members = make([]*mailinglist.GroupsioMemberRequest, len(body.Members))
+	for i, val := range body.Members {
+		v.Members[i] = unmarshalGroupsioMemberRequestRequestBodyToMailinglistGroupsioMemberRequest(val)
+	}
+	v.SubgroupID = subgroupID
+	v.BearerToken = bearerToken
+
+	return v
+}
+
+// NewMergeGroupsioMembersPayload builds a mailing-list service
+// merge-groupsio-members endpoint payload.
+func NewMergeGroupsioMembersPayload(body *MergeGroupsioMembersRequestBody, subgroupID string, bearerToken *string) *mailinglist.MergeGroupsioMembersPayload {
+	v := &mailinglist.MergeGroupsioMembersPayload{
+		KeepMemberID: *body.KeepMemberID,
+	}
+	v.MergeMemberIds = make([]string, len(body.MergeMemberIds))
+	for i, val := range body.MergeMemberIds {
+		v.MergeMemberIds[i] = val
+	}
+	v.SubgroupID = subgroupID
+	v.BearerToken = bearerToken
+
+	return v
+}
+
+// NewChangeGroupsioMemberEmailPayload builds a mailing-list service
+// change-groupsio-member-email endpoint payload.
+func NewChangeGroupsioMemberEmailPayload(body *ChangeGroupsioMemberEmailRequestBody, subgroupID string, memberID string, bearerToken *string) *mailinglist.ChangeGroupsioMemberEmailPayload {
+	v := &mailinglist.ChangeGroupsioMemberEmailPayload{
+		Email: *body.Email,
+	}
+	v.SubgroupID = subgroupID
+	v.MemberID = memberID
+	v.BearerToken = bearerToken
+
+	return v
+}
+
 // NewCheckGroupsioSubscriberPayload builds a mailing-list service
 // check-groupsio-subscriber endpoint payload.
 func NewCheckGroupsioSubscriberPayload(body *CheckGroupsioSubscriberRequestBody, bearerToken *string) *mailinglist.CheckGroupsioSubscriberPayload {
@@ -2650,6 +4940,81 @@ func NewGetGroupsioArtifactDownloadPayload(subgroupID string, artifactID string,
 	return v
 }
 
+// NewGetGroupsioProjectSummaryPayload builds a mailing-list service
+// get-groupsio-project-summary endpoint payload.
+func NewGetGroupsioProjectSummaryPayload(projectUID string, bearerToken *string) *mailinglist.GetGroupsioProjectSummaryPayload {
+	v := &mailinglist.GetGroupsioProjectSummaryPayload{}
+	v.ProjectUID = projectUID
+	v.BearerToken = bearerToken
+
+	return v
+}
+
+// NewListGroupsioOrphanedMailingListsPayload builds a mailing-list service
+// list-groupsio-orphaned-mailing-lists endpoint payload.
+func NewListGroupsioOrphanedMailingListsPayload(projectUID string, bearerToken *string) *mailinglist.ListGroupsioOrphanedMailingListsPayload {
+	v := &mailinglist.ListGroupsioOrphanedMailingListsPayload{}
+	v.ProjectUID = projectUID
+	v.BearerToken = bearerToken
+
+	return v
+}
+
+// NewRepublishGroupsioProjectIndexPayload builds a mailing-list service
+// republish-groupsio-project-index endpoint payload.
+func NewRepublishGroupsioProjectIndexPayload(projectUID string, bearerToken *string) *mailinglist.RepublishGroupsioProjectIndexPayload {
+	v := &mailinglist.RepublishGroupsioProjectIndexPayload{}
+	v.ProjectUID = projectUID
+	v.BearerToken = bearerToken
+
+	return v
+}
+
+// NewGetGroupsioMailingListStatsPayload builds a mailing-list service
+// get-groupsio-mailing-list-stats endpoint payload.
+func NewGetGroupsioMailingListStatsPayload(subgroupID string, bearerToken *string) *mailinglist.GetGroupsioMailingListStatsPayload {
+	v := &mailinglist.GetGroupsioMailingListStatsPayload{}
+	v.SubgroupID = subgroupID
+	v.BearerToken = bearerToken
+
+	return v
+}
+
+// NewGetGroupsioServiceTreePayload builds a mailing-list service
+// get-groupsio-service-tree endpoint payload.
+func NewGetGroupsioServiceTreePayload(serviceID string, includeMemberCounts bool, bearerToken *string) *mailinglist.GetGroupsioServiceTreePayload {
+	v := &mailinglist.GetGroupsioServiceTreePayload{}
+	v.ServiceID = serviceID
+	v.IncludeMemberCounts = includeMemberCounts
+	v.BearerToken = bearerToken
+
+	return v
+}
+
+// NewGetGroupsioMemberHierarchyPayload builds a mailing-list service
+// get-groupsio-member-hierarchy endpoint payload.
+func NewGetGroupsioMemberHierarchyPayload(subgroupID string, memberID string, bearerToken *string) *mailinglist.GetGroupsioMemberHierarchyPayload {
+	v := &mailinglist.GetGroupsioMemberHierarchyPayload{}
+	v.SubgroupID = subgroupID
+	v.MemberID = memberID
+	v.BearerToken = bearerToken
+
+	return v
+}
+
+// NewListGroupsioProjectMembersPayload builds a mailing-list service
+// list-groupsio-project-members endpoint payload.
+func NewListGroupsioProjectMembersPayload(projectUID string, limit int, cursor *string, dedupe bool, bearerToken *string) *mailinglist.ListGroupsioProjectMembersPayload {
+	v := &mailinglist.ListGroupsioProjectMembersPayload{}
+	v.ProjectUID = projectUID
+	v.Limit = limit
+	v.Cursor = cursor
+	v.Dedupe = dedupe
+	v.BearerToken = bearerToken
+
+	return v
+}
+
 // ValidateCreateGroupsioServiceRequestBody runs the validations defined on
 // Create-Groupsio-ServiceRequestBody
 func ValidateCreateGroupsioServiceRequestBody(body *CreateGroupsioServiceRequestBody) (err error) {
@@ -2726,6 +5091,33 @@ func ValidateAddGroupsioMemberRequestBody(body *AddGroupsioMemberRequestBody) (e
 	return
 }
 
+// ValidateUpsertGroupsioMemberRequestBody runs the validations defined on
+// Upsert-Groupsio-MemberRequestBody
+func ValidateUpsertGroupsioMemberRequestBody(body *UpsertGroupsioMemberRequestBody) (err error) {
+	if body.Email == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("email", "body"))
+	}
+	if body.Email != nil {
+		err = goa.MergeErrors(err, goa.ValidateFormat("body.email", *body.Email, goa.FormatEmail))
+	}
+	if body.MemberType != nil {
+		if !(*body.MemberType == "direct") {
+			err = goa.MergeErrors(err, goa.InvalidEnumValueError("body.member_type", *body.MemberType, []any{"direct"}))
+		}
+	}
+	if body.ModStatus != nil {
+		if !(*body.ModStatus == "none" || *body.ModStatus == "moderator" || *body.ModStatus == "owner") {
+			err = goa.MergeErrors(err, goa.InvalidEnumValueError("body.mod_status", *body.ModStatus, []any{"none", "moderator", "owner"}))
+		}
+	}
+	if body.DeliveryMode != nil {
+		if !(*body.DeliveryMode == "email_delivery_single" || *body.DeliveryMode == "email_delivery_digest" || *body.DeliveryMode == "email_delivery_none" || *body.DeliveryMode == "email_delivery_special" || *body.DeliveryMode == "email_delivery_html_digest" || *body.DeliveryMode == "email_delivery_summary") {
+			err = goa.MergeErrors(err, goa.InvalidEnumValueError("body.delivery_mode", *body.DeliveryMode, []any{"email_delivery_single", "email_delivery_digest", "email_delivery_none", "email_delivery_special", "email_delivery_html_digest", "email_delivery_summary"}))
+		}
+	}
+	return
+}
+
 // ValidateUpdateGroupsioMemberRequestBody runs the validations defined on
 // Update-Groupsio-MemberRequestBody
 func ValidateUpdateGroupsioMemberRequestBody(body *UpdateGroupsioMemberRequestBody) (err error) {
@@ -2759,6 +5151,49 @@ func ValidateInviteGroupsioMembersRequestBody(body *InviteGroupsioMembersRequest
 	return
 }
 
+// ValidateImportGroupsioMembersRequestBody runs the validations defined on
+// Import-Groupsio-MembersRequestBody
+func ValidateImportGroupsioMembersRequestBody(body *ImportGroupsioMembersRequestBody) (err error) {
+	if body.Members == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("members", "body"))
+	}
+	for _, e := range body.Members {
+		if e != nil {
+			if err2 := ValidateGroupsioMemberRequestRequestBody(e); err2 != nil {
+				err = goa.MergeErrors(err, err2)
+			}
+		}
+	}
+	return
+}
+
+// ValidateMergeGroupsioMembersRequestBody runs the validations defined on
+// Merge-Groupsio-MembersRequestBody
+func ValidateMergeGroupsioMembersRequestBody(body *MergeGroupsioMembersRequestBody) (err error) {
+	if body.KeepMemberID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("keep_member_id", "body"))
+	}
+	if body.MergeMemberIds == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("merge_member_ids", "body"))
+	}
+	if len(body.MergeMemberIds) < 1 {
+		err = goa.MergeErrors(err, goa.InvalidLengthError("body.merge_member_ids", body.MergeMemberIds, len(body.MergeMemberIds), 1, true))
+	}
+	return
+}
+
+// ValidateChangeGroupsioMemberEmailRequestBody runs the validations defined on
+// Change-Groupsio-Member-EmailRequestBody
+func ValidateChangeGroupsioMemberEmailRequestBody(body *ChangeGroupsioMemberEmailRequestBody) (err error) {
+	if body.Email == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("email", "body"))
+	}
+	if body.Email != nil {
+		err = goa.MergeErrors(err, goa.ValidateFormat("body.email", *body.Email, goa.FormatEmail))
+	}
+	return
+}
+
 // ValidateCheckGroupsioSubscriberRequestBody runs the validations defined on
 // Check-Groupsio-SubscriberRequestBody
 func ValidateCheckGroupsioSubscriberRequestBody(body *CheckGroupsioSubscriberRequestBody) (err error) {
@@ -2773,3 +5208,27 @@ func ValidateCheckGroupsioSubscriberRequestBody(body *CheckGroupsioSubscriberReq
 	}
 	return
 }
+
+// ValidateGroupsioMemberRequestRequestBody runs the validations defined on
+// groupsio-member-requestRequestBody
+func ValidateGroupsioMemberRequestRequestBody(body *GroupsioMemberRequestRequestBody) (err error) {
+	if body.Email != nil {
+		err = goa.MergeErrors(err, goa.ValidateFormat("body.email", *body.Email, goa.FormatEmail))
+	}
+	if body.MemberType != nil {
+		if !(*body.MemberType == "direct") {
+			err = goa.MergeErrors(err, goa.InvalidEnumValueError("body.member_type", *body.MemberType, []any{"direct"}))
+		}
+	}
+	if body.ModStatus != nil {
+		if !(*body.ModStatus == "none" || *body.ModStatus == "moderator" || *body.ModStatus == "owner") {
+			err = goa.MergeErrors(err, goa.InvalidEnumValueError("body.mod_status", *body.ModStatus, []any{"none", "moderator", "owner"}))
+		}
+	}
+	if body.DeliveryMode != nil {
+		if !(*body.DeliveryMode == "email_delivery_single" || *body.DeliveryMode == "email_delivery_digest" || *body.DeliveryMode == "email_delivery_none" || *body.DeliveryMode == "email_delivery_special" || *body.DeliveryMode == "email_delivery_html_digest" || *body.DeliveryMode == "email_delivery_summary") {
+			err = goa.MergeErrors(err, goa.InvalidEnumValueError("body.delivery_mode", *body.DeliveryMode, []any{"email_delivery_single", "email_delivery_digest", "email_delivery_none", "email_delivery_special", "email_delivery_html_digest", "email_delivery_summary"}))
+		}
+	}
+	return
+}
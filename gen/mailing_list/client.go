@@ -16,61 +16,103 @@ import (
 
 // Client is the "mailing-list" service client.
 type Client struct {
-	LivezEndpoint                             goa.Endpoint
-	ReadyzEndpoint                            goa.Endpoint
-	ListGroupsioServicesEndpoint              goa.Endpoint
-	CreateGroupsioServiceEndpoint             goa.Endpoint
-	GetGroupsioServiceEndpoint                goa.Endpoint
-	UpdateGroupsioServiceEndpoint             goa.Endpoint
-	DeleteGroupsioServiceEndpoint             goa.Endpoint
-	GetGroupsioServiceProjectsEndpoint        goa.Endpoint
-	FindParentGroupsioServiceEndpoint         goa.Endpoint
-	ListGroupsioMailingListsEndpoint          goa.Endpoint
-	CreateGroupsioMailingListEndpoint         goa.Endpoint
-	GetGroupsioMailingListEndpoint            goa.Endpoint
-	UpdateGroupsioMailingListEndpoint         goa.Endpoint
-	DeleteGroupsioMailingListEndpoint         goa.Endpoint
-	GetGroupsioMailingListCountEndpoint       goa.Endpoint
-	GetGroupsioMailingListMemberCountEndpoint goa.Endpoint
-	ListGroupsioMembersEndpoint               goa.Endpoint
-	AddGroupsioMemberEndpoint                 goa.Endpoint
-	GetGroupsioMemberEndpoint                 goa.Endpoint
-	UpdateGroupsioMemberEndpoint              goa.Endpoint
-	DeleteGroupsioMemberEndpoint              goa.Endpoint
-	InviteGroupsioMembersEndpoint             goa.Endpoint
-	CheckGroupsioSubscriberEndpoint           goa.Endpoint
-	GetGroupsioArtifactEndpoint               goa.Endpoint
-	GetGroupsioArtifactDownloadEndpoint       goa.Endpoint
+	LivezEndpoint                                goa.Endpoint
+	ReadyzEndpoint                               goa.Endpoint
+	ListGroupsioServicesEndpoint                 goa.Endpoint
+	CreateGroupsioServiceEndpoint                goa.Endpoint
+	GetGroupsioServiceEndpoint                   goa.Endpoint
+	UpdateGroupsioServiceEndpoint                goa.Endpoint
+	PromoteGroupsioServiceEndpoint               goa.Endpoint
+	DeleteGroupsioServiceEndpoint                goa.Endpoint
+	GetGroupsioServiceProjectsEndpoint           goa.Endpoint
+	ListGroupsioServicesByStatusEndpoint         goa.Endpoint
+	ListUnprovisionedGroupsioServicesEndpoint    goa.Endpoint
+	FindParentGroupsioServiceEndpoint            goa.Endpoint
+	ListGroupsioMailingListsEndpoint             goa.Endpoint
+	ListGroupsioMailingListsByVisibilityEndpoint goa.Endpoint
+	ListGroupsioMailingListsUpdatedSinceEndpoint goa.Endpoint
+	CreateGroupsioMailingListEndpoint            goa.Endpoint
+	GetGroupsioMailingListEndpoint               goa.Endpoint
+	UpdateGroupsioMailingListEndpoint            goa.Endpoint
+	DeleteGroupsioMailingListEndpoint            goa.Endpoint
+	GetGroupsioMailingListCountEndpoint          goa.Endpoint
+	GetGroupsioMailingListMemberCountEndpoint    goa.Endpoint
+	ListGroupsioMembersEndpoint                  goa.Endpoint
+	ListGroupsioMembersNeedingReviewEndpoint     goa.Endpoint
+	ListGroupsioMembersModifiedSinceEndpoint     goa.Endpoint
+	ListGroupsioMembersByOrganizationEndpoint    goa.Endpoint
+	ListGroupsioDuplicateMembersEndpoint         goa.Endpoint
+	PreviewGroupsioDeliveryModeChangeEndpoint    goa.Endpoint
+	AddGroupsioMemberEndpoint                    goa.Endpoint
+	UpsertGroupsioMemberEndpoint                 goa.Endpoint
+	GetGroupsioMemberEndpoint                    goa.Endpoint
+	UpdateGroupsioMemberEndpoint                 goa.Endpoint
+	DeleteGroupsioMemberEndpoint                 goa.Endpoint
+	InviteGroupsioMembersEndpoint                goa.Endpoint
+	ImportGroupsioMembersEndpoint                goa.Endpoint
+	MergeGroupsioMembersEndpoint                 goa.Endpoint
+	ChangeGroupsioMemberEmailEndpoint            goa.Endpoint
+	CheckGroupsioSubscriberEndpoint              goa.Endpoint
+	GetGroupsioArtifactEndpoint                  goa.Endpoint
+	GetGroupsioArtifactDownloadEndpoint          goa.Endpoint
+	GetGroupsioProjectSummaryEndpoint            goa.Endpoint
+	ListGroupsioOrphanedMailingListsEndpoint     goa.Endpoint
+	RepublishGroupsioProjectIndexEndpoint        goa.Endpoint
+	GetGroupsioMailingListStatsEndpoint          goa.Endpoint
+	GetGroupsioServiceTreeEndpoint               goa.Endpoint
+	GetGroupsioMemberHierarchyEndpoint           goa.Endpoint
+	ListGroupsioProjectMembersEndpoint           goa.Endpoint
 }
 
 // NewClient initializes a "mailing-list" service client given the endpoints.
-func NewClient(livez, readyz, listGroupsioServices, createGroupsioService, getGroupsioService, updateGroupsioService, deleteGroupsioService, getGroupsioServiceProjects, findParentGroupsioService, listGroupsioMailingLists, createGroupsioMailingList, getGroupsioMailingList, updateGroupsioMailingList, deleteGroupsioMailingList, getGroupsioMailingListCount, getGroupsioMailingListMemberCount, listGroupsioMembers, addGroupsioMember, getGroupsioMember, updateGroupsioMember, deleteGroupsioMember, inviteGroupsioMembers, checkGroupsioSubscriber, getGroupsioArtifact, getGroupsioArtifactDownload goa.Endpoint) *Client {
+func NewClient(livez, readyz, listGroupsioServices, createGroupsioService, getGroupsioService, updateGroupsioService, promoteGroupsioService, deleteGroupsioService, getGroupsioServiceProjects, listGroupsioServicesByStatus, listUnprovisionedGroupsioServices, findParentGroupsioService, listGroupsioMailingLists, listGroupsioMailingListsByVisibility, listGroupsioMailingListsUpdatedSince, createGroupsioMailingList, getGroupsioMailingList, updateGroupsioMailingList, deleteGroupsioMailingList, getGroupsioMailingListCount, getGroupsioMailingListMemberCount, listGroupsioMembers, listGroupsioMembersNeedingReview, listGroupsioMembersModifiedSince, listGroupsioMembersByOrganization, listGroupsioDuplicateMembers, previewGroupsioDeliveryModeChange, addGroupsioMember, upsertGroupsioMember, getGroupsioMember, updateGroupsioMember, deleteGroupsioMember, inviteGroupsioMembers, importGroupsioMembers, mergeGroupsioMembers, changeGroupsioMemberEmail, checkGroupsioSubscriber, getGroupsioArtifact, getGroupsioArtifactDownload, getGroupsioProjectSummary, listGroupsioOrphanedMailingLists, republishGroupsioProjectIndex, getGroupsioMailingListStats, getGroupsioServiceTree, getGroupsioMemberHierarchy, listGroupsioProjectMembers goa.Endpoint) *Client {
 	return &Client{
-		LivezEndpoint:                             livez,
-		ReadyzEndpoint:                            readyz,
-		ListGroupsioServicesEndpoint:              listGroupsioServices,
-		CreateGroupsioServiceEndpoint:             createGroupsioService,
-		GetGroupsioServiceEndpoint:                getGroupsioService,
-		UpdateGroupsioServiceEndpoint:             updateGroupsioService,
-		DeleteGroupsioServiceEndpoint:             deleteGroupsioService,
-		GetGroupsioServiceProjectsEndpoint:        getGroupsioServiceProjects,
-		FindParentGroupsioServiceEndpoint:         findParentGroupsioService,
-		ListGroupsioMailingListsEndpoint:          listGroupsioMailingLists,
-		CreateGroupsioMailingListEndpoint:         createGroupsioMailingList,
-		GetGroupsioMailingListEndpoint:            getGroupsioMailingList,
-		UpdateGroupsioMailingListEndpoint:         updateGroupsioMailingList,
-		DeleteGroupsioMailingListEndpoint:         deleteGroupsioMailingList,
-		GetGroupsioMailingListCountEndpoint:       getGroupsioMailingListCount,
-		GetGroupsioMailingListMemberCountEndpoint: getGroupsioMailingListMemberCount,
-		ListGroupsioMembersEndpoint:               listGroupsioMembers,
-		AddGroupsioMemberEndpoint:                 addGroupsioMember,
-		GetGroupsioMemberEndpoint:                 getGroupsioMember,
-		UpdateGroupsioMemberEndpoint:              updateGroupsioMember,
-		DeleteGroupsioMemberEndpoint:              deleteGroupsioMember,
-		InviteGroupsioMembersEndpoint:             inviteGroupsioMembers,
-		CheckGroupsioSubscriberEndpoint:           checkGroupsioSubscriber,
-		GetGroupsioArtifactEndpoint:               getGroupsioArtifact,
-		GetGroupsioArtifactDownloadEndpoint:       getGroupsioArtifactDownload,
+		LivezEndpoint:                                livez,
+		ReadyzEndpoint:                               readyz,
+		ListGroupsioServicesEndpoint:                 listGroupsioServices,
+		CreateGroupsioServiceEndpoint:                createGroupsioService,
+		GetGroupsioServiceEndpoint:                   getGroupsioService,
+		UpdateGroupsioServiceEndpoint:                updateGroupsioService,
+		PromoteGroupsioServiceEndpoint:               promoteGroupsioService,
+		DeleteGroupsioServiceEndpoint:                deleteGroupsioService,
+		GetGroupsioServiceProjectsEndpoint:           getGroupsioServiceProjects,
+		ListGroupsioServicesByStatusEndpoint:         listGroupsioServicesByStatus,
+		ListUnprovisionedGroupsioServicesEndpoint:    listUnprovisionedGroupsioServices,
+		FindParentGroupsioServiceEndpoint:            findParentGroupsioService,
+		ListGroupsioMailingListsEndpoint:             listGroupsioMailingLists,
+		ListGroupsioMailingListsByVisibilityEndpoint: listGroupsioMailingListsByVisibility,
+		ListGroupsioMailingListsUpdatedSinceEndpoint: listGroupsioMailingListsUpdatedSince,
+		CreateGroupsioMailingListEndpoint:            createGroupsioMailingList,
+		GetGroupsioMailingListEndpoint:               getGroupsioMailingList,
+		UpdateGroupsioMailingListEndpoint:            updateGroupsioMailingList,
+		DeleteGroupsioMailingListEndpoint:            deleteGroupsioMailingList,
+		GetGroupsioMailingListCountEndpoint:          getGroupsioMailingListCount,
+		GetGroupsioMailingListMemberCountEndpoint:    getGroupsioMailingListMemberCount,
+		ListGroupsioMembersEndpoint:                  listGroupsioMembers,
+		ListGroupsioMembersNeedingReviewEndpoint:     listGroupsioMembersNeedingReview,
+		ListGroupsioMembersModifiedSinceEndpoint:     listGroupsioMembersModifiedSince,
+		ListGroupsioMembersByOrganizationEndpoint:    listGroupsioMembersByOrganization,
+		ListGroupsioDuplicateMembersEndpoint:         listGroupsioDuplicateMembers,
+		PreviewGroupsioDeliveryModeChangeEndpoint:    previewGroupsioDeliveryModeChange,
+		AddGroupsioMemberEndpoint:                    addGroupsioMember,
+		UpsertGroupsioMemberEndpoint:                 upsertGroupsioMember,
+		GetGroupsioMemberEndpoint:                    getGroupsioMember,
+		UpdateGroupsioMemberEndpoint:                 updateGroupsioMember,
+		DeleteGroupsioMemberEndpoint:                 deleteGroupsioMember,
+		InviteGroupsioMembersEndpoint:                inviteGroupsioMembers,
+		ImportGroupsioMembersEndpoint:                importGroupsioMembers,
+		MergeGroupsioMembersEndpoint:                 mergeGroupsioMembers,
+		ChangeGroupsioMemberEmailEndpoint:            changeGroupsioMemberEmail,
+		CheckGroupsioSubscriberEndpoint:              checkGroupsioSubscriber,
+		GetGroupsioArtifactEndpoint:                  getGroupsioArtifact,
+		GetGroupsioArtifactDownloadEndpoint:          getGroupsioArtifactDownload,
+		GetGroupsioProjectSummaryEndpoint:            getGroupsioProjectSummary,
+		ListGroupsioOrphanedMailingListsEndpoint:     listGroupsioOrphanedMailingLists,
+		RepublishGroupsioProjectIndexEndpoint:        republishGroupsioProjectIndex,
+		GetGroupsioMailingListStatsEndpoint:          getGroupsioMailingListStats,
+		GetGroupsioServiceTreeEndpoint:               getGroupsioServiceTree,
+		GetGroupsioMemberHierarchyEndpoint:           getGroupsioMemberHierarchy,
+		ListGroupsioProjectMembersEndpoint:           listGroupsioProjectMembers,
 	}
 }
 
@@ -88,13 +130,13 @@ func (c *Client) Livez(ctx context.Context) (res []byte, err error) {
 // Readyz may return the following errors:
 //   - "ServiceUnavailable" (type *ServiceUnavailableError): Service unavailable
 //   - error: internal error
-func (c *Client) Readyz(ctx context.Context) (res []byte, err error) {
+func (c *Client) Readyz(ctx context.Context) (res *Readiness, err error) {
 	var ires any
 	ires, err = c.ReadyzEndpoint(ctx, nil)
 	if err != nil {
 		return
 	}
-	return ires.([]byte), nil
+	return ires.(*Readiness), nil
 }
 
 // ListGroupsioServices calls the "list-groupsio-services" endpoint of the
@@ -163,6 +205,24 @@ func (c *Client) UpdateGroupsioService(ctx context.Context, p *UpdateGroupsioSer
 	return ires.(*GroupsioService), nil
 }
 
+// PromoteGroupsioService calls the "promote-groupsio-service" endpoint of the
+// "mailing-list" service.
+// PromoteGroupsioService may return the following errors:
+//   - "BadRequest" (type *BadRequestError): Bad request
+//   - "NotFound" (type *NotFoundError): Service not found
+//   - "Conflict" (type *ConflictError): Project already has a primary service
+//   - "InternalServerError" (type *InternalServerError): Internal server error
+//   - "ServiceUnavailable" (type *ServiceUnavailableError): Service unavailable
+//   - error: internal error
+func (c *Client) PromoteGroupsioService(ctx context.Context, p *PromoteGroupsioServicePayload) (res *GroupsioServicePromotion, err error) {
+	var ires any
+	ires, err = c.PromoteGroupsioServiceEndpoint(ctx, p)
+	if err != nil {
+		return
+	}
+	return ires.(*GroupsioServicePromotion), nil
+}
+
 // DeleteGroupsioService calls the "delete-groupsio-service" endpoint of the
 // "mailing-list" service.
 // DeleteGroupsioService may return the following errors:
@@ -190,6 +250,39 @@ func (c *Client) GetGroupsioServiceProjects(ctx context.Context, p *GetGroupsioS
 	return ires.(*GroupsioProjectsResponse), nil
 }
 
+// ListGroupsioServicesByStatus calls the "list-groupsio-services-by-status"
+// endpoint of the "mailing-list" service.
+// ListGroupsioServicesByStatus may return the following errors:
+//   - "BadRequest" (type *BadRequestError): Bad request
+//   - "InternalServerError" (type *InternalServerError): Internal server error
+//   - "ServiceUnavailable" (type *ServiceUnavailableError): Service unavailable
+//   - error: internal error
+func (c *Client) ListGroupsioServicesByStatus(ctx context.Context, p *ListGroupsioServicesByStatusPayload) (res *GroupsioServiceList, err error) {
+	var ires any
+	ires, err = c.ListGroupsioServicesByStatusEndpoint(ctx, p)
+	if err != nil {
+		return
+	}
+	return ires.(*GroupsioServiceList), nil
+}
+
+// ListUnprovisionedGroupsioServices calls the
+// "list-unprovisioned-groupsio-services" endpoint of the "mailing-list"
+// service.
+// ListUnprovisionedGroupsioServices may return the following errors:
+//   - "BadRequest" (type *BadRequestError): Bad request
+//   - "InternalServerError" (type *InternalServerError): Internal server error
+//   - "ServiceUnavailable" (type *ServiceUnavailableError): Service unavailable
+//   - error: internal error
+func (c *Client) ListUnprovisionedGroupsioServices(ctx context.Context, p *ListUnprovisionedGroupsioServicesPayload) (res *GroupsioServiceList, err error) {
+	var ires any
+	ires, err = c.ListUnprovisionedGroupsioServicesEndpoint(ctx, p)
+	if err != nil {
+		return
+	}
+	return ires.(*GroupsioServiceList), nil
+}
+
 // FindParentGroupsioService calls the "find-parent-groupsio-service" endpoint
 // of the "mailing-list" service.
 // FindParentGroupsioService may return the following errors:
@@ -223,6 +316,40 @@ func (c *Client) ListGroupsioMailingLists(ctx context.Context, p *ListGroupsioMa
 	return ires.(*GroupsioSubgroupList), nil
 }
 
+// ListGroupsioMailingListsByVisibility calls the
+// "list-groupsio-mailing-lists-by-visibility" endpoint of the "mailing-list"
+// service.
+// ListGroupsioMailingListsByVisibility may return the following errors:
+//   - "BadRequest" (type *BadRequestError): Bad request
+//   - "InternalServerError" (type *InternalServerError): Internal server error
+//   - "ServiceUnavailable" (type *ServiceUnavailableError): Service unavailable
+//   - error: internal error
+func (c *Client) ListGroupsioMailingListsByVisibility(ctx context.Context, p *ListGroupsioMailingListsByVisibilityPayload) (res *GroupsioSubgroupList, err error) {
+	var ires any
+	ires, err = c.ListGroupsioMailingListsByVisibilityEndpoint(ctx, p)
+	if err != nil {
+		return
+	}
+	return ires.(*GroupsioSubgroupList), nil
+}
+
+// ListGroupsioMailingListsUpdatedSince calls the
+// "list-groupsio-mailing-lists-updated-since" endpoint of the "mailing-list"
+// service.
+// ListGroupsioMailingListsUpdatedSince may return the following errors:
+//   - "BadRequest" (type *BadRequestError): Bad request
+//   - "InternalServerError" (type *InternalServerError): Internal server error
+//   - "ServiceUnavailable" (type *ServiceUnavailableError): Service unavailable
+//   - error: internal error
+func (c *Client) ListGroupsioMailingListsUpdatedSince(ctx context.Context, p *ListGroupsioMailingListsUpdatedSincePayload) (res *GroupsioSubgroupList, err error) {
+	var ires any
+	ires, err = c.ListGroupsioMailingListsUpdatedSinceEndpoint(ctx, p)
+	if err != nil {
+		return
+	}
+	return ires.(*GroupsioSubgroupList), nil
+}
+
 // CreateGroupsioMailingList calls the "create-groupsio-mailing-list" endpoint
 // of the "mailing-list" service.
 // CreateGroupsioMailingList may return the following errors:
@@ -334,6 +461,94 @@ func (c *Client) ListGroupsioMembers(ctx context.Context, p *ListGroupsioMembers
 	return ires.(*GroupsioMemberList), nil
 }
 
+// ListGroupsioMembersNeedingReview calls the
+// "list-groupsio-members-needing-review" endpoint of the "mailing-list"
+// service.
+// ListGroupsioMembersNeedingReview may return the following errors:
+//   - "BadRequest" (type *BadRequestError): Bad request
+//   - "NotFound" (type *NotFoundError): Subgroup not found
+//   - "InternalServerError" (type *InternalServerError): Internal server error
+//   - "ServiceUnavailable" (type *ServiceUnavailableError): Service unavailable
+//   - error: internal error
+func (c *Client) ListGroupsioMembersNeedingReview(ctx context.Context, p *ListGroupsioMembersNeedingReviewPayload) (res *GroupsioMemberList, err error) {
+	var ires any
+	ires, err = c.ListGroupsioMembersNeedingReviewEndpoint(ctx, p)
+	if err != nil {
+		return
+	}
+	return ires.(*GroupsioMemberList), nil
+}
+
+// ListGroupsioMembersModifiedSince calls the
+// "list-groupsio-members-modified-since" endpoint of the "mailing-list"
+// service.
+// ListGroupsioMembersModifiedSince may return the following errors:
+//   - "BadRequest" (type *BadRequestError): Bad request
+//   - "NotFound" (type *NotFoundError): Subgroup not found
+//   - "InternalServerError" (type *InternalServerError): Internal server error
+//   - "ServiceUnavailable" (type *ServiceUnavailableError): Service unavailable
+//   - error: internal error
+func (c *Client) ListGroupsioMembersModifiedSince(ctx context.Context, p *ListGroupsioMembersModifiedSincePayload) (res *GroupsioMemberList, err error) {
+	var ires any
+	ires, err = c.ListGroupsioMembersModifiedSinceEndpoint(ctx, p)
+	if err != nil {
+		return
+	}
+	return ires.(*GroupsioMemberList), nil
+}
+
+// ListGroupsioMembersByOrganization calls the
+// "list-groupsio-members-by-organization" endpoint of the "mailing-list"
+// service.
+// ListGroupsioMembersByOrganization may return the following errors:
+//   - "BadRequest" (type *BadRequestError): Bad request
+//   - "NotFound" (type *NotFoundError): Subgroup not found
+//   - "InternalServerError" (type *InternalServerError): Internal server error
+//   - "ServiceUnavailable" (type *ServiceUnavailableError): Service unavailable
+//   - error: internal error
+func (c *Client) ListGroupsioMembersByOrganization(ctx context.Context, p *ListGroupsioMembersByOrganizationPayload) (res *GroupsioMemberList, err error) {
+	var ires any
+	ires, err = c.ListGroupsioMembersByOrganizationEndpoint(ctx, p)
+	if err != nil {
+		return
+	}
+	return ires.(*GroupsioMemberList), nil
+}
+
+// ListGroupsioDuplicateMembers calls the "list-groupsio-duplicate-members"
+// endpoint of the "mailing-list" service.
+// ListGroupsioDuplicateMembers may return the following errors:
+//   - "NotFound" (type *NotFoundError): Subgroup not found
+//   - "InternalServerError" (type *InternalServerError): Internal server error
+//   - "ServiceUnavailable" (type *ServiceUnavailableError): Service unavailable
+//   - error: internal error
+func (c *Client) ListGroupsioDuplicateMembers(ctx context.Context, p *ListGroupsioDuplicateMembersPayload) (res *GroupsioMemberDuplicates, err error) {
+	var ires any
+	ires, err = c.ListGroupsioDuplicateMembersEndpoint(ctx, p)
+	if err != nil {
+		return
+	}
+	return ires.(*GroupsioMemberDuplicates), nil
+}
+
+// PreviewGroupsioDeliveryModeChange calls the
+// "preview-groupsio-delivery-mode-change" endpoint of the "mailing-list"
+// service.
+// PreviewGroupsioDeliveryModeChange may return the following errors:
+//   - "BadRequest" (type *BadRequestError): Bad request
+//   - "NotFound" (type *NotFoundError): Subgroup not found
+//   - "InternalServerError" (type *InternalServerError): Internal server error
+//   - "ServiceUnavailable" (type *ServiceUnavailableError): Service unavailable
+//   - error: internal error
+func (c *Client) PreviewGroupsioDeliveryModeChange(ctx context.Context, p *PreviewGroupsioDeliveryModeChangePayload) (res *GroupsioDeliveryModePreview, err error) {
+	var ires any
+	ires, err = c.PreviewGroupsioDeliveryModeChangeEndpoint(ctx, p)
+	if err != nil {
+		return
+	}
+	return ires.(*GroupsioDeliveryModePreview), nil
+}
+
 // AddGroupsioMember calls the "add-groupsio-member" endpoint of the
 // "mailing-list" service.
 // AddGroupsioMember may return the following errors:
@@ -352,6 +567,24 @@ func (c *Client) AddGroupsioMember(ctx context.Context, p *AddGroupsioMemberPayl
 	return ires.(*GroupsioMember), nil
 }
 
+// UpsertGroupsioMember calls the "upsert-groupsio-member" endpoint of the
+// "mailing-list" service.
+// UpsertGroupsioMember may return the following errors:
+//   - "BadRequest" (type *BadRequestError): Bad request
+//   - "NotFound" (type *NotFoundError): Subgroup not found
+//   - "Conflict" (type *ConflictError): Member could not be added or found
+//   - "InternalServerError" (type *InternalServerError): Internal server error
+//   - "ServiceUnavailable" (type *ServiceUnavailableError): Service unavailable
+//   - error: internal error
+func (c *Client) UpsertGroupsioMember(ctx context.Context, p *UpsertGroupsioMemberPayload) (res *GroupsioMemberUpsertResult, err error) {
+	var ires any
+	ires, err = c.UpsertGroupsioMemberEndpoint(ctx, p)
+	if err != nil {
+		return
+	}
+	return ires.(*GroupsioMemberUpsertResult), nil
+}
+
 // GetGroupsioMember calls the "get-groupsio-member" endpoint of the
 // "mailing-list" service.
 // GetGroupsioMember may return the following errors:
@@ -410,6 +643,55 @@ func (c *Client) InviteGroupsioMembers(ctx context.Context, p *InviteGroupsioMem
 	return
 }
 
+// ImportGroupsioMembers calls the "import-groupsio-members" endpoint of the
+// "mailing-list" service.
+// ImportGroupsioMembers may return the following errors:
+//   - "BadRequest" (type *BadRequestError): Bad request
+//   - "NotFound" (type *NotFoundError): Subgroup not found
+//   - "Conflict" (type *ConflictError): Subgroup is frozen
+//   - "InternalServerError" (type *InternalServerError): Internal server error
+//   - "ServiceUnavailable" (type *ServiceUnavailableError): Service unavailable
+//   - error: internal error
+func (c *Client) ImportGroupsioMembers(ctx context.Context, p *ImportGroupsioMembersPayload) (res *GroupsioImportSummary, err error) {
+	var ires any
+	ires, err = c.ImportGroupsioMembersEndpoint(ctx, p)
+	if err != nil {
+		return
+	}
+	return ires.(*GroupsioImportSummary), nil
+}
+
+// MergeGroupsioMembers calls the "merge-groupsio-members" endpoint of the
+// "mailing-list" service.
+// MergeGroupsioMembers may return the following errors:
+//   - "BadRequest" (type *BadRequestError): Bad request
+//   - "NotFound" (type *NotFoundError): Subgroup or member not found
+//   - "InternalServerError" (type *InternalServerError): Internal server error
+//   - "ServiceUnavailable" (type *ServiceUnavailableError): Service unavailable
+//   - error: internal error
+func (c *Client) MergeGroupsioMembers(ctx context.Context, p *MergeGroupsioMembersPayload) (err error) {
+	_, err = c.MergeGroupsioMembersEndpoint(ctx, p)
+	return
+}
+
+// ChangeGroupsioMemberEmail calls the "change-groupsio-member-email" endpoint
+// of the "mailing-list" service.
+// ChangeGroupsioMemberEmail may return the following errors:
+//   - "BadRequest" (type *BadRequestError): Bad request
+//   - "NotFound" (type *NotFoundError): Member not found
+//   - "Conflict" (type *ConflictError): Another member already uses the email
+//   - "InternalServerError" (type *InternalServerError): Internal server error
+//   - "ServiceUnavailable" (type *ServiceUnavailableError): Service unavailable
+//   - error: internal error
+func (c *Client) ChangeGroupsioMemberEmail(ctx context.Context, p *ChangeGroupsioMemberEmailPayload) (res *GroupsioMember, err error) {
+	var ires any
+	ires, err = c.ChangeGroupsioMemberEmailEndpoint(ctx, p)
+	if err != nil {
+		return
+	}
+	return ires.(*GroupsioMember), nil
+}
+
 // CheckGroupsioSubscriber calls the "check-groupsio-subscriber" endpoint of
 // the "mailing-list" service.
 // CheckGroupsioSubscriber may return the following errors:
@@ -457,3 +739,116 @@ func (c *Client) GetGroupsioArtifactDownload(ctx context.Context, p *GetGroupsio
 	}
 	return ires.(*GroupsioArtifactDownload), nil
 }
+
+// GetGroupsioProjectSummary calls the "get-groupsio-project-summary" endpoint
+// of the "mailing-list" service.
+// GetGroupsioProjectSummary may return the following errors:
+//   - "BadRequest" (type *BadRequestError): Bad request
+//   - "InternalServerError" (type *InternalServerError): Internal server error
+//   - "ServiceUnavailable" (type *ServiceUnavailableError): Service unavailable
+//   - error: internal error
+func (c *Client) GetGroupsioProjectSummary(ctx context.Context, p *GetGroupsioProjectSummaryPayload) (res *GroupsioProjectSummary, err error) {
+	var ires any
+	ires, err = c.GetGroupsioProjectSummaryEndpoint(ctx, p)
+	if err != nil {
+		return
+	}
+	return ires.(*GroupsioProjectSummary), nil
+}
+
+// ListGroupsioOrphanedMailingLists calls the
+// "list-groupsio-orphaned-mailing-lists" endpoint of the "mailing-list"
+// service.
+// ListGroupsioOrphanedMailingLists may return the following errors:
+//   - "BadRequest" (type *BadRequestError): Bad request
+//   - "InternalServerError" (type *InternalServerError): Internal server error
+//   - "ServiceUnavailable" (type *ServiceUnavailableError): Service unavailable
+//   - error: internal error
+func (c *Client) ListGroupsioOrphanedMailingLists(ctx context.Context, p *ListGroupsioOrphanedMailingListsPayload) (res *GroupsioSubgroupList, err error) {
+	var ires any
+	ires, err = c.ListGroupsioOrphanedMailingListsEndpoint(ctx, p)
+	if err != nil {
+		return
+	}
+	return ires.(*GroupsioSubgroupList), nil
+}
+
+// RepublishGroupsioProjectIndex calls the "republish-groupsio-project-index"
+// endpoint of the "mailing-list" service.
+// RepublishGroupsioProjectIndex may return the following errors:
+//   - "BadRequest" (type *BadRequestError): Bad request
+//   - "InternalServerError" (type *InternalServerError): Internal server error
+//   - "ServiceUnavailable" (type *ServiceUnavailableError): Service unavailable
+//   - error: internal error
+func (c *Client) RepublishGroupsioProjectIndex(ctx context.Context, p *RepublishGroupsioProjectIndexPayload) (res *GroupsioRepublishSummary, err error) {
+	var ires any
+	ires, err = c.RepublishGroupsioProjectIndexEndpoint(ctx, p)
+	if err != nil {
+		return
+	}
+	return ires.(*GroupsioRepublishSummary), nil
+}
+
+// GetGroupsioMailingListStats calls the "get-groupsio-mailing-list-stats"
+// endpoint of the "mailing-list" service.
+// GetGroupsioMailingListStats may return the following errors:
+//   - "NotFound" (type *NotFoundError): Subgroup not found
+//   - "InternalServerError" (type *InternalServerError): Internal server error
+//   - "ServiceUnavailable" (type *ServiceUnavailableError): Service unavailable
+//   - error: internal error
+func (c *Client) GetGroupsioMailingListStats(ctx context.Context, p *GetGroupsioMailingListStatsPayload) (res *GroupsioSubgroupStats, err error) {
+	var ires any
+	ires, err = c.GetGroupsioMailingListStatsEndpoint(ctx, p)
+	if err != nil {
+		return
+	}
+	return ires.(*GroupsioSubgroupStats), nil
+}
+
+// GetGroupsioServiceTree calls the "get-groupsio-service-tree" endpoint of the
+// "mailing-list" service.
+// GetGroupsioServiceTree may return the following errors:
+//   - "NotFound" (type *NotFoundError): Service not found
+//   - "InternalServerError" (type *InternalServerError): Internal server error
+//   - "ServiceUnavailable" (type *ServiceUnavailableError): Service unavailable
+//   - error: internal error
+func (c *Client) GetGroupsioServiceTree(ctx context.Context, p *GetGroupsioServiceTreePayload) (res *GroupsioServiceTree, err error) {
+	var ires any
+	ires, err = c.GetGroupsioServiceTreeEndpoint(ctx, p)
+	if err != nil {
+		return
+	}
+	return ires.(*GroupsioServiceTree), nil
+}
+
+// GetGroupsioMemberHierarchy calls the "get-groupsio-member-hierarchy"
+// endpoint of the "mailing-list" service.
+// GetGroupsioMemberHierarchy may return the following errors:
+//   - "NotFound" (type *NotFoundError): Member not found
+//   - "InternalServerError" (type *InternalServerError): Internal server error
+//   - "ServiceUnavailable" (type *ServiceUnavailableError): Service unavailable
+//   - error: internal error
+func (c *Client) GetGroupsioMemberHierarchy(ctx context.Context, p *GetGroupsioMemberHierarchyPayload) (res *GroupsioMemberHierarchy, err error) {
+	var ires any
+	ires, err = c.GetGroupsioMemberHierarchyEndpoint(ctx, p)
+	if err != nil {
+		return
+	}
+	return ires.(*GroupsioMemberHierarchy), nil
+}
+
+// ListGroupsioProjectMembers calls the "list-groupsio-project-members"
+// endpoint of the "mailing-list" service.
+// ListGroupsioProjectMembers may return the following errors:
+//   - "BadRequest" (type *BadRequestError): Bad request
+//   - "InternalServerError" (type *InternalServerError): Internal server error
+//   - "ServiceUnavailable" (type *ServiceUnavailableError): Service unavailable
+//   - error: internal error
+func (c *Client) ListGroupsioProjectMembers(ctx context.Context, p *ListGroupsioProjectMembersPayload) (res *GroupsioMemberPage, err error) {
+	var ires any
+	ires, err = c.ListGroupsioProjectMembersEndpoint(ctx, p)
+	if err != nil {
+		return
+	}
+	return ires.(*GroupsioMemberPage), nil
+}
@@ -17,31 +17,52 @@ import (
 
 // Endpoints wraps the "mailing-list" service endpoints.
 type Endpoints struct {
-	Livez                             goa.Endpoint
-	Readyz                            goa.Endpoint
-	ListGroupsioServices              goa.Endpoint
-	CreateGroupsioService             goa.Endpoint
-	GetGroupsioService                goa.Endpoint
-	UpdateGroupsioService             goa.Endpoint
-	DeleteGroupsioService             goa.Endpoint
-	GetGroupsioServiceProjects        goa.Endpoint
-	FindParentGroupsioService         goa.Endpoint
-	ListGroupsioMailingLists          goa.Endpoint
-	CreateGroupsioMailingList         goa.Endpoint
-	GetGroupsioMailingList            goa.Endpoint
-	UpdateGroupsioMailingList         goa.Endpoint
-	DeleteGroupsioMailingList         goa.Endpoint
-	GetGroupsioMailingListCount       goa.Endpoint
-	GetGroupsioMailingListMemberCount goa.Endpoint
-	ListGroupsioMembers               goa.Endpoint
-	AddGroupsioMember                 goa.Endpoint
-	GetGroupsioMember                 goa.Endpoint
-	UpdateGroupsioMember              goa.Endpoint
-	DeleteGroupsioMember              goa.Endpoint
-	InviteGroupsioMembers             goa.Endpoint
-	CheckGroupsioSubscriber           goa.Endpoint
-	GetGroupsioArtifact               goa.Endpoint
-	GetGroupsioArtifactDownload       goa.Endpoint
+	Livez                                goa.Endpoint
+	Readyz                               goa.Endpoint
+	ListGroupsioServices                 goa.Endpoint
+	CreateGroupsioService                goa.Endpoint
+	GetGroupsioService                   goa.Endpoint
+	UpdateGroupsioService                goa.Endpoint
+	PromoteGroupsioService               goa.Endpoint
+	DeleteGroupsioService                goa.Endpoint
+	GetGroupsioServiceProjects           goa.Endpoint
+	ListGroupsioServicesByStatus         goa.Endpoint
+	ListUnprovisionedGroupsioServices    goa.Endpoint
+	FindParentGroupsioService            goa.Endpoint
+	ListGroupsioMailingLists             goa.Endpoint
+	ListGroupsioMailingListsByVisibility goa.Endpoint
+	ListGroupsioMailingListsUpdatedSince goa.Endpoint
+	CreateGroupsioMailingList            goa.Endpoint
+	GetGroupsioMailingList               goa.Endpoint
+	UpdateGroupsioMailingList            goa.Endpoint
+	DeleteGroupsioMailingList            goa.Endpoint
+	GetGroupsioMailingListCount          goa.Endpoint
+	GetGroupsioMailingListMemberCount    goa.Endpoint
+	ListGroupsioMembers                  goa.Endpoint
+	ListGroupsioMembersNeedingReview     goa.Endpoint
+	ListGroupsioMembersModifiedSince     goa.Endpoint
+	ListGroupsioMembersByOrganization    goa.Endpoint
+	ListGroupsioDuplicateMembers         goa.Endpoint
+	PreviewGroupsioDeliveryModeChange    goa.Endpoint
+	AddGroupsioMember                    goa.Endpoint
+	UpsertGroupsioMember                 goa.Endpoint
+	GetGroupsioMember                    goa.Endpoint
+	UpdateGroupsioMember                 goa.Endpoint
+	DeleteGroupsioMember                 goa.Endpoint
+	InviteGroupsioMembers                goa.Endpoint
+	ImportGroupsioMembers                goa.Endpoint
+	MergeGroupsioMembers                 goa.Endpoint
+	ChangeGroupsioMemberEmail            goa.Endpoint
+	CheckGroupsioSubscriber              goa.Endpoint
+	GetGroupsioArtifact                  goa.Endpoint
+	GetGroupsioArtifactDownload          goa.Endpoint
+	GetGroupsioProjectSummary            goa.Endpoint
+	ListGroupsioOrphanedMailingLists     goa.Endpoint
+	RepublishGroupsioProjectIndex        goa.Endpoint
+	GetGroupsioMailingListStats          goa.Endpoint
+	GetGroupsioServiceTree               goa.Endpoint
+	GetGroupsioMemberHierarchy           goa.Endpoint
+	ListGroupsioProjectMembers           goa.Endpoint
 }
 
 // NewEndpoints wraps the methods of the "mailing-list" service with endpoints.
@@ -49,31 +70,52 @@ func NewEndpoints(s Service) *Endpoints {
 	// Casting service to Auther interface
 	a := s.(Auther)
 	return &Endpoints{
-		Livez:                             NewLivezEndpoint(s),
-		Readyz:                            NewReadyzEndpoint(s),
-		ListGroupsioServices:              NewListGroupsioServicesEndpoint(s, a.JWTAuth),
-		CreateGroupsioService:             NewCreateGroupsioServiceEndpoint(s, a.JWTAuth),
-		GetGroupsioService:                NewGetGroupsioServiceEndpoint(s, a.JWTAuth),
-		UpdateGroupsioService:             NewUpdateGroupsioServiceEndpoint(s, a.JWTAuth),
-		DeleteGroupsioService:             NewDeleteGroupsioServiceEndpoint(s, a.JWTAuth),
-		GetGroupsioServiceProjects:        NewGetGroupsioServiceProjectsEndpoint(s, a.JWTAuth),
-		FindParentGroupsioService:         NewFindParentGroupsioServiceEndpoint(s, a.JWTAuth),
-		ListGroupsioMailingLists:          NewListGroupsioMailingListsEndpoint(s, a.JWTAuth),
-		CreateGroupsioMailingList:         NewCreateGroupsioMailingListEndpoint(s, a.JWTAuth),
-		GetGroupsioMailingList:            NewGetGroupsioMailingListEndpoint(s, a.JWTAuth),
-		UpdateGroupsioMailingList:         NewUpdateGroupsioMailingListEndpoint(s, a.JWTAuth),
-		DeleteGroupsioMailingList:         NewDeleteGroupsioMailingListEndpoint(s, a.JWTAuth),
-		GetGroupsioMailingListCount:       NewGetGroupsioMailingListCountEndpoint(s, a.JWTAuth),
-		GetGroupsioMailingListMemberCount: NewGetGroupsioMailingListMemberCountEndpoint(s, a.JWTAuth),
-		ListGroupsioMembers:               NewListGroupsioMembersEndpoint(s, a.JWTAuth),
-		AddGroupsioMember:                 NewAddGroupsioMemberEndpoint(s, a.JWTAuth),
-		GetGroupsioMember:                 NewGetGroupsioMemberEndpoint(s, a.JWTAuth),
-		UpdateGroupsioMember:              NewUpdateGroupsioMemberEndpoint(s, a.JWTAuth),
-		DeleteGroupsioMember:              NewDeleteGroupsioMemberEndpoint(s, a.JWTAuth),
-		InviteGroupsioMembers:             NewInviteGroupsioMembersEndpoint(s, a.JWTAuth),
-		CheckGroupsioSubscriber:           NewCheckGroupsioSubscriberEndpoint(s, a.JWTAuth),
-		GetGroupsioArtifact:               NewGetGroupsioArtifactEndpoint(s, a.JWTAuth),
-		GetGroupsioArtifactDownload:       NewGetGroupsioArtifactDownloadEndpoint(s, a.JWTAuth),
+		Livez:                                NewLivezEndpoint(s),
+		Readyz:                               NewReadyzEndpoint(s),
+		ListGroupsioServices:                 NewListGroupsioServicesEndpoint(s, a.JWTAuth),
+		CreateGroupsioService:                NewCreateGroupsioServiceEndpoint(s, a.JWTAuth),
+		GetGroupsioService:                   NewGetGroupsioServiceEndpoint(s, a.JWTAuth),
+		UpdateGroupsioService:                NewUpdateGroupsioServiceEndpoint(s, a.JWTAuth),
+		PromoteGroupsioService:               NewPromoteGroupsioServiceEndpoint(s, a.JWTAuth),
+		DeleteGroupsioService:                NewDeleteGroupsioServiceEndpoint(s, a.JWTAuth),
+		GetGroupsioServiceProjects:           NewGetGroupsioServiceProjectsEndpoint(s, a.JWTAuth),
+		ListGroupsioServicesByStatus:         NewListGroupsioServicesByStatusEndpoint(s, a.JWTAuth),
+		ListUnprovisionedGroupsioServices:    NewListUnprovisionedGroupsioServicesEndpoint(s, a.JWTAuth),
+		FindParentGroupsioService:            NewFindParentGroupsioServiceEndpoint(s, a.JWTAuth),
+		ListGroupsioMailingLists:             NewListGroupsioMailingListsEndpoint(s, a.JWTAuth),
+		ListGroupsioMailingListsByVisibility: NewListGroupsioMailingListsByVisibilityEndpoint(s, a.JWTAuth),
+		ListGroupsioMailingListsUpdatedSince: NewListGroupsioMailingListsUpdatedSinceEndpoint(s, a.JWTAuth),
+		CreateGroupsioMailingList:            NewCreateGroupsioMailingListEndpoint(s, a.JWTAuth),
+		GetGroupsioMailingList:               NewGetGroupsioMailingListEndpoint(s, a.JWTAuth),
+		UpdateGroupsioMailingList:            NewUpdateGroupsioMailingListEndpoint(s, a.JWTAuth),
+		DeleteGroupsioMailingList:            NewDeleteGroupsioMailingListEndpoint(s, a.JWTAuth),
+		GetGroupsioMailingListCount:          NewGetGroupsioMailingListCountEndpoint(s, a.JWTAuth),
+		GetGroupsioMailingListMemberCount:    NewGetGroupsioMailingListMemberCountEndpoint(s, a.JWTAuth),
+		ListGroupsioMembers:                  NewListGroupsioMembersEndpoint(s, a.JWTAuth),
+		ListGroupsioMembersNeedingReview:     NewListGroupsioMembersNeedingReviewEndpoint(s, a.JWTAuth),
+		ListGroupsioMembersModifiedSince:     NewListGroupsioMembersModifiedSinceEndpoint(s, a.JWTAuth),
+		ListGroupsioMembersByOrganization:    NewListGroupsioMembersByOrganizationEndpoint(s, a.JWTAuth),
+		ListGroupsioDuplicateMembers:         NewListGroupsioDuplicateMembersEndpoint(s, a.JWTAuth),
+		PreviewGroupsioDeliveryModeChange:    NewPreviewGroupsioDeliveryModeChangeEndpoint(s, a.JWTAuth),
+		AddGroupsioMember:                    NewAddGroupsioMemberEndpoint(s, a.JWTAuth),
+		UpsertGroupsioMember:                 NewUpsertGroupsioMemberEndpoint(s, a.JWTAuth),
+		GetGroupsioMember:                    NewGetGroupsioMemberEndpoint(s, a.JWTAuth),
+		UpdateGroupsioMember:                 NewUpdateGroupsioMemberEndpoint(s, a.JWTAuth),
+		DeleteGroupsioMember:                 NewDeleteGroupsioMemberEndpoint(s, a.JWTAuth),
+		InviteGroupsioMembers:                NewInviteGroupsioMembersEndpoint(s, a.JWTAuth),
+		ImportGroupsioMembers:                NewImportGroupsioMembersEndpoint(s, a.JWTAuth),
+		MergeGroupsioMembers:                 NewMergeGroupsioMembersEndpoint(s, a.JWTAuth),
+		ChangeGroupsioMemberEmail:            NewChangeGroupsioMemberEmailEndpoint(s, a.JWTAuth),
+		CheckGroupsioSubscriber:              NewCheckGroupsioSubscriberEndpoint(s, a.JWTAuth),
+		GetGroupsioArtifact:                  NewGetGroupsioArtifactEndpoint(s, a.JWTAuth),
+		GetGroupsioArtifactDownload:          NewGetGroupsioArtifactDownloadEndpoint(s, a.JWTAuth),
+		GetGroupsioProjectSummary:            NewGetGroupsioProjectSummaryEndpoint(s, a.JWTAuth),
+		ListGroupsioOrphanedMailingLists:     NewListGroupsioOrphanedMailingListsEndpoint(s, a.JWTAuth),
+		RepublishGroupsioProjectIndex:        NewRepublishGroupsioProjectIndexEndpoint(s, a.JWTAuth),
+		GetGroupsioMailingListStats:          NewGetGroupsioMailingListStatsEndpoint(s, a.JWTAuth),
+		GetGroupsioServiceTree:               NewGetGroupsioServiceTreeEndpoint(s, a.JWTAuth),
+		GetGroupsioMemberHierarchy:           NewGetGroupsioMemberHierarchyEndpoint(s, a.JWTAuth),
+		ListGroupsioProjectMembers:           NewListGroupsioProjectMembersEndpoint(s, a.JWTAuth),
 	}
 }
 
@@ -85,10 +127,15 @@ func (e *Endpoints) Use(m func(goa.Endpoint) goa.Endpoint) {
 	e.CreateGroupsioService = m(e.CreateGroupsioService)
 	e.GetGroupsioService = m(e.GetGroupsioService)
 	e.UpdateGroupsioService = m(e.UpdateGroupsioService)
+	e.PromoteGroupsioService = m(e.PromoteGroupsioService)
 	e.DeleteGroupsioService = m(e.DeleteGroupsioService)
 	e.GetGroupsioServiceProjects = m(e.GetGroupsioServiceProjects)
+	e.ListGroupsioServicesByStatus = m(e.ListGroupsioServicesByStatus)
+	e.ListUnprovisionedGroupsioServices = m(e.ListUnprovisionedGroupsioServices)
 	e.FindParentGroupsioService = m(e.FindParentGroupsioService)
 	e.ListGroupsioMailingLists = m(e.ListGroupsioMailingLists)
+	e.ListGroupsioMailingListsByVisibility = m(e.ListGroupsioMailingListsByVisibility)
+	e.ListGroupsioMailingListsUpdatedSince = m(e.ListGroupsioMailingListsUpdatedSince)
 	e.CreateGroupsioMailingList = m(e.CreateGroupsioMailingList)
 	e.GetGroupsioMailingList = m(e.GetGroupsioMailingList)
 	e.UpdateGroupsioMailingList = m(e.UpdateGroupsioMailingList)
@@ -96,14 +143,30 @@ func (e *Endpoints) Use(m func(goa.Endpoint) goa.Endpoint) {
 	e.GetGroupsioMailingListCount = m(e.GetGroupsioMailingListCount)
 	e.GetGroupsioMailingListMemberCount = m(e.GetGroupsioMailingListMemberCount)
 	e.ListGroupsioMembers = m(e.ListGroupsioMembers)
+	e.ListGroupsioMembersNeedingReview = m(e.ListGroupsioMembersNeedingReview)
+	e.ListGroupsioMembersModifiedSince = m(e.ListGroupsioMembersModifiedSince)
+	e.ListGroupsioMembersByOrganization = m(e.ListGroupsioMembersByOrganization)
+	e.ListGroupsioDuplicateMembers = m(e.ListGroupsioDuplicateMembers)
+	e.PreviewGroupsioDeliveryModeChange = m(e.PreviewGroupsioDeliveryModeChange)
 	e.AddGroupsioMember = m(e.AddGroupsioMember)
+	e.UpsertGroupsioMember = m(e.UpsertGroupsioMember)
 	e.GetGroupsioMember = m(e.GetGroupsioMember)
 	e.UpdateGroupsioMember = m(e.UpdateGroupsioMember)
 	e.DeleteGroupsioMember = m(e.DeleteGroupsioMember)
 	e.InviteGroupsioMembers = m(e.InviteGroupsioMembers)
+	e.ImportGroupsioMembers = m(e.ImportGroupsioMembers)
+	e.MergeGroupsioMembers = m(e.MergeGroupsioMembers)
+	e.ChangeGroupsioMemberEmail = m(e.ChangeGroupsioMemberEmail)
 	e.CheckGroupsioSubscriber = m(e.CheckGroupsioSubscriber)
 	e.GetGroupsioArtifact = m(e.GetGroupsioArtifact)
 	e.GetGroupsioArtifactDownload = m(e.GetGroupsioArtifactDownload)
+	e.GetGroupsioProjectSummary = m(e.GetGroupsioProjectSummary)
+	e.ListGroupsioOrphanedMailingLists = m(e.ListGroupsioOrphanedMailingLists)
+	e.RepublishGroupsioProjectIndex = m(e.RepublishGroupsioProjectIndex)
+	e.GetGroupsioMailingListStats = m(e.GetGroupsioMailingListStats)
+	e.GetGroupsioServiceTree = m(e.GetGroupsioServiceTree)
+	e.GetGroupsioMemberHierarchy = m(e.GetGroupsioMemberHierarchy)
+	e.ListGroupsioProjectMembers = m(e.ListGroupsioProjectMembers)
 }
 
 // NewLivezEndpoint returns an endpoint function that calls the method "livez"
@@ -214,6 +277,29 @@ func NewUpdateGroupsioServiceEndpoint(s Service, authJWTFn security.AuthJWTFunc)
 	}
 }
 
+// NewPromoteGroupsioServiceEndpoint returns an endpoint function that calls
+// the method "promote-groupsio-service" of service "mailing-list".
+func NewPromoteGroupsioServiceEndpoint(s Service, authJWTFn security.AuthJWTFunc) goa.Endpoint {
+	return func(ctx context.Context, req any) (any, error) {
+		p := req.(*PromoteGroupsioServicePayload)
+		var err error
+		sc := security.JWTScheme{
+			Name:           "jwt",
+			Scopes:         []string{},
+			RequiredScopes: []string{},
+		}
+		var token string
+		if p.BearerToken != nil {
+			token = *p.BearerToken
+		}
+		ctx, err = authJWTFn(ctx, token, &sc)
+		if err != nil {
+			return nil, err
+		}
+		return s.PromoteGroupsioService(ctx, p)
+	}
+}
+
 // NewDeleteGroupsioServiceEndpoint returns an endpoint function that calls the
 // method "delete-groupsio-service" of service "mailing-list".
 func NewDeleteGroupsioServiceEndpoint(s Service, authJWTFn security.AuthJWTFunc) goa.Endpoint {
@@ -260,6 +346,54 @@ func NewGetGroupsioServiceProjectsEndpoint(s Service, authJWTFn security.AuthJWT
 	}
 }
 
+// NewListGroupsioServicesByStatusEndpoint returns an endpoint function that
+// calls the method "list-groupsio-services-by-status" of service
+// "mailing-list".
+func NewListGroupsioServicesByStatusEndpoint(s Service, authJWTFn security.AuthJWTFunc) goa.Endpoint {
+	return func(ctx context.Context, req any) (any, error) {
+		p := req.(*ListGroupsioServicesByStatusPayload)
+		var err error
+		sc := security.JWTScheme{
+			Name:           "jwt",
+			Scopes:         []string{},
+			RequiredScopes: []string{},
+		}
+		var token string
+		if p.BearerToken != nil {
+			token = *p.BearerToken
+		}
+		ctx, err = authJWTFn(ctx, token, &sc)
+		if err != nil {
+			return nil, err
+		}
+		return s.ListGroupsioServicesByStatus(ctx, p)
+	}
+}
+
+// NewListUnprovisionedGroupsioServicesEndpoint returns an endpoint function
+// that calls the method "list-unprovisioned-groupsio-services" of service
+// "mailing-list".
+func NewListUnprovisionedGroupsioServicesEndpoint(s Service, authJWTFn security.AuthJWTFunc) goa.Endpoint {
+	return func(ctx context.Context, req any) (any, error) {
+		p := req.(*ListUnprovisionedGroupsioServicesPayload)
+		var err error
+		sc := security.JWTScheme{
+			Name:           "jwt",
+			Scopes:         []string{},
+			RequiredScopes: []string{},
+		}
+		var token string
+		if p.BearerToken != nil {
+			token = *p.BearerToken
+		}
+		ctx, err = authJWTFn(ctx, token, &sc)
+		if err != nil {
+			return nil, err
+		}
+		return s.ListUnprovisionedGroupsioServices(ctx, p)
+	}
+}
+
 // NewFindParentGroupsioServiceEndpoint returns an endpoint function that calls
 // the method "find-parent-groupsio-service" of service "mailing-list".
 func NewFindParentGroupsioServiceEndpoint(s Service, authJWTFn security.AuthJWTFunc) goa.Endpoint {
@@ -306,6 +440,54 @@ func NewListGroupsioMailingListsEndpoint(s Service, authJWTFn security.AuthJWTFu
 	}
 }
 
+// NewListGroupsioMailingListsByVisibilityEndpoint returns an endpoint function
+// that calls the method "list-groupsio-mailing-lists-by-visibility" of service
+// "mailing-list".
+func NewListGroupsioMailingListsByVisibilityEndpoint(s Service, authJWTFn security.AuthJWTFunc) goa.Endpoint {
+	return func(ctx context.Context, req any) (any, error) {
+		p := req.(*ListGroupsioMailingListsByVisibilityPayload)
+		var err error
+		sc := security.JWTScheme{
+			Name:           "jwt",
+			Scopes:         []string{},
+			RequiredScopes: []string{},
+		}
+		var token string
+		if p.BearerToken != nil {
+			token = *p.BearerToken
+		}
+		ctx, err = authJWTFn(ctx, token, &sc)
+		if err != nil {
+			return nil, err
+		}
+		return s.ListGroupsioMailingListsByVisibility(ctx, p)
+	}
+}
+
+// NewListGroupsioMailingListsUpdatedSinceEndpoint returns an endpoint function
+// that calls the method "list-groupsio-mailing-lists-updated-since" of service
+// "mailing-list".
+func NewListGroupsioMailingListsUpdatedSinceEndpoint(s Service, authJWTFn security.AuthJWTFunc) goa.Endpoint {
+	return func(ctx context.Context, req any) (any, error) {
+		p := req.(*ListGroupsioMailingListsUpdatedSincePayload)
+		var err error
+		sc := security.JWTScheme{
+			Name:           "jwt",
+			Scopes:         []string{},
+			RequiredScopes: []string{},
+		}
+		var token string
+		if p.BearerToken != nil {
+			token = *p.BearerToken
+		}
+		ctx, err = authJWTFn(ctx, token, &sc)
+		if err != nil {
+			return nil, err
+		}
+		return s.ListGroupsioMailingListsUpdatedSince(ctx, p)
+	}
+}
+
 // NewCreateGroupsioMailingListEndpoint returns an endpoint function that calls
 // the method "create-groupsio-mailing-list" of service "mailing-list".
 func NewCreateGroupsioMailingListEndpoint(s Service, authJWTFn security.AuthJWTFunc) goa.Endpoint {
@@ -468,6 +650,125 @@ func NewListGroupsioMembersEndpoint(s Service, authJWTFn security.AuthJWTFunc) g
 	}
 }
 
+// NewListGroupsioMembersNeedingReviewEndpoint returns an endpoint function
+// that calls the method "list-groupsio-members-needing-review" of service
+// "mailing-list".
+func NewListGroupsioMembersNeedingReviewEndpoint(s Service, authJWTFn security.AuthJWTFunc) goa.Endpoint {
+	return func(ctx context.Context, req any) (any, error) {
+		p := req.(*ListGroupsioMembersNeedingReviewPayload)
+		var err error
+		sc := security.JWTScheme{
+			Name:           "jwt",
+			Scopes:         []string{},
+			RequiredScopes: []string{},
+		}
+		var token string
+		if p.BearerToken != nil {
+			token = *p.BearerToken
+		}
+		ctx, err = authJWTFn(ctx, token, &sc)
+		if err != nil {
+			return nil, err
+		}
+		return s.ListGroupsioMembersNeedingReview(ctx, p)
+	}
+}
+
+// NewListGroupsioMembersModifiedSinceEndpoint returns an endpoint function
+// that calls the method "list-groupsio-members-modified-since" of service
+// "mailing-list".
+func NewListGroupsioMembersModifiedSinceEndpoint(s Service, authJWTFn security.AuthJWTFunc) goa.Endpoint {
+	return func(ctx context.Context, req any) (any, error) {
+		p := req.(*ListGroupsioMembersModifiedSincePayload)
+		var err error
+		sc := security.JWTScheme{
+			Name:           "jwt",
+			Scopes:         []string{},
+			RequiredScopes: []string{},
+		}
+		var token string
+		if p.BearerToken != nil {
+			token = *p.BearerToken
+		}
+		ctx, err = authJWTFn(ctx, token, &sc)
+		if err != nil {
+			return nil, err
+		}
+		return s.ListGroupsioMembersModifiedSince(ctx, p)
+	}
+}
+
+// NewListGroupsioMembersByOrganizationEndpoint returns an endpoint function
+// that calls the method "list-groupsio-members-by-organization" of service
+// "mailing-list".
+func NewListGroupsioMembersByOrganizationEndpoint(s Service, authJWTFn security.AuthJWTFunc) goa.Endpoint {
+	return func(ctx context.Context, req any) (any, error) {
+		p := req.(*ListGroupsioMembersByOrganizationPayload)
+		var err error
+		sc := security.JWTScheme{
+			Name:           "jwt",
+			Scopes:         []string{},
+			RequiredScopes: []string{},
+		}
+		var token string
+		if p.BearerToken != nil {
+			token = *p.BearerToken
+		}
+		ctx, err = authJWTFn(ctx, token, &sc)
+		if err != nil {
+			return nil, err
+		}
+		return s.ListGroupsioMembersByOrganization(ctx, p)
+	}
+}
+
+// NewListGroupsioDuplicateMembersEndpoint returns an endpoint function that
+// calls the method "list-groupsio-duplicate-members" of service "mailing-list".
+func NewListGroupsioDuplicateMembersEndpoint(s Service, authJWTFn security.AuthJWTFunc) goa.Endpoint {
+	return func(ctx context.Context, req any) (any, error) {
+		p := req.(*ListGroupsioDuplicateMembersPayload)
+		var err error
+		sc := security.JWTScheme{
+			Name:           "jwt",
+			Scopes:         []string{},
+			RequiredScopes: []string{},
+		}
+		var token string
+		if p.BearerToken != nil {
+			token = *p.BearerToken
+		}
+		ctx, err = authJWTFn(ctx, token, &sc)
+		if err != nil {
+			return nil, err
+		}
+		return s.ListGroupsioDuplicateMembers(ctx, p)
+	}
+}
+
+// NewPreviewGroupsioDeliveryModeChangeEndpoint returns an endpoint function
+// that calls the method "preview-groupsio-delivery-mode-change" of service
+// "mailing-list".
+func NewPreviewGroupsioDeliveryModeChangeEndpoint(s Service, authJWTFn security.AuthJWTFunc) goa.Endpoint {
+	return func(ctx context.Context, req any) (any, error) {
+		p := req.(*PreviewGroupsioDeliveryModeChangePayload)
+		var err error
+		sc := security.JWTScheme{
+			Name:           "jwt",
+			Scopes:         []string{},
+			RequiredScopes: []string{},
+		}
+		var token string
+		if p.BearerToken != nil {
+			token = *p.BearerToken
+		}
+		ctx, err = authJWTFn(ctx, token, &sc)
+		if err != nil {
+			return nil, err
+		}
+		return s.PreviewGroupsioDeliveryModeChange(ctx, p)
+	}
+}
+
 // NewAddGroupsioMemberEndpoint returns an endpoint function that calls the
 // method "add-groupsio-member" of service "mailing-list".
 func NewAddGroupsioMemberEndpoint(s Service, authJWTFn security.AuthJWTFunc) goa.Endpoint {
@@ -491,6 +792,29 @@ func NewAddGroupsioMemberEndpoint(s Service, authJWTFn security.AuthJWTFunc) goa
 	}
 }
 
+// NewUpsertGroupsioMemberEndpoint returns an endpoint function that calls the
+// method "upsert-groupsio-member" of service "mailing-list".
+func NewUpsertGroupsioMemberEndpoint(s Service, authJWTFn security.AuthJWTFunc) goa.Endpoint {
+	return func(ctx context.Context, req any) (any, error) {
+		p := req.(*UpsertGroupsioMemberPayload)
+		var err error
+		sc := security.JWTScheme{
+			Name:           "jwt",
+			Scopes:         []string{},
+			RequiredScopes: []string{},
+		}
+		var token string
+		if p.BearerToken != nil {
+			token = *p.BearerToken
+		}
+		ctx, err = authJWTFn(ctx, token, &sc)
+		if err != nil {
+			return nil, err
+		}
+		return s.UpsertGroupsioMember(ctx, p)
+	}
+}
+
 // NewGetGroupsioMemberEndpoint returns an endpoint function that calls the
 // method "get-groupsio-member" of service "mailing-list".
 func NewGetGroupsioMemberEndpoint(s Service, authJWTFn security.AuthJWTFunc) goa.Endpoint {
@@ -583,6 +907,75 @@ func NewInviteGroupsioMembersEndpoint(s Service, authJWTFn security.AuthJWTFunc)
 	}
 }
 
+// NewImportGroupsioMembersEndpoint returns an endpoint function that calls the
+// method "import-groupsio-members" of service "mailing-list".
+func NewImportGroupsioMembersEndpoint(s Service, authJWTFn security.AuthJWTFunc) goa.Endpoint {
+	return func(ctx context.Context, req any) (any, error) {
+		p := req.(*ImportGroupsioMembersPayload)
+		var err error
+		sc := security.JWTScheme{
+			Name:           "jwt",
+			Scopes:         []string{},
+			RequiredScopes: []string{},
+		}
+		var token string
+		if p.BearerToken != nil {
+			token = *p.BearerToken
+		}
+		ctx, err = authJWTFn(ctx, token, &sc)
+		if err != nil {
+			return nil, err
+		}
+		return s.ImportGroupsioMembers(ctx, p)
+	}
+}
+
+// NewMergeGroupsioMembersEndpoint returns an endpoint function that calls the
+// method "merge-groupsio-members" of service "mailing-list".
+func NewMergeGroupsioMembersEndpoint(s Service, authJWTFn security.AuthJWTFunc) goa.Endpoint {
+	return func(ctx context.Context, req any) (any, error) {
+		p := req.(*MergeGroupsioMembersPayload)
+		var err error
+		sc := security.JWTScheme{
+			Name:           "jwt",
+			Scopes:         []string{},
+			RequiredScopes: []string{},
+		}
+		var token string
+		if p.BearerToken != nil {
+			token = *p.BearerToken
+		}
+		ctx, err = authJWTFn(ctx, token, &sc)
+		if err != nil {
+			return nil, err
+		}
+		return nil, s.MergeGroupsioMembers(ctx, p)
+	}
+}
+
+// NewChangeGroupsioMemberEmailEndpoint returns an endpoint function that calls
+// the method "change-groupsio-member-email" of service "mailing-list".
+func NewChangeGroupsioMemberEmailEndpoint(s Service, authJWTFn security.AuthJWTFunc) goa.Endpoint {
+	return func(ctx context.Context, req any) (any, error) {
+		p := req.(*ChangeGroupsioMemberEmailPayload)
+		var err error
+		sc := security.JWTScheme{
+			Name:           "jwt",
+			Scopes:         []string{},
+			RequiredScopes: []string{},
+		}
+		var token string
+		if p.BearerToken != nil {
+			token = *p.BearerToken
+		}
+		ctx, err = authJWTFn(ctx, token, &sc)
+		if err != nil {
+			return nil, err
+		}
+		return s.ChangeGroupsioMemberEmail(ctx, p)
+	}
+}
+
 // NewCheckGroupsioSubscriberEndpoint returns an endpoint function that calls
 // the method "check-groupsio-subscriber" of service "mailing-list".
 func NewCheckGroupsioSubscriberEndpoint(s Service, authJWTFn security.AuthJWTFunc) goa.Endpoint {
@@ -651,3 +1044,166 @@ func NewGetGroupsioArtifactDownloadEndpoint(s Service, authJWTFn security.AuthJW
 		return s.GetGroupsioArtifactDownload(ctx, p)
 	}
 }
+
+// NewGetGroupsioProjectSummaryEndpoint returns an endpoint function that calls
+// the method "get-groupsio-project-summary" of service "mailing-list".
+func NewGetGroupsioProjectSummaryEndpoint(s Service, authJWTFn security.AuthJWTFunc) goa.Endpoint {
+	return func(ctx context.Context, req any) (any, error) {
+		p := req.(*GetGroupsioProjectSummaryPayload)
+		var err error
+		sc := security.JWTScheme{
+			Name:           "jwt",
+			Scopes:         []string{},
+			RequiredScopes: []string{},
+		}
+		var token string
+		if p.BearerToken != nil {
+			token = *p.BearerToken
+		}
+		ctx, err = authJWTFn(ctx, token, &sc)
+		if err != nil {
+			return nil, err
+		}
+		return s.GetGroupsioProjectSummary(ctx, p)
+	}
+}
+
+// NewListGroupsioOrphanedMailingListsEndpoint returns an endpoint function
+// that calls the method "list-groupsio-orphaned-mailing-lists" of service
+// "mailing-list".
+func NewListGroupsioOrphanedMailingListsEndpoint(s Service, authJWTFn security.AuthJWTFunc) goa.Endpoint {
+	return func(ctx context.Context, req any) (any, error) {
+		p := req.(*ListGroupsioOrphanedMailingListsPayload)
+		var err error
+		sc := security.JWTScheme{
+			Name:           "jwt",
+			Scopes:         []string{},
+			RequiredScopes: []string{},
+		}
+		var token string
+		if p.BearerToken != nil {
+			token = *p.BearerToken
+		}
+		ctx, err = authJWTFn(ctx, token, &sc)
+		if err != nil {
+			return nil, err
+		}
+		return s.ListGroupsioOrphanedMailingLists(ctx, p)
+	}
+}
+
+// NewRepublishGroupsioProjectIndexEndpoint returns an endpoint function that
+// calls the method "republish-groupsio-project-index" of service
+// "mailing-list".
+func NewRepublishGroupsioProjectIndexEndpoint(s Service, authJWTFn security.AuthJWTFunc) goa.Endpoint {
+	return func(ctx context.Context, req any) (any, error) {
+		p := req.(*RepublishGroupsioProjectIndexPayload)
+		var err error
+		sc := security.JWTScheme{
+			Name:           "jwt",
+			Scopes:         []string{},
+			RequiredScopes: []string{},
+		}
+		var token string
+		if p.BearerToken != nil {
+			token = *p.BearerToken
+		}
+		ctx, err = authJWTFn(ctx, token, &sc)
+		if err != nil {
+			return nil, err
+		}
+		return s.RepublishGroupsioProjectIndex(ctx, p)
+	}
+}
+
+// NewGetGroupsioMailingListStatsEndpoint returns an endpoint function that
+// calls the method "get-groupsio-mailing-list-stats" of service "mailing-list".
+func NewGetGroupsioMailingListStatsEndpoint(s Service, authJWTFn security.AuthJWTFunc) goa.Endpoint {
+	return func(ctx context.Context, req any) (any, error) {
+		p := req.(*GetGroupsioMailingListStatsPayload)
+		var err error
+		sc := security.JWTScheme{
+			Name:           "jwt",
+			Scopes:         []string{},
+			RequiredScopes: []string{},
+		}
+		var token string
+		if p.BearerToken != nil {
+			token = *p.BearerToken
+		}
+		ctx, err = authJWTFn(ctx, token, &sc)
+		if err != nil {
+			return nil, err
+		}
+		return s.GetGroupsioMailingListStats(ctx, p)
+	}
+}
+
+// NewGetGroupsioServiceTreeEndpoint returns an endpoint function that calls
+// the method "get-groupsio-service-tree" of service "mailing-list".
+func NewGetGroupsioServiceTreeEndpoint(s Service, authJWTFn security.AuthJWTFunc) goa.Endpoint {
+	return func(ctx context.Context, req any) (any, error) {
+		p := req.(*GetGroupsioServiceTreePayload)
+		var err error
+		sc := security.JWTScheme{
+			Name:           "jwt",
+			Scopes:         []string{},
+			RequiredScopes: []string{},
+		}
+		var token string
+		if p.BearerToken != nil {
+			token = *p.BearerToken
+		}
+		ctx, err = authJWTFn(ctx, token, &sc)
+		if err != nil {
+			return nil, err
+		}
+		return s.GetGroupsioServiceTree(ctx, p)
+	}
+}
+
+// NewGetGroupsioMemberHierarchyEndpoint returns an endpoint function that
+// calls the method "get-groupsio-member-hierarchy" of service "mailing-list".
+func NewGetGroupsioMemberHierarchyEndpoint(s Service, authJWTFn security.AuthJWTFunc) goa.Endpoint {
+	return func(ctx context.Context, req any) (any, error) {
+		p := req.(*GetGroupsioMemberHierarchyPayload)
+		var err error
+		sc := security.JWTScheme{
+			Name:           "jwt",
+			Scopes:         []string{},
+			RequiredScopes: []string{},
+		}
+		var token string
+		if p.BearerToken != nil {
+			token = *p.BearerToken
+		}
+		ctx, err = authJWTFn(ctx, token, &sc)
+		if err != nil {
+			return nil, err
+		}
+		return s.GetGroupsioMemberHierarchy(ctx, p)
+	}
+}
+
+// NewListGroupsioProjectMembersEndpoint returns an endpoint function that
+// calls the method "list-groupsio-project-members" of service "mailing-list".
+func NewListGroupsioProjectMembersEndpoint(s Service, authJWTFn security.AuthJWTFunc) goa.Endpoint {
+	return func(ctx context.Context, req any) (any, error) {
+		p := req.(*ListGroupsioProjectMembersPayload)
+		var err error
+		sc := security.JWTScheme{
+			Name:           "jwt",
+			Scopes:         []string{},
+			RequiredScopes: []string{},
+		}
+		var token string
+		if p.BearerToken != nil {
+			token = *p.BearerToken
+		}
+		ctx, err = authJWTFn(ctx, token, &sc)
+		if err != nil {
+			return nil, err
+		}
+		return s.ListGroupsioProjectMembers(ctx, p)
+	}
+}
@@ -18,8 +18,10 @@ import (
 type Service interface {
 	// Check if the service is alive.
 	Livez(context.Context) (res []byte, err error)
-	// Check if the service is able to take inbound requests.
-	Readyz(context.Context) (res []byte, err error)
+	// Check if the service is able to take inbound requests. Reports the status of
+	// each dependency; non-critical dependencies being down degrade the status
+	// without failing the probe.
+	Readyz(context.Context) (res *Readiness, err error)
 	// List GroupsIO services, optionally filtered by project UID
 	ListGroupsioServices(context.Context, *ListGroupsioServicesPayload) (res *GroupsioServiceList, err error)
 	// Create a GroupsIO service
@@ -28,15 +30,27 @@ type Service interface {
 	GetGroupsioService(context.Context, *GetGroupsioServicePayload) (res *GroupsioService, err error)
 	// Update a GroupsIO service
 	UpdateGroupsioService(context.Context, *UpdateGroupsioServicePayload) (res *GroupsioService, err error)
+	// Promote a formation GroupsIO service to its project's primary service,
+	// clearing its prefix
+	PromoteGroupsioService(context.Context, *PromoteGroupsioServicePayload) (res *GroupsioServicePromotion, err error)
 	// Delete a GroupsIO service
 	DeleteGroupsioService(context.Context, *DeleteGroupsioServicePayload) (err error)
 	// Get projects that have GroupsIO services
 	GetGroupsioServiceProjects(context.Context, *GetGroupsioServiceProjectsPayload) (res *GroupsioProjectsResponse, err error)
+	// List GroupsIO services across all projects that have the given status
+	ListGroupsioServicesByStatus(context.Context, *ListGroupsioServicesByStatusPayload) (res *GroupsioServiceList, err error)
+	// List a project's GroupsIO services that have no Groups.io group ID yet
+	ListUnprovisionedGroupsioServices(context.Context, *ListUnprovisionedGroupsioServicesPayload) (res *GroupsioServiceList, err error)
 	// Find the parent GroupsIO service for a project
 	FindParentGroupsioService(context.Context, *FindParentGroupsioServicePayload) (res *GroupsioService, err error)
 	// List GroupsIO subgroups, optionally filtered by project UID and/or committee
 	// UID
 	ListGroupsioMailingLists(context.Context, *ListGroupsioMailingListsPayload) (res *GroupsioSubgroupList, err error)
+	// List a project's public or private GroupsIO subgroups
+	ListGroupsioMailingListsByVisibility(context.Context, *ListGroupsioMailingListsByVisibilityPayload) (res *GroupsioSubgroupList, err error)
+	// List a project's GroupsIO subgroups updated after a cutoff, for incremental
+	// sync
+	ListGroupsioMailingListsUpdatedSince(context.Context, *ListGroupsioMailingListsUpdatedSincePayload) (res *GroupsioSubgroupList, err error)
 	// Create a GroupsIO subgroup
 	CreateGroupsioMailingList(context.Context, *CreateGroupsioMailingListPayload) (res *GroupsioSubgroup, err error)
 	// Get a GroupsIO subgroup by ID
@@ -51,8 +65,24 @@ type Service interface {
 	GetGroupsioMailingListMemberCount(context.Context, *GetGroupsioMailingListMemberCountPayload) (res *GroupsioCount, err error)
 	// List members of a GroupsIO subgroup
 	ListGroupsioMembers(context.Context, *ListGroupsioMembersPayload) (res *GroupsioMemberList, err error)
+	// List members of a GroupsIO subgroup not reviewed since a cutoff, including
+	// members never reviewed
+	ListGroupsioMembersNeedingReview(context.Context, *ListGroupsioMembersNeedingReviewPayload) (res *GroupsioMemberList, err error)
+	// List members of a GroupsIO subgroup updated after a cutoff, for incremental
+	// sync
+	ListGroupsioMembersModifiedSince(context.Context, *ListGroupsioMembersModifiedSincePayload) (res *GroupsioMemberList, err error)
+	// List members of a GroupsIO subgroup whose organization matches, ignoring case
+	ListGroupsioMembersByOrganization(context.Context, *ListGroupsioMembersByOrganizationPayload) (res *GroupsioMemberList, err error)
+	// List groups of members of a GroupsIO subgroup that share an email address
+	ListGroupsioDuplicateMembers(context.Context, *ListGroupsioDuplicateMembersPayload) (res *GroupsioMemberDuplicates, err error)
+	// Preview, without writing anything, which members of a GroupsIO subgroup a
+	// delivery mode change would affect
+	PreviewGroupsioDeliveryModeChange(context.Context, *PreviewGroupsioDeliveryModeChangePayload) (res *GroupsioDeliveryModePreview, err error)
 	// Add a member to a GroupsIO subgroup
 	AddGroupsioMember(context.Context, *AddGroupsioMemberPayload) (res *GroupsioMember, err error)
+	// Add a member to a GroupsIO subgroup, or update the member with the same
+	// email if there is one
+	UpsertGroupsioMember(context.Context, *UpsertGroupsioMemberPayload) (res *GroupsioMemberUpsertResult, err error)
 	// Get a member of a GroupsIO subgroup by ID
 	GetGroupsioMember(context.Context, *GetGroupsioMemberPayload) (res *GroupsioMember, err error)
 	// Update a member of a GroupsIO subgroup
@@ -61,12 +91,36 @@ type Service interface {
 	DeleteGroupsioMember(context.Context, *DeleteGroupsioMemberPayload) (err error)
 	// Invite members to a GroupsIO subgroup by email
 	InviteGroupsioMembers(context.Context, *InviteGroupsioMembersPayload) (err error)
+	// Add many members to a GroupsIO subgroup, skipping those that already exist
+	ImportGroupsioMembers(context.Context, *ImportGroupsioMembersPayload) (res *GroupsioImportSummary, err error)
+	// Merge duplicate members of a GroupsIO subgroup by keeping one and removing
+	// the others
+	MergeGroupsioMembers(context.Context, *MergeGroupsioMembersPayload) (err error)
+	// Change a member's email address in place, keeping the member record and its
+	// history
+	ChangeGroupsioMemberEmail(context.Context, *ChangeGroupsioMemberEmailPayload) (res *GroupsioMember, err error)
 	// Check if an email address is subscribed to a GroupsIO subgroup
 	CheckGroupsioSubscriber(context.Context, *CheckGroupsioSubscriberPayload) (res *GroupsioCheckSubscriberResponse, err error)
 	// Get a GroupsIO subgroup artifact by ID
 	GetGroupsioArtifact(context.Context, *GetGroupsioArtifactPayload) (res *GroupsioArtifact, err error)
 	// Get a presigned S3 download URL for a GroupsIO subgroup artifact
 	GetGroupsioArtifactDownload(context.Context, *GetGroupsioArtifactDownloadPayload) (res *GroupsioArtifactDownload, err error)
+	// Get the number of GroupsIO services, subgroups and members in a project
+	GetGroupsioProjectSummary(context.Context, *GetGroupsioProjectSummaryPayload) (res *GroupsioProjectSummary, err error)
+	// List a project's GroupsIO subgroups whose parent service no longer exists
+	ListGroupsioOrphanedMailingLists(context.Context, *ListGroupsioOrphanedMailingListsPayload) (res *GroupsioSubgroupList, err error)
+	// Re-publish indexer messages for every GroupsIO service, subgroup and member
+	// of a project, e.g. after the search index is rebuilt. Safe to retry: a retry
+	// after a failure skips the subgroups whose members were already republished
+	RepublishGroupsioProjectIndex(context.Context, *RepublishGroupsioProjectIndexPayload) (res *GroupsioRepublishSummary, err error)
+	// Get member statistics for a GroupsIO subgroup
+	GetGroupsioMailingListStats(context.Context, *GetGroupsioMailingListStatsPayload) (res *GroupsioSubgroupStats, err error)
+	// Get a GroupsIO service with its subgroups nested beneath it
+	GetGroupsioServiceTree(context.Context, *GetGroupsioServiceTreePayload) (res *GroupsioServiceTree, err error)
+	// Get a GroupsIO member with the subgroup, service and project above it
+	GetGroupsioMemberHierarchy(context.Context, *GetGroupsioMemberHierarchyPayload) (res *GroupsioMemberHierarchy, err error)
+	// Page through the members of every GroupsIO subgroup in a project
+	ListGroupsioProjectMembers(context.Context, *ListGroupsioProjectMembersPayload) (res *GroupsioMemberPage, err error)
 }
 
 // Auther defines the authorization functions to be implemented by the service.
@@ -89,7 +143,7 @@ const ServiceName = "mailing-list"
 // MethodNames lists the service method names as defined in the design. These
 // are the same values that are set in the endpoint request contexts under the
 // MethodKey key.
-var MethodNames = [25]string{"livez", "readyz", "list-groupsio-services", "create-groupsio-service", "get-groupsio-service", "update-groupsio-service", "delete-groupsio-service", "get-groupsio-service-projects", "find-parent-groupsio-service", "list-groupsio-mailing-lists", "create-groupsio-mailing-list", "get-groupsio-mailing-list", "update-groupsio-mailing-list", "delete-groupsio-mailing-list", "get-groupsio-mailing-list-count", "get-groupsio-mailing-list-member-count", "list-groupsio-members", "add-groupsio-member", "get-groupsio-member", "update-groupsio-member", "delete-groupsio-member", "invite-groupsio-members", "check-groupsio-subscriber", "get-groupsio-artifact", "get-groupsio-artifact-download"}
+var MethodNames = [46]string{"livez", "readyz", "list-groupsio-services", "create-groupsio-service", "get-groupsio-service", "update-groupsio-service", "promote-groupsio-service", "delete-groupsio-service", "get-groupsio-service-projects", "list-groupsio-services-by-status", "list-unprovisioned-groupsio-services", "find-parent-groupsio-service", "list-groupsio-mailing-lists", "list-groupsio-mailing-lists-by-visibility", "list-groupsio-mailing-lists-updated-since", "create-groupsio-mailing-list", "get-groupsio-mailing-list", "update-groupsio-mailing-list", "delete-groupsio-mailing-list", "get-groupsio-mailing-list-count", "get-groupsio-mailing-list-member-count", "list-groupsio-members", "list-groupsio-members-needing-review", "list-groupsio-members-modified-since", "list-groupsio-members-by-organization", "list-groupsio-duplicate-members", "preview-groupsio-delivery-mode-change", "add-groupsio-member", "upsert-groupsio-member", "get-groupsio-member", "update-groupsio-member", "delete-groupsio-member", "invite-groupsio-members", "import-groupsio-members", "merge-groupsio-members", "change-groupsio-member-email", "check-groupsio-subscriber", "get-groupsio-artifact", "get-groupsio-artifact-download", "get-groupsio-project-summary", "list-groupsio-orphaned-mailing-lists", "republish-groupsio-project-index", "get-groupsio-mailing-list-stats", "get-groupsio-service-tree", "get-groupsio-member-hierarchy", "list-groupsio-project-members"}
 
 // AddGroupsioMemberPayload is the payload type of the mailing-list service
 // add-groupsio-member method.
@@ -114,6 +168,19 @@ type AddGroupsioMemberPayload struct {
 	JobTitle *string
 }
 
+// ChangeGroupsioMemberEmailPayload is the payload type of the mailing-list
+// service change-groupsio-member-email method.
+type ChangeGroupsioMemberEmailPayload struct {
+	// JWT token issued by Heimdall
+	BearerToken *string
+	// Subgroup ID
+	SubgroupID string
+	// Member ID
+	MemberID string
+	// New email address
+	Email string
+}
+
 // CheckGroupsioSubscriberPayload is the payload type of the mailing-list
 // service check-groupsio-subscriber method.
 type CheckGroupsioSubscriberPayload struct {
@@ -254,6 +321,26 @@ type GetGroupsioMailingListPayload struct {
 	SubgroupID string
 }
 
+// GetGroupsioMailingListStatsPayload is the payload type of the mailing-list
+// service get-groupsio-mailing-list-stats method.
+type GetGroupsioMailingListStatsPayload struct {
+	// JWT token issued by Heimdall
+	BearerToken *string
+	// Subgroup ID
+	SubgroupID string
+}
+
+// GetGroupsioMemberHierarchyPayload is the payload type of the mailing-list
+// service get-groupsio-member-hierarchy method.
+type GetGroupsioMemberHierarchyPayload struct {
+	// JWT token issued by Heimdall
+	BearerToken *string
+	// Subgroup ID
+	SubgroupID string
+	// Member ID
+	MemberID string
+}
+
 // GetGroupsioMemberPayload is the payload type of the mailing-list service
 // get-groupsio-member method.
 type GetGroupsioMemberPayload struct {
@@ -265,6 +352,15 @@ type GetGroupsioMemberPayload struct {
 	MemberID string
 }
 
+// GetGroupsioProjectSummaryPayload is the payload type of the mailing-list
+// service get-groupsio-project-summary method.
+type GetGroupsioProjectSummaryPayload struct {
+	// JWT token issued by Heimdall
+	BearerToken *string
+	// LFX v2 project UID
+	ProjectUID string
+}
+
 // GetGroupsioServicePayload is the payload type of the mailing-list service
 // get-groupsio-service method.
 type GetGroupsioServicePayload struct {
@@ -281,6 +377,17 @@ type GetGroupsioServiceProjectsPayload struct {
 	BearerToken *string
 }
 
+// GetGroupsioServiceTreePayload is the payload type of the mailing-list
+// service get-groupsio-service-tree method.
+type GetGroupsioServiceTreePayload struct {
+	// JWT token issued by Heimdall
+	BearerToken *string
+	// Service ID
+	ServiceID string
+	// Include each subgroup's member count
+	IncludeMemberCounts bool
+}
+
 // GroupsioArtifact is the result type of the mailing-list service
 // get-groupsio-artifact method.
 type GroupsioArtifact struct {
@@ -363,6 +470,32 @@ type GroupsioCount struct {
 	Count int
 }
 
+// GroupsioDeliveryModePreview is the result type of the mailing-list service
+// preview-groupsio-delivery-mode-change method.
+type GroupsioDeliveryModePreview struct {
+	// Delivery mode the preview was computed for
+	TargetMode string
+	// Emails of members on a different delivery mode
+	Affected []string
+	// Emails of members already on target_mode
+	Unchanged []string
+}
+
+// GroupsioImportSummary is the result type of the mailing-list service
+// import-groupsio-members method.
+type GroupsioImportSummary struct {
+	// Number of members added
+	Created int
+	// Number of members that already existed
+	Skipped int
+	// Number of members that could not be added
+	Errored int
+	// Emails of the skipped members
+	SkippedEmails []string
+	// Emails of the members that could not be added
+	ErroredEmails []string
+}
+
 // GroupsioMember is the result type of the mailing-list service
 // add-groupsio-member method.
 type GroupsioMember struct {
@@ -396,6 +529,38 @@ type GroupsioMember struct {
 	UpdatedAt *string
 }
 
+// GroupsioMemberDuplicates is the result type of the mailing-list service
+// list-groupsio-duplicate-members method.
+type GroupsioMemberDuplicates struct {
+	// Each group lists the IDs of two or more members with the same email
+	Groups [][]string
+}
+
+// GroupsioMemberHierarchy is the result type of the mailing-list service
+// get-groupsio-member-hierarchy method.
+type GroupsioMemberHierarchy struct {
+	// The member
+	Member *GroupsioMember
+	// The member's subgroup
+	Subgroup *GroupsioSubgroup
+	// The subgroup's service
+	Service *GroupsioService
+	// The service's project
+	Project *GroupsioMemberHierarchyProject
+	// Why an entity above the member could not be resolved
+	Warnings []string
+}
+
+// The project a member's subgroup belongs to
+type GroupsioMemberHierarchyProject struct {
+	// LFX v2 project UID
+	UID string
+	// Project name
+	Name *string
+	// Project slug
+	Slug *string
+}
+
 // GroupsioMemberList is the result type of the mailing-list service
 // list-groupsio-members method.
 type GroupsioMemberList struct {
@@ -405,6 +570,56 @@ type GroupsioMemberList struct {
 	Total *int
 }
 
+// GroupsioMemberPage is the result type of the mailing-list service
+// list-groupsio-project-members method.
+type GroupsioMemberPage struct {
+	// Members on this page
+	Items []*GroupsioMember
+	// Cursor for the next page; omitted on the last page
+	NextCursor *string
+}
+
+// Request body for adding or updating a GroupsIO member
+type GroupsioMemberRequest struct {
+	// Member email address
+	Email *string
+	// Member display name
+	Name *string
+	// Member type; only 'direct' is accepted for API-managed members
+	MemberType *string
+	// Moderation status
+	ModStatus *string
+	// Email delivery mode
+	DeliveryMode *string
+	// Member organization
+	Organization *string
+	// Member job title
+	JobTitle *string
+}
+
+// GroupsioMemberUpsertResult is the result type of the mailing-list service
+// upsert-groupsio-member method.
+type GroupsioMemberUpsertResult struct {
+	// The member after the upsert
+	Member *GroupsioMember
+	// True when the member was added, false when an existing member was updated
+	Created bool
+}
+
+// GroupsioProjectSummary is the result type of the mailing-list service
+// get-groupsio-project-summary method.
+type GroupsioProjectSummary struct {
+	// LFX v2 project UID
+	ProjectUID string
+	// Number of services
+	ServiceCount int
+	// Number of subgroups
+	MailingListCount int
+	// Sum of the subgroups' member counts; a person in two subgroups is counted
+	// twice
+	MemberCount int
+}
+
 // GroupsioProjectsResponse is the result type of the mailing-list service
 // get-groupsio-service-projects method.
 type GroupsioProjectsResponse struct {
@@ -412,6 +627,13 @@ type GroupsioProjectsResponse struct {
 	Projects []string
 }
 
+// GroupsioRepublishSummary is the result type of the mailing-list service
+// republish-groupsio-project-index method.
+type GroupsioRepublishSummary struct {
+	// Number of indexer messages published
+	Published int
+}
+
 // GroupsioService is the result type of the mailing-list service
 // create-groupsio-service method.
 type GroupsioService struct {
@@ -444,6 +666,33 @@ type GroupsioServiceList struct {
 	Total *int
 }
 
+// GroupsioServicePromotion is the result type of the mailing-list service
+// promote-groupsio-service method.
+type GroupsioServicePromotion struct {
+	// The promoted service
+	Service *GroupsioService
+	// Subgroups of the service whose names still start with the former formation
+	// prefix; they are not renamed
+	PrefixedSubgroups []*GroupsioSubgroup
+}
+
+// GroupsioServiceTree is the result type of the mailing-list service
+// get-groupsio-service-tree method.
+type GroupsioServiceTree struct {
+	// The service
+	Service *GroupsioService
+	// Subgroups of the service
+	MailingLists []*GroupsioServiceTreeSubgroup
+}
+
+// A GroupsIO subgroup within a service tree
+type GroupsioServiceTreeSubgroup struct {
+	// The subgroup
+	Subgroup *GroupsioSubgroup
+	// Number of members; omitted unless member counts were requested
+	MemberCount *int
+}
+
 // GroupsioSubgroup is the result type of the mailing-list service
 // create-groupsio-mailing-list method.
 type GroupsioSubgroup struct {
@@ -469,6 +718,8 @@ type GroupsioSubgroup struct {
 	CreatedAt *string
 	// Last update timestamp
 	UpdatedAt *string
+	// Best-effort follow-up steps that failed after the subgroup was saved
+	Warnings []string
 }
 
 // GroupsioSubgroupList is the result type of the mailing-list service
@@ -480,6 +731,34 @@ type GroupsioSubgroupList struct {
 	Total *int
 }
 
+// GroupsioSubgroupStats is the result type of the mailing-list service
+// get-groupsio-mailing-list-stats method.
+type GroupsioSubgroupStats struct {
+	// Subgroup ID
+	SubgroupID string
+	// Number of members
+	MemberCount int
+	// Member count per delivery mode; members without one are counted under ""
+	MembersByDeliveryMode map[string]int
+	// Number of bouncing members
+	BounceCount int
+	// Subgroup creation timestamp
+	CreatedAt *string
+	// Subgroup last update timestamp
+	UpdatedAt *string
+}
+
+// ImportGroupsioMembersPayload is the payload type of the mailing-list service
+// import-groupsio-members method.
+type ImportGroupsioMembersPayload struct {
+	// JWT token issued by Heimdall
+	BearerToken *string
+	// Subgroup ID
+	SubgroupID string
+	// Members to add
+	Members []*GroupsioMemberRequest
+}
+
 // InviteGroupsioMembersPayload is the payload type of the mailing-list service
 // invite-groupsio-members method.
 type InviteGroupsioMembersPayload struct {
@@ -491,6 +770,26 @@ type InviteGroupsioMembersPayload struct {
 	Emails []string
 }
 
+// ListGroupsioDuplicateMembersPayload is the payload type of the mailing-list
+// service list-groupsio-duplicate-members method.
+type ListGroupsioDuplicateMembersPayload struct {
+	// JWT token issued by Heimdall
+	BearerToken *string
+	// Subgroup ID
+	SubgroupID string
+}
+
+// ListGroupsioMailingListsByVisibilityPayload is the payload type of the
+// mailing-list service list-groupsio-mailing-lists-by-visibility method.
+type ListGroupsioMailingListsByVisibilityPayload struct {
+	// JWT token issued by Heimdall
+	BearerToken *string
+	// LFX v2 project UID
+	ProjectUID string
+	// List public (true) or private (false) subgroups
+	Public bool
+}
+
 // ListGroupsioMailingListsPayload is the payload type of the mailing-list
 // service list-groupsio-mailing-lists method.
 type ListGroupsioMailingListsPayload struct {
@@ -502,6 +801,50 @@ type ListGroupsioMailingListsPayload struct {
 	CommitteeUID *string
 }
 
+// ListGroupsioMailingListsUpdatedSincePayload is the payload type of the
+// mailing-list service list-groupsio-mailing-lists-updated-since method.
+type ListGroupsioMailingListsUpdatedSincePayload struct {
+	// JWT token issued by Heimdall
+	BearerToken *string
+	// LFX v2 project UID
+	ProjectUID string
+	// Only subgroups updated after this time are returned (RFC 3339)
+	Since string
+}
+
+// ListGroupsioMembersByOrganizationPayload is the payload type of the
+// mailing-list service list-groupsio-members-by-organization method.
+type ListGroupsioMembersByOrganizationPayload struct {
+	// JWT token issued by Heimdall
+	BearerToken *string
+	// Subgroup ID
+	SubgroupID string
+	// Organization name
+	Organization string
+}
+
+// ListGroupsioMembersModifiedSincePayload is the payload type of the
+// mailing-list service list-groupsio-members-modified-since method.
+type ListGroupsioMembersModifiedSincePayload struct {
+	// JWT token issued by Heimdall
+	BearerToken *string
+	// Subgroup ID
+	SubgroupID string
+	// Only members updated after this time are returned (RFC 3339)
+	Since string
+}
+
+// ListGroupsioMembersNeedingReviewPayload is the payload type of the
+// mailing-list service list-groupsio-members-needing-review method.
+type ListGroupsioMembersNeedingReviewPayload struct {
+	// JWT token issued by Heimdall
+	BearerToken *string
+	// Subgroup ID
+	SubgroupID string
+	// Review cutoff (RFC 3339)
+	OlderThan string
+}
+
 // ListGroupsioMembersPayload is the payload type of the mailing-list service
 // list-groupsio-members method.
 type ListGroupsioMembersPayload struct {
@@ -511,6 +854,39 @@ type ListGroupsioMembersPayload struct {
 	SubgroupID string
 }
 
+// ListGroupsioOrphanedMailingListsPayload is the payload type of the
+// mailing-list service list-groupsio-orphaned-mailing-lists method.
+type ListGroupsioOrphanedMailingListsPayload struct {
+	// JWT token issued by Heimdall
+	BearerToken *string
+	// LFX v2 project UID
+	ProjectUID string
+}
+
+// ListGroupsioProjectMembersPayload is the payload type of the mailing-list
+// service list-groupsio-project-members method.
+type ListGroupsioProjectMembersPayload struct {
+	// JWT token issued by Heimdall
+	BearerToken *string
+	// LFX v2 project UID
+	ProjectUID string
+	// Maximum members per page; 0 uses the default of 100
+	Limit int
+	// next_cursor of the previous page
+	Cursor *string
+	// Return each email once, from the first subgroup it appears in
+	Dedupe bool
+}
+
+// ListGroupsioServicesByStatusPayload is the payload type of the mailing-list
+// service list-groupsio-services-by-status method.
+type ListGroupsioServicesByStatusPayload struct {
+	// JWT token issued by Heimdall
+	BearerToken *string
+	// Service status, e.g. pending
+	Status string
+}
+
 // ListGroupsioServicesPayload is the payload type of the mailing-list service
 // list-groupsio-services method.
 type ListGroupsioServicesPayload struct {
@@ -520,6 +896,65 @@ type ListGroupsioServicesPayload struct {
 	ProjectUID *string
 }
 
+// ListUnprovisionedGroupsioServicesPayload is the payload type of the
+// mailing-list service list-unprovisioned-groupsio-services method.
+type ListUnprovisionedGroupsioServicesPayload struct {
+	// JWT token issued by Heimdall
+	BearerToken *string
+	// LFX v2 project UID
+	ProjectUID string
+}
+
+// MergeGroupsioMembersPayload is the payload type of the mailing-list service
+// merge-groupsio-members method.
+type MergeGroupsioMembersPayload struct {
+	// JWT token issued by Heimdall
+	BearerToken *string
+	// Subgroup ID
+	SubgroupID string
+	// ID of the member to keep
+	KeepMemberID string
+	// IDs of the members to remove; each must share the kept member's email
+	MergeMemberIds []string
+}
+
+// PreviewGroupsioDeliveryModeChangePayload is the payload type of the
+// mailing-list service preview-groupsio-delivery-mode-change method.
+type PreviewGroupsioDeliveryModeChangePayload struct {
+	// JWT token issued by Heimdall
+	BearerToken *string
+	// Subgroup ID
+	SubgroupID string
+	// Delivery mode to preview, e.g. email_delivery_digest
+	TargetMode string
+}
+
+// PromoteGroupsioServicePayload is the payload type of the mailing-list
+// service promote-groupsio-service method.
+type PromoteGroupsioServicePayload struct {
+	// JWT token issued by Heimdall
+	BearerToken *string
+	// Service ID
+	ServiceID string
+}
+
+// Readiness is the result type of the mailing-list service readyz method.
+type Readiness struct {
+	// Aggregate status
+	Status string
+	// Status of each dependency
+	Dependencies map[string]string
+}
+
+// RepublishGroupsioProjectIndexPayload is the payload type of the mailing-list
+// service republish-groupsio-project-index method.
+type RepublishGroupsioProjectIndexPayload struct {
+	// JWT token issued by Heimdall
+	BearerToken *string
+	// LFX v2 project UID
+	ProjectUID string
+}
+
 // UpdateGroupsioMailingListPayload is the payload type of the mailing-list
 // service update-groupsio-mailing-list method.
 type UpdateGroupsioMailingListPayload struct {
@@ -554,6 +989,9 @@ type UpdateGroupsioMemberPayload struct {
 	SubgroupID string
 	// Member ID
 	MemberID string
+	// Subgroup the member belongs to; a member cannot be moved, so any value other
+	// than subgroup_id is rejected
+	MailingListUID *string
 	// Member email address
 	Email *string
 	// Member display name
@@ -591,6 +1029,29 @@ type UpdateGroupsioServicePayload struct {
 	Status *string
 }
 
+// UpsertGroupsioMemberPayload is the payload type of the mailing-list service
+// upsert-groupsio-member method.
+type UpsertGroupsioMemberPayload struct {
+	// JWT token issued by Heimdall
+	BearerToken *string
+	// Subgroup ID
+	SubgroupID string
+	// Member email address
+	Email string
+	// Member display name
+	Name *string
+	// Member type; only 'direct' is accepted for API-managed members
+	MemberType *string
+	// Moderation status
+	ModStatus *string
+	// Email delivery mode
+	DeliveryMode *string
+	// Member organization
+	Organization *string
+	// Member job title
+	JobTitle *string
+}
+
 type BadRequestError struct {
 	// Error message
 	Message string
@@ -3,6 +3,44 @@
 
 package model
 
+import "strings"
+
+// Canonical committee member voting statuses, as used by the committee service.
+const (
+	VotingStatusVotingRep          = "Voting Rep"
+	VotingStatusAlternateVotingRep = "Alternate Voting Rep"
+	VotingStatusObserver           = "Observer"
+	VotingStatusEmeritus           = "Emeritus"
+	VotingStatusNone               = "None"
+)
+
+// canonicalVotingStatuses maps votingStatusKey of each canonical voting status to its canonical form.
+var canonicalVotingStatuses = func() map[string]string {
+	m := make(map[string]string)
+	for _, s := range []string{VotingStatusVotingRep, VotingStatusAlternateVotingRep, VotingStatusObserver, VotingStatusEmeritus, VotingStatusNone} {
+		m[votingStatusKey(s)] = s
+	}
+	return m
+}()
+
+// votingStatusKey folds case and treats spaces, underscores and hyphens alike, so that
+// "voting_rep", "Voting Rep" and "VOTING-REP" share a key.
+func votingStatusKey(s string) string {
+	words := strings.FieldsFunc(strings.ToLower(s), func(r rune) bool {
+		return r == ' ' || r == '_' || r == '-'
+	})
+	return strings.Join(words, "_")
+}
+
+// CanonicalVotingStatus returns the canonical form of a voting status and true, or the
+// trimmed input and false when it is not a known voting status.
+func CanonicalVotingStatus(s string) (string, bool) {
+	if canonical, ok := canonicalVotingStatuses[votingStatusKey(s)]; ok {
+		return canonical, true
+	}
+	return strings.TrimSpace(s), false
+}
+
 // Committee represents a committee associated with a mailing list.
 // Multiple committees can be associated with a single mailing list,
 // and any committee grants access (OR logic for access control).
@@ -17,3 +55,29 @@ type Committee struct {
 	// are synced to the mailing list (e.g., "Voting Rep", "Alternate Voting Rep").
 	AllowedVotingStatuses []string `json:"allowed_voting_statuses,omitempty"`
 }
+
+// NormalizeVotingStatuses rewrites AllowedVotingStatuses in canonical form, dropping blanks and
+// duplicates. Unknown statuses are kept (trimmed) and returned so callers can report them.
+func (c *Committee) NormalizeVotingStatuses() (unknown []string) {
+	if c.AllowedVotingStatuses == nil {
+		return nil
+	}
+	out := make([]string, 0, len(c.AllowedVotingStatuses))
+	seen := make(map[string]struct{}, len(c.AllowedVotingStatuses))
+	for _, s := range c.AllowedVotingStatuses {
+		canonical, known := CanonicalVotingStatus(s)
+		if canonical == "" {
+			continue
+		}
+		if _, dup := seen[canonical]; dup {
+			continue
+		}
+		seen[canonical] = struct{}{}
+		out = append(out, canonical)
+		if !known {
+			unknown = append(unknown, canonical)
+		}
+	}
+	c.AllowedVotingStatuses = out
+	return unknown
+}
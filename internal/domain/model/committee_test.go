@@ -0,0 +1,40 @@
+// Copyright The Linux Foundation and each contributor to LFX.
+// SPDX-License-Identifier: MIT
+
+package model
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCanonicalVotingStatus(t *testing.T) {
+	tests := []struct {
+		in        string
+		want      string
+		wantKnown bool
+	}{
+		{in: "Voting Rep", want: VotingStatusVotingRep, wantKnown: true},
+		{in: "voting_rep", want: VotingStatusVotingRep, wantKnown: true},
+		{in: "  VOTING-REP ", want: VotingStatusVotingRep, wantKnown: true},
+		{in: "alternate voting rep", want: VotingStatusAlternateVotingRep, wantKnown: true},
+		{in: "observer", want: VotingStatusObserver, wantKnown: true},
+		{in: " Chair ", want: "Chair", wantKnown: false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.in, func(t *testing.T) {
+			got, known := CanonicalVotingStatus(tt.in)
+			assert.Equal(t, tt.want, got)
+			assert.Equal(t, tt.wantKnown, known)
+		})
+	}
+}
+
+func TestCommittee_NormalizeVotingStatuses(t *testing.T) {
+	c := Committee{AllowedVotingStatuses: []string{"voting_rep", "Voting Rep", "", "Observer", " Chair "}}
+
+	unknown := c.NormalizeVotingStatuses()
+	assert.Equal(t, []string{VotingStatusVotingRep, VotingStatusObserver, "Chair"}, c.AllowedVotingStatuses)
+	assert.Equal(t, []string{"Chair"}, unknown)
+}
@@ -6,8 +6,11 @@ package model
 
 import (
 	"fmt"
+	"slices"
 	"strings"
 	"time"
+
+	errs "github.com/linuxfoundation/lfx-v2-mailing-list-service/pkg/errors"
 )
 
 // Mailing list type constants.
@@ -17,6 +20,12 @@ const (
 	TypeDiscussionOpen      = "discussion_open"
 )
 
+// MailingListTypes are the mailing list types accepted by Groups.io.
+var MailingListTypes = []string{TypeAnnouncement, TypeDiscussionModerated, TypeDiscussionOpen}
+
+// AudienceAccessPublic is the audience access value of a mailing list anyone can join.
+const AudienceAccessPublic = "public"
+
 // GroupsIOMailingList represents a GroupsIO mailing list entity with committee support
 type GroupsIOMailingList struct {
 	UID             string `json:"uid"`
@@ -27,6 +36,7 @@ type GroupsIOMailingList struct {
 	Source          string `json:"source"`           // "api", "webhook", or "mock" - tracks origin for business logic
 	Type            string `json:"type"`             // "announcement" | "discussion_moderated" | "discussion_open"
 	SubscriberCount int    `json:"subscriber_count"` // Number of members in this mailing list
+	Frozen          bool   `json:"frozen"`           // Archived in Groups.io; no new members may join
 
 	// Committee association - supports multiple committees with OR logic for access control
 	Committees []Committee `json:"committees,omitempty"`
@@ -42,11 +52,58 @@ type GroupsIOMailingList struct {
 	URL   string   `json:"url,omitempty"`   // The groups.io URL for the subgroup
 	Flags []string `json:"flags,omitempty"` // Warning messages about unusual settings
 
+	// Warnings describe best-effort steps that failed after the list was saved (e.g. committee
+	// notification). Only set on create/update responses; never persisted.
+	Warnings []string `json:"warnings,omitempty"`
+
 	CreatedAt       time.Time `json:"created_at"`
 	UpdatedAt       time.Time `json:"updated_at"`
 	SystemUpdatedAt time.Time `json:"system_updated_at,omitempty"` // Last modified by system (scripts/webhooks)
 }
 
+// ValidateIDs checks that the Groups.io subgroup ID, when set, is positive.
+func (ml *GroupsIOMailingList) ValidateIDs() error {
+	return validatePositiveID("group_id", ml.GroupID)
+}
+
+// IsPublic reports whether the mailing list is publicly visible. Lists read through ITX carry
+// their visibility in AudienceAccess; lists from the v1 data stream set Public.
+func (ml *GroupsIOMailingList) IsPublic() bool {
+	return ml.Public || ml.AudienceAccess == AudienceAccessPublic
+}
+
+// ValidateCommittees rejects a committee list that references the same committee UID more than once.
+func (ml *GroupsIOMailingList) ValidateCommittees() error {
+	seen := make(map[string]struct{}, len(ml.Committees))
+	for _, c := range ml.Committees {
+		if _, dup := seen[c.UID]; dup {
+			return errs.NewValidation(fmt.Sprintf("committee %q is referenced more than once", c.UID))
+		}
+		seen[c.UID] = struct{}{}
+	}
+	return nil
+}
+
+// Clone returns a deep copy of the mailing list, including each committee's
+// AllowedVotingStatuses. Returns nil for a nil receiver.
+func (ml *GroupsIOMailingList) Clone() *GroupsIOMailingList {
+	if ml == nil {
+		return nil
+	}
+	c := *ml
+	c.GroupID = clonePtr(ml.GroupID)
+	c.Flags = slices.Clone(ml.Flags)
+	c.Warnings = slices.Clone(ml.Warnings)
+	if ml.Committees != nil {
+		c.Committees = make([]Committee, len(ml.Committees))
+		for i, committee := range ml.Committees {
+			committee.AllowedVotingStatuses = slices.Clone(committee.AllowedVotingStatuses)
+			c.Committees[i] = committee
+		}
+	}
+	return &c
+}
+
 // GroupsIOMailingListSettings represents the settings for a GroupsIO mailing list (user management).
 type GroupsIOMailingListSettings struct {
 	UID             string     `json:"uid"`
@@ -60,6 +117,13 @@ type GroupsIOMailingListSettings struct {
 	UpdatedAt       time.Time  `json:"updated_at"`
 }
 
+// NormalizeUsers deduplicates Writers and Auditors in place and rejects users present in both.
+func (s *GroupsIOMailingListSettings) NormalizeUsers() error {
+	s.Writers = DedupeUserInfos(s.Writers)
+	s.Auditors = DedupeUserInfos(s.Auditors)
+	return ValidateDisjointUsers(s.Writers, s.Auditors)
+}
+
 // Tags generates a consistent set of tags for the GrpsIO mailing list settings
 func (s *GroupsIOMailingListSettings) Tags() []string {
 	var tags []string
@@ -7,6 +7,7 @@ import (
 	"testing"
 
 	"github.com/google/uuid"
+	errs "github.com/linuxfoundation/lfx-v2-mailing-list-service/pkg/errors"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -325,3 +326,58 @@ func createValidTestMailingList() *GroupsIOMailingList {
 		},
 	}
 }
+
+func TestGroupsIOMailingList_Clone(t *testing.T) {
+	groupID := int64(42)
+	orig := &GroupsIOMailingList{
+		UID:     "ml-1",
+		GroupID: &groupID,
+		Flags:   []string{"flag-1"},
+		Committees: []Committee{
+			{UID: "c-1", AllowedVotingStatuses: []string{"Voting Rep"}},
+		},
+	}
+
+	c := orig.Clone()
+	*c.GroupID = 7
+	c.Flags[0] = "mutated"
+	c.Committees[0].UID = "mutated"
+	c.Committees[0].AllowedVotingStatuses[0] = "mutated"
+
+	assert.Equal(t, int64(42), *orig.GroupID)
+	assert.Equal(t, []string{"flag-1"}, orig.Flags)
+	assert.Equal(t, "c-1", orig.Committees[0].UID)
+	assert.Equal(t, []string{"Voting Rep"}, orig.Committees[0].AllowedVotingStatuses)
+	assert.Nil(t, (*GroupsIOMailingList)(nil).Clone())
+}
+
+func TestGroupsIOMailingList_ValidateIDs(t *testing.T) {
+	id := func(v int64) *int64 { return &v }
+	assert.NoError(t, (&GroupsIOMailingList{}).ValidateIDs())
+	assert.NoError(t, (&GroupsIOMailingList{GroupID: id(7)}).ValidateIDs())
+	assert.Error(t, (&GroupsIOMailingList{GroupID: id(0)}).ValidateIDs())
+	assert.Error(t, (&GroupsIOMailingList{GroupID: id(-7)}).ValidateIDs())
+}
+
+func TestGroupsIOMailingList_ValidateCommittees(t *testing.T) {
+	tests := []struct {
+		name       string
+		committees []Committee
+		wantErr    bool
+	}{
+		{name: "none"},
+		{name: "single", committees: []Committee{{UID: "c-1"}}},
+		{name: "distinct", committees: []Committee{{UID: "c-1"}, {UID: "c-2"}}},
+		{name: "duplicate", committees: []Committee{{UID: "c-1"}, {UID: "c-2"}, {UID: "c-1"}}, wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := (&GroupsIOMailingList{Committees: tt.committees}).ValidateCommittees()
+			if tt.wantErr {
+				assert.IsType(t, errs.Validation{}, err)
+				return
+			}
+			assert.NoError(t, err)
+		})
+	}
+}
@@ -8,6 +8,8 @@ import (
 	"fmt"
 	"strings"
 	"time"
+
+	"github.com/linuxfoundation/lfx-v2-mailing-list-service/pkg/utils"
 )
 
 // GrpsIOMember represents a GroupsIO mailing list member
@@ -60,6 +62,43 @@ type GrpsIOMember struct {
 	SystemUpdatedAt time.Time `json:"system_updated_at,omitempty"` // Last modified by system (scripts/webhooks)
 }
 
+// ValidateIDs checks that the Groups.io member and group IDs, when set, are positive.
+func (m *GrpsIOMember) ValidateIDs() error {
+	if err := validatePositiveID("member_id", m.MemberID); err != nil {
+		return err
+	}
+	return validatePositiveID("group_id", m.GroupID)
+}
+
+// Clone returns a deep copy of the member. Returns nil for a nil receiver.
+func (m *GrpsIOMember) Clone() *GrpsIOMember {
+	if m == nil {
+		return nil
+	}
+	c := *m
+	c.MemberID = clonePtr(m.MemberID)
+	c.GroupID = clonePtr(m.GroupID)
+	c.LastReviewedAt = clonePtr(m.LastReviewedAt)
+	c.LastReviewedBy = clonePtr(m.LastReviewedBy)
+	return &c
+}
+
+// GetLastReviewedAtTime safely parses LastReviewedAt into a time.Time pointer.
+// Returns nil if LastReviewedAt is nil or empty.
+func (m *GrpsIOMember) GetLastReviewedAtTime() (*time.Time, error) {
+	return utils.ParseTimestampPtr(m.LastReviewedAt)
+}
+
+// NeedsReview reports whether the member has never been reviewed or was last reviewed
+// before the cutoff. An unparseable LastReviewedAt is treated as never reviewed.
+func (m *GrpsIOMember) NeedsReview(cutoff time.Time) bool {
+	reviewedAt, err := m.GetLastReviewedAtTime()
+	if err != nil || reviewedAt == nil {
+		return true
+	}
+	return reviewedAt.Before(cutoff)
+}
+
 // Tags generates a consistent set of tags for the member.
 func (m *GrpsIOMember) Tags() []string {
 	var tags []string
@@ -5,6 +5,7 @@ package model
 
 import (
 	"testing"
+	"time"
 
 	"github.com/google/uuid"
 	"github.com/stretchr/testify/assert"
@@ -123,3 +124,71 @@ func BenchmarkGrpsIOMember_Tags(b *testing.B) {
 		_ = member.Tags()
 	}
 }
+
+func TestGrpsIOMember_NeedsReview(t *testing.T) {
+	cutoff := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	ptr := func(s string) *string { return &s }
+
+	tests := []struct {
+		name           string
+		lastReviewedAt *string
+		expected       bool
+	}{
+		{name: "never reviewed", lastReviewedAt: nil, expected: true},
+		{name: "empty timestamp", lastReviewedAt: ptr(""), expected: true},
+		{name: "reviewed before cutoff", lastReviewedAt: ptr("2025-06-01T00:00:00Z"), expected: true},
+		{name: "reviewed after cutoff", lastReviewedAt: ptr("2026-02-01T00:00:00Z"), expected: false},
+		{name: "unparseable timestamp", lastReviewedAt: ptr("last tuesday"), expected: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			m := &GrpsIOMember{LastReviewedAt: tt.lastReviewedAt}
+			assert.Equal(t, tt.expected, m.NeedsReview(cutoff))
+		})
+	}
+}
+
+func TestGrpsIOMember_Clone(t *testing.T) {
+	memberID, groupID := int64(1), int64(2)
+	reviewedAt, reviewedBy := "2026-01-01T00:00:00Z", "reviewer"
+	orig := &GrpsIOMember{UID: "m-1", MemberID: &memberID, GroupID: &groupID, LastReviewedAt: &reviewedAt, LastReviewedBy: &reviewedBy}
+
+	c := orig.Clone()
+	*c.MemberID = 10
+	*c.GroupID = 20
+	*c.LastReviewedAt = "mutated"
+	*c.LastReviewedBy = "mutated"
+
+	assert.Equal(t, int64(1), *orig.MemberID)
+	assert.Equal(t, int64(2), *orig.GroupID)
+	assert.Equal(t, "2026-01-01T00:00:00Z", *orig.LastReviewedAt)
+	assert.Equal(t, "reviewer", *orig.LastReviewedBy)
+	assert.Nil(t, (*GrpsIOMember)(nil).Clone())
+}
+
+func TestGrpsIOMember_ValidateIDs(t *testing.T) {
+	id := func(v int64) *int64 { return &v }
+	tests := []struct {
+		name    string
+		member  *GrpsIOMember
+		wantErr bool
+	}{
+		{name: "unset", member: &GrpsIOMember{}},
+		{name: "positive", member: &GrpsIOMember{MemberID: id(1), GroupID: id(2)}},
+		{name: "zero member id", member: &GrpsIOMember{MemberID: id(0)}, wantErr: true},
+		{name: "negative member id", member: &GrpsIOMember{MemberID: id(-5)}, wantErr: true},
+		{name: "zero group id", member: &GrpsIOMember{GroupID: id(0)}, wantErr: true},
+		{name: "negative group id", member: &GrpsIOMember{GroupID: id(-5)}, wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.member.ValidateIDs()
+			if tt.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			assert.NoError(t, err)
+		})
+	}
+}
@@ -6,10 +6,12 @@ package model
 
 import (
 	"fmt"
+	"slices"
 	"strings"
 	"time"
 
 	"github.com/linuxfoundation/lfx-v2-mailing-list-service/pkg/constants"
+	errs "github.com/linuxfoundation/lfx-v2-mailing-list-service/pkg/errors"
 	"github.com/linuxfoundation/lfx-v2-mailing-list-service/pkg/utils"
 )
 
@@ -24,6 +26,75 @@ type UserInfo struct {
 	Avatar   *string `json:"avatar,omitempty"`
 }
 
+// userKey identifies a user by username, falling back to email, compared case-insensitively.
+func (u UserInfo) userKey() string {
+	if u.Username != nil && *u.Username != "" {
+		return "username:" + strings.ToLower(*u.Username)
+	}
+	if u.Email != nil && *u.Email != "" {
+		return "email:" + strings.ToLower(*u.Email)
+	}
+	return ""
+}
+
+// identifier returns the username, or the email when no username is set.
+func (u UserInfo) identifier() string {
+	if u.Username != nil && *u.Username != "" {
+		return *u.Username
+	}
+	if u.Email != nil {
+		return *u.Email
+	}
+	return ""
+}
+
+// DedupeUserInfos returns users with repeated entries removed, preserving first-seen order.
+// Users are matched by username, or by email when no username is set.
+func DedupeUserInfos(users []UserInfo) []UserInfo {
+	if len(users) == 0 {
+		return users
+	}
+	seen := make(map[string]struct{}, len(users))
+	out := make([]UserInfo, 0, len(users))
+	for _, u := range users {
+		key := u.userKey()
+		if key != "" {
+			if _, dup := seen[key]; dup {
+				continue
+			}
+			seen[key] = struct{}{}
+		}
+		out = append(out, u)
+	}
+	return out
+}
+
+// ValidateDisjointUsers returns a Validation error when a user is listed as both
+// writer and auditor, which would produce contradictory access relations.
+func ValidateDisjointUsers(writers, auditors []UserInfo) error {
+	writerKeys := make(map[string]struct{}, len(writers))
+	for _, w := range writers {
+		if key := w.userKey(); key != "" {
+			writerKeys[key] = struct{}{}
+		}
+	}
+	for _, a := range auditors {
+		if _, overlap := writerKeys[a.userKey()]; overlap {
+			return errs.NewValidation(fmt.Sprintf("user %q cannot be both writer and auditor", a.identifier()))
+		}
+	}
+	return nil
+}
+
+// validatePositiveID returns a Validation error when a Groups.io ID is set but not positive.
+// Groups.io never assigns zero or negative IDs, so such values indicate a bug upstream.
+func validatePositiveID(field string, id *int64) error {
+	if id != nil && *id <= 0 {
+		return errs.NewValidation(fmt.Sprintf("%s must be a positive integer, got %d", field, *id))
+	}
+	return nil
+}
+
 type GrpsIOServiceFull struct {
 	Base     *GroupsIOService       `json:"base"`
 	Settings *GrpsIOServiceSettings `json:"settings"`
@@ -42,6 +113,22 @@ type GrpsIOServiceSettings struct {
 	UpdatedAt       time.Time  `json:"updated_at"`
 }
 
+// ValidateIDs checks that the Groups.io group ID, when set, is positive.
+func (s *GroupsIOService) ValidateIDs() error {
+	return validatePositiveID("group_id", s.GroupID)
+}
+
+// Clone returns a deep copy of the service. Returns nil for a nil receiver.
+func (s *GroupsIOService) Clone() *GroupsIOService {
+	if s == nil {
+		return nil
+	}
+	c := *s
+	c.GroupID = clonePtr(s.GroupID)
+	c.GlobalOwners = slices.Clone(s.GlobalOwners)
+	return &c
+}
+
 // Tags generates a consistent set of tags for the GroupsIOService settings
 func (s *GrpsIOServiceSettings) Tags() []string {
 	var tags []string
@@ -59,10 +146,38 @@ func (s *GrpsIOServiceSettings) Tags() []string {
 	return tags
 }
 
+// NormalizeUsers deduplicates Writers and Auditors in place and rejects users present in both.
+func (s *GrpsIOServiceSettings) NormalizeUsers() error {
+	s.Writers = DedupeUserInfos(s.Writers)
+	s.Auditors = DedupeUserInfos(s.Auditors)
+	return ValidateDisjointUsers(s.Writers, s.Auditors)
+}
+
 // ValidateLastReviewedAt validates the LastReviewedAt timestamp format.
-// Returns nil if the field is nil (allowed) or contains a valid RFC3339 timestamp.
+// Returns nil if the field is nil (allowed) or contains a valid RFC3339 timestamp. RFC3339
+// requires a zone, so naive local times without "Z" or an offset are rejected.
 func (s *GrpsIOServiceSettings) ValidateLastReviewedAt() error {
-	return utils.ValidateRFC3339Ptr(s.LastReviewedAt)
+	if err := utils.ValidateRFC3339Ptr(s.LastReviewedAt); err != nil {
+		return errs.NewValidation(fmt.Sprintf(
+			"last_reviewed_at %q must be an RFC3339 timestamp with \"Z\" or a UTC offset (e.g. 2006-01-02T15:04:05Z)",
+			*s.LastReviewedAt))
+	}
+	return nil
+}
+
+// NormalizeLastReviewedAt validates LastReviewedAt and rewrites it in UTC, so equal instants
+// are stored identically regardless of the offset they were submitted with.
+func (s *GrpsIOServiceSettings) NormalizeLastReviewedAt() error {
+	if err := s.ValidateLastReviewedAt(); err != nil {
+		return err
+	}
+	t, _ := s.GetLastReviewedAtTime()
+	if t == nil {
+		return nil
+	}
+	utc := t.UTC()
+	s.LastReviewedAt = utils.FormatTimePtr(&utc)
+	return nil
 }
 
 // GetLastReviewedAtTime safely parses LastReviewedAt into a time.Time pointer.
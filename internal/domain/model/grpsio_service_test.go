@@ -7,6 +7,7 @@ import (
 	"testing"
 
 	"github.com/google/uuid"
+	errs "github.com/linuxfoundation/lfx-v2-mailing-list-service/pkg/errors"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -101,3 +102,108 @@ func BenchmarkGrpsIOService_Tags(b *testing.B) {
 		_ = service.Tags()
 	}
 }
+
+func TestGrpsIOServiceSettings_NormalizeUsers(t *testing.T) {
+	str := func(s string) *string { return &s }
+
+	t.Run("duplicates are removed preserving order", func(t *testing.T) {
+		settings := &GrpsIOServiceSettings{
+			Writers:  []UserInfo{{Username: str("alice")}, {Username: str("bob")}, {Username: str("Alice")}},
+			Auditors: []UserInfo{{Email: str("carol@example.com")}, {Email: str("CAROL@example.com")}},
+		}
+
+		assert.NoError(t, settings.NormalizeUsers())
+		assert.Equal(t, []UserInfo{{Username: str("alice")}, {Username: str("bob")}}, settings.Writers)
+		assert.Equal(t, []UserInfo{{Email: str("carol@example.com")}}, settings.Auditors)
+	})
+
+	t.Run("user in both writers and auditors is rejected", func(t *testing.T) {
+		settings := &GrpsIOServiceSettings{
+			Writers:  []UserInfo{{Username: str("alice")}},
+			Auditors: []UserInfo{{Username: str("bob")}, {Username: str("ALICE")}},
+		}
+
+		err := settings.NormalizeUsers()
+		assert.Error(t, err)
+		assert.IsType(t, errs.Validation{}, err)
+		assert.Contains(t, err.Error(), "ALICE")
+	})
+
+	t.Run("mailing list settings apply the same rules", func(t *testing.T) {
+		settings := &GroupsIOMailingListSettings{
+			Writers:  []UserInfo{{Username: str("alice")}},
+			Auditors: []UserInfo{{Username: str("alice")}},
+		}
+
+		assert.IsType(t, errs.Validation{}, settings.NormalizeUsers())
+	})
+}
+
+func TestGrpsIOServiceSettings_NormalizeLastReviewedAt(t *testing.T) {
+	str := func(s string) *string { return &s }
+	tests := []struct {
+		name    string
+		input   *string
+		want    *string
+		wantErr bool
+	}{
+		{name: "nil is allowed", input: nil, want: nil},
+		{name: "utc is kept", input: str("2026-03-01T10:00:00Z"), want: str("2026-03-01T10:00:00Z")},
+		{name: "offset is converted to utc", input: str("2026-03-01T12:00:00+02:00"), want: str("2026-03-01T10:00:00Z")},
+		{name: "naive local time is rejected", input: str("2026-03-01T10:00:00"), wantErr: true},
+		{name: "date only is rejected", input: str("2026-03-01"), wantErr: true},
+		{name: "empty is rejected", input: str(""), wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			settings := &GrpsIOServiceSettings{LastReviewedAt: tt.input}
+
+			err := settings.NormalizeLastReviewedAt()
+			if tt.wantErr {
+				assert.IsType(t, errs.Validation{}, err)
+				assert.Contains(t, err.Error(), "last_reviewed_at")
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tt.want, settings.LastReviewedAt)
+		})
+	}
+}
+
+func TestGroupsIOService_Clone(t *testing.T) {
+	groupID := int64(42)
+	orig := &GroupsIOService{UID: "svc-1", GroupID: &groupID, GlobalOwners: []string{"a@example.com"}}
+
+	c := orig.Clone()
+	*c.GroupID = 7
+	c.GlobalOwners[0] = "mutated@example.com"
+	c.GlobalOwners = append(c.GlobalOwners, "extra@example.com")
+
+	assert.Equal(t, int64(42), *orig.GroupID)
+	assert.Equal(t, []string{"a@example.com"}, orig.GlobalOwners)
+	assert.Nil(t, (*GroupsIOService)(nil).Clone())
+}
+
+func TestGroupsIOService_ValidateIDs(t *testing.T) {
+	id := func(v int64) *int64 { return &v }
+	tests := []struct {
+		name    string
+		groupID *int64
+		wantErr bool
+	}{
+		{name: "unset", groupID: nil},
+		{name: "positive", groupID: id(42)},
+		{name: "zero", groupID: id(0), wantErr: true},
+		{name: "negative", groupID: id(-1), wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := (&GroupsIOService{GroupID: tt.groupID}).ValidateIDs()
+			if tt.wantErr {
+				assert.IsType(t, errs.Validation{}, err)
+				return
+			}
+			assert.NoError(t, err)
+		})
+	}
+}
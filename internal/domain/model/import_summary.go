@@ -0,0 +1,14 @@
+// Copyright The Linux Foundation and each contributor to LFX.
+// SPDX-License-Identifier: MIT
+
+package model
+
+// ImportSummary reports the outcome of a member import. Members that already
+// exist on the mailing list are counted as skipped rather than errored.
+type ImportSummary struct {
+	Created       int      `json:"created"`
+	Skipped       int      `json:"skipped"`
+	Errored       int      `json:"errored"`
+	SkippedEmails []string `json:"skipped_emails,omitempty"`
+	ErroredEmails []string `json:"errored_emails,omitempty"`
+}
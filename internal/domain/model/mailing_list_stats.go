@@ -0,0 +1,22 @@
+// Copyright The Linux Foundation and each contributor to LFX.
+// SPDX-License-Identifier: MIT
+
+package model
+
+import "time"
+
+// Groups.io member statuses that indicate delivery to the member is failing.
+const (
+	MemberStatusBouncing = "bouncing"
+	MemberStatusBounced  = "bounced"
+)
+
+// MailingListStats summarises a mailing list's membership for analytics views.
+type MailingListStats struct {
+	MailingListUID        string         `json:"mailing_list_uid"`
+	MemberCount           int            `json:"member_count"`
+	MembersByDeliveryMode map[string]int `json:"members_by_delivery_mode"`
+	BounceCount           int            `json:"bounce_count"`
+	CreatedAt             time.Time      `json:"created_at"`
+	UpdatedAt             time.Time      `json:"updated_at"`
+}
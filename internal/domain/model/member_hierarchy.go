@@ -0,0 +1,21 @@
+// Copyright The Linux Foundation and each contributor to LFX.
+// SPDX-License-Identifier: MIT
+
+package model
+
+// MemberHierarchy is a member together with the mailing list, service and project above it.
+// Entities that could not be resolved are nil, with the reason recorded in Warnings.
+type MemberHierarchy struct {
+	Member      *GrpsIOMember        `json:"member"`
+	MailingList *GroupsIOMailingList `json:"mailing_list,omitempty"`
+	Service     *GroupsIOService     `json:"service,omitempty"`
+	Project     *HierarchyProject    `json:"project,omitempty"`
+	Warnings    []string             `json:"warnings,omitempty"`
+}
+
+// HierarchyProject identifies the project at the top of a MemberHierarchy.
+type HierarchyProject struct {
+	UID  string `json:"uid"`
+	Name string `json:"name,omitempty"`
+	Slug string `json:"slug,omitempty"`
+}
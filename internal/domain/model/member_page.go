@@ -0,0 +1,20 @@
+// Copyright The Linux Foundation and each contributor to LFX.
+// SPDX-License-Identifier: MIT
+
+package model
+
+// ListOptions controls paging for cross-list member reads.
+type ListOptions struct {
+	// Limit is the maximum number of members per page; <= 0 uses the reader's default.
+	Limit int
+	// Cursor is the NextCursor of the previous page; empty starts from the beginning.
+	Cursor string
+	// Dedupe returns each email address once, from the first mailing list it appears in.
+	Dedupe bool
+}
+
+// MemberPage is one page of members. NextCursor is empty on the last page.
+type MemberPage struct {
+	Members    []*GrpsIOMember `json:"members"`
+	NextCursor string          `json:"next_cursor,omitempty"`
+}
@@ -0,0 +1,12 @@
+// Copyright The Linux Foundation and each contributor to LFX.
+// SPDX-License-Identifier: MIT
+
+package model
+
+// ProjectSummary describes a project's GroupsIO footprint in a single read.
+type ProjectSummary struct {
+	ProjectUID       string `json:"project_uid"`
+	ServiceCount     int    `json:"service_count"`
+	MailingListCount int    `json:"mailing_list_count"`
+	MemberCount      int    `json:"member_count"`
+}
@@ -0,0 +1,12 @@
+// Copyright The Linux Foundation and each contributor to LFX.
+// SPDX-License-Identifier: MIT
+
+package model
+
+// ServicePromotion reports the outcome of promoting a formation service to primary.
+// PrefixedMailingLists are the service's lists whose group names still start with the
+// former formation prefix; Groups.io holds those names, so they are reported, not renamed.
+type ServicePromotion struct {
+	Service              *GroupsIOService       `json:"service"`
+	PrefixedMailingLists []*GroupsIOMailingList `json:"prefixed_mailing_lists,omitempty"`
+}
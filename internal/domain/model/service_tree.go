@@ -0,0 +1,17 @@
+// Copyright The Linux Foundation and each contributor to LFX.
+// SPDX-License-Identifier: MIT
+
+package model
+
+// ServiceTree nests a service's mailing lists, ordered by group name, under the service.
+type ServiceTree struct {
+	Service      *GroupsIOService          `json:"service"`
+	MailingLists []*ServiceTreeMailingList `json:"mailing_lists"`
+}
+
+// ServiceTreeMailingList is a mailing list within a ServiceTree. MemberCount is nil when
+// member counts were not requested.
+type ServiceTreeMailingList struct {
+	MailingList *GroupsIOMailingList `json:"mailing_list"`
+	MemberCount *int                 `json:"member_count,omitempty"`
+}
@@ -8,10 +8,12 @@ package port
 type GroupsIOReaderWriter interface {
 	GroupsIOServiceReader
 	GroupsIOServiceWriter
+	GroupsIOServicePromoter
 	GroupsIOMailingListReader
 	GroupsIOMailingListWriter
 	GroupsIOMailingListMemberReader
 	GroupsIOMailingListMemberWriter
 	GroupsIOArtifactReader
 	InviteAcceptanceClient
+	ReadinessChecker
 }
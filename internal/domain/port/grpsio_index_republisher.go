@@ -0,0 +1,14 @@
+// Copyright The Linux Foundation and each contributor to LFX.
+// SPDX-License-Identifier: MIT
+
+package port
+
+import "context"
+
+// GroupsIOIndexRepublisher re-emits indexer messages for existing GroupsIO entities, e.g. to
+// repopulate the search index after it has been rebuilt.
+type GroupsIOIndexRepublisher interface {
+	// RepublishProjectIndex publishes an indexer message for every service, mailing list and
+	// member of the project, and returns how many messages were published.
+	RepublishProjectIndex(ctx context.Context, projectUID string) (published int, err error)
+}
@@ -5,6 +5,7 @@ package port
 
 import (
 	"context"
+	"time"
 
 	"github.com/linuxfoundation/lfx-v2-mailing-list-service/internal/domain/model"
 )
@@ -26,3 +27,16 @@ type GroupsIOMailingListReader interface {
 	// GetMailingListMemberCount returns the count of members in a given mailing list.
 	GetMailingListMemberCount(ctx context.Context, mailingListID string) (int, error)
 }
+
+// GroupsIOMailingListQueryReader extends GroupsIOMailingListReader with filtered views of a
+// project's mailing lists. ITX has no server-side filters for these, so implementations list
+// the project's mailing lists and filter them.
+type GroupsIOMailingListQueryReader interface {
+	GroupsIOMailingListReader
+
+	// ListMailingListsByVisibility returns the project's public (public=true) or private mailing lists.
+	ListMailingListsByVisibility(ctx context.Context, projectUID string, public bool) ([]*model.GroupsIOMailingList, error)
+
+	// ListMailingListsUpdatedSince returns the project's mailing lists updated after since.
+	ListMailingListsUpdatedSince(ctx context.Context, projectUID string, since time.Time) ([]*model.GroupsIOMailingList, error)
+}
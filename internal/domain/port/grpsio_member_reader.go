@@ -6,6 +6,7 @@ package port
 
 import (
 	"context"
+	"time"
 
 	"github.com/linuxfoundation/lfx-v2-mailing-list-service/internal/domain/model"
 )
@@ -21,3 +22,27 @@ type GroupsIOMailingListMemberReader interface {
 	// CheckSubscriber checks whether an email is subscribed to a mailing list.
 	CheckSubscriber(ctx context.Context, mailingListID string, email string) (bool, error)
 }
+
+// GroupsIOMailingListMemberQueryReader extends GroupsIOMailingListMemberReader with filtered
+// views of a mailing list's members. ITX has no server-side filters for these, so
+// implementations list the members and filter them.
+type GroupsIOMailingListMemberQueryReader interface {
+	GroupsIOMailingListMemberReader
+
+	// ListMembersNeedingReview returns the members never reviewed or last reviewed before olderThan.
+	ListMembersNeedingReview(ctx context.Context, mailingListID string, olderThan time.Time) ([]*model.GrpsIOMember, error)
+
+	// ListMembersModifiedSince returns the members updated after since.
+	ListMembersModifiedSince(ctx context.Context, mailingListID string, since time.Time) ([]*model.GrpsIOMember, error)
+
+	// ListMembersByOrganization returns the members whose organization matches, ignoring case.
+	ListMembersByOrganization(ctx context.Context, mailingListID string, organization string) ([]*model.GrpsIOMember, error)
+
+	// FindDuplicateMembers groups the UIDs of members that share an email; only groups of two
+	// or more are returned.
+	FindDuplicateMembers(ctx context.Context, mailingListID string) ([][]string, error)
+
+	// PreviewDeliveryModeChange reports, without writing, which members' emails would change
+	// (affected) or stay (unchanged) if the whole list were switched to targetMode.
+	PreviewDeliveryModeChange(ctx context.Context, mailingListID string, targetMode string) (affected []string, unchanged []string, err error)
+}
@@ -23,3 +23,23 @@ type GroupsIOMailingListMemberWriter interface {
 	// InviteMembers sends invitations to the given email addresses to join a mailing list.
 	InviteMembers(ctx context.Context, mailingListID string, emails []string) error
 }
+
+// GroupsIOMailingListMemberManager extends GroupsIOMailingListMemberWriter with member
+// operations the orchestrator composes from several ITX calls.
+type GroupsIOMailingListMemberManager interface {
+	GroupsIOMailingListMemberWriter
+
+	// ChangeMemberEmail changes a member's email in place, keeping the member record.
+	ChangeMemberEmail(ctx context.Context, mailingListID string, memberID string, newEmail string) (*model.GrpsIOMember, error)
+
+	// UpsertMember adds the member, or updates the existing member with the same email;
+	// created reports which happened.
+	UpsertMember(ctx context.Context, mailingListID string, member *model.GrpsIOMember) (result *model.GrpsIOMember, created bool, err error)
+
+	// MergeMembers keeps keepUID, carrying over the duplicates' strongest moderation status,
+	// and removes mergeUIDs, which must all share keepUID's non-empty email.
+	MergeMembers(ctx context.Context, mailingListID string, keepUID string, mergeUIDs []string) error
+
+	// ImportMembers adds each member to a mailing list, skipping members that already exist.
+	ImportMembers(ctx context.Context, mailingListID string, members []*model.GrpsIOMember) (*model.ImportSummary, error)
+}
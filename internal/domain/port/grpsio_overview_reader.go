@@ -0,0 +1,34 @@
+// Copyright The Linux Foundation and each contributor to LFX.
+// SPDX-License-Identifier: MIT
+
+package port
+
+import (
+	"context"
+
+	"github.com/linuxfoundation/lfx-v2-mailing-list-service/internal/domain/model"
+)
+
+// GroupsIOOverviewReader defines aggregated, read-only views that span services,
+// mailing lists and members. All IDs are v2 UUIDs.
+type GroupsIOOverviewReader interface {
+	// GetProjectSummary returns the number of services, mailing lists and members for a project.
+	GetProjectSummary(ctx context.Context, projectUID string) (*model.ProjectSummary, error)
+
+	// ListOrphanedMailingLists returns the project's mailing lists whose parent service no longer exists.
+	ListOrphanedMailingLists(ctx context.Context, projectUID string) ([]*model.GroupsIOMailingList, error)
+
+	// GetMailingListStats returns member counts (total, by delivery mode, bouncing) and timestamps for a mailing list.
+	GetMailingListStats(ctx context.Context, mailingListID string) (*model.MailingListStats, error)
+
+	// GetServiceTree returns a service with its mailing lists nested beneath it, optionally
+	// including each list's member count.
+	GetServiceTree(ctx context.Context, serviceUID string, includeMemberCounts bool) (*model.ServiceTree, error)
+
+	// ListMembersByProject pages through the members of every mailing list in a project.
+	ListMembersByProject(ctx context.Context, projectUID string, opts model.ListOptions) (*model.MemberPage, error)
+
+	// GetMemberHierarchy returns a member with its mailing list, service and project, reporting
+	// unresolvable parents as warnings rather than errors.
+	GetMemberHierarchy(ctx context.Context, mailingListUID string, memberUID string) (*model.MemberHierarchy, error)
+}
@@ -26,3 +26,23 @@ type GroupsIOServiceReader interface {
 	// FindParentService finds the parent service for a project by project UID.
 	FindParentService(ctx context.Context, projectUID string) (*model.GroupsIOService, error)
 }
+
+// GroupsIOServiceBatchReader fetches several services in one call.
+type GroupsIOServiceBatchReader interface {
+	// GetServices returns the services that exist among serviceIDs, keyed by ID.
+	// Missing IDs are omitted rather than reported as errors.
+	GetServices(ctx context.Context, serviceIDs []string) (map[string]*model.GroupsIOService, error)
+}
+
+// GroupsIOServiceQueryReader extends GroupsIOServiceReader with filtered views of the
+// services. ITX has no server-side filters for these, so implementations list and filter.
+type GroupsIOServiceQueryReader interface {
+	GroupsIOServiceReader
+
+	// ListServicesByStatus returns every service, across all projects, with the given status.
+	ListServicesByStatus(ctx context.Context, status string) ([]*model.GroupsIOService, error)
+
+	// ListUnprovisionedServices returns the services that have no Groups.io group ID,
+	// optionally filtered by project UID.
+	ListUnprovisionedServices(ctx context.Context, projectUID string) ([]*model.GroupsIOService, error)
+}
@@ -22,3 +22,19 @@ type GroupsIOServiceWriter interface {
 	// DeleteService deletes a GroupsIO service.
 	DeleteService(ctx context.Context, serviceID string) error
 }
+
+// GroupsIOServicePromoter changes a GroupsIO service's type in ITX. It is kept apart from
+// UpdateService because the update request omits empty fields and so cannot clear a prefix.
+type GroupsIOServicePromoter interface {
+	// PromoteServiceToPrimary sets the service's type to primary and clears its prefix.
+	PromoteServiceToPrimary(ctx context.Context, serviceID string) (*model.GroupsIOService, error)
+}
+
+// GroupsIOServiceManager extends GroupsIOServiceWriter with service operations the
+// orchestrator composes from several ITX calls.
+type GroupsIOServiceManager interface {
+	GroupsIOServiceWriter
+
+	// PromoteFormationToPrimary turns a formation service into its project's primary service.
+	PromoteFormationToPrimary(ctx context.Context, formationServiceUID string) (*model.ServicePromotion, error)
+}
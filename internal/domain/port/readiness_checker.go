@@ -0,0 +1,12 @@
+// Copyright The Linux Foundation and each contributor to LFX.
+// SPDX-License-Identifier: MIT
+
+package port
+
+import "context"
+
+// ReadinessChecker reports whether an external dependency can currently serve requests.
+type ReadinessChecker interface {
+	// IsReady returns nil when the dependency is usable, or an error describing why not.
+	IsReady(ctx context.Context) error
+}
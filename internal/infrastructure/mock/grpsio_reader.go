@@ -0,0 +1,271 @@
+// Copyright The Linux Foundation and each contributor to LFX.
+// SPDX-License-Identifier: MIT
+
+package mock
+
+import (
+	"context"
+	"sort"
+	"sync"
+
+	"github.com/linuxfoundation/lfx-v2-mailing-list-service/internal/domain/model"
+	"github.com/linuxfoundation/lfx-v2-mailing-list-service/internal/domain/port"
+	"github.com/linuxfoundation/lfx-v2-mailing-list-service/pkg/constants"
+	errs "github.com/linuxfoundation/lfx-v2-mailing-list-service/pkg/errors"
+)
+
+// FakeGroupsIOReader is an in-memory test double for the GroupsIO service,
+// mailing list and member reader ports. Seed it with AddService, AddMailingList
+// and AddMember. Reads return copies so callers cannot mutate seeded records.
+// Set Err to simulate a failure on every read.
+type FakeGroupsIOReader struct {
+	mu           sync.RWMutex
+	services     map[string]*model.GroupsIOService
+	mailingLists map[string]*model.GroupsIOMailingList
+	members      map[string]map[string]*model.GrpsIOMember
+	Err          error
+}
+
+var (
+	_ port.GroupsIOServiceReader           = (*FakeGroupsIOReader)(nil)
+	_ port.GroupsIOServiceBatchReader      = (*FakeGroupsIOReader)(nil)
+	_ port.GroupsIOMailingListReader       = (*FakeGroupsIOReader)(nil)
+	_ port.GroupsIOMailingListMemberReader = (*FakeGroupsIOReader)(nil)
+)
+
+// NewFakeGroupsIOReader returns an empty FakeGroupsIOReader.
+func NewFakeGroupsIOReader() *FakeGroupsIOReader {
+	return &FakeGroupsIOReader{
+		services:     make(map[string]*model.GroupsIOService),
+		mailingLists: make(map[string]*model.GroupsIOMailingList),
+		members:      make(map[string]map[string]*model.GrpsIOMember),
+	}
+}
+
+// AddService seeds a service keyed by its UID.
+func (f *FakeGroupsIOReader) AddService(svc *model.GroupsIOService) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.services[svc.UID] = svc.Clone()
+}
+
+// AddMailingList seeds a mailing list keyed by its UID.
+func (f *FakeGroupsIOReader) AddMailingList(ml *model.GroupsIOMailingList) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.mailingLists[ml.UID] = ml.Clone()
+}
+
+// AddMember seeds a member of the given mailing list keyed by its UID.
+func (f *FakeGroupsIOReader) AddMember(mailingListID string, m *model.GrpsIOMember) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	if f.members[mailingListID] == nil {
+		f.members[mailingListID] = make(map[string]*model.GrpsIOMember)
+	}
+	c := m.Clone()
+	c.MailingListUID = mailingListID
+	f.members[mailingListID][m.UID] = c
+}
+
+// ---- GroupsIOServiceReader ----
+
+// ListServices returns the seeded services for projectUID (all services when empty), ordered by UID.
+func (f *FakeGroupsIOReader) ListServices(_ context.Context, projectUID string) ([]*model.GroupsIOService, int, error) {
+	if f.Err != nil {
+		return nil, 0, f.Err
+	}
+	f.mu.RLock()
+	defer f.mu.RUnlock()
+	out := make([]*model.GroupsIOService, 0, len(f.services))
+	for _, svc := range f.services {
+		if projectUID == "" || svc.ProjectUID == projectUID {
+			out = append(out, svc.Clone())
+		}
+	}
+	sort.Slice(out, func(i, j int) bool { return out[i].UID < out[j].UID })
+	return out, len(out), nil
+}
+
+// GetService returns the seeded service or NotFound.
+func (f *FakeGroupsIOReader) GetService(_ context.Context, serviceID string) (*model.GroupsIOService, error) {
+	if f.Err != nil {
+		return nil, f.Err
+	}
+	f.mu.RLock()
+	defer f.mu.RUnlock()
+	svc, ok := f.services[serviceID]
+	if !ok {
+		return nil, errs.NewNotFound("service not found")
+	}
+	return svc.Clone(), nil
+}
+
+// GetServices returns the seeded services among serviceIDs, omitting unknown IDs.
+func (f *FakeGroupsIOReader) GetServices(_ context.Context, serviceIDs []string) (map[string]*model.GroupsIOService, error) {
+	if f.Err != nil {
+		return nil, f.Err
+	}
+	f.mu.RLock()
+	defer f.mu.RUnlock()
+	out := make(map[string]*model.GroupsIOService, len(serviceIDs))
+	for _, id := range serviceIDs {
+		if svc, ok := f.services[id]; ok {
+			out[id] = svc.Clone()
+		}
+	}
+	return out, nil
+}
+
+// GetProjects returns the distinct project UIDs of the seeded services, sorted.
+func (f *FakeGroupsIOReader) GetProjects(_ context.Context) ([]string, error) {
+	if f.Err != nil {
+		return nil, f.Err
+	}
+	f.mu.RLock()
+	defer f.mu.RUnlock()
+	seen := make(map[string]struct{})
+	for _, svc := range f.services {
+		if svc.ProjectUID != "" {
+			seen[svc.ProjectUID] = struct{}{}
+		}
+	}
+	out := make([]string, 0, len(seen))
+	for id := range seen {
+		out = append(out, id)
+	}
+	sort.Strings(out)
+	return out, nil
+}
+
+// FindParentService returns the project's primary service, NotFound when there is none,
+// or Unexpected when more than one primary service was seeded.
+func (f *FakeGroupsIOReader) FindParentService(ctx context.Context, projectUID string) (*model.GroupsIOService, error) {
+	svcs, _, err := f.ListServices(ctx, projectUID)
+	if err != nil {
+		return nil, err
+	}
+	var primary *model.GroupsIOService
+	for _, svc := range svcs {
+		if svc.Type != constants.ITXServiceTypePrimary {
+			continue
+		}
+		if primary != nil {
+			return nil, errs.NewUnexpected("project has more than one primary service")
+		}
+		primary = svc
+	}
+	if primary == nil {
+		return nil, errs.NewNotFound("no parent service found for project")
+	}
+	return primary, nil
+}
+
+// ---- GroupsIOMailingListReader ----
+
+// ListMailingLists returns the seeded mailing lists matching projectUID and committeeUID
+// (either may be empty to skip that filter), ordered by UID.
+func (f *FakeGroupsIOReader) ListMailingLists(_ context.Context, projectUID string, committeeUID string) ([]*model.GroupsIOMailingList, int, error) {
+	if f.Err != nil {
+		return nil, 0, f.Err
+	}
+	f.mu.RLock()
+	defer f.mu.RUnlock()
+	out := make([]*model.GroupsIOMailingList, 0, len(f.mailingLists))
+	for _, ml := range f.mailingLists {
+		if projectUID != "" && ml.ProjectUID != projectUID {
+			continue
+		}
+		if committeeUID != "" && !hasCommittee(ml, committeeUID) {
+			continue
+		}
+		out = append(out, ml.Clone())
+	}
+	sort.Slice(out, func(i, j int) bool { return out[i].UID < out[j].UID })
+	return out, len(out), nil
+}
+
+// GetMailingList returns the seeded mailing list or NotFound.
+func (f *FakeGroupsIOReader) GetMailingList(_ context.Context, mailingListID string) (*model.GroupsIOMailingList, error) {
+	if f.Err != nil {
+		return nil, f.Err
+	}
+	f.mu.RLock()
+	defer f.mu.RUnlock()
+	ml, ok := f.mailingLists[mailingListID]
+	if !ok {
+		return nil, errs.NewNotFound("mailing list not found")
+	}
+	return ml.Clone(), nil
+}
+
+// GetMailingListCount returns the number of seeded mailing lists for projectUID.
+func (f *FakeGroupsIOReader) GetMailingListCount(ctx context.Context, projectUID string) (int, error) {
+	_, total, err := f.ListMailingLists(ctx, projectUID, "")
+	return total, err
+}
+
+// GetMailingListMemberCount returns the number of seeded members of the mailing list.
+func (f *FakeGroupsIOReader) GetMailingListMemberCount(_ context.Context, mailingListID string) (int, error) {
+	if f.Err != nil {
+		return 0, f.Err
+	}
+	f.mu.RLock()
+	defer f.mu.RUnlock()
+	return len(f.members[mailingListID]), nil
+}
+
+// ---- GroupsIOMailingListMemberReader ----
+
+// ListMembers returns the seeded members of the mailing list, ordered by UID.
+func (f *FakeGroupsIOReader) ListMembers(_ context.Context, mailingListID string) ([]*model.GrpsIOMember, int, error) {
+	if f.Err != nil {
+		return nil, 0, f.Err
+	}
+	f.mu.RLock()
+	defer f.mu.RUnlock()
+	out := make([]*model.GrpsIOMember, 0, len(f.members[mailingListID]))
+	for _, m := range f.members[mailingListID] {
+		out = append(out, m.Clone())
+	}
+	sort.Slice(out, func(i, j int) bool { return out[i].UID < out[j].UID })
+	return out, len(out), nil
+}
+
+// GetMember returns the seeded member or NotFound.
+func (f *FakeGroupsIOReader) GetMember(_ context.Context, mailingListID string, memberID string) (*model.GrpsIOMember, error) {
+	if f.Err != nil {
+		return nil, f.Err
+	}
+	f.mu.RLock()
+	defer f.mu.RUnlock()
+	m, ok := f.members[mailingListID][memberID]
+	if !ok {
+		return nil, errs.NewNotFound("member not found")
+	}
+	return m.Clone(), nil
+}
+
+// CheckSubscriber reports whether a seeded member of the mailing list has the given email.
+func (f *FakeGroupsIOReader) CheckSubscriber(_ context.Context, mailingListID string, email string) (bool, error) {
+	if f.Err != nil {
+		return false, f.Err
+	}
+	f.mu.RLock()
+	defer f.mu.RUnlock()
+	for _, m := range f.members[mailingListID] {
+		if m.Email == email {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+func hasCommittee(ml *model.GroupsIOMailingList, committeeUID string) bool {
+	for _, c := range ml.Committees {
+		if c.UID == committeeUID {
+			return true
+		}
+	}
+	return false
+}
@@ -59,6 +59,7 @@ func fromWireSubgroup(w *subgroupWire) *model.GroupsIOMailingList {
 		Description:    w.Description,
 		Type:           w.Type,
 		AudienceAccess: w.AudienceAccess,
+		Frozen:         w.Frozen,
 		CreatedAt:      createdAt,
 		UpdatedAt:      updatedAt,
 	}
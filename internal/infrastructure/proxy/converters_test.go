@@ -182,6 +182,7 @@ func (s *ProxyConvertersSuite) TestFromWireSubgroup() {
 		expectDescription string
 		expectType        string
 		expectAccess      string
+		expectFrozen      bool
 		expectCommittees  []model.Committee
 		expectCreatedAt   time.Time
 		expectUpdatedAt   time.Time
@@ -232,6 +233,7 @@ func (s *ProxyConvertersSuite) TestFromWireSubgroup() {
 				Description:    "A description",
 				Type:           "announcement",
 				AudienceAccess: "public",
+				Frozen:         true,
 			},
 			expectUID:         "42",
 			expectProjectUID:  "proj-sfid",
@@ -240,6 +242,7 @@ func (s *ProxyConvertersSuite) TestFromWireSubgroup() {
 			expectDescription: "A description",
 			expectType:        "announcement",
 			expectAccess:      "public",
+			expectFrozen:      true,
 		},
 	}
 
@@ -258,6 +261,7 @@ func (s *ProxyConvertersSuite) TestFromWireSubgroup() {
 			s.Equal(tt.expectDescription, got.Description)
 			s.Equal(tt.expectType, got.Type)
 			s.Equal(tt.expectAccess, got.AudienceAccess)
+			s.Equal(tt.expectFrozen, got.Frozen)
 			s.Equal(tt.expectCommittees, got.Committees)
 			s.Equal(tt.expectCreatedAt, got.CreatedAt)
 			s.Equal(tt.expectUpdatedAt, got.UpdatedAt)
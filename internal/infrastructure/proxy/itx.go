@@ -10,6 +10,7 @@ import (
 	"encoding/json"
 	"errors"
 	"fmt"
+	"io"
 	"log/slog"
 	"net/http"
 	"net/url"
@@ -19,6 +20,7 @@ import (
 	"github.com/linuxfoundation/lfx-v2-mailing-list-service/internal/domain/model"
 	"github.com/linuxfoundation/lfx-v2-mailing-list-service/internal/domain/port"
 	pkgauth "github.com/linuxfoundation/lfx-v2-mailing-list-service/pkg/auth"
+	"github.com/linuxfoundation/lfx-v2-mailing-list-service/pkg/constants"
 	errs "github.com/linuxfoundation/lfx-v2-mailing-list-service/pkg/errors"
 	"github.com/linuxfoundation/lfx-v2-mailing-list-service/pkg/httpclient"
 	"go.opentelemetry.io/contrib/instrumentation/net/http/otelhttp"
@@ -35,12 +37,20 @@ type Config struct {
 	Auth0Domain string
 	Audience    string
 	Timeout     time.Duration
+	// ReadTimeout bounds a single read-only call to ITX (GETs, and POST queries such as
+	// the subscriber check), retries included.
+	// Zero means the call only inherits the caller's deadline.
+	ReadTimeout time.Duration
+	// WriteTimeout bounds a single mutating POST/PUT/DELETE call to ITX, retries included.
+	// Zero means the call only inherits the caller's deadline.
+	WriteTimeout time.Duration
 }
 
 // itx implements port.GroupsIOServiceWriter via the ITX HTTP API.
 type itx struct {
-	httpClient *httpclient.Client
-	config     Config
+	httpClient  *httpclient.Client
+	config      Config
+	tokenSource oauth2.TokenSource
 }
 
 // buildURL constructs an ITX endpoint URL from path segments using url.JoinPath,
@@ -66,8 +76,52 @@ func (c *itx) mapHTTPError(statusCode int, body []byte) error {
 	}
 }
 
+// operationTimeout returns the per-call timeout for an operation: ReadTimeout when it only
+// reads, WriteTimeout when it changes state.
+func (c *itx) operationTimeout(readOnly bool) time.Duration {
+	if readOnly {
+		return c.config.ReadTimeout
+	}
+	return c.config.WriteTimeout
+}
+
+// request issues an ITX call bounded by the per-operation timeout, treating GETs as reads
+// and every other method as a write.
+func (c *itx) request(ctx context.Context, method, u string, body io.Reader, headers map[string]string) (*httpclient.Response, error) {
+	return c.do(ctx, method, u, body, headers, method == http.MethodGet)
+}
+
+// readRequest issues a read-only ITX call that ITX exposes with a non-GET method (e.g. a POST
+// query), bounded by the read timeout.
+func (c *itx) readRequest(ctx context.Context, method, u string, body io.Reader, headers map[string]string) (*httpclient.Response, error) {
+	return c.do(ctx, method, u, body, headers, true)
+}
+
+// do issues an ITX call bounded by the timeout for its operation. When the derived deadline
+// expires (and the caller's did not), a Timeout error is returned so a hung upstream cannot
+// block the caller indefinitely.
+func (c *itx) do(ctx context.Context, method, u string, body io.Reader, headers map[string]string, readOnly bool) (*httpclient.Response, error) {
+	timeout := c.operationTimeout(readOnly)
+	if timeout <= 0 {
+		return c.httpClient.Request(ctx, method, u, body, headers)
+	}
+
+	callCtx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	resp, err := c.httpClient.Request(callCtx, method, u, body, headers)
+	if err != nil && ctx.Err() == nil && errors.Is(callCtx.Err(), context.DeadlineExceeded) {
+		return nil, errs.NewTimeout(fmt.Sprintf("ITX %s request timed out after %s", method, timeout), err)
+	}
+	return resp, err
+}
+
 // handleRequestError converts a httpclient error into a domain error.
 func (c *itx) handleRequestError(err error) error {
+	var timeoutErr errs.Timeout
+	if errors.As(err, &timeoutErr) {
+		return err
+	}
 	var retryErr *httpclient.RetryableError
 	if errors.As(err, &retryErr) {
 		return c.mapHTTPError(retryErr.StatusCode, []byte(retryErr.Message))
@@ -88,7 +142,7 @@ func (c *itx) CreateService(ctx context.Context, svc *model.GroupsIOService) (*m
 	if err != nil {
 		return nil, errs.NewUnexpected("failed to build URL", err)
 	}
-	resp, err := c.httpClient.Request(ctx, http.MethodPost, u, bytes.NewReader(bodyBytes), map[string]string{"Content-Type": "application/json"})
+	resp, err := c.request(ctx, http.MethodPost, u, bytes.NewReader(bodyBytes), map[string]string{"Content-Type": "application/json"})
 	if err != nil {
 		return nil, c.handleRequestError(err)
 	}
@@ -102,7 +156,17 @@ func (c *itx) CreateService(ctx context.Context, svc *model.GroupsIOService) (*m
 
 // UpdateService updates a GroupsIO service.
 func (c *itx) UpdateService(ctx context.Context, serviceID string, svc *model.GroupsIOService) (*model.GroupsIOService, error) {
-	bodyBytes, err := json.Marshal(toWireServiceRequest(svc))
+	return c.putService(ctx, serviceID, toWireServiceRequest(svc))
+}
+
+// PromoteServiceToPrimary sets a GroupsIO service's type to primary and clears its prefix.
+func (c *itx) PromoteServiceToPrimary(ctx context.Context, serviceID string) (*model.GroupsIOService, error) {
+	return c.putService(ctx, serviceID, &servicePromotionWire{Type: constants.ITXServiceTypePrimary})
+}
+
+// putService sends body as the update request of a GroupsIO service.
+func (c *itx) putService(ctx context.Context, serviceID string, body any) (*model.GroupsIOService, error) {
+	bodyBytes, err := json.Marshal(body)
 	if err != nil {
 		return nil, errs.NewUnexpected("failed to marshal request", err)
 	}
@@ -111,7 +175,7 @@ func (c *itx) UpdateService(ctx context.Context, serviceID string, svc *model.Gr
 	if err != nil {
 		return nil, errs.NewUnexpected("failed to build URL", err)
 	}
-	resp, err := c.httpClient.Request(ctx, http.MethodPut, u, bytes.NewReader(bodyBytes), map[string]string{"Content-Type": "application/json"})
+	resp, err := c.request(ctx, http.MethodPut, u, bytes.NewReader(bodyBytes), map[string]string{"Content-Type": "application/json"})
 	if err != nil {
 		return nil, c.handleRequestError(err)
 	}
@@ -134,7 +198,7 @@ func (c *itx) DeleteService(ctx context.Context, serviceID string) error {
 	if err != nil {
 		return errs.NewUnexpected("failed to build URL", err)
 	}
-	_, err = c.httpClient.Request(ctx, http.MethodDelete, u, nil, nil)
+	_, err = c.request(ctx, http.MethodDelete, u, nil, nil)
 	if err != nil {
 		return c.handleRequestError(err)
 	}
@@ -147,7 +211,7 @@ func (c *itx) getService(ctx context.Context, serviceID string) (*model.GroupsIO
 	if err != nil {
 		return nil, errs.NewUnexpected("failed to build URL", err)
 	}
-	resp, err := c.httpClient.Request(ctx, http.MethodGet, u, nil, nil)
+	resp, err := c.request(ctx, http.MethodGet, u, nil, nil)
 	if err != nil {
 		return nil, c.handleRequestError(err)
 	}
@@ -166,7 +230,7 @@ func (c *itx) getSubgroup(ctx context.Context, mailingListID string) (*model.Gro
 	if err != nil {
 		return nil, errs.NewUnexpected("failed to build URL", err)
 	}
-	resp, err := c.httpClient.Request(ctx, http.MethodGet, u, nil, nil)
+	resp, err := c.request(ctx, http.MethodGet, u, nil, nil)
 	if err != nil {
 		return nil, c.handleRequestError(err)
 	}
@@ -188,7 +252,7 @@ func (c *itx) CreateMailingList(ctx context.Context, ml *model.GroupsIOMailingLi
 	if err != nil {
 		return nil, errs.NewUnexpected("failed to build URL", err)
 	}
-	resp, err := c.httpClient.Request(ctx, http.MethodPost, u, bytes.NewReader(bodyBytes), map[string]string{"Content-Type": "application/json"})
+	resp, err := c.request(ctx, http.MethodPost, u, bytes.NewReader(bodyBytes), map[string]string{"Content-Type": "application/json"})
 	if err != nil {
 		return nil, c.handleRequestError(err)
 	}
@@ -211,7 +275,7 @@ func (c *itx) UpdateMailingList(ctx context.Context, mailingListID string, ml *m
 	if err != nil {
 		return nil, errs.NewUnexpected("failed to build URL", err)
 	}
-	resp, err := c.httpClient.Request(ctx, http.MethodPut, u, bytes.NewReader(bodyBytes), map[string]string{"Content-Type": "application/json"})
+	resp, err := c.request(ctx, http.MethodPut, u, bytes.NewReader(bodyBytes), map[string]string{"Content-Type": "application/json"})
 	if err != nil {
 		return nil, c.handleRequestError(err)
 	}
@@ -234,7 +298,7 @@ func (c *itx) DeleteMailingList(ctx context.Context, mailingListID string) error
 	if err != nil {
 		return errs.NewUnexpected("failed to build URL", err)
 	}
-	_, err = c.httpClient.Request(ctx, http.MethodDelete, u, nil, nil)
+	_, err = c.request(ctx, http.MethodDelete, u, nil, nil)
 	if err != nil {
 		return c.handleRequestError(err)
 	}
@@ -249,7 +313,7 @@ func (c *itx) ListMembers(ctx context.Context, mailingListID string) ([]*model.G
 	if err != nil {
 		return nil, 0, errs.NewUnexpected("failed to build URL", err)
 	}
-	resp, err := c.httpClient.Request(ctx, http.MethodGet, u, nil, nil)
+	resp, err := c.request(ctx, http.MethodGet, u, nil, nil)
 	if err != nil {
 		return nil, 0, c.handleRequestError(err)
 	}
@@ -282,7 +346,8 @@ func (c *itx) CheckSubscriber(ctx context.Context, mailingListID string, email s
 	if err != nil {
 		return false, errs.NewUnexpected("failed to build URL", err)
 	}
-	resp, err := c.httpClient.Request(ctx, http.MethodPost, u, bytes.NewReader(bodyBytes), map[string]string{"Content-Type": "application/json"})
+	// The check only reads, so it gets the read timeout despite being a POST.
+	resp, err := c.readRequest(ctx, http.MethodPost, u, bytes.NewReader(bodyBytes), map[string]string{"Content-Type": "application/json"})
 	if err != nil {
 		return false, c.handleRequestError(err)
 	}
@@ -302,7 +367,7 @@ func (c *itx) getMember(ctx context.Context, mailingListID string, memberID stri
 	if err != nil {
 		return nil, errs.NewUnexpected("failed to build URL", err)
 	}
-	resp, err := c.httpClient.Request(ctx, http.MethodGet, u, nil, nil)
+	resp, err := c.request(ctx, http.MethodGet, u, nil, nil)
 	if err != nil {
 		return nil, c.handleRequestError(err)
 	}
@@ -324,7 +389,7 @@ func (c *itx) AddMember(ctx context.Context, mailingListID string, member *model
 	if err != nil {
 		return nil, errs.NewUnexpected("failed to build URL", err)
 	}
-	resp, err := c.httpClient.Request(ctx, http.MethodPost, u, bytes.NewReader(bodyBytes), map[string]string{"Content-Type": "application/json"})
+	resp, err := c.request(ctx, http.MethodPost, u, bytes.NewReader(bodyBytes), map[string]string{"Content-Type": "application/json"})
 	if err != nil {
 		return nil, c.handleRequestError(err)
 	}
@@ -347,7 +412,7 @@ func (c *itx) UpdateMember(ctx context.Context, mailingListID string, memberID s
 	if err != nil {
 		return nil, errs.NewUnexpected("failed to build URL", err)
 	}
-	resp, err := c.httpClient.Request(ctx, http.MethodPut, u, bytes.NewReader(bodyBytes), map[string]string{"Content-Type": "application/json"})
+	resp, err := c.request(ctx, http.MethodPut, u, bytes.NewReader(bodyBytes), map[string]string{"Content-Type": "application/json"})
 	if err != nil {
 		return nil, c.handleRequestError(err)
 	}
@@ -370,7 +435,7 @@ func (c *itx) DeleteMember(ctx context.Context, mailingListID string, memberID s
 	if err != nil {
 		return errs.NewUnexpected("failed to build URL", err)
 	}
-	_, err = c.httpClient.Request(ctx, http.MethodDelete, u, nil, nil)
+	_, err = c.request(ctx, http.MethodDelete, u, nil, nil)
 	if err != nil {
 		return c.handleRequestError(err)
 	}
@@ -388,7 +453,7 @@ func (c *itx) InviteMembers(ctx context.Context, mailingListID string, emails []
 	if err != nil {
 		return errs.NewUnexpected("failed to build URL", err)
 	}
-	_, err = c.httpClient.Request(ctx, http.MethodPost, u, bytes.NewReader(bodyBytes), map[string]string{"Content-Type": "application/json"})
+	_, err = c.request(ctx, http.MethodPost, u, bytes.NewReader(bodyBytes), map[string]string{"Content-Type": "application/json"})
 	if err != nil {
 		return c.handleRequestError(err)
 	}
@@ -409,7 +474,7 @@ func (c *itx) AcceptInvite(ctx context.Context, email, username string) error {
 		return errs.NewUnexpected("failed to build accept-invite URL", err)
 	}
 
-	_, err = c.httpClient.Request(ctx, http.MethodPost, u, bytes.NewReader(body), map[string]string{
+	_, err = c.request(ctx, http.MethodPost, u, bytes.NewReader(body), map[string]string{
 		"Content-Type": "application/json",
 	})
 	if err != nil {
@@ -437,7 +502,7 @@ func (c *itx) ListMailingLists(ctx context.Context, projectID string, committeeI
 		u += "?" + q.Encode()
 	}
 
-	resp, err := c.httpClient.Request(ctx, http.MethodGet, u, nil, nil)
+	resp, err := c.request(ctx, http.MethodGet, u, nil, nil)
 	if err != nil {
 		return nil, 0, c.handleRequestError(err)
 	}
@@ -467,7 +532,7 @@ func (c *itx) GetMailingListCount(ctx context.Context, projectID string) (int, e
 	}
 	u += "?" + url.Values{"project_id": {projectID}}.Encode()
 
-	resp, err := c.httpClient.Request(ctx, http.MethodGet, u, nil, nil)
+	resp, err := c.request(ctx, http.MethodGet, u, nil, nil)
 	if err != nil {
 		return 0, c.handleRequestError(err)
 	}
@@ -485,7 +550,7 @@ func (c *itx) GetMailingListMemberCount(ctx context.Context, mailingListID strin
 	if err != nil {
 		return 0, errs.NewUnexpected("failed to build URL", err)
 	}
-	resp, err := c.httpClient.Request(ctx, http.MethodGet, u, nil, nil)
+	resp, err := c.request(ctx, http.MethodGet, u, nil, nil)
 	if err != nil {
 		return 0, c.handleRequestError(err)
 	}
@@ -509,7 +574,7 @@ func (c *itx) listServices(ctx context.Context, projectID string) (*serviceListR
 		u += "?" + url.Values{"project_id": {projectID}}.Encode()
 	}
 
-	resp, err := c.httpClient.Request(ctx, http.MethodGet, u, nil, nil)
+	resp, err := c.request(ctx, http.MethodGet, u, nil, nil)
 	if err != nil {
 		return nil, c.handleRequestError(err)
 	}
@@ -568,12 +633,48 @@ func (c *itx) FindParentService(ctx context.Context, projectID string) (*model.G
 		return nil, err
 	}
 
+	var primary *serviceWire
 	for _, item := range wire.Items {
-		if item.Type == "v2_primary" {
-			return fromWireService(item), nil
+		if item.Type != constants.ITXServiceTypePrimary {
+			continue
+		}
+		if primary != nil {
+			// ITX enforces one primary service per project; reaching here means that
+			// constraint was violated, and silently picking one would hide it.
+			return nil, errs.NewUnexpected(fmt.Sprintf("project %s has more than one primary service (%s, %s)", projectID, primary.ID, item.ID))
+		}
+		primary = item
+	}
+	if primary == nil {
+		return nil, errs.NewNotFound("no parent service found for project")
+	}
+	return fromWireService(primary), nil
+}
+
+// ---- ReadinessChecker implementation ----
+
+// IsReady verifies that an ITX access token can be obtained, which surfaces broken
+// credentials or an unreachable Auth0 tenant before the first write fails.
+// oauth2.TokenSource takes no context, so the fetch runs in its own goroutine and
+// IsReady gives up when ctx is done; a stuck token endpoint cannot hang the probe.
+func (c *itx) IsReady(ctx context.Context) error {
+	if c.tokenSource == nil {
+		return nil
+	}
+	result := make(chan error, 1)
+	go func() {
+		_, err := c.tokenSource.Token()
+		result <- err
+	}()
+	select {
+	case err := <-result:
+		if err != nil {
+			return errs.NewServiceUnavailable("unable to obtain ITX access token", err)
 		}
+		return nil
+	case <-ctx.Done():
+		return errs.NewServiceUnavailable("timed out obtaining ITX access token", ctx.Err())
 	}
-	return nil, errs.NewNotFound("no parent service found for project")
 }
 
 // ---- GroupsIOArtifactReader implementation ----
@@ -584,7 +685,7 @@ func (c *itx) GetArtifact(ctx context.Context, subgroupID string, artifactID str
 	if err != nil {
 		return nil, errs.NewUnexpected("failed to build URL", err)
 	}
-	resp, err := c.httpClient.Request(ctx, http.MethodGet, u, nil, nil)
+	resp, err := c.request(ctx, http.MethodGet, u, nil, nil)
 	if err != nil {
 		return nil, c.handleRequestError(err)
 	}
@@ -601,7 +702,7 @@ func (c *itx) GetArtifactDownloadURL(ctx context.Context, subgroupID string, art
 	if err != nil {
 		return "", errs.NewUnexpected("failed to build URL", err)
 	}
-	resp, err := c.httpClient.Request(ctx, http.MethodGet, u, nil, nil)
+	resp, err := c.request(ctx, http.MethodGet, u, nil, nil)
 	if err != nil {
 		return "", c.handleRequestError(err)
 	}
@@ -639,9 +740,9 @@ func NewProxy(ctx context.Context, config Config) (port.GroupsIOReaderWriter, er
 		return nil, fmt.Errorf("failed to create Auth0 authentication client: %w", err)
 	}
 
-	tokenSource := pkgauth.NewAuth0TokenSource(ctx, authConfig, config.Audience, itxScope)
+	tokenSource := oauth2.ReuseTokenSource(nil, pkgauth.NewAuth0TokenSource(ctx, authConfig, config.Audience, itxScope))
 	// Wrap the oauth2 transport with otelhttp so ITX API calls appear in traces.
-	oauthHTTPClient := oauth2.NewClient(ctx, oauth2.ReuseTokenSource(nil, tokenSource))
+	oauthHTTPClient := oauth2.NewClient(ctx, tokenSource)
 	oauthHTTPClient.Transport = otelhttp.NewTransport(oauthHTTPClient.Transport)
 	oauthHTTPClient.Timeout = config.Timeout
 
@@ -651,6 +752,7 @@ func NewProxy(ctx context.Context, config Config) (port.GroupsIOReaderWriter, er
 				Timeout: config.Timeout,
 			},
 			oauthHTTPClient),
-		config: config,
+		config:      config,
+		tokenSource: tokenSource,
 	}, nil
 }
@@ -0,0 +1,237 @@
+// Copyright The Linux Foundation and each contributor to LFX.
+// SPDX-License-Identifier: MIT
+
+package proxy
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/linuxfoundation/lfx-v2-mailing-list-service/internal/domain/model"
+	"github.com/linuxfoundation/lfx-v2-mailing-list-service/pkg/constants"
+	errs "github.com/linuxfoundation/lfx-v2-mailing-list-service/pkg/errors"
+	"github.com/linuxfoundation/lfx-v2-mailing-list-service/pkg/httpclient"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/oauth2"
+)
+
+// newTestITX returns an itx client pointed at the given stub server without OAuth.
+func newTestITX(baseURL string, config Config) *itx {
+	config.BaseURL = baseURL
+	return &itx{
+		httpClient: httpclient.NewClient(httpclient.Config{}),
+		config:     config,
+	}
+}
+
+func TestRequest_ReadTimeoutExpires_ReturnsTimeoutError(t *testing.T) {
+	release := make(chan struct{})
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		select {
+		case <-release:
+		case <-time.After(2 * time.Second):
+		}
+		_, _ = w.Write([]byte(`{"items":[],"total":0}`))
+	}))
+	defer srv.Close()
+	defer close(release)
+
+	c := newTestITX(srv.URL, Config{ReadTimeout: 50 * time.Millisecond, WriteTimeout: time.Minute})
+
+	_, _, err := c.ListServices(context.Background(), "proj-1")
+	require.Error(t, err)
+	var timeoutErr errs.Timeout
+	assert.True(t, errors.As(err, &timeoutErr), "expected errs.Timeout, got %T: %v", err, err)
+}
+
+func TestRequest_WriteTimeoutIndependentOfReadTimeout(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		time.Sleep(100 * time.Millisecond)
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	defer srv.Close()
+
+	// A short read timeout must not cut off a write that fits its own budget.
+	c := newTestITX(srv.URL, Config{ReadTimeout: 10 * time.Millisecond, WriteTimeout: time.Second})
+
+	err := c.DeleteService(context.Background(), "svc-1")
+	assert.NoError(t, err)
+}
+
+func TestCheckSubscriber_ReadOnlyPost_UsesReadTimeout(t *testing.T) {
+	release := make(chan struct{})
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		select {
+		case <-release:
+		case <-time.After(2 * time.Second):
+		}
+		_, _ = w.Write([]byte(`{"subscribed":true}`))
+	}))
+	defer srv.Close()
+	defer close(release)
+
+	// The subscriber check is a POST but only reads, so the short read timeout applies.
+	c := newTestITX(srv.URL, Config{ReadTimeout: 50 * time.Millisecond, WriteTimeout: time.Minute})
+
+	_, err := c.CheckSubscriber(context.Background(), "ml-1", "a@example.com")
+	require.Error(t, err)
+	var timeoutErr errs.Timeout
+	assert.True(t, errors.As(err, &timeoutErr), "expected errs.Timeout, got %T: %v", err, err)
+}
+
+func TestRequest_CallerDeadline_NotReportedAsTimeout(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		time.Sleep(200 * time.Millisecond)
+	}))
+	defer srv.Close()
+
+	c := newTestITX(srv.URL, Config{ReadTimeout: time.Second})
+
+	ctx, cancel := context.WithTimeout(context.Background(), 20*time.Millisecond)
+	defer cancel()
+
+	_, err := c.GetService(ctx, "svc-1")
+	require.Error(t, err)
+	var timeoutErr errs.Timeout
+	assert.False(t, errors.As(err, &timeoutErr))
+}
+
+// ---- FindParentService ----
+
+func serveServices(t *testing.T, body string) *httptest.Server {
+	t.Helper()
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(body))
+	}))
+	t.Cleanup(srv.Close)
+	return srv
+}
+
+func TestFindParentService_NoPrimary_ReturnsNotFound(t *testing.T) {
+	srv := serveServices(t, `{"items":[{"id":"svc-2","type":"v2_formation"}],"total":1}`)
+	c := newTestITX(srv.URL, Config{})
+
+	_, err := c.FindParentService(context.Background(), "proj-1")
+	assert.IsType(t, errs.NotFound{}, err)
+}
+
+func TestFindParentService_OnePrimary_ReturnsIt(t *testing.T) {
+	srv := serveServices(t, `{"items":[{"id":"svc-2","type":"v2_formation"},{"id":"svc-1","type":"v2_primary"}],"total":2}`)
+	c := newTestITX(srv.URL, Config{})
+
+	svc, err := c.FindParentService(context.Background(), "proj-1")
+	require.NoError(t, err)
+	assert.Equal(t, "svc-1", svc.UID)
+}
+
+func TestFindParentService_MultiplePrimaries_ReturnsUnexpected(t *testing.T) {
+	srv := serveServices(t, `{"items":[{"id":"svc-1","type":"v2_primary"},{"id":"svc-3","type":"v2_primary"}],"total":2}`)
+	c := newTestITX(srv.URL, Config{})
+
+	_, err := c.FindParentService(context.Background(), "proj-1")
+	require.Error(t, err)
+	assert.IsType(t, errs.Unexpected{}, err)
+	assert.Contains(t, err.Error(), "svc-1")
+	assert.Contains(t, err.Error(), "svc-3")
+}
+
+// ---- UpdateMember ----
+
+func TestUpdateMember_ProfileFields_SentToITX(t *testing.T) {
+	var body map[string]any
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		raw, _ := io.ReadAll(r.Body)
+		_ = json.Unmarshal(raw, &body)
+		_, _ = w.Write([]byte(`{"id":"m-1","organization":"Acme","job_title":"Engineer"}`))
+	}))
+	defer srv.Close()
+	c := newTestITX(srv.URL, Config{})
+
+	got, err := c.UpdateMember(context.Background(), "ml-1", "m-1", &model.GrpsIOMember{
+		Organization: "Acme",
+		JobTitle:     "Engineer",
+	})
+	require.NoError(t, err)
+	assert.Equal(t, "Acme", body["organization"])
+	assert.Equal(t, "Engineer", body["job_title"])
+	assert.Equal(t, "Acme", got.Organization)
+	assert.Equal(t, "Engineer", got.JobTitle)
+}
+
+func TestPromoteServiceToPrimary_SendsEmptyPrefix(t *testing.T) {
+	var method string
+	var body map[string]any
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		method = r.Method
+		raw, _ := io.ReadAll(r.Body)
+		_ = json.Unmarshal(raw, &body)
+		_, _ = w.Write([]byte(`{"id":"svc-1","type":"v2_primary"}`))
+	}))
+	defer srv.Close()
+	c := newTestITX(srv.URL, Config{})
+
+	got, err := c.PromoteServiceToPrimary(context.Background(), "svc-1")
+	require.NoError(t, err)
+	assert.Equal(t, http.MethodPut, method)
+	assert.Equal(t, constants.ITXServiceTypePrimary, body["type"])
+	prefix, ok := body["prefix"]
+	assert.True(t, ok, "prefix must be sent so ITX clears it")
+	assert.Equal(t, "", prefix)
+	assert.Equal(t, constants.ITXServiceTypePrimary, got.Type)
+}
+
+func TestUpdateService_EmptyPrefix_IsOmitted(t *testing.T) {
+	var body map[string]any
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		raw, _ := io.ReadAll(r.Body)
+		_ = json.Unmarshal(raw, &body)
+		_, _ = w.Write([]byte(`{"id":"svc-1","type":"v2_primary"}`))
+	}))
+	defer srv.Close()
+	c := newTestITX(srv.URL, Config{})
+
+	_, err := c.UpdateService(context.Background(), "svc-1", &model.GroupsIOService{Type: constants.ITXServiceTypePrimary})
+	require.NoError(t, err)
+	assert.NotContains(t, body, "prefix")
+}
+
+// slowTokenSource blocks until release is closed, mimicking a stuck token endpoint.
+type slowTokenSource struct {
+	release chan struct{}
+}
+
+func (s *slowTokenSource) Token() (*oauth2.Token, error) {
+	<-s.release
+	return &oauth2.Token{AccessToken: "token"}, nil
+}
+
+func TestIsReady_SlowTokenSource_ReturnsWhenContextExpires(t *testing.T) {
+	ts := &slowTokenSource{release: make(chan struct{})}
+	defer close(ts.release)
+	c := &itx{tokenSource: ts}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+
+	start := time.Now()
+	err := c.IsReady(ctx)
+	require.Error(t, err)
+	assert.Less(t, time.Since(start), time.Second)
+	var unavailable errs.ServiceUnavailable
+	assert.True(t, errors.As(err, &unavailable), "expected errs.ServiceUnavailable, got %T: %v", err, err)
+}
+
+func TestIsReady_TokenAvailable_ReturnsNil(t *testing.T) {
+	ts := &slowTokenSource{release: make(chan struct{})}
+	close(ts.release)
+	c := &itx{tokenSource: ts}
+
+	assert.NoError(t, c.IsReady(context.Background()))
+}
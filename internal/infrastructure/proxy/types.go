@@ -27,6 +27,13 @@ type serviceRequestWire struct {
 	Status    string `json:"status,omitempty"`
 }
 
+// servicePromotionWire represents the update request that promotes a GroupsIO service to
+// primary. Prefix has no omitempty, so the empty string reaches ITX and clears the prefix.
+type servicePromotionWire struct {
+	Type   string `json:"type"`
+	Prefix string `json:"prefix"`
+}
+
 // serviceListResponseWire represents a list response of GroupsIO services from the ITX API.
 type serviceListResponseWire struct {
 	Items []*serviceWire `json:"items"`
@@ -44,6 +51,7 @@ type subgroupWire struct {
 	Description    string `json:"description,omitempty"`
 	Type           string `json:"type,omitempty"`
 	AudienceAccess string `json:"visibility,omitempty"`
+	Frozen         bool   `json:"frozen,omitempty"`
 	CreatedAt      string `json:"created_at,omitempty"`
 	UpdatedAt      string `json:"last_modified_at,omitempty"`
 }
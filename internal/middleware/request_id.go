@@ -4,8 +4,6 @@
 package middleware
 
 import (
-	"context"
-	"log/slog"
 	"net/http"
 
 	"github.com/linuxfoundation/lfx-v2-mailing-list-service/pkg/constants"
@@ -29,13 +27,9 @@ func RequestIDMiddleware() func(http.Handler) http.Handler {
 			// Add request ID to response header
 			w.Header().Set(constants.RequestIDHeader, requestID)
 
-			// Add request ID to context
-			ctx := context.WithValue(r.Context(), constants.RequestIDContextKey, requestID)
-
-			// Log the request ID using the context-aware logger
-			// So using slog along with the context
-			// This allows the request ID to be included in all logs for this request
-			ctx = log.AppendCtx(ctx, slog.String(constants.RequestIDHeader, requestID))
+			// Add request ID to context; the context-aware logger includes it as
+			// the request_id field in all logs for this request
+			ctx := log.WithRequestID(r.Context(), requestID)
 
 			// Create a new request with the updated context
 			r = r.WithContext(ctx)
@@ -19,6 +19,7 @@ import (
 	"github.com/linuxfoundation/lfx-v2-mailing-list-service/internal/domain/port"
 	"github.com/linuxfoundation/lfx-v2-mailing-list-service/pkg/constants"
 	"github.com/linuxfoundation/lfx-v2-mailing-list-service/pkg/mapconv"
+	"github.com/linuxfoundation/lfx-v2-mailing-list-service/pkg/redaction"
 	"github.com/nats-io/nats.go/jetstream"
 )
 
@@ -141,7 +142,7 @@ func (h *MemberInviteHandler) MaybeSendInvite(
 	result, sendErr := h.inviteSender.SendInvite(ctx, req)
 	if sendErr != nil {
 		logger.WarnContext(ctx, "failed to send LFID invite for mailing-list member; continuing",
-			"member_uid", member.UID, "error", sendErr)
+			"member_uid", member.UID, "email", redaction.Email(email), "error", sendErr)
 		// Release the dedup slot so JetStream redelivery can retry.
 		if purgeErr := h.mappings.PurgeMapping(ctx, inviteSentKey); purgeErr != nil {
 			logger.WarnContext(ctx, "failed to release invite dedup slot after send failure; retries suppressed",
@@ -72,6 +72,10 @@ func HandleDataStreamServiceUpdate(ctx context.Context, uid string, data map[str
 	// Publish settings indexer message when writers or auditors are present.
 	settings := buildServiceSettings(uid, data)
 	if settings != nil {
+		if err := settings.NormalizeUsers(); err != nil {
+			// v1 remains the source of truth, so the record is still synced; surface the conflict for cleanup.
+			slog.WarnContext(ctx, "service settings list a user as both writer and auditor", "uid", uid, "error", err)
+		}
 		settingsRef := fmt.Sprintf("groupsio_service:%s", uid)
 		settingsConfig := &indexertypes.IndexingConfig{
 			ObjectID:             uid,
@@ -192,6 +196,8 @@ func transformV1ToGrpsIOService(uid string, data map[string]any) *model.GroupsIO
 		ProjectUID:  mapconv.StringVal(data, "project_id"),
 		ProjectSlug: mapconv.StringVal(data, "proj_id"),
 		Source:      "v1-sync",
+		// v1 has no global owners; emit [] rather than null for a consistent document shape.
+		GlobalOwners: []string{},
 	}
 
 	if ts := mapconv.StringVal(data, "created_at"); ts != "" {
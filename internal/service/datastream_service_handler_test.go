@@ -5,6 +5,7 @@ package service
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
 	"testing"
 
@@ -92,3 +93,19 @@ func TestHandleDataStreamServiceDelete_HappyPath_ACKAndTombstones(t *testing.T)
 	assert.True(t, m.IsTombstoned(context.Background(),
 		fmt.Sprintf("%s.svc-1", constants.KVMappingPrefixService)))
 }
+
+func TestTransformV1ToGrpsIOService_NoOwners_MarshalsEmptyArray(t *testing.T) {
+	svc := transformV1ToGrpsIOService("svc-1", map[string]any{"project_id": "sfid-proj"})
+
+	b, err := json.Marshal(svc)
+	assert.NoError(t, err)
+	assert.Contains(t, string(b), `"global_owners":[]`)
+}
+
+func TestBuildServiceSettings_WritersOnly_MarshalsEmptyAuditors(t *testing.T) {
+	settings := buildServiceSettings("svc-1", map[string]any{"writers": []any{"alice"}})
+
+	b, err := json.Marshal(settings)
+	assert.NoError(t, err)
+	assert.Contains(t, string(b), `"auditors":[]`)
+}
@@ -7,6 +7,7 @@ import (
 	"context"
 	"fmt"
 	"log/slog"
+	"slices"
 	"time"
 
 	fgaconstants "github.com/linuxfoundation/lfx-v2-fga-sync/pkg/constants"
@@ -51,6 +52,12 @@ func HandleDataStreamSubgroupUpdate(ctx context.Context, uid string, data map[st
 	}
 
 	list := transformV1ToGrpsIOMailingList(uid, data)
+	for i := range list.Committees {
+		if unknown := list.Committees[i].NormalizeVotingStatuses(); len(unknown) > 0 {
+			slog.WarnContext(ctx, "subgroup committee filters contain unrecognized voting statuses",
+				"uid", uid, "committee_uid", list.Committees[i].UID, "voting_statuses", unknown)
+		}
+	}
 
 	if list.ServiceUID == "" {
 		slog.ErrorContext(ctx, "missing parent_id in subgroup event, discarding", "uid", uid)
@@ -116,6 +123,10 @@ func HandleDataStreamSubgroupUpdate(ctx context.Context, uid string, data map[st
 	// Publish settings indexer message when writers or auditors are present.
 	settings := buildMailingListSettings(uid, data)
 	if settings != nil {
+		if err := settings.NormalizeUsers(); err != nil {
+			// v1 remains the source of truth, so the record is still synced; surface the conflict for cleanup.
+			slog.WarnContext(ctx, "subgroup settings list a user as both writer and auditor", "uid", uid, "error", err)
+		}
 		settingsRef := fmt.Sprintf("groupsio_mailing_list:%s", uid)
 		settingsConfig := &indexertypes.IndexingConfig{
 			ObjectID:             uid,
@@ -138,39 +149,7 @@ func HandleDataStreamSubgroupUpdate(ctx context.Context, uid string, data map[st
 		}
 	}
 
-	references := map[string][]string{
-		// Project access is inherited through the service — only service reference needed.
-		constants.RelationGroupsIOService: {list.ServiceUID},
-	}
-	for _, committee := range list.Committees {
-		if committee.UID != "" {
-			references[constants.RelationCommittee] = append(references[constants.RelationCommittee], committee.UID)
-		}
-	}
-	relations := map[string][]string{}
-	if settings != nil {
-		if writers := userInfoUsernames(settings.Writers); len(writers) > 0 {
-			relations[constants.RelationWriter] = writers
-		}
-		if auditors := userInfoUsernames(settings.Auditors); len(auditors) > 0 {
-			relations[constants.RelationAuditor] = auditors
-		}
-	}
-	accessData := fgatypes.GenericAccessData{
-		UID:        uid,
-		Public:     list.Public,
-		References: references,
-		// member relations are managed separately via member_put and must not be overwritten here
-		ExcludeRelations: []string{constants.RelationMember},
-	}
-	if len(relations) > 0 {
-		accessData.Relations = relations
-	}
-	accessMsg := fgatypes.GenericFGAMessage{
-		ObjectType: constants.ObjectTypeGroupsIOMailingList,
-		Operation:  "update_access",
-		Data:       accessData,
-	}
+	accessMsg := buildMailingListAccessMessage(list, settings)
 	if err := publisher.Access(ctx, fgaconstants.GenericUpdateAccessSubject, accessMsg); err != nil {
 		slog.WarnContext(ctx, "failed to publish subgroup access message", "uid", uid, "error", err)
 	}
@@ -244,6 +223,59 @@ func HandleDataStreamSubgroupDelete(ctx context.Context, uid string, publisher p
 	return false
 }
 
+// PreviewMailingListAccess returns the access-control message that syncing the mailing list
+// with the given settings would publish, without publishing anything. settings may be nil when
+// the list has no writers or auditors.
+func PreviewMailingListAccess(list *model.GroupsIOMailingList, settings *model.GroupsIOMailingListSettings) (*fgatypes.GenericFGAMessage, error) {
+	if list == nil || list.UID == "" {
+		return nil, pkgerrors.NewValidation("mailing list uid is required")
+	}
+	msg := buildMailingListAccessMessage(list, settings)
+	return &msg, nil
+}
+
+// buildMailingListAccessMessage builds the update_access message for a mailing list: its parent
+// service and committees as references, and writers/auditors from settings as relations.
+func buildMailingListAccessMessage(list *model.GroupsIOMailingList, settings *model.GroupsIOMailingListSettings) fgatypes.GenericFGAMessage {
+	references := map[string][]string{
+		// Project access is inherited through the service — only service reference needed.
+		constants.RelationGroupsIOService: {list.ServiceUID},
+	}
+	for _, committee := range list.Committees {
+		if committee.UID != "" {
+			references[constants.RelationCommittee] = append(references[constants.RelationCommittee], committee.UID)
+		}
+	}
+	if committees := references[constants.RelationCommittee]; len(committees) > 1 {
+		slices.Sort(committees)
+		references[constants.RelationCommittee] = slices.Compact(committees)
+	}
+	relations := map[string][]string{}
+	if settings != nil {
+		if writers := userInfoUsernames(settings.Writers); len(writers) > 0 {
+			relations[constants.RelationWriter] = writers
+		}
+		if auditors := userInfoUsernames(settings.Auditors); len(auditors) > 0 {
+			relations[constants.RelationAuditor] = auditors
+		}
+	}
+	accessData := fgatypes.GenericAccessData{
+		UID:        list.UID,
+		Public:     list.Public,
+		References: references,
+		// member relations are managed separately via member_put and must not be overwritten here
+		ExcludeRelations: []string{constants.RelationMember},
+	}
+	if len(relations) > 0 {
+		accessData.Relations = relations
+	}
+	return fgatypes.GenericFGAMessage{
+		ObjectType: constants.ObjectTypeGroupsIOMailingList,
+		Operation:  "update_access",
+		Data:       accessData,
+	}
+}
+
 // buildMailingListSettings constructs a GrpsIOMailingListSettings from v1 writers/auditors.
 // Returns nil when both slices are empty (no settings message needed).
 func buildMailingListSettings(uid string, data map[string]any) *model.GroupsIOMailingListSettings {
@@ -259,10 +291,11 @@ func buildMailingListSettings(uid string, data map[string]any) *model.GroupsIOMa
 	}
 }
 
-// toUserInfoSlice converts a slice of username strings to UserInfo values.
+// toUserInfoSlice converts a slice of username strings to UserInfo values. Empty input yields
+// an empty, non-nil slice so settings documents carry [] rather than null.
 func toUserInfoSlice(usernames []string) []model.UserInfo {
 	if len(usernames) == 0 {
-		return nil
+		return []model.UserInfo{}
 	}
 	out := make([]model.UserInfo, len(usernames))
 	for i, u := range usernames {
@@ -272,7 +305,8 @@ func toUserInfoSlice(usernames []string) []model.UserInfo {
 	return out
 }
 
-// userInfoUsernames extracts the non-empty Username pointers from a []UserInfo slice.
+// userInfoUsernames extracts the non-empty Username pointers from a []UserInfo slice,
+// sorted and deduplicated so the emitted access message is stable across equivalent inputs.
 func userInfoUsernames(users []model.UserInfo) []string {
 	out := make([]string, 0, len(users))
 	for _, u := range users {
@@ -280,7 +314,8 @@ func userInfoUsernames(users []model.UserInfo) []string {
 			out = append(out, *u.Username)
 		}
 	}
-	return out
+	slices.Sort(out)
+	return slices.Compact(out)
 }
 
 // transformV1ToGrpsIOMailingList maps v1 DynamoDB fields to the GrpsIOMailingList domain model.
@@ -5,13 +5,17 @@ package service
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
 	"testing"
 
 	fgaconstants "github.com/linuxfoundation/lfx-v2-fga-sync/pkg/constants"
+	"github.com/linuxfoundation/lfx-v2-mailing-list-service/internal/domain/model"
 	"github.com/linuxfoundation/lfx-v2-mailing-list-service/internal/infrastructure/mock"
 	"github.com/linuxfoundation/lfx-v2-mailing-list-service/pkg/constants"
+	pkgerrors "github.com/linuxfoundation/lfx-v2-mailing-list-service/pkg/errors"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 func TestHandleDataStreamSubgroupUpdate_MissingProjectID_ACK(t *testing.T) {
@@ -182,3 +186,109 @@ func TestHandleDataStreamSubgroupDelete_HappyPath_ACKAndTombstones(t *testing.T)
 	assert.True(t, m.IsTombstoned(context.Background(),
 		fmt.Sprintf("%s.sg-1", constants.KVMappingPrefixSubgroup)))
 }
+
+func TestHandleDataStreamSubgroupUpdate_EquivalentUserOrder_IdenticalAccessMessage(t *testing.T) {
+	publishAccess := func(writers, auditors []any) []byte {
+		m := mock.NewFakeMappingStore()
+		m.Set(fmt.Sprintf("%s.sfid-proj", constants.KVMappingPrefixProjectBySFID), "proj-uid")
+		m.Set(fmt.Sprintf("%s.svc-1", constants.KVMappingPrefixService), "svc-1")
+		pl := mock.NewFakeProjectLookup()
+		pl.Slugs["proj-uid"] = "my-project"
+
+		pub := &mock.SpyMessagePublisher{}
+		nak := HandleDataStreamSubgroupUpdate(context.Background(), "sg-1",
+			map[string]any{
+				"project_id": "sfid-proj",
+				"parent_id":  "svc-1",
+				"group_name": "dev",
+				"writers":    writers,
+				"auditors":   auditors,
+			},
+			pub, m, pl)
+		require.False(t, nak)
+		require.Len(t, pub.AccessCalls, 1)
+
+		b, err := json.Marshal(pub.AccessCalls[0].Message)
+		require.NoError(t, err)
+		return b
+	}
+
+	first := publishAccess([]any{"carol", "alice", "bob"}, []any{"erin", "dave"})
+	second := publishAccess([]any{"bob", "carol", "alice", "bob"}, []any{"dave", "erin", "dave"})
+	assert.Contains(t, string(first), `"alice","bob","carol"`)
+	assert.Equal(t, string(first), string(second))
+}
+
+func TestHandleDataStreamSubgroupUpdate_MixedCaseCommitteeFilters_IndexedCanonically(t *testing.T) {
+	publishIndexer := func(filters []any) string {
+		m := mock.NewFakeMappingStore()
+		m.Set(fmt.Sprintf("%s.sfid-proj", constants.KVMappingPrefixProjectBySFID), "proj-uid")
+		m.Set(fmt.Sprintf("%s.sfid-committee", constants.KVMappingPrefixCommitteeBySFID), "committee-uid")
+		m.Set(fmt.Sprintf("%s.svc-1", constants.KVMappingPrefixService), "svc-1")
+		pl := mock.NewFakeProjectLookup()
+		pl.Slugs["proj-uid"] = "my-project"
+
+		pub := &mock.SpyMessagePublisher{}
+		nak := HandleDataStreamSubgroupUpdate(context.Background(), "sg-1",
+			map[string]any{
+				"project_id":        "sfid-proj",
+				"parent_id":         "svc-1",
+				"committee":         "sfid-committee",
+				"committee_filters": filters,
+			},
+			pub, m, pl)
+		require.False(t, nak)
+		require.NotEmpty(t, pub.IndexerCalls)
+
+		b, err := json.Marshal(pub.IndexerCalls[0].Message)
+		require.NoError(t, err)
+		return string(b)
+	}
+
+	for _, filters := range [][]any{
+		{"voting_rep", "alternate voting rep"},
+		{"Voting Rep", "Alternate Voting Rep"},
+		{"VOTING-REP", "Alternate_Voting_Rep", "voting rep"},
+	} {
+		got := publishIndexer(filters)
+		assert.Contains(t, got, `"committee_voting_status:Voting Rep"`, "filters %v", filters)
+		assert.Contains(t, got, `"committee_voting_status:Alternate Voting Rep"`, "filters %v", filters)
+		assert.NotContains(t, got, "voting_rep", "filters %v", filters)
+	}
+}
+
+func TestPreviewMailingListAccess_MatchesPublishedMessage(t *testing.T) {
+	m := mock.NewFakeMappingStore()
+	m.Set(fmt.Sprintf("%s.sfid-proj", constants.KVMappingPrefixProjectBySFID), "proj-uid")
+	m.Set(fmt.Sprintf("%s.svc-1", constants.KVMappingPrefixService), "svc-1")
+	pl := mock.NewFakeProjectLookup()
+	pl.Slugs["proj-uid"] = "my-project"
+	data := map[string]any{
+		"project_id": "sfid-proj",
+		"parent_id":  "svc-1",
+		"group_name": "dev",
+		"writers":    []any{"bob", "alice"},
+		"auditors":   []any{"carol"},
+	}
+
+	pub := &mock.SpyMessagePublisher{}
+	require.False(t, HandleDataStreamSubgroupUpdate(context.Background(), "sg-1", data, pub, m, pl))
+	require.Len(t, pub.AccessCalls, 1)
+	published, err := json.Marshal(pub.AccessCalls[0].Message)
+	require.NoError(t, err)
+
+	preview, err := PreviewMailingListAccess(
+		&model.GroupsIOMailingList{UID: "sg-1", ServiceUID: "svc-1"},
+		buildMailingListSettings("sg-1", data),
+	)
+	require.NoError(t, err)
+	previewed, err := json.Marshal(preview)
+	require.NoError(t, err)
+
+	assert.JSONEq(t, string(published), string(previewed))
+}
+
+func TestPreviewMailingListAccess_MissingUID_ReturnsValidation(t *testing.T) {
+	_, err := PreviewMailingListAccess(&model.GroupsIOMailingList{ServiceUID: "svc-1"}, nil)
+	assert.IsType(t, pkgerrors.Validation{}, err)
+}
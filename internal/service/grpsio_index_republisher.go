@@ -0,0 +1,275 @@
+// Copyright The Linux Foundation and each contributor to LFX.
+// SPDX-License-Identifier: MIT
+
+package service
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	indexertypes "github.com/linuxfoundation/lfx-v2-indexer-service/pkg/types"
+	"github.com/linuxfoundation/lfx-v2-mailing-list-service/internal/domain/model"
+	"github.com/linuxfoundation/lfx-v2-mailing-list-service/internal/domain/port"
+	"github.com/linuxfoundation/lfx-v2-mailing-list-service/pkg/constants"
+	errs "github.com/linuxfoundation/lfx-v2-mailing-list-service/pkg/errors"
+	"github.com/linuxfoundation/lfx-v2-mailing-list-service/pkg/utils"
+)
+
+// GroupsIOIndexRepublisherOrchestrator implements port.GroupsIOIndexRepublisher by reading a
+// project's services, mailing lists and members and publishing an "updated" indexer message
+// for each. The messages are upserts, so republishing an entity the index already has is harmless.
+type GroupsIOIndexRepublisherOrchestrator struct {
+	serviceReader     port.GroupsIOServiceReader
+	mailingListReader port.GroupsIOMailingListReader
+	memberReader      port.GroupsIOMailingListMemberReader
+	publisher         port.MessagePublisher
+	rate              int
+	clock             utils.Clock
+
+	mu sync.Mutex
+	// done records, per project, the mailing lists whose members were all published by a
+	// call that later failed, so the next call for that project can skip them.
+	done map[string]map[string]struct{}
+}
+
+// IndexRepublisherOrchestratorOption configures a GroupsIOIndexRepublisherOrchestrator.
+type IndexRepublisherOrchestratorOption func(*GroupsIOIndexRepublisherOrchestrator)
+
+// WithRepublishServiceReader sets the service reader.
+func WithRepublishServiceReader(r port.GroupsIOServiceReader) IndexRepublisherOrchestratorOption {
+	return func(o *GroupsIOIndexRepublisherOrchestrator) {
+		o.serviceReader = r
+	}
+}
+
+// WithRepublishMailingListReader sets the mailing list reader.
+func WithRepublishMailingListReader(r port.GroupsIOMailingListReader) IndexRepublisherOrchestratorOption {
+	return func(o *GroupsIOIndexRepublisherOrchestrator) {
+		o.mailingListReader = r
+	}
+}
+
+// WithRepublishMemberReader sets the member reader.
+func WithRepublishMemberReader(r port.GroupsIOMailingListMemberReader) IndexRepublisherOrchestratorOption {
+	return func(o *GroupsIOIndexRepublisherOrchestrator) {
+		o.memberReader = r
+	}
+}
+
+// WithRepublishPublisher sets the publisher the indexer messages are sent through.
+func WithRepublishPublisher(p port.MessagePublisher) IndexRepublisherOrchestratorOption {
+	return func(o *GroupsIOIndexRepublisherOrchestrator) {
+		o.publisher = p
+	}
+}
+
+// WithRepublishRateLimit spaces the published messages to at most perSecond per second, so a
+// large project doesn't flood the indexer. Values <= 0 leave publishing unthrottled.
+func WithRepublishRateLimit(perSecond int) IndexRepublisherOrchestratorOption {
+	return func(o *GroupsIOIndexRepublisherOrchestrator) {
+		o.rate = perSecond
+	}
+}
+
+// WithRepublishClock replaces the clock used to pace publishing. Intended for tests.
+func WithRepublishClock(c utils.Clock) IndexRepublisherOrchestratorOption {
+	return func(o *GroupsIOIndexRepublisherOrchestrator) {
+		o.clock = c
+	}
+}
+
+// republishRun publishes the messages of one RepublishProjectIndex call, pacing them to the
+// configured rate.
+type republishRun struct {
+	o         *GroupsIOIndexRepublisherOrchestrator
+	interval  time.Duration
+	last      time.Time
+	published int
+}
+
+// publish builds the indexer message for input and sends it on subject, waiting first when
+// the previous message was sent less than one interval ago.
+func (r *republishRun) publish(ctx context.Context, subject string, input any, tags []string, config *indexertypes.IndexingConfig) error {
+	if r.interval > 0 && r.published > 0 {
+		if wait := r.interval - r.o.clock.Now().Sub(r.last); wait > 0 {
+			if err := r.o.clock.Sleep(ctx, wait); err != nil {
+				return err
+			}
+		}
+	}
+	r.last = r.o.clock.Now()
+
+	msg := &model.IndexerMessage{Action: model.ActionUpdated, Tags: tags}
+	built, err := msg.BuildWithIndexingConfig(ctx, input, config)
+	if err != nil {
+		return errs.NewUnexpected("failed to build indexer message", err)
+	}
+	if err := r.o.publisher.Indexer(ctx, subject, built); err != nil {
+		return err
+	}
+	r.published++
+	return nil
+}
+
+// serviceIndexingConfig returns the indexing config of a service's indexer message.
+func serviceIndexingConfig(svc *model.GroupsIOService) *indexertypes.IndexingConfig {
+	isPublic := svc.Public
+	svcRef := fmt.Sprintf("groupsio_service:%s", svc.UID)
+	return &indexertypes.IndexingConfig{
+		ObjectID:             svc.UID,
+		Public:               &isPublic,
+		AccessCheckObject:    svcRef,
+		AccessCheckRelation:  "viewer",
+		HistoryCheckObject:   svcRef,
+		HistoryCheckRelation: "auditor",
+		ParentRefs:           svc.ParentRefs(),
+		NameAndAliases:       svc.NameAndAliases(),
+		SortName:             svc.SortName(),
+		Fulltext:             svc.Fulltext(),
+		Tags:                 svc.Tags(),
+	}
+}
+
+// mailingListIndexingConfig returns the indexing config of a mailing list's indexer message.
+func mailingListIndexingConfig(ml *model.GroupsIOMailingList) *indexertypes.IndexingConfig {
+	isPublic := ml.Public
+	listRef := fmt.Sprintf("groupsio_mailing_list:%s", ml.UID)
+	return &indexertypes.IndexingConfig{
+		ObjectID:             ml.UID,
+		Public:               &isPublic,
+		AccessCheckObject:    listRef,
+		AccessCheckRelation:  "viewer",
+		HistoryCheckObject:   listRef,
+		HistoryCheckRelation: "auditor",
+		ParentRefs:           ml.ParentRefs(),
+		NameAndAliases:       ml.NameAndAliases(),
+		SortName:             ml.SortName(),
+		Fulltext:             ml.Fulltext(),
+		Tags:                 ml.Tags(),
+	}
+}
+
+// memberIndexingConfig returns the indexing config of a member's indexer message.
+func memberIndexingConfig(member *model.GrpsIOMember) *indexertypes.IndexingConfig {
+	mailingListRef := fmt.Sprintf("groupsio_mailing_list:%s", member.MailingListUID)
+	return &indexertypes.IndexingConfig{
+		ObjectID:             member.UID,
+		AccessCheckObject:    mailingListRef,
+		AccessCheckRelation:  "viewer",
+		HistoryCheckObject:   mailingListRef,
+		HistoryCheckRelation: "auditor",
+		ParentRefs:           member.ParentRefs(),
+		NameAndAliases:       member.NameAndAliases(),
+		SortName:             member.SortName(),
+		Fulltext:             member.Fulltext(),
+		Tags:                 member.Tags(),
+	}
+}
+
+// completedLists returns a copy of the mailing lists already republished for projectUID.
+func (o *GroupsIOIndexRepublisherOrchestrator) completedLists(projectUID string) map[string]struct{} {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+	done := make(map[string]struct{}, len(o.done[projectUID]))
+	for id := range o.done[projectUID] {
+		done[id] = struct{}{}
+	}
+	return done
+}
+
+// markListDone records that every member of mailingListID was republished for projectUID.
+func (o *GroupsIOIndexRepublisherOrchestrator) markListDone(projectUID, mailingListID string) {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+	if o.done[projectUID] == nil {
+		o.done[projectUID] = make(map[string]struct{})
+	}
+	o.done[projectUID][mailingListID] = struct{}{}
+}
+
+// clearProgress forgets the recorded progress for projectUID once it has been fully republished.
+func (o *GroupsIOIndexRepublisherOrchestrator) clearProgress(projectUID string) {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+	delete(o.done, projectUID)
+}
+
+// RepublishProjectIndex publishes an indexer message for every service, mailing list and
+// member of the project. Services and mailing lists are always republished; a mailing list
+// whose members were all published by an earlier call that then failed is skipped, so a
+// retried call resumes where the last one stopped. With a rate limit, consecutive messages are
+// sent at least 1/rate seconds apart. On error the count published so far is returned.
+func (o *GroupsIOIndexRepublisherOrchestrator) RepublishProjectIndex(ctx context.Context, projectUID string) (int, error) {
+	if projectUID == "" {
+		return 0, errs.NewValidation("project_uid is required")
+	}
+	if o.serviceReader == nil || o.mailingListReader == nil || o.memberReader == nil || o.publisher == nil {
+		return 0, errs.NewServiceUnavailable("index republishing is not configured")
+	}
+
+	run := &republishRun{o: o}
+	if o.rate > 0 {
+		run.interval = time.Second / time.Duration(o.rate)
+	}
+
+	services, _, err := o.serviceReader.ListServices(ctx, projectUID)
+	if err != nil {
+		return 0, err
+	}
+	for _, svc := range services {
+		if err := run.publish(ctx, constants.IndexGroupsIOServiceSubject, svc, svc.Tags(), serviceIndexingConfig(svc)); err != nil {
+			return run.published, err
+		}
+	}
+
+	mailingLists, _, err := o.mailingListReader.ListMailingLists(ctx, projectUID, "")
+	if err != nil {
+		return run.published, err
+	}
+	done := o.completedLists(projectUID)
+	for _, ml := range mailingLists {
+		if err := run.publish(ctx, constants.IndexGroupsIOMailingListSubject, ml, ml.Tags(), mailingListIndexingConfig(ml)); err != nil {
+			return run.published, err
+		}
+		if _, ok := done[ml.UID]; ok {
+			continue
+		}
+
+		members, _, err := o.memberReader.ListMembers(ctx, ml.UID)
+		if err != nil {
+			return run.published, err
+		}
+		for _, member := range members {
+			if member.MailingListUID == "" {
+				member.MailingListUID = ml.UID
+			}
+			if member.ProjectUID == "" {
+				member.ProjectUID = projectUID
+			}
+			if err := run.publish(ctx, constants.IndexGroupsIOMemberSubject, member, member.Tags(), memberIndexingConfig(member)); err != nil {
+				return run.published, err
+			}
+		}
+		o.markListDone(projectUID, ml.UID)
+	}
+
+	o.clearProgress(projectUID)
+	return run.published, nil
+}
+
+// Ensure GroupsIOIndexRepublisherOrchestrator implements port.GroupsIOIndexRepublisher.
+var _ port.GroupsIOIndexRepublisher = (*GroupsIOIndexRepublisherOrchestrator)(nil)
+
+// NewGroupsIOIndexRepublisherOrchestrator creates a new GroupsIOIndexRepublisherOrchestrator.
+func NewGroupsIOIndexRepublisherOrchestrator(opts ...IndexRepublisherOrchestratorOption) *GroupsIOIndexRepublisherOrchestrator {
+	o := &GroupsIOIndexRepublisherOrchestrator{
+		clock: utils.RealClock{},
+		done:  make(map[string]map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(o)
+	}
+	return o
+}
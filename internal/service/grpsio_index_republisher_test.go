@@ -0,0 +1,148 @@
+// Copyright The Linux Foundation and each contributor to LFX.
+// SPDX-License-Identifier: MIT
+
+package service
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/linuxfoundation/lfx-v2-mailing-list-service/internal/domain/model"
+	"github.com/linuxfoundation/lfx-v2-mailing-list-service/internal/infrastructure/mock"
+	"github.com/linuxfoundation/lfx-v2-mailing-list-service/pkg/constants"
+	errs "github.com/linuxfoundation/lfx-v2-mailing-list-service/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// countingPublisher counts indexer messages per subject. When failAfter > 0, every Indexer
+// call after the first failAfter fails.
+type countingPublisher struct {
+	mock.SpyMessagePublisher
+	bySubject map[string]int
+	failAfter int
+}
+
+func (p *countingPublisher) Indexer(ctx context.Context, subject string, message any) error {
+	if p.failAfter > 0 && len(p.IndexerCalls) >= p.failAfter {
+		return errs.NewServiceUnavailable("indexer unavailable")
+	}
+	if p.bySubject == nil {
+		p.bySubject = make(map[string]int)
+	}
+	p.bySubject[subject]++
+	return p.SpyMessagePublisher.Indexer(ctx, subject, message)
+}
+
+// seedRepublishProject seeds one project with a service, two mailing lists and three members,
+// plus an unrelated project that must not be republished.
+func seedRepublishProject() *mock.FakeGroupsIOReader {
+	store := mock.NewFakeGroupsIOReader()
+	store.AddService(&model.GroupsIOService{UID: "svc-1", ProjectUID: "proj-1", Type: constants.ITXServiceTypePrimary})
+	store.AddMailingList(&model.GroupsIOMailingList{UID: "ml-1", ServiceUID: "svc-1", ProjectUID: "proj-1"})
+	store.AddMailingList(&model.GroupsIOMailingList{UID: "ml-2", ServiceUID: "svc-1", ProjectUID: "proj-1"})
+	store.AddMember("ml-1", &model.GrpsIOMember{UID: "m-1", Email: "a@example.com"})
+	store.AddMember("ml-1", &model.GrpsIOMember{UID: "m-2", Email: "b@example.com"})
+	store.AddMember("ml-2", &model.GrpsIOMember{UID: "m-3", Email: "c@example.com"})
+
+	store.AddService(&model.GroupsIOService{UID: "svc-2", ProjectUID: "proj-2", Type: constants.ITXServiceTypePrimary})
+	store.AddMailingList(&model.GroupsIOMailingList{UID: "ml-3", ServiceUID: "svc-2", ProjectUID: "proj-2"})
+	store.AddMember("ml-3", &model.GrpsIOMember{UID: "m-4", Email: "d@example.com"})
+	return store
+}
+
+func newTestRepublisher(store *mock.FakeGroupsIOReader, pub *countingPublisher, opts ...IndexRepublisherOrchestratorOption) *GroupsIOIndexRepublisherOrchestrator {
+	return NewGroupsIOIndexRepublisherOrchestrator(append([]IndexRepublisherOrchestratorOption{
+		WithRepublishServiceReader(store),
+		WithRepublishMailingListReader(store),
+		WithRepublishMemberReader(store),
+		WithRepublishPublisher(pub),
+	}, opts...)...)
+}
+
+func TestRepublishProjectIndex_PublishesEveryEntityOfProject(t *testing.T) {
+	pub := &countingPublisher{}
+	o := newTestRepublisher(seedRepublishProject(), pub)
+
+	published, err := o.RepublishProjectIndex(context.Background(), "proj-1")
+	require.NoError(t, err)
+	assert.Equal(t, 6, published)
+	assert.Equal(t, map[string]int{
+		constants.IndexGroupsIOServiceSubject:     1,
+		constants.IndexGroupsIOMailingListSubject: 2,
+		constants.IndexGroupsIOMemberSubject:      3,
+	}, pub.bySubject)
+	for _, call := range pub.IndexerCalls {
+		msg, ok := call.Message.(*model.IndexerMessage)
+		require.True(t, ok)
+		assert.Equal(t, model.ActionUpdated, msg.Action)
+	}
+}
+
+func TestRepublishProjectIndex_RepeatedCall_PublishesSameCount(t *testing.T) {
+	pub := &countingPublisher{}
+	o := newTestRepublisher(seedRepublishProject(), pub)
+
+	first, err := o.RepublishProjectIndex(context.Background(), "proj-1")
+	require.NoError(t, err)
+	second, err := o.RepublishProjectIndex(context.Background(), "proj-1")
+	require.NoError(t, err)
+	assert.Equal(t, first, second)
+	assert.Len(t, pub.IndexerCalls, first+second)
+}
+
+func TestRepublishProjectIndex_AfterFailure_ResumesSkippingCompletedLists(t *testing.T) {
+	// Service, ml-1, its two members and ml-2 succeed; the member of ml-2 fails.
+	pub := &countingPublisher{failAfter: 5}
+	o := newTestRepublisher(seedRepublishProject(), pub)
+
+	published, err := o.RepublishProjectIndex(context.Background(), "proj-1")
+	require.Error(t, err)
+	assert.Equal(t, 5, published)
+
+	pub.failAfter = 0
+	pub.bySubject = nil
+	published, err = o.RepublishProjectIndex(context.Background(), "proj-1")
+	require.NoError(t, err)
+	// The service and both lists again, but only ml-2's member.
+	assert.Equal(t, 4, published)
+	assert.Equal(t, 1, pub.bySubject[constants.IndexGroupsIOMemberSubject])
+
+	// Once a call completes the progress is cleared, so the next call republishes everything.
+	published, err = o.RepublishProjectIndex(context.Background(), "proj-1")
+	require.NoError(t, err)
+	assert.Equal(t, 6, published)
+}
+
+func TestRepublishProjectIndex_RateLimited_SpacesMessages(t *testing.T) {
+	clock := &fakeClock{now: time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)}
+	o := newTestRepublisher(seedRepublishProject(), &countingPublisher{}, WithRepublishRateLimit(10), WithRepublishClock(clock))
+
+	published, err := o.RepublishProjectIndex(context.Background(), "proj-1")
+	require.NoError(t, err)
+	assert.Equal(t, 6, published)
+	assert.Len(t, clock.sleeps, 5)
+	for _, d := range clock.sleeps {
+		assert.Equal(t, 100*time.Millisecond, d)
+	}
+}
+
+func TestRepublishProjectIndex_ReaderError_ReturnsError(t *testing.T) {
+	store := seedRepublishProject()
+	store.Err = errs.NewServiceUnavailable("itx down")
+	o := newTestRepublisher(store, &countingPublisher{})
+
+	published, err := o.RepublishProjectIndex(context.Background(), "proj-1")
+	var unavailable errs.ServiceUnavailable
+	assert.True(t, errors.As(err, &unavailable))
+	assert.Zero(t, published)
+}
+
+func TestRepublishProjectIndex_EmptyProjectUID_ReturnsValidation(t *testing.T) {
+	o := newTestRepublisher(seedRepublishProject(), &countingPublisher{})
+
+	_, err := o.RepublishProjectIndex(context.Background(), "")
+	assert.IsType(t, errs.Validation{}, err)
+}
@@ -5,6 +5,7 @@ package service
 
 import (
 	"context"
+	"time"
 
 	"github.com/linuxfoundation/lfx-v2-mailing-list-service/internal/domain/model"
 	"github.com/linuxfoundation/lfx-v2-mailing-list-service/internal/domain/port"
@@ -69,6 +70,38 @@ func (o *GroupsIOMailingListReaderOrchestrator) ListMailingLists(ctx context.Con
 	return items, total, nil
 }
 
+// ListMailingListsByVisibility returns the project's mailing lists whose visibility (see
+// model.GroupsIOMailingList.IsPublic) matches public.
+func (o *GroupsIOMailingListReaderOrchestrator) ListMailingListsByVisibility(ctx context.Context, projectUID string, public bool) ([]*model.GroupsIOMailingList, error) {
+	items, _, err := o.ListMailingLists(ctx, projectUID, "")
+	if err != nil {
+		return nil, err
+	}
+	var out []*model.GroupsIOMailingList
+	for _, ml := range items {
+		if ml.IsPublic() == public {
+			out = append(out, ml)
+		}
+	}
+	return out, nil
+}
+
+// ListMailingListsUpdatedSince returns the project's mailing lists whose UpdatedAt is strictly
+// after since, for incremental sync clients resuming from a checkpoint.
+func (o *GroupsIOMailingListReaderOrchestrator) ListMailingListsUpdatedSince(ctx context.Context, projectUID string, since time.Time) ([]*model.GroupsIOMailingList, error) {
+	items, _, err := o.ListMailingLists(ctx, projectUID, "")
+	if err != nil {
+		return nil, err
+	}
+	var out []*model.GroupsIOMailingList
+	for _, ml := range items {
+		if ml.UpdatedAt.After(since) {
+			out = append(out, ml)
+		}
+	}
+	return out, nil
+}
+
 // GetMailingList retrieves a mailing list by ID and translates v1 IDs to v2 in the response.
 func (o *GroupsIOMailingListReaderOrchestrator) GetMailingList(ctx context.Context, mailingListID string) (*model.GroupsIOMailingList, error) {
 	ml, err := o.reader.GetMailingList(ctx, mailingListID)
@@ -116,7 +149,7 @@ func (o *GroupsIOMailingListReaderOrchestrator) translateMailingListResponse(ctx
 }
 
 // NewGroupsIOMailingListReaderOrchestrator creates a new reader orchestrator with the given options.
-func NewGroupsIOMailingListReaderOrchestrator(opts ...MailingListReaderOrchestratorOption) port.GroupsIOMailingListReader {
+func NewGroupsIOMailingListReaderOrchestrator(opts ...MailingListReaderOrchestratorOption) port.GroupsIOMailingListQueryReader {
 	o := &GroupsIOMailingListReaderOrchestrator{}
 	for _, opt := range opts {
 		opt(o)
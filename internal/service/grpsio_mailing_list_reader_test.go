@@ -0,0 +1,65 @@
+// Copyright The Linux Foundation and each contributor to LFX.
+// SPDX-License-Identifier: MIT
+
+package service
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/linuxfoundation/lfx-v2-mailing-list-service/internal/domain/model"
+	"github.com/linuxfoundation/lfx-v2-mailing-list-service/internal/infrastructure/mock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// ---- helpers ----
+
+func newTestMailingListReader(store *mock.FakeGroupsIOReader) *GroupsIOMailingListReaderOrchestrator {
+	return NewGroupsIOMailingListReaderOrchestrator(
+		WithMailingListReader(store),
+		WithMailingListReaderTranslator(&passthroughTranslator{}),
+	).(*GroupsIOMailingListReaderOrchestrator)
+}
+
+// ---- ListMailingListsByVisibility ----
+
+func TestListMailingListsByVisibility_SplitsPublicAndPrivate(t *testing.T) {
+	store := mock.NewFakeGroupsIOReader()
+	store.AddMailingList(&model.GroupsIOMailingList{UID: "ml-1", ProjectUID: "proj-1", AudienceAccess: model.AudienceAccessPublic})
+	store.AddMailingList(&model.GroupsIOMailingList{UID: "ml-2", ProjectUID: "proj-1", AudienceAccess: "invite_only"})
+	store.AddMailingList(&model.GroupsIOMailingList{UID: "ml-3", ProjectUID: "proj-1", Public: true})
+	store.AddMailingList(&model.GroupsIOMailingList{UID: "ml-4", ProjectUID: "proj-2", AudienceAccess: model.AudienceAccessPublic})
+	o := newTestMailingListReader(store)
+
+	public, err := o.ListMailingListsByVisibility(context.Background(), "proj-1", true)
+	require.NoError(t, err)
+	var publicUIDs []string
+	for _, ml := range public {
+		publicUIDs = append(publicUIDs, ml.UID)
+	}
+	assert.ElementsMatch(t, []string{"ml-1", "ml-3"}, publicUIDs)
+
+	private, err := o.ListMailingListsByVisibility(context.Background(), "proj-1", false)
+	require.NoError(t, err)
+	require.Len(t, private, 1)
+	assert.Equal(t, "ml-2", private[0].UID)
+}
+
+// ---- ListMailingListsUpdatedSince ----
+
+func TestListMailingListsUpdatedSince_ReturnsOnlyListsAfterCutoff(t *testing.T) {
+	cutoff := time.Date(2026, 3, 1, 0, 0, 0, 0, time.UTC)
+	store := mock.NewFakeGroupsIOReader()
+	store.AddMailingList(&model.GroupsIOMailingList{UID: "ml-old", ProjectUID: "proj-1", UpdatedAt: cutoff.Add(-time.Hour)})
+	store.AddMailingList(&model.GroupsIOMailingList{UID: "ml-at", ProjectUID: "proj-1", UpdatedAt: cutoff})
+	store.AddMailingList(&model.GroupsIOMailingList{UID: "ml-new", ProjectUID: "proj-1", UpdatedAt: cutoff.Add(time.Hour)})
+	store.AddMailingList(&model.GroupsIOMailingList{UID: "ml-other", ProjectUID: "proj-2", UpdatedAt: cutoff.Add(time.Hour)})
+	o := newTestMailingListReader(store)
+
+	got, err := o.ListMailingListsUpdatedSince(context.Background(), "proj-1", cutoff)
+	require.NoError(t, err)
+	require.Len(t, got, 1)
+	assert.Equal(t, "ml-new", got[0].UID)
+}
@@ -6,7 +6,11 @@ package service
 
 import (
 	"context"
+	"errors"
+	"fmt"
 	"log/slog"
+	"strings"
+	"time"
 
 	"github.com/linuxfoundation/lfx-v2-mailing-list-service/internal/domain/model"
 	"github.com/linuxfoundation/lfx-v2-mailing-list-service/internal/domain/port"
@@ -24,6 +28,13 @@ type GroupsIOMailingListOrchestrator struct {
 	publisher              port.MessagePublisher
 	serviceReader          port.GroupsIOServiceReader
 	committeeProjectLookup port.CommitteeProjectLookup
+	reservedGroupNames     map[string]struct{}
+	adoptExisting          bool
+	createBudget           time.Duration
+	committeeRequiredTypes map[string]struct{}
+	strictPublish          bool
+	maxListsPerService     int
+	idempotentDeletes      bool
 }
 
 // MailingListOrchestratorOption configures a GroupsIOMailingListOrchestrator.
@@ -72,11 +83,134 @@ func WithMailingListCommitteeProjectLookup(l port.CommitteeProjectLookup) Mailin
 	}
 }
 
+// WithReservedGroupNames replaces the default set of group names rejected on create.
+// Names are matched case-insensitively; passing no names disables the check.
+func WithReservedGroupNames(names ...string) MailingListOrchestratorOption {
+	return func(o *GroupsIOMailingListOrchestrator) {
+		o.reservedGroupNames = reservedNameSet(names)
+	}
+}
+
+// WithAdoptExistingOnConflict makes CreateMailingList adopt a same-named mailing list that
+// already exists under the same service (e.g. created out-of-band in Groups.io) when the
+// create is rejected with a Conflict, instead of failing. Requires the event reader.
+func WithAdoptExistingOnConflict(enabled bool) MailingListOrchestratorOption {
+	return func(o *GroupsIOMailingListOrchestrator) {
+		o.adoptExisting = enabled
+	}
+}
+
+// WithCreateBudget bounds the pre-create steps of CreateMailingList (validation lookups and
+// ID translation). If the budget runs out before the list is sent to Groups.io, the create is
+// abandoned with a Timeout and nothing is written. The Groups.io call itself is not cut short,
+// since an interrupted create could leave a list behind; it is bounded by the ITX write
+// timeout instead. Values <= 0 disable the budget.
+func WithCreateBudget(d time.Duration) MailingListOrchestratorOption {
+	return func(o *GroupsIOMailingListOrchestrator) {
+		o.createBudget = d
+	}
+}
+
+// WithStrictPublish makes CreateMailingList fail when the committee cannot be notified of a
+// newly created list: the list is deleted again and the create returns an error, instead of
+// succeeding with a warning. Adopted lists were not created by the call and are never deleted.
+// Disabled by default.
+func WithStrictPublish(enabled bool) MailingListOrchestratorOption {
+	return func(o *GroupsIOMailingListOrchestrator) {
+		o.strictPublish = enabled
+	}
+}
+
+// WithMaxMailingListsPerService caps how many mailing lists a single service may have; creating
+// one more is rejected with a Validation error. Values <= 0, the default, leave it unlimited.
+// The cap is best effort: lists are counted before the create and ITX has no atomic check, so
+// concurrent creates under the same service can each pass the count and overshoot the cap.
+func WithMaxMailingListsPerService(n int) MailingListOrchestratorOption {
+	return func(o *GroupsIOMailingListOrchestrator) {
+		o.maxListsPerService = n
+	}
+}
+
+// WithIdempotentMailingListDelete makes DeleteMailingList succeed when the list is already
+// gone, so retried deletes do not fail with NotFound. Committee cleanup is still attempted.
+func WithIdempotentMailingListDelete(enabled bool) MailingListOrchestratorOption {
+	return func(o *GroupsIOMailingListOrchestrator) {
+		o.idempotentDeletes = enabled
+	}
+}
+
+// WithCommitteeRequiredTypes requires mailing lists of the given types (e.g.
+// model.TypeDiscussionModerated) to be associated with a committee on create and update.
+// No types are required by default.
+func WithCommitteeRequiredTypes(types ...string) MailingListOrchestratorOption {
+	return func(o *GroupsIOMailingListOrchestrator) {
+		o.committeeRequiredTypes = make(map[string]struct{}, len(types))
+		for _, t := range types {
+			if t = strings.TrimSpace(t); t != "" {
+				o.committeeRequiredTypes[t] = struct{}{}
+			}
+		}
+	}
+}
+
+// validateCommitteeRequired rejects a mailing list without a committee when its type is
+// configured as committee-required.
+func (o *GroupsIOMailingListOrchestrator) validateCommitteeRequired(ml *model.GroupsIOMailingList) error {
+	if _, required := o.committeeRequiredTypes[ml.Type]; required && committeeUID(ml) == "" {
+		return errs.NewValidation(fmt.Sprintf("mailing lists of type %q must be associated with a committee", ml.Type))
+	}
+	return nil
+}
+
+// createStepError returns a Timeout when the create budget (budgetCtx) ran out while the
+// caller's context is still live, and err otherwise. A nil err is a plain checkpoint.
+func (o *GroupsIOMailingListOrchestrator) createStepError(ctx, budgetCtx context.Context, err error) error {
+	if budgetCtx.Err() != nil && ctx.Err() == nil {
+		return errs.NewTimeout(fmt.Sprintf("mailing list create exceeded its %s budget before reaching Groups.io", o.createBudget), budgetCtx.Err())
+	}
+	return err
+}
+
+// reservedNameSet normalizes the given names into a lookup set.
+func reservedNameSet(names []string) map[string]struct{} {
+	set := make(map[string]struct{}, len(names))
+	for _, n := range names {
+		if n = strings.ToLower(strings.TrimSpace(n)); n != "" {
+			set[n] = struct{}{}
+		}
+	}
+	return set
+}
+
+// validateGroupName rejects group names that collide with Groups.io internals.
+func (o *GroupsIOMailingListOrchestrator) validateGroupName(ml *model.GroupsIOMailingList) error {
+	if _, reserved := o.reservedGroupNames[strings.ToLower(strings.TrimSpace(ml.GroupName))]; reserved {
+		return errs.NewValidation(fmt.Sprintf("group name %q is reserved", ml.GroupName))
+	}
+	return nil
+}
+
+// parentService loads ml's parent service so the validators share one lookup instead of each
+// fetching it. Returns nil when no service reader is configured or the list names no service.
+func (o *GroupsIOMailingListOrchestrator) parentService(ctx context.Context, ml *model.GroupsIOMailingList) (*model.GroupsIOService, error) {
+	if o.serviceReader == nil || ml.ServiceUID == "" {
+		return nil, nil
+	}
+	svc, err := o.serviceReader.GetService(ctx, ml.ServiceUID)
+	if err != nil {
+		return nil, parentServiceError(ml.ServiceUID, err)
+	}
+	if svc == nil {
+		return nil, errs.NewServiceUnavailable("service reader returned nil service")
+	}
+	return svc, nil
+}
+
 // validateCommitteeProject checks that the supplied committee belongs to the same project as
-// the parent service. No-op when no committee is present. Returns ServiceUnavailable when
-// required dependencies are not configured. On success it sets ml.ProjectUID from the
-// authoritative service record.
-func (o *GroupsIOMailingListOrchestrator) validateCommitteeProject(ctx context.Context, ml *model.GroupsIOMailingList) error {
+// the parent service svc, as loaded by parentService. No-op when no committee is present.
+// Returns ServiceUnavailable when required dependencies are not configured. On success it sets
+// ml.ProjectUID from the authoritative service record.
+func (o *GroupsIOMailingListOrchestrator) validateCommitteeProject(ctx context.Context, ml *model.GroupsIOMailingList, svc *model.GroupsIOService) error {
 	if len(ml.Committees) == 0 || ml.Committees[0].UID == "" {
 		return nil
 	}
@@ -87,13 +221,8 @@ func (o *GroupsIOMailingListOrchestrator) validateCommitteeProject(ctx context.C
 	if ml.ServiceUID == "" {
 		return errs.NewValidation("service_id is required when committee_uid is provided")
 	}
-
-	svc, err := o.serviceReader.GetService(ctx, ml.ServiceUID)
-	if err != nil {
-		return err
-	}
 	if svc == nil {
-		return errs.NewServiceUnavailable("service reader returned nil service")
+		return errs.NewServiceUnavailable("parent service was not loaded")
 	}
 
 	committeeProject, err := o.committeeProjectLookup.GetCommitteeProject(ctx, ml.Committees[0].UID)
@@ -109,22 +238,127 @@ func (o *GroupsIOMailingListOrchestrator) validateCommitteeProject(ctx context.C
 	return nil
 }
 
+// validateParentServiceStatus rejects creating a mailing list under a disabled or deleted
+// service. Skipped when svc is nil, i.e. no service reader is configured or the list names
+// no service.
+func (o *GroupsIOMailingListOrchestrator) validateParentServiceStatus(ml *model.GroupsIOMailingList, svc *model.GroupsIOService) error {
+	if svc == nil {
+		return nil
+	}
+	switch svc.Status {
+	case constants.ServiceStatusDisabled, constants.ServiceStatusDeleted:
+		return errs.NewValidation(fmt.Sprintf("service %s is %s and cannot accept new mailing lists", ml.ServiceUID, svc.Status))
+	}
+	return nil
+}
+
+// validateServiceListCap rejects creating a mailing list under a service that already has the
+// configured maximum number of lists. Lists are listed per project, so the parent service's
+// project lists, as loaded by parentService into svc, are counted down to this service.
+// Skipped when no cap is set or the list names no service.
+func (o *GroupsIOMailingListOrchestrator) validateServiceListCap(ctx context.Context, ml *model.GroupsIOMailingList, svc *model.GroupsIOService) error {
+	if o.maxListsPerService <= 0 || ml.ServiceUID == "" {
+		return nil
+	}
+	if svc == nil || o.reader == nil {
+		return errs.NewServiceUnavailable("mailing list cap is set but the service or mailing list reader is not configured")
+	}
+
+	lists, _, err := o.reader.ListMailingLists(ctx, svc.ProjectUID, "")
+	if err != nil {
+		return err
+	}
+	count := 0
+	for _, existing := range lists {
+		if existing.ServiceUID == ml.ServiceUID {
+			count++
+		}
+	}
+	if count >= o.maxListsPerService {
+		return errs.NewValidation(fmt.Sprintf("service %s already has %d mailing lists; the maximum per service is %d",
+			ml.ServiceUID, count, o.maxListsPerService))
+	}
+	return nil
+}
+
+// parentServiceError classifies a failed parent-service lookup so clients can tell a genuine
+// not-found (don't retry) from a transient outage (retry). Client and availability errors
+// already carrying a domain type are returned unchanged; anything else is treated as transient.
+func parentServiceError(serviceUID string, err error) error {
+	var (
+		notFound     errs.NotFound
+		validation   errs.Validation
+		unauthorized errs.Unauthorized
+		unavailable  errs.ServiceUnavailable
+		timeout      errs.Timeout
+	)
+	switch {
+	case errors.As(err, &notFound):
+		return errs.NewNotFound(fmt.Sprintf("parent service %s not found", serviceUID), err)
+	case errors.As(err, &validation), errors.As(err, &unauthorized),
+		errors.As(err, &unavailable), errors.As(err, &timeout):
+		return err
+	default:
+		return errs.NewServiceUnavailable(fmt.Sprintf("unable to load parent service %s", serviceUID), err)
+	}
+}
+
 // CreateMailingList creates a new mailing list, mapping project_uid (v2) -> project_id (v1)
 // and committee_uid (v2) -> committee_id (v1) before forwarding.
 // After a successful create it publishes a committee mailing list status event.
 func (o *GroupsIOMailingListOrchestrator) CreateMailingList(ctx context.Context, ml *model.GroupsIOMailingList) (*model.GroupsIOMailingList, error) {
-	if err := o.validateCommitteeProject(ctx, ml); err != nil {
+	if err := ml.ValidateIDs(); err != nil {
+		return nil, err
+	}
+	if err := ml.ValidateCommittees(); err != nil {
+		return nil, err
+	}
+	if err := o.validateCommitteeRequired(ml); err != nil {
+		return nil, err
+	}
+	if err := o.validateGroupName(ml); err != nil {
 		return nil, err
 	}
 
-	toSend, err := o.mapMailingListRequest(ctx, ml)
+	budgetCtx := ctx
+	if o.createBudget > 0 {
+		var cancel context.CancelFunc
+		budgetCtx, cancel = context.WithTimeout(ctx, o.createBudget)
+		defer cancel()
+	}
+
+	svc, err := o.parentService(budgetCtx, ml)
 	if err != nil {
+		return nil, o.createStepError(ctx, budgetCtx, err)
+	}
+	if err := o.validateParentServiceStatus(ml, svc); err != nil {
+		return nil, o.createStepError(ctx, budgetCtx, err)
+	}
+	if err := o.validateServiceListCap(budgetCtx, ml, svc); err != nil {
+		return nil, o.createStepError(ctx, budgetCtx, err)
+	}
+	if err := o.validateCommitteeProject(budgetCtx, ml, svc); err != nil {
+		return nil, o.createStepError(ctx, budgetCtx, err)
+	}
+
+	toSend, err := o.mapMailingListRequest(budgetCtx, ml)
+	if err != nil {
+		return nil, o.createStepError(ctx, budgetCtx, err)
+	}
+	if err := o.createStepError(ctx, budgetCtx, nil); err != nil {
 		return nil, err
 	}
 
 	resp, err := o.writer.CreateMailingList(ctx, toSend)
 	if err != nil {
-		return nil, err
+		existing := o.adoptOnConflict(ctx, ml, svc, err)
+		if existing == nil {
+			return nil, err
+		}
+		if err := o.notifyCommitteeAdded(ctx, committeeUID(existing)); err != nil {
+			existing.Warnings = append(existing.Warnings, committeeNotifyWarning(committeeUID(existing)))
+		}
+		return existing, nil
 	}
 
 	mapped, err := o.mapMailingListResponse(ctx, resp)
@@ -132,10 +366,65 @@ func (o *GroupsIOMailingListOrchestrator) CreateMailingList(ctx context.Context,
 		return nil, err
 	}
 
-	o.notifyCommitteeAdded(ctx, committeeUID(mapped))
+	if err := o.notifyCommitteeAdded(ctx, committeeUID(mapped)); err != nil {
+		if o.strictPublish {
+			return nil, o.rollbackCreate(ctx, resp.UID, committeeUID(mapped), err)
+		}
+		mapped.Warnings = append(mapped.Warnings, committeeNotifyWarning(committeeUID(mapped)))
+	}
 	return mapped, nil
 }
 
+// rollbackCreate deletes a just-created mailing list after its committee notification failed
+// in strict publish mode, and returns the error the create should fail with.
+func (o *GroupsIOMailingListOrchestrator) rollbackCreate(ctx context.Context, mailingListID, cUID string, publishErr error) error {
+	if err := o.writer.DeleteMailingList(ctx, mailingListID); err != nil {
+		slog.ErrorContext(ctx, "failed to roll back mailing list after committee notification failure",
+			"mailing_list_id", mailingListID,
+			"committee_uid", cUID,
+			"error", err)
+		return errs.NewUnexpected(fmt.Sprintf("mailing list %s was created but committee %s could not be notified and the rollback failed", mailingListID, cUID), errors.Join(publishErr, err))
+	}
+	return errs.NewServiceUnavailable(fmt.Sprintf("mailing list create rolled back: committee %s could not be notified", cUID), publishErr)
+}
+
+// adoptOnConflict returns the already-existing mailing list matching ml's group name and
+// service when adoption is enabled and createErr is a Conflict. svc is the parent service
+// loaded by parentService, used for the project when ml carries none. Returns nil when the
+// create error should be returned as-is. The adopted list is returned unchanged (the requested
+// settings are not applied), with a warning saying so.
+func (o *GroupsIOMailingListOrchestrator) adoptOnConflict(ctx context.Context, ml *model.GroupsIOMailingList, svc *model.GroupsIOService, createErr error) *model.GroupsIOMailingList {
+	var conflict errs.Conflict
+	if !o.adoptExisting || o.reader == nil || !errors.As(createErr, &conflict) {
+		return nil
+	}
+
+	projectUID := ml.ProjectUID
+	if projectUID == "" && svc != nil {
+		projectUID = svc.ProjectUID
+	}
+	if projectUID == "" {
+		return nil
+	}
+
+	existing, _, err := o.reader.ListMailingLists(ctx, projectUID, "")
+	if err != nil {
+		slog.WarnContext(ctx, "cannot adopt existing mailing list: listing failed",
+			"project_uid", projectUID, "error", err)
+		return nil
+	}
+	for _, e := range existing {
+		if strings.EqualFold(e.GroupName, ml.GroupName) && e.ServiceUID == ml.ServiceUID {
+			slog.InfoContext(ctx, "adopted existing mailing list after create conflict",
+				"mailing_list_id", e.UID, "group_name", e.GroupName)
+			e.Warnings = append(e.Warnings, fmt.Sprintf(
+				"mailing list %q already existed and was adopted; the requested settings were not applied", e.GroupName))
+			return e
+		}
+	}
+	return nil
+}
+
 // UpdateMailingList updates a mailing list, mapping project_uid (v2) -> project_id (v1)
 // and committee_uid (v2) -> committee_id (v1) before forwarding.
 //
@@ -152,7 +441,24 @@ func (o *GroupsIOMailingListOrchestrator) CreateMailingList(ctx context.Context,
 //     committee is shared across multiple mailing lists.
 //   - notifyCommitteeAdded always publishes has_mailing_list=true unconditionally.
 func (o *GroupsIOMailingListOrchestrator) UpdateMailingList(ctx context.Context, mailingListID string, ml *model.GroupsIOMailingList) (*model.GroupsIOMailingList, error) {
-	if err := o.validateCommitteeProject(ctx, ml); err != nil {
+	if err := ml.ValidateIDs(); err != nil {
+		return nil, err
+	}
+	if err := ml.ValidateCommittees(); err != nil {
+		return nil, err
+	}
+	if err := o.validateCommitteeRequired(ml); err != nil {
+		return nil, err
+	}
+	// The parent service is only needed to check a committee's project; plain updates skip the lookup.
+	var svc *model.GroupsIOService
+	if committeeUID(ml) != "" {
+		var err error
+		if svc, err = o.parentService(ctx, ml); err != nil {
+			return nil, err
+		}
+	}
+	if err := o.validateCommitteeProject(ctx, ml, svc); err != nil {
 		return nil, err
 	}
 
@@ -177,8 +483,12 @@ func (o *GroupsIOMailingListOrchestrator) UpdateMailingList(ctx context.Context,
 	// Compare pre- and post-update committee UIDs to detect association changes.
 	newCUID := committeeUID(mapped)
 	if oldCUID != newCUID {
-		o.notifyCommitteeRemoved(ctx, oldCUID, mailingListID)
-		o.notifyCommitteeAdded(ctx, newCUID)
+		if err := o.notifyCommitteeRemoved(ctx, oldCUID, mailingListID); err != nil {
+			mapped.Warnings = append(mapped.Warnings, committeeNotifyWarning(oldCUID))
+		}
+		if err := o.notifyCommitteeAdded(ctx, newCUID); err != nil {
+			mapped.Warnings = append(mapped.Warnings, committeeNotifyWarning(newCUID))
+		}
 	}
 
 	return mapped, nil
@@ -191,43 +501,51 @@ func (o *GroupsIOMailingListOrchestrator) DeleteMailingList(ctx context.Context,
 	// Fetch current state before delete so we know which committee to notify.
 	cUID := o.fetchCommitteeUID(ctx, mailingListID)
 
-	if err := o.writer.DeleteMailingList(ctx, mailingListID); err != nil {
+	err := o.writer.DeleteMailingList(ctx, mailingListID)
+	if err := deleteError(ctx, o.idempotentDeletes, "mailing_list", mailingListID, err); err != nil {
 		return err
 	}
 
-	o.notifyCommitteeRemoved(ctx, cUID, mailingListID)
+	// Delete has no response body to carry a warning; a failed publish is only logged.
+	_ = o.notifyCommitteeRemoved(ctx, cUID, mailingListID)
 	return nil
 }
 
 // ---- Event publishing helpers ----
 
+// committeeNotifyWarning is the client-facing warning added to a create/update response when
+// the committee could not be told about the change. The mailing list itself was saved.
+func committeeNotifyWarning(cUID string) string {
+	return fmt.Sprintf("mailing list saved, but committee %s could not be notified; its has_mailing_list flag may be stale", cUID)
+}
+
 // notifyCommitteeAdded unconditionally publishes has_mailing_list=true for the given committee.
-// No-op when cUID is empty or publisher is not configured.
-func (o *GroupsIOMailingListOrchestrator) notifyCommitteeAdded(ctx context.Context, cUID string) {
-	o.publishCommitteeMailingListChanged(ctx, cUID, true)
+// No-op when cUID is empty or publisher is not configured. Returns the publish error, if any.
+func (o *GroupsIOMailingListOrchestrator) notifyCommitteeAdded(ctx context.Context, cUID string) error {
+	return o.publishCommitteeMailingListChanged(ctx, cUID, true)
 }
 
 // notifyCommitteeRemoved publishes has_mailing_list=false for the given committee only if
 // no other mailing lists still reference it. Checks remaining count and excludes the
 // mailing list identified by excludeMLID (the one being deleted/modified) to guard against
-// stale reads from ITX. No-op when cUID is empty.
-func (o *GroupsIOMailingListOrchestrator) notifyCommitteeRemoved(ctx context.Context, cUID string, excludeMLID string) {
+// stale reads from ITX. No-op when cUID is empty. Returns the publish error, if any.
+func (o *GroupsIOMailingListOrchestrator) notifyCommitteeRemoved(ctx context.Context, cUID string, excludeMLID string) error {
 	if cUID == "" {
-		return
+		return nil
 	}
 	if o.committeeHasRemainingMailingLists(ctx, cUID, excludeMLID) {
-		return
+		return nil
 	}
-	o.publishCommitteeMailingListChanged(ctx, cUID, false)
+	return o.publishCommitteeMailingListChanged(ctx, cUID, false)
 }
 
 // publishCommitteeMailingListChanged best-effort publishes a CommitteeMailingListChangedEvent.
 // No-op when cUID is empty or publisher is not configured. The committee service's
 // UpdateHasMailingList is the idempotency guard and skips the KV write + re-index if the
-// flag already matches.
-func (o *GroupsIOMailingListOrchestrator) publishCommitteeMailingListChanged(ctx context.Context, cUID string, hasMailingList bool) {
+// flag already matches. The error is logged and returned so callers can surface a warning.
+func (o *GroupsIOMailingListOrchestrator) publishCommitteeMailingListChanged(ctx context.Context, cUID string, hasMailingList bool) error {
 	if cUID == "" || o.publisher == nil {
-		return
+		return nil
 	}
 	event := &model.CommitteeMailingListChangedEvent{
 		CommitteeUID:   cUID,
@@ -238,7 +556,9 @@ func (o *GroupsIOMailingListOrchestrator) publishCommitteeMailingListChanged(ctx
 			"committee_uid", cUID,
 			"has_mailing_list", hasMailingList,
 			"error", err)
+		return err
 	}
+	return nil
 }
 
 // fetchCommitteeUID reads the current committee UID for a mailing list.
@@ -340,7 +660,9 @@ func (o *GroupsIOMailingListOrchestrator) mapMailingListResponse(ctx context.Con
 
 // NewGroupsIOMailingListOrchestrator creates a new orchestrator with the given options.
 func NewGroupsIOMailingListOrchestrator(opts ...MailingListOrchestratorOption) port.GroupsIOMailingListWriter {
-	o := &GroupsIOMailingListOrchestrator{}
+	o := &GroupsIOMailingListOrchestrator{
+		reservedGroupNames: reservedNameSet(constants.DefaultReservedGroupNames),
+	}
 	for _, opt := range opts {
 		opt(o)
 	}
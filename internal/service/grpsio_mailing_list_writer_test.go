@@ -6,7 +6,9 @@ package service
 import (
 	"context"
 	"errors"
+	"fmt"
 	"testing"
+	"time"
 
 	"github.com/linuxfoundation/lfx-v2-mailing-list-service/internal/domain/model"
 	"github.com/linuxfoundation/lfx-v2-mailing-list-service/internal/domain/port"
@@ -45,9 +47,13 @@ type stubMLWriter struct {
 	createErr  error
 	updateErr  error
 	deleteErr  error
+
+	createCalls int
+	deletedIDs  []string
 }
 
 func (w *stubMLWriter) CreateMailingList(_ context.Context, ml *model.GroupsIOMailingList) (*model.GroupsIOMailingList, error) {
+	w.createCalls++
 	if w.createResp != nil {
 		return w.createResp, w.createErr
 	}
@@ -61,7 +67,10 @@ func (w *stubMLWriter) UpdateMailingList(_ context.Context, _ string, ml *model.
 	return ml, w.updateErr
 }
 
-func (w *stubMLWriter) DeleteMailingList(_ context.Context, _ string) error { return w.deleteErr }
+func (w *stubMLWriter) DeleteMailingList(_ context.Context, mailingListID string) error {
+	w.deletedIDs = append(w.deletedIDs, mailingListID)
+	return w.deleteErr
+}
 
 var _ port.GroupsIOMailingListWriter = (*stubMLWriter)(nil)
 
@@ -98,11 +107,21 @@ var _ port.Translator = (*passthroughTranslator)(nil)
 
 // stubServiceReader returns the configured service/err from GetService.
 type stubServiceReader struct {
-	svc *model.GroupsIOService
-	err error
+	svc   *model.GroupsIOService
+	err   error
+	delay time.Duration
+	calls int
 }
 
-func (r *stubServiceReader) GetService(_ context.Context, _ string) (*model.GroupsIOService, error) {
+func (r *stubServiceReader) GetService(ctx context.Context, _ string) (*model.GroupsIOService, error) {
+	r.calls++
+	if r.delay > 0 {
+		select {
+		case <-time.After(r.delay):
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		}
+	}
 	return r.svc, r.err
 }
 func (r *stubServiceReader) ListServices(_ context.Context, _ string) ([]*model.GroupsIOService, int, error) {
@@ -285,6 +304,150 @@ func TestCreateMailingList_WriterError_NoPublish(t *testing.T) {
 	assert.Empty(t, spy.calls)
 }
 
+func TestCreateMailingList_PublishFails_ReturnsCreatedWithWarning(t *testing.T) {
+	spy := &spyInternalPublisher{err: errors.New("nats unavailable")}
+	writer := &stubMLWriter{createResp: mlWith("committee-create")}
+	o := newTestOrchestrator(writer, nil, spy)
+
+	resp, err := o.CreateMailingList(context.Background(), mlWith("committee-create"))
+	require.NoError(t, err, "a failed best-effort publish must not fail the create")
+	require.NotNil(t, resp)
+	require.Len(t, resp.Warnings, 1)
+	assert.Contains(t, resp.Warnings[0], "committee-create")
+}
+
+func TestCreateMailingList_PublishSucceeds_NoWarnings(t *testing.T) {
+	writer := &stubMLWriter{createResp: mlWith("committee-create")}
+	o := newTestOrchestrator(writer, nil, &spyInternalPublisher{})
+
+	resp, err := o.CreateMailingList(context.Background(), mlWith("committee-create"))
+	require.NoError(t, err)
+	assert.Empty(t, resp.Warnings)
+}
+
+// ---- strict publish ----
+
+func TestCreateMailingList_StrictPublishFails_RollsBackAndReturnsError(t *testing.T) {
+	spy := &spyInternalPublisher{err: errors.New("nats unavailable")}
+	created := mlWith("committee-create")
+	created.UID = "ml-new"
+	writer := &stubMLWriter{createResp: created}
+	o := newTestOrchestrator(writer, nil, spy)
+	o.strictPublish = true
+
+	resp, err := o.CreateMailingList(context.Background(), mlWith("committee-create"))
+	assert.Nil(t, resp)
+	assert.IsType(t, errs.ServiceUnavailable{}, err)
+	assert.Equal(t, []string{"ml-new"}, writer.deletedIDs)
+}
+
+func TestCreateMailingList_StrictPublishRollbackFails_ReturnsUnexpected(t *testing.T) {
+	spy := &spyInternalPublisher{err: errors.New("nats unavailable")}
+	created := mlWith("committee-create")
+	created.UID = "ml-new"
+	writer := &stubMLWriter{createResp: created, deleteErr: errs.NewServiceUnavailable("itx down")}
+	o := newTestOrchestrator(writer, nil, spy)
+	o.strictPublish = true
+
+	_, err := o.CreateMailingList(context.Background(), mlWith("committee-create"))
+	assert.IsType(t, errs.Unexpected{}, err)
+	assert.Contains(t, err.Error(), "ml-new")
+}
+
+func TestCreateMailingList_StrictPublishSucceeds_NoRollback(t *testing.T) {
+	writer := &stubMLWriter{createResp: mlWith("committee-create")}
+	o := newTestOrchestrator(writer, nil, &spyInternalPublisher{})
+	o.strictPublish = true
+
+	resp, err := o.CreateMailingList(context.Background(), mlWith("committee-create"))
+	require.NoError(t, err)
+	assert.Empty(t, resp.Warnings)
+	assert.Empty(t, writer.deletedIDs)
+}
+
+func TestCreateMailingList_DefaultPublishFails_NoRollback(t *testing.T) {
+	spy := &spyInternalPublisher{err: errors.New("nats unavailable")}
+	writer := &stubMLWriter{createResp: mlWith("committee-create")}
+	o := newTestOrchestrator(writer, nil, spy)
+
+	_, err := o.CreateMailingList(context.Background(), mlWith("committee-create"))
+	require.NoError(t, err)
+	assert.Empty(t, writer.deletedIDs)
+}
+
+// ---- idempotent delete ----
+
+func TestDeleteMailingList_IdempotentRepeatDelete_Succeeds(t *testing.T) {
+	writer := &stubMLWriter{}
+	o := newTestOrchestrator(writer, nil, &spyInternalPublisher{})
+	o.idempotentDeletes = true
+
+	require.NoError(t, o.DeleteMailingList(context.Background(), "ml-1"))
+
+	writer.deleteErr = errs.NewNotFound("mailing list not found")
+	require.NoError(t, o.DeleteMailingList(context.Background(), "ml-1"))
+	assert.Equal(t, []string{"ml-1", "ml-1"}, writer.deletedIDs)
+}
+
+func TestDeleteMailingList_DefaultRepeatDelete_ReturnsNotFound(t *testing.T) {
+	writer := &stubMLWriter{deleteErr: errs.NewNotFound("mailing list not found")}
+	o := newTestOrchestrator(writer, nil, &spyInternalPublisher{})
+
+	err := o.DeleteMailingList(context.Background(), "ml-1")
+	assert.IsType(t, errs.NotFound{}, err)
+}
+
+// ---- max mailing lists per service ----
+
+func TestCreateMailingList_ServiceListCap(t *testing.T) {
+	existing := []*model.GroupsIOMailingList{
+		{UID: "ml-1", ServiceUID: "svc-1"},
+		{UID: "ml-2", ServiceUID: "svc-1"},
+		{UID: "ml-3", ServiceUID: "svc-other"},
+	}
+	tests := []struct {
+		name    string
+		max     int
+		wantErr bool
+	}{
+		{name: "unlimited", max: 0},
+		{name: "below cap", max: 3},
+		{name: "at cap", max: 2, wantErr: true},
+		{name: "above cap", max: 1, wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			writer := &stubMLWriter{}
+			reader := &stubMLReader{listMLs: existing}
+			o := newTestOrchestrator(writer, reader, nil)
+			o.maxListsPerService = tt.max
+
+			_, err := o.CreateMailingList(context.Background(), &model.GroupsIOMailingList{ServiceUID: "svc-1"})
+			if tt.wantErr {
+				assert.IsType(t, errs.Validation{}, err)
+				assert.Contains(t, err.Error(), fmt.Sprintf("maximum per service is %d", tt.max))
+				assert.Zero(t, writer.createCalls)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, 1, writer.createCalls)
+		})
+	}
+}
+
+func TestCreateMailingList_CapAndCommittee_LoadsParentServiceOnce(t *testing.T) {
+	writer := &stubMLWriter{}
+	reader := &stubMLReader{listMLs: []*model.GroupsIOMailingList{{UID: "ml-1", ServiceUID: "svc-1"}}}
+	svcReader := &stubServiceReader{svc: &model.GroupsIOService{ProjectUID: "test-project"}}
+	o := newTestOrchestratorWithValidation(writer, reader, nil, svcReader, &stubCommitteeProjectLookup{projectUID: "test-project"})
+	o.maxListsPerService = 5
+
+	_, err := o.CreateMailingList(context.Background(), mlWithService("committee-1", "svc-1"))
+	require.NoError(t, err)
+	assert.Equal(t, 1, writer.createCalls)
+	assert.Equal(t, 1, svcReader.calls, "the cap and committee checks share one parent service lookup")
+}
+
 // ---- UpdateMailingList ----
 
 func TestUpdateMailingList_SameCommittee_NoPublish(t *testing.T) {
@@ -318,6 +481,19 @@ func TestUpdateMailingList_CommitteeChanged_PublishesFalseOldTrueNew(t *testing.
 	assert.True(t, evtNew.HasMailingList)
 }
 
+func TestUpdateMailingList_PublishFails_ReturnsWarningPerCommittee(t *testing.T) {
+	spy := &spyInternalPublisher{err: errors.New("nats unavailable")}
+	reader := &stubMLReader{ml: mlWith("old-committee")}
+	writer := &stubMLWriter{updateResp: mlWith("new-committee")}
+	o := newTestOrchestrator(writer, reader, spy)
+
+	resp, err := o.UpdateMailingList(context.Background(), "ml-1", mlWith("new-committee"))
+	require.NoError(t, err)
+	require.Len(t, resp.Warnings, 2)
+	assert.Contains(t, resp.Warnings[0], "old-committee")
+	assert.Contains(t, resp.Warnings[1], "new-committee")
+}
+
 func TestUpdateMailingList_CommitteeRemovedOnUpdate_PublishesFalseOldNoNew(t *testing.T) {
 	spy := &spyInternalPublisher{}
 	reader := &stubMLReader{ml: mlWith("old-committee")}              // listMLs empty → no remaining MLs
@@ -534,6 +710,54 @@ func TestCommitteeHasRemainingMailingLists_Empty_ReturnsFalse(t *testing.T) {
 	assert.False(t, o.committeeHasRemainingMailingLists(context.Background(), "c-1", "ml-1"))
 }
 
+// ---- parentService ----
+
+func TestParentService_NoServiceUID_Skips(t *testing.T) {
+	svcReader := &stubServiceReader{svc: &model.GroupsIOService{ProjectUID: "proj-A"}}
+	o := newTestOrchestratorWithValidation(&stubMLWriter{}, nil, nil, svcReader, nil)
+
+	svc, err := o.parentService(context.Background(), &model.GroupsIOMailingList{})
+	require.NoError(t, err)
+	assert.Nil(t, svc)
+	assert.Zero(t, svcReader.calls)
+}
+
+func TestParentService_NilService_ReturnsServiceUnavailable(t *testing.T) {
+	svcReader := &stubServiceReader{svc: nil} // returns nil, nil
+	o := newTestOrchestratorWithValidation(&stubMLWriter{}, nil, nil, svcReader, nil)
+
+	_, err := o.parentService(context.Background(), mlWithService("committee-1", "svc-1"))
+	require.Error(t, err)
+	assert.IsType(t, errs.ServiceUnavailable{}, err)
+}
+
+func TestParentService_ServiceReaderError_MapsByType(t *testing.T) {
+	tests := []struct {
+		name     string
+		readErr  error
+		expected error
+	}{
+		{name: "not found", readErr: errs.NewNotFound("resource not found"), expected: errs.NotFound{}},
+		{name: "service unavailable", readErr: errs.NewServiceUnavailable("ITX service unavailable"), expected: errs.ServiceUnavailable{}},
+		{name: "timeout", readErr: errs.NewTimeout("ITX GET request timed out"), expected: errs.Timeout{}},
+		{name: "unexpected upstream error", readErr: errs.NewUnexpected("ITX error (status 500)"), expected: errs.ServiceUnavailable{}},
+		{name: "untyped error", readErr: errors.New("connection reset by peer"), expected: errs.ServiceUnavailable{}},
+		{name: "validation", readErr: errs.NewValidation("bad request"), expected: errs.Validation{}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			svcReader := &stubServiceReader{err: tt.readErr}
+			o := newTestOrchestratorWithValidation(&stubMLWriter{}, nil, nil, svcReader, nil)
+
+			_, err := o.parentService(context.Background(), mlWithService("committee-1", "svc-1"))
+			require.Error(t, err)
+			assert.IsType(t, tt.expected, err)
+			assert.ErrorIs(t, err, tt.readErr, "underlying error should remain inspectable")
+		})
+	}
+}
+
 // ---- validateCommitteeProject ----
 
 func TestValidateCommitteeProject_NoCommittee_Skips(t *testing.T) {
@@ -542,7 +766,7 @@ func TestValidateCommitteeProject_NoCommittee_Skips(t *testing.T) {
 	o := newTestOrchestratorWithValidation(&stubMLWriter{}, nil, nil, svcReader, lookup)
 
 	ml := &model.GroupsIOMailingList{ServiceUID: "svc-1"}
-	require.NoError(t, o.validateCommitteeProject(context.Background(), ml))
+	require.NoError(t, o.validateCommitteeProject(context.Background(), ml, svcReader.svc))
 }
 
 func TestValidateCommitteeProject_NilServiceReader_ReturnsServiceUnavailable(t *testing.T) {
@@ -550,7 +774,7 @@ func TestValidateCommitteeProject_NilServiceReader_ReturnsServiceUnavailable(t *
 	o := newTestOrchestratorWithValidation(&stubMLWriter{}, nil, nil, nil, lookup)
 
 	ml := mlWithService("committee-1", "svc-1")
-	err := o.validateCommitteeProject(context.Background(), ml)
+	err := o.validateCommitteeProject(context.Background(), ml, nil)
 	require.Error(t, err)
 	assert.IsType(t, errs.ServiceUnavailable{}, err)
 }
@@ -560,18 +784,18 @@ func TestValidateCommitteeProject_NilLookup_ReturnsServiceUnavailable(t *testing
 	o := newTestOrchestratorWithValidation(&stubMLWriter{}, nil, nil, svcReader, nil)
 
 	ml := mlWithService("committee-1", "svc-1")
-	err := o.validateCommitteeProject(context.Background(), ml)
+	err := o.validateCommitteeProject(context.Background(), ml, svcReader.svc)
 	require.Error(t, err)
 	assert.IsType(t, errs.ServiceUnavailable{}, err)
 }
 
-func TestValidateCommitteeProject_NilService_ReturnsServiceUnavailable(t *testing.T) {
-	svcReader := &stubServiceReader{svc: nil} // returns nil, nil
+func TestValidateCommitteeProject_ServiceNotLoaded_ReturnsServiceUnavailable(t *testing.T) {
+	svcReader := &stubServiceReader{svc: &model.GroupsIOService{ProjectUID: "proj-A"}}
 	lookup := &stubCommitteeProjectLookup{projectUID: "proj-A"}
 	o := newTestOrchestratorWithValidation(&stubMLWriter{}, nil, nil, svcReader, lookup)
 
 	ml := mlWithService("committee-1", "svc-1")
-	err := o.validateCommitteeProject(context.Background(), ml)
+	err := o.validateCommitteeProject(context.Background(), ml, nil)
 	require.Error(t, err)
 	assert.IsType(t, errs.ServiceUnavailable{}, err)
 }
@@ -584,7 +808,7 @@ func TestValidateCommitteeProject_MissingServiceUID_ReturnsValidationError(t *te
 	ml := &model.GroupsIOMailingList{
 		Committees: []model.Committee{{UID: "committee-1"}},
 	}
-	err := o.validateCommitteeProject(context.Background(), ml)
+	err := o.validateCommitteeProject(context.Background(), ml, nil)
 	require.Error(t, err)
 	assert.IsType(t, errs.Validation{}, err)
 }
@@ -595,7 +819,7 @@ func TestValidateCommitteeProject_SameProject_Passes(t *testing.T) {
 	o := newTestOrchestratorWithValidation(&stubMLWriter{}, nil, nil, svcReader, lookup)
 
 	ml := mlWithService("committee-1", "svc-1")
-	require.NoError(t, o.validateCommitteeProject(context.Background(), ml))
+	require.NoError(t, o.validateCommitteeProject(context.Background(), ml, svcReader.svc))
 	assert.Equal(t, "proj-A", ml.ProjectUID, "ProjectUID should be set from the service")
 }
 
@@ -605,23 +829,11 @@ func TestValidateCommitteeProject_DifferentProject_ReturnsValidationError(t *tes
 	o := newTestOrchestratorWithValidation(&stubMLWriter{}, nil, nil, svcReader, lookup)
 
 	ml := mlWithService("committee-1", "svc-1")
-	err := o.validateCommitteeProject(context.Background(), ml)
+	err := o.validateCommitteeProject(context.Background(), ml, svcReader.svc)
 	require.Error(t, err)
 	assert.IsType(t, errs.Validation{}, err)
 }
 
-func TestValidateCommitteeProject_ServiceReaderError_Propagates(t *testing.T) {
-	svcErr := errors.New("service not found")
-	svcReader := &stubServiceReader{err: svcErr}
-	lookup := &stubCommitteeProjectLookup{projectUID: "proj-A"}
-	o := newTestOrchestratorWithValidation(&stubMLWriter{}, nil, nil, svcReader, lookup)
-
-	ml := mlWithService("committee-1", "svc-1")
-	err := o.validateCommitteeProject(context.Background(), ml)
-	require.Error(t, err)
-	assert.Equal(t, svcErr, err)
-}
-
 func TestValidateCommitteeProject_LookupError_Propagates(t *testing.T) {
 	svcReader := &stubServiceReader{svc: &model.GroupsIOService{ProjectUID: "proj-A"}}
 	lookupErr := errors.New("committee not found")
@@ -629,7 +841,7 @@ func TestValidateCommitteeProject_LookupError_Propagates(t *testing.T) {
 	o := newTestOrchestratorWithValidation(&stubMLWriter{}, nil, nil, svcReader, lookup)
 
 	ml := mlWithService("committee-1", "svc-1")
-	err := o.validateCommitteeProject(context.Background(), ml)
+	err := o.validateCommitteeProject(context.Background(), ml, svcReader.svc)
 	require.Error(t, err)
 	assert.Equal(t, lookupErr, err)
 }
@@ -659,6 +871,7 @@ func TestCreateMailingList_SameProjectCommittee_Succeeds(t *testing.T) {
 	resp, err := o.CreateMailingList(context.Background(), mlWithService("committee-1", "svc-1"))
 	require.NoError(t, err)
 	require.NotNil(t, resp)
+	assert.Equal(t, 1, svcReader.calls, "status and committee checks share one parent service lookup")
 }
 
 func TestUpdateMailingList_CrossProjectCommittee_ReturnsError(t *testing.T) {
@@ -674,3 +887,235 @@ func TestUpdateMailingList_CrossProjectCommittee_ReturnsError(t *testing.T) {
 	assert.IsType(t, errs.Validation{}, err)
 	assert.Empty(t, spy.calls, "no event published on validation failure")
 }
+
+// ---- reserved group names ----
+
+func TestCreateMailingList_ReservedGroupName_ReturnsValidation(t *testing.T) {
+	writer := &stubMLWriter{}
+	o := NewGroupsIOMailingListOrchestrator(
+		WithMailingListWriter(writer),
+		WithMailingListTranslator(&passthroughTranslator{}),
+	)
+
+	_, err := o.CreateMailingList(context.Background(), &model.GroupsIOMailingList{GroupName: "Owner"})
+	require.Error(t, err)
+	assert.IsType(t, errs.Validation{}, err)
+}
+
+func TestCreateMailingList_DefaultReservedGroupNames_ReturnValidation(t *testing.T) {
+	writer := &stubMLWriter{}
+	o := NewGroupsIOMailingListOrchestrator(
+		WithMailingListWriter(writer),
+		WithMailingListTranslator(&passthroughTranslator{}),
+	)
+
+	for _, name := range []string{"admin", "moderators", "owner"} {
+		_, err := o.CreateMailingList(context.Background(), &model.GroupsIOMailingList{GroupName: name})
+		assert.IsType(t, errs.Validation{}, err, name)
+	}
+	assert.Zero(t, writer.createCalls)
+}
+
+func TestCreateMailingList_SimilarToDefaultReservedGroupNames_Succeeds(t *testing.T) {
+	writer := &stubMLWriter{}
+	o := NewGroupsIOMailingListOrchestrator(
+		WithMailingListWriter(writer),
+		WithMailingListTranslator(&passthroughTranslator{}),
+	)
+
+	for _, name := range []string{"admins-team", "moderators-wg", "help"} {
+		_, err := o.CreateMailingList(context.Background(), &model.GroupsIOMailingList{GroupName: name})
+		assert.NoError(t, err, name)
+	}
+}
+
+func TestCreateMailingList_SimilarToReservedGroupName_Succeeds(t *testing.T) {
+	writer := &stubMLWriter{}
+	o := NewGroupsIOMailingListOrchestrator(
+		WithMailingListWriter(writer),
+		WithMailingListTranslator(&passthroughTranslator{}),
+	)
+
+	resp, err := o.CreateMailingList(context.Background(), &model.GroupsIOMailingList{GroupName: "owner-team"})
+	require.NoError(t, err)
+	assert.Equal(t, "owner-team", resp.GroupName)
+}
+
+func TestCreateMailingList_CustomReservedGroupNames_OverrideDefaults(t *testing.T) {
+	writer := &stubMLWriter{}
+	o := NewGroupsIOMailingListOrchestrator(
+		WithMailingListWriter(writer),
+		WithMailingListTranslator(&passthroughTranslator{}),
+		WithReservedGroupNames("board"),
+	)
+
+	_, err := o.CreateMailingList(context.Background(), &model.GroupsIOMailingList{GroupName: "board"})
+	assert.IsType(t, errs.Validation{}, err)
+
+	_, err = o.CreateMailingList(context.Background(), &model.GroupsIOMailingList{GroupName: "owner"})
+	assert.NoError(t, err)
+}
+
+// ---- adopt existing on conflict ----
+
+func TestCreateMailingList_ConflictWithAdoption_AdoptsExisting(t *testing.T) {
+	existing := &model.GroupsIOMailingList{UID: "ml-existing", GroupName: "dev", ServiceUID: "test-service", ProjectUID: "test-project"}
+	reader := &stubMLReader{listMLs: []*model.GroupsIOMailingList{
+		{UID: "ml-other", GroupName: "dev", ServiceUID: "other-service", ProjectUID: "test-project"},
+		existing,
+	}}
+	writer := &stubMLWriter{createErr: errs.NewConflict("conflict: subgroup already exists")}
+	o := newTestOrchestrator(writer, reader, nil)
+	o.adoptExisting = true
+
+	resp, err := o.CreateMailingList(context.Background(), &model.GroupsIOMailingList{GroupName: "Dev", ServiceUID: "test-service"})
+	require.NoError(t, err)
+	assert.Equal(t, "ml-existing", resp.UID)
+	require.Len(t, resp.Warnings, 1)
+	assert.Contains(t, resp.Warnings[0], "adopted")
+	assert.Equal(t, 1, o.serviceReader.(*stubServiceReader).calls, "adoption reuses the parent service loaded before the create")
+}
+
+func TestCreateMailingList_ConflictWithoutAdoption_ReturnsConflict(t *testing.T) {
+	reader := &stubMLReader{listMLs: []*model.GroupsIOMailingList{{UID: "ml-existing", GroupName: "dev", ServiceUID: "test-service"}}}
+	writer := &stubMLWriter{createErr: errs.NewConflict("conflict: subgroup already exists")}
+	o := newTestOrchestrator(writer, reader, nil)
+
+	_, err := o.CreateMailingList(context.Background(), &model.GroupsIOMailingList{GroupName: "dev", ServiceUID: "test-service"})
+	assert.IsType(t, errs.Conflict{}, err)
+}
+
+func TestCreateMailingList_ConflictNoMatchingList_ReturnsConflict(t *testing.T) {
+	reader := &stubMLReader{listMLs: []*model.GroupsIOMailingList{{UID: "ml-other", GroupName: "board", ServiceUID: "test-service"}}}
+	writer := &stubMLWriter{createErr: errs.NewConflict("conflict: subgroup already exists")}
+	o := newTestOrchestrator(writer, reader, nil)
+	o.adoptExisting = true
+
+	_, err := o.CreateMailingList(context.Background(), &model.GroupsIOMailingList{GroupName: "dev", ServiceUID: "test-service"})
+	assert.IsType(t, errs.Conflict{}, err)
+}
+
+// ---- parent service status ----
+
+func TestCreateMailingList_ParentServiceStatus(t *testing.T) {
+	tests := []struct {
+		status  string
+		wantErr bool
+	}{
+		{status: constants.ServiceStatusActive},
+		{status: constants.ServiceStatusCreated},
+		{status: constants.ServiceStatusPending},
+		{status: constants.ServiceStatusDisabled, wantErr: true},
+		{status: constants.ServiceStatusDeleted, wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.status, func(t *testing.T) {
+			svcReader := &stubServiceReader{svc: &model.GroupsIOService{ProjectUID: "proj-A", Status: tt.status}}
+			o := newTestOrchestratorWithValidation(&stubMLWriter{}, nil, nil, svcReader, nil)
+
+			_, err := o.CreateMailingList(context.Background(), &model.GroupsIOMailingList{GroupName: "dev", ServiceUID: "svc-1"})
+			if tt.wantErr {
+				assert.IsType(t, errs.Validation{}, err)
+				return
+			}
+			assert.NoError(t, err)
+		})
+	}
+}
+
+// ---- create budget ----
+
+func TestCreateMailingList_BudgetExceededBeforeCreate_ReturnsTimeoutWithoutWriting(t *testing.T) {
+	writer := &stubMLWriter{}
+	svcReader := &stubServiceReader{svc: &model.GroupsIOService{ProjectUID: "proj-A", Status: constants.ServiceStatusActive}, delay: time.Second}
+	o := newTestOrchestratorWithValidation(writer, nil, nil, svcReader, nil)
+	o.createBudget = 20 * time.Millisecond
+
+	_, err := o.CreateMailingList(context.Background(), &model.GroupsIOMailingList{GroupName: "dev", ServiceUID: "svc-1"})
+	require.Error(t, err)
+	assert.IsType(t, errs.Timeout{}, err)
+	assert.Zero(t, writer.createCalls)
+}
+
+func TestCreateMailingList_WithinBudget_Creates(t *testing.T) {
+	writer := &stubMLWriter{}
+	svcReader := &stubServiceReader{svc: &model.GroupsIOService{ProjectUID: "proj-A", Status: constants.ServiceStatusActive}}
+	o := newTestOrchestratorWithValidation(writer, nil, nil, svcReader, nil)
+	o.createBudget = time.Second
+
+	_, err := o.CreateMailingList(context.Background(), &model.GroupsIOMailingList{GroupName: "dev", ServiceUID: "svc-1"})
+	require.NoError(t, err)
+	assert.Equal(t, 1, writer.createCalls)
+}
+
+func TestCreateMailingList_CallerCancelled_NotReportedAsTimeout(t *testing.T) {
+	svcReader := &stubServiceReader{svc: &model.GroupsIOService{ProjectUID: "proj-A"}, delay: time.Second}
+	o := newTestOrchestratorWithValidation(&stubMLWriter{}, nil, nil, svcReader, nil)
+	o.createBudget = time.Minute
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	_, err := o.CreateMailingList(ctx, &model.GroupsIOMailingList{GroupName: "dev", ServiceUID: "svc-1"})
+	require.Error(t, err)
+	var timeoutErr errs.Timeout
+	assert.False(t, errors.As(err, &timeoutErr))
+}
+
+// ---- duplicate committees ----
+
+func TestCreateMailingList_DuplicateCommittees_ReturnsValidation(t *testing.T) {
+	writer := &stubMLWriter{}
+	o := newTestOrchestrator(writer, nil, nil)
+
+	_, err := o.CreateMailingList(context.Background(), &model.GroupsIOMailingList{
+		GroupName:  "dev",
+		Committees: []model.Committee{{UID: "c-1"}, {UID: "c-1"}},
+	})
+	assert.IsType(t, errs.Validation{}, err)
+	assert.Zero(t, writer.createCalls)
+}
+
+// ---- committee-required list types ----
+
+func TestCreateMailingList_CommitteeRequiredType(t *testing.T) {
+	tests := []struct {
+		name       string
+		listType   string
+		committees []model.Committee
+		wantErr    bool
+	}{
+		{name: "required and missing", listType: model.TypeDiscussionModerated, wantErr: true},
+		{name: "required and present", listType: model.TypeDiscussionModerated, committees: []model.Committee{{UID: "c-1"}}},
+		{name: "not required", listType: model.TypeDiscussionOpen},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			writer := &stubMLWriter{}
+			svcReader := &stubServiceReader{svc: &model.GroupsIOService{ProjectUID: "proj-A"}}
+			o := newTestOrchestratorWithValidation(writer, nil, nil, svcReader, &stubCommitteeProjectLookup{projectUID: "proj-A"})
+			WithCommitteeRequiredTypes(model.TypeDiscussionModerated)(o)
+
+			_, err := o.CreateMailingList(context.Background(), &model.GroupsIOMailingList{
+				GroupName:  "dev",
+				ServiceUID: "svc-1",
+				Type:       tt.listType,
+				Committees: tt.committees,
+			})
+			if tt.wantErr {
+				assert.IsType(t, errs.Validation{}, err)
+				assert.Zero(t, writer.createCalls)
+				return
+			}
+			assert.NoError(t, err)
+		})
+	}
+}
+
+func TestUpdateMailingList_CommitteeRequiredTypeMissing_ReturnsValidation(t *testing.T) {
+	o := newTestOrchestrator(&stubMLWriter{}, nil, nil)
+	WithCommitteeRequiredTypes(model.TypeDiscussionModerated)(o)
+
+	_, err := o.UpdateMailingList(context.Background(), "ml-1", &model.GroupsIOMailingList{Type: model.TypeDiscussionModerated})
+	assert.IsType(t, errs.Validation{}, err)
+}
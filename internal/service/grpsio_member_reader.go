@@ -5,9 +5,15 @@ package service
 
 import (
 	"context"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
 
 	"github.com/linuxfoundation/lfx-v2-mailing-list-service/internal/domain/model"
 	"github.com/linuxfoundation/lfx-v2-mailing-list-service/internal/domain/port"
+	"github.com/linuxfoundation/lfx-v2-mailing-list-service/pkg/constants"
+	errs "github.com/linuxfoundation/lfx-v2-mailing-list-service/pkg/errors"
 )
 
 // GroupsIOMailingListMemberReaderOrchestrator implements port.GroupsIOMailingListMemberReader
@@ -42,8 +48,117 @@ func (o *GroupsIOMailingListMemberReaderOrchestrator) CheckSubscriber(ctx contex
 	return o.reader.CheckSubscriber(ctx, mailingListID, email)
 }
 
+// ListMembersNeedingReview returns the members of a mailing list whose LastReviewedAt is
+// nil or before olderThan, for governance reviews of stale memberships.
+func (o *GroupsIOMailingListMemberReaderOrchestrator) ListMembersNeedingReview(ctx context.Context, mailingListID string, olderThan time.Time) ([]*model.GrpsIOMember, error) {
+	members, _, err := o.reader.ListMembers(ctx, mailingListID)
+	if err != nil {
+		return nil, err
+	}
+	var out []*model.GrpsIOMember
+	for _, m := range members {
+		if m.NeedsReview(olderThan) {
+			out = append(out, m)
+		}
+	}
+	return out, nil
+}
+
+// ListMembersModifiedSince returns the members of a mailing list whose UpdatedAt is after since,
+// for incremental sync jobs. ITX has no modified-since filter, so the list is filtered here.
+func (o *GroupsIOMailingListMemberReaderOrchestrator) ListMembersModifiedSince(ctx context.Context, mailingListID string, since time.Time) ([]*model.GrpsIOMember, error) {
+	members, _, err := o.reader.ListMembers(ctx, mailingListID)
+	if err != nil {
+		return nil, err
+	}
+	var out []*model.GrpsIOMember
+	for _, m := range members {
+		if m.UpdatedAt.After(since) {
+			out = append(out, m)
+		}
+	}
+	return out, nil
+}
+
+// ListMembersByOrganization returns the members of a mailing list whose Organization matches
+// organization, ignoring case and surrounding whitespace. ITX has no organization filter, so
+// the list is scanned here.
+func (o *GroupsIOMailingListMemberReaderOrchestrator) ListMembersByOrganization(ctx context.Context, mailingListID string, organization string) ([]*model.GrpsIOMember, error) {
+	org := strings.TrimSpace(organization)
+	if org == "" {
+		return nil, errs.NewValidation("organization is required")
+	}
+	members, _, err := o.reader.ListMembers(ctx, mailingListID)
+	if err != nil {
+		return nil, err
+	}
+	var out []*model.GrpsIOMember
+	for _, m := range members {
+		if strings.EqualFold(strings.TrimSpace(m.Organization), org) {
+			out = append(out, m)
+		}
+	}
+	return out, nil
+}
+
+// FindDuplicateMembers groups the UIDs of members of a mailing list that share an email
+// (trimmed, case-insensitive). Members without an email are not duplicates of each other and
+// are left out. Only groups with more than one member are returned; groups and the UIDs within
+// them are sorted for stable output.
+func (o *GroupsIOMailingListMemberReaderOrchestrator) FindDuplicateMembers(ctx context.Context, mailingListID string) ([][]string, error) {
+	members, _, err := o.reader.ListMembers(ctx, mailingListID)
+	if err != nil {
+		return nil, err
+	}
+	byEmail := make(map[string][]string)
+	for _, m := range members {
+		email := normalizeEmail(m.Email)
+		if email == "" {
+			continue
+		}
+		byEmail[email] = append(byEmail[email], m.UID)
+	}
+	var groups [][]string
+	for _, uids := range byEmail {
+		if len(uids) > 1 {
+			slices.Sort(uids)
+			groups = append(groups, uids)
+		}
+	}
+	slices.SortFunc(groups, func(a, b []string) int { return strings.Compare(a[0], b[0]) })
+	return groups, nil
+}
+
+// normalizeEmail folds an email address for duplicate detection.
+func normalizeEmail(email string) string {
+	return strings.ToLower(strings.TrimSpace(email))
+}
+
+// PreviewDeliveryModeChange reports, without writing anything, which members' emails would
+// change if the whole list were switched to targetMode (affected) and which are already on
+// it (unchanged). targetMode must be one of constants.DeliveryModes.
+func (o *GroupsIOMailingListMemberReaderOrchestrator) PreviewDeliveryModeChange(ctx context.Context, mailingListID string, targetMode string) (affected []string, unchanged []string, err error) {
+	if !slices.Contains(constants.DeliveryModes, targetMode) {
+		return nil, nil, errs.NewValidation(fmt.Sprintf("invalid delivery mode %q; must be one of %s",
+			targetMode, strings.Join(constants.DeliveryModes, ", ")))
+	}
+
+	members, _, err := o.reader.ListMembers(ctx, mailingListID)
+	if err != nil {
+		return nil, nil, err
+	}
+	for _, m := range members {
+		if m.DeliveryMode == targetMode {
+			unchanged = append(unchanged, m.Email)
+		} else {
+			affected = append(affected, m.Email)
+		}
+	}
+	return affected, unchanged, nil
+}
+
 // NewGroupsIOMailingListMemberReaderOrchestrator creates a new member reader orchestrator with the given options.
-func NewGroupsIOMailingListMemberReaderOrchestrator(opts ...MemberReaderOrchestratorOption) port.GroupsIOMailingListMemberReader {
+func NewGroupsIOMailingListMemberReaderOrchestrator(opts ...MemberReaderOrchestratorOption) port.GroupsIOMailingListMemberQueryReader {
 	o := &GroupsIOMailingListMemberReaderOrchestrator{}
 	for _, opt := range opts {
 		opt(o)
@@ -0,0 +1,149 @@
+// Copyright The Linux Foundation and each contributor to LFX.
+// SPDX-License-Identifier: MIT
+
+package service
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/linuxfoundation/lfx-v2-mailing-list-service/internal/domain/model"
+	"github.com/linuxfoundation/lfx-v2-mailing-list-service/internal/infrastructure/mock"
+	errs "github.com/linuxfoundation/lfx-v2-mailing-list-service/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// ---- helpers ----
+
+func newTestMemberReader(store *mock.FakeGroupsIOReader, opts ...MemberReaderOrchestratorOption) *GroupsIOMailingListMemberReaderOrchestrator {
+	return NewGroupsIOMailingListMemberReaderOrchestrator(append([]MemberReaderOrchestratorOption{
+		WithMemberReader(store),
+	}, opts...)...).(*GroupsIOMailingListMemberReaderOrchestrator)
+}
+
+func stringPtr(s string) *string { return &s }
+
+// ---- ListMembersNeedingReview ----
+
+func TestListMembersNeedingReview_ReturnsStaleAndNeverReviewed(t *testing.T) {
+	store := mock.NewFakeGroupsIOReader()
+	store.AddMember("ml-1", &model.GrpsIOMember{UID: "m-recent", LastReviewedAt: stringPtr("2026-09-01T00:00:00Z")})
+	store.AddMember("ml-1", &model.GrpsIOMember{UID: "m-stale", LastReviewedAt: stringPtr("2025-01-15T00:00:00Z")})
+	store.AddMember("ml-1", &model.GrpsIOMember{UID: "m-never"})
+	store.AddMember("ml-2", &model.GrpsIOMember{UID: "m-other-list"})
+	o := newTestMemberReader(store)
+
+	cutoff := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	got, err := o.ListMembersNeedingReview(context.Background(), "ml-1", cutoff)
+	require.NoError(t, err)
+
+	var uids []string
+	for _, m := range got {
+		uids = append(uids, m.UID)
+	}
+	assert.ElementsMatch(t, []string{"m-stale", "m-never"}, uids)
+}
+
+func TestListMembersNeedingReview_AllRecent_ReturnsEmpty(t *testing.T) {
+	store := mock.NewFakeGroupsIOReader()
+	store.AddMember("ml-1", &model.GrpsIOMember{UID: "m-1", LastReviewedAt: stringPtr("2026-09-01T00:00:00Z")})
+	o := newTestMemberReader(store)
+
+	got, err := o.ListMembersNeedingReview(context.Background(), "ml-1", time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC))
+	require.NoError(t, err)
+	assert.Empty(t, got)
+}
+
+// ---- ListMembersModifiedSince ----
+
+func TestListMembersModifiedSince_ReturnsOnlyMembersUpdatedAfterCutoff(t *testing.T) {
+	cutoff := time.Date(2026, 6, 1, 0, 0, 0, 0, time.UTC)
+	store := mock.NewFakeGroupsIOReader()
+	store.AddMember("ml-1", &model.GrpsIOMember{UID: "m-before", UpdatedAt: cutoff.Add(-time.Hour)})
+	store.AddMember("ml-1", &model.GrpsIOMember{UID: "m-at", UpdatedAt: cutoff})
+	store.AddMember("ml-1", &model.GrpsIOMember{UID: "m-after", UpdatedAt: cutoff.Add(time.Hour)})
+	store.AddMember("ml-2", &model.GrpsIOMember{UID: "m-other-list", UpdatedAt: cutoff.Add(time.Hour)})
+	o := newTestMemberReader(store)
+
+	got, err := o.ListMembersModifiedSince(context.Background(), "ml-1", cutoff)
+	require.NoError(t, err)
+	require.Len(t, got, 1)
+	assert.Equal(t, "m-after", got[0].UID)
+}
+
+// ---- ListMembersByOrganization ----
+
+func TestListMembersByOrganization_MatchesCaseInsensitively(t *testing.T) {
+	store := mock.NewFakeGroupsIOReader()
+	store.AddMember("ml-1", &model.GrpsIOMember{UID: "m-1", Organization: "Acme Corp"})
+	store.AddMember("ml-1", &model.GrpsIOMember{UID: "m-2", Organization: "acme corp "})
+	store.AddMember("ml-1", &model.GrpsIOMember{UID: "m-3", Organization: "Globex"})
+	store.AddMember("ml-1", &model.GrpsIOMember{UID: "m-4"})
+	store.AddMember("ml-2", &model.GrpsIOMember{UID: "m-other-list", Organization: "Acme Corp"})
+	o := newTestMemberReader(store)
+
+	got, err := o.ListMembersByOrganization(context.Background(), "ml-1", "ACME CORP")
+	require.NoError(t, err)
+	var uids []string
+	for _, m := range got {
+		uids = append(uids, m.UID)
+	}
+	assert.ElementsMatch(t, []string{"m-1", "m-2"}, uids)
+}
+
+func TestListMembersByOrganization_EmptyOrganization_ReturnsValidation(t *testing.T) {
+	o := newTestMemberReader(mock.NewFakeGroupsIOReader())
+
+	_, err := o.ListMembersByOrganization(context.Background(), "ml-1", " ")
+	assert.IsType(t, errs.Validation{}, err)
+}
+
+// ---- FindDuplicateMembers ----
+
+func TestFindDuplicateMembers_GroupsByNormalizedEmail(t *testing.T) {
+	store := mock.NewFakeGroupsIOReader()
+	store.AddMember("ml-1", &model.GrpsIOMember{UID: "m-1", Email: "a@example.com"})
+	store.AddMember("ml-1", &model.GrpsIOMember{UID: "m-2", Email: " A@Example.com"})
+	store.AddMember("ml-1", &model.GrpsIOMember{UID: "m-3", Email: "b@example.com"})
+	o := newTestMemberReader(store)
+
+	groups, err := o.FindDuplicateMembers(context.Background(), "ml-1")
+	require.NoError(t, err)
+	assert.Equal(t, [][]string{{"m-1", "m-2"}}, groups)
+}
+
+func TestFindDuplicateMembers_EmptyEmails_AreNotDuplicates(t *testing.T) {
+	store := mock.NewFakeGroupsIOReader()
+	store.AddMember("ml-1", &model.GrpsIOMember{UID: "m-1", Email: ""})
+	store.AddMember("ml-1", &model.GrpsIOMember{UID: "m-2", Email: "  "})
+	store.AddMember("ml-1", &model.GrpsIOMember{UID: "m-3", Email: "a@example.com"})
+	o := newTestMemberReader(store)
+
+	groups, err := o.FindDuplicateMembers(context.Background(), "ml-1")
+	require.NoError(t, err)
+	assert.Empty(t, groups)
+}
+
+// ---- PreviewDeliveryModeChange ----
+
+func TestPreviewDeliveryModeChange_MixedModes_SplitsAffectedAndUnchanged(t *testing.T) {
+	store := mock.NewFakeGroupsIOReader()
+	store.AddMember("ml-1", &model.GrpsIOMember{UID: "m-1", Email: "single@example.com", DeliveryMode: "email_delivery_single"})
+	store.AddMember("ml-1", &model.GrpsIOMember{UID: "m-2", Email: "digest@example.com", DeliveryMode: "email_delivery_digest"})
+	store.AddMember("ml-1", &model.GrpsIOMember{UID: "m-3", Email: "none@example.com", DeliveryMode: "email_delivery_none"})
+	o := newTestMemberReader(store)
+
+	affected, unchanged, err := o.PreviewDeliveryModeChange(context.Background(), "ml-1", "email_delivery_digest")
+	require.NoError(t, err)
+	assert.ElementsMatch(t, []string{"single@example.com", "none@example.com"}, affected)
+	assert.Equal(t, []string{"digest@example.com"}, unchanged)
+}
+
+func TestPreviewDeliveryModeChange_UnknownMode_ReturnsValidation(t *testing.T) {
+	o := newTestMemberReader(mock.NewFakeGroupsIOReader())
+
+	_, _, err := o.PreviewDeliveryModeChange(context.Background(), "ml-1", "weekly")
+	assert.IsType(t, errs.Validation{}, err)
+}
@@ -5,16 +5,36 @@ package service
 
 import (
 	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"net/mail"
+	"strings"
+	"time"
 
 	"github.com/linuxfoundation/lfx-v2-mailing-list-service/internal/domain/model"
 	"github.com/linuxfoundation/lfx-v2-mailing-list-service/internal/domain/port"
+	"github.com/linuxfoundation/lfx-v2-mailing-list-service/pkg/constants"
+	errs "github.com/linuxfoundation/lfx-v2-mailing-list-service/pkg/errors"
+	"github.com/linuxfoundation/lfx-v2-mailing-list-service/pkg/redaction"
+	"github.com/linuxfoundation/lfx-v2-mailing-list-service/pkg/utils"
 )
 
+// DefaultMaxBatchSize is the largest number of entries accepted by a single batch member operation.
+const DefaultMaxBatchSize = 100
+
 // GroupsIOMailingListMemberWriterOrchestrator implements port.GroupsIOMailingListMemberWriter
 // by wrapping an inner GroupsIOMailingListMemberWriter and forwarding requests.
 // Member IDs are numeric strings assigned by Groups.io; no v1/v2 UUID translation is needed.
 type GroupsIOMailingListMemberWriterOrchestrator struct {
-	writer port.GroupsIOMailingListMemberWriter
+	writer            port.GroupsIOMailingListMemberWriter
+	reader            port.GroupsIOMailingListMemberReader
+	mailingListReader port.GroupsIOMailingListReader
+	serviceReader     port.GroupsIOServiceReader
+	maxBatchSize      int
+	importRate        int
+	clock             utils.Clock
+	idempotentDeletes bool
 }
 
 // MemberWriterOrchestratorOption configures a GroupsIOMailingListMemberWriterOrchestrator.
@@ -27,29 +47,392 @@ func WithMemberWriter(w port.GroupsIOMailingListMemberWriter) MemberWriterOrches
 	}
 }
 
+// WithMemberWriterReader sets the member reader used to look up current state
+// (e.g. email uniqueness checks) before mutations.
+func WithMemberWriterReader(r port.GroupsIOMailingListMemberReader) MemberWriterOrchestratorOption {
+	return func(o *GroupsIOMailingListMemberWriterOrchestrator) {
+		o.reader = r
+	}
+}
+
+// WithMemberMailingListReader sets the mailing list reader used to enforce list-type
+// restrictions (e.g. announcement lists) on new members.
+func WithMemberMailingListReader(r port.GroupsIOMailingListReader) MemberWriterOrchestratorOption {
+	return func(o *GroupsIOMailingListMemberWriterOrchestrator) {
+		o.mailingListReader = r
+	}
+}
+
+// WithMemberServiceReader sets the service reader used to reject new members on lists
+// whose parent service is disabled. Requires WithMemberMailingListReader to take effect.
+func WithMemberServiceReader(r port.GroupsIOServiceReader) MemberWriterOrchestratorOption {
+	return func(o *GroupsIOMailingListMemberWriterOrchestrator) {
+		o.serviceReader = r
+	}
+}
+
+// WithMaxBatchSize caps the number of entries accepted by batch operations such as
+// InviteMembers. Values <= 0 keep DefaultMaxBatchSize.
+func WithMaxBatchSize(n int) MemberWriterOrchestratorOption {
+	return func(o *GroupsIOMailingListMemberWriterOrchestrator) {
+		if n > 0 {
+			o.maxBatchSize = n
+		}
+	}
+}
+
+// WithImportRateLimit spaces the member adds made by ImportMembers to at most perSecond per
+// second, so large imports don't overwhelm Groups.io. Values <= 0 leave imports unthrottled.
+func WithImportRateLimit(perSecond int) MemberWriterOrchestratorOption {
+	return func(o *GroupsIOMailingListMemberWriterOrchestrator) {
+		o.importRate = perSecond
+	}
+}
+
+// WithIdempotentMemberDelete makes DeleteMember succeed when the member is already gone,
+// so retried deletes do not fail with NotFound.
+func WithIdempotentMemberDelete(enabled bool) MemberWriterOrchestratorOption {
+	return func(o *GroupsIOMailingListMemberWriterOrchestrator) {
+		o.idempotentDeletes = enabled
+	}
+}
+
+// WithMemberWriterClock replaces the clock used to pace imports. Intended for tests.
+func WithMemberWriterClock(c utils.Clock) MemberWriterOrchestratorOption {
+	return func(o *GroupsIOMailingListMemberWriterOrchestrator) {
+		o.clock = c
+	}
+}
+
+// validateBatchSize rejects batches larger than the configured maximum before any work is done.
+func (o *GroupsIOMailingListMemberWriterOrchestrator) validateBatchSize(n int) error {
+	if n > o.maxBatchSize {
+		return errs.NewValidation(fmt.Sprintf("batch of %d exceeds the maximum of %d entries", n, o.maxBatchSize))
+	}
+	return nil
+}
+
+// parentList is the mailing list new members are added to, with its parent service. It is
+// resolved once per call so a batch of adds does not re-fetch the same list and service.
+type parentList struct {
+	list    *model.GroupsIOMailingList
+	service *model.GroupsIOService
+}
+
+// resolveParentList loads the mailing list and, when it names one, its parent service. Returns
+// nil when no mailing list reader is configured; the service is nil when no service reader is.
+func (o *GroupsIOMailingListMemberWriterOrchestrator) resolveParentList(ctx context.Context, mailingListID string) (*parentList, error) {
+	if o.mailingListReader == nil {
+		return nil, nil
+	}
+	ml, err := o.mailingListReader.GetMailingList(ctx, mailingListID)
+	if err != nil {
+		return nil, err
+	}
+	parent := &parentList{list: ml}
+	if o.serviceReader == nil || ml.ServiceUID == "" {
+		return parent, nil
+	}
+	if parent.service, err = o.serviceReader.GetService(ctx, ml.ServiceUID); err != nil {
+		return nil, err
+	}
+	return parent, nil
+}
+
+// checkOpen reports whether the list accepts new members at all: a frozen (archived) list is
+// a Conflict with the list's state, and a list whose parent service is disabled or deleted is
+// a Validation error. A nil parent (no mailing list reader configured) is always open.
+func (p *parentList) checkOpen() error {
+	if p == nil {
+		return nil
+	}
+	if p.list.Frozen {
+		return errs.NewConflict(fmt.Sprintf("mailing list %s is frozen and cannot accept new members", p.list.UID))
+	}
+	if p.service == nil {
+		return nil
+	}
+	switch p.service.Status {
+	case constants.ServiceStatusDisabled, constants.ServiceStatusDeleted:
+		return errs.NewValidation(fmt.Sprintf("mailing list %s belongs to %s service %s and cannot accept new members",
+			p.list.UID, p.service.Status, p.list.ServiceUID))
+	}
+	return nil
+}
+
+// validateNewMember applies the parent-list checks for a new member: the list must be open
+// (see checkOpen), and moderators may not be added to announcement lists, since only owners
+// post there.
+func (p *parentList) validateNewMember(member *model.GrpsIOMember) error {
+	if err := p.checkOpen(); err != nil {
+		return err
+	}
+	if p != nil && p.list.Type == model.TypeAnnouncement && member.ModStatus == constants.ModStatusModerator {
+		return errs.NewValidation(fmt.Sprintf("mod_status %q is not allowed on announcement mailing lists; use %q or %q",
+			member.ModStatus, constants.ModStatusNone, constants.ModStatusOwner))
+	}
+	return nil
+}
+
 // AddMember adds a new member to a mailing list.
 func (o *GroupsIOMailingListMemberWriterOrchestrator) AddMember(ctx context.Context, mailingListID string, member *model.GrpsIOMember) (*model.GrpsIOMember, error) {
+	parent, err := o.resolveParentList(ctx, mailingListID)
+	if err != nil {
+		return nil, err
+	}
+	return o.addMember(ctx, mailingListID, member, parent)
+}
+
+// addMember validates member against an already-resolved parent and adds it.
+func (o *GroupsIOMailingListMemberWriterOrchestrator) addMember(ctx context.Context, mailingListID string, member *model.GrpsIOMember, parent *parentList) (*model.GrpsIOMember, error) {
+	if err := member.ValidateIDs(); err != nil {
+		return nil, err
+	}
+	if err := parent.validateNewMember(member); err != nil {
+		return nil, err
+	}
 	return o.writer.AddMember(ctx, mailingListID, member)
 }
 
-// UpdateMember updates an existing member in a mailing list.
+// UpdateMember updates an existing member in a mailing list. A member cannot be moved to
+// another list; a MailingListUID other than mailingListID is rejected.
 func (o *GroupsIOMailingListMemberWriterOrchestrator) UpdateMember(ctx context.Context, mailingListID string, memberID string, member *model.GrpsIOMember) (*model.GrpsIOMember, error) {
+	if err := member.ValidateIDs(); err != nil {
+		return nil, err
+	}
+	if member.MailingListUID != "" && member.MailingListUID != mailingListID {
+		return nil, errs.NewValidation(fmt.Sprintf("mailing_list_uid cannot be changed from %s to %s; remove and re-add the member instead",
+			mailingListID, member.MailingListUID))
+	}
 	return o.writer.UpdateMember(ctx, mailingListID, memberID, member)
 }
 
+// UpsertMember adds the member to the mailing list, or updates the existing member with the
+// same email (case-insensitive) when there is one; created reports which happened. If the add
+// loses a race with a concurrent add of the same email (Conflict), the member is looked up
+// again and updated instead.
+func (o *GroupsIOMailingListMemberWriterOrchestrator) UpsertMember(ctx context.Context, mailingListID string, member *model.GrpsIOMember) (result *model.GrpsIOMember, created bool, err error) {
+	if o.reader == nil {
+		return nil, false, errs.NewServiceUnavailable("member upsert is not configured")
+	}
+
+	existing, err := o.findMemberByEmail(ctx, mailingListID, member.Email)
+	if err != nil {
+		return nil, false, err
+	}
+	if existing == nil {
+		result, err = o.AddMember(ctx, mailingListID, member)
+		var conflict errs.Conflict
+		if !errors.As(err, &conflict) {
+			return result, err == nil, err
+		}
+		if existing, err = o.findMemberByEmail(ctx, mailingListID, member.Email); err != nil {
+			return nil, false, err
+		}
+		if existing == nil {
+			return nil, false, conflict
+		}
+	}
+
+	result, err = o.UpdateMember(ctx, mailingListID, existing.UID, member)
+	return result, false, err
+}
+
+// MergeMembers resolves duplicate members of a mailing list by keeping keepUID and deleting
+// each of mergeUIDs, which must all share keepUID's (non-empty) email; repeated UIDs are merged
+// once. Before anything is deleted the strongest moderation status among the duplicates is
+// carried onto the kept member, and a delivery mode is filled in if the kept member has none.
+// The creation timestamp cannot be carried across: Groups.io assigns it and does not accept it
+// on update, so the kept member keeps its own. Every member is checked before anything is
+// written; an update or delete failure stops the merge and is returned.
+func (o *GroupsIOMailingListMemberWriterOrchestrator) MergeMembers(ctx context.Context, mailingListID string, keepUID string, mergeUIDs []string) error {
+	if o.reader == nil {
+		return errs.NewServiceUnavailable("member merge is not configured")
+	}
+
+	keep, err := o.reader.GetMember(ctx, mailingListID, keepUID)
+	if err != nil {
+		return err
+	}
+	email := normalizeEmail(keep.Email)
+	if email == "" {
+		return errs.NewValidation(fmt.Sprintf("member %s has no email to merge on", keepUID))
+	}
+
+	merged := *keep
+	uids := make([]string, 0, len(mergeUIDs))
+	seen := make(map[string]bool, len(mergeUIDs))
+	for _, uid := range mergeUIDs {
+		if seen[uid] {
+			continue
+		}
+		seen[uid] = true
+		if uid == keepUID {
+			return errs.NewValidation(fmt.Sprintf("member %s cannot be merged into itself", uid))
+		}
+		m, err := o.reader.GetMember(ctx, mailingListID, uid)
+		if err != nil {
+			return err
+		}
+		if normalizeEmail(m.Email) != email {
+			return errs.NewValidation(fmt.Sprintf("member %s does not share the email of member %s", uid, keepUID))
+		}
+		if modStatusRank[m.ModStatus] > modStatusRank[merged.ModStatus] {
+			merged.ModStatus = m.ModStatus
+		}
+		if merged.DeliveryMode == "" {
+			merged.DeliveryMode = m.DeliveryMode
+		}
+		uids = append(uids, uid)
+	}
+
+	if merged.ModStatus != keep.ModStatus || merged.DeliveryMode != keep.DeliveryMode {
+		if _, err := o.writer.UpdateMember(ctx, mailingListID, keepUID, &merged); err != nil {
+			return err
+		}
+	}
+	for _, uid := range uids {
+		if err := o.writer.DeleteMember(ctx, mailingListID, uid); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// modStatusRank orders moderation statuses from least to most privileged, so a merge keeps
+// the strongest one. Unknown statuses rank with "none".
+var modStatusRank = map[string]int{
+	constants.ModStatusNone:      0,
+	constants.ModStatusModerator: 1,
+	constants.ModStatusOwner:     2,
+}
+
+// findMemberByEmail returns the member of the mailing list with the given email
+// (case-insensitive), or nil if there is none.
+func (o *GroupsIOMailingListMemberWriterOrchestrator) findMemberByEmail(ctx context.Context, mailingListID string, email string) (*model.GrpsIOMember, error) {
+	members, _, err := o.reader.ListMembers(ctx, mailingListID)
+	if err != nil {
+		return nil, err
+	}
+	for _, m := range members {
+		if strings.EqualFold(m.Email, email) {
+			return m, nil
+		}
+	}
+	return nil, nil
+}
+
 // DeleteMember removes a member from a mailing list.
 func (o *GroupsIOMailingListMemberWriterOrchestrator) DeleteMember(ctx context.Context, mailingListID string, memberID string) error {
-	return o.writer.DeleteMember(ctx, mailingListID, memberID)
+	return deleteError(ctx, o.idempotentDeletes, "member", memberID, o.writer.DeleteMember(ctx, mailingListID, memberID))
+}
+
+// ChangeMemberEmail changes a member's email in place, keeping the member record (and its
+// Groups.io history) instead of forcing a delete and re-add. The new email must be valid and
+// must not already be subscribed to the mailing list. Changing to the exact current email is
+// a no-op; a case-only change is applied without the subscriber check, since Groups.io matches
+// addresses case-insensitively and would report the member itself as the conflict.
+func (o *GroupsIOMailingListMemberWriterOrchestrator) ChangeMemberEmail(ctx context.Context, mailingListID string, memberID string, newEmail string) (*model.GrpsIOMember, error) {
+	if o.reader == nil {
+		return nil, errs.NewServiceUnavailable("member email change is not configured")
+	}
+
+	newEmail = strings.TrimSpace(newEmail)
+	if addr, err := mail.ParseAddress(newEmail); err != nil || addr.Address != newEmail {
+		return nil, errs.NewValidation(fmt.Sprintf("invalid email address %q", newEmail))
+	}
+
+	member, err := o.reader.GetMember(ctx, mailingListID, memberID)
+	if err != nil {
+		return nil, err
+	}
+	if member.Email == newEmail {
+		return member, nil
+	}
+
+	if !strings.EqualFold(member.Email, newEmail) {
+		subscribed, err := o.reader.CheckSubscriber(ctx, mailingListID, newEmail)
+		if err != nil {
+			return nil, err
+		}
+		if subscribed {
+			return nil, errs.NewConflict("another member of this mailing list already uses that email")
+		}
+	}
+
+	member.Email = newEmail
+	return o.writer.UpdateMember(ctx, mailingListID, memberID, member)
 }
 
 // InviteMembers sends invitations to the given email addresses to join a mailing list.
 func (o *GroupsIOMailingListMemberWriterOrchestrator) InviteMembers(ctx context.Context, mailingListID string, emails []string) error {
+	if err := o.validateBatchSize(len(emails)); err != nil {
+		return err
+	}
 	return o.writer.InviteMembers(ctx, mailingListID, emails)
 }
 
+// ImportMembers adds each member to a mailing list and reports how many were created, skipped
+// because they already exist (the writer returned Conflict), or failed. The parent list and
+// service are resolved once for the whole batch. A failing member does not stop the import;
+// only an oversized batch, an unresolvable or closed parent list (see checkOpen), or a cancelled
+// context aborts it. With an import rate limit, consecutive adds start at least 1/rate seconds apart.
+func (o *GroupsIOMailingListMemberWriterOrchestrator) ImportMembers(ctx context.Context, mailingListID string, members []*model.GrpsIOMember) (*model.ImportSummary, error) {
+	if err := o.validateBatchSize(len(members)); err != nil {
+		return nil, err
+	}
+	parent, err := o.resolveParentList(ctx, mailingListID)
+	if err != nil {
+		return nil, err
+	}
+	if err := parent.checkOpen(); err != nil {
+		return nil, err
+	}
+
+	var interval time.Duration
+	if o.importRate > 0 {
+		interval = time.Second / time.Duration(o.importRate)
+	}
+
+	summary := &model.ImportSummary{}
+	var lastAdd time.Time
+	for i, m := range members {
+		if err := ctx.Err(); err != nil {
+			return summary, err
+		}
+		if interval > 0 && i > 0 {
+			if wait := interval - o.clock.Now().Sub(lastAdd); wait > 0 {
+				if err := o.clock.Sleep(ctx, wait); err != nil {
+					return summary, err
+				}
+			}
+		}
+		lastAdd = o.clock.Now()
+
+		_, err := o.addMember(ctx, mailingListID, m, parent)
+		var conflict errs.Conflict
+		switch {
+		case err == nil:
+			summary.Created++
+		case errors.As(err, &conflict):
+			summary.Skipped++
+			summary.SkippedEmails = append(summary.SkippedEmails, m.Email)
+		default:
+			slog.WarnContext(ctx, "member import entry failed",
+				"mailing_list_id", mailingListID, "email", redaction.Email(m.Email), "error", err)
+			summary.Errored++
+			summary.ErroredEmails = append(summary.ErroredEmails, m.Email)
+		}
+	}
+	return summary, nil
+}
+
 // NewGroupsIOMailingListMemberWriterOrchestrator creates a new member writer orchestrator with the given options.
-func NewGroupsIOMailingListMemberWriterOrchestrator(opts ...MemberWriterOrchestratorOption) port.GroupsIOMailingListMemberWriter {
-	o := &GroupsIOMailingListMemberWriterOrchestrator{}
+func NewGroupsIOMailingListMemberWriterOrchestrator(opts ...MemberWriterOrchestratorOption) port.GroupsIOMailingListMemberManager {
+	o := &GroupsIOMailingListMemberWriterOrchestrator{
+		maxBatchSize: DefaultMaxBatchSize,
+		clock:        utils.RealClock{},
+	}
 	for _, opt := range opts {
 		opt(o)
 	}
@@ -0,0 +1,604 @@
+// Copyright The Linux Foundation and each contributor to LFX.
+// SPDX-License-Identifier: MIT
+
+package service
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/linuxfoundation/lfx-v2-mailing-list-service/internal/domain/model"
+	"github.com/linuxfoundation/lfx-v2-mailing-list-service/internal/domain/port"
+	"github.com/linuxfoundation/lfx-v2-mailing-list-service/internal/infrastructure/mock"
+	"github.com/linuxfoundation/lfx-v2-mailing-list-service/pkg/constants"
+	errs "github.com/linuxfoundation/lfx-v2-mailing-list-service/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// ---- test doubles ----
+
+// stubMemberWriter echoes members back and records the calls that reached it.
+type stubMemberWriter struct {
+	existing map[string]bool // emails for which AddMember reports Conflict
+	failing  map[string]bool // emails for which AddMember fails with an unexpected error
+
+	added      []*model.GrpsIOMember
+	updated    []*model.GrpsIOMember
+	updatedIDs []string
+	deleted    []string
+	invited    [][]string
+	addErr     error
+	updErr     error
+	delErr     error
+	invErr     error
+}
+
+func (w *stubMemberWriter) AddMember(_ context.Context, _ string, m *model.GrpsIOMember) (*model.GrpsIOMember, error) {
+	if w.addErr != nil {
+		return nil, w.addErr
+	}
+	if w.existing[m.Email] {
+		return nil, errs.NewConflict("member already exists")
+	}
+	if w.failing[m.Email] {
+		return nil, errs.NewUnexpected("ITX error (status 500)")
+	}
+	w.added = append(w.added, m)
+	return m, nil
+}
+
+func (w *stubMemberWriter) UpdateMember(_ context.Context, _ string, memberID string, m *model.GrpsIOMember) (*model.GrpsIOMember, error) {
+	if w.updErr != nil {
+		return nil, w.updErr
+	}
+	w.updated = append(w.updated, m)
+	w.updatedIDs = append(w.updatedIDs, memberID)
+	return m, nil
+}
+
+func (w *stubMemberWriter) DeleteMember(_ context.Context, _ string, memberID string) error {
+	if w.delErr != nil {
+		return w.delErr
+	}
+	w.deleted = append(w.deleted, memberID)
+	return nil
+}
+
+func (w *stubMemberWriter) InviteMembers(_ context.Context, _ string, emails []string) error {
+	if w.invErr != nil {
+		return w.invErr
+	}
+	w.invited = append(w.invited, emails)
+	return nil
+}
+
+var _ port.GroupsIOMailingListMemberWriter = (*stubMemberWriter)(nil)
+
+// ---- helpers ----
+
+func newTestMemberWriter(writer port.GroupsIOMailingListMemberWriter, opts ...MemberWriterOrchestratorOption) *GroupsIOMailingListMemberWriterOrchestrator {
+	return NewGroupsIOMailingListMemberWriterOrchestrator(append([]MemberWriterOrchestratorOption{
+		WithMemberWriter(writer),
+	}, opts...)...).(*GroupsIOMailingListMemberWriterOrchestrator)
+}
+
+func testEmails(n int) []string {
+	out := make([]string, n)
+	for i := range out {
+		out[i] = fmt.Sprintf("user%d@example.com", i)
+	}
+	return out
+}
+
+// ---- max batch size ----
+
+func TestInviteMembers_AtMaxBatchSize_Succeeds(t *testing.T) {
+	writer := &stubMemberWriter{}
+	o := newTestMemberWriter(writer, WithMaxBatchSize(3))
+
+	require.NoError(t, o.InviteMembers(context.Background(), "ml-1", testEmails(3)))
+	assert.Len(t, writer.invited, 1)
+}
+
+func TestInviteMembers_AboveMaxBatchSize_ReturnsValidationBeforeWork(t *testing.T) {
+	writer := &stubMemberWriter{}
+	o := newTestMemberWriter(writer, WithMaxBatchSize(3))
+
+	err := o.InviteMembers(context.Background(), "ml-1", testEmails(4))
+	require.Error(t, err)
+	assert.IsType(t, errs.Validation{}, err)
+	assert.Empty(t, writer.invited, "oversized batch must not reach ITX")
+}
+
+func TestInviteMembers_DefaultMaxBatchSize_Applies(t *testing.T) {
+	writer := &stubMemberWriter{}
+	o := newTestMemberWriter(writer, WithMaxBatchSize(0))
+
+	assert.NoError(t, o.InviteMembers(context.Background(), "ml-1", testEmails(DefaultMaxBatchSize)))
+	assert.IsType(t, errs.Validation{}, o.InviteMembers(context.Background(), "ml-1", testEmails(DefaultMaxBatchSize+1)))
+}
+
+// ---- idempotent delete ----
+
+func TestDeleteMember_IdempotentRepeatDelete_Succeeds(t *testing.T) {
+	writer := &stubMemberWriter{}
+	o := newTestMemberWriter(writer, WithIdempotentMemberDelete(true))
+
+	require.NoError(t, o.DeleteMember(context.Background(), "ml-1", "m-1"))
+	assert.Equal(t, []string{"m-1"}, writer.deleted)
+
+	writer.delErr = errs.NewNotFound("member not found")
+	assert.NoError(t, o.DeleteMember(context.Background(), "ml-1", "m-1"))
+}
+
+func TestDeleteMember_DefaultRepeatDelete_ReturnsNotFound(t *testing.T) {
+	writer := &stubMemberWriter{delErr: errs.NewNotFound("member not found")}
+	o := newTestMemberWriter(writer)
+
+	err := o.DeleteMember(context.Background(), "ml-1", "m-1")
+	assert.IsType(t, errs.NotFound{}, err)
+}
+
+// ---- ChangeMemberEmail ----
+
+func TestChangeMemberEmail_NewEmail_UpdatesMember(t *testing.T) {
+	store := mock.NewFakeGroupsIOReader()
+	store.AddMember("ml-1", &model.GrpsIOMember{UID: "m-1", Email: "old@example.com", GroupsFullName: "Ada"})
+	writer := &stubMemberWriter{}
+	o := newTestMemberWriter(writer, WithMemberWriterReader(store))
+
+	got, err := o.ChangeMemberEmail(context.Background(), "ml-1", "m-1", "new@example.com")
+	require.NoError(t, err)
+	assert.Equal(t, "new@example.com", got.Email)
+	require.Len(t, writer.updated, 1)
+	assert.Equal(t, "Ada", writer.updated[0].GroupsFullName, "other fields are preserved")
+}
+
+func TestChangeMemberEmail_EmailAlreadyInList_ReturnsConflict(t *testing.T) {
+	store := mock.NewFakeGroupsIOReader()
+	store.AddMember("ml-1", &model.GrpsIOMember{UID: "m-1", Email: "old@example.com"})
+	store.AddMember("ml-1", &model.GrpsIOMember{UID: "m-2", Email: "taken@example.com"})
+	writer := &stubMemberWriter{}
+	o := newTestMemberWriter(writer, WithMemberWriterReader(store))
+
+	_, err := o.ChangeMemberEmail(context.Background(), "ml-1", "m-1", "taken@example.com")
+	require.Error(t, err)
+	assert.IsType(t, errs.Conflict{}, err)
+	assert.Empty(t, writer.updated)
+}
+
+func TestChangeMemberEmail_CaseOnlyChange_UpdatesMember(t *testing.T) {
+	store := mock.NewFakeGroupsIOReader()
+	store.AddMember("ml-1", &model.GrpsIOMember{UID: "m-1", Email: "ada@example.com"})
+	writer := &stubMemberWriter{}
+	o := newTestMemberWriter(writer, WithMemberWriterReader(store))
+
+	got, err := o.ChangeMemberEmail(context.Background(), "ml-1", "m-1", "Ada@Example.com")
+	require.NoError(t, err)
+	assert.Equal(t, "Ada@Example.com", got.Email)
+	assert.Len(t, writer.updated, 1)
+}
+
+func TestChangeMemberEmail_SameEmail_IsNoOp(t *testing.T) {
+	store := mock.NewFakeGroupsIOReader()
+	store.AddMember("ml-1", &model.GrpsIOMember{UID: "m-1", Email: "ada@example.com"})
+	writer := &stubMemberWriter{}
+	o := newTestMemberWriter(writer, WithMemberWriterReader(store))
+
+	_, err := o.ChangeMemberEmail(context.Background(), "ml-1", "m-1", "ada@example.com")
+	require.NoError(t, err)
+	assert.Empty(t, writer.updated)
+}
+
+func TestChangeMemberEmail_InvalidEmail_ReturnsValidation(t *testing.T) {
+	store := mock.NewFakeGroupsIOReader()
+	store.AddMember("ml-1", &model.GrpsIOMember{UID: "m-1", Email: "old@example.com"})
+	o := newTestMemberWriter(&stubMemberWriter{}, WithMemberWriterReader(store))
+
+	_, err := o.ChangeMemberEmail(context.Background(), "ml-1", "m-1", "Ada <ada@example.com>")
+	assert.IsType(t, errs.Validation{}, err)
+}
+
+func TestChangeMemberEmail_UnknownMember_ReturnsNotFound(t *testing.T) {
+	o := newTestMemberWriter(&stubMemberWriter{}, WithMemberWriterReader(mock.NewFakeGroupsIOReader()))
+
+	_, err := o.ChangeMemberEmail(context.Background(), "ml-1", "missing", "new@example.com")
+	assert.IsType(t, errs.NotFound{}, err)
+}
+
+// ---- announcement list mod status ----
+
+func TestAddMember_ModeratorOnAnnouncementList_ReturnsValidation(t *testing.T) {
+	store := mock.NewFakeGroupsIOReader()
+	store.AddMailingList(&model.GroupsIOMailingList{UID: "ml-1", Type: model.TypeAnnouncement})
+	writer := &stubMemberWriter{}
+	o := newTestMemberWriter(writer, WithMemberMailingListReader(store))
+
+	_, err := o.AddMember(context.Background(), "ml-1", &model.GrpsIOMember{Email: "a@example.com", ModStatus: constants.ModStatusModerator})
+	require.Error(t, err)
+	assert.IsType(t, errs.Validation{}, err)
+	assert.Empty(t, writer.added)
+}
+
+func TestAddMember_NonPostingOnAnnouncementList_Succeeds(t *testing.T) {
+	store := mock.NewFakeGroupsIOReader()
+	store.AddMailingList(&model.GroupsIOMailingList{UID: "ml-1", Type: model.TypeAnnouncement})
+	writer := &stubMemberWriter{}
+	o := newTestMemberWriter(writer, WithMemberMailingListReader(store))
+
+	for _, status := range []string{"", constants.ModStatusNone, constants.ModStatusOwner} {
+		_, err := o.AddMember(context.Background(), "ml-1", &model.GrpsIOMember{Email: "a@example.com", ModStatus: status})
+		assert.NoError(t, err, "mod_status %q", status)
+	}
+	assert.Len(t, writer.added, 3)
+}
+
+func TestAddMember_ModeratorOnDiscussionList_Succeeds(t *testing.T) {
+	store := mock.NewFakeGroupsIOReader()
+	store.AddMailingList(&model.GroupsIOMailingList{UID: "ml-1", Type: model.TypeDiscussionOpen})
+	writer := &stubMemberWriter{}
+	o := newTestMemberWriter(writer, WithMemberMailingListReader(store))
+
+	_, err := o.AddMember(context.Background(), "ml-1", &model.GrpsIOMember{Email: "a@example.com", ModStatus: constants.ModStatusModerator})
+	require.NoError(t, err)
+	assert.Len(t, writer.added, 1)
+}
+
+// ---- parent service status ----
+
+func TestAddMember_ParentServiceStatus(t *testing.T) {
+	tests := []struct {
+		status  string
+		wantErr bool
+	}{
+		{status: constants.ServiceStatusActive},
+		{status: constants.ServiceStatusCreated},
+		{status: constants.ServiceStatusDisabled, wantErr: true},
+		{status: constants.ServiceStatusDeleted, wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.status, func(t *testing.T) {
+			store := mock.NewFakeGroupsIOReader()
+			store.AddService(&model.GroupsIOService{UID: "svc-1", Status: tt.status})
+			store.AddMailingList(&model.GroupsIOMailingList{UID: "ml-1", ServiceUID: "svc-1", Type: model.TypeDiscussionOpen})
+			writer := &stubMemberWriter{}
+			o := newTestMemberWriter(writer, WithMemberMailingListReader(store), WithMemberServiceReader(store))
+
+			_, err := o.AddMember(context.Background(), "ml-1", &model.GrpsIOMember{Email: "a@example.com"})
+			if tt.wantErr {
+				assert.IsType(t, errs.Validation{}, err)
+				assert.Empty(t, writer.added)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Len(t, writer.added, 1)
+		})
+	}
+}
+
+func TestAddMember_FrozenList_ReturnsConflict(t *testing.T) {
+	store := mock.NewFakeGroupsIOReader()
+	store.AddMailingList(&model.GroupsIOMailingList{UID: "ml-1", Type: model.TypeDiscussionOpen, Frozen: true})
+	writer := &stubMemberWriter{}
+	o := newTestMemberWriter(writer, WithMemberMailingListReader(store))
+
+	_, err := o.AddMember(context.Background(), "ml-1", &model.GrpsIOMember{Email: "a@example.com"})
+	require.Error(t, err)
+	assert.IsType(t, errs.Conflict{}, err)
+	assert.Empty(t, writer.added)
+}
+
+// countingParentReader counts the parent lookups made through it.
+type countingParentReader struct {
+	*mock.FakeGroupsIOReader
+	listCalls    int
+	serviceCalls int
+}
+
+func (r *countingParentReader) GetMailingList(ctx context.Context, id string) (*model.GroupsIOMailingList, error) {
+	r.listCalls++
+	return r.FakeGroupsIOReader.GetMailingList(ctx, id)
+}
+
+func (r *countingParentReader) GetService(ctx context.Context, id string) (*model.GroupsIOService, error) {
+	r.serviceCalls++
+	return r.FakeGroupsIOReader.GetService(ctx, id)
+}
+
+// ---- ImportMembers ----
+
+func TestImportMembers_ResolvesParentOncePerBatch(t *testing.T) {
+	store := &countingParentReader{FakeGroupsIOReader: mock.NewFakeGroupsIOReader()}
+	store.AddService(&model.GroupsIOService{UID: "svc-1", Status: constants.ServiceStatusActive})
+	store.AddMailingList(&model.GroupsIOMailingList{UID: "ml-1", ServiceUID: "svc-1", Type: model.TypeDiscussionOpen})
+	writer := &stubMemberWriter{}
+	o := newTestMemberWriter(writer, WithMemberMailingListReader(store), WithMemberServiceReader(store))
+
+	summary, err := o.ImportMembers(context.Background(), "ml-1", []*model.GrpsIOMember{
+		{Email: "a@example.com"}, {Email: "b@example.com"}, {Email: "c@example.com"},
+	})
+	require.NoError(t, err)
+	assert.Equal(t, 3, summary.Created)
+	assert.Equal(t, 1, store.listCalls)
+	assert.Equal(t, 1, store.serviceCalls)
+}
+
+func TestImportMembers_FrozenList_ReturnsConflictBeforeWork(t *testing.T) {
+	store := mock.NewFakeGroupsIOReader()
+	store.AddMailingList(&model.GroupsIOMailingList{UID: "ml-1", Frozen: true})
+	writer := &stubMemberWriter{}
+	o := newTestMemberWriter(writer, WithMemberMailingListReader(store))
+
+	_, err := o.ImportMembers(context.Background(), "ml-1", []*model.GrpsIOMember{{Email: "a@example.com"}})
+	require.Error(t, err)
+	assert.IsType(t, errs.Conflict{}, err)
+	assert.Empty(t, writer.added)
+}
+
+func TestImportMembers_DisabledService_ReturnsValidationBeforeWork(t *testing.T) {
+	store := mock.NewFakeGroupsIOReader()
+	store.AddService(&model.GroupsIOService{UID: "svc-1", Status: constants.ServiceStatusDisabled})
+	store.AddMailingList(&model.GroupsIOMailingList{UID: "ml-1", ServiceUID: "svc-1"})
+	writer := &stubMemberWriter{}
+	o := newTestMemberWriter(writer, WithMemberMailingListReader(store), WithMemberServiceReader(store))
+
+	_, err := o.ImportMembers(context.Background(), "ml-1", []*model.GrpsIOMember{{Email: "a@example.com"}, {Email: "b@example.com"}})
+	require.Error(t, err)
+	assert.IsType(t, errs.Validation{}, err)
+	assert.Empty(t, writer.added)
+}
+
+func TestImportMembers_NewAndExisting_ReportsCreatedAndSkipped(t *testing.T) {
+	writer := &stubMemberWriter{existing: map[string]bool{"old@example.com": true}}
+	o := newTestMemberWriter(writer)
+
+	summary, err := o.ImportMembers(context.Background(), "ml-1", []*model.GrpsIOMember{
+		{Email: "new@example.com"},
+		{Email: "old@example.com"},
+	})
+	require.NoError(t, err)
+	assert.Equal(t, 1, summary.Created)
+	assert.Equal(t, 1, summary.Skipped)
+	assert.Zero(t, summary.Errored)
+	assert.Equal(t, []string{"old@example.com"}, summary.SkippedEmails)
+	require.Len(t, writer.added, 1)
+	assert.Equal(t, "new@example.com", writer.added[0].Email)
+}
+
+func TestImportMembers_EntryFails_CountsErrorAndContinues(t *testing.T) {
+	writer := &stubMemberWriter{failing: map[string]bool{"bad@example.com": true}}
+	o := newTestMemberWriter(writer)
+
+	summary, err := o.ImportMembers(context.Background(), "ml-1", []*model.GrpsIOMember{
+		{Email: "bad@example.com"},
+		{Email: "good@example.com"},
+	})
+	require.NoError(t, err)
+	assert.Equal(t, 1, summary.Created)
+	assert.Equal(t, 1, summary.Errored)
+	assert.Equal(t, []string{"bad@example.com"}, summary.ErroredEmails)
+}
+
+func TestImportMembers_AboveMaxBatchSize_ReturnsValidation(t *testing.T) {
+	writer := &stubMemberWriter{}
+	o := newTestMemberWriter(writer, WithMaxBatchSize(1))
+
+	_, err := o.ImportMembers(context.Background(), "ml-1", []*model.GrpsIOMember{{Email: "a@example.com"}, {Email: "b@example.com"}})
+	assert.IsType(t, errs.Validation{}, err)
+	assert.Empty(t, writer.added)
+}
+
+func TestAddMember_NegativeMemberID_ReturnsValidation(t *testing.T) {
+	writer := &stubMemberWriter{}
+	o := newTestMemberWriter(writer)
+
+	memberID := int64(-3)
+	_, err := o.AddMember(context.Background(), "ml-1", &model.GrpsIOMember{Email: "a@example.com", MemberID: &memberID})
+	assert.IsType(t, errs.Validation{}, err)
+	assert.Empty(t, writer.added)
+}
+
+// ---- import rate limit ----
+
+// fakeClock advances only when Sleep is called and records each requested wait.
+type fakeClock struct {
+	now    time.Time
+	sleeps []time.Duration
+}
+
+func (c *fakeClock) Now() time.Time { return c.now }
+
+func (c *fakeClock) Sleep(ctx context.Context, d time.Duration) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+	c.sleeps = append(c.sleeps, d)
+	c.now = c.now.Add(d)
+	return nil
+}
+
+func TestImportMembers_RateLimited_SpacesAdds(t *testing.T) {
+	writer := &stubMemberWriter{}
+	clock := &fakeClock{now: time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)}
+	o := newTestMemberWriter(writer, WithImportRateLimit(5), WithMemberWriterClock(clock))
+
+	members := []*model.GrpsIOMember{{Email: "a@example.com"}, {Email: "b@example.com"}, {Email: "c@example.com"}}
+	summary, err := o.ImportMembers(context.Background(), "ml-1", members)
+	require.NoError(t, err)
+	assert.Equal(t, 3, summary.Created)
+	assert.Equal(t, []time.Duration{200 * time.Millisecond, 200 * time.Millisecond}, clock.sleeps)
+}
+
+func TestImportMembers_Unthrottled_NeverSleeps(t *testing.T) {
+	clock := &fakeClock{}
+	o := newTestMemberWriter(&stubMemberWriter{}, WithMemberWriterClock(clock))
+
+	_, err := o.ImportMembers(context.Background(), "ml-1", []*model.GrpsIOMember{{Email: "a@example.com"}, {Email: "b@example.com"}})
+	require.NoError(t, err)
+	assert.Empty(t, clock.sleeps)
+}
+
+func TestImportMembers_RateLimitedCancelled_StopsWaiting(t *testing.T) {
+	writer := &stubMemberWriter{}
+	o := newTestMemberWriter(writer, WithImportRateLimit(1))
+
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+
+	summary, err := o.ImportMembers(ctx, "ml-1", []*model.GrpsIOMember{{Email: "a@example.com"}, {Email: "b@example.com"}})
+	require.ErrorIs(t, err, context.DeadlineExceeded)
+	assert.Equal(t, 1, summary.Created)
+	assert.Len(t, writer.added, 1)
+}
+
+// ---- UpsertMember ----
+
+func TestUpsertMember_NewEmail_Creates(t *testing.T) {
+	store := mock.NewFakeGroupsIOReader()
+	store.AddMember("ml-1", &model.GrpsIOMember{UID: "m-1", Email: "a@example.com"})
+	writer := &stubMemberWriter{}
+	o := newTestMemberWriter(writer, WithMemberWriterReader(store))
+
+	_, created, err := o.UpsertMember(context.Background(), "ml-1", &model.GrpsIOMember{Email: "b@example.com"})
+	require.NoError(t, err)
+	assert.True(t, created)
+	assert.Len(t, writer.added, 1)
+	assert.Empty(t, writer.updated)
+}
+
+func TestUpsertMember_ExistingEmail_UpdatesExistingMember(t *testing.T) {
+	store := mock.NewFakeGroupsIOReader()
+	store.AddMember("ml-1", &model.GrpsIOMember{UID: "m-1", Email: "a@example.com"})
+	writer := &stubMemberWriter{}
+	o := newTestMemberWriter(writer, WithMemberWriterReader(store))
+
+	_, created, err := o.UpsertMember(context.Background(), "ml-1", &model.GrpsIOMember{Email: "A@Example.com", DeliveryMode: "digest"})
+	require.NoError(t, err)
+	assert.False(t, created)
+	assert.Empty(t, writer.added)
+	assert.Equal(t, []string{"m-1"}, writer.updatedIDs)
+}
+
+func TestUpsertMember_AddConflictWithoutMatch_ReturnsConflict(t *testing.T) {
+	store := mock.NewFakeGroupsIOReader()
+	writer := &stubMemberWriter{existing: map[string]bool{"a@example.com": true}}
+	o := newTestMemberWriter(writer, WithMemberWriterReader(store))
+
+	_, _, err := o.UpsertMember(context.Background(), "ml-1", &model.GrpsIOMember{Email: "a@example.com"})
+	assert.IsType(t, errs.Conflict{}, err)
+	assert.Empty(t, writer.updated)
+}
+
+// ---- MergeMembers ----
+
+func TestMergeMembers_DuplicatePair_DeletesExtra(t *testing.T) {
+	store := mock.NewFakeGroupsIOReader()
+	store.AddMember("ml-1", &model.GrpsIOMember{UID: "m-1", Email: "a@example.com"})
+	store.AddMember("ml-1", &model.GrpsIOMember{UID: "m-2", Email: "A@example.com"})
+	writer := &stubMemberWriter{}
+	o := newTestMemberWriter(writer, WithMemberWriterReader(store))
+
+	require.NoError(t, o.MergeMembers(context.Background(), "ml-1", "m-1", []string{"m-2"}))
+	assert.Equal(t, []string{"m-2"}, writer.deleted)
+}
+
+func TestMergeMembers_DifferentEmail_ReturnsValidationWithoutDeleting(t *testing.T) {
+	store := mock.NewFakeGroupsIOReader()
+	store.AddMember("ml-1", &model.GrpsIOMember{UID: "m-1", Email: "a@example.com"})
+	store.AddMember("ml-1", &model.GrpsIOMember{UID: "m-2", Email: "a@example.com"})
+	store.AddMember("ml-1", &model.GrpsIOMember{UID: "m-3", Email: "b@example.com"})
+	writer := &stubMemberWriter{}
+	o := newTestMemberWriter(writer, WithMemberWriterReader(store))
+
+	err := o.MergeMembers(context.Background(), "ml-1", "m-1", []string{"m-2", "m-3"})
+	assert.IsType(t, errs.Validation{}, err)
+	assert.Empty(t, writer.deleted)
+}
+
+func TestMergeMembers_DuplicateHasStrongerModStatus_UpdatesKeepBeforeDeleting(t *testing.T) {
+	store := mock.NewFakeGroupsIOReader()
+	store.AddMember("ml-1", &model.GrpsIOMember{UID: "m-1", Email: "a@example.com", ModStatus: "none"})
+	store.AddMember("ml-1", &model.GrpsIOMember{UID: "m-2", Email: "a@example.com", ModStatus: "owner", DeliveryMode: "digest"})
+	store.AddMember("ml-1", &model.GrpsIOMember{UID: "m-3", Email: "a@example.com", ModStatus: "moderator"})
+	writer := &stubMemberWriter{}
+	o := newTestMemberWriter(writer, WithMemberWriterReader(store))
+
+	require.NoError(t, o.MergeMembers(context.Background(), "ml-1", "m-1", []string{"m-2", "m-3"}))
+	require.Len(t, writer.updated, 1)
+	assert.Equal(t, []string{"m-1"}, writer.updatedIDs)
+	assert.Equal(t, "owner", writer.updated[0].ModStatus)
+	assert.Equal(t, "digest", writer.updated[0].DeliveryMode)
+	assert.Equal(t, []string{"m-2", "m-3"}, writer.deleted)
+}
+
+func TestMergeMembers_NothingToCarryOver_DoesNotUpdate(t *testing.T) {
+	store := mock.NewFakeGroupsIOReader()
+	store.AddMember("ml-1", &model.GrpsIOMember{UID: "m-1", Email: "a@example.com", ModStatus: "owner", DeliveryMode: "email"})
+	store.AddMember("ml-1", &model.GrpsIOMember{UID: "m-2", Email: "a@example.com", ModStatus: "moderator", DeliveryMode: "digest"})
+	writer := &stubMemberWriter{}
+	o := newTestMemberWriter(writer, WithMemberWriterReader(store))
+
+	require.NoError(t, o.MergeMembers(context.Background(), "ml-1", "m-1", []string{"m-2"}))
+	assert.Empty(t, writer.updated)
+	assert.Equal(t, []string{"m-2"}, writer.deleted)
+}
+
+func TestMergeMembers_UpdateFails_ReturnsErrorWithoutDeleting(t *testing.T) {
+	store := mock.NewFakeGroupsIOReader()
+	store.AddMember("ml-1", &model.GrpsIOMember{UID: "m-1", Email: "a@example.com"})
+	store.AddMember("ml-1", &model.GrpsIOMember{UID: "m-2", Email: "a@example.com", ModStatus: "moderator"})
+	writer := &stubMemberWriter{updErr: errs.NewUnexpected("ITX error (status 500)")}
+	o := newTestMemberWriter(writer, WithMemberWriterReader(store))
+
+	err := o.MergeMembers(context.Background(), "ml-1", "m-1", []string{"m-2"})
+	assert.IsType(t, errs.Unexpected{}, err)
+	assert.Empty(t, writer.deleted)
+}
+
+func TestMergeMembers_EmptyEmails_ReturnsValidationWithoutDeleting(t *testing.T) {
+	store := mock.NewFakeGroupsIOReader()
+	store.AddMember("ml-1", &model.GrpsIOMember{UID: "m-1", Email: " "})
+	store.AddMember("ml-1", &model.GrpsIOMember{UID: "m-2", Email: ""})
+	writer := &stubMemberWriter{}
+	o := newTestMemberWriter(writer, WithMemberWriterReader(store))
+
+	err := o.MergeMembers(context.Background(), "ml-1", "m-1", []string{"m-2"})
+	assert.IsType(t, errs.Validation{}, err)
+	assert.Empty(t, writer.deleted)
+}
+
+func TestMergeMembers_RepeatedUID_DeletesOnce(t *testing.T) {
+	store := mock.NewFakeGroupsIOReader()
+	store.AddMember("ml-1", &model.GrpsIOMember{UID: "m-1", Email: "a@example.com"})
+	store.AddMember("ml-1", &model.GrpsIOMember{UID: "m-2", Email: "a@example.com"})
+	writer := &stubMemberWriter{}
+	o := newTestMemberWriter(writer, WithMemberWriterReader(store))
+
+	require.NoError(t, o.MergeMembers(context.Background(), "ml-1", "m-1", []string{"m-2", "m-2"}))
+	assert.Equal(t, []string{"m-2"}, writer.deleted)
+}
+
+// ---- UpdateMember mailing list immutability ----
+
+func TestUpdateMember_ChangedMailingListUID_ReturnsValidation(t *testing.T) {
+	writer := &stubMemberWriter{}
+	o := newTestMemberWriter(writer)
+
+	_, err := o.UpdateMember(context.Background(), "ml-1", "m-1", &model.GrpsIOMember{MailingListUID: "ml-2"})
+	assert.IsType(t, errs.Validation{}, err)
+	assert.Empty(t, writer.updated)
+}
+
+func TestUpdateMember_SameOrEmptyMailingListUID_Updates(t *testing.T) {
+	writer := &stubMemberWriter{}
+	o := newTestMemberWriter(writer)
+
+	for _, uid := range []string{"", "ml-1"} {
+		_, err := o.UpdateMember(context.Background(), "ml-1", "m-1", &model.GrpsIOMember{MailingListUID: uid})
+		assert.NoError(t, err, "mailing_list_uid %q", uid)
+	}
+	assert.Len(t, writer.updated, 2)
+}
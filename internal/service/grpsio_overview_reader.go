@@ -0,0 +1,402 @@
+// Copyright The Linux Foundation and each contributor to LFX.
+// SPDX-License-Identifier: MIT
+
+package service
+
+import (
+	"cmp"
+	"context"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/linuxfoundation/lfx-v2-mailing-list-service/internal/domain/model"
+	"github.com/linuxfoundation/lfx-v2-mailing-list-service/internal/domain/port"
+	errs "github.com/linuxfoundation/lfx-v2-mailing-list-service/pkg/errors"
+	"golang.org/x/sync/errgroup"
+)
+
+// DefaultMemberPageSize is the page size used by ListMembersByProject when no limit is given.
+const DefaultMemberPageSize = 100
+
+// GroupsIOOverviewReaderOrchestrator implements port.GroupsIOOverviewReader by composing the
+// service, mailing list and member readers. The readers are expected to accept and return
+// v2 UUIDs (i.e. the translating reader orchestrators), so no ID mapping happens here.
+type GroupsIOOverviewReaderOrchestrator struct {
+	serviceReader      port.GroupsIOServiceReader
+	serviceBatchReader port.GroupsIOServiceBatchReader
+	mailingListReader  port.GroupsIOMailingListReader
+	memberReader       port.GroupsIOMailingListMemberReader
+	concurrency        int
+}
+
+// OverviewReaderOrchestratorOption configures a GroupsIOOverviewReaderOrchestrator.
+type OverviewReaderOrchestratorOption func(*GroupsIOOverviewReaderOrchestrator)
+
+// WithOverviewServiceReader sets the service reader.
+func WithOverviewServiceReader(r port.GroupsIOServiceReader) OverviewReaderOrchestratorOption {
+	return func(o *GroupsIOOverviewReaderOrchestrator) {
+		o.serviceReader = r
+	}
+}
+
+// WithOverviewServiceBatchReader sets the batch service reader used to resolve parent services.
+func WithOverviewServiceBatchReader(r port.GroupsIOServiceBatchReader) OverviewReaderOrchestratorOption {
+	return func(o *GroupsIOOverviewReaderOrchestrator) {
+		o.serviceBatchReader = r
+	}
+}
+
+// WithOverviewMailingListReader sets the mailing list reader.
+func WithOverviewMailingListReader(r port.GroupsIOMailingListReader) OverviewReaderOrchestratorOption {
+	return func(o *GroupsIOOverviewReaderOrchestrator) {
+		o.mailingListReader = r
+	}
+}
+
+// WithOverviewMemberReader sets the member reader.
+func WithOverviewMemberReader(r port.GroupsIOMailingListMemberReader) OverviewReaderOrchestratorOption {
+	return func(o *GroupsIOOverviewReaderOrchestrator) {
+		o.memberReader = r
+	}
+}
+
+// WithOverviewConcurrency caps how many per-mailing-list reads (e.g. member counts) run at
+// once. Values <= 1 keep the reads serial.
+func WithOverviewConcurrency(n int) OverviewReaderOrchestratorOption {
+	return func(o *GroupsIOOverviewReaderOrchestrator) {
+		o.concurrency = n
+	}
+}
+
+// memberCounts returns the member count of each mailing list, in the same order as
+// mailingLists, reading up to o.concurrency lists at a time. The first failed read cancels
+// the reads still in flight.
+func (o *GroupsIOOverviewReaderOrchestrator) memberCounts(ctx context.Context, mailingLists []*model.GroupsIOMailingList) ([]int, error) {
+	counts := make([]int, len(mailingLists))
+	g, gctx := errgroup.WithContext(ctx)
+	g.SetLimit(max(o.concurrency, 1))
+	for i, ml := range mailingLists {
+		g.Go(func() error {
+			n, err := o.mailingListReader.GetMailingListMemberCount(gctx, ml.UID)
+			if err != nil {
+				return err
+			}
+			counts[i] = n
+			return nil
+		})
+	}
+	if err := g.Wait(); err != nil {
+		return nil, err
+	}
+	return counts, nil
+}
+
+// GetProjectSummary counts the project's services, mailing lists and members.
+// The member count is the sum of each mailing list's member count, so a person
+// subscribed to two lists is counted twice.
+func (o *GroupsIOOverviewReaderOrchestrator) GetProjectSummary(ctx context.Context, projectUID string) (*model.ProjectSummary, error) {
+	_, serviceCount, err := o.serviceReader.ListServices(ctx, projectUID)
+	if err != nil {
+		return nil, err
+	}
+
+	mailingLists, mailingListCount, err := o.mailingListReader.ListMailingLists(ctx, projectUID, "")
+	if err != nil {
+		return nil, err
+	}
+
+	counts, err := o.memberCounts(ctx, mailingLists)
+	if err != nil {
+		return nil, err
+	}
+	memberCount := 0
+	for _, n := range counts {
+		memberCount += n
+	}
+
+	return &model.ProjectSummary{
+		ProjectUID:       projectUID,
+		ServiceCount:     serviceCount,
+		MailingListCount: mailingListCount,
+		MemberCount:      memberCount,
+	}, nil
+}
+
+// ListOrphanedMailingLists returns the project's mailing lists whose ServiceUID is empty or no
+// longer resolves to an existing service (e.g. the service was force-deleted). Parent services
+// are resolved in one batch rather than once per list.
+func (o *GroupsIOOverviewReaderOrchestrator) ListOrphanedMailingLists(ctx context.Context, projectUID string) ([]*model.GroupsIOMailingList, error) {
+	if o.serviceBatchReader == nil {
+		return nil, errs.NewServiceUnavailable("batch service reader is not configured")
+	}
+
+	mailingLists, _, err := o.mailingListReader.ListMailingLists(ctx, projectUID, "")
+	if err != nil {
+		return nil, err
+	}
+
+	serviceIDs := make([]string, 0, len(mailingLists))
+	for _, ml := range mailingLists {
+		serviceIDs = append(serviceIDs, ml.ServiceUID)
+	}
+	services, err := o.serviceBatchReader.GetServices(ctx, serviceIDs)
+	if err != nil {
+		return nil, err
+	}
+
+	var orphans []*model.GroupsIOMailingList
+	for _, ml := range mailingLists {
+		if _, ok := services[ml.ServiceUID]; !ok {
+			orphans = append(orphans, ml)
+		}
+	}
+	return orphans, nil
+}
+
+// GetMailingListStats combines the mailing list's timestamps with counts computed from its
+// current members. Members without a delivery mode are counted under "". Groups.io does not
+// expose message history through ITX, so no last-message time is reported.
+func (o *GroupsIOOverviewReaderOrchestrator) GetMailingListStats(ctx context.Context, mailingListID string) (*model.MailingListStats, error) {
+	ml, err := o.mailingListReader.GetMailingList(ctx, mailingListID)
+	if err != nil {
+		return nil, err
+	}
+
+	members, _, err := o.memberReader.ListMembers(ctx, mailingListID)
+	if err != nil {
+		return nil, err
+	}
+
+	stats := &model.MailingListStats{
+		MailingListUID:        mailingListID,
+		MemberCount:           len(members),
+		MembersByDeliveryMode: make(map[string]int),
+		CreatedAt:             ml.CreatedAt,
+		UpdatedAt:             ml.UpdatedAt,
+	}
+	for _, m := range members {
+		stats.MembersByDeliveryMode[m.DeliveryMode]++
+		if m.Status == model.MemberStatusBouncing || m.Status == model.MemberStatusBounced {
+			stats.BounceCount++
+		}
+	}
+	return stats, nil
+}
+
+// GetServiceTree returns the service and its mailing lists sorted by group name. Mailing lists
+// are listed per project, so the service's project lists are filtered down to this service.
+// Member counts cost one read per list and are only fetched when includeMemberCounts is set.
+func (o *GroupsIOOverviewReaderOrchestrator) GetServiceTree(ctx context.Context, serviceUID string, includeMemberCounts bool) (*model.ServiceTree, error) {
+	svc, err := o.serviceReader.GetService(ctx, serviceUID)
+	if err != nil {
+		return nil, err
+	}
+
+	mailingLists, _, err := o.mailingListReader.ListMailingLists(ctx, svc.ProjectUID, "")
+	if err != nil {
+		return nil, err
+	}
+
+	var serviceLists []*model.GroupsIOMailingList
+	for _, ml := range mailingLists {
+		if ml.ServiceUID == serviceUID {
+			serviceLists = append(serviceLists, ml)
+		}
+	}
+
+	var counts []int
+	if includeMemberCounts {
+		if counts, err = o.memberCounts(ctx, serviceLists); err != nil {
+			return nil, err
+		}
+	}
+
+	tree := &model.ServiceTree{
+		Service:      svc,
+		MailingLists: make([]*model.ServiceTreeMailingList, 0, len(serviceLists)),
+	}
+	for i, ml := range serviceLists {
+		node := &model.ServiceTreeMailingList{MailingList: ml}
+		if includeMemberCounts {
+			node.MemberCount = &counts[i]
+		}
+		tree.MailingLists = append(tree.MailingLists, node)
+	}
+	slices.SortFunc(tree.MailingLists, func(a, b *model.ServiceTreeMailingList) int {
+		return cmp.Compare(a.MailingList.GroupName, b.MailingList.GroupName)
+	})
+	return tree, nil
+}
+
+// ListMembersByProject pages through the members of all of a project's mailing lists, walking
+// lists in UID order and each list's members in UID order. The cursor records the list and the
+// position within it, so lists created or removed between pages do not shift the rest of the
+// walk. With opts.Dedupe, a member is skipped when its email (ignoring case) already appears in
+// an earlier list or earlier in the same list. That is checked on each page by re-reading the
+// lists before the cursor, so the cursor stays the same size however many emails were seen.
+// Members without an email are never treated as duplicates.
+func (o *GroupsIOOverviewReaderOrchestrator) ListMembersByProject(ctx context.Context, projectUID string, opts model.ListOptions) (*model.MemberPage, error) {
+	limit := opts.Limit
+	if limit <= 0 {
+		limit = DefaultMemberPageSize
+	}
+	cursor, err := decodeMemberCursor(opts.Cursor)
+	if err != nil {
+		return nil, err
+	}
+
+	mailingLists, _, err := o.mailingListReader.ListMailingLists(ctx, projectUID, "")
+	if err != nil {
+		return nil, err
+	}
+	slices.SortFunc(mailingLists, func(a, b *model.GroupsIOMailingList) int { return cmp.Compare(a.UID, b.UID) })
+
+	// earlier holds the emails of every list already walked, when deduping.
+	earlier := make(map[string]struct{})
+	page := &model.MemberPage{Members: []*model.GrpsIOMember{}}
+	for _, ml := range mailingLists {
+		if ml.UID < cursor.mailingListUID && !opts.Dedupe {
+			continue
+		}
+
+		members, _, err := o.memberReader.ListMembers(ctx, ml.UID)
+		if err != nil {
+			return nil, err
+		}
+		if ml.UID < cursor.mailingListUID {
+			addMemberEmails(earlier, members)
+			continue
+		}
+		slices.SortFunc(members, func(a, b *model.GrpsIOMember) int { return cmp.Compare(a.UID, b.UID) })
+
+		inList := make(map[string]struct{}, len(members))
+		for i, m := range members {
+			email := normalizeEmail(m.Email)
+			if opts.Dedupe && email != "" {
+				_, seenEarlier := earlier[email]
+				_, seenInList := inList[email]
+				inList[email] = struct{}{}
+				if seenEarlier || seenInList {
+					continue
+				}
+			}
+			if ml.UID == cursor.mailingListUID && i < cursor.index {
+				continue
+			}
+			if len(page.Members) == limit {
+				page.NextCursor = encodeMemberCursor(memberCursor{mailingListUID: ml.UID, index: i})
+				return page, nil
+			}
+			page.Members = append(page.Members, m)
+		}
+		if opts.Dedupe {
+			addMemberEmails(earlier, members)
+		}
+	}
+	return page, nil
+}
+
+// addMemberEmails adds the normalized, non-empty emails of members to emails.
+func addMemberEmails(emails map[string]struct{}, members []*model.GrpsIOMember) {
+	for _, m := range members {
+		if email := normalizeEmail(m.Email); email != "" {
+			emails[email] = struct{}{}
+		}
+	}
+}
+
+// memberCursor is the decoded form of a ListMembersByProject cursor: the next member to return
+// is members[index] of mailingListUID.
+type memberCursor struct {
+	mailingListUID string
+	index          int
+}
+
+// encodeMemberCursor builds an opaque cursor of the form "list:index".
+func encodeMemberCursor(c memberCursor) string {
+	return base64.RawURLEncoding.EncodeToString([]byte(c.mailingListUID + ":" + strconv.Itoa(c.index)))
+}
+
+// decodeMemberCursor reverses encodeMemberCursor. An empty cursor starts at the beginning.
+func decodeMemberCursor(cursor string) (memberCursor, error) {
+	var c memberCursor
+	if cursor == "" {
+		return c, nil
+	}
+	raw, err := base64.RawURLEncoding.DecodeString(cursor)
+	if err != nil {
+		return c, errs.NewValidation("invalid cursor", err)
+	}
+	parts := strings.SplitN(string(raw), ":", 2)
+	if len(parts) != 2 || parts[0] == "" {
+		return c, errs.NewValidation("invalid cursor")
+	}
+	index, err := strconv.Atoi(parts[1])
+	if err != nil || index < 0 {
+		return c, errs.NewValidation(fmt.Sprintf("invalid cursor position %q", parts[1]))
+	}
+	c.mailingListUID, c.index = parts[0], index
+	return c, nil
+}
+
+// GetMemberHierarchy returns a member with the mailing list, service and project above it.
+// ITX scopes members to their mailing list, so the list UID is required to find the member.
+// A missing member is an error; a missing list or service stops the walk and is reported in
+// the hierarchy's warnings, with the project taken from the list when the service is gone.
+func (o *GroupsIOOverviewReaderOrchestrator) GetMemberHierarchy(ctx context.Context, mailingListUID string, memberUID string) (*model.MemberHierarchy, error) {
+	member, err := o.memberReader.GetMember(ctx, mailingListUID, memberUID)
+	if err != nil {
+		return nil, err
+	}
+	h := &model.MemberHierarchy{Member: member}
+
+	var notFound errs.NotFound
+	ml, err := o.mailingListReader.GetMailingList(ctx, mailingListUID)
+	switch {
+	case errors.As(err, &notFound):
+		h.Warnings = append(h.Warnings, fmt.Sprintf("mailing list %s not found", mailingListUID))
+		return h, nil
+	case err != nil:
+		return nil, err
+	}
+	h.MailingList = ml
+
+	if ml.ServiceUID == "" {
+		h.Warnings = append(h.Warnings, fmt.Sprintf("mailing list %s has no parent service", ml.UID))
+	} else {
+		svc, err := o.serviceReader.GetService(ctx, ml.ServiceUID)
+		switch {
+		case errors.As(err, &notFound):
+			h.Warnings = append(h.Warnings, fmt.Sprintf("service %s not found", ml.ServiceUID))
+		case err != nil:
+			return nil, err
+		default:
+			h.Service = svc
+		}
+	}
+
+	switch {
+	case h.Service != nil && h.Service.ProjectUID != "":
+		h.Project = &model.HierarchyProject{UID: h.Service.ProjectUID, Name: h.Service.ProjectName, Slug: h.Service.ProjectSlug}
+	case ml.ProjectUID != "":
+		h.Project = &model.HierarchyProject{UID: ml.ProjectUID, Name: ml.ProjectName, Slug: ml.ProjectSlug}
+	default:
+		h.Warnings = append(h.Warnings, "project could not be resolved")
+	}
+	return h, nil
+}
+
+// NewGroupsIOOverviewReaderOrchestrator creates a new overview reader orchestrator with the given options.
+func NewGroupsIOOverviewReaderOrchestrator(opts ...OverviewReaderOrchestratorOption) *GroupsIOOverviewReaderOrchestrator {
+	o := &GroupsIOOverviewReaderOrchestrator{}
+	for _, opt := range opts {
+		opt(o)
+	}
+	return o
+}
+
+var _ port.GroupsIOOverviewReader = (*GroupsIOOverviewReaderOrchestrator)(nil)
@@ -0,0 +1,439 @@
+// Copyright The Linux Foundation and each contributor to LFX.
+// SPDX-License-Identifier: MIT
+
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/linuxfoundation/lfx-v2-mailing-list-service/internal/domain/model"
+	"github.com/linuxfoundation/lfx-v2-mailing-list-service/internal/infrastructure/mock"
+	errs "github.com/linuxfoundation/lfx-v2-mailing-list-service/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// ---- helpers ----
+
+func newTestOverviewReader(store *mock.FakeGroupsIOReader, opts ...OverviewReaderOrchestratorOption) *GroupsIOOverviewReaderOrchestrator {
+	return NewGroupsIOOverviewReaderOrchestrator(append([]OverviewReaderOrchestratorOption{
+		WithOverviewServiceReader(store),
+		WithOverviewServiceBatchReader(store),
+		WithOverviewMailingListReader(store),
+		WithOverviewMemberReader(store),
+	}, opts...)...)
+}
+
+// seedProject seeds proj-1 with two services, three mailing lists and four members,
+// plus an unrelated project that must not leak into proj-1's results.
+func seedProject() *mock.FakeGroupsIOReader {
+	store := mock.NewFakeGroupsIOReader()
+	store.AddService(&model.GroupsIOService{UID: "svc-1", ProjectUID: "proj-1", Type: "v2_primary"})
+	store.AddService(&model.GroupsIOService{UID: "svc-2", ProjectUID: "proj-1", Type: "v2_formation", Prefix: "form"})
+	store.AddService(&model.GroupsIOService{UID: "svc-3", ProjectUID: "proj-2", Type: "v2_primary"})
+
+	store.AddMailingList(&model.GroupsIOMailingList{UID: "ml-1", ProjectUID: "proj-1", ServiceUID: "svc-1", GroupName: "dev"})
+	store.AddMailingList(&model.GroupsIOMailingList{UID: "ml-2", ProjectUID: "proj-1", ServiceUID: "svc-1", GroupName: "announce"})
+	store.AddMailingList(&model.GroupsIOMailingList{UID: "ml-3", ProjectUID: "proj-1", ServiceUID: "svc-2", GroupName: "form-board"})
+	store.AddMailingList(&model.GroupsIOMailingList{UID: "ml-4", ProjectUID: "proj-2", ServiceUID: "svc-3", GroupName: "other"})
+
+	store.AddMember("ml-1", &model.GrpsIOMember{UID: "m-1", Email: "a@example.com"})
+	store.AddMember("ml-1", &model.GrpsIOMember{UID: "m-2", Email: "b@example.com"})
+	store.AddMember("ml-2", &model.GrpsIOMember{UID: "m-3", Email: "a@example.com"})
+	store.AddMember("ml-3", &model.GrpsIOMember{UID: "m-4", Email: "c@example.com"})
+	store.AddMember("ml-4", &model.GrpsIOMember{UID: "m-5", Email: "d@example.com"})
+	return store
+}
+
+// ---- GetProjectSummary ----
+
+func TestGetProjectSummary_SeededProject_ReturnsCounts(t *testing.T) {
+	o := newTestOverviewReader(seedProject())
+
+	summary, err := o.GetProjectSummary(context.Background(), "proj-1")
+	require.NoError(t, err)
+	assert.Equal(t, "proj-1", summary.ProjectUID)
+	assert.Equal(t, 2, summary.ServiceCount)
+	assert.Equal(t, 3, summary.MailingListCount)
+	assert.Equal(t, 4, summary.MemberCount)
+}
+
+func TestGetProjectSummary_EmptyProject_ReturnsZeroCounts(t *testing.T) {
+	o := newTestOverviewReader(seedProject())
+
+	summary, err := o.GetProjectSummary(context.Background(), "proj-empty")
+	require.NoError(t, err)
+	assert.Zero(t, summary.ServiceCount)
+	assert.Zero(t, summary.MailingListCount)
+	assert.Zero(t, summary.MemberCount)
+}
+
+func TestGetProjectSummary_ReaderError_Propagates(t *testing.T) {
+	store := seedProject()
+	store.Err = errors.New("itx unavailable")
+	o := newTestOverviewReader(store)
+
+	_, err := o.GetProjectSummary(context.Background(), "proj-1")
+	assert.Error(t, err)
+}
+
+// ---- ListOrphanedMailingLists ----
+
+func TestListOrphanedMailingLists_DeletedParentService_ReturnsOrphan(t *testing.T) {
+	store := seedProject()
+	store.AddMailingList(&model.GroupsIOMailingList{UID: "ml-orphan", ProjectUID: "proj-1", ServiceUID: "svc-deleted", GroupName: "stale"})
+	o := newTestOverviewReader(store)
+
+	orphans, err := o.ListOrphanedMailingLists(context.Background(), "proj-1")
+	require.NoError(t, err)
+	require.Len(t, orphans, 1)
+	assert.Equal(t, "ml-orphan", orphans[0].UID)
+}
+
+func TestListOrphanedMailingLists_AllParentsExist_ReturnsEmpty(t *testing.T) {
+	o := newTestOverviewReader(seedProject())
+
+	orphans, err := o.ListOrphanedMailingLists(context.Background(), "proj-1")
+	require.NoError(t, err)
+	assert.Empty(t, orphans)
+}
+
+func TestListOrphanedMailingLists_NoBatchReader_ReturnsServiceUnavailable(t *testing.T) {
+	o := NewGroupsIOOverviewReaderOrchestrator(WithOverviewMailingListReader(seedProject()))
+
+	_, err := o.ListOrphanedMailingLists(context.Background(), "proj-1")
+	assert.IsType(t, errs.ServiceUnavailable{}, err)
+}
+
+// ---- GetMailingListStats ----
+
+func TestGetMailingListStats_SeededMembers_CountsByModeAndBounces(t *testing.T) {
+	created := time.Date(2025, 3, 1, 0, 0, 0, 0, time.UTC)
+	updated := time.Date(2026, 4, 1, 0, 0, 0, 0, time.UTC)
+	store := mock.NewFakeGroupsIOReader()
+	store.AddMailingList(&model.GroupsIOMailingList{UID: "ml-1", CreatedAt: created, UpdatedAt: updated})
+	store.AddMember("ml-1", &model.GrpsIOMember{UID: "m-1", DeliveryMode: "email_delivery_single", Status: "normal"})
+	store.AddMember("ml-1", &model.GrpsIOMember{UID: "m-2", DeliveryMode: "email_delivery_single", Status: model.MemberStatusBouncing})
+	store.AddMember("ml-1", &model.GrpsIOMember{UID: "m-3", DeliveryMode: "email_delivery_digest", Status: "normal"})
+	store.AddMember("ml-1", &model.GrpsIOMember{UID: "m-4", DeliveryMode: "email_delivery_none", Status: model.MemberStatusBounced})
+	o := newTestOverviewReader(store)
+
+	stats, err := o.GetMailingListStats(context.Background(), "ml-1")
+	require.NoError(t, err)
+	assert.Equal(t, 4, stats.MemberCount)
+	assert.Equal(t, map[string]int{
+		"email_delivery_single": 2,
+		"email_delivery_digest": 1,
+		"email_delivery_none":   1,
+	}, stats.MembersByDeliveryMode)
+	assert.Equal(t, 2, stats.BounceCount)
+	assert.Equal(t, created, stats.CreatedAt)
+	assert.Equal(t, updated, stats.UpdatedAt)
+}
+
+func TestGetMailingListStats_UnknownList_ReturnsNotFound(t *testing.T) {
+	o := newTestOverviewReader(mock.NewFakeGroupsIOReader())
+
+	_, err := o.GetMailingListStats(context.Background(), "missing")
+	assert.IsType(t, errs.NotFound{}, err)
+}
+
+// ---- GetServiceTree ----
+
+func TestGetServiceTree_TwoListService_ReturnsSortedListsWithCounts(t *testing.T) {
+	o := newTestOverviewReader(seedProject())
+
+	tree, err := o.GetServiceTree(context.Background(), "svc-1", true)
+	require.NoError(t, err)
+	assert.Equal(t, "svc-1", tree.Service.UID)
+	require.Len(t, tree.MailingLists, 2)
+	assert.Equal(t, "announce", tree.MailingLists[0].MailingList.GroupName)
+	assert.Equal(t, "dev", tree.MailingLists[1].MailingList.GroupName)
+	require.NotNil(t, tree.MailingLists[0].MemberCount)
+	assert.Equal(t, 1, *tree.MailingLists[0].MemberCount)
+	require.NotNil(t, tree.MailingLists[1].MemberCount)
+	assert.Equal(t, 2, *tree.MailingLists[1].MemberCount)
+}
+
+func TestGetServiceTree_WithoutMemberCounts_LeavesCountsNil(t *testing.T) {
+	o := newTestOverviewReader(seedProject())
+
+	tree, err := o.GetServiceTree(context.Background(), "svc-1", false)
+	require.NoError(t, err)
+	require.Len(t, tree.MailingLists, 2)
+	for _, node := range tree.MailingLists {
+		assert.Nil(t, node.MemberCount)
+	}
+}
+
+func TestGetServiceTree_UnknownService_ReturnsNotFound(t *testing.T) {
+	o := newTestOverviewReader(seedProject())
+
+	_, err := o.GetServiceTree(context.Background(), "svc-missing", false)
+	assert.IsType(t, errs.NotFound{}, err)
+}
+
+// ---- ListMembersByProject ----
+
+func memberUIDs(members []*model.GrpsIOMember) []string {
+	uids := make([]string, 0, len(members))
+	for _, m := range members {
+		uids = append(uids, m.UID)
+	}
+	return uids
+}
+
+func TestListMembersByProject_PagesThroughAllLists(t *testing.T) {
+	o := newTestOverviewReader(seedProject())
+
+	var got []string
+	opts := model.ListOptions{Limit: 3}
+	for pages := 0; ; pages++ {
+		require.Less(t, pages, 5, "paging did not terminate")
+		page, err := o.ListMembersByProject(context.Background(), "proj-1", opts)
+		require.NoError(t, err)
+		assert.LessOrEqual(t, len(page.Members), 3)
+		got = append(got, memberUIDs(page.Members)...)
+		if page.NextCursor == "" {
+			break
+		}
+		opts.Cursor = page.NextCursor
+	}
+	assert.Equal(t, []string{"m-1", "m-2", "m-3", "m-4"}, got)
+}
+
+func TestListMembersByProject_Dedupe_SkipsEmailsSeenInEarlierLists(t *testing.T) {
+	o := newTestOverviewReader(seedProject())
+
+	first, err := o.ListMembersByProject(context.Background(), "proj-1", model.ListOptions{Limit: 2, Dedupe: true})
+	require.NoError(t, err)
+	assert.Equal(t, []string{"m-1", "m-2"}, memberUIDs(first.Members))
+	require.NotEmpty(t, first.NextCursor)
+
+	// m-3 (ml-2) repeats a@example.com from ml-1 and must not reappear on the next page.
+	second, err := o.ListMembersByProject(context.Background(), "proj-1", model.ListOptions{Limit: 2, Dedupe: true, Cursor: first.NextCursor})
+	require.NoError(t, err)
+	assert.Equal(t, []string{"m-4"}, memberUIDs(second.Members))
+	assert.Empty(t, second.NextCursor)
+}
+
+// listedMembersReader records which mailing lists had their members listed.
+type listedMembersReader struct {
+	*mock.FakeGroupsIOReader
+	listed []string
+}
+
+func (r *listedMembersReader) ListMembers(ctx context.Context, mailingListID string) ([]*model.GrpsIOMember, int, error) {
+	r.listed = append(r.listed, mailingListID)
+	return r.FakeGroupsIOReader.ListMembers(ctx, mailingListID)
+}
+
+func TestListMembersByProject_WithoutDedupe_DoesNotRereadListsBeforeCursor(t *testing.T) {
+	store := seedProject()
+	reader := &listedMembersReader{FakeGroupsIOReader: store}
+	o := newTestOverviewReader(store, WithOverviewMemberReader(reader))
+
+	first, err := o.ListMembersByProject(context.Background(), "proj-1", model.ListOptions{Limit: 2})
+	require.NoError(t, err)
+	require.NotEmpty(t, first.NextCursor)
+
+	reader.listed = nil
+	second, err := o.ListMembersByProject(context.Background(), "proj-1", model.ListOptions{Limit: 2, Cursor: first.NextCursor})
+	require.NoError(t, err)
+	assert.Equal(t, []string{"m-3", "m-4"}, memberUIDs(second.Members))
+	assert.NotContains(t, reader.listed, "ml-1")
+}
+
+func TestListMembersByProject_DedupeLargeProject_KeepsCursorBounded(t *testing.T) {
+	store := mock.NewFakeGroupsIOReader()
+	// Three lists of 200 members; each list shares half its emails with the list before it.
+	for l := 0; l < 3; l++ {
+		mlUID := fmt.Sprintf("ml-%d", l)
+		store.AddMailingList(&model.GroupsIOMailingList{UID: mlUID, ProjectUID: "proj-1"})
+		for m := 0; m < 200; m++ {
+			store.AddMember(mlUID, &model.GrpsIOMember{
+				UID:   fmt.Sprintf("m-%d-%03d", l, m),
+				Email: fmt.Sprintf("user%d@example.com", l*100+m),
+			})
+		}
+	}
+	// Same email as another member of the same list, differing only in case.
+	store.AddMember("ml-0", &model.GrpsIOMember{UID: "m-0-dup", Email: "USER0@example.com"})
+	o := newTestOverviewReader(store)
+
+	seen := make(map[string]bool)
+	opts := model.ListOptions{Limit: 25, Dedupe: true}
+	for pages := 0; ; pages++ {
+		require.Less(t, pages, 50, "paging did not terminate")
+		page, err := o.ListMembersByProject(context.Background(), "proj-1", opts)
+		require.NoError(t, err)
+		for _, m := range page.Members {
+			assert.False(t, seen[m.Email], "email %s returned twice", m.Email)
+			seen[m.Email] = true
+		}
+		if page.NextCursor == "" {
+			break
+		}
+		assert.LessOrEqual(t, len(page.NextCursor), 32, "the cursor must not grow with the emails seen")
+		opts.Cursor = page.NextCursor
+	}
+	assert.Len(t, seen, 400)
+}
+
+func TestListMembersByProject_Dedupe_KeepsMembersWithoutEmail(t *testing.T) {
+	store := mock.NewFakeGroupsIOReader()
+	store.AddMailingList(&model.GroupsIOMailingList{UID: "ml-1", ProjectUID: "proj-1"})
+	store.AddMember("ml-1", &model.GrpsIOMember{UID: "m-1"})
+	store.AddMember("ml-1", &model.GrpsIOMember{UID: "m-2"})
+	o := newTestOverviewReader(store)
+
+	page, err := o.ListMembersByProject(context.Background(), "proj-1", model.ListOptions{Dedupe: true})
+	require.NoError(t, err)
+	assert.Equal(t, []string{"m-1", "m-2"}, memberUIDs(page.Members))
+}
+
+func TestListMembersByProject_InvalidCursor_ReturnsValidation(t *testing.T) {
+	o := newTestOverviewReader(seedProject())
+
+	_, err := o.ListMembersByProject(context.Background(), "proj-1", model.ListOptions{Cursor: "not base64!"})
+	assert.IsType(t, errs.Validation{}, err)
+}
+
+// ---- concurrency ----
+
+// inFlightCountReader records the peak number of concurrent member count reads.
+type inFlightCountReader struct {
+	*mock.FakeGroupsIOReader
+	inFlight, peak atomic.Int32
+}
+
+func (r *inFlightCountReader) GetMailingListMemberCount(ctx context.Context, mailingListID string) (int, error) {
+	n := r.inFlight.Add(1)
+	defer r.inFlight.Add(-1)
+	for {
+		p := r.peak.Load()
+		if n <= p || r.peak.CompareAndSwap(p, n) {
+			break
+		}
+	}
+	time.Sleep(10 * time.Millisecond)
+	return r.FakeGroupsIOReader.GetMailingListMemberCount(ctx, mailingListID)
+}
+
+func seedManyLists(n int) *mock.FakeGroupsIOReader {
+	store := mock.NewFakeGroupsIOReader()
+	store.AddService(&model.GroupsIOService{UID: "svc-1", ProjectUID: "proj-1", Type: "v2_primary"})
+	for i := range n {
+		uid := fmt.Sprintf("ml-%02d", i)
+		store.AddMailingList(&model.GroupsIOMailingList{UID: uid, ProjectUID: "proj-1", ServiceUID: "svc-1", GroupName: fmt.Sprintf("list-%02d", n-i)})
+		for j := range i % 3 {
+			store.AddMember(uid, &model.GrpsIOMember{UID: fmt.Sprintf("%s-m-%d", uid, j)})
+		}
+	}
+	return store
+}
+
+func TestOverviewConcurrency_MatchesSerialAndCapsInFlight(t *testing.T) {
+	store := seedManyLists(10)
+	serial := newTestOverviewReader(store)
+	reader := &inFlightCountReader{FakeGroupsIOReader: store}
+	parallel := newTestOverviewReader(store, WithOverviewMailingListReader(reader), WithOverviewConcurrency(3))
+
+	wantSummary, err := serial.GetProjectSummary(context.Background(), "proj-1")
+	require.NoError(t, err)
+	gotSummary, err := parallel.GetProjectSummary(context.Background(), "proj-1")
+	require.NoError(t, err)
+	assert.Equal(t, wantSummary, gotSummary)
+
+	wantTree, err := serial.GetServiceTree(context.Background(), "svc-1", true)
+	require.NoError(t, err)
+	gotTree, err := parallel.GetServiceTree(context.Background(), "svc-1", true)
+	require.NoError(t, err)
+	assert.Equal(t, wantTree, gotTree)
+
+	assert.LessOrEqual(t, reader.peak.Load(), int32(3))
+	assert.Greater(t, reader.peak.Load(), int32(1))
+}
+
+// failFastCountReader fails the count of failUID and blocks every other count until its
+// context is cancelled or a second has passed, counting the reads that saw the cancellation.
+type failFastCountReader struct {
+	*mock.FakeGroupsIOReader
+	failUID   string
+	cancelled atomic.Int32
+}
+
+func (r *failFastCountReader) GetMailingListMemberCount(ctx context.Context, mailingListID string) (int, error) {
+	if mailingListID == r.failUID {
+		return 0, errs.NewServiceUnavailable("itx down")
+	}
+	select {
+	case <-ctx.Done():
+		r.cancelled.Add(1)
+		return 0, ctx.Err()
+	case <-time.After(time.Second):
+		return 0, nil
+	}
+}
+
+func TestOverviewConcurrency_FailedCount_CancelsInFlightReads(t *testing.T) {
+	store := seedManyLists(4)
+	reader := &failFastCountReader{FakeGroupsIOReader: store, failUID: "ml-03"}
+	o := newTestOverviewReader(store, WithOverviewMailingListReader(reader), WithOverviewConcurrency(4))
+
+	start := time.Now()
+	_, err := o.GetProjectSummary(context.Background(), "proj-1")
+	require.Error(t, err)
+	assert.Less(t, time.Since(start), 500*time.Millisecond)
+	assert.Equal(t, int32(3), reader.cancelled.Load())
+}
+
+// ---- GetMemberHierarchy ----
+
+func TestGetMemberHierarchy_CompleteChain_ReturnsAllLevels(t *testing.T) {
+	store := mock.NewFakeGroupsIOReader()
+	store.AddService(&model.GroupsIOService{UID: "svc-1", ProjectUID: "proj-1", ProjectName: "Project One", ProjectSlug: "one"})
+	store.AddMailingList(&model.GroupsIOMailingList{UID: "ml-1", ProjectUID: "proj-1", ServiceUID: "svc-1"})
+	store.AddMember("ml-1", &model.GrpsIOMember{UID: "m-1", Email: "a@example.com"})
+	o := newTestOverviewReader(store)
+
+	h, err := o.GetMemberHierarchy(context.Background(), "ml-1", "m-1")
+	require.NoError(t, err)
+	assert.Equal(t, "m-1", h.Member.UID)
+	require.NotNil(t, h.MailingList)
+	assert.Equal(t, "ml-1", h.MailingList.UID)
+	require.NotNil(t, h.Service)
+	assert.Equal(t, "svc-1", h.Service.UID)
+	assert.Equal(t, &model.HierarchyProject{UID: "proj-1", Name: "Project One", Slug: "one"}, h.Project)
+	assert.Empty(t, h.Warnings)
+}
+
+func TestGetMemberHierarchy_MissingService_ReturnsPartialWithWarning(t *testing.T) {
+	store := mock.NewFakeGroupsIOReader()
+	store.AddMailingList(&model.GroupsIOMailingList{UID: "ml-1", ProjectUID: "proj-1", ServiceUID: "svc-gone"})
+	store.AddMember("ml-1", &model.GrpsIOMember{UID: "m-1", Email: "a@example.com"})
+	o := newTestOverviewReader(store)
+
+	h, err := o.GetMemberHierarchy(context.Background(), "ml-1", "m-1")
+	require.NoError(t, err)
+	assert.NotNil(t, h.MailingList)
+	assert.Nil(t, h.Service)
+	require.NotNil(t, h.Project)
+	assert.Equal(t, "proj-1", h.Project.UID)
+	require.Len(t, h.Warnings, 1)
+	assert.Contains(t, h.Warnings[0], "svc-gone")
+}
+
+func TestGetMemberHierarchy_MissingMember_ReturnsNotFound(t *testing.T) {
+	store := mock.NewFakeGroupsIOReader()
+	store.AddMailingList(&model.GroupsIOMailingList{UID: "ml-1", ProjectUID: "proj-1"})
+	o := newTestOverviewReader(store)
+
+	_, err := o.GetMemberHierarchy(context.Background(), "ml-1", "m-1")
+	assert.IsType(t, errs.NotFound{}, err)
+}
@@ -5,17 +5,29 @@ package service
 
 import (
 	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+	"sync"
 
 	"github.com/linuxfoundation/lfx-v2-mailing-list-service/internal/domain/model"
 	"github.com/linuxfoundation/lfx-v2-mailing-list-service/internal/domain/port"
 	"github.com/linuxfoundation/lfx-v2-mailing-list-service/pkg/constants"
+	errs "github.com/linuxfoundation/lfx-v2-mailing-list-service/pkg/errors"
+
+	"golang.org/x/sync/errgroup"
 )
 
+// defaultBatchFetchConcurrency bounds the number of concurrent upstream calls made by batch readers.
+const defaultBatchFetchConcurrency = 5
+
 // GroupsIOServiceReaderOrchestrator implements port.GroupsIOServiceReader by wrapping an inner
 // GroupsIOServiceReader and translating v1 SFIDs to v2 UUIDs in responses.
 type GroupsIOServiceReaderOrchestrator struct {
-	reader     port.GroupsIOServiceReader
-	translator port.Translator
+	reader           port.GroupsIOServiceReader
+	translator       port.Translator
+	batchConcurrency int
 }
 
 // ServiceReaderOrchestratorOption configures a GroupsIOServiceReaderOrchestrator.
@@ -35,6 +47,13 @@ func WithServiceReaderTranslator(t port.Translator) ServiceReaderOrchestratorOpt
 	}
 }
 
+// WithServiceReaderBatchConcurrency sets how many services GetServices fetches concurrently.
+func WithServiceReaderBatchConcurrency(n int) ServiceReaderOrchestratorOption {
+	return func(o *GroupsIOServiceReaderOrchestrator) {
+		o.batchConcurrency = n
+	}
+}
+
 // ListServices lists GroupsIO services, mapping project_uid (v2) -> project_id (v1) in the
 // request and project_id (v1) -> project_uid (v2) in each response.
 func (o *GroupsIOServiceReaderOrchestrator) ListServices(ctx context.Context, projectUID string) ([]*model.GroupsIOService, int, error) {
@@ -73,6 +92,88 @@ func (o *GroupsIOServiceReaderOrchestrator) GetService(ctx context.Context, serv
 	return mapServiceResponse(ctx, o.translator, svc)
 }
 
+// GetServices fetches several services by ID with bounded concurrency and returns them keyed
+// by ID. IDs that do not exist are omitted from the result rather than failing the batch;
+// any other error aborts the batch and cancels the fetches still in flight. Duplicate IDs are
+// fetched once.
+func (o *GroupsIOServiceReaderOrchestrator) GetServices(ctx context.Context, serviceIDs []string) (map[string]*model.GroupsIOService, error) {
+	var (
+		mu  sync.Mutex
+		out = make(map[string]*model.GroupsIOService, len(serviceIDs))
+	)
+
+	g, gctx := errgroup.WithContext(ctx)
+	g.SetLimit(max(o.batchConcurrency, 1))
+
+	seen := make(map[string]struct{}, len(serviceIDs))
+	for _, id := range serviceIDs {
+		if _, dup := seen[id]; dup || id == "" {
+			continue
+		}
+		seen[id] = struct{}{}
+		g.Go(func() error {
+			svc, err := o.GetService(gctx, id)
+			if err != nil {
+				var notFound errs.NotFound
+				if errors.As(err, &notFound) {
+					return nil
+				}
+				return err
+			}
+			mu.Lock()
+			out[id] = svc
+			mu.Unlock()
+			return nil
+		})
+	}
+
+	if err := g.Wait(); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// ListServicesByStatus returns every service, across all projects, whose status matches
+// (e.g. "pending" services that still need provisioning). The status must be one of
+// constants.ServiceStatuses.
+func (o *GroupsIOServiceReaderOrchestrator) ListServicesByStatus(ctx context.Context, status string) ([]*model.GroupsIOService, error) {
+	if !slices.Contains(constants.ServiceStatuses, status) {
+		return nil, errs.NewValidation(fmt.Sprintf("invalid service status %q; must be one of %s",
+			status, strings.Join(constants.ServiceStatuses, ", ")))
+	}
+
+	svcs, _, err := o.ListServices(ctx, "")
+	if err != nil {
+		return nil, err
+	}
+
+	var out []*model.GroupsIOService
+	for _, svc := range svcs {
+		if svc.Status == status {
+			out = append(out, svc)
+		}
+	}
+	return out, nil
+}
+
+// ListUnprovisionedServices returns the project's services that have no Groups.io group ID,
+// i.e. services that were never provisioned in Groups.io and need attention. An empty
+// projectUID searches across all projects.
+func (o *GroupsIOServiceReaderOrchestrator) ListUnprovisionedServices(ctx context.Context, projectUID string) ([]*model.GroupsIOService, error) {
+	svcs, _, err := o.ListServices(ctx, projectUID)
+	if err != nil {
+		return nil, err
+	}
+
+	var out []*model.GroupsIOService
+	for _, svc := range svcs {
+		if svc.GroupID == nil {
+			out = append(out, svc)
+		}
+	}
+	return out, nil
+}
+
 // GetProjects returns v2 project UIDs that have GroupsIO services, translating
 // v1 project IDs -> v2 UUIDs.
 func (o *GroupsIOServiceReaderOrchestrator) GetProjects(ctx context.Context) ([]string, error) {
@@ -111,9 +212,13 @@ func (o *GroupsIOServiceReaderOrchestrator) FindParentService(ctx context.Contex
 
 // NewGroupsIOServiceReaderOrchestrator creates a new reader orchestrator with the given options.
 func NewGroupsIOServiceReaderOrchestrator(opts ...ServiceReaderOrchestratorOption) *GroupsIOServiceReaderOrchestrator {
-	o := &GroupsIOServiceReaderOrchestrator{}
+	o := &GroupsIOServiceReaderOrchestrator{
+		batchConcurrency: defaultBatchFetchConcurrency,
+	}
 	for _, opt := range opts {
 		opt(o)
 	}
 	return o
 }
+
+var _ port.GroupsIOServiceBatchReader = (*GroupsIOServiceReaderOrchestrator)(nil)
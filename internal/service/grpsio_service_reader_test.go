@@ -0,0 +1,165 @@
+// Copyright The Linux Foundation and each contributor to LFX.
+// SPDX-License-Identifier: MIT
+
+package service
+
+import (
+	"context"
+	"errors"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/linuxfoundation/lfx-v2-mailing-list-service/internal/domain/model"
+	"github.com/linuxfoundation/lfx-v2-mailing-list-service/internal/domain/port"
+	"github.com/linuxfoundation/lfx-v2-mailing-list-service/internal/infrastructure/mock"
+	"github.com/linuxfoundation/lfx-v2-mailing-list-service/pkg/constants"
+	errs "github.com/linuxfoundation/lfx-v2-mailing-list-service/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// ---- helpers ----
+
+func newTestServiceReader(store *mock.FakeGroupsIOReader, opts ...ServiceReaderOrchestratorOption) *GroupsIOServiceReaderOrchestrator {
+	return NewGroupsIOServiceReaderOrchestrator(append([]ServiceReaderOrchestratorOption{
+		WithServiceReader(store),
+		WithServiceReaderTranslator(&passthroughTranslator{}),
+	}, opts...)...)
+}
+
+// ---- GetServices ----
+
+func TestGetServices_MixedPresentAndAbsent_SkipsMissing(t *testing.T) {
+	store := mock.NewFakeGroupsIOReader()
+	store.AddService(&model.GroupsIOService{UID: "svc-1", ProjectUID: "proj-1", GroupID: int64Ptr(1)})
+	store.AddService(&model.GroupsIOService{UID: "svc-2", ProjectUID: "proj-1", GroupID: int64Ptr(2)})
+	o := newTestServiceReader(store, WithServiceReaderBatchConcurrency(2))
+
+	got, err := o.GetServices(context.Background(), []string{"svc-1", "missing", "svc-2", "svc-1"})
+	require.NoError(t, err)
+	require.Len(t, got, 2)
+	assert.Equal(t, int64(1), *got["svc-1"].GroupID)
+	assert.Equal(t, int64(2), *got["svc-2"].GroupID)
+	assert.NotContains(t, got, "missing")
+}
+
+func TestGetServices_MutatingResult_DoesNotAffectStore(t *testing.T) {
+	store := mock.NewFakeGroupsIOReader()
+	store.AddService(&model.GroupsIOService{UID: "svc-1", Prefix: "orig", GroupID: int64Ptr(1)})
+	o := newTestServiceReader(store)
+
+	got, err := o.GetServices(context.Background(), []string{"svc-1"})
+	require.NoError(t, err)
+	got["svc-1"].Prefix = "changed"
+	*got["svc-1"].GroupID = 99
+
+	again, err := o.GetServices(context.Background(), []string{"svc-1"})
+	require.NoError(t, err)
+	assert.Equal(t, "orig", again["svc-1"].Prefix)
+	assert.Equal(t, int64(1), *again["svc-1"].GroupID)
+}
+
+func TestGetServices_ReaderError_Propagates(t *testing.T) {
+	store := mock.NewFakeGroupsIOReader()
+	store.Err = errors.New("itx unavailable")
+	o := newTestServiceReader(store)
+
+	_, err := o.GetServices(context.Background(), []string{"svc-1"})
+	assert.Error(t, err)
+}
+
+func TestGetServices_Empty_ReturnsEmptyMap(t *testing.T) {
+	o := newTestServiceReader(mock.NewFakeGroupsIOReader())
+
+	got, err := o.GetServices(context.Background(), nil)
+	require.NoError(t, err)
+	assert.Empty(t, got)
+}
+
+// cancelAwareServiceReader fails GetService for failID and blocks every other call until its
+// context is cancelled, counting the calls that observed the cancellation.
+type cancelAwareServiceReader struct {
+	port.GroupsIOServiceReader
+	failID    string
+	cancelled atomic.Int32
+}
+
+func (r *cancelAwareServiceReader) GetService(ctx context.Context, serviceID string) (*model.GroupsIOService, error) {
+	if serviceID == r.failID {
+		return nil, errs.NewServiceUnavailable("itx down")
+	}
+	select {
+	case <-ctx.Done():
+		r.cancelled.Add(1)
+		return nil, ctx.Err()
+	case <-time.After(5 * time.Second):
+		return &model.GroupsIOService{UID: serviceID}, nil
+	}
+}
+
+func TestGetServices_OneFetchFails_CancelsTheOthers(t *testing.T) {
+	reader := &cancelAwareServiceReader{failID: "svc-bad"}
+	o := NewGroupsIOServiceReaderOrchestrator(
+		WithServiceReader(reader),
+		WithServiceReaderTranslator(&passthroughTranslator{}),
+		WithServiceReaderBatchConcurrency(3),
+	)
+
+	start := time.Now()
+	_, err := o.GetServices(context.Background(), []string{"svc-1", "svc-2", "svc-bad"})
+	assert.IsType(t, errs.ServiceUnavailable{}, err)
+	assert.Less(t, time.Since(start), 2*time.Second, "in-flight fetches should stop once one fails")
+	assert.Equal(t, int32(2), reader.cancelled.Load())
+}
+
+// ---- ListServicesByStatus ----
+
+func TestListServicesByStatus_Pending_ReturnsOnlyPendingAcrossProjects(t *testing.T) {
+	store := mock.NewFakeGroupsIOReader()
+	store.AddService(&model.GroupsIOService{UID: "svc-1", ProjectUID: "proj-1", Status: constants.ServiceStatusPending})
+	store.AddService(&model.GroupsIOService{UID: "svc-2", ProjectUID: "proj-1", Status: constants.ServiceStatusActive})
+	store.AddService(&model.GroupsIOService{UID: "svc-3", ProjectUID: "proj-2", Status: constants.ServiceStatusPending})
+	o := newTestServiceReader(store)
+
+	got, err := o.ListServicesByStatus(context.Background(), constants.ServiceStatusPending)
+	require.NoError(t, err)
+
+	var uids []string
+	for _, svc := range got {
+		uids = append(uids, svc.UID)
+	}
+	assert.ElementsMatch(t, []string{"svc-1", "svc-3"}, uids)
+}
+
+func TestListServicesByStatus_UnknownStatus_ReturnsValidation(t *testing.T) {
+	o := newTestServiceReader(mock.NewFakeGroupsIOReader())
+
+	_, err := o.ListServicesByStatus(context.Background(), "provisioning")
+	assert.IsType(t, errs.Validation{}, err)
+}
+
+// ---- ListUnprovisionedServices ----
+
+func TestListUnprovisionedServices_MixedGroupIDs_ReturnsOnlyNilGroupID(t *testing.T) {
+	groupID := int64(42)
+	store := mock.NewFakeGroupsIOReader()
+	store.AddService(&model.GroupsIOService{UID: "svc-1", ProjectUID: "proj-1", GroupID: &groupID})
+	store.AddService(&model.GroupsIOService{UID: "svc-2", ProjectUID: "proj-1"})
+	store.AddService(&model.GroupsIOService{UID: "svc-3", ProjectUID: "proj-2"})
+	o := newTestServiceReader(store)
+
+	got, err := o.ListUnprovisionedServices(context.Background(), "proj-1")
+	require.NoError(t, err)
+	require.Len(t, got, 1)
+	assert.Equal(t, "svc-2", got[0].UID)
+}
+
+func TestListUnprovisionedServices_ReaderError_Propagates(t *testing.T) {
+	store := mock.NewFakeGroupsIOReader()
+	store.Err = errs.NewServiceUnavailable("itx down")
+	o := newTestServiceReader(store)
+
+	_, err := o.ListUnprovisionedServices(context.Background(), "proj-1")
+	assert.IsType(t, errs.ServiceUnavailable{}, err)
+}
@@ -6,17 +6,27 @@ package service
 
 import (
 	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"regexp"
+	"strings"
 
 	"github.com/linuxfoundation/lfx-v2-mailing-list-service/internal/domain/model"
 	"github.com/linuxfoundation/lfx-v2-mailing-list-service/internal/domain/port"
 	"github.com/linuxfoundation/lfx-v2-mailing-list-service/pkg/constants"
+	errs "github.com/linuxfoundation/lfx-v2-mailing-list-service/pkg/errors"
 )
 
 // GroupsIOServiceWriterOrchestrator implements port.GrpsIOServiceWriter by wrapping an inner
 // GrpsIOServiceWriter and translating v2 UUIDs to v1 SFIDs before forwarding requests.
 type GroupsIOServiceWriterOrchestrator struct {
-	writer     port.GroupsIOServiceWriter
-	translator port.Translator
+	writer            port.GroupsIOServiceWriter
+	reader            port.GroupsIOServiceReader
+	promoter          port.GroupsIOServicePromoter
+	mailingListReader port.GroupsIOMailingListReader
+	translator        port.Translator
+	idempotentDeletes bool
 }
 
 // ServiceWriterOrchestratorOption configures a GroupsIOServiceWriterOrchestrator.
@@ -29,6 +39,29 @@ func WithServiceWriter(w port.GroupsIOServiceWriter) ServiceWriterOrchestratorOp
 	}
 }
 
+// WithServiceWriterReader sets the service reader used by operations that must inspect existing
+// services before writing (e.g. formation promotion). It is expected to return v2 project UIDs.
+func WithServiceWriterReader(r port.GroupsIOServiceReader) ServiceWriterOrchestratorOption {
+	return func(o *GroupsIOServiceWriterOrchestrator) {
+		o.reader = r
+	}
+}
+
+// WithServicePromoter sets the ITX client that promotes a service to primary.
+func WithServicePromoter(p port.GroupsIOServicePromoter) ServiceWriterOrchestratorOption {
+	return func(o *GroupsIOServiceWriterOrchestrator) {
+		o.promoter = p
+	}
+}
+
+// WithServiceWriterMailingListReader sets the mailing list reader used by formation promotion
+// to find lists still named with the formation prefix. It is expected to take v2 project UIDs.
+func WithServiceWriterMailingListReader(r port.GroupsIOMailingListReader) ServiceWriterOrchestratorOption {
+	return func(o *GroupsIOServiceWriterOrchestrator) {
+		o.mailingListReader = r
+	}
+}
+
 // WithServiceTranslator sets the ID translator.
 func WithServiceTranslator(t port.Translator) ServiceWriterOrchestratorOption {
 	return func(o *GroupsIOServiceWriterOrchestrator) {
@@ -36,8 +69,50 @@ func WithServiceTranslator(t port.Translator) ServiceWriterOrchestratorOption {
 	}
 }
 
+// WithIdempotentServiceDelete makes DeleteService succeed when the service is already gone,
+// so retried deletes do not fail with NotFound.
+func WithIdempotentServiceDelete(enabled bool) ServiceWriterOrchestratorOption {
+	return func(o *GroupsIOServiceWriterOrchestrator) {
+		o.idempotentDeletes = enabled
+	}
+}
+
+// formationPrefixPattern matches a well-formed formation prefix: lowercase letters, digits and
+// inner hyphens, as Groups.io subgroup names built from it must be.
+var formationPrefixPattern = regexp.MustCompile(`^[a-z0-9]+(-[a-z0-9]+)*$`)
+
+// validateService enforces per-type invariants before a service is forwarded to ITX.
+// Shared services attach to an existing Groups.io group, so they must carry its group ID.
+// Formation services prefix their lists' group names, so the prefix must be well-formed.
+func validateService(svc *model.GroupsIOService) error {
+	if err := svc.ValidateIDs(); err != nil {
+		return err
+	}
+	switch svc.Type {
+	case constants.ITXServiceTypeShared:
+		if svc.GroupID == nil {
+			return errs.NewValidation("group_id is required for shared services")
+		}
+	case constants.ITXServiceTypeFormation:
+		if svc.Prefix == "" {
+			return errs.NewValidation("prefix is required for formation services")
+		}
+		if !formationPrefixPattern.MatchString(svc.Prefix) {
+			return errs.NewValidation(fmt.Sprintf("prefix %q must contain only lowercase letters, digits and hyphens", svc.Prefix))
+		}
+	}
+	return nil
+}
+
 // CreateService creates a new GroupsIO service, mapping project_uid (v2) -> project_id (v1).
 func (o *GroupsIOServiceWriterOrchestrator) CreateService(ctx context.Context, svc *model.GroupsIOService) (*model.GroupsIOService, error) {
+	if err := validateService(svc); err != nil {
+		return nil, err
+	}
+	if err := o.checkPrimaryAvailable(ctx, svc); err != nil {
+		return nil, err
+	}
+
 	toSend := *svc
 	if svc.ProjectUID != "" {
 		v1ID, err := o.translator.MapID(ctx, constants.TranslationSubjectProject, constants.TranslationDirectionV2ToV1, svc.ProjectUID)
@@ -57,6 +132,10 @@ func (o *GroupsIOServiceWriterOrchestrator) CreateService(ctx context.Context, s
 
 // UpdateService updates a GroupsIO service, mapping project_uid (v2) -> project_id (v1).
 func (o *GroupsIOServiceWriterOrchestrator) UpdateService(ctx context.Context, serviceID string, svc *model.GroupsIOService) (*model.GroupsIOService, error) {
+	if err := svc.ValidateIDs(); err != nil {
+		return nil, err
+	}
+
 	toSend := *svc
 	if svc.ProjectUID != "" {
 		v1ID, err := o.translator.MapID(ctx, constants.TranslationSubjectProject, constants.TranslationDirectionV2ToV1, svc.ProjectUID)
@@ -74,9 +153,105 @@ func (o *GroupsIOServiceWriterOrchestrator) UpdateService(ctx context.Context, s
 	return mapServiceResponse(ctx, o.translator, resp)
 }
 
+// checkPrimaryAvailable rejects creating a primary service for a project that already has one,
+// so the conflict surfaces before ITX provisions a Groups.io group for the new service. It is
+// skipped when no reader is configured or the service is not a primary.
+func (o *GroupsIOServiceWriterOrchestrator) checkPrimaryAvailable(ctx context.Context, svc *model.GroupsIOService) error {
+	if o.reader == nil || svc.Type != constants.ITXServiceTypePrimary || svc.ProjectUID == "" {
+		return nil
+	}
+
+	primary, err := o.reader.FindParentService(ctx, svc.ProjectUID)
+	var notFound errs.NotFound
+	switch {
+	case err == nil:
+		return errs.NewConflict(fmt.Sprintf("project %s already has primary service %s", svc.ProjectUID, primary.UID))
+	case errors.As(err, &notFound):
+		return nil
+	default:
+		return err
+	}
+}
+
+// PromoteFormationToPrimary turns a formation service into its project's primary service.
+// A project may only have one primary service, so the promotion is rejected with a Conflict
+// when one already exists. The formation prefix is cleared, since a primary service's lists
+// are named without it. List group names are held by Groups.io and renaming a list changes
+// its address, so lists still named with the former prefix are reported rather than renamed.
+func (o *GroupsIOServiceWriterOrchestrator) PromoteFormationToPrimary(ctx context.Context, formationServiceUID string) (*model.ServicePromotion, error) {
+	if o.reader == nil || o.promoter == nil {
+		return nil, errs.NewServiceUnavailable("service promotion is not configured")
+	}
+	if formationServiceUID == "" {
+		return nil, errs.NewValidation("service_id is required")
+	}
+
+	svc, err := o.reader.GetService(ctx, formationServiceUID)
+	if err != nil {
+		return nil, err
+	}
+	if svc.Type != constants.ITXServiceTypeFormation {
+		return nil, errs.NewValidation(fmt.Sprintf("service %s is a %s service, only formation services can be promoted", formationServiceUID, svc.Type))
+	}
+
+	promoted := *svc
+	promoted.Type = constants.ITXServiceTypePrimary
+	promoted.Prefix = ""
+	if err := o.checkPrimaryAvailable(ctx, &promoted); err != nil {
+		return nil, err
+	}
+
+	resp, err := o.promoter.PromoteServiceToPrimary(ctx, formationServiceUID)
+	if err != nil {
+		return nil, err
+	}
+	resp, err = mapServiceResponse(ctx, o.translator, resp)
+	if err != nil {
+		return nil, err
+	}
+	return &model.ServicePromotion{
+		Service:              resp,
+		PrefixedMailingLists: o.prefixedMailingLists(ctx, svc),
+	}, nil
+}
+
+// prefixedMailingLists returns the formation service's lists whose group names start with its
+// prefix. The promotion has already happened by the time this runs, so a failed lookup is
+// logged and reported as no lists rather than failing the call.
+func (o *GroupsIOServiceWriterOrchestrator) prefixedMailingLists(ctx context.Context, formation *model.GroupsIOService) []*model.GroupsIOMailingList {
+	if o.mailingListReader == nil || formation.Prefix == "" {
+		return nil
+	}
+	lists, _, err := o.mailingListReader.ListMailingLists(ctx, formation.ProjectUID, "")
+	if err != nil {
+		slog.WarnContext(ctx, "failed to list mailing lists of promoted service",
+			"service_id", formation.UID, "error", err)
+		return nil
+	}
+	namePrefix := strings.ToLower(formation.Prefix) + "-"
+	var prefixed []*model.GroupsIOMailingList
+	for _, ml := range lists {
+		if ml.ServiceUID == formation.UID && strings.HasPrefix(strings.ToLower(ml.GroupName), namePrefix) {
+			prefixed = append(prefixed, ml)
+		}
+	}
+	return prefixed
+}
+
 // DeleteService deletes a GroupsIO service.
 func (o *GroupsIOServiceWriterOrchestrator) DeleteService(ctx context.Context, serviceID string) error {
-	return o.writer.DeleteService(ctx, serviceID)
+	return deleteError(ctx, o.idempotentDeletes, "service", serviceID, o.writer.DeleteService(ctx, serviceID))
+}
+
+// deleteError returns the error a delete should report. With idempotent deletes, a NotFound
+// means an earlier attempt already removed the entity, so it is logged and treated as success.
+func deleteError(ctx context.Context, idempotent bool, entity, id string, err error) error {
+	var notFound errs.NotFound
+	if err != nil && idempotent && errors.As(err, &notFound) {
+		slog.InfoContext(ctx, "entity already deleted, treating delete as successful", "entity", entity, "id", id)
+		return nil
+	}
+	return err
 }
 
 // mapServiceResponse maps project_id (v1) -> project_uid (v2) in a service response.
@@ -96,6 +271,9 @@ func mapServiceResponse(ctx context.Context, translator port.Translator, svc *mo
 	return svc, nil
 }
 
+// Ensure GroupsIOServiceWriterOrchestrator implements port.GroupsIOServiceManager.
+var _ port.GroupsIOServiceManager = (*GroupsIOServiceWriterOrchestrator)(nil)
+
 // NewGroupsIOServiceWriterOrchestrator creates a new orchestrator with the given options.
 func NewGroupsIOServiceWriterOrchestrator(opts ...ServiceWriterOrchestratorOption) *GroupsIOServiceWriterOrchestrator {
 	o := &GroupsIOServiceWriterOrchestrator{}
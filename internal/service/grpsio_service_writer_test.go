@@ -0,0 +1,293 @@
+// Copyright The Linux Foundation and each contributor to LFX.
+// SPDX-License-Identifier: MIT
+
+package service
+
+import (
+	"context"
+	"testing"
+
+	"github.com/linuxfoundation/lfx-v2-mailing-list-service/internal/domain/model"
+	"github.com/linuxfoundation/lfx-v2-mailing-list-service/internal/domain/port"
+	"github.com/linuxfoundation/lfx-v2-mailing-list-service/internal/infrastructure/mock"
+	"github.com/linuxfoundation/lfx-v2-mailing-list-service/pkg/constants"
+	errs "github.com/linuxfoundation/lfx-v2-mailing-list-service/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// ---- test doubles ----
+
+// stubServiceWriter echoes requests back and records how many calls reached it.
+type stubServiceWriter struct {
+	createCalls int
+	updateCalls int
+	deleteCalls int
+	promoted    []string
+	updated     *model.GroupsIOService
+	createErr   error
+	updateErr   error
+	deleteErr   error
+}
+
+func (w *stubServiceWriter) CreateService(_ context.Context, svc *model.GroupsIOService) (*model.GroupsIOService, error) {
+	w.createCalls++
+	return svc, w.createErr
+}
+
+func (w *stubServiceWriter) UpdateService(_ context.Context, _ string, svc *model.GroupsIOService) (*model.GroupsIOService, error) {
+	w.updateCalls++
+	w.updated = svc
+	return svc, w.updateErr
+}
+
+func (w *stubServiceWriter) DeleteService(_ context.Context, _ string) error {
+	w.deleteCalls++
+	return w.deleteErr
+}
+
+func (w *stubServiceWriter) PromoteServiceToPrimary(_ context.Context, serviceID string) (*model.GroupsIOService, error) {
+	w.promoted = append(w.promoted, serviceID)
+	return &model.GroupsIOService{UID: serviceID, Type: constants.ITXServiceTypePrimary, ProjectUID: "proj-1"}, w.updateErr
+}
+
+var (
+	_ port.GroupsIOServiceWriter   = (*stubServiceWriter)(nil)
+	_ port.GroupsIOServicePromoter = (*stubServiceWriter)(nil)
+)
+
+// ---- helpers ----
+
+func newTestServiceWriter(writer port.GroupsIOServiceWriter, opts ...ServiceWriterOrchestratorOption) *GroupsIOServiceWriterOrchestrator {
+	return NewGroupsIOServiceWriterOrchestrator(append([]ServiceWriterOrchestratorOption{
+		WithServiceWriter(writer),
+		WithServiceTranslator(&passthroughTranslator{}),
+	}, opts...)...)
+}
+
+func int64Ptr(v int64) *int64 { return &v }
+
+// ---- shared service group ID ----
+
+func TestCreateService_SharedWithGroupID_Succeeds(t *testing.T) {
+	writer := &stubServiceWriter{}
+	o := newTestServiceWriter(writer)
+
+	resp, err := o.CreateService(context.Background(), &model.GroupsIOService{
+		Type:       constants.ITXServiceTypeShared,
+		GroupID:    int64Ptr(12345),
+		ProjectUID: "proj-1",
+	})
+	require.NoError(t, err)
+	require.NotNil(t, resp.GroupID)
+	assert.Equal(t, int64(12345), *resp.GroupID)
+	assert.Equal(t, 1, writer.createCalls)
+}
+
+func TestCreateService_SharedWithNilGroupID_ReturnsValidation(t *testing.T) {
+	writer := &stubServiceWriter{}
+	o := newTestServiceWriter(writer)
+
+	_, err := o.CreateService(context.Background(), &model.GroupsIOService{
+		Type:       constants.ITXServiceTypeShared,
+		ProjectUID: "proj-1",
+	})
+	require.Error(t, err)
+	assert.IsType(t, errs.Validation{}, err)
+	assert.Zero(t, writer.createCalls, "invalid service must not reach ITX")
+}
+
+// ---- positive Groups.io IDs ----
+
+func TestCreateService_NonPositiveGroupID_ReturnsValidation(t *testing.T) {
+	for _, id := range []int64{0, -1} {
+		writer := &stubServiceWriter{}
+		o := newTestServiceWriter(writer)
+
+		_, err := o.CreateService(context.Background(), &model.GroupsIOService{
+			Type:       constants.ITXServiceTypeShared,
+			GroupID:    int64Ptr(id),
+			ProjectUID: "proj-1",
+		})
+		assert.IsType(t, errs.Validation{}, err, "group_id %d", id)
+		assert.Zero(t, writer.createCalls)
+	}
+}
+
+func TestUpdateService_NonPositiveGroupID_ReturnsValidation(t *testing.T) {
+	writer := &stubServiceWriter{}
+	o := newTestServiceWriter(writer)
+
+	_, err := o.UpdateService(context.Background(), "svc-1", &model.GroupsIOService{GroupID: int64Ptr(0)})
+	assert.IsType(t, errs.Validation{}, err)
+	assert.Zero(t, writer.updateCalls)
+}
+
+// ---- formation prefix ----
+
+func TestCreateService_FormationPrefix_Validated(t *testing.T) {
+	tests := []struct {
+		prefix  string
+		wantErr bool
+	}{
+		{prefix: "form"},
+		{prefix: "tac-2026"},
+		{prefix: "", wantErr: true},
+		{prefix: "Form", wantErr: true},
+		{prefix: "form_x", wantErr: true},
+		{prefix: "-form", wantErr: true},
+		{prefix: "form-", wantErr: true},
+		{prefix: "form list", wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.prefix, func(t *testing.T) {
+			writer := &stubServiceWriter{}
+			o := newTestServiceWriter(writer)
+
+			_, err := o.CreateService(context.Background(), &model.GroupsIOService{
+				Type:       constants.ITXServiceTypeFormation,
+				Prefix:     tt.prefix,
+				ProjectUID: "proj-1",
+			})
+			if tt.wantErr {
+				assert.IsType(t, errs.Validation{}, err)
+				assert.Zero(t, writer.createCalls)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, 1, writer.createCalls)
+		})
+	}
+}
+
+// ---- idempotent delete ----
+
+func TestDeleteService_Idempotent(t *testing.T) {
+	tests := []struct {
+		name       string
+		idempotent bool
+		deleteErr  error
+		wantErr    any
+	}{
+		{name: "first delete succeeds", idempotent: true},
+		{name: "repeat delete succeeds when idempotent", idempotent: true, deleteErr: errs.NewNotFound("service not found")},
+		{name: "repeat delete is not found by default", deleteErr: errs.NewNotFound("service not found"), wantErr: errs.NotFound{}},
+		{name: "other errors still fail when idempotent", idempotent: true, deleteErr: errs.NewServiceUnavailable("itx down"), wantErr: errs.ServiceUnavailable{}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			writer := &stubServiceWriter{deleteErr: tt.deleteErr}
+			o := newTestServiceWriter(writer, WithIdempotentServiceDelete(tt.idempotent))
+
+			err := o.DeleteService(context.Background(), "svc-1")
+			if tt.wantErr != nil {
+				assert.IsType(t, tt.wantErr, err)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, 1, writer.deleteCalls)
+		})
+	}
+}
+
+// ---- primary conflict pre-check ----
+
+func TestCreateService_PrimaryAlreadyExists_ReturnsConflictWithoutITXCall(t *testing.T) {
+	reader := mock.NewFakeGroupsIOReader()
+	reader.AddService(&model.GroupsIOService{UID: "svc-primary", Type: constants.ITXServiceTypePrimary, ProjectUID: "proj-1"})
+	writer := &stubServiceWriter{}
+	o := newTestServiceWriter(writer, WithServiceWriterReader(reader))
+
+	_, err := o.CreateService(context.Background(), &model.GroupsIOService{
+		Type:       constants.ITXServiceTypePrimary,
+		ProjectUID: "proj-1",
+	})
+	assert.IsType(t, errs.Conflict{}, err)
+	assert.Zero(t, writer.createCalls)
+}
+
+func TestCreateService_PrimaryInOtherProject_Creates(t *testing.T) {
+	reader := mock.NewFakeGroupsIOReader()
+	reader.AddService(&model.GroupsIOService{UID: "svc-primary", Type: constants.ITXServiceTypePrimary, ProjectUID: "proj-2"})
+	writer := &stubServiceWriter{}
+	o := newTestServiceWriter(writer, WithServiceWriterReader(reader))
+
+	_, err := o.CreateService(context.Background(), &model.GroupsIOService{
+		Type:       constants.ITXServiceTypePrimary,
+		ProjectUID: "proj-1",
+	})
+	require.NoError(t, err)
+	assert.Equal(t, 1, writer.createCalls)
+}
+
+func TestCreateService_PrimaryLookupFails_ReturnsErrorWithoutITXCall(t *testing.T) {
+	reader := mock.NewFakeGroupsIOReader()
+	reader.Err = errs.NewServiceUnavailable("itx down")
+	writer := &stubServiceWriter{}
+	o := newTestServiceWriter(writer, WithServiceWriterReader(reader))
+
+	_, err := o.CreateService(context.Background(), &model.GroupsIOService{
+		Type:       constants.ITXServiceTypePrimary,
+		ProjectUID: "proj-1",
+	})
+	assert.IsType(t, errs.ServiceUnavailable{}, err)
+	assert.Zero(t, writer.createCalls)
+}
+
+// ---- formation promotion ----
+
+func TestPromoteFormationToPrimary_NoPrimary_PromotesAndReportsPrefixedLists(t *testing.T) {
+	reader := mock.NewFakeGroupsIOReader()
+	reader.AddService(&model.GroupsIOService{UID: "svc-form", Type: constants.ITXServiceTypeFormation, Prefix: "form", ProjectUID: "proj-1"})
+	reader.AddMailingList(&model.GroupsIOMailingList{UID: "ml-1", ServiceUID: "svc-form", ProjectUID: "proj-1", GroupName: "form-dev"})
+	reader.AddMailingList(&model.GroupsIOMailingList{UID: "ml-2", ServiceUID: "svc-form", ProjectUID: "proj-1", GroupName: "announce"})
+	reader.AddMailingList(&model.GroupsIOMailingList{UID: "ml-3", ServiceUID: "svc-other", ProjectUID: "proj-1", GroupName: "form-other"})
+	writer := &stubServiceWriter{}
+	o := newTestServiceWriter(writer, WithServiceWriterReader(reader), WithServicePromoter(writer), WithServiceWriterMailingListReader(reader))
+
+	resp, err := o.PromoteFormationToPrimary(context.Background(), "svc-form")
+	require.NoError(t, err)
+	assert.Equal(t, constants.ITXServiceTypePrimary, resp.Service.Type)
+	assert.Empty(t, resp.Service.Prefix)
+	assert.Equal(t, []string{"svc-form"}, writer.promoted)
+	assert.Zero(t, writer.updateCalls)
+	require.Len(t, resp.PrefixedMailingLists, 1)
+	assert.Equal(t, "ml-1", resp.PrefixedMailingLists[0].UID)
+}
+
+func TestPromoteFormationToPrimary_MailingListLookupFails_StillPromotes(t *testing.T) {
+	reader := mock.NewFakeGroupsIOReader()
+	reader.AddService(&model.GroupsIOService{UID: "svc-form", Type: constants.ITXServiceTypeFormation, Prefix: "form", ProjectUID: "proj-1"})
+	lists := mock.NewFakeGroupsIOReader()
+	lists.Err = errs.NewServiceUnavailable("itx down")
+	writer := &stubServiceWriter{}
+	o := newTestServiceWriter(writer, WithServiceWriterReader(reader), WithServicePromoter(writer), WithServiceWriterMailingListReader(lists))
+
+	resp, err := o.PromoteFormationToPrimary(context.Background(), "svc-form")
+	require.NoError(t, err)
+	assert.Equal(t, []string{"svc-form"}, writer.promoted)
+	assert.Empty(t, resp.PrefixedMailingLists)
+}
+
+func TestPromoteFormationToPrimary_PrimaryExists_ReturnsConflict(t *testing.T) {
+	reader := mock.NewFakeGroupsIOReader()
+	reader.AddService(&model.GroupsIOService{UID: "svc-primary", Type: constants.ITXServiceTypePrimary, ProjectUID: "proj-1"})
+	reader.AddService(&model.GroupsIOService{UID: "svc-form", Type: constants.ITXServiceTypeFormation, Prefix: "form", ProjectUID: "proj-1"})
+	writer := &stubServiceWriter{}
+	o := newTestServiceWriter(writer, WithServiceWriterReader(reader), WithServicePromoter(writer))
+
+	_, err := o.PromoteFormationToPrimary(context.Background(), "svc-form")
+	assert.IsType(t, errs.Conflict{}, err)
+	assert.Empty(t, writer.promoted)
+}
+
+func TestPromoteFormationToPrimary_NotFormation_ReturnsValidation(t *testing.T) {
+	reader := mock.NewFakeGroupsIOReader()
+	reader.AddService(&model.GroupsIOService{UID: "svc-shared", Type: constants.ITXServiceTypeShared, GroupID: int64Ptr(1), ProjectUID: "proj-1"})
+	writer := &stubServiceWriter{}
+	o := newTestServiceWriter(writer, WithServiceWriterReader(reader), WithServicePromoter(writer))
+
+	_, err := o.PromoteFormationToPrimary(context.Background(), "svc-shared")
+	assert.IsType(t, errs.Validation{}, err)
+	assert.Empty(t, writer.promoted)
+}
@@ -10,5 +10,32 @@ const (
 	ServiceTypeShared    = "shared"
 )
 
+// Service types as exchanged with the ITX API (and exposed by the v2 REST API).
+const (
+	ITXServiceTypePrimary   = "v2_primary"
+	ITXServiceTypeFormation = "v2_formation"
+	ITXServiceTypeShared    = "v2_shared"
+)
+
+// Service statuses as exchanged with the ITX API.
+const (
+	ServiceStatusPending  = "pending"
+	ServiceStatusCreated  = "created"
+	ServiceStatusActive   = "active"
+	ServiceStatusInactive = "inactive"
+	ServiceStatusDisabled = "disabled"
+	ServiceStatusDeleted  = "deleted"
+)
+
+// ServiceStatuses lists every recognised service status.
+var ServiceStatuses = []string{
+	ServiceStatusPending,
+	ServiceStatusCreated,
+	ServiceStatusActive,
+	ServiceStatusInactive,
+	ServiceStatusDisabled,
+	ServiceStatusDeleted,
+}
+
 // MailingListAPIQueue is the NATS queue group for mailing list service subscriptions
 const MailingListAPIQueue = "lfx-v2-mailing-list-api"
@@ -17,3 +17,24 @@ const (
 	ErrInvalidTimestampFormat = "invalid timestamp format, expected RFC3339 (2006-01-02T15:04:05Z07:00)"
 	ErrEmptyTimestamp         = "timestamp cannot be empty"
 )
+
+// DefaultReservedGroupNames are mailing list names that conflict with Groups.io internals: its
+// admin and moderator roles and the group's command addresses (e.g. main+owner@). They are
+// rejected on create unless the orchestrator is configured otherwise.
+var DefaultReservedGroupNames = []string{
+	"admin",
+	"moderators",
+	"owner",
+	"subscribe",
+	"unsubscribe",
+}
+
+// DeliveryModes are the member email delivery modes accepted by Groups.io.
+var DeliveryModes = []string{
+	"email_delivery_single",
+	"email_delivery_digest",
+	"email_delivery_none",
+	"email_delivery_special",
+	"email_delivery_html_digest",
+	"email_delivery_summary",
+}
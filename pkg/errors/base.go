@@ -21,6 +21,11 @@ func (b base) error() string {
 	return fmt.Sprintf("%s: %v", b.message, b.err)
 }
 
+// Message returns the error's own message, without the wrapped error's text.
+func (b base) Message() string {
+	return b.message
+}
+
 // Unwrap exposes the underlying error to support errors.Is / errors.As.
 func (b base) Unwrap() error {
 	return b.err
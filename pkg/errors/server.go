@@ -54,3 +54,28 @@ func NewServiceUnavailable(message string, err ...error) ServiceUnavailable {
 		},
 	}
 }
+
+// Timeout represents an upstream call that did not complete within its deadline.
+type Timeout struct {
+	base
+}
+
+// Error returns the error message for Timeout.
+func (t Timeout) Error() string {
+	return t.error()
+}
+
+// Unwrap returns the wrapped error, if any.
+func (t Timeout) Unwrap() error {
+	return t.err
+}
+
+// NewTimeout creates a new Timeout error with the provided message.
+func NewTimeout(message string, err ...error) Timeout {
+	return Timeout{
+		base: base{
+			message: message,
+			err:     errors.Join(err...),
+		},
+	}
+}
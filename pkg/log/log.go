@@ -10,6 +10,8 @@ import (
 	"log/slog"
 	"os"
 
+	"github.com/linuxfoundation/lfx-v2-mailing-list-service/pkg/constants"
+
 	slogotel "github.com/remychantenay/slog-otel"
 )
 
@@ -67,6 +69,24 @@ func AppendCtx(parent context.Context, attr slog.Attr) context.Context {
 	return context.WithValue(parent, slogFields, v)
 }
 
+// RequestIDKey is the log field carrying the ID of the API request a record belongs to.
+const RequestIDKey = "request_id"
+
+// WithRequestID stores the request ID in the context and attaches it as the request_id
+// field of every record logged with that context (or any context derived from it).
+func WithRequestID(parent context.Context, requestID string) context.Context {
+	ctx := context.WithValue(parent, constants.RequestIDContextKey, requestID)
+	return AppendCtx(ctx, slog.String(RequestIDKey, requestID))
+}
+
+// RequestIDFromContext returns the request ID set by WithRequestID, or "" if there is none.
+func RequestIDFromContext(ctx context.Context) string {
+	if requestID, ok := ctx.Value(constants.RequestIDContextKey).(string); ok {
+		return requestID
+	}
+	return ""
+}
+
 // InitStructureLogConfig sets the structured log behavior
 func InitStructureLogConfig() {
 	logOptions := &slog.HandlerOptions{}
@@ -142,6 +142,31 @@ func TestContextHandler_Handle(t *testing.T) {
 	// check that the attributes were actually added to the record
 }
 
+func TestWithRequestID_AddsRequestIDField(t *testing.T) {
+	var buf bytes.Buffer
+	logger := slog.New(contextHandler{slog.NewJSONHandler(&buf, nil)})
+
+	ctx := WithRequestID(context.Background(), "req-123")
+	logger.InfoContext(ctx, "test message")
+
+	var entry map[string]any
+	if err := json.Unmarshal(buf.Bytes(), &entry); err != nil {
+		t.Fatalf("failed to parse log output: %v", err)
+	}
+	if entry[RequestIDKey] != "req-123" {
+		t.Errorf("expected %s 'req-123', got %v", RequestIDKey, entry[RequestIDKey])
+	}
+	if got := RequestIDFromContext(ctx); got != "req-123" {
+		t.Errorf("expected request ID 'req-123' from context, got %q", got)
+	}
+}
+
+func TestRequestIDFromContext_Missing(t *testing.T) {
+	if got := RequestIDFromContext(context.Background()); got != "" {
+		t.Errorf("expected empty request ID, got %q", got)
+	}
+}
+
 func TestInitStructureLogConfig_DefaultLevel(t *testing.T) {
 	t.Setenv("LOG_LEVEL", "")
 	InitStructureLogConfig()
@@ -7,9 +7,76 @@
 package redaction
 
 import (
+	"fmt"
 	"strings"
+	"sync/atomic"
 )
 
+// Mode selects how much of an email address Email reveals.
+type Mode string
+
+const (
+	// ModeNone leaves email addresses unredacted. Intended for local development only.
+	ModeNone Mode = "none"
+	// ModePartial keeps a short prefix of the local part and the full domain (see RedactEmail).
+	ModePartial Mode = "partial"
+	// ModeFull hides the whole address.
+	ModeFull Mode = "full"
+)
+
+// fullyRedactedEmail replaces an email address in ModeFull.
+const fullyRedactedEmail = "****@****"
+
+// emailMode is the process-wide mode used by Email. Defaults to ModePartial.
+var emailMode atomic.Value
+
+func init() {
+	emailMode.Store(ModePartial)
+}
+
+// ParseMode parses a redaction mode name. An empty string yields ModePartial.
+func ParseMode(s string) (Mode, error) {
+	switch m := Mode(strings.ToLower(strings.TrimSpace(s))); m {
+	case "":
+		return ModePartial, nil
+	case ModeNone, ModePartial, ModeFull:
+		return m, nil
+	default:
+		return "", fmt.Errorf("unknown redaction mode %q (want none, partial or full)", s)
+	}
+}
+
+// SetEmailMode sets the mode used by Email for the whole process.
+func SetEmailMode(mode Mode) {
+	emailMode.Store(mode)
+}
+
+// Email redacts an email address according to the configured mode (see SetEmailMode).
+// Use it whenever an email address is written to logs.
+func Email(email string) string {
+	return RedactEmailMode(email, emailMode.Load().(Mode))
+}
+
+// RedactEmailMode redacts an email address using the given mode.
+//
+// Examples:
+//   - RedactEmailMode("johndoe@company.com", ModeNone) → "johndoe@company.com"
+//   - RedactEmailMode("johndoe@company.com", ModePartial) → "joh****@company.com"
+//   - RedactEmailMode("johndoe@company.com", ModeFull) → "****@****"
+func RedactEmailMode(email string, mode Mode) string {
+	if email == "" {
+		return ""
+	}
+	switch mode {
+	case ModeNone:
+		return email
+	case ModeFull:
+		return fullyRedactedEmail
+	default:
+		return RedactEmail(email)
+	}
+}
+
 // Redact redacts sensitive information for logging and output purposes.
 // Shows the first 3 characters when the string has more than 5 characters,
 // otherwise shows asterisks for shorter strings.
@@ -130,6 +130,72 @@ func TestRedactEmail(t *testing.T) {
 	}
 }
 
+func TestRedactEmailMode(t *testing.T) {
+	tests := []struct {
+		name     string
+		input    string
+		mode     Mode
+		expected string
+	}{
+		{name: "none keeps address", input: "johndoe@example.com", mode: ModeNone, expected: "johndoe@example.com"},
+		{name: "partial keeps prefix and domain", input: "johndoe@example.com", mode: ModePartial, expected: "joh****@example.com"},
+		{name: "full hides everything", input: "johndoe@example.com", mode: ModeFull, expected: "****@****"},
+		{name: "unknown mode falls back to partial", input: "johndoe@example.com", mode: Mode("bogus"), expected: "joh****@example.com"},
+		{name: "empty input stays empty", input: "", mode: ModeFull, expected: ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result := RedactEmailMode(tt.input, tt.mode)
+			if result != tt.expected {
+				t.Errorf("RedactEmailMode(%q, %q) = %q, want %q", tt.input, tt.mode, result, tt.expected)
+			}
+		})
+	}
+}
+
+func TestParseMode(t *testing.T) {
+	tests := []struct {
+		input    string
+		expected Mode
+		wantErr  bool
+	}{
+		{input: "", expected: ModePartial},
+		{input: "none", expected: ModeNone},
+		{input: "Partial", expected: ModePartial},
+		{input: " full ", expected: ModeFull},
+		{input: "masked", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.input, func(t *testing.T) {
+			mode, err := ParseMode(tt.input)
+			if tt.wantErr {
+				if err == nil {
+					t.Errorf("ParseMode(%q) expected error", tt.input)
+				}
+				return
+			}
+			if err != nil || mode != tt.expected {
+				t.Errorf("ParseMode(%q) = %q, %v, want %q", tt.input, mode, err, tt.expected)
+			}
+		})
+	}
+}
+
+func TestEmail_UsesConfiguredMode(t *testing.T) {
+	t.Cleanup(func() { SetEmailMode(ModePartial) })
+
+	SetEmailMode(ModeFull)
+	if got := Email("johndoe@example.com"); got != "****@****" {
+		t.Errorf("Email() in full mode = %q", got)
+	}
+	SetEmailMode(ModeNone)
+	if got := Email("johndoe@example.com"); got != "johndoe@example.com" {
+		t.Errorf("Email() in none mode = %q", got)
+	}
+}
+
 // Benchmarks to ensure redaction performance is acceptable
 func BenchmarkRedact(b *testing.B) {
 	testString := "johndoe123@example.com"
@@ -0,0 +1,27 @@
+// Copyright The Linux Foundation and each contributor to LFX.
+// SPDX-License-Identifier: MIT
+
+package utils
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRealClock_Sleep_Elapses(t *testing.T) {
+	start := time.Now()
+	err := RealClock{}.Sleep(context.Background(), 10*time.Millisecond)
+	assert.NoError(t, err)
+	assert.GreaterOrEqual(t, time.Since(start), 10*time.Millisecond)
+}
+
+func TestRealClock_Sleep_CancelledContext_ReturnsEarly(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	err := RealClock{}.Sleep(ctx, time.Minute)
+	assert.ErrorIs(t, err, context.Canceled)
+}
@@ -23,6 +23,10 @@
 //	            groupsio_artifact
 //	-reindex  Actually re-put KV entries and trigger reindexing (default: false,
 //	          logs what would be re-put without making any changes)
+//	-project  Only reindex objects tagged with this v2 project UID (default: all projects)
+//	-rate     Maximum KV re-puts per second (default: 0, unlimited)
+//
+// Re-putting an entry is idempotent, so an interrupted run can simply be started again.
 //
 // Environment variables:
 //
@@ -82,6 +86,8 @@ type osScrollResponse struct {
 func main() {
 	typesFlag := flag.String("types", "", "comma-separated list of object types to reindex (required)")
 	reindex := flag.Bool("reindex", false, "actually re-put KV entries and trigger reindexing (default: logs only)")
+	projectUID := flag.String("project", "", "only reindex objects belonging to this v2 project UID")
+	rate := flag.Int("rate", 0, "maximum KV re-puts per second (0 = unlimited)")
 	flag.Parse()
 
 	osURL := os.Getenv("OPENSEARCH_URL")
@@ -116,6 +122,8 @@ func main() {
 		"nats_url", natsURL,
 		"reindex", *reindex,
 		"types", *typesFlag,
+		"project_uid", *projectUID,
+		"rate", *rate,
 	)
 
 	nc, err := nats.Connect(natsURL,
@@ -128,12 +136,12 @@ func main() {
 		os.Exit(1)
 	}
 
-	exitCode := run(ctx, nc, osURL, requestedTypes, *reindex)
+	exitCode := run(ctx, nc, osURL, requestedTypes, *projectUID, *rate, *reindex)
 	nc.Close()
 	os.Exit(exitCode)
 }
 
-func run(ctx context.Context, nc *nats.Conn, osURL string, requestedTypes []string, reindex bool) int {
+func run(ctx context.Context, nc *nats.Conn, osURL string, requestedTypes []string, projectUID string, rate int, reindex bool) int {
 	js, err := jetstream.New(nc)
 	if err != nil {
 		slog.ErrorContext(ctx, "failed to create JetStream context", "error", err)
@@ -148,6 +156,14 @@ func run(ctx context.Context, nc *nats.Conn, osURL string, requestedTypes []stri
 
 	httpClient := &http.Client{Timeout: 30 * time.Second}
 
+	// throttle paces KV re-puts when -rate is set; nil means unlimited.
+	var throttle <-chan time.Time
+	if rate > 0 {
+		ticker := time.NewTicker(time.Second / time.Duration(rate))
+		defer ticker.Stop()
+		throttle = ticker.C
+	}
+
 	var totalProcessed, totalFailed, totalSkipped, totalNotFound int
 
 	for _, objectType := range requestedTypes {
@@ -156,7 +172,7 @@ func run(ctx context.Context, nc *nats.Conn, osURL string, requestedTypes []stri
 
 		slog.InfoContext(ctx, "processing object type", "object_type", objectType)
 
-		processed, failed, skipped, notFound, err := reindexType(ctx, httpClient, kv, osURL, objectType, kvPrefix, reindex)
+		processed, failed, skipped, notFound, err := reindexType(ctx, httpClient, kv, osURL, objectType, kvPrefix, projectUID, throttle, reindex)
 		if err != nil {
 			slog.ErrorContext(ctx, "fatal error processing type", "object_type", objectType, "error", err)
 			return 1
@@ -189,16 +205,18 @@ func run(ctx context.Context, nc *nats.Conn, osURL string, requestedTypes []stri
 	return 0
 }
 
-// reindexType scrolls OpenSearch for a given object_type, then re-puts each
-// matching KV entry to re-trigger the event processing pipeline.
+// reindexType scrolls OpenSearch for a given object_type (optionally limited to one
+// project), then re-puts each matching KV entry to re-trigger the event processing pipeline.
+// When throttle is non-nil, each re-put waits for a tick.
 func reindexType(
 	ctx context.Context,
 	httpClient *http.Client,
 	kv jetstream.KeyValue,
-	osURL, objectType, kvPrefix string,
+	osURL, objectType, kvPrefix, projectUID string,
+	throttle <-chan time.Time,
 	reindex bool,
 ) (processed, failed, skipped, notFound int, err error) {
-	scrollID, firstPage, err := openScroll(ctx, httpClient, osURL, objectType, scrollPageSize)
+	scrollID, firstPage, err := openScroll(ctx, httpClient, osURL, objectType, projectUID, scrollPageSize)
 	if err != nil {
 		return 0, 0, 0, 0, fmt.Errorf("open scroll: %w", err)
 	}
@@ -225,6 +243,14 @@ func reindexType(
 				continue
 			}
 
+			if throttle != nil {
+				select {
+				case <-ctx.Done():
+					return processed, failed, skipped, notFound, ctx.Err()
+				case <-throttle:
+				}
+			}
+
 			entry, getErr := kv.Get(ctx, kvKey)
 			if getErr != nil {
 				if errors.Is(getErr, jetstream.ErrKeyNotFound) {
@@ -263,11 +289,18 @@ func reindexType(
 	return processed, failed, skipped, notFound, nil
 }
 
-// openScroll opens an OpenSearch scroll for all documents of the given object_type.
-func openScroll(ctx context.Context, client *http.Client, osURL, objectType string, pageSize int) (string, []osHit, error) {
+// openScroll opens an OpenSearch scroll for all documents of the given object_type,
+// restricted to documents tagged project_uid:<projectUID> when projectUID is set.
+func openScroll(ctx context.Context, client *http.Client, osURL, objectType, projectUID string, pageSize int) (string, []osHit, error) {
+	filters := []map[string]any{
+		{"term": map[string]any{"object_type": objectType}},
+	}
+	if projectUID != "" {
+		filters = append(filters, map[string]any{"term": map[string]any{"tags": "project_uid:" + projectUID}})
+	}
 	query := map[string]any{
 		"query": map[string]any{
-			"term": map[string]any{"object_type": objectType},
+			"bool": map[string]any{"filter": filters},
 		},
 		"_source": []string{"object_id"},
 		"size":    pageSize,